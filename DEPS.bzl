@@ -4684,6 +4684,19 @@ def go_deps():
             "https://storage.googleapis.com/pingcapmirror/gomod/github.com/lightstep/lightstep-tracer-go/com_github_lightstep_lightstep_tracer_go-v0.15.6.zip",
         ],
     )
+    go_repository(
+        name = "com_github_linkedin_goavro_v2",
+        build_file_proto_mode = "disable_global",
+        importpath = "github.com/linkedin/goavro/v2",
+        sha256 = "d8125b07b796030376602d66bea868af562c15eb3098c75850c4f8435b3473de",
+        strip_prefix = "github.com/linkedin/goavro/v2@v2.12.0",
+        urls = [
+            "http://bazel-cache.pingcap.net:8080/gomod/github.com/linkedin/goavro/v2/com_github_linkedin_goavro_v2-v2.12.0.zip",
+            "http://ats.apps.svc/gomod/github.com/linkedin/goavro/v2/com_github_linkedin_goavro_v2-v2.12.0.zip",
+            "https://cache.hawkingrei.com/gomod/github.com/linkedin/goavro/v2/com_github_linkedin_goavro_v2-v2.12.0.zip",
+            "https://storage.googleapis.com/pingcapmirror/gomod/github.com/linkedin/goavro/v2/com_github_linkedin_goavro_v2-v2.12.0.zip",
+        ],
+    )
     go_repository(
         name = "com_github_lufeee_execinquery",
         build_file_proto_mode = "disable_global",
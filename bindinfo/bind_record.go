@@ -56,6 +56,8 @@ const (
 	Builtin = "builtin"
 	// History indicate the binding is created from statement summary by plan digest
 	History = "history"
+	// Regression indicates the binding is created by TiDB automatically to fix a plan regression.
+	Regression = "regression"
 )
 
 // Binding stores the basic bind hint info.
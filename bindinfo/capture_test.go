@@ -27,7 +27,10 @@ import (
 	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/parser/auth"
 	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/testkit"
+	"github.com/pingcap/tidb/util/execdetails"
 	utilparser "github.com/pingcap/tidb/util/parser"
 	"github.com/pingcap/tidb/util/stmtsummary"
 	"github.com/stretchr/testify/require"
@@ -1027,3 +1030,66 @@ func TestCaptureHints(t *testing.T) {
 		require.Equal(t, res[0][9], sqlDigestWithDB.String())
 	}
 }
+
+func TestCapturePlanRegressions(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, key ia(a), key ib(b))")
+	stmtsummary.StmtSummaryByDigestMap.Clear()
+	defer stmtsummary.StmtSummaryByDigestMap.Clear()
+
+	query := "select * from t where a > 1 and b > 1"
+	now := time.Now()
+	addStmt := func(planDigest, hint string, latency time.Duration, startTime time.Time) {
+		stmtsummary.StmtSummaryByDigestMap.AddStatement(&stmtsummary.StmtExecInfo{
+			SchemaName:    "test",
+			OriginalSQL:   query,
+			NormalizedSQL: "select * from `t` where `a` > ? and `b` > ?",
+			Digest:        "digest",
+			PlanDigest:    planDigest,
+			PlanGenerator: func() (string, string) { return "", hint },
+			User:          "root",
+			TotalLatency:  latency,
+			CopTasks:      &stmtctx.CopTasksDetails{},
+			ExecDetail:    &execdetails.ExecDetails{},
+			StmtCtx:       &stmtctx.StatementContext{StmtType: "Select"},
+			StartTime:     startTime,
+			Succeed:       true,
+		})
+	}
+	for i := 0; i < 10; i++ {
+		addStmt("p1", "use_index(@`sel_1` `test`.`t` `ia`)", time.Millisecond, now.Add(-time.Hour))
+	}
+	for i := 0; i < 10; i++ {
+		addStmt("p2", "use_index(@`sel_1` `test`.`t` `ib`)", 10*time.Millisecond, now)
+	}
+
+	// Nothing is captured when it's disabled.
+	bindHandle := dom.BindHandle()
+	bindHandle.CapturePlanRegressions(variable.PlanRegressionOff, 2, 10)
+	tk.MustQuery("select count(*) from mysql.plan_regressions").Check(testkit.Rows("0"))
+
+	// The regression is proposed without creating the binding.
+	bindHandle.CapturePlanRegressions(variable.PlanRegressionPropose, 2, 10)
+	tk.MustQuery("select default_db, sql_digest, regressed_plan_digest, regressed_exec_count, previous_plan_digest, previous_exec_count, bind_sql, status from mysql.plan_regressions").Check(testkit.Rows(
+		"test digest p2 10 p1 10 SELECT /*+ use_index(@`sel_1` `test`.`t` `ia`)*/ * FROM `test`.`t` WHERE `a` > 1 AND `b` > 1 proposed"))
+	tk.MustQuery("select regressed_avg_latency, previous_avg_latency from mysql.plan_regressions").Check(testkit.Rows("10000000 1000000"))
+	require.Len(t, tk.MustQuery("show global bindings").Rows(), 0)
+
+	// The proposed regression is fixed when the policy is changed to BIND.
+	bindHandle.CapturePlanRegressions(variable.PlanRegressionBind, 2, 10)
+	tk.MustQuery("select status from mysql.plan_regressions").Check(testkit.Rows("bound"))
+	rows := tk.MustQuery("show global bindings").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "select * from `test` . `t` where `a` > ? and `b` > ?", rows[0][0])
+	require.Equal(t, "SELECT /*+ use_index(@`sel_1` `test`.`t` `ia`)*/ * FROM `test`.`t` WHERE `a` > 1 AND `b` > 1", rows[0][1])
+	require.Equal(t, bindinfo.Regression, rows[0][8])
+	require.Equal(t, "p1", rows[0][10])
+
+	// The regression is handled only once, even if the binding is dropped.
+	tk.MustExec("drop global binding for " + query)
+	bindHandle.CapturePlanRegressions(variable.PlanRegressionBind, 2, 10)
+	tk.MustQuery("select count(*) from mysql.plan_regressions").Check(testkit.Rows("1"))
+	require.Len(t, tk.MustQuery("show global bindings").Rows(), 0)
+}
@@ -0,0 +1,212 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bindinfo
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/util/logutil"
+	utilparser "github.com/pingcap/tidb/util/parser"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"github.com/pingcap/tidb/util/stmtsummary"
+	stmtsummaryv2 "github.com/pingcap/tidb/util/stmtsummary/v2"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+// The status of the plan regressions in mysql.plan_regressions.
+const (
+	// regressionProposed means the binding fixing the regression is waiting for review.
+	regressionProposed = "proposed"
+	// regressionBound means the binding fixing the regression has been created.
+	regressionBound = "bound"
+)
+
+// planRegression is a statement which switches to a plan markedly slower than its previous plan.
+type planRegression struct {
+	regressed *stmtsummary.BindableStmt
+	previous  *stmtsummary.BindableStmt
+}
+
+// detectPlanRegressions finds the statements whose latest plan is at least ratio times slower than a previous plan
+// on average. Both plans need to be executed at least minExecCount times, and the previous plan must not be used since
+// the latest plan appears, which means the statement has switched to the latest plan.
+func detectPlanRegressions(stmts []*stmtsummary.BindableStmt, ratio float64, minExecCount int64) []planRegression {
+	type digestKey struct {
+		schema string
+		digest string
+	}
+	var keys []digestKey
+	plansByDigest := make(map[digestKey][]*stmtsummary.BindableStmt)
+	for _, stmt := range stmts {
+		if stmt.PlanDigest == "" {
+			continue
+		}
+		key := digestKey{schema: stmt.Schema, digest: stmt.Digest}
+		plans, ok := plansByDigest[key]
+		if !ok {
+			keys = append(keys, key)
+		}
+		// The same plan may be recorded more than once, e.g, after different previous statements in transactions.
+		idx := slices.IndexFunc(plans, func(plan *stmtsummary.BindableStmt) bool { return plan.PlanDigest == stmt.PlanDigest })
+		if idx < 0 {
+			plan := *stmt
+			plan.Users = maps.Clone(stmt.Users)
+			plansByDigest[key] = append(plans, &plan)
+			continue
+		}
+		mergeBindablePlan(plans[idx], stmt)
+	}
+
+	var regressions []planRegression
+	for _, key := range keys {
+		plans := plansByDigest[key]
+		if len(plans) < 2 {
+			continue
+		}
+		latest := plans[0]
+		for _, plan := range plans[1:] {
+			if plan.FirstSeen.After(latest.FirstSeen) {
+				latest = plan
+			}
+		}
+		if latest.ExecCount < minExecCount {
+			continue
+		}
+		var previous *stmtsummary.BindableStmt
+		for _, plan := range plans {
+			if plan == latest || plan.ExecCount < minExecCount || plan.PlanHint == "" || plan.LastSeen.After(latest.FirstSeen) {
+				continue
+			}
+			if previous == nil || plan.AvgLatency() < previous.AvgLatency() {
+				previous = plan
+			}
+		}
+		if previous == nil || float64(latest.AvgLatency()) < ratio*float64(previous.AvgLatency()) {
+			continue
+		}
+		regressions = append(regressions, planRegression{regressed: latest, previous: previous})
+	}
+	return regressions
+}
+
+func mergeBindablePlan(plan, other *stmtsummary.BindableStmt) {
+	if other.LastSeen.After(plan.LastSeen) {
+		plan.Query, plan.PlanHint = other.Query, other.PlanHint
+		plan.Charset, plan.Collation = other.Charset, other.Collation
+		plan.LastSeen = other.LastSeen
+	}
+	if other.FirstSeen.Before(plan.FirstSeen) {
+		plan.FirstSeen = other.FirstSeen
+	}
+	plan.ExecCount += other.ExecCount
+	plan.SumLatency += other.SumLatency
+	maps.Copy(plan.Users, other.Users)
+}
+
+// CapturePlanRegressions finds the plan regressions from the statement summary, and records them with the bindings
+// pinning the previous plans in mysql.plan_regressions. The bindings are also created if the policy is BIND.
+// A regression is handled only once, so it won't be fixed again if the binding is dropped or rejected by users.
+func (h *BindHandle) CapturePlanRegressions(policy string, ratio float64, minExecCount int64) {
+	if policy != variable.PlanRegressionPropose && policy != variable.PlanRegressionBind {
+		return
+	}
+	regressions := detectPlanRegressions(stmtsummaryv2.GetBindablePlans(), ratio, minExecCount)
+	if len(regressions) == 0 {
+		return
+	}
+	parser4Capture := parser.New()
+	for _, regression := range regressions {
+		if err := h.handlePlanRegression(parser4Capture, regression, policy); err != nil {
+			logutil.BgLogger().Warn("handle plan regression failed", zap.String("category", "sql-bind"),
+				zap.String("SQL", regression.previous.Query), zap.Error(err))
+		}
+	}
+}
+
+func (h *BindHandle) handlePlanRegression(p *parser.Parser, regression planRegression, policy string) error {
+	previous, regressed := regression.previous, regression.regressed
+	stmt, err := p.ParseOneStmt(previous.Query, previous.Charset, previous.Collation)
+	if err != nil {
+		return err
+	}
+	if insertStmt, ok := stmt.(*ast.InsertStmt); ok && insertStmt.Select == nil {
+		return nil
+	}
+	dbName := utilparser.GetDefaultDB(stmt, previous.Schema)
+	normalizedSQL, digest := parser.NormalizeDigest(utilparser.RestoreWithDefaultDB(stmt, dbName, previous.Query))
+	// The plan is already decided by users or the other bindings.
+	if r := h.GetBindRecord(digest.String(), normalizedSQL, dbName); r != nil && r.HasAvailableBinding() {
+		return nil
+	}
+
+	exec := h.sctx.Context.(sqlexec.RestrictedSQLExecutor)
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnBindInfo)
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, "SELECT status FROM mysql.plan_regressions WHERE sql_digest = %? AND regressed_plan_digest = %?",
+		regressed.Digest, regressed.PlanDigest)
+	if err != nil {
+		return err
+	}
+	recorded := len(rows) > 0
+	// Only the proposed regressions are fixed when the policy is changed to BIND.
+	if recorded && (policy != variable.PlanRegressionBind || rows[0].GetEnum(0).String() != regressionProposed) {
+		return nil
+	}
+	bindSQL := GenerateBindSQL(context.TODO(), stmt, previous.PlanHint, true, dbName)
+	if bindSQL == "" {
+		return nil
+	}
+
+	status := regressionProposed
+	if policy == variable.PlanRegressionBind {
+		h.sctx.Lock()
+		charset, collation := h.sctx.GetSessionVars().GetCharsetInfo()
+		h.sctx.Unlock()
+		binding := Binding{
+			BindSQL:    bindSQL,
+			Status:     Enabled,
+			Charset:    charset,
+			Collation:  collation,
+			Source:     Regression,
+			SQLDigest:  digest.String(),
+			PlanDigest: previous.PlanDigest,
+		}
+		// We don't need to pass the `sctx` because the BindSQL has been validated already.
+		if err = h.CreateBindRecord(nil, &BindRecord{OriginalSQL: normalizedSQL, Db: dbName, Bindings: []Binding{binding}}); err != nil {
+			return err
+		}
+		status = regressionBound
+		logutil.BgLogger().Info("plan regression is fixed by binding", zap.String("category", "sql-bind"),
+			zap.String("SQL", normalizedSQL), zap.String("regressedPlanDigest", regressed.PlanDigest),
+			zap.Duration("regressedAvgLatency", regressed.AvgLatency()), zap.String("previousPlanDigest", previous.PlanDigest),
+			zap.Duration("previousAvgLatency", previous.AvgLatency()))
+	}
+	if recorded {
+		_, _, err = exec.ExecRestrictedSQL(ctx, nil, "UPDATE mysql.plan_regressions SET status = %?, bind_sql = %? WHERE sql_digest = %? AND regressed_plan_digest = %?",
+			status, bindSQL, regressed.Digest, regressed.PlanDigest)
+		return err
+	}
+	_, _, err = exec.ExecRestrictedSQL(ctx, nil, `INSERT IGNORE INTO mysql.plan_regressions (default_db, sql_digest, original_sql,
+		regressed_plan_digest, regressed_avg_latency, regressed_exec_count, previous_plan_digest, previous_avg_latency, previous_exec_count,
+		bind_sql, status) VALUES (%?, %?, %?, %?, %?, %?, %?, %?, %?, %?, %?)`,
+		dbName, regressed.Digest, normalizedSQL, regressed.PlanDigest, regressed.AvgLatency().Nanoseconds(), regressed.ExecCount,
+		previous.PlanDigest, previous.AvgLatency().Nanoseconds(), previous.ExecCount, bindSQL, status)
+	return err
+}
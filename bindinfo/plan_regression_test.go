@@ -0,0 +1,96 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bindinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/util/stmtsummary"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectPlanRegressions(t *testing.T) {
+	now := time.Now()
+	newPlan := func(digest, planDigest, hint string, execCount int64, avgLatency time.Duration, firstSeen, lastSeen time.Duration) *stmtsummary.BindableStmt {
+		return &stmtsummary.BindableStmt{
+			Schema:     "test",
+			Query:      "select * from t where a > 1 and b > 1",
+			PlanHint:   hint,
+			Users:      map[string]struct{}{"root": {}},
+			Digest:     digest,
+			PlanDigest: planDigest,
+			ExecCount:  execCount,
+			SumLatency: avgLatency * time.Duration(execCount),
+			FirstSeen:  now.Add(-firstSeen),
+			LastSeen:   now.Add(-lastSeen),
+		}
+	}
+
+	// The statement switches from plan p1 to the slower plan p2.
+	stmts := []*stmtsummary.BindableStmt{
+		newPlan("d1", "p1", "use_index(@`sel_1` `test`.`t` `ia`)", 20, time.Millisecond, 2*time.Hour, time.Hour),
+		newPlan("d1", "p2", "use_index(@`sel_1` `test`.`t` `ib`)", 20, 10*time.Millisecond, 50*time.Minute, time.Minute),
+	}
+	regressions := detectPlanRegressions(stmts, 2, 10)
+	require.Len(t, regressions, 1)
+	require.Equal(t, "p2", regressions[0].regressed.PlanDigest)
+	require.Equal(t, "p1", regressions[0].previous.PlanDigest)
+
+	// The plan isn't slow enough.
+	require.Len(t, detectPlanRegressions(stmts, 20, 10), 0)
+	// The plans aren't executed enough times.
+	require.Len(t, detectPlanRegressions(stmts, 2, 30), 0)
+
+	// The same plan recorded in different entries is merged, so it's executed enough times.
+	stmts = append(stmts, newPlan("d1", "p2", "use_index(@`sel_1` `test`.`t` `ib`)", 20, 10*time.Millisecond, 30*time.Minute, time.Minute))
+	require.Len(t, detectPlanRegressions(stmts, 2, 30), 0)
+	stmts = append(stmts, newPlan("d1", "p1", "use_index(@`sel_1` `test`.`t` `ia`)", 20, time.Millisecond, 3*time.Hour, 2*time.Hour))
+	regressions = detectPlanRegressions(stmts, 2, 30)
+	require.Len(t, regressions, 1)
+	require.Equal(t, int64(40), regressions[0].regressed.ExecCount)
+	require.Equal(t, int64(40), regressions[0].previous.ExecCount)
+	require.Equal(t, 10*time.Millisecond, regressions[0].regressed.AvgLatency())
+	require.Equal(t, time.Millisecond, regressions[0].previous.AvgLatency())
+	// The input isn't modified by merging.
+	require.Equal(t, int64(20), stmts[1].ExecCount)
+
+	// The previous plan is still in use, so the statement switches between the plans instead of regressing.
+	stmts = []*stmtsummary.BindableStmt{
+		newPlan("d1", "p1", "use_index(@`sel_1` `test`.`t` `ia`)", 20, time.Millisecond, 2*time.Hour, 0),
+		newPlan("d1", "p2", "use_index(@`sel_1` `test`.`t` `ib`)", 20, 10*time.Millisecond, 50*time.Minute, time.Minute),
+	}
+	require.Len(t, detectPlanRegressions(stmts, 2, 10), 0)
+
+	// The plan without hints can't be pinned by bindings.
+	stmts = []*stmtsummary.BindableStmt{
+		newPlan("d1", "p1", "", 20, time.Millisecond, 2*time.Hour, time.Hour),
+		newPlan("d1", "p2", "use_index(@`sel_1` `test`.`t` `ib`)", 20, 10*time.Millisecond, 50*time.Minute, time.Minute),
+	}
+	require.Len(t, detectPlanRegressions(stmts, 2, 10), 0)
+
+	// The fastest previous plan is chosen, and the different statements are detected separately.
+	stmts = []*stmtsummary.BindableStmt{
+		newPlan("d1", "p1", "use_index(@`sel_1` `test`.`t` `ia`)", 20, 2*time.Millisecond, 3*time.Hour, 2*time.Hour),
+		newPlan("d1", "p2", "use_index(@`sel_1` `test`.`t` `ic`)", 20, time.Millisecond, 2*time.Hour, time.Hour),
+		newPlan("d1", "p3", "use_index(@`sel_1` `test`.`t` `ib`)", 20, 10*time.Millisecond, 50*time.Minute, time.Minute),
+		newPlan("d2", "p4", "use_index(@`sel_1` `test`.`t` `ia`)", 20, time.Millisecond, 3*time.Hour, 2*time.Hour),
+		newPlan("d2", "p5", "use_index(@`sel_1` `test`.`t` `ib`)", 20, time.Millisecond, 50*time.Minute, time.Minute),
+	}
+	regressions = detectPlanRegressions(stmts, 2, 10)
+	require.Len(t, regressions, 1)
+	require.Equal(t, "p3", regressions[0].regressed.PlanDigest)
+	require.Equal(t, "p2", regressions[0].previous.PlanDigest)
+}
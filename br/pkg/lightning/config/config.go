@@ -797,6 +797,14 @@ type CSVConfig struct {
 	UnescapedQuote bool `toml:"-" json:"-"`
 }
 
+// JSONLConfig is the config for JSON Lines (newline-delimited JSON) files.
+type JSONLConfig struct {
+	// ColumnPaths maps a column name to the JSON path of its value in each line,
+	// such as `$.user.name`. Columns not listed here are read from the top-level
+	// key with the same name.
+	ColumnPaths map[string]string `toml:"column-paths" json:"column-paths"`
+}
+
 func (csv *CSVConfig) adjust() error {
 	if len(csv.Separator) == 0 {
 		return common.ErrInvalidConfig.GenWithStack("`mydumper.csv.separator` must not be empty")
@@ -844,6 +852,7 @@ type MydumperRuntime struct {
 	SourceDir        string           `toml:"data-source-dir" json:"data-source-dir"`
 	CharacterSet     string           `toml:"character-set" json:"character-set"`
 	CSV              CSVConfig        `toml:"csv" json:"csv"`
+	JSONL            JSONLConfig      `toml:"jsonl" json:"jsonl"`
 	MaxRegionSize    ByteSize         `toml:"max-region-size" json:"max-region-size"`
 	Filter           []string         `toml:"filter" json:"filter"`
 	FileRouters      []*FileRouteRule `toml:"files" json:"files"`
@@ -109,6 +109,21 @@ func openParser(
 		if err != nil {
 			return nil, err
 		}
+	case mydump.SourceTypeAvro:
+		parser, err = mydump.NewAvroParser(ctx, reader)
+		if err != nil {
+			return nil, err
+		}
+	case mydump.SourceTypeORC:
+		parser, err = mydump.NewORCParser(ctx, reader)
+		if err != nil {
+			return nil, err
+		}
+	case mydump.SourceTypeJSONL:
+		parser, err = mydump.NewJSONLParser(ctx, &cfg.Mydumper.JSONL, reader, blockBufSize, ioWorkers, getJSONLColumnNames(tblInfo))
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, errors.Errorf("file '%s' with unknown source type '%s'", chunk.Key.Path, chunk.FileMeta.Type.String())
 	}
@@ -160,6 +175,20 @@ func getColumnNames(tableInfo *model.TableInfo, permutation []int) []string {
 	return names
 }
 
+// getJSONLColumnNames returns the columns read from JSON Lines files, they're
+// all the columns of the table except the generated ones, so the column order
+// doesn't depend on the keys of any line and is stable across restarts.
+func getJSONLColumnNames(tableInfo *model.TableInfo) []string {
+	names := make([]string, 0, len(tableInfo.Columns))
+	for _, col := range tableInfo.Columns {
+		if col.Hidden || col.IsGenerated() {
+			continue
+		}
+		names = append(names, col.Name.O)
+	}
+	return names
+}
+
 func (cr *chunkProcessor) process(
 	ctx context.Context,
 	t *TableImporter,
@@ -344,7 +373,7 @@ func (cr *chunkProcessor) encodeLoop(
 			err = cr.parser.ReadRow()
 			columnNames := cr.parser.Columns()
 			newOffset, rowID = cr.parser.Pos()
-			if cr.chunk.FileMeta.Compression != mydump.CompressionNone || cr.chunk.FileMeta.Type == mydump.SourceTypeParquet {
+			if cr.chunk.FileMeta.Compression != mydump.CompressionNone || cr.chunk.FileMeta.Type.IsWholeFile() {
 				newScannedOffset, scannedOffsetErr = cr.parser.ScannedPos()
 				if scannedOffsetErr != nil {
 					logger.Warn("fail to get data engine ScannedPos, progress may not be accurate",
@@ -494,7 +523,7 @@ func (cr *chunkProcessor) encodeLoop(
 		if m, ok := metric.FromContext(ctx); ok {
 			m.RowEncodeSecondsHistogram.Observe(encodeDur.Seconds())
 			m.RowReadSecondsHistogram.Observe(readDur.Seconds())
-			if cr.chunk.FileMeta.Type == mydump.SourceTypeParquet {
+			if cr.chunk.FileMeta.Type.IsWholeFile() {
 				m.RowReadBytesHistogram.Observe(float64(newScannedOffset - scannedOffset))
 			} else {
 				m.RowReadBytesHistogram.Observe(float64(newOffset - offset))
@@ -711,7 +740,7 @@ func (cr *chunkProcessor) deliverLoop(
 			}
 			delta := highOffset - lowOffset
 			if delta >= 0 {
-				if cr.chunk.FileMeta.Type == mydump.SourceTypeParquet {
+				if cr.chunk.FileMeta.Type.IsWholeFile() {
 					if currRealOffset > startRealOffset {
 						m.BytesCounter.WithLabelValues(metric.StateRestored).Add(float64(currRealOffset - startRealOffset))
 					}
@@ -843,3 +843,22 @@ func TestGetColumnsNames(t *testing.T) {
 	require.Equal(t, []string{"c", "_tidb_rowid", "a"}, getColumnNames(tableInfo, []int{2, -1, 0, 1}))
 	require.Equal(t, []string{"_tidb_rowid", "b"}, getColumnNames(tableInfo, []int{-1, 1, -1, 0}))
 }
+
+func TestGetJSONLColumnNames(t *testing.T) {
+	p := parser.New()
+	p.SetSQLMode(mysql.ModeANSIQuotes)
+	se := tmock.NewContext()
+	node, err := p.ParseOneStmt(`
+	CREATE TABLE "table" (
+		a INT,
+		B INT,
+		c INT AS (a + 1),
+		d JSON
+	)`, "", "")
+	require.NoError(t, err)
+	tableInfo, err := ddl.MockTableInfo(se, node.(*ast.CreateTableStmt), 0xabcdef)
+	require.NoError(t, err)
+	tableInfo.State = model.StatePublic
+
+	require.Equal(t, []string{"a", "B", "d"}, getJSONLColumnNames(tableInfo))
+}
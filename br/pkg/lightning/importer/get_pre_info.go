@@ -494,6 +494,21 @@ func (p *PreImportInfoGetterImpl) ReadFirstNRowsByFileMeta(ctx context.Context,
 		if err != nil {
 			return nil, nil, errors.Trace(err)
 		}
+	case mydump.SourceTypeAvro:
+		parser, err = mydump.NewAvroParser(ctx, reader)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+	case mydump.SourceTypeORC:
+		parser, err = mydump.NewORCParser(ctx, reader)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+	case mydump.SourceTypeJSONL:
+		parser, err = mydump.NewJSONLParser(ctx, &p.cfg.Mydumper.JSONL, reader, blockBufSize, p.ioWorkers, nil)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
 	default:
 		panic(fmt.Sprintf("unknown file type '%s'", dataFileMeta.Type))
 	}
@@ -662,6 +677,21 @@ func (p *PreImportInfoGetterImpl) sampleDataFromTable(
 		if err != nil {
 			return 0.0, false, errors.Trace(err)
 		}
+	case mydump.SourceTypeAvro:
+		parser, err = mydump.NewAvroParser(ctx, reader)
+		if err != nil {
+			return 0.0, false, errors.Trace(err)
+		}
+	case mydump.SourceTypeORC:
+		parser, err = mydump.NewORCParser(ctx, reader)
+		if err != nil {
+			return 0.0, false, errors.Trace(err)
+		}
+	case mydump.SourceTypeJSONL:
+		parser, err = mydump.NewJSONLParser(ctx, &p.cfg.Mydumper.JSONL, reader, blockBufSize, p.ioWorkers, getJSONLColumnNames(tableInfo))
+		if err != nil {
+			return 0.0, false, errors.Trace(err)
+		}
 	default:
 		panic(fmt.Sprintf("file '%s' with unknown source type '%s'", sampleFile.Path, sampleFile.Type.String()))
 	}
@@ -1727,8 +1727,8 @@ func (rc *Controller) importTables(ctx context.Context) (finalErr error) {
 			if len(cp.Engines) == 0 {
 				for i, fi := range tableMeta.DataFiles {
 					totalDataSizeToRestore += fi.FileMeta.FileSize
-					if fi.FileMeta.Type == mydump.SourceTypeParquet {
-						numberRows, err := mydump.ReadParquetFileRowCountByFile(ctx, rc.store, fi.FileMeta)
+					if fi.FileMeta.Type.IsWholeFile() {
+						numberRows, err := mydump.ReadWholeFileRowCountByFile(ctx, rc.store, fi.FileMeta)
 						if err != nil {
 							return errors.Trace(err)
 						}
@@ -1742,9 +1742,9 @@ func (rc *Controller) importTables(ctx context.Context) (finalErr error) {
 			} else {
 				for _, eng := range cp.Engines {
 					for _, chunk := range eng.Chunks {
-						// for parquet files filesize is more accurate, we can calculate correct unfinished bytes unless
+						// for parquet, avro and ORC files filesize is more accurate, we can calculate correct unfinished bytes unless
 						//  we set up the reader, so we directly use filesize here
-						if chunk.FileMeta.Type == mydump.SourceTypeParquet {
+						if chunk.FileMeta.Type.IsWholeFile() {
 							totalDataSizeToRestore += chunk.FileMeta.FileSize
 							if m, ok := metric.FromContext(ctx); ok {
 								m.RowsCounter.WithLabelValues(metric.StateTotalRestore, tableName).Add(float64(chunk.UnfinishedSize()))
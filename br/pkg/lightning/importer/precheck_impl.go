@@ -1017,7 +1017,14 @@ func (ci *schemaCheckItem) SchemaIsValid(ctx context.Context, tableInfo *mydump.
 	// get columns name from data file.
 	dataFileMeta := dataFile.FileMeta
 
-	if tp := dataFileMeta.Type; tp != mydump.SourceTypeCSV && tp != mydump.SourceTypeSQL && tp != mydump.SourceTypeParquet {
+	if dataFileMeta.Type == mydump.SourceTypeJSONL {
+		// values of JSON Lines files are mapped to columns by key, keys absent
+		// from a line are read as NULL and unknown keys are ignored.
+		log.FromContext(ctx).Info("skip checking JSON Lines file against schema", zap.String("path", dataFileMeta.Path))
+		return msgs, nil
+	}
+	if tp := dataFileMeta.Type; tp != mydump.SourceTypeCSV && tp != mydump.SourceTypeSQL && tp != mydump.SourceTypeParquet &&
+		tp != mydump.SourceTypeAvro && tp != mydump.SourceTypeORC {
 		msgs = append(msgs, fmt.Sprintf("file '%s' with unknown source type '%s'", dataFileMeta.Path, dataFileMeta.Type.String()))
 		return msgs, nil
 	}
@@ -0,0 +1,418 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/types"
+	"go.uber.org/zap"
+)
+
+const (
+	avroReadBufferSize = 64 * 1024
+
+	avroLogicalTypeDate = "date"
+	// maxAvroDecimalScale is used to format decimals whose scale is unknown,
+	// it's the max scale of the decimal type of TiDB.
+	maxAvroDecimalScale = 30
+)
+
+// avroFieldType is the type info of a field of the avro record, which can't
+// be derived from the decoded value.
+type avroFieldType struct {
+	// union is true if the field is a union, whose values are decoded as a map
+	// from the name of the member type to the value.
+	union       bool
+	logicalType string
+	// scale of the decimal values, it's -1 if unknown.
+	scale int
+}
+
+// AvroParser parses an avro object container file for import.
+// It implements the Parser interface.
+//
+// The top level schema of the file must be a record, each field of the record
+// is mapped to a column. The values are converted as follows:
+//   - boolean is converted to 1/0, int and long to integers, float and
+//     double to floats, string and enum to strings, bytes and fixed to bytes
+//   - decimal is converted to a decimal string, date to a date string,
+//     time-millis and time-micros to a time string, timestamp-millis and
+//     timestamp-micros to an UTC datetime string
+//   - null is converted to NULL, a union is converted by its member value
+//   - record, array and map are converted to JSON text
+type AvroParser struct {
+	reader     *goavro.OCFReader
+	columns    []string
+	fieldNames []string
+	fieldTypes []avroFieldType
+	readRows   int64
+	lastRow    Row
+	logger     log.Logger
+
+	readSeekCloser ReadSeekCloser
+	// bufReader buffers the reads from counter, the bytes consumed by the avro
+	// reader are the bytes read from counter minus the buffered ones.
+	bufReader *bufio.Reader
+	counter   *avroCountingReader
+}
+
+// avroCountingReader counts the bytes read from the underlying reader.
+type avroCountingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *avroCountingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// NewAvroParser generates an avro parser.
+func NewAvroParser(
+	ctx context.Context,
+	r storage.ReadSeekCloser,
+) (*AvroParser, error) {
+	offset, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	counter := &avroCountingReader{r: r, n: offset}
+	bufReader := bufio.NewReaderSize(counter, avroReadBufferSize)
+	reader, err := goavro.NewOCFReader(bufReader)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	fieldNames, fieldTypes, err := parseAvroSchema(reader.Codec().Schema())
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]string, 0, len(fieldNames))
+	for _, name := range fieldNames {
+		columns = append(columns, strings.ToLower(name))
+	}
+
+	return &AvroParser{
+		reader:         reader,
+		columns:        columns,
+		fieldNames:     fieldNames,
+		fieldTypes:     fieldTypes,
+		logger:         log.FromContext(ctx),
+		readSeekCloser: r,
+		bufReader:      bufReader,
+		counter:        counter,
+	}, nil
+}
+
+func parseAvroSchema(schema string) ([]string, []avroFieldType, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(schema), &v); err != nil {
+		return nil, nil, errors.Annotate(err, "invalid avro schema")
+	}
+	record, ok := v.(map[string]interface{})
+	if !ok || record["type"] != "record" {
+		return nil, nil, errors.Errorf("unsupported avro schema, the top level type must be a record, got %s", schema)
+	}
+	fields, _ := record["fields"].([]interface{})
+	names := make([]string, 0, len(fields))
+	fieldTypes := make([]avroFieldType, 0, len(fields))
+	for _, f := range fields {
+		field, ok := f.(map[string]interface{})
+		if !ok {
+			return nil, nil, errors.Errorf("invalid avro record field %v", f)
+		}
+		name, _ := field["name"].(string)
+		names = append(names, name)
+		fieldTypes = append(fieldTypes, newAvroFieldType(field["type"]))
+	}
+	return names, fieldTypes, nil
+}
+
+func newAvroFieldType(schema interface{}) avroFieldType {
+	ft := avroFieldType{scale: -1}
+	switch s := schema.(type) {
+	case []interface{}:
+		ft.union = true
+		// the type info can only be resolved for the union of null and another type,
+		// which is the usual way to declare a nullable field.
+		var member interface{}
+		memberCnt := 0
+		for _, m := range s {
+			if m != "null" {
+				member = m
+				memberCnt++
+			}
+		}
+		if memberCnt == 1 {
+			memberType := newAvroFieldType(member)
+			ft.logicalType, ft.scale = memberType.logicalType, memberType.scale
+		}
+	case map[string]interface{}:
+		if logicalType, ok := s["logicalType"].(string); ok {
+			ft.logicalType = logicalType
+		}
+		if scale, ok := s["scale"].(float64); ok {
+			ft.scale = int(scale)
+		} else if ft.logicalType == "decimal" {
+			// the scale of decimal is 0 if not specified.
+			ft.scale = 0
+		}
+	}
+	return ft
+}
+
+// ReadAvroFileRowCountByFile reads the avro file row count through fileMeta.
+// Only the block headers are decoded, the records are skipped.
+func ReadAvroFileRowCountByFile(
+	ctx context.Context,
+	store storage.ExternalStorage,
+	fileMeta SourceFileMeta,
+) (int64, error) {
+	r, err := store.Open(ctx, fileMeta.Path)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	//nolint: errcheck
+	defer r.Close()
+	reader, err := goavro.NewOCFReader(bufio.NewReaderSize(r, avroReadBufferSize))
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	var numRows int64
+	for reader.Scan() {
+		numRows += reader.RemainingBlockItems()
+		reader.SkipThisBlockAndReset()
+	}
+	if err = reader.Err(); err != nil {
+		return 0, errors.Trace(err)
+	}
+	return numRows, nil
+}
+
+// Pos returns the currently row number of the avro file
+func (ap *AvroParser) Pos() (pos int64, rowID int64) {
+	return ap.readRows, ap.lastRow.RowID
+}
+
+// SetPos sets the position in an avro file.
+// It implements the Parser interface.
+func (ap *AvroParser) SetPos(pos int64, rowID int64) error {
+	if pos < ap.readRows {
+		return errors.Errorf("can't seek back in avro file from row %d to row %d", ap.readRows, pos)
+	}
+	ap.lastRow.RowID = rowID
+
+	for ap.readRows < pos {
+		if !ap.reader.Scan() {
+			if err := ap.reader.Err(); err != nil {
+				return errors.Trace(err)
+			}
+			return errors.Errorf("avro file has only %d rows, can't seek to row %d", ap.readRows, pos)
+		}
+		// skip the whole block if possible, otherwise skip the records one by one.
+		if remaining := ap.reader.RemainingBlockItems(); remaining <= pos-ap.readRows {
+			ap.reader.SkipThisBlockAndReset()
+			ap.readRows += remaining
+			continue
+		}
+		if _, err := ap.reader.Read(); err != nil {
+			return errors.Trace(err)
+		}
+		ap.readRows++
+	}
+	return nil
+}
+
+// ScannedPos implements the Parser interface.
+// For avro it's the offset of the bytes consumed by the avro reader, which
+// reads the file block by block.
+func (ap *AvroParser) ScannedPos() (int64, error) {
+	return ap.counter.n - int64(ap.bufReader.Buffered()), nil
+}
+
+// Close closes the avro file of the parser.
+// It implements the Parser interface.
+func (ap *AvroParser) Close() error {
+	return ap.readSeekCloser.Close()
+}
+
+// ReadRow reads a row in the avro file by the parser.
+// It implements the Parser interface.
+func (ap *AvroParser) ReadRow() error {
+	ap.lastRow.RowID++
+	ap.lastRow.Length = 0
+	if !ap.reader.Scan() {
+		if err := ap.reader.Err(); err != nil {
+			return errors.Trace(err)
+		}
+		return io.EOF
+	}
+	datum, err := ap.reader.Read()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ap.readRows++
+	record, ok := datum.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("unexpected avro record %v", datum)
+	}
+
+	length := len(ap.fieldNames)
+	if cap(ap.lastRow.Row) < length {
+		ap.lastRow.Row = make([]types.Datum, length)
+	} else {
+		ap.lastRow.Row = ap.lastRow.Row[:length]
+	}
+	for i, name := range ap.fieldNames {
+		v := record[name]
+		ap.lastRow.Length += getAvroDatumLen(v)
+		if err := setAvroDatumValue(&ap.lastRow.Row[i], v, ap.fieldTypes[i], ap.logger); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getAvroDatumLen(v interface{}) int {
+	switch x := v.(type) {
+	case nil:
+		return 0
+	case string:
+		return len(x)
+	case []byte:
+		return len(x)
+	default:
+		return 8
+	}
+}
+
+// convert an avro value to Datum
+//
+// See: https://avro.apache.org/docs/1.11.1/specification/
+func setAvroDatumValue(d *types.Datum, v interface{}, ft avroFieldType, logger log.Logger) error {
+	switch x := v.(type) {
+	case nil:
+		d.SetNull()
+	case bool:
+		if x {
+			d.SetUint64(1)
+		} else {
+			d.SetUint64(0)
+		}
+	case int32:
+		d.SetInt64(int64(x))
+	case int64:
+		d.SetInt64(x)
+	case float32:
+		d.SetFloat32(x)
+	case float64:
+		d.SetFloat64(x)
+	case string:
+		d.SetString(x, "utf8mb4_bin")
+	case []byte:
+		d.SetBytes(x)
+	case *big.Rat:
+		d.SetString(formatAvroDecimal(x, ft.scale), "utf8mb4_bin")
+	case time.Time:
+		if ft.logicalType == avroLogicalTypeDate {
+			d.SetString(x.Format(time.DateOnly), "utf8mb4_bin")
+		} else {
+			d.SetString(x.UTC().Format(utcTimeLayout), "utf8mb4_bin")
+		}
+	case time.Duration:
+		d.SetString(time.Time{}.Add(x).Format("15:04:05.999999"), "utf8mb4_bin")
+	case map[string]interface{}:
+		if ft.union && len(x) == 1 {
+			for memberName, member := range x {
+				memberType := ft
+				memberType.union = false
+				if memberType.logicalType == "" && strings.HasSuffix(memberName, "."+avroLogicalTypeDate) {
+					memberType.logicalType = avroLogicalTypeDate
+				}
+				return setAvroDatumValue(d, member, memberType, logger)
+			}
+		}
+		return setAvroDatumByJSON(d, x)
+	case []interface{}:
+		return setAvroDatumByJSON(d, x)
+	default:
+		logger.Error("unknown value", zap.String("type", fmt.Sprintf("%T", v)), zap.Reflect("value", v))
+		return errors.Errorf("unknown value: %v", v)
+	}
+	return nil
+}
+
+func setAvroDatumByJSON(d *types.Datum, v interface{}) error {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	d.SetString(string(bs), "utf8mb4_bin")
+	return nil
+}
+
+func formatAvroDecimal(v *big.Rat, scale int) string {
+	if scale >= 0 {
+		return v.FloatString(scale)
+	}
+	s := v.FloatString(maxAvroDecimalScale)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// LastRow gets the last row parsed by the parser.
+// It implements the Parser interface.
+func (ap *AvroParser) LastRow() Row {
+	return ap.lastRow
+}
+
+// RecycleRow implements the Parser interface.
+func (*AvroParser) RecycleRow(_ Row) {
+}
+
+// Columns returns the _lower-case_ column names corresponding to values in
+// the LastRow.
+func (ap *AvroParser) Columns() []string {
+	return ap.columns
+}
+
+// SetColumns set restored column names to parser
+func (*AvroParser) SetColumns(_ []string) {
+	// just do nothing
+}
+
+// SetLogger sets the logger used in the parser.
+// It implements the Parser interface.
+func (ap *AvroParser) SetLogger(l log.Logger) {
+	ap.logger = l
+}
+
+// SetRowID sets the rowID in an avro file.
+// It implements the Parser interface.
+func (ap *AvroParser) SetRowID(rowID int64) {
+	ap.lastRow.RowID = rowID
+}
@@ -0,0 +1,169 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func openAvroParser(t *testing.T, fileName string) *AvroParser {
+	store, err := storage.NewLocalStorage("avro")
+	require.NoError(t, err)
+	r, err := store.Open(context.Background(), fileName)
+	require.NoError(t, err)
+	parser, err := NewAvroParser(context.Background(), r)
+	require.NoError(t, err)
+	return parser
+}
+
+func checkAvroRows(t *testing.T, parser *AvroParser, expectedRows [][]interface{}) {
+	for _, expected := range expectedRows {
+		require.NoError(t, parser.ReadRow())
+		row := parser.LastRow().Row
+		require.Len(t, row, len(expected))
+		for i, v := range expected {
+			require.Equal(t, v, row[i].GetValue(), "column %s", parser.Columns()[i])
+		}
+	}
+}
+
+func TestAvroParserKafkaConnect(t *testing.T) {
+	// the file is written with deflate codec in 2 blocks, the first block has 3 rows.
+	parser := openAvroParser(t, "kafka_connect.avro")
+	require.Equal(t, []string{
+		"id", "name", "price", "created_at", "birthday", "clock", "active",
+		"score", "ratio", "status", "payload", "tags", "attrs",
+	}, parser.Columns())
+
+	checkAvroRows(t, parser, [][]interface{}{
+		{
+			int64(1), "alice", "123.45", "2023-07-01 12:30:45.123Z", "1990-01-02", "13:14:15.5", uint64(1),
+			float32(1.5), 0.25, "ACTIVE", []byte{0, 1}, `["a","b"]`, `{"k":1}`,
+		},
+		{
+			int64(2), nil, "-0.05", "1970-01-01 00:00:00Z", nil, "00:00:00", uint64(0),
+			float32(-2), 1e10, "INACTIVE", []byte{}, `[]`, `{}`,
+		},
+		{
+			int64(3), "carol", nil, "2000-02-29 23:59:59Z", "2000-02-29", "00:00:01", uint64(1),
+			float32(0), -0.5, "ACTIVE", []byte("x"), `["c"]`, `{}`,
+		},
+		{
+			int64(4), "dave", "100.00", "2023-12-31 01:02:03Z", nil, "00:01:00", uint64(0),
+			float32(3.25), 3.5, "INACTIVE", []byte("y"), `[]`, `{}`,
+		},
+	})
+	pos, rowID := parser.Pos()
+	require.Equal(t, int64(4), pos)
+	require.Equal(t, int64(4), rowID)
+	checkAvroRows(t, parser, [][]interface{}{
+		{
+			int64(5), "eve", "0.01", "2024-01-01 00:00:00Z", nil, "00:00:00", uint64(1),
+			float32(4), 4.5, "ACTIVE", []byte("z"), `[]`, `{}`,
+		},
+	})
+	require.ErrorIs(t, parser.ReadRow(), io.EOF)
+	require.NoError(t, parser.Close())
+}
+
+func TestAvroParserSnappy(t *testing.T) {
+	parser := openAvroParser(t, "snappy.avro")
+	require.Equal(t, []string{"id", "name", "amount", "updated", "value"}, parser.Columns())
+	checkAvroRows(t, parser, [][]interface{}{
+		{int64(1), "x", "-12345.6789", "2023-01-02 03:04:05.678901Z", int64(42)},
+		{int64(2), nil, "0.0001", nil, "forty-two"},
+		{int64(3), "z", "5.0000", nil, nil},
+	})
+	require.ErrorIs(t, parser.ReadRow(), io.EOF)
+	require.NoError(t, parser.Close())
+}
+
+func TestAvroParserSetPos(t *testing.T) {
+	store, err := storage.NewLocalStorage("avro")
+	require.NoError(t, err)
+	rowCount, err := ReadAvroFileRowCountByFile(context.Background(), store, SourceFileMeta{Path: "kafka_connect.avro"})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), rowCount)
+
+	for _, pos := range []int64{0, 2, 3, 4} {
+		parser := openAvroParser(t, "kafka_connect.avro")
+		require.NoError(t, parser.SetPos(pos, pos+100))
+		for i := pos; i < rowCount; i++ {
+			require.NoError(t, parser.ReadRow())
+			require.Equal(t, i+1, parser.LastRow().Row[0].GetInt64())
+			require.Equal(t, i+101, parser.LastRow().RowID)
+		}
+		require.ErrorIs(t, parser.ReadRow(), io.EOF)
+		require.Error(t, parser.SetPos(1, 0))
+		require.NoError(t, parser.Close())
+	}
+
+	parser := openAvroParser(t, "kafka_connect.avro")
+	require.ErrorContains(t, parser.SetPos(6, 0), "avro file has only 5 rows")
+	require.NoError(t, parser.Close())
+}
+
+func TestAvroParserInvalidSchema(t *testing.T) {
+	_, _, err := parseAvroSchema(`"long"`)
+	require.ErrorContains(t, err, "the top level type must be a record")
+	_, _, err = parseAvroSchema(`{"type": "array", "items": "long"}`)
+	require.ErrorContains(t, err, "the top level type must be a record")
+
+	names, fieldTypes, err := parseAvroSchema(`{"type": "record", "name": "r", "fields": [
+		{"name": "a", "type": ["null", {"type": "bytes", "logicalType": "decimal", "precision": 5}]},
+		{"name": "b", "type": ["null", "long", {"type": "int", "logicalType": "date"}]},
+		{"name": "c", "type": {"type": "int", "logicalType": "date"}}
+	]}`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, names)
+	require.Equal(t, []avroFieldType{
+		{union: true, logicalType: "decimal", scale: 0},
+		{union: true, scale: -1},
+		{logicalType: "date", scale: -1},
+	}, fieldTypes)
+}
+
+func TestAvroParserScannedPos(t *testing.T) {
+	// the file is much smaller than the read buffer, the scanned position
+	// must only count the bytes consumed by the avro reader.
+	const fileSize = 1172
+	parser := openAvroParser(t, "kafka_connect.avro")
+	headerEnd, err := parser.ScannedPos()
+	require.NoError(t, err)
+	require.Greater(t, headerEnd, int64(0))
+
+	require.NoError(t, parser.ReadRow())
+	firstBlockEnd, err := parser.ScannedPos()
+	require.NoError(t, err)
+	require.Greater(t, firstBlockEnd, headerEnd)
+	require.Less(t, firstBlockEnd, int64(fileSize))
+	// the rows of the same block don't consume more bytes.
+	require.NoError(t, parser.ReadRow())
+	require.NoError(t, parser.ReadRow())
+	pos, err := parser.ScannedPos()
+	require.NoError(t, err)
+	require.Equal(t, firstBlockEnd, pos)
+
+	require.NoError(t, parser.ReadRow())
+	pos, err = parser.ScannedPos()
+	require.NoError(t, err)
+	require.Equal(t, int64(fileSize), pos)
+	require.NoError(t, parser.Close())
+}
@@ -0,0 +1,467 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/lightning/metric"
+	"github.com/pingcap/tidb/br/pkg/lightning/worker"
+	"github.com/pingcap/tidb/types"
+	"go.uber.org/zap"
+)
+
+var errJSONLNotObject = errors.NewNoStackError("syntax error: each line of a JSON Lines file must be a JSON object")
+
+// jsonPathLeg is one step of a jsonPath, either an object key or an array
+// index.
+type jsonPathLeg struct {
+	key   string
+	index int
+}
+
+// jsonPath is a simplified JSON path such as `$.user.name` or `$.tags[0]`.
+// We don't use types.JSONPathExpression because it works on BinaryJSON, which
+// stores numbers as float64 and loses the precision of decimals.
+type jsonPath []jsonPathLeg
+
+// parseJSONPath parses a JSON path. Supported legs are `.key`, `."quoted key"`
+// and `[index]`, wildcards are not supported since a column holds one value.
+func parseJSONPath(path string) (jsonPath, error) {
+	s := strings.TrimSpace(path)
+	if len(s) == 0 || s[0] != '$' {
+		return nil, errors.Errorf("invalid JSON path '%s': it must start with '$'", path)
+	}
+	s = s[1:]
+	var p jsonPath
+	for len(s) > 0 {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+			if len(s) > 0 && s[0] == '"' {
+				end := 1
+				for end < len(s) && s[end] != '"' {
+					if s[end] == '\\' {
+						end++
+					}
+					end++
+				}
+				if end >= len(s) {
+					return nil, errors.Errorf("invalid JSON path '%s': unterminated quoted key", path)
+				}
+				key, err := strconv.Unquote(s[:end+1])
+				if err != nil {
+					return nil, errors.Errorf("invalid JSON path '%s': %s", path, err.Error())
+				}
+				p = append(p, jsonPathLeg{key: key, index: -1})
+				s = s[end+1:]
+				continue
+			}
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			if end == 0 {
+				return nil, errors.Errorf("invalid JSON path '%s': empty key", path)
+			}
+			p = append(p, jsonPathLeg{key: s[:end], index: -1})
+			s = s[end:]
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return nil, errors.Errorf("invalid JSON path '%s': unterminated array index", path)
+			}
+			idx, err := strconv.Atoi(strings.TrimSpace(s[1:end]))
+			if err != nil || idx < 0 {
+				return nil, errors.Errorf("invalid JSON path '%s': invalid array index '%s'", path, s[1:end])
+			}
+			p = append(p, jsonPathLeg{index: idx})
+			s = s[end+1:]
+		default:
+			return nil, errors.Errorf("invalid JSON path '%s': unexpected character '%c'", path, s[0])
+		}
+	}
+	return p, nil
+}
+
+// ValidateJSONPath checks whether path is a JSON path accepted by JSONLParser.
+func ValidateJSONPath(path string) error {
+	_, err := parseJSONPath(path)
+	return err
+}
+
+// SplitJSONPaths splits a comma separated list of JSON paths. Commas inside
+// quoted keys such as `$."a,b"` don't separate paths. The paths are trimmed
+// but not validated.
+func SplitJSONPaths(s string) ([]string, error) {
+	var (
+		paths   []string
+		start   int
+		inQuote bool
+	)
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if inQuote {
+				i++
+			}
+		case '"':
+			inQuote = !inQuote
+		case ',':
+			if !inQuote {
+				paths = append(paths, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if inQuote {
+		return nil, errors.Errorf("invalid JSON paths '%s': unterminated quoted key", s)
+	}
+	return append(paths, strings.TrimSpace(s[start:])), nil
+}
+
+// jsonlField describes where to find the value of a column in a line.
+type jsonlField struct {
+	// key is the top-level key of the value, it's matched case-insensitively
+	// when the column is mapped by name.
+	key string
+	// path is set when the column is mapped by JSON path, key is unused then.
+	path jsonPath
+}
+
+// JSONLParser is a parser of JSON Lines (newline-delimited JSON) files, each
+// line of which holds one JSON object that is mapped to one row. Keys absent
+// from a line are read as NULL, and keys not mapped to any column are ignored.
+type JSONLParser struct {
+	blockParser
+
+	fields []jsonlField
+	// lower-case column name -> JSON path configured by the user.
+	columnPaths map[string]jsonPath
+	// when no columns are given, the columns are inferred from the keys of the
+	// first line.
+	inferColumns bool
+
+	// top-level keys and values of the line being parsed.
+	line        []byte
+	keys        []string
+	values      []json.RawMessage
+	keyIdx      map[string]int
+	lowerKeyIdx map[string]int
+}
+
+// NewJSONLParser creates a JSON Lines parser. columns are the columns the values
+// of each row are produced for, and when it's empty, the top-level keys of the
+// first line are used instead.
+func NewJSONLParser(
+	ctx context.Context,
+	cfg *config.JSONLConfig,
+	reader ReadSeekCloser,
+	blockBufSize int64,
+	ioWorkers *worker.Pool,
+	columns []string,
+) (*JSONLParser, error) {
+	columnPaths := make(map[string]jsonPath)
+	if cfg != nil {
+		for col, path := range cfg.ColumnPaths {
+			p, err := parseJSONPath(path)
+			if err != nil {
+				return nil, errors.Annotatef(err, "column '%s'", col)
+			}
+			columnPaths[strings.ToLower(col)] = p
+		}
+	}
+	metrics, _ := metric.FromContext(ctx)
+	parser := &JSONLParser{
+		blockParser:  makeBlockParser(reader, blockBufSize, ioWorkers, metrics, log.FromContext(ctx)),
+		columnPaths:  columnPaths,
+		inferColumns: len(columns) == 0,
+		keyIdx:       make(map[string]int),
+		lowerKeyIdx:  make(map[string]int),
+	}
+	if !parser.inferColumns {
+		parser.setFields(columns)
+	}
+	return parser, nil
+}
+
+func (parser *JSONLParser) setFields(columns []string) {
+	parser.columns = make([]string, 0, len(columns))
+	parser.fields = make([]jsonlField, 0, len(columns))
+	for _, col := range columns {
+		lowerCol := strings.ToLower(col)
+		parser.columns = append(parser.columns, lowerCol)
+		parser.fields = append(parser.fields, jsonlField{key: col, path: parser.columnPaths[lowerCol]})
+	}
+}
+
+// inferFields sets the columns to the top-level keys of the current line,
+// followed by the columns which are only configured with a JSON path.
+func (parser *JSONLParser) inferFields() {
+	columns := slices.Clone(parser.keys)
+	seen := make(map[string]struct{}, len(columns))
+	for _, key := range columns {
+		seen[strings.ToLower(key)] = struct{}{}
+	}
+	extra := make([]string, 0, len(parser.columnPaths))
+	for col := range parser.columnPaths {
+		if _, ok := seen[col]; !ok {
+			extra = append(extra, col)
+		}
+	}
+	slices.Sort(extra)
+	parser.setFields(append(columns, extra...))
+	parser.inferColumns = false
+}
+
+// SetColumns implements the Parser interface. The columns of a JSONLParser are
+// decided when it's created or by the first line, so the restored columns are
+// ignored.
+func (*JSONLParser) SetColumns(_ []string) {
+	// just do nothing
+}
+
+// readLine reads the next line without the trailing newline. The returned
+// slice is only valid until the next read.
+func (parser *JSONLParser) readLine() ([]byte, error) {
+	searched := 0
+	for {
+		if idx := bytes.IndexByte(parser.buf[searched:], '\n'); idx >= 0 {
+			idx += searched
+			line := parser.buf[:idx]
+			parser.buf = parser.buf[idx+1:]
+			parser.pos += int64(idx + 1)
+			return line, nil
+		}
+		searched = len(parser.buf)
+		if parser.isLastChunk {
+			if len(parser.buf) == 0 {
+				return nil, io.EOF
+			}
+			line := parser.buf
+			parser.buf = nil
+			parser.pos += int64(len(line))
+			return line, nil
+		}
+		if len(parser.buf) > LargestEntryLimit {
+			return nil, errors.New("size of row cannot exceed the max value of txn-entry-size-limit")
+		}
+		if err := parser.readBlock(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+}
+
+// decodeLine decodes the top-level keys and values of a line.
+func (parser *JSONLParser) decodeLine(line []byte) error {
+	parser.line = line
+	parser.keys = parser.keys[:0]
+	parser.values = parser.values[:0]
+	clear(parser.keyIdx)
+	clear(parser.lowerKeyIdx)
+
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	tok, err := decoder.Token()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return errJSONLNotObject
+	}
+	for decoder.More() {
+		tok, err = decoder.Token()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		//nolint: forcetypeassert
+		key := tok.(string)
+		var value json.RawMessage
+		if err = decoder.Decode(&value); err != nil {
+			return errors.Trace(err)
+		}
+		parser.keyIdx[key] = len(parser.keys)
+		lowerKey := strings.ToLower(key)
+		if _, ok := parser.lowerKeyIdx[lowerKey]; !ok {
+			parser.lowerKeyIdx[lowerKey] = len(parser.keys)
+		}
+		parser.keys = append(parser.keys, key)
+		parser.values = append(parser.values, value)
+	}
+	if _, err = decoder.Token(); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err = decoder.Token(); err != io.EOF {
+		return errors.New("syntax error: unexpected content after the JSON object")
+	}
+	return nil
+}
+
+// lookup returns the value of the field in the current line, found is false if
+// the value doesn't exist.
+func (parser *JSONLParser) lookup(field *jsonlField) (value json.RawMessage, found bool, err error) {
+	if field.path == nil {
+		idx, ok := parser.keyIdx[field.key]
+		if !ok {
+			idx, ok = parser.lowerKeyIdx[strings.ToLower(field.key)]
+		}
+		if !ok {
+			return nil, false, nil
+		}
+		return parser.values[idx], true, nil
+	}
+
+	if len(field.path) == 0 {
+		return parser.line, true, nil
+	}
+	if field.path[0].index >= 0 {
+		// a line is always an object, not an array.
+		return nil, false, nil
+	}
+	idx, ok := parser.keyIdx[field.path[0].key]
+	if !ok {
+		return nil, false, nil
+	}
+	return walkJSONPath(parser.values[idx], field.path[1:])
+}
+
+func walkJSONPath(value json.RawMessage, path jsonPath) (json.RawMessage, bool, error) {
+	for _, leg := range path {
+		if len(value) == 0 {
+			return nil, false, nil
+		}
+		if leg.index >= 0 {
+			if value[0] != '[' {
+				return nil, false, nil
+			}
+			var arr []json.RawMessage
+			if err := json.Unmarshal(value, &arr); err != nil {
+				return nil, false, errors.Trace(err)
+			}
+			if leg.index >= len(arr) {
+				return nil, false, nil
+			}
+			value = arr[leg.index]
+			continue
+		}
+		if value[0] != '{' {
+			return nil, false, nil
+		}
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(value, &obj); err != nil {
+			return nil, false, errors.Trace(err)
+		}
+		var ok bool
+		if value, ok = obj[leg.key]; !ok {
+			return nil, false, nil
+		}
+	}
+	return value, true, nil
+}
+
+// setJSONLDatum converts a JSON value to a datum. Strings are unquoted, numbers
+// keep their text to not lose precision, booleans are converted to 1 and 0,
+// and objects and arrays are kept as JSON text.
+func setJSONLDatum(d *types.Datum, value json.RawMessage) error {
+	value = bytes.TrimSpace(value)
+	if len(value) == 0 {
+		d.SetNull()
+		return nil
+	}
+	switch value[0] {
+	case 'n':
+		d.SetNull()
+	case 't':
+		d.SetString("1", "utf8mb4_bin")
+	case 'f':
+		d.SetString("0", "utf8mb4_bin")
+	case '"':
+		var s string
+		if err := json.Unmarshal(value, &s); err != nil {
+			return errors.Trace(err)
+		}
+		d.SetString(s, "utf8mb4_bin")
+	default:
+		d.SetString(string(value), "utf8mb4_bin")
+	}
+	return nil
+}
+
+// ReadRow reads a row from the datafile.
+func (parser *JSONLParser) ReadRow() error {
+	row := &parser.lastRow
+	row.Length = 0
+	row.RowID++
+
+	var line []byte
+	for {
+		var err error
+		if line, err = parser.readLine(); err != nil {
+			return errors.Trace(err)
+		}
+		line = bytes.TrimSpace(line)
+		// skip blank lines
+		if len(line) > 0 {
+			break
+		}
+	}
+	if err := parser.decodeLine(line); err != nil {
+		parser.Logger.Error("invalid JSON line",
+			zap.Int64("pos", parser.pos),
+			zap.ByteString("content", line[:min(len(line), 256)]),
+		)
+		return errors.Annotate(err, "syntax error: invalid JSON line")
+	}
+	if parser.inferColumns {
+		parser.inferFields()
+	}
+
+	row.Length = len(line)
+	row.Row = parser.acquireDatumSlice()
+	if cap(row.Row) >= len(parser.fields) {
+		row.Row = row.Row[:len(parser.fields)]
+	} else {
+		row.Row = make([]types.Datum, len(parser.fields))
+	}
+	for i := range parser.fields {
+		value, found, err := parser.lookup(&parser.fields[i])
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !found {
+			row.Row[i].SetNull()
+			continue
+		}
+		if err = setJSONLDatum(&row.Row[i], value); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// ReadUntilNewLine seeks the file until the next newline, and returns the file
+// offset beyond the newline.
+func (parser *JSONLParser) ReadUntilNewLine() (int64, error) {
+	_, err := parser.readLine()
+	return parser.pos, err
+}
@@ -0,0 +1,250 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump_test
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/types"
+	"github.com/stretchr/testify/require"
+)
+
+func readAllJSONLRows(t *testing.T, parser mydump.Parser) [][]types.Datum {
+	var rows [][]types.Datum
+	for {
+		err := parser.ReadRow()
+		if errors.Cause(err) == io.EOF {
+			return rows
+		}
+		require.NoError(t, err)
+		rows = append(rows, append([]types.Datum{}, parser.LastRow().Row...))
+	}
+}
+
+func TestJSONLParserInferColumns(t *testing.T) {
+	input := "{\"id\": 1, \"Name\": \"a\\tb\", \"price\": 12345678901234567890.123, \"ok\": true}\n" +
+		"\n" +
+		"  {\"ok\": false, \"id\": 2, \"extra\": [1, 2]}\r\n" +
+		"{\"id\": 3, \"name\": null, \"price\": 1e3}"
+	parser, err := mydump.NewJSONLParser(context.Background(), nil, mydump.NewStringReader(input), 8, ioWorkersForCSV, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, parser.ReadRow())
+	require.Equal(t, []string{"id", "name", "price", "ok"}, parser.Columns())
+	require.Equal(t, int64(1), parser.LastRow().RowID)
+	require.Equal(t, []types.Datum{
+		types.NewStringDatum("1"),
+		types.NewStringDatum("a\tb"),
+		types.NewStringDatum("12345678901234567890.123"),
+		types.NewStringDatum("1"),
+	}, parser.LastRow().Row)
+	assertPosEqual(t, parser, 73, 1)
+
+	require.NoError(t, parser.ReadRow())
+	require.Equal(t, []types.Datum{
+		types.NewStringDatum("2"),
+		nullDatum,
+		nullDatum,
+		types.NewStringDatum("0"),
+	}, parser.LastRow().Row)
+	assertPosEqual(t, parser, 117, 2)
+
+	require.NoError(t, parser.ReadRow())
+	require.Equal(t, []types.Datum{
+		types.NewStringDatum("3"),
+		nullDatum,
+		types.NewStringDatum("1e3"),
+		nullDatum,
+	}, parser.LastRow().Row)
+	assertPosEqual(t, parser, int64(len(input)), 3)
+
+	require.ErrorIs(t, errors.Cause(parser.ReadRow()), io.EOF)
+	require.NoError(t, parser.Close())
+}
+
+func TestJSONLParserColumnPaths(t *testing.T) {
+	input := `{"id": 1, "user": {"name": "alice", "tags": ["x", "y"]}, "a b": {"c": 1.50}}
+{"id": 2, "user": {"name": "bob", "tags": []}, "a b": "not an object"}
+{"id": 3, "user": "no user"}
+`
+	cfg := &config.JSONLConfig{
+		ColumnPaths: map[string]string{
+			"user_name": "$.user.name",
+			"TAG":       "$.user.tags[1]",
+			"abc":       `$."a b".c`,
+			"user":      "$.user",
+		},
+	}
+
+	// columns are given
+	parser, err := mydump.NewJSONLParser(context.Background(), cfg, mydump.NewStringReader(input), 16, ioWorkersForCSV,
+		[]string{"ID", "user_name", "tag", "abc", "user", "missing"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "user_name", "tag", "abc", "user", "missing"}, parser.Columns())
+	require.Equal(t, [][]types.Datum{
+		{
+			types.NewStringDatum("1"),
+			types.NewStringDatum("alice"),
+			types.NewStringDatum("y"),
+			types.NewStringDatum("1.50"),
+			types.NewStringDatum(`{"name": "alice", "tags": ["x", "y"]}`),
+			nullDatum,
+		},
+		{
+			types.NewStringDatum("2"),
+			types.NewStringDatum("bob"),
+			nullDatum,
+			nullDatum,
+			types.NewStringDatum(`{"name": "bob", "tags": []}`),
+			nullDatum,
+		},
+		{
+			types.NewStringDatum("3"),
+			nullDatum,
+			nullDatum,
+			nullDatum,
+			types.NewStringDatum("no user"),
+			nullDatum,
+		},
+	}, readAllJSONLRows(t, parser))
+	// restored columns don't change the columns of JSON Lines parser.
+	parser.SetColumns([]string{"a"})
+	require.Equal(t, []string{"id", "user_name", "tag", "abc", "user", "missing"}, parser.Columns())
+	require.NoError(t, parser.Close())
+
+	// columns are inferred, the columns only mapped by path are appended.
+	parser, err = mydump.NewJSONLParser(context.Background(), cfg, mydump.NewStringReader(input), 16, ioWorkersForCSV, nil)
+	require.NoError(t, err)
+	require.NoError(t, parser.ReadRow())
+	require.Equal(t, []string{"id", "user", "a b", "abc", "tag", "user_name"}, parser.Columns())
+	require.Equal(t, []types.Datum{
+		types.NewStringDatum("1"),
+		types.NewStringDatum(`{"name": "alice", "tags": ["x", "y"]}`),
+		types.NewStringDatum(`{"c": 1.50}`),
+		types.NewStringDatum("1.50"),
+		types.NewStringDatum("y"),
+		types.NewStringDatum("alice"),
+	}, parser.LastRow().Row)
+	require.NoError(t, parser.Close())
+
+	for _, path := range []string{"", "user.name", "$.", "$.a[", "$.a[-1]", "$.a[x]", `$."a`, "$a"} {
+		cfg := &config.JSONLConfig{ColumnPaths: map[string]string{"a": path}}
+		_, err = mydump.NewJSONLParser(context.Background(), cfg, mydump.NewStringReader(input), 16, ioWorkersForCSV, nil)
+		require.ErrorContains(t, err, "invalid JSON path", path)
+		require.Error(t, mydump.ValidateJSONPath(path), path)
+	}
+	require.NoError(t, mydump.ValidateJSONPath("$"))
+
+	for s, expected := range map[string][]string{
+		"$.a":                 {"$.a"},
+		" $.a , $.b[0] ":      {"$.a", "$.b[0]"},
+		`$."a,b", $.c`:        {`$."a,b"`, "$.c"},
+		`$."a\",b",$."c\\",$`: {`$."a\",b"`, `$."c\\"`, "$"},
+		"$.a,":                {"$.a", ""},
+	} {
+		paths, err := mydump.SplitJSONPaths(s)
+		require.NoError(t, err, s)
+		require.Equal(t, expected, paths, s)
+	}
+	_, err = mydump.SplitJSONPaths(`$."a,b`)
+	require.ErrorContains(t, err, "unterminated quoted key")
+	require.NoError(t, mydump.ValidateJSONPath(`$.a[0]."b.c"[12].d`))
+}
+
+func TestJSONLParserSetPos(t *testing.T) {
+	input := `{"a": 1}
+{"a": 2}
+{"a": 3}
+`
+	parser, err := mydump.NewJSONLParser(context.Background(), nil, mydump.NewStringReader(input), 4, ioWorkersForCSV, []string{"a"})
+	require.NoError(t, err)
+	require.NoError(t, parser.SetPos(9, 1))
+	require.Equal(t, [][]types.Datum{
+		{types.NewStringDatum("2")},
+		{types.NewStringDatum("3")},
+	}, readAllJSONLRows(t, parser))
+	assertPosEqual(t, parser, 27, 4)
+
+	parser, err = mydump.NewJSONLParser(context.Background(), nil, mydump.NewStringReader(input), 4, ioWorkersForCSV, nil)
+	require.NoError(t, err)
+	require.NoError(t, parser.SetPos(3, 0))
+	pos, err := parser.ReadUntilNewLine()
+	require.NoError(t, err)
+	require.Equal(t, int64(9), pos)
+}
+
+func TestJSONLParserInvalidLine(t *testing.T) {
+	for _, input := range []string{
+		"[1, 2]\n",
+		"1\n",
+		"{\"a\": 1\n",
+		"{\"a\": 1} {\"a\": 2}\n",
+		"{\"a\": 1}\n{\"a\": }\n",
+	} {
+		parser, err := mydump.NewJSONLParser(context.Background(), nil, mydump.NewStringReader(input), 16, ioWorkersForCSV, nil)
+		require.NoError(t, err)
+		for {
+			err = parser.ReadRow()
+			if err != nil {
+				break
+			}
+		}
+		require.ErrorContains(t, err, "syntax error", input)
+	}
+}
+
+func TestJSONLParserCompressed(t *testing.T) {
+	dir := t.TempDir()
+	fileName := "test.t.jsonl.gz"
+	f, err := os.Create(filepath.Join(dir, fileName))
+	require.NoError(t, err)
+	w := gzip.NewWriter(f)
+	for i := 0; i < 3; i++ {
+		_, err = w.Write([]byte(`{"a": "x", "b": {"c": 1}}` + "\n"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	store, err := storage.NewLocalStorage(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+	fileMeta := &mydump.SourceFileMeta{
+		Path:        fileName,
+		Type:        mydump.SourceTypeJSONL,
+		Compression: mydump.ParseCompressionOnFileExtension(fileName),
+	}
+	require.Equal(t, mydump.CompressionGZ, fileMeta.Compression)
+	reader, err := mydump.OpenReader(ctx, fileMeta, store, storage.DecompressConfig{})
+	require.NoError(t, err)
+	cfg := &config.JSONLConfig{ColumnPaths: map[string]string{"c": "$.b.c"}}
+	parser, err := mydump.NewJSONLParser(ctx, cfg, reader, 8, ioWorkersForCSV, []string{"a", "c"})
+	require.NoError(t, err)
+	rows := readAllJSONLRows(t, parser)
+	require.Len(t, rows, 3)
+	for _, row := range rows {
+		require.Equal(t, []types.Datum{types.NewStringDatum("x"), types.NewStringDatum("1")}, row)
+	}
+	require.NoError(t, parser.Close())
+}
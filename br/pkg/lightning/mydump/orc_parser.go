@@ -0,0 +1,276 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/lightning/orc"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/types"
+	"go.uber.org/zap"
+)
+
+// orcLocalTimeLayout is the layout of the timestamps without time zone, which
+// are the wall clock time of the ORC writer.
+const orcLocalTimeLayout = "2006-01-02 15:04:05.999999"
+
+// ORCParser parses an ORC file for import.
+// It implements the Parser interface.
+//
+// The top level type of the file must be a struct, each field of the struct
+// is mapped to a column. The values are converted as follows:
+//   - boolean is converted to 1/0, tinyint, smallint, int and bigint to
+//     integers, float and double to floats, string, varchar and char to
+//     strings, binary to bytes
+//   - decimal is converted to a decimal string, date to a date string,
+//     timestamp to a datetime string of the writer time zone, and timestamp
+//     with local time zone to an UTC datetime string
+//   - uniontype is converted by its member value
+//   - array, map and struct are converted to JSON text
+type ORCParser struct {
+	reader     *orc.Reader
+	columns    []string
+	fieldTypes []*orc.Type
+	readRows   int64
+	lastRow    Row
+	logger     log.Logger
+
+	readSeekCloser ReadSeekCloser
+}
+
+// NewORCParser generates an ORC parser.
+func NewORCParser(
+	ctx context.Context,
+	r storage.ReadSeekCloser,
+) (*ORCParser, error) {
+	reader, err := orc.NewReader(r)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	schema := reader.Schema()
+	columns := make([]string, 0, len(schema.FieldNames))
+	for _, name := range schema.FieldNames {
+		columns = append(columns, strings.ToLower(name))
+	}
+
+	return &ORCParser{
+		reader:         reader,
+		columns:        columns,
+		fieldTypes:     schema.Children,
+		logger:         log.FromContext(ctx),
+		readSeekCloser: r,
+	}, nil
+}
+
+// ReadORCFileRowCountByFile reads the ORC file row count through fileMeta.
+// Only the footer of the file is read.
+func ReadORCFileRowCountByFile(
+	ctx context.Context,
+	store storage.ExternalStorage,
+	fileMeta SourceFileMeta,
+) (int64, error) {
+	r, err := store.Open(ctx, fileMeta.Path)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	//nolint: errcheck
+	defer r.Close()
+	reader, err := orc.NewReader(r)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	defer reader.Close()
+	return reader.NumRows(), nil
+}
+
+// Pos returns the currently row number of the ORC file
+func (op *ORCParser) Pos() (pos int64, rowID int64) {
+	return op.readRows, op.lastRow.RowID
+}
+
+// SetPos sets the position in an ORC file.
+// It implements the Parser interface.
+func (op *ORCParser) SetPos(pos int64, rowID int64) error {
+	if pos < op.readRows {
+		return errors.Errorf("can't seek back in ORC file from row %d to row %d", op.readRows, pos)
+	}
+	op.lastRow.RowID = rowID
+
+	// the stripes before pos are skipped without being read.
+	if err := op.reader.SkipRows(pos - op.readRows); err != nil {
+		if errors.Cause(err) == io.EOF {
+			return errors.Errorf("ORC file has only %d rows, can't seek to row %d", op.reader.NumRows(), pos)
+		}
+		return errors.Trace(err)
+	}
+	op.readRows = pos
+	return nil
+}
+
+// ScannedPos implements the Parser interface.
+// For ORC it's the end offset of the current stripe, which is read at once.
+func (op *ORCParser) ScannedPos() (int64, error) {
+	return op.reader.Offset(), nil
+}
+
+// Close closes the ORC file of the parser.
+// It implements the Parser interface.
+func (op *ORCParser) Close() error {
+	op.reader.Close()
+	return op.readSeekCloser.Close()
+}
+
+// ReadRow reads a row in the ORC file by the parser.
+// It implements the Parser interface.
+func (op *ORCParser) ReadRow() error {
+	op.lastRow.RowID++
+	op.lastRow.Length = 0
+	values, err := op.reader.Next()
+	if err != nil {
+		if errors.Cause(err) == io.EOF {
+			return io.EOF
+		}
+		return errors.Trace(err)
+	}
+	op.readRows++
+
+	length := len(values)
+	if cap(op.lastRow.Row) < length {
+		op.lastRow.Row = make([]types.Datum, length)
+	} else {
+		op.lastRow.Row = op.lastRow.Row[:length]
+	}
+	for i, v := range values {
+		op.lastRow.Length += getAvroDatumLen(v)
+		if err := setORCDatumValue(&op.lastRow.Row[i], v, op.fieldTypes[i], op.logger); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convert an ORC value to Datum
+//
+// See: https://orc.apache.org/docs/types.html
+func setORCDatumValue(d *types.Datum, v interface{}, tp *orc.Type, logger log.Logger) error {
+	switch x := v.(type) {
+	case nil:
+		d.SetNull()
+	case bool:
+		if x {
+			d.SetUint64(1)
+		} else {
+			d.SetUint64(0)
+		}
+	case int64:
+		d.SetInt64(x)
+	case float32:
+		d.SetFloat32(x)
+	case float64:
+		d.SetFloat64(x)
+	case string:
+		d.SetString(x, "utf8mb4_bin")
+	case []byte:
+		d.SetBytes(x)
+	case orc.Decimal:
+		d.SetString(x.String(), "utf8mb4_bin")
+	case orc.Date:
+		d.SetString(x.String(), "utf8mb4_bin")
+	case time.Time:
+		if tp.Kind == orc.KindTimestampInstant {
+			d.SetString(x.UTC().Format(utcTimeLayout), "utf8mb4_bin")
+		} else {
+			d.SetString(x.Format(orcLocalTimeLayout), "utf8mb4_bin")
+		}
+	case []interface{}, []orc.MapEntry, map[string]interface{}:
+		bs, err := json.Marshal(orcJSONValue(x))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		d.SetString(string(bs), "utf8mb4_bin")
+	default:
+		logger.Error("unknown value", zap.String("type", fmt.Sprintf("%T", v)), zap.Reflect("value", v))
+		return errors.Errorf("unknown value: %v", v)
+	}
+	return nil
+}
+
+// orcJSONValue converts the maps in an ORC value to JSON objects, whose keys
+// are formatted as strings.
+func orcJSONValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case []interface{}:
+		values := make([]interface{}, 0, len(x))
+		for _, elem := range x {
+			values = append(values, orcJSONValue(elem))
+		}
+		return values
+	case []orc.MapEntry:
+		values := make(map[string]interface{}, len(x))
+		for _, e := range x {
+			values[fmt.Sprint(e.Key)] = orcJSONValue(e.Value)
+		}
+		return values
+	case map[string]interface{}:
+		values := make(map[string]interface{}, len(x))
+		for name, field := range x {
+			values[name] = orcJSONValue(field)
+		}
+		return values
+	default:
+		return v
+	}
+}
+
+// LastRow gets the last row parsed by the parser.
+// It implements the Parser interface.
+func (op *ORCParser) LastRow() Row {
+	return op.lastRow
+}
+
+// RecycleRow implements the Parser interface.
+func (*ORCParser) RecycleRow(_ Row) {
+}
+
+// Columns returns the _lower-case_ column names corresponding to values in
+// the LastRow.
+func (op *ORCParser) Columns() []string {
+	return op.columns
+}
+
+// SetColumns set restored column names to parser
+func (*ORCParser) SetColumns(_ []string) {
+	// just do nothing
+}
+
+// SetLogger sets the logger used in the parser.
+// It implements the Parser interface.
+func (op *ORCParser) SetLogger(l log.Logger) {
+	op.logger = l
+}
+
+// SetRowID sets the rowID in an ORC file.
+// It implements the Parser interface.
+func (op *ORCParser) SetRowID(rowID int64) {
+	op.lastRow.RowID = rowID
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func openORCParser(t *testing.T, fileName string) *ORCParser {
+	store, err := storage.NewLocalStorage("orc")
+	require.NoError(t, err)
+	r, err := store.Open(context.Background(), fileName)
+	require.NoError(t, err)
+	parser, err := NewORCParser(context.Background(), r)
+	require.NoError(t, err)
+	return parser
+}
+
+func checkORCRows(t *testing.T, parser *ORCParser, expectedRows [][]interface{}) {
+	for _, expected := range expectedRows {
+		require.NoError(t, parser.ReadRow())
+		row := parser.LastRow().Row
+		require.Len(t, row, len(expected))
+		for i, v := range expected {
+			require.Equal(t, v, row[i].GetValue(), "column %s", parser.Columns()[i])
+		}
+	}
+}
+
+func TestORCParserHive(t *testing.T) {
+	// the file is written with zlib compression in 2 stripes, the first stripe
+	// has 3 rows.
+	parser := openORCParser(t, "hive.orc")
+	require.Equal(t, []string{
+		"id", "name", "price", "created_at", "birthday", "active",
+		"score", "ratio", "status", "payload", "tags", "attrs",
+	}, parser.Columns())
+
+	checkORCRows(t, parser, [][]interface{}{
+		{
+			int64(1), "alice", "123.45", "2023-07-01 12:30:45.123", "1990-01-02", uint64(1),
+			float32(1.5), 0.25, "ACTIVE", []byte{0, 1}, `["a","b"]`, `{"k":1}`,
+		},
+		{
+			int64(2), nil, "-0.05", "1970-01-01 00:00:00", nil, uint64(0),
+			float32(-2), 1e10, "INACTIVE", []byte{}, `[]`, `{}`,
+		},
+		{
+			int64(3), "carol", nil, "2000-02-29 23:59:59", "2000-02-29", uint64(1),
+			float32(0), -0.5, "ACTIVE", []byte("x"), `["c"]`, `{}`,
+		},
+	})
+	pos, rowID := parser.Pos()
+	require.Equal(t, int64(3), pos)
+	require.Equal(t, int64(3), rowID)
+	checkORCRows(t, parser, [][]interface{}{
+		{
+			int64(4), "dave", "100.00", "2023-12-31 01:02:03", nil, uint64(0),
+			float32(3.25), 3.5, "INACTIVE", []byte("y"), `[]`, `{}`,
+		},
+		{
+			int64(5), "eve", "0.01", "2024-01-01 00:00:00", nil, uint64(1),
+			float32(4), 4.5, "ACTIVE", []byte("z"), `[]`, `{}`,
+		},
+	})
+	require.ErrorIs(t, parser.ReadRow(), io.EOF)
+	require.NoError(t, parser.Close())
+}
+
+func TestORCParserZstd(t *testing.T) {
+	parser := openORCParser(t, "zstd.orc")
+	require.Equal(t, []string{"id", "small", "tiny", "amount", "updated", "point", "value"}, parser.Columns())
+	checkORCRows(t, parser, [][]interface{}{
+		{int64(1), int64(-300), int64(-1), "-12345.6789", "2023-01-02 03:04:05.678901Z", `{"x":1.5,"y":-2}`, int64(42)},
+		{int64(2), nil, int64(127), "0.0001", nil, nil, "forty-two"},
+		{int64(3), int64(32767), nil, "5.0000", nil, `{"x":0,"y":null}`, nil},
+	})
+	require.ErrorIs(t, parser.ReadRow(), io.EOF)
+	require.NoError(t, parser.Close())
+}
+
+func TestORCParserSetPos(t *testing.T) {
+	store, err := storage.NewLocalStorage("orc")
+	require.NoError(t, err)
+	rowCount, err := ReadORCFileRowCountByFile(context.Background(), store, SourceFileMeta{Path: "hive.orc"})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), rowCount)
+	rowCount, err = ReadWholeFileRowCountByFile(context.Background(), store, SourceFileMeta{Path: "hive.orc", Type: SourceTypeORC})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), rowCount)
+
+	for _, pos := range []int64{0, 2, 3, 4} {
+		parser := openORCParser(t, "hive.orc")
+		require.NoError(t, parser.SetPos(pos, pos+100))
+		for i := pos; i < rowCount; i++ {
+			require.NoError(t, parser.ReadRow())
+			require.Equal(t, i+1, parser.LastRow().Row[0].GetInt64())
+			require.Equal(t, i+101, parser.LastRow().RowID)
+		}
+		require.ErrorIs(t, parser.ReadRow(), io.EOF)
+		require.Error(t, parser.SetPos(1, 0))
+		require.NoError(t, parser.Close())
+	}
+
+	parser := openORCParser(t, "hive.orc")
+	require.ErrorContains(t, parser.SetPos(6, 0), "ORC file has only 5 rows")
+	require.NoError(t, parser.Close())
+}
+
+func TestORCParserScannedPos(t *testing.T) {
+	const fileSize = 874
+	parser := openORCParser(t, "hive.orc")
+	// only the footer is read before reading the rows.
+	pos, err := parser.ScannedPos()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), pos)
+
+	require.NoError(t, parser.ReadRow())
+	firstStripeEnd, err := parser.ScannedPos()
+	require.NoError(t, err)
+	require.Greater(t, firstStripeEnd, int64(3))
+	require.Less(t, firstStripeEnd, int64(fileSize))
+	require.NoError(t, parser.ReadRow())
+	require.NoError(t, parser.ReadRow())
+	pos, err = parser.ScannedPos()
+	require.NoError(t, err)
+	require.Equal(t, firstStripeEnd, pos)
+
+	require.NoError(t, parser.ReadRow())
+	pos, err = parser.ScannedPos()
+	require.NoError(t, err)
+	require.Greater(t, pos, firstStripeEnd)
+	// the footer is at the end of the file.
+	require.Less(t, pos, int64(fileSize))
+	require.NoError(t, parser.Close())
+}
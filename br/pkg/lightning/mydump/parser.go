@@ -100,8 +100,8 @@ type ChunkParser struct {
 // Chunk represents a portion of the data file.
 type Chunk struct {
 	Offset int64
-	// for parquet file, it's the total row count
-	// see makeParquetFileRegion
+	// for parquet, avro and ORC file, it's the total row count
+	// see makeWholeFileRegion
 	EndOffset  int64
 	RealOffset int64
 	// we estimate row-id range of the chunk using file-size divided by some factor(depends on column count)
@@ -145,7 +145,7 @@ const (
 type Parser interface {
 	// Pos returns means the position that parser have already handled. It's mainly used for checkpoint.
 	// For normal files it's the file offset we handled.
-	// For parquet files it's the row count we handled.
+	// For parquet, avro and ORC files it's the row count we handled.
 	// For compressed files it's the uncompressed file offset we handled.
 	// TODO: replace pos with a new structure to specify position offset and rows offset
 	Pos() (pos int64, rowID int64)
@@ -35,6 +35,9 @@ const (
 	tableRegionSizeWarningThreshold int64 = 1024 * 1024 * 1024
 	// the increment ratio of large CSV file size threshold by `region-split-size`
 	largeCSVLowerThresholdRation = 10
+	// keys may be absent from a line of JSON Lines file, so a row can be as short
+	// as `{}`, we use it to estimate the max row count.
+	jsonlMinRowSize = 2
 	// TableFileSizeINF for compressed size, for lightning 10TB is a relatively big value and will strongly affect efficiency
 	// It's used to make sure compressed files can be read until EOF. Because we can't get the exact decompressed size of the compressed files.
 	TableFileSizeINF = 10 * 1024 * tableRegionSizeWarningThreshold
@@ -239,8 +242,8 @@ func MakeTableRegions(
 				err     error
 			)
 			dataFileSize := info.FileMeta.FileSize
-			if info.FileMeta.Type == SourceTypeParquet {
-				regions, sizes, err = makeParquetFileRegion(egCtx, cfg, info)
+			if info.FileMeta.Type.IsWholeFile() {
+				regions, sizes, err = makeWholeFileRegion(egCtx, cfg, info)
 			} else if info.FileMeta.Type == SourceTypeCSV && cfg.StrictFormat &&
 				info.FileMeta.Compression == CompressionNone &&
 				dataFileSize > cfg.MaxChunkSize+cfg.MaxChunkSize/largeCSVLowerThresholdRation {
@@ -251,6 +254,12 @@ func MakeTableRegions(
 				// avoid split a lot of small chunks.
 				// If a csv file is compressed, we can't split it now because we can't get the exact size of a row.
 				regions, sizes, err = SplitLargeCSV(egCtx, cfg, info)
+			} else if info.FileMeta.Type == SourceTypeJSONL &&
+				info.FileMeta.Compression == CompressionNone &&
+				dataFileSize > cfg.MaxChunkSize+cfg.MaxChunkSize/largeCSVLowerThresholdRation {
+				// a newline never appears inside a JSON value, so JSON Lines files can
+				// always be split, no matter whether the format is strict.
+				regions, sizes, err = SplitLargeJSONL(egCtx, cfg, info)
 			} else {
 				regions, sizes, err = MakeSourceFileRegion(egCtx, cfg, info)
 			}
@@ -319,8 +328,11 @@ func MakeSourceFileRegion(
 	fi FileInfo,
 ) ([]*TableRegion, []float64, error) {
 	divisor := int64(cfg.ColumnCnt)
-	isCsvFile := fi.FileMeta.Type == SourceTypeCSV
-	if !isCsvFile {
+	switch fi.FileMeta.Type {
+	case SourceTypeCSV:
+	case SourceTypeJSONL:
+		divisor = jsonlMinRowSize
+	default:
 		divisor += 2
 	}
 
@@ -361,9 +373,9 @@ func MakeSourceFileRegion(
 	return []*TableRegion{tableRegion}, []float64{float64(fi.FileMeta.RealSize)}, nil
 }
 
-// because parquet files can't seek efficiently, there is no benefit in split.
-// parquet file are column orient, so the offset is read line number
-func makeParquetFileRegion(
+// because parquet, avro and ORC files can't seek efficiently, there is no benefit in split.
+// parquet and ORC file are column orient and avro file are block compressed, so the offset is read line number
+func makeWholeFileRegion(
 	ctx context.Context,
 	cfg *DataDivideConfig,
 	dataFile FileInfo,
@@ -372,7 +384,7 @@ func makeParquetFileRegion(
 	var err error
 	// for safety
 	if numberRows <= 0 {
-		numberRows, err = ReadParquetFileRowCountByFile(ctx, cfg.Store, dataFile.FileMeta)
+		numberRows, err = ReadWholeFileRowCountByFile(ctx, cfg.Store, dataFile.FileMeta)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -494,3 +506,82 @@ func SplitLargeCSV(
 	}
 	return regions, dataFileSizes, nil
 }
+
+// SplitLargeJSONL splits a large JSON Lines file into multiple regions, the size
+// of each region is specified by `config.MaxRegionSize`. Each region ends at a
+// newline.
+func SplitLargeJSONL(
+	ctx context.Context,
+	cfg *DataDivideConfig,
+	dataFile FileInfo,
+) (regions []*TableRegion, dataFileSizes []float64, err error) {
+	maxRegionSize := cfg.MaxChunkSize
+	dataFileSizes = make([]float64, 0, dataFile.FileMeta.FileSize/maxRegionSize+1)
+	startOffset, endOffset := int64(0), min(maxRegionSize, dataFile.FileMeta.FileSize)
+	var prevRowIdxMax int64
+	divisor := int64(jsonlMinRowSize)
+	for {
+		if endOffset != dataFile.FileMeta.FileSize {
+			r, err := cfg.Store.Open(ctx, dataFile.FileMeta.Path)
+			if err != nil {
+				return nil, nil, err
+			}
+			parser, err := NewJSONLParser(ctx, nil, r, cfg.ReadBlockSize, cfg.IOWorkers, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err = parser.SetPos(endOffset, 0); err != nil {
+				return nil, nil, err
+			}
+			pos, err := parser.ReadUntilNewLine()
+			if err != nil {
+				if !errors.ErrorEqual(err, io.EOF) {
+					return nil, nil, err
+				}
+				pos = dataFile.FileMeta.FileSize
+			}
+			endOffset = pos
+			parser.Close()
+		}
+		rowIDMax := prevRowIdxMax + (endOffset-startOffset)/divisor
+		regions = append(regions,
+			&TableRegion{
+				DB:       cfg.TableMeta.DB,
+				Table:    cfg.TableMeta.Name,
+				FileMeta: dataFile.FileMeta,
+				Chunk: Chunk{
+					Offset:       startOffset,
+					EndOffset:    endOffset,
+					PrevRowIDMax: prevRowIdxMax,
+					RowIDMax:     rowIDMax,
+				},
+			})
+		dataFileSizes = append(dataFileSizes, float64(endOffset-startOffset))
+		prevRowIdxMax = rowIDMax
+		if endOffset == dataFile.FileMeta.FileSize {
+			break
+		}
+		startOffset = endOffset
+		if endOffset += maxRegionSize; endOffset > dataFile.FileMeta.FileSize {
+			endOffset = dataFile.FileMeta.FileSize
+		}
+	}
+	return regions, dataFileSizes, nil
+}
+
+// ReadWholeFileRowCountByFile reads the row count of a parquet, avro or ORC
+// file through fileMeta.
+func ReadWholeFileRowCountByFile(
+	ctx context.Context,
+	store storage.ExternalStorage,
+	fileMeta SourceFileMeta,
+) (int64, error) {
+	switch fileMeta.Type {
+	case SourceTypeAvro:
+		return ReadAvroFileRowCountByFile(ctx, store, fileMeta)
+	case SourceTypeORC:
+		return ReadORCFileRowCountByFile(ctx, store, fileMeta)
+	default:
+		return ReadParquetFileRowCountByFile(ctx, store, fileMeta)
+	}
+}
@@ -487,3 +487,62 @@ func TestSplitLargeFileOnlyOneChunk(t *testing.T) {
 		require.Equal(t, columns, regions[i].Chunk.Columns)
 	}
 }
+
+func TestSplitLargeJSONL(t *testing.T) {
+	meta := &MDTableMeta{
+		DB:   "jsonl",
+		Name: "large_jsonl_file",
+	}
+	cfg := &config.Config{
+		Mydumper: config.MydumperRuntime{
+			ReadBlockSize: config.ReadBlockSize,
+			Filter:        []string{"*.*"},
+		},
+	}
+
+	dir := t.TempDir()
+	fileName := "test.jsonl"
+	// every line takes 10 bytes, and the last one has no newline.
+	content := []byte("{\"a\": 11}\n{\"a\": 22}\n{\"a\": 33}\n{\"a\": 44}")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, fileName), content, 0o644))
+	fileSize := int64(len(content))
+	fileInfo := FileInfo{FileMeta: SourceFileMeta{Path: fileName, Type: SourceTypeJSONL, FileSize: fileSize}}
+	ioWorker := worker.NewPool(context.Background(), 4, "io")
+	store, err := storage.NewLocalStorage(dir)
+	require.NoError(t, err)
+	divideConfig := NewDataDivideConfig(cfg, 1, ioWorker, store, meta)
+
+	for _, tc := range []struct {
+		maxRegionSize config.ByteSize
+		offsets       [][]int64
+	}{
+		{1, [][]int64{{0, 10}, {10, 20}, {20, 30}, {30, 39}}},
+		{10, [][]int64{{0, 20}, {20, 39}}},
+		{15, [][]int64{{0, 20}, {20, 39}}},
+		{25, [][]int64{{0, 30}, {30, 39}}},
+		{35, [][]int64{{0, 39}}},
+	} {
+		divideConfig.MaxChunkSize = int64(tc.maxRegionSize)
+		regions, _, err := SplitLargeJSONL(context.Background(), divideConfig, fileInfo)
+		require.NoError(t, err)
+		require.Len(t, regions, len(tc.offsets))
+		var prevRowIDMax int64
+		for i := range tc.offsets {
+			require.Equal(t, tc.offsets[i][0], regions[i].Chunk.Offset)
+			require.Equal(t, tc.offsets[i][1], regions[i].Chunk.EndOffset)
+			require.Equal(t, prevRowIDMax, regions[i].Chunk.PrevRowIDMax)
+			// the row-id range must be large enough to hold all rows of the region.
+			require.GreaterOrEqual(t, regions[i].Chunk.RowIDMax-prevRowIDMax, (tc.offsets[i][1]-tc.offsets[i][0])/10)
+			prevRowIDMax = regions[i].Chunk.RowIDMax
+		}
+	}
+
+	// large JSON Lines files are split by MakeTableRegions even without strict-format.
+	divideConfig.MaxChunkSize = 10
+	divideConfig.TableMeta.DataFiles = []FileInfo{fileInfo}
+	regions, err := MakeTableRegions(context.Background(), divideConfig)
+	require.NoError(t, err)
+	require.Len(t, regions, 2)
+	require.Equal(t, int64(20), regions[0].Chunk.EndOffset)
+	require.Equal(t, int64(39), regions[1].Chunk.EndOffset)
+}
@@ -34,6 +34,12 @@ const (
 	SourceTypeParquet
 	// SourceTypeViewSchema means this source file is a schema file for the view.
 	SourceTypeViewSchema
+	// SourceTypeJSONL means this source file is a JSON Lines (newline-delimited JSON) data file.
+	SourceTypeJSONL
+	// SourceTypeAvro means this source file is an Avro object container data file.
+	SourceTypeAvro
+	// SourceTypeORC means this source file is an ORC data file.
+	SourceTypeORC
 )
 
 const (
@@ -49,6 +55,14 @@ const (
 	TypeCSV = "csv"
 	// TypeParquet is the source type value for parquet data file.
 	TypeParquet = "parquet"
+	// TypeJSONL is the source type value for JSON Lines data file.
+	TypeJSONL = "jsonl"
+	// TypeNDJSON is an alias of TypeJSONL.
+	TypeNDJSON = "ndjson"
+	// TypeAvro is the source type value for Avro object container data file.
+	TypeAvro = "avro"
+	// TypeORC is the source type value for ORC data file.
+	TypeORC = "orc"
 	// TypeIgnore is the source type value for a ignored data file.
 	TypeIgnore = "ignore"
 )
@@ -102,6 +116,12 @@ func parseSourceType(t string) (SourceType, error) {
 		return SourceTypeCSV, nil
 	case TypeParquet:
 		return SourceTypeParquet, nil
+	case TypeJSONL, TypeNDJSON:
+		return SourceTypeJSONL, nil
+	case TypeAvro:
+		return SourceTypeAvro, nil
+	case TypeORC:
+		return SourceTypeORC, nil
 	case TypeIgnore:
 		return SourceTypeIgnore, nil
 	case ViewSchema:
@@ -123,6 +143,12 @@ func (s SourceType) String() string {
 		return TypeSQL
 	case SourceTypeParquet:
 		return TypeParquet
+	case SourceTypeJSONL:
+		return TypeJSONL
+	case SourceTypeAvro:
+		return TypeAvro
+	case SourceTypeORC:
+		return TypeORC
 	case SourceTypeViewSchema:
 		return ViewSchema
 	default:
@@ -130,6 +156,13 @@ func (s SourceType) String() string {
 	}
 }
 
+// IsWholeFile returns whether the data file of the source type is imported as
+// a whole, whose offsets are row numbers instead of bytes. These files are
+// compressed by their own format.
+func (s SourceType) IsWholeFile() bool {
+	return s == SourceTypeParquet || s == SourceTypeAvro || s == SourceTypeORC
+}
+
 // ParseCompressionOnFileExtension parses the compression type from the file extension.
 func ParseCompressionOnFileExtension(filename string) Compression {
 	fileExt := strings.ToLower(filepath.Ext(filename))
@@ -171,7 +204,7 @@ var defaultFileRouteRules = []*config.FileRouteRule{
 	// ignore *-schema-trigger.sql, *-schema-post.sql files
 	{Pattern: `(?i).*(-schema-trigger|-schema-post)\.sql(?:\.(\w*?))?$`, Type: "ignore"},
 	// ignore backup files
-	{Pattern: `(?i).*\.(sql|csv|parquet)(\.(\w+))?\.(bak|BAK)$`, Type: "ignore"},
+	{Pattern: `(?i).*\.(sql|csv|parquet|jsonl|ndjson|avro|orc)(\.(\w+))?\.(bak|BAK)$`, Type: "ignore"},
 	// db schema create file pattern, matches files like '{schema}-schema-create.sql[.{compress}]'
 	{Pattern: `(?i)^(?:[^/]*/)*([^/.]+)-schema-create\.sql(?:\.(\w*?))?$`,
 		Schema: "$1", Table: "", Type: SchemaSchema, Compression: "$2", Unescape: true},
@@ -181,8 +214,8 @@ var defaultFileRouteRules = []*config.FileRouteRule{
 	// view schema create file pattern, matches files like '{schema}.{table}-schema-view.sql[.{compress}]'
 	{Pattern: `(?i)^(?:[^/]*/)*([^/.]+)\.(.*?)-schema-view\.sql(?:\.(\w*?))?$`,
 		Schema: "$1", Table: "$2", Type: ViewSchema, Compression: "$3", Unescape: true},
-	// source file pattern, matches files like '{schema}.{table}.0001.{sql|csv}[.{compress}]'
-	{Pattern: `(?i)^(?:[^/]*/)*([^/.]+)\.(.*?)(?:\.([0-9]+))?\.(sql|csv|parquet)(?:\.(\w+))?$`,
+	// source file pattern, matches files like '{schema}.{table}.0001.{sql|csv|parquet|jsonl|avro|orc}[.{compress}]'
+	{Pattern: `(?i)^(?:[^/]*/)*([^/.]+)\.(.*?)(?:\.([0-9]+))?\.(sql|csv|parquet|jsonl|ndjson|avro|orc)(?:\.(\w+))?$`,
 		Schema: "$1", Table: "$2", Type: "$4", Key: "$3", Compression: "$5", Unescape: true},
 }
 
@@ -347,6 +380,12 @@ func (p regexRouterParser) Parse(r *config.FileRouteRule, logger log.Logger) (*R
 			if result.Type == SourceTypeParquet && compression != CompressionNone {
 				return errors.Errorf("can't support whole compressed parquet file, should compress parquet files by choosing correct parquet compress writer, path: %s", r.Path)
 			}
+			if result.Type == SourceTypeAvro && compression != CompressionNone {
+				return errors.Errorf("can't support whole compressed avro file, should compress avro files by choosing correct avro codec, path: %s", r.Path)
+			}
+			if result.Type == SourceTypeORC && compression != CompressionNone {
+				return errors.Errorf("can't support whole compressed ORC file, should compress ORC files by choosing correct ORC compression, path: %s", r.Path)
+			}
 			result.Compression = compression
 			return nil
 		})
@@ -0,0 +1,505 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+// columnReader reads the values of a column from the streams of a stripe.
+//
+// The values are returned as:
+//   - nil for null
+//   - bool for boolean
+//   - int64 for tinyint, smallint, int and bigint
+//   - float32 for float and float64 for double
+//   - string for string, varchar and char, []byte for binary
+//   - Decimal for decimal, Date for date
+//   - time.Time for timestamp, in the time zone of the writer; and for
+//     timestamp with local time zone, in UTC
+//   - []interface{} for array, []MapEntry for map, map[string]interface{}
+//     for struct and the value of the selected member for uniontype
+type columnReader interface {
+	next() (interface{}, error)
+}
+
+// maxPreallocCount bounds the capacity preallocated by the counts read from
+// the file, the slices grow as the values are read, so the memory is bounded
+// by the values in the streams rather than the counts.
+const maxPreallocCount = 1024
+
+// stripeStreams is the streams and the column encodings of a stripe.
+type stripeStreams struct {
+	streams   map[streamKey]*streamSource
+	encodings []columnEncoding
+	location  *time.Location
+}
+
+type streamKey struct {
+	column uint32
+	kind   streamKind
+}
+
+func (s *stripeStreams) stream(tp *Type, kind streamKind) *byteStream {
+	// an absent stream is the same as an empty one.
+	return &byteStream{src: s.streams[streamKey{column: uint32(tp.ID), kind: kind}]}
+}
+
+func (s *stripeStreams) encoding(tp *Type) encodingKind {
+	if tp.ID < len(s.encodings) {
+		return s.encodings[tp.ID].kind
+	}
+	return encodingDirect
+}
+
+func (s *stripeStreams) intReader(tp *Type, kind streamKind, signed bool) *intReader {
+	return newIntReader(s.stream(tp, kind), signed, s.encoding(tp))
+}
+
+// presentReader reads the PRESENT stream, which is absent if the column has
+// no null value in the stripe.
+type presentReader struct {
+	present *boolReader
+}
+
+func newPresentReader(tp *Type, s *stripeStreams) presentReader {
+	if _, ok := s.streams[streamKey{column: uint32(tp.ID), kind: streamPresent}]; !ok {
+		return presentReader{}
+	}
+	return presentReader{present: newBoolReader(s.stream(tp, streamPresent))}
+}
+
+func (r presentReader) isNull() (bool, error) {
+	if r.present == nil {
+		return false, nil
+	}
+	present, err := r.present.next()
+	return !present, err
+}
+
+func newColumnReader(tp *Type, s *stripeStreams) (columnReader, error) {
+	present := newPresentReader(tp, s)
+	switch tp.Kind {
+	case KindBoolean:
+		return &boolColumn{presentReader: present, data: newBoolReader(s.stream(tp, streamData))}, nil
+	case KindByte:
+		return &byteColumn{presentReader: present, data: &byteRLEReader{in: s.stream(tp, streamData)}}, nil
+	case KindShort, KindInt, KindLong:
+		return &intColumn{presentReader: present, data: s.intReader(tp, streamData, true)}, nil
+	case KindFloat, KindDouble:
+		return &floatColumn{presentReader: present, data: s.stream(tp, streamData), double: tp.Kind == KindDouble}, nil
+	case KindString, KindVarchar, KindChar, KindBinary:
+		return newStringColumn(tp, s, present)
+	case KindDecimal:
+		c := &decimalColumn{presentReader: present, scale: tp.Scale}
+		if _, ok := s.streams[streamKey{column: uint32(tp.ID), kind: streamSecondary}]; ok {
+			c.data = s.stream(tp, streamData)
+			c.scales = s.intReader(tp, streamSecondary, true)
+		} else {
+			// decimals of precision up to 18 may be written as integers, the
+			// scale of which is the scale of the type.
+			c.unscaled = s.intReader(tp, streamData, true)
+		}
+		return c, nil
+	case KindDate:
+		return &dateColumn{presentReader: present, data: s.intReader(tp, streamData, true)}, nil
+	case KindTimestamp, KindTimestampInstant:
+		location := time.UTC
+		if tp.Kind == KindTimestamp {
+			location = s.location
+		}
+		return &timestampColumn{
+			presentReader: present,
+			seconds:       s.intReader(tp, streamData, true),
+			nanos:         s.intReader(tp, streamSecondary, false),
+			location:      location,
+			base:          time.Date(2015, 1, 1, 0, 0, 0, 0, location).Unix(),
+		}, nil
+	case KindList, KindMap:
+		children, err := newColumnReaders(tp.Children, s)
+		if err != nil {
+			return nil, err
+		}
+		if tp.Kind == KindList && len(children) == 1 {
+			return &listColumn{presentReader: present, lengths: s.intReader(tp, streamLength, false), elem: children[0]}, nil
+		}
+		if tp.Kind == KindMap && len(children) == 2 {
+			return &mapColumn{
+				presentReader: present,
+				lengths:       s.intReader(tp, streamLength, false),
+				key:           children[0],
+				value:         children[1],
+			}, nil
+		}
+		return nil, errors.Errorf("invalid ORC %s type of %d children", tp.Kind, len(children))
+	case KindStruct:
+		fields, err := newColumnReaders(tp.Children, s)
+		if err != nil {
+			return nil, err
+		}
+		return &structColumn{presentReader: present, names: tp.FieldNames, fields: fields}, nil
+	case KindUnion:
+		members, err := newColumnReaders(tp.Children, s)
+		if err != nil {
+			return nil, err
+		}
+		return &unionColumn{presentReader: present, tags: &byteRLEReader{in: s.stream(tp, streamData)}, members: members}, nil
+	default:
+		return nil, errors.Errorf("unsupported ORC type kind %d", tp.Kind)
+	}
+}
+
+func newColumnReaders(types []*Type, s *stripeStreams) ([]columnReader, error) {
+	readers := make([]columnReader, 0, len(types))
+	for _, tp := range types {
+		r, err := newColumnReader(tp, s)
+		if err != nil {
+			return nil, err
+		}
+		readers = append(readers, r)
+	}
+	return readers, nil
+}
+
+type boolColumn struct {
+	presentReader
+	data *boolReader
+}
+
+func (c *boolColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	return c.data.next()
+}
+
+type byteColumn struct {
+	presentReader
+	data *byteRLEReader
+}
+
+func (c *byteColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	b, err := c.data.next()
+	return int64(int8(b)), err
+}
+
+type intColumn struct {
+	presentReader
+	data *intReader
+}
+
+func (c *intColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	return c.data.next()
+}
+
+type floatColumn struct {
+	presentReader
+	data   *byteStream
+	double bool
+}
+
+func (c *floatColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	if c.double {
+		b, err := c.data.readBytes(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	}
+	b, err := c.data.readBytes(4)
+	if err != nil {
+		return nil, err
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+}
+
+// stringColumn reads the string and binary columns. With the direct encoding
+// the values are in the DATA stream and their lengths are in the LENGTH
+// stream. With the dictionary encoding the DATA stream is the indexes of the
+// values in the dictionary.
+type stringColumn struct {
+	presentReader
+	binary  bool
+	data    *byteStream
+	lengths *intReader
+	// ids and dictionary are set for the dictionary encoding.
+	ids        *intReader
+	dictionary [][]byte
+}
+
+func newStringColumn(tp *Type, s *stripeStreams, present presentReader) (*stringColumn, error) {
+	c := &stringColumn{presentReader: present, binary: tp.Kind == KindBinary}
+	switch s.encoding(tp) {
+	case encodingDirect, encodingDirectV2:
+		c.data = s.stream(tp, streamData)
+		c.lengths = s.intReader(tp, streamLength, false)
+		return c, nil
+	}
+
+	dictData := s.stream(tp, streamDictionaryData)
+	lengths := s.intReader(tp, streamLength, false)
+	size := s.encodings[tp.ID].dictionarySize
+	c.dictionary = make([][]byte, 0, min(size, maxPreallocCount))
+	for i := uint64(0); i < size; i++ {
+		length, err := lengths.next()
+		if err != nil {
+			return nil, errors.Annotatef(err, "failed to read the dictionary of ORC column %d", tp.ID)
+		}
+		b, err := dictData.readBytes(int(length))
+		if err != nil {
+			return nil, errors.Annotatef(err, "failed to read the dictionary of ORC column %d", tp.ID)
+		}
+		c.dictionary = append(c.dictionary, b)
+	}
+	c.ids = s.intReader(tp, streamData, false)
+	return c, nil
+}
+
+func (c *stringColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	var b []byte
+	if c.ids != nil {
+		id, err := c.ids.next()
+		if err != nil {
+			return nil, err
+		}
+		if id < 0 || id >= int64(len(c.dictionary)) {
+			return nil, errors.Errorf("ORC dictionary index %d out of range %d", id, len(c.dictionary))
+		}
+		b = c.dictionary[id]
+	} else {
+		length, err := c.lengths.next()
+		if err != nil {
+			return nil, err
+		}
+		if b, err = c.data.readBytes(int(length)); err != nil {
+			return nil, err
+		}
+	}
+	if c.binary {
+		return b, nil
+	}
+	return string(b), nil
+}
+
+type decimalColumn struct {
+	presentReader
+	data   *byteStream
+	scales *intReader
+	// unscaled is set if the values are written as integers.
+	unscaled *intReader
+	scale    int
+}
+
+func (c *decimalColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	if c.unscaled != nil {
+		v, err := c.unscaled.next()
+		if err != nil {
+			return nil, err
+		}
+		return Decimal{Value: big.NewInt(v), Scale: c.scale}, nil
+	}
+	v, err := c.data.readBigVarint()
+	if err != nil {
+		return nil, err
+	}
+	scale, err := c.scales.next()
+	if err != nil {
+		return nil, err
+	}
+	d := Decimal{Value: v, Scale: int(scale)}
+	// rescale the value to the scale of the type.
+	if d.Scale < c.scale {
+		d.Value.Mul(d.Value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(c.scale-d.Scale)), nil))
+		d.Scale = c.scale
+	}
+	return d, nil
+}
+
+type dateColumn struct {
+	presentReader
+	data *intReader
+}
+
+func (c *dateColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	v, err := c.data.next()
+	return Date(v), err
+}
+
+// timestampColumn reads the timestamp columns. The DATA stream is the seconds
+// since 2015-01-01 00:00:00 in the time zone, and the SECONDARY stream is the
+// nanoseconds whose trailing zeros are removed.
+type timestampColumn struct {
+	presentReader
+	seconds  *intReader
+	nanos    *intReader
+	location *time.Location
+	// base is the unix time of 2015-01-01 00:00:00 in the time zone.
+	base int64
+}
+
+func (c *timestampColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	seconds, err := c.seconds.next()
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := c.nanos.next()
+	if err != nil {
+		return nil, err
+	}
+	// the lowest 3 bits are the number of the removed zeros minus 1.
+	nanos := encoded >> 3
+	if zeros := encoded & 0x07; zeros != 0 {
+		for i := int64(0); i <= zeros; i++ {
+			nanos *= 10
+		}
+	}
+	seconds += c.base
+	// the seconds of the timestamps before 1970 are rounded toward zero by
+	// the writer.
+	if seconds < 0 && nanos > 999999 {
+		seconds--
+	}
+	return time.Unix(seconds, nanos).In(c.location), nil
+}
+
+type listColumn struct {
+	presentReader
+	lengths *intReader
+	elem    columnReader
+}
+
+func (c *listColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	length, err := c.lengths.next()
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 {
+		return nil, errors.Errorf("invalid ORC list length %d", length)
+	}
+	values := make([]interface{}, 0, min(length, maxPreallocCount))
+	for i := int64(0); i < length; i++ {
+		v, err := c.elem.next()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+type mapColumn struct {
+	presentReader
+	lengths    *intReader
+	key, value columnReader
+}
+
+func (c *mapColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	length, err := c.lengths.next()
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 {
+		return nil, errors.Errorf("invalid ORC map length %d", length)
+	}
+	entries := make([]MapEntry, 0, min(length, maxPreallocCount))
+	for i := int64(0); i < length; i++ {
+		var e MapEntry
+		if e.Key, err = c.key.next(); err != nil {
+			return nil, err
+		}
+		if e.Value, err = c.value.next(); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+type structColumn struct {
+	presentReader
+	names  []string
+	fields []columnReader
+}
+
+func (c *structColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	values := make(map[string]interface{}, len(c.fields))
+	for i, f := range c.fields {
+		v, err := f.next()
+		if err != nil {
+			return nil, err
+		}
+		if i < len(c.names) {
+			values[c.names[i]] = v
+		}
+	}
+	return values, nil
+}
+
+// unionColumn reads the uniontype columns, the DATA stream is the tags of the
+// selected members. Only the selected member has the value of a row.
+type unionColumn struct {
+	presentReader
+	tags    *byteRLEReader
+	members []columnReader
+}
+
+func (c *unionColumn) next() (interface{}, error) {
+	if isNull, err := c.isNull(); isNull || err != nil {
+		return nil, err
+	}
+	tag, err := c.tags.next()
+	if err != nil {
+		return nil, err
+	}
+	if int(tag) >= len(c.members) {
+		return nil, errors.Errorf("ORC union tag %d out of range %d", tag, len(c.members))
+	}
+	return c.members[tag].next()
+}
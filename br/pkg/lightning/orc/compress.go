@@ -0,0 +1,218 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+	"github.com/pingcap/errors"
+)
+
+const (
+	// chunkHeaderSize is the size of the header of each compressed chunk.
+	chunkHeaderSize = 3
+	// maxCompressionBlockSize is the max size of the decompressed chunks, the
+	// length of a chunk stored as is must fit in the 23 bits of the header.
+	maxCompressionBlockSize = 1<<23 - 1
+)
+
+// decompressor decompresses the metadata and the streams of an ORC file.
+//
+// Unless the compression is NONE, the bytes are split into chunks, each one
+// has a 3 bytes little endian header whose lowest bit tells whether the chunk
+// is stored as is, the other bits are the length of the chunk.
+type decompressor struct {
+	kind compressionKind
+	// blockSize bounds the size of each decompressed chunk.
+	blockSize int
+	zstd      *zstd.Decoder
+	lz4Buf    []byte
+}
+
+func newDecompressor(kind compressionKind, blockSize int) (*decompressor, error) {
+	if blockSize <= 0 || blockSize > maxCompressionBlockSize {
+		return nil, errors.Errorf("invalid ORC compression block size %d", blockSize)
+	}
+	d := &decompressor{kind: kind, blockSize: blockSize}
+	switch kind {
+	case compressionNone, compressionZlib, compressionSnappy, compressionLz4:
+	case compressionZstd:
+		var err error
+		// the max memory also bounds the window size, which may exceed the
+		// block size, so the decoded size is checked after decoding.
+		d.zstd, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(maxCompressionBlockSize+1))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	default:
+		return nil, errors.Errorf("unsupported ORC compression %s", kind)
+	}
+	return d, nil
+}
+
+// decompress decompresses the whole metadata, like the footers.
+func (d *decompressor) decompress(data []byte) ([]byte, error) {
+	if d.kind == compressionNone {
+		return data, nil
+	}
+	out := make([]byte, 0, len(data)*2)
+	for len(data) > 0 {
+		if len(data) < chunkHeaderSize {
+			return nil, errors.New("invalid ORC compressed chunk header")
+		}
+		isOriginal, length := parseChunkHeader(data)
+		data = data[chunkHeaderSize:]
+		if length > len(data) {
+			return nil, errors.Errorf("ORC compressed chunk of %d bytes exceeds the stream", length)
+		}
+		chunk := data[:length]
+		data = data[length:]
+		if isOriginal {
+			out = append(out, chunk...)
+			continue
+		}
+		var err error
+		if out, err = d.decompressChunk(out, chunk); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// parseChunkHeader parses the header of a compressed chunk.
+func parseChunkHeader(header []byte) (isOriginal bool, length int) {
+	v := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+	return v&1 == 1, v >> 1
+}
+
+// decompressChunk appends the decompressed chunk to out, the decompressed
+// bytes must not exceed the block size.
+func (d *decompressor) decompressChunk(out, chunk []byte) ([]byte, error) {
+	out, err := d.doDecompressChunk(out, chunk)
+	if err != nil {
+		return nil, errors.Annotatef(err, "failed to decompress ORC %s chunk", d.kind)
+	}
+	return out, nil
+}
+
+func (d *decompressor) doDecompressChunk(out, chunk []byte) ([]byte, error) {
+	switch d.kind {
+	case compressionZlib:
+		r := flate.NewReader(bytes.NewReader(chunk))
+		//nolint: errcheck
+		defer r.Close()
+		buf := bytes.NewBuffer(out)
+		n, err := io.Copy(buf, io.LimitReader(r, int64(d.blockSize)+1))
+		if err != nil {
+			return nil, err
+		}
+		if n > int64(d.blockSize) {
+			return nil, errors.Errorf("the chunk exceeds the block size %d", d.blockSize)
+		}
+		return buf.Bytes(), nil
+	case compressionSnappy:
+		n, err := snappy.DecodedLen(chunk)
+		if err != nil {
+			return nil, err
+		}
+		if n > d.blockSize {
+			return nil, errors.Errorf("the chunk of %d bytes exceeds the block size %d", n, d.blockSize)
+		}
+		b, err := snappy.Decode(nil, chunk)
+		if err != nil {
+			return nil, err
+		}
+		return append(out, b...), nil
+	case compressionLz4:
+		if d.lz4Buf == nil {
+			d.lz4Buf = make([]byte, d.blockSize)
+		}
+		n, err := lz4.UncompressBlock(chunk, d.lz4Buf)
+		if err != nil {
+			return nil, err
+		}
+		return append(out, d.lz4Buf[:n]...), nil
+	case compressionZstd:
+		n := len(out)
+		out, err := d.zstd.DecodeAll(chunk, out)
+		if err != nil {
+			return nil, err
+		}
+		if len(out)-n > d.blockSize {
+			return nil, errors.Errorf("the chunk of %d bytes exceeds the block size %d", len(out)-n, d.blockSize)
+		}
+		return out, nil
+	}
+	return nil, errors.Errorf("unsupported ORC compression %s", d.kind)
+}
+
+// streamSource reads a stream chunk by chunk from the file, so only a chunk
+// of each stream is in memory.
+type streamSource struct {
+	r io.ReadSeeker
+	d *decompressor
+	// offset is the offset of the next chunk in the file, and end is the end
+	// offset of the stream.
+	offset int64
+	end    int64
+}
+
+// next returns the next decompressed chunk of the stream. Each chunk is a new
+// slice, which isn't reused by the following chunks.
+func (s *streamSource) next() ([]byte, error) {
+	if s.offset >= s.end {
+		return nil, errUnexpectedEOS
+	}
+	if s.d.kind == compressionNone {
+		n := min(s.end-s.offset, int64(s.d.blockSize))
+		b, err := readAt(s.r, s.offset, n, s.end)
+		if err != nil {
+			return nil, err
+		}
+		s.offset += n
+		return b, nil
+	}
+
+	header, err := readAt(s.r, s.offset, chunkHeaderSize, s.end)
+	if err != nil {
+		return nil, errors.Annotate(err, "invalid ORC compressed chunk header")
+	}
+	isOriginal, length := parseChunkHeader(header)
+	s.offset += chunkHeaderSize
+	if int64(length) > s.end-s.offset {
+		return nil, errors.Errorf("ORC compressed chunk of %d bytes exceeds the stream", length)
+	}
+	chunk, err := readAt(s.r, s.offset, int64(length), s.end)
+	if err != nil {
+		return nil, err
+	}
+	s.offset += int64(length)
+	if isOriginal {
+		return chunk, nil
+	}
+	return s.d.decompressChunk(nil, chunk)
+}
+
+func (d *decompressor) close() {
+	if d.zstd != nil {
+		d.zstd.Close()
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"github.com/pingcap/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The metadata of an ORC file is encoded by protobuf, the messages are decoded
+// by hand here and only the fields used by the reader are kept.
+// See https://github.com/apache/orc/blob/main/proto/orc_proto.proto
+
+type compressionKind uint64
+
+const (
+	compressionNone compressionKind = iota
+	compressionZlib
+	compressionSnappy
+	compressionLzo
+	compressionLz4
+	compressionZstd
+)
+
+func (k compressionKind) String() string {
+	switch k {
+	case compressionNone:
+		return "NONE"
+	case compressionZlib:
+		return "ZLIB"
+	case compressionSnappy:
+		return "SNAPPY"
+	case compressionLzo:
+		return "LZO"
+	case compressionLz4:
+		return "LZ4"
+	case compressionZstd:
+		return "ZSTD"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+type streamKind uint64
+
+const (
+	streamPresent streamKind = iota
+	streamData
+	streamLength
+	streamDictionaryData
+	streamDictionaryCount
+	streamSecondary
+	streamRowIndex
+	streamBloomFilter
+	streamBloomFilterUTF8
+)
+
+type encodingKind uint64
+
+const (
+	encodingDirect encodingKind = iota
+	encodingDictionary
+	encodingDirectV2
+	encodingDictionaryV2
+)
+
+type postScript struct {
+	footerLength         uint64
+	compression          compressionKind
+	compressionBlockSize uint64
+	magic                string
+}
+
+type footer struct {
+	stripes      []StripeInformation
+	types        []*Type
+	numberOfRows uint64
+}
+
+// StripeInformation is the location of a stripe in the ORC file.
+type StripeInformation struct {
+	Offset       uint64
+	IndexLength  uint64
+	DataLength   uint64
+	FooterLength uint64
+	NumberOfRows uint64
+}
+
+type stream struct {
+	kind   streamKind
+	column uint32
+	length uint64
+}
+
+type columnEncoding struct {
+	kind           encodingKind
+	dictionarySize uint64
+}
+
+type stripeFooter struct {
+	streams        []stream
+	columns        []columnEncoding
+	writerTimezone string
+}
+
+type protoField struct {
+	num protowire.Number
+	typ protowire.Type
+	// v is the value of a varint field.
+	v uint64
+	// b is the value of a length delimited field.
+	b []byte
+}
+
+// uint32s returns the values of a repeated uint32 field, which may be packed.
+func (f protoField) uint32s(values []uint32) ([]uint32, error) {
+	if f.typ == protowire.VarintType {
+		return append(values, uint32(f.v)), nil
+	}
+	b := f.b
+	for len(b) > 0 {
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return nil, errors.Annotate(protowire.ParseError(n), "invalid ORC metadata")
+		}
+		values = append(values, uint32(v))
+		b = b[n:]
+	}
+	return values, nil
+}
+
+// parseMessage calls fn with each field of the protobuf message b.
+func parseMessage(b []byte, fn func(f protoField) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return errors.Annotate(protowire.ParseError(n), "invalid ORC metadata")
+		}
+		b = b[n:]
+		f := protoField{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.v, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			f.b, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return errors.Annotate(protowire.ParseError(n), "invalid ORC metadata")
+		}
+		b = b[n:]
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parsePostScript(b []byte) (*postScript, error) {
+	ps := &postScript{}
+	err := parseMessage(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			ps.footerLength = f.v
+		case 2:
+			ps.compression = compressionKind(f.v)
+		case 3:
+			ps.compressionBlockSize = f.v
+		case 8000:
+			ps.magic = string(f.b)
+		}
+		return nil
+	})
+	return ps, err
+}
+
+func parseFooter(b []byte) (*footer, error) {
+	ft := &footer{}
+	err := parseMessage(b, func(f protoField) error {
+		switch f.num {
+		case 3:
+			si, err := parseStripeInformation(f.b)
+			if err != nil {
+				return err
+			}
+			ft.stripes = append(ft.stripes, si)
+		case 4:
+			tp, err := parseType(f.b)
+			if err != nil {
+				return err
+			}
+			tp.ID = len(ft.types)
+			ft.types = append(ft.types, tp)
+		case 6:
+			ft.numberOfRows = f.v
+		}
+		return nil
+	})
+	return ft, err
+}
+
+func parseStripeInformation(b []byte) (StripeInformation, error) {
+	si := StripeInformation{}
+	err := parseMessage(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			si.Offset = f.v
+		case 2:
+			si.IndexLength = f.v
+		case 3:
+			si.DataLength = f.v
+		case 4:
+			si.FooterLength = f.v
+		case 5:
+			si.NumberOfRows = f.v
+		}
+		return nil
+	})
+	return si, err
+}
+
+func parseType(b []byte) (*Type, error) {
+	tp := &Type{}
+	err := parseMessage(b, func(f protoField) (err error) {
+		switch f.num {
+		case 1:
+			tp.Kind = Kind(f.v)
+		case 2:
+			tp.subtypes, err = f.uint32s(tp.subtypes)
+		case 3:
+			tp.FieldNames = append(tp.FieldNames, string(f.b))
+		case 4:
+			tp.MaxLength = int(f.v)
+		case 5:
+			tp.Precision = int(f.v)
+		case 6:
+			tp.Scale = int(f.v)
+		}
+		return err
+	})
+	return tp, err
+}
+
+func parseStripeFooter(b []byte) (*stripeFooter, error) {
+	sf := &stripeFooter{}
+	err := parseMessage(b, func(f protoField) error {
+		switch f.num {
+		case 1:
+			s := stream{}
+			if err := parseMessage(f.b, func(f protoField) error {
+				switch f.num {
+				case 1:
+					s.kind = streamKind(f.v)
+				case 2:
+					s.column = uint32(f.v)
+				case 3:
+					s.length = f.v
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			sf.streams = append(sf.streams, s)
+		case 2:
+			e := columnEncoding{}
+			if err := parseMessage(f.b, func(f protoField) error {
+				switch f.num {
+				case 1:
+					e.kind = encodingKind(f.v)
+				case 2:
+					e.dictionarySize = f.v
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			sf.columns = append(sf.columns, e)
+		case 3:
+			sf.writerTimezone = string(f.b)
+		}
+		return nil
+	})
+	return sf, err
+}
@@ -0,0 +1,327 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package orc implements a reader of the Apache ORC file format.
+//
+// The reader reads the rows of the file stripe by stripe, the streams of a
+// stripe are read from the file chunk by chunk as the values are read, so
+// only a compression block of each stream is in memory at a time. Encrypted
+// columns and the LZO compression are not supported.
+//
+// See https://orc.apache.org/specification/ORCv1/
+package orc
+
+import (
+	"io"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+const (
+	magic = "ORC"
+	// tailReadSize is the size of the tail read at first, which should contain
+	// the footer of most files.
+	tailReadSize = 16 * 1024
+
+	defaultCompressionBlockSize = 256 * 1024
+)
+
+// Reader reads the rows of an ORC file, the top level type of which must be
+// a struct.
+type Reader struct {
+	r            io.ReadSeeker
+	size         int64
+	decompressor *decompressor
+	schema       *Type
+	stripes      []StripeInformation
+	numRows      int64
+
+	// nextStripe is the index of the stripe to read after the current one.
+	nextStripe int
+	// rowsLeft is the number of the rows left in the current stripe.
+	rowsLeft int64
+	// root reads the PRESENT stream of the top level struct, the fields are
+	// null if the struct is null.
+	root   presentReader
+	fields []columnReader
+	// offset is the end offset of the bytes read in the file.
+	offset int64
+	row    []interface{}
+}
+
+// NewReader reads the metadata of an ORC file. The reader doesn't close r.
+func NewReader(r io.ReadSeeker) (*Reader, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if size <= int64(len(magic)) {
+		return nil, errors.Errorf("invalid ORC file of %d bytes", size)
+	}
+	tail, err := readAt(r, max(0, size-tailReadSize), min(size, tailReadSize), size)
+	if err != nil {
+		return nil, err
+	}
+	psLen := int64(tail[len(tail)-1])
+	if psLen+1 > int64(len(tail)) {
+		return nil, errors.New("invalid ORC file, the post script is truncated")
+	}
+	ps, err := parsePostScript(tail[int64(len(tail))-1-psLen : len(tail)-1])
+	if err != nil {
+		return nil, errors.Annotate(err, "invalid ORC post script")
+	}
+	if ps.magic != magic {
+		return nil, errors.Errorf("invalid ORC file, the magic is %q", ps.magic)
+	}
+	blockSize := defaultCompressionBlockSize
+	if ps.compressionBlockSize != 0 {
+		blockSize = int(min(ps.compressionBlockSize, maxCompressionBlockSize+1))
+	}
+	d, err := newDecompressor(ps.compression, blockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	footerOffset := size - 1 - psLen - int64(ps.footerLength)
+	if footerOffset < 0 {
+		d.close()
+		return nil, errors.New("invalid ORC file, the footer is truncated")
+	}
+	var footerBytes []byte
+	if tailOffset := size - int64(len(tail)); footerOffset >= tailOffset {
+		footerBytes = tail[footerOffset-tailOffset : footerOffset-tailOffset+int64(ps.footerLength)]
+	} else if footerBytes, err = readAt(r, footerOffset, int64(ps.footerLength), size); err != nil {
+		d.close()
+		return nil, err
+	}
+	if footerBytes, err = d.decompress(footerBytes); err != nil {
+		d.close()
+		return nil, err
+	}
+	ft, err := parseFooter(footerBytes)
+	if err != nil {
+		d.close()
+		return nil, errors.Annotate(err, "invalid ORC footer")
+	}
+	schema, err := buildTypeTree(ft.types)
+	if err != nil {
+		d.close()
+		return nil, err
+	}
+
+	return &Reader{
+		r:            r,
+		size:         size,
+		decompressor: d,
+		schema:       schema,
+		stripes:      ft.stripes,
+		numRows:      int64(ft.numberOfRows),
+		offset:       int64(len(magic)),
+		row:          make([]interface{}, len(schema.Children)),
+	}, nil
+}
+
+// readAt reads length bytes at offset, the bytes must not exceed size, which is
+// the size of the file or the end of the stream being read.
+func readAt(r io.ReadSeeker, offset, length, size int64) ([]byte, error) {
+	if offset < 0 || length < 0 || length > size-offset {
+		return nil, errors.Errorf("invalid ORC file, %d bytes at offset %d exceed the size %d", length, offset, size)
+	}
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return nil, errors.Trace(err)
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return b, nil
+}
+
+// buildTypeTree links the types, which are stored in pre-order, to a tree.
+func buildTypeTree(types []*Type) (*Type, error) {
+	if len(types) == 0 {
+		return nil, errors.New("invalid ORC file, the schema is empty")
+	}
+	for _, tp := range types {
+		for _, id := range tp.subtypes {
+			if int(id) <= tp.ID || int(id) >= len(types) {
+				return nil, errors.Errorf("invalid ORC schema, type %d has a subtype %d", tp.ID, id)
+			}
+			tp.Children = append(tp.Children, types[id])
+		}
+		if tp.Kind == KindStruct && len(tp.FieldNames) != len(tp.Children) {
+			return nil, errors.Errorf("invalid ORC schema, struct type %d has %d fields and %d names",
+				tp.ID, len(tp.Children), len(tp.FieldNames))
+		}
+	}
+	if types[0].Kind != KindStruct {
+		return nil, errors.Errorf("unsupported ORC schema, the top level type must be a struct, got %s", types[0].Kind)
+	}
+	return types[0], nil
+}
+
+// Schema returns the top level struct type of the file.
+func (r *Reader) Schema() *Type {
+	return r.schema
+}
+
+// NumRows returns the row count of the file.
+func (r *Reader) NumRows() int64 {
+	return r.numRows
+}
+
+// Stripes returns the stripes of the file.
+func (r *Reader) Stripes() []StripeInformation {
+	return r.stripes
+}
+
+// Offset returns the end offset of the bytes read in the file. The file is
+// read stripe by stripe, so it's the end of the current stripe.
+func (r *Reader) Offset() int64 {
+	return r.offset
+}
+
+// Next reads the next row, the values are the fields of the top level struct.
+// The returned slice is reused by the next call. It returns io.EOF if there
+// are no more rows.
+func (r *Reader) Next() ([]interface{}, error) {
+	for r.rowsLeft == 0 {
+		if r.nextStripe >= len(r.stripes) {
+			return nil, io.EOF
+		}
+		if err := r.readStripe(); err != nil {
+			return nil, err
+		}
+	}
+	isNull, err := r.root.isNull()
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read ORC row")
+	}
+	for i, f := range r.fields {
+		if isNull {
+			r.row[i] = nil
+			continue
+		}
+		v, err := f.next()
+		if err != nil {
+			return nil, errors.Annotatef(err, "failed to read ORC column %s", r.schema.FieldNames[i])
+		}
+		r.row[i] = v
+	}
+	r.rowsLeft--
+	return r.row, nil
+}
+
+// SkipRows skips n rows, the stripes whose rows are all skipped are not read.
+// It returns io.EOF if there are less than n rows left.
+func (r *Reader) SkipRows(n int64) error {
+	for n > 0 {
+		if r.rowsLeft == 0 {
+			if r.nextStripe >= len(r.stripes) {
+				return io.EOF
+			}
+			si := r.stripes[r.nextStripe]
+			if int64(si.NumberOfRows) <= n {
+				r.nextStripe++
+				r.offset = int64(si.Offset + si.IndexLength + si.DataLength + si.FooterLength)
+				n -= int64(si.NumberOfRows)
+				continue
+			}
+			if err := r.readStripe(); err != nil {
+				return err
+			}
+		}
+		for ; n > 0 && r.rowsLeft > 0; n-- {
+			if _, err := r.Next(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readStripe reads the footer of the next stripe, the streams are read when
+// the values are read.
+func (r *Reader) readStripe() error {
+	si := r.stripes[r.nextStripe]
+	if si.Offset > uint64(r.size) {
+		return errors.Errorf("invalid ORC file, stripe %d exceeds the file", r.nextStripe)
+	}
+	// the lengths are checked one by one, so the sum doesn't overflow.
+	stripeEnd := int64(si.Offset)
+	for _, length := range []uint64{si.IndexLength, si.DataLength, si.FooterLength} {
+		if length > uint64(r.size-stripeEnd) {
+			return errors.Errorf("invalid ORC file, stripe %d exceeds the file", r.nextStripe)
+		}
+		stripeEnd += int64(length)
+	}
+	footerOffset := stripeEnd - int64(si.FooterLength)
+	buf, err := readAt(r.r, footerOffset, int64(si.FooterLength), r.size)
+	if err != nil {
+		return err
+	}
+	footerBytes, err := r.decompressor.decompress(buf)
+	if err != nil {
+		return err
+	}
+	sf, err := parseStripeFooter(footerBytes)
+	if err != nil {
+		return errors.Annotate(err, "invalid ORC stripe footer")
+	}
+
+	streams := &stripeStreams{
+		streams:   make(map[streamKey]*streamSource, len(sf.streams)),
+		encodings: sf.columns,
+		location:  time.UTC,
+	}
+	if sf.writerTimezone != "" {
+		if streams.location, err = time.LoadLocation(sf.writerTimezone); err != nil {
+			return errors.Annotatef(err, "unknown time zone %s of the ORC writer", sf.writerTimezone)
+		}
+	}
+	// the streams are stored one by one from the start of the stripe.
+	offset := int64(si.Offset)
+	for _, s := range sf.streams {
+		if s.length > uint64(footerOffset-offset) {
+			return errors.Errorf("invalid ORC stripe, stream of column %d exceeds the stripe", s.column)
+		}
+		start := offset
+		offset += int64(s.length)
+		if s.kind > streamSecondary {
+			// the indexes are not used.
+			continue
+		}
+		streams.streams[streamKey{column: s.column, kind: s.kind}] = &streamSource{
+			r:      r.r,
+			d:      r.decompressor,
+			offset: start,
+			end:    offset,
+		}
+	}
+
+	r.root = newPresentReader(r.schema, streams)
+	if r.fields, err = newColumnReaders(r.schema.Children, streams); err != nil {
+		return err
+	}
+	r.nextStripe++
+	r.rowsLeft = int64(si.NumberOfRows)
+	r.offset = stripeEnd
+	return nil
+}
+
+// Close releases the resources of the reader, it doesn't close the file.
+func (r *Reader) Close() {
+	r.decompressor.close()
+}
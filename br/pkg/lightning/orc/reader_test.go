@@ -0,0 +1,303 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func allTypesSchema() *Type {
+	return newStructType(
+		[]string{"b", "i8", "i16", "i32", "i64", "f32", "f64", "s", "vc", "bin", "dec", "d", "ts", "tsi", "l", "m", "st", "u"},
+		newType(KindBoolean),
+		newType(KindByte),
+		newType(KindShort),
+		newType(KindInt),
+		newType(KindLong),
+		newType(KindFloat),
+		newType(KindDouble),
+		newType(KindString),
+		newType(KindVarchar),
+		newType(KindBinary),
+		newDecimalType(20, 4),
+		newType(KindDate),
+		newType(KindTimestamp),
+		newType(KindTimestampInstant),
+		newType(KindList, newType(KindLong)),
+		newType(KindMap, newType(KindString), newType(KindDouble)),
+		newStructType([]string{"x", "y"}, newType(KindInt), newType(KindString)),
+		newType(KindUnion, newType(KindInt), newType(KindString)),
+	)
+}
+
+func newDecimal(s string, scale int) Decimal {
+	v, _ := new(big.Int).SetString(s, 10)
+	return Decimal{Value: v, Scale: scale}
+}
+
+func allTypesRows(n int, location *time.Location) [][]interface{} {
+	rows := make([][]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		row := []interface{}{
+			i%3 == 0,
+			int64(int8(i)),
+			int64(i * 100),
+			int64(-i * 10000),
+			int64(i) << 40,
+			float32(i) / 4,
+			float64(i) * 1.5,
+			fmt.Sprintf("s%d", i%5),
+			strings.Repeat("v", i%7),
+			[]byte{byte(i), 0},
+			newDecimal(fmt.Sprintf("%d", i*123456-1000000), 4),
+			Date(int64(i*1000 - 10000)),
+			time.Date(1960+i%80, time.Month(i%12+1), 2, 3, 4, 5, i*1000, location),
+			time.Unix(int64(i)*100000, 999999999).UTC(),
+			[]interface{}{int64(i), nil, int64(-i)},
+			[]MapEntry{{Key: "k", Value: float64(i)}, {Key: "n", Value: nil}},
+			map[string]interface{}{"x": int64(i), "y": nil},
+			unionValue{tag: i % 2, value: []interface{}{int64(i), fmt.Sprint(i)}[i%2]},
+		}
+		// some nulls, and a null struct, whose children have no values.
+		if i%4 == 1 {
+			for j := range row {
+				if j%2 == 0 {
+					row[j] = nil
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// expectedValue converts the value written to the one read.
+func expectedValue(v interface{}) interface{} {
+	if u, ok := v.(unionValue); ok {
+		return u.value
+	}
+	return v
+}
+
+func checkRows(t *testing.T, r *Reader, rows [][]interface{}) {
+	for i, row := range rows {
+		got, err := r.Next()
+		require.NoError(t, err)
+		require.Len(t, got, len(row))
+		for j, v := range row {
+			expected := expectedValue(v)
+			if ts, ok := expected.(time.Time); ok {
+				require.True(t, ts.Equal(got[j].(time.Time)), "row %d column %d, expected %s, got %s", i, j, ts, got[j])
+				require.Equal(t, ts.Location().String(), got[j].(time.Time).Location().String())
+				continue
+			}
+			require.Equal(t, expected, got[j], "row %d column %d", i, j)
+		}
+	}
+}
+
+func TestReadAllTypes(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	writers := []*testWriter{
+		{compression: compressionNone},
+		{compression: compressionZlib, blockSize: 100, dictionary: true, stripeRows: 7},
+		{compression: compressionSnappy, blockSize: 1000, rleV1: true, stripeRows: 10},
+		{compression: compressionZstd, blockSize: 64, rleV1: true, dictionary: true, timezone: "Asia/Shanghai"},
+		{compression: compressionLz4, blockSize: 256, stripeRows: 1},
+	}
+	for _, w := range writers {
+		location := time.UTC
+		if w.timezone != "" {
+			location = shanghai
+		}
+		rows := allTypesRows(30, location)
+		data := w.write(t, allTypesSchema(), rows)
+		r, err := NewReader(bytes.NewReader(data))
+		require.NoError(t, err, "%+v", w)
+		require.Equal(t, int64(30), r.NumRows())
+		require.Equal(t, allTypesSchema().FieldNames, r.Schema().FieldNames)
+		require.Equal(t, KindDecimal, r.Schema().Children[10].Kind)
+		require.Equal(t, 4, r.Schema().Children[10].Scale)
+		require.Equal(t, KindString, r.Schema().Children[15].Children[0].Kind)
+
+		checkRows(t, r, rows)
+		_, err = r.Next()
+		require.ErrorIs(t, err, io.EOF)
+		last := r.Stripes()[len(r.Stripes())-1]
+		require.Equal(t, int64(last.Offset+last.IndexLength+last.DataLength+last.FooterLength), r.Offset())
+		r.Close()
+	}
+}
+
+func TestSkipRows(t *testing.T) {
+	rows := allTypesRows(30, time.UTC)
+	data := (&testWriter{compression: compressionSnappy, blockSize: 128, stripeRows: 7}).write(t, allTypesSchema(), rows)
+	for _, n := range []int64{0, 3, 7, 13, 14, 29, 30} {
+		r, err := NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Len(t, r.Stripes(), 5)
+		require.Equal(t, int64(len(magic)), r.Offset())
+		require.NoError(t, r.SkipRows(n))
+		// the stripes of the skipped rows are passed.
+		if stripe := n / 7; stripe > 0 {
+			si := r.Stripes()[stripe-1]
+			if n%7 == 0 {
+				require.Equal(t, int64(si.Offset+si.IndexLength+si.DataLength+si.FooterLength), r.Offset())
+			} else {
+				require.Greater(t, r.Offset(), int64(si.Offset+si.IndexLength+si.DataLength+si.FooterLength))
+			}
+		}
+		checkRows(t, r, rows[n:])
+		_, err = r.Next()
+		require.ErrorIs(t, err, io.EOF)
+		require.ErrorIs(t, r.SkipRows(1), io.EOF)
+		r.Close()
+	}
+}
+
+// readRecorder records the largest read from the file.
+type readRecorder struct {
+	io.ReadSeeker
+	maxRead int
+}
+
+func (r *readRecorder) Read(p []byte) (int, error) {
+	r.maxRead = max(r.maxRead, len(p))
+	return r.ReadSeeker.Read(p)
+}
+
+func TestReadStreamsByChunks(t *testing.T) {
+	rows := allTypesRows(100, time.UTC)
+	for _, w := range []*testWriter{
+		{compression: compressionNone},
+		{compression: compressionZlib, blockSize: 100, dictionary: true},
+	} {
+		data := w.write(t, allTypesSchema(), rows)
+		rr := &readRecorder{ReadSeeker: bytes.NewReader(data)}
+		r, err := NewReader(rr)
+		require.NoError(t, err)
+		require.Len(t, r.Stripes(), 1)
+		rr.maxRead = 0
+		checkRows(t, r, rows)
+		// the streams are read chunk by chunk, rather than the whole stripe.
+		si := r.Stripes()[0]
+		require.Less(t, rr.maxRead, int(si.DataLength/4), "%+v", w)
+		r.Close()
+	}
+}
+
+func TestDecimalAndTimestamp(t *testing.T) {
+	require.Equal(t, "0.0001", newDecimal("1", 4).String())
+	require.Equal(t, "-0.0500", newDecimal("-500", 4).String())
+	require.Equal(t, "-12345.6789", newDecimal("-123456789", 4).String())
+	require.Equal(t, "42", newDecimal("42", 0).String())
+	require.Equal(t, "1970-01-02", Date(1).String())
+	require.Equal(t, "1969-12-31", Date(-1).String())
+
+	// a decimal written with a scale less than the one of the type is
+	// rescaled, and the nanos are encoded without the trailing zeros.
+	schema := newStructType([]string{"d", "ts"}, newDecimalType(10, 3), newType(KindTimestamp))
+	rows := [][]interface{}{
+		{newDecimal("15", 1), time.Date(1969, 12, 31, 23, 59, 58, 500000000, time.UTC)},
+		{newDecimal("-15", 3), time.Date(2015, 1, 1, 0, 0, 0, 1000, time.UTC)},
+	}
+	data := (&testWriter{}).write(t, schema, rows)
+	r, err := NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	row, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, "1.500", row[0].(Decimal).String())
+	require.Equal(t, "1969-12-31 23:59:58.5", row[1].(time.Time).Format("2006-01-02 15:04:05.999999999"))
+	row, err = r.Next()
+	require.NoError(t, err)
+	require.Equal(t, "-0.015", row[0].(Decimal).String())
+	require.Equal(t, "2015-01-01 00:00:00.000001", row[1].(time.Time).Format("2006-01-02 15:04:05.999999999"))
+	require.Equal(t, int64(1)<<3|2, encodeNanos(1000))
+}
+
+func TestInvalidFile(t *testing.T) {
+	_, err := NewReader(bytes.NewReader([]byte("ORC")))
+	require.ErrorContains(t, err, "invalid ORC file")
+
+	data := (&testWriter{}).write(t, newStructType([]string{"a"}, newType(KindInt)), [][]interface{}{{int64(1)}})
+	// the magic is at the end of the post script.
+	copy(data[len(data)-4:], "CRO")
+	_, err = NewReader(bytes.NewReader(data))
+	require.ErrorContains(t, err, `the magic is "CRO"`)
+
+	data = (&testWriter{}).write(t, newType(KindList, newType(KindInt)), nil)
+	_, err = NewReader(bytes.NewReader(data))
+	require.ErrorContains(t, err, "the top level type must be a struct, got array")
+
+	// the stream is shorter than the rows.
+	data = (&testWriter{}).write(t, newStructType([]string{"a"}, newType(KindInt)), [][]interface{}{{int64(1)}})
+	r, err := NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	r.numRows, r.stripes[0].NumberOfRows = 2, 2
+	_, err = r.Next()
+	require.NoError(t, err)
+	_, err = r.Next()
+	require.ErrorContains(t, err, "failed to read ORC column a: unexpected end of ORC stream")
+	// the lengths read from the file are checked against the size of the file.
+	r, err = NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	r.stripes[0].DataLength = math.MaxUint64 - 10
+	_, err = r.Next()
+	require.ErrorContains(t, err, "invalid ORC file, stripe 0 exceeds the file")
+	data = (&testWriter{compression: compressionZlib, blockSize: maxCompressionBlockSize + 1}).write(t,
+		newStructType([]string{"a"}, newType(KindInt)), nil)
+	_, err = NewReader(bytes.NewReader(data))
+	require.ErrorContains(t, err, "invalid ORC compression block size 8388608")
+}
+
+func TestInvalidCounts(t *testing.T) {
+	d, err := newDecompressor(compressionNone, defaultCompressionBlockSize)
+	require.NoError(t, err)
+	source := func(b []byte) *streamSource {
+		return &streamSource{r: bytes.NewReader(b), d: d, end: int64(len(b))}
+	}
+
+	// the counts read from the file don't allocate the memory before the
+	// values are read.
+	s := &stripeStreams{
+		streams: map[streamKey]*streamSource{
+			{column: 1, kind: streamLength}:         source(encodeIntsV2([]int64{1}, false)),
+			{column: 1, kind: streamDictionaryData}: source([]byte("a")),
+		},
+		encodings: []columnEncoding{{}, {kind: encodingDictionaryV2, dictionarySize: math.MaxUint64}},
+	}
+	_, err = newStringColumn(&Type{ID: 1, Kind: KindString}, s, presentReader{})
+	require.ErrorContains(t, err, "failed to read the dictionary of ORC column 1: unexpected end of ORC stream")
+
+	list := &listColumn{
+		lengths: newIntReader(&byteStream{src: source(encodeIntsV2([]int64{1 << 40}, false))}, false, encodingDirectV2),
+		elem:    &intColumn{data: newIntReader(&byteStream{}, true, encodingDirectV2)},
+	}
+	_, err = list.next()
+	require.ErrorIs(t, err, errUnexpectedEOS)
+	list.lengths = newIntReader(&byteStream{buf: encodeIntsV2([]int64{-1}, true)}, true, encodingDirectV2)
+	_, err = list.next()
+	require.ErrorContains(t, err, "invalid ORC list length -1")
+}
@@ -0,0 +1,514 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"math/big"
+
+	"github.com/pingcap/errors"
+)
+
+// The run length encodings of ORC.
+// See https://orc.apache.org/specification/ORCv1/#run-length-encoding
+
+var errUnexpectedEOS = errors.New("unexpected end of ORC stream")
+
+// byteStream reads the decompressed bytes of a stream. The stream is read
+// chunk by chunk from the file, buf is the current chunk.
+type byteStream struct {
+	buf []byte
+	pos int
+	// src reads the next chunks, it's nil if the whole stream is in buf.
+	src *streamSource
+}
+
+// fill reads the next chunk of the stream into buf.
+func (s *byteStream) fill() error {
+	if s.src == nil {
+		return errUnexpectedEOS
+	}
+	buf, err := s.src.next()
+	if err != nil {
+		return err
+	}
+	s.buf, s.pos = buf, 0
+	return nil
+}
+
+func (s *byteStream) readByte() (byte, error) {
+	for s.pos >= len(s.buf) {
+		if err := s.fill(); err != nil {
+			return 0, err
+		}
+	}
+	b := s.buf[s.pos]
+	s.pos++
+	return b, nil
+}
+
+// readBytes reads n bytes. The returned slice isn't changed by the following
+// reads, the bytes across chunks are copied to a new slice.
+func (s *byteStream) readBytes(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, errUnexpectedEOS
+	}
+	if n <= len(s.buf)-s.pos {
+		b := s.buf[s.pos : s.pos+n]
+		s.pos += n
+		return b, nil
+	}
+	// the bytes are appended as the chunks are read, so the buffer never
+	// exceeds the bytes of the stream.
+	b := append([]byte(nil), s.buf[s.pos:]...)
+	s.pos = len(s.buf)
+	for len(b) < n {
+		if err := s.fill(); err != nil {
+			return nil, err
+		}
+		m := min(n-len(b), len(s.buf))
+		b = append(b, s.buf[:m]...)
+		s.pos = m
+	}
+	return b, nil
+}
+
+// readUvarint reads a base 128 varint.
+func (s *byteStream) readUvarint() (uint64, error) {
+	var v uint64
+	for shift := 0; shift < 64; shift += 7 {
+		b, err := s.readByte()
+		if err != nil {
+			return 0, err
+		}
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v, nil
+		}
+	}
+	return 0, errors.New("ORC varint overflows 64 bits")
+}
+
+func (s *byteStream) readVarint() (int64, error) {
+	v, err := s.readUvarint()
+	return zigzagDecode(v), err
+}
+
+// readBigVarint reads a zigzag encoded varint of unbounded length, which is
+// used by the values of decimal columns.
+func (s *byteStream) readBigVarint() (*big.Int, error) {
+	v := new(big.Int)
+	var word big.Int
+	for shift := uint(0); ; shift += 7 {
+		b, err := s.readByte()
+		if err != nil {
+			return nil, err
+		}
+		word.SetUint64(uint64(b & 0x7f))
+		v.Or(v, word.Lsh(&word, shift))
+		if b < 0x80 {
+			break
+		}
+	}
+	negative := v.Bit(0) == 1
+	v.Rsh(v, 1)
+	if negative {
+		v.Neg(v).Sub(v, big.NewInt(1))
+	}
+	return v, nil
+}
+
+// readBigEndian reads an unsigned integer of n bytes in big endian.
+func (s *byteStream) readBigEndian(n int) (uint64, error) {
+	b, err := s.readBytes(n)
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	for _, x := range b {
+		v = v<<8 | uint64(x)
+	}
+	return v, nil
+}
+
+// readBitPacked reads n values of width bits, the values are packed from the
+// most significant bit and the last byte is padded.
+func (s *byteStream) readBitPacked(values []uint64, n, width int) ([]uint64, error) {
+	b, err := s.readBytes((n*width + 7) / 8)
+	if err != nil {
+		return nil, err
+	}
+	bitPos := 0
+	for i := 0; i < n; i++ {
+		var v uint64
+		for need := width; need > 0; {
+			avail := 8 - bitPos%8
+			take := min(avail, need)
+			bits := b[bitPos/8] >> (avail - take) & (1<<take - 1)
+			v = v<<take | uint64(bits)
+			need -= take
+			bitPos += take
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func zigzagDecode(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// byteRLEReader decodes the byte run length encoding. A control byte below
+// 0x80 is followed by a byte repeated control+3 times, otherwise it's
+// followed by 0x100-control literal bytes.
+type byteRLEReader struct {
+	in        *byteStream
+	run       bool
+	runValue  byte
+	literals  []byte
+	remaining int
+}
+
+func (r *byteRLEReader) next() (byte, error) {
+	if r.remaining == 0 {
+		control, err := r.in.readByte()
+		if err != nil {
+			return 0, err
+		}
+		if control < 0x80 {
+			r.run, r.remaining = true, int(control)+3
+			if r.runValue, err = r.in.readByte(); err != nil {
+				return 0, err
+			}
+		} else {
+			r.run, r.remaining = false, 0x100-int(control)
+			if r.literals, err = r.in.readBytes(r.remaining); err != nil {
+				return 0, err
+			}
+		}
+	}
+	r.remaining--
+	if r.run {
+		return r.runValue, nil
+	}
+	b := r.literals[0]
+	r.literals = r.literals[1:]
+	return b, nil
+}
+
+// boolReader decodes booleans which are packed into bytes from the most
+// significant bit, the bytes are encoded by byte run length encoding.
+type boolReader struct {
+	bytes    byteRLEReader
+	current  byte
+	bitsLeft int
+}
+
+func newBoolReader(in *byteStream) *boolReader {
+	return &boolReader{bytes: byteRLEReader{in: in}}
+}
+
+func (r *boolReader) next() (bool, error) {
+	if r.bitsLeft == 0 {
+		b, err := r.bytes.next()
+		if err != nil {
+			return false, err
+		}
+		r.current, r.bitsLeft = b, 8
+	}
+	r.bitsLeft--
+	return r.current>>r.bitsLeft&1 == 1, nil
+}
+
+// intReader decodes the integer run length encoding, version 1 or 2 by the
+// encoding of the column. Unsigned values are returned as int64 too.
+type intReader struct {
+	in     *byteStream
+	signed bool
+	v2     bool
+	values []int64
+	idx    int
+	// unpacked is a buffer for the bit packed values.
+	unpacked []uint64
+}
+
+func newIntReader(in *byteStream, signed bool, encoding encodingKind) *intReader {
+	v2 := encoding == encodingDirectV2 || encoding == encodingDictionaryV2
+	return &intReader{in: in, signed: signed, v2: v2}
+}
+
+func (r *intReader) next() (int64, error) {
+	for r.idx >= len(r.values) {
+		r.values, r.idx = r.values[:0], 0
+		var err error
+		if r.v2 {
+			err = r.readRunV2()
+		} else {
+			err = r.readRunV1()
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	v := r.values[r.idx]
+	r.idx++
+	return v, nil
+}
+
+func (r *intReader) readValue() (int64, error) {
+	if r.signed {
+		return r.in.readVarint()
+	}
+	v, err := r.in.readUvarint()
+	return int64(v), err
+}
+
+func (r *intReader) decode(v uint64) int64 {
+	if r.signed {
+		return zigzagDecode(v)
+	}
+	return int64(v)
+}
+
+// readRunV1 reads a run of version 1. A control byte below 0x80 is followed
+// by a signed delta byte and a base value, which makes control+3 values,
+// otherwise it's followed by 0x100-control literal values.
+func (r *intReader) readRunV1() error {
+	control, err := r.in.readByte()
+	if err != nil {
+		return err
+	}
+	if control < 0x80 {
+		delta, err := r.in.readByte()
+		if err != nil {
+			return err
+		}
+		base, err := r.readValue()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < int(control)+3; i++ {
+			r.values = append(r.values, base+int64(i)*int64(int8(delta)))
+		}
+		return nil
+	}
+	for i := 0; i < 0x100-int(control); i++ {
+		v, err := r.readValue()
+		if err != nil {
+			return err
+		}
+		r.values = append(r.values, v)
+	}
+	return nil
+}
+
+const (
+	rleV2ShortRepeat = iota
+	rleV2Direct
+	rleV2PatchedBase
+	rleV2Delta
+)
+
+// readRunV2 reads a run of version 2, the highest 2 bits of the first byte is
+// the sub encoding of the run.
+func (r *intReader) readRunV2() error {
+	first, err := r.in.readByte()
+	if err != nil {
+		return err
+	}
+	switch first >> 6 {
+	case rleV2ShortRepeat:
+		return r.readShortRepeat(first)
+	case rleV2Direct:
+		return r.readDirect(first)
+	case rleV2PatchedBase:
+		return r.readPatchedBase(first)
+	default:
+		return r.readDelta(first)
+	}
+}
+
+// readRunLength reads the 9 bits run length of the direct, patched base and
+// delta sub encoding.
+func (r *intReader) readRunLength(first byte) (int, error) {
+	second, err := r.in.readByte()
+	if err != nil {
+		return 0, err
+	}
+	return (int(first&1)<<8 | int(second)) + 1, nil
+}
+
+func (r *intReader) readShortRepeat(first byte) error {
+	width := int(first>>3&0x07) + 1
+	count := int(first&0x07) + 3
+	v, err := r.in.readBigEndian(width)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		r.values = append(r.values, r.decode(v))
+	}
+	return nil
+}
+
+func (r *intReader) readDirect(first byte) error {
+	width := decodeBitWidth(int(first >> 1 & 0x1f))
+	length, err := r.readRunLength(first)
+	if err != nil {
+		return err
+	}
+	if r.unpacked, err = r.in.readBitPacked(r.unpacked[:0], length, width); err != nil {
+		return err
+	}
+	for _, v := range r.unpacked {
+		r.values = append(r.values, r.decode(v))
+	}
+	return nil
+}
+
+func (r *intReader) readPatchedBase(first byte) error {
+	width := decodeBitWidth(int(first >> 1 & 0x1f))
+	length, err := r.readRunLength(first)
+	if err != nil {
+		return err
+	}
+	third, err := r.in.readByte()
+	if err != nil {
+		return err
+	}
+	fourth, err := r.in.readByte()
+	if err != nil {
+		return err
+	}
+	baseWidth := int(third>>5&0x07) + 1
+	patchWidth := decodeBitWidth(int(third & 0x1f))
+	patchGapWidth := int(fourth>>5&0x07) + 1
+	patchListLength := int(fourth & 0x1f)
+	if width+patchWidth > 64 {
+		return errors.Errorf("invalid ORC patched base run, value width %d and patch width %d", width, patchWidth)
+	}
+
+	// the base value is in sign magnitude format.
+	u, err := r.in.readBigEndian(baseWidth)
+	if err != nil {
+		return err
+	}
+	signMask := uint64(1) << (baseWidth*8 - 1)
+	base := int64(u &^ signMask)
+	if u&signMask != 0 {
+		base = -base
+	}
+
+	if r.unpacked, err = r.in.readBitPacked(r.unpacked[:0], length, width); err != nil {
+		return err
+	}
+	patches, err := r.in.readBitPacked(nil, patchListLength, closestFixedBits(patchGapWidth+patchWidth))
+	if err != nil {
+		return err
+	}
+	patchMask := uint64(1)<<patchWidth - 1
+	// each patch is the gap from the previous patched value and the patch of
+	// the high bits. A gap longer than 255 is split into patches of gap 255
+	// and patch 0.
+	patchIdx := 0
+	nextPatch := func(pos int) (int, uint64) {
+		for patchIdx < len(patches) {
+			gap, patch := int(patches[patchIdx]>>patchWidth), patches[patchIdx]&patchMask
+			patchIdx++
+			pos += gap
+			if gap != 255 || patch != 0 {
+				return pos, patch
+			}
+		}
+		return -1, 0
+	}
+	patchPos, patch := nextPatch(0)
+	for i, v := range r.unpacked {
+		if i == patchPos {
+			v |= patch << width
+			patchPos, patch = nextPatch(i)
+		}
+		r.values = append(r.values, base+int64(v))
+	}
+	return nil
+}
+
+func (r *intReader) readDelta(first byte) error {
+	widthCode := int(first >> 1 & 0x1f)
+	length, err := r.readRunLength(first)
+	if err != nil {
+		return err
+	}
+	base, err := r.readValue()
+	if err != nil {
+		return err
+	}
+	deltaBase, err := r.in.readVarint()
+	if err != nil {
+		return err
+	}
+	r.values = append(r.values, base)
+	// the deltas are all deltaBase if the width is 0.
+	if widthCode == 0 {
+		for i := 1; i < length; i++ {
+			base += deltaBase
+			r.values = append(r.values, base)
+		}
+		return nil
+	}
+	if length < 2 {
+		return errors.Errorf("invalid ORC delta run of length %d", length)
+	}
+	base += deltaBase
+	r.values = append(r.values, base)
+	// the other deltas are bit packed and have the same sign as deltaBase.
+	if r.unpacked, err = r.in.readBitPacked(r.unpacked[:0], length-2, decodeBitWidth(widthCode)); err != nil {
+		return err
+	}
+	for _, delta := range r.unpacked {
+		if deltaBase < 0 {
+			base -= int64(delta)
+		} else {
+			base += int64(delta)
+		}
+		r.values = append(r.values, base)
+	}
+	return nil
+}
+
+// decodeBitWidth decodes the 5 bits width of the bit packed values.
+func decodeBitWidth(code int) int {
+	switch {
+	case code <= 23:
+		return code + 1
+	case code <= 27:
+		return 26 + (code-24)*2
+	default:
+		return 40 + (code-28)*8
+	}
+}
+
+// closestFixedBits rounds up the width to one that decodeBitWidth supports.
+func closestFixedBits(width int) int {
+	switch {
+	case width == 0:
+		return 1
+	case width <= 24:
+		return width
+	case width <= 32:
+		return (width + 1) / 2 * 2
+	default:
+		return (width + 7) / 8 * 8
+	}
+}
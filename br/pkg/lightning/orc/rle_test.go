@@ -0,0 +1,153 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readInts(t *testing.T, data []byte, signed bool, encoding encodingKind, n int) []int64 {
+	r := newIntReader(&byteStream{buf: data}, signed, encoding)
+	values := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		v, err := r.next()
+		require.NoError(t, err)
+		values = append(values, v)
+	}
+	_, err := r.next()
+	require.ErrorIs(t, err, errUnexpectedEOS)
+	return values
+}
+
+func TestIntRLEV2Examples(t *testing.T) {
+	// the examples of the specification.
+	cases := []struct {
+		data   []byte
+		values []int64
+	}{
+		{
+			// short repeat
+			data:   []byte{0x0a, 0x27, 0x10},
+			values: []int64{10000, 10000, 10000, 10000, 10000},
+		},
+		{
+			// direct
+			data:   []byte{0x5e, 0x03, 0x5c, 0xa1, 0xab, 0x1e, 0xde, 0xad, 0xbe, 0xef},
+			values: []int64{23713, 43806, 57005, 48879},
+		},
+		{
+			// patched base
+			data: []byte{
+				0x8e, 0x13, 0x2b, 0x21, 0x07, 0xd0, 0x1e, 0x00, 0x14, 0x70, 0x28, 0x32, 0x3c, 0x46,
+				0x50, 0x5a, 0x64, 0x6e, 0x78, 0x82, 0x8c, 0x96, 0xa0, 0xaa, 0xb4, 0xbe, 0xfc, 0xe8,
+			},
+			values: []int64{
+				2030, 2000, 2020, 1000000, 2040, 2050, 2060, 2070, 2080, 2090,
+				2100, 2110, 2120, 2130, 2140, 2150, 2160, 2170, 2180, 2190,
+			},
+		},
+		{
+			// delta
+			data:   []byte{0xc6, 0x09, 0x02, 0x02, 0x22, 0x42, 0x42, 0x46},
+			values: []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29},
+		},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.values, readInts(t, c.data, false, encodingDirectV2, len(c.values)))
+	}
+
+	// fixed delta, the signed base is -1 and the delta is -2.
+	require.Equal(t, []int64{-1, -3, -5, -7}, readInts(t, []byte{0xc0, 0x03, 0x01, 0x03}, true, encodingDirectV2, 4))
+	// decreasing delta, the base is 100, the delta base is -10, the other
+	// deltas are 1 and 2 of 2 bits.
+	require.Equal(t, []int64{100, 90, 89, 87}, readInts(t, []byte{0xc2, 0x03, 0xc8, 0x01, 0x13, 0x60}, true, encodingDirectV2, 4))
+	// patched base of 300 values with the base -2, the values are 0 of 1 bit
+	// except the one at 280, which is patched to 1<<1 by a gap of 255+25.
+	data := []byte{0x81, 0x2b, 0x00, 0xe2, 0x82}
+	data = append(data, make([]byte, 38)...)
+	data = append(data, 0xff, 0x0c, 0xc0)
+	expected := make([]int64, 300)
+	for i := range expected {
+		expected[i] = -2
+	}
+	expected[280] = 0
+	require.Equal(t, expected, readInts(t, data, true, encodingDirectV2, 300))
+}
+
+func TestIntRLEV1(t *testing.T) {
+	// a run of 5 values from 10 by step -2, and literals 1, -1.
+	data := []byte{0x02, 0xfe, 0x14, 0xfe, 0x02, 0x01}
+	require.Equal(t, []int64{10, 8, 6, 4, 2, 1, -1}, readInts(t, data, true, encodingDirect, 7))
+	require.Equal(t, []int64{1, 2, 3, 300}, readInts(t, encodeIntsV1([]int64{1, 2, 3, 300}, false), false, encodingDictionary, 4))
+}
+
+func TestIntRLERoundTrip(t *testing.T) {
+	values := []int64{0, 1, -1, math.MaxInt64, math.MinInt64, 7, 7, 7, 7, 1 << 40, -(1 << 40)}
+	for i := 0; i < 600; i++ {
+		values = append(values, int64(i*i-300))
+	}
+	for _, v1 := range []bool{false, true} {
+		encoding, data := encodingDirectV2, encodeIntsV2(values, true)
+		if v1 {
+			encoding, data = encodingDirect, encodeIntsV1(values, true)
+		}
+		require.Equal(t, values, readInts(t, data, true, encoding, len(values)))
+	}
+	unsigned := []int64{0, 1, math.MaxInt64, 3, 3, 3, 255, 256}
+	require.Equal(t, unsigned, readInts(t, encodeIntsV2(unsigned, false), false, encodingDirectV2, len(unsigned)))
+}
+
+func TestByteAndBoolRLE(t *testing.T) {
+	// the example of the specification: 100 zeros, then 0x44 and 0x45.
+	r := &byteRLEReader{in: &byteStream{buf: []byte{0x61, 0x00, 0xfe, 0x44, 0x45}}}
+	for i := 0; i < 102; i++ {
+		b, err := r.next()
+		require.NoError(t, err)
+		switch i {
+		case 100:
+			require.Equal(t, byte(0x44), b)
+		case 101:
+			require.Equal(t, byte(0x45), b)
+		default:
+			require.Equal(t, byte(0), b)
+		}
+	}
+	_, err := r.next()
+	require.ErrorIs(t, err, errUnexpectedEOS)
+
+	// the example of the specification: true then 7 false.
+	br := newBoolReader(&byteStream{buf: []byte{0xff, 0x80}})
+	for i := 0; i < 8; i++ {
+		v, err := br.next()
+		require.NoError(t, err)
+		require.Equal(t, i == 0, v)
+	}
+}
+
+func TestBigVarint(t *testing.T) {
+	huge, ok := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	require.True(t, ok)
+	for _, v := range []*big.Int{big.NewInt(0), big.NewInt(-1), big.NewInt(1), big.NewInt(math.MinInt64), huge} {
+		s := &byteStream{buf: appendBigVarint(nil, v)}
+		got, err := s.readBigVarint()
+		require.NoError(t, err)
+		require.Equal(t, 0, v.Cmp(got), "expected %s, got %s", v, got)
+		require.Equal(t, len(s.buf), s.pos)
+	}
+}
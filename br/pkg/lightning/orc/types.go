@@ -0,0 +1,126 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Kind is the kind of an ORC type.
+type Kind int
+
+// The kinds of ORC types, the values are the same as the ones in the file.
+const (
+	KindBoolean Kind = iota
+	KindByte
+	KindShort
+	KindInt
+	KindLong
+	KindFloat
+	KindDouble
+	KindString
+	KindBinary
+	KindTimestamp
+	KindList
+	KindMap
+	KindStruct
+	KindUnion
+	KindDecimal
+	KindDate
+	KindVarchar
+	KindChar
+	KindTimestampInstant
+)
+
+var kindNames = []string{
+	"boolean", "tinyint", "smallint", "int", "bigint", "float", "double", "string", "binary", "timestamp",
+	"array", "map", "struct", "uniontype", "decimal", "date", "varchar", "char", "timestamp with local time zone",
+}
+
+func (k Kind) String() string {
+	if k >= 0 && int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return "unknown"
+}
+
+// Type is a node of the type tree of an ORC file. The ID is the column id of
+// the type, which is the index of the pre-order traversal of the tree.
+type Type struct {
+	ID       int
+	Kind     Kind
+	Children []*Type
+	// FieldNames are the names of the children of a struct.
+	FieldNames []string
+	MaxLength  int
+	Precision  int
+	Scale      int
+
+	subtypes []uint32
+}
+
+// Decimal is a decimal value, which is Value * 10^-Scale.
+type Decimal struct {
+	Value *big.Int
+	Scale int
+}
+
+// String formats the decimal with exactly Scale digits after the decimal point.
+func (d Decimal) String() string {
+	s := d.Value.String()
+	if d.Scale <= 0 {
+		return s + strings.Repeat("0", -d.Scale)
+	}
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+	if len(s) <= d.Scale {
+		s = strings.Repeat("0", d.Scale-len(s)+1) + s
+	}
+	return sign + s[:len(s)-d.Scale] + "." + s[len(s)-d.Scale:]
+}
+
+// MarshalJSON implements the json.Marshaler interface, the decimal is encoded
+// as a JSON number.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// Date is a date value, which is the number of days since the unix epoch.
+type Date int64
+
+// Time returns the midnight of the date in UTC.
+func (d Date) Time() time.Time {
+	return time.Unix(int64(d)*24*60*60, 0).UTC()
+}
+
+// String formats the date as YYYY-MM-DD.
+func (d Date) String() string {
+	return d.Time().Format(time.DateOnly)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// MapEntry is an entry of a map value.
+type MapEntry struct {
+	Key   interface{}
+	Value interface{}
+}
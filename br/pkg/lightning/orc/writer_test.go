@@ -0,0 +1,596 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"math"
+	"math/big"
+	"math/bits"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// testWriter writes ORC files for the tests, it supports the same types and
+// encodings as the reader, except the patched base and delta sub encodings
+// of RLE v2.
+
+type testWriter struct {
+	compression compressionKind
+	blockSize   int
+	// rleV1 writes the integers by RLE v1, otherwise by RLE v2.
+	rleV1 bool
+	// dictionary writes the strings by the dictionary encoding.
+	dictionary bool
+	// timezone is the time zone of the timestamps, it's UTC if empty.
+	timezone   string
+	stripeRows int
+}
+
+type unionValue struct {
+	tag   int
+	value interface{}
+}
+
+func newStructType(names []string, children ...*Type) *Type {
+	return &Type{Kind: KindStruct, FieldNames: names, Children: children}
+}
+
+func newType(kind Kind, children ...*Type) *Type {
+	return &Type{Kind: kind, Children: children}
+}
+
+func newDecimalType(precision, scale int) *Type {
+	return &Type{Kind: KindDecimal, Precision: precision, Scale: scale}
+}
+
+// flattenTypes assigns the column ids to the types in pre-order.
+func flattenTypes(tp *Type, types []*Type) []*Type {
+	tp.ID = len(types)
+	tp.subtypes = nil
+	types = append(types, tp)
+	for _, child := range tp.Children {
+		types = flattenTypes(child, types)
+		tp.subtypes = append(tp.subtypes, uint32(child.ID))
+	}
+	return types
+}
+
+type testStream struct {
+	kind   streamKind
+	column int
+	data   []byte
+}
+
+type testStripe struct {
+	w         *testWriter
+	streams   []testStream
+	encodings []columnEncoding
+}
+
+func (w *testWriter) write(t *testing.T, schema *Type, rows [][]interface{}) []byte {
+	types := flattenTypes(schema, nil)
+	stripeRows := w.stripeRows
+	if stripeRows == 0 {
+		stripeRows = len(rows)
+	}
+
+	file := []byte(magic)
+	var ft []byte
+	for start := 0; start < len(rows); start += stripeRows {
+		end := min(start+stripeRows, len(rows))
+		s := &testStripe{w: w, encodings: make([]columnEncoding, len(types))}
+		// a fake index stream, which must be skipped by the reader.
+		s.streams = append(s.streams, testStream{kind: streamRowIndex, column: 0, data: []byte("index")})
+		for i, field := range schema.Children {
+			values := make([]interface{}, 0, end-start)
+			for _, row := range rows[start:end] {
+				values = append(values, row[i])
+			}
+			s.writeColumn(field, values)
+		}
+
+		offset := uint64(len(file))
+		var indexLength, dataLength uint64
+		var sf []byte
+		for _, st := range s.streams {
+			data := w.compress(t, st.data)
+			file = append(file, data...)
+			if st.kind == streamRowIndex {
+				indexLength += uint64(len(data))
+			} else {
+				dataLength += uint64(len(data))
+			}
+			var msg []byte
+			msg = appendVarintField(msg, 1, uint64(st.kind))
+			msg = appendVarintField(msg, 2, uint64(st.column))
+			msg = appendVarintField(msg, 3, uint64(len(data)))
+			sf = protowire.AppendTag(sf, 1, protowire.BytesType)
+			sf = protowire.AppendBytes(sf, msg)
+		}
+		for _, e := range s.encodings {
+			var msg []byte
+			msg = appendVarintField(msg, 1, uint64(e.kind))
+			if e.dictionarySize > 0 {
+				msg = appendVarintField(msg, 2, e.dictionarySize)
+			}
+			sf = protowire.AppendTag(sf, 2, protowire.BytesType)
+			sf = protowire.AppendBytes(sf, msg)
+		}
+		if w.timezone != "" {
+			sf = protowire.AppendTag(sf, 3, protowire.BytesType)
+			sf = protowire.AppendString(sf, w.timezone)
+		}
+		sf = w.compress(t, sf)
+		file = append(file, sf...)
+
+		var si []byte
+		si = appendVarintField(si, 1, offset)
+		si = appendVarintField(si, 2, indexLength)
+		si = appendVarintField(si, 3, dataLength)
+		si = appendVarintField(si, 4, uint64(len(sf)))
+		si = appendVarintField(si, 5, uint64(end-start))
+		ft = protowire.AppendTag(ft, 3, protowire.BytesType)
+		ft = protowire.AppendBytes(ft, si)
+	}
+	for _, tp := range types {
+		var msg []byte
+		msg = appendVarintField(msg, 1, uint64(tp.Kind))
+		if len(tp.subtypes) > 0 {
+			var packed []byte
+			for _, id := range tp.subtypes {
+				packed = protowire.AppendVarint(packed, uint64(id))
+			}
+			msg = protowire.AppendTag(msg, 2, protowire.BytesType)
+			msg = protowire.AppendBytes(msg, packed)
+		}
+		for _, name := range tp.FieldNames {
+			msg = protowire.AppendTag(msg, 3, protowire.BytesType)
+			msg = protowire.AppendString(msg, name)
+		}
+		if tp.Kind == KindDecimal {
+			msg = appendVarintField(msg, 5, uint64(tp.Precision))
+			msg = appendVarintField(msg, 6, uint64(tp.Scale))
+		}
+		ft = protowire.AppendTag(ft, 4, protowire.BytesType)
+		ft = protowire.AppendBytes(ft, msg)
+	}
+	ft = appendVarintField(ft, 6, uint64(len(rows)))
+	ft = w.compress(t, ft)
+	file = append(file, ft...)
+
+	var ps []byte
+	ps = appendVarintField(ps, 1, uint64(len(ft)))
+	ps = appendVarintField(ps, 2, uint64(w.compression))
+	ps = appendVarintField(ps, 3, uint64(w.blockSize))
+	ps = protowire.AppendTag(ps, 4, protowire.BytesType)
+	ps = protowire.AppendBytes(ps, []byte{0, 12})
+	ps = protowire.AppendTag(ps, 8000, protowire.BytesType)
+	ps = protowire.AppendString(ps, magic)
+	file = append(file, ps...)
+	return append(file, byte(len(ps)))
+}
+
+func appendVarintField(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+// compress splits the data into chunks of the block size and compresses them.
+func (w *testWriter) compress(t *testing.T, data []byte) []byte {
+	if w.compression == compressionNone {
+		return data
+	}
+	var out []byte
+	for len(data) > 0 {
+		chunk := data[:min(len(data), w.blockSize)]
+		data = data[len(chunk):]
+		var compressed []byte
+		switch w.compression {
+		case compressionZlib:
+			var buf bytes.Buffer
+			fw, err := flate.NewWriter(&buf, flate.BestCompression)
+			require.NoError(t, err)
+			_, err = fw.Write(chunk)
+			require.NoError(t, err)
+			require.NoError(t, fw.Close())
+			compressed = buf.Bytes()
+		case compressionSnappy:
+			compressed = snappy.Encode(nil, chunk)
+		case compressionLz4:
+			buf := make([]byte, lz4.CompressBlockBound(len(chunk)))
+			n, err := lz4.CompressBlock(chunk, buf, nil)
+			require.NoError(t, err)
+			compressed = buf[:n]
+		case compressionZstd:
+			enc, err := zstd.NewWriter(nil)
+			require.NoError(t, err)
+			compressed = enc.EncodeAll(chunk, nil)
+			require.NoError(t, enc.Close())
+		}
+		header := len(compressed) << 1
+		if len(compressed) == 0 || len(compressed) >= len(chunk) {
+			compressed, header = chunk, len(chunk)<<1|1
+		}
+		out = append(out, byte(header), byte(header>>8), byte(header>>16))
+		out = append(out, compressed...)
+	}
+	return out
+}
+
+func (s *testStripe) addStream(kind streamKind, tp *Type, data []byte) {
+	s.streams = append(s.streams, testStream{kind: kind, column: tp.ID, data: data})
+}
+
+func (s *testStripe) intEncoding() encodingKind {
+	if s.w.rleV1 {
+		return encodingDirect
+	}
+	return encodingDirectV2
+}
+
+func (s *testStripe) encodeInts(values []int64, signed bool) []byte {
+	if s.w.rleV1 {
+		return encodeIntsV1(values, signed)
+	}
+	return encodeIntsV2(values, signed)
+}
+
+// writeColumn writes the values of the rows whose parent isn't null.
+func (s *testStripe) writeColumn(tp *Type, values []interface{}) {
+	present := make([]bool, 0, len(values))
+	nonNulls := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		present = append(present, v != nil)
+		if v != nil {
+			nonNulls = append(nonNulls, v)
+		}
+	}
+	if len(nonNulls) < len(values) {
+		s.addStream(streamPresent, tp, encodeBools(present))
+	}
+	s.encodings[tp.ID] = columnEncoding{kind: s.intEncoding()}
+
+	switch tp.Kind {
+	case KindBoolean:
+		bools := make([]bool, 0, len(nonNulls))
+		for _, v := range nonNulls {
+			bools = append(bools, v.(bool))
+		}
+		s.addStream(streamData, tp, encodeBools(bools))
+	case KindByte:
+		bs := make([]byte, 0, len(nonNulls))
+		for _, v := range nonNulls {
+			bs = append(bs, byte(v.(int64)))
+		}
+		s.addStream(streamData, tp, encodeByteRLE(bs))
+	case KindShort, KindInt, KindLong, KindDate:
+		ints := make([]int64, 0, len(nonNulls))
+		for _, v := range nonNulls {
+			if d, ok := v.(Date); ok {
+				v = int64(d)
+			}
+			ints = append(ints, v.(int64))
+		}
+		s.addStream(streamData, tp, s.encodeInts(ints, true))
+	case KindFloat, KindDouble:
+		var data []byte
+		for _, v := range nonNulls {
+			if tp.Kind == KindFloat {
+				data = binary.LittleEndian.AppendUint32(data, math.Float32bits(v.(float32)))
+			} else {
+				data = binary.LittleEndian.AppendUint64(data, math.Float64bits(v.(float64)))
+			}
+		}
+		s.addStream(streamData, tp, data)
+	case KindString, KindVarchar, KindChar, KindBinary:
+		strs := make([]string, 0, len(nonNulls))
+		for _, v := range nonNulls {
+			if b, ok := v.([]byte); ok {
+				v = string(b)
+			}
+			strs = append(strs, v.(string))
+		}
+		s.writeStrings(tp, strs)
+	case KindDecimal:
+		var data []byte
+		scales := make([]int64, 0, len(nonNulls))
+		for _, v := range nonNulls {
+			d := v.(Decimal)
+			data = appendBigVarint(data, d.Value)
+			scales = append(scales, int64(d.Scale))
+		}
+		s.addStream(streamData, tp, data)
+		s.addStream(streamSecondary, tp, s.encodeInts(scales, true))
+	case KindTimestamp, KindTimestampInstant:
+		location := time.UTC
+		if tp.Kind == KindTimestamp && s.w.timezone != "" {
+			location, _ = time.LoadLocation(s.w.timezone)
+		}
+		base := time.Date(2015, 1, 1, 0, 0, 0, 0, location).Unix()
+		seconds := make([]int64, 0, len(nonNulls))
+		nanos := make([]int64, 0, len(nonNulls))
+		for _, v := range nonNulls {
+			ts := v.(time.Time)
+			sec, nano := ts.Unix(), int64(ts.Nanosecond())
+			// round toward zero like the java writer.
+			if sec < 0 && nano > 999999 {
+				sec++
+			}
+			seconds = append(seconds, sec-base)
+			nanos = append(nanos, encodeNanos(nano))
+		}
+		s.addStream(streamData, tp, s.encodeInts(seconds, true))
+		s.addStream(streamSecondary, tp, s.encodeInts(nanos, false))
+	case KindList:
+		lengths := make([]int64, 0, len(nonNulls))
+		var elems []interface{}
+		for _, v := range nonNulls {
+			list := v.([]interface{})
+			lengths = append(lengths, int64(len(list)))
+			elems = append(elems, list...)
+		}
+		s.addStream(streamLength, tp, s.encodeInts(lengths, false))
+		s.writeColumn(tp.Children[0], elems)
+	case KindMap:
+		lengths := make([]int64, 0, len(nonNulls))
+		var keys, vals []interface{}
+		for _, v := range nonNulls {
+			entries := v.([]MapEntry)
+			lengths = append(lengths, int64(len(entries)))
+			for _, e := range entries {
+				keys, vals = append(keys, e.Key), append(vals, e.Value)
+			}
+		}
+		s.addStream(streamLength, tp, s.encodeInts(lengths, false))
+		s.writeColumn(tp.Children[0], keys)
+		s.writeColumn(tp.Children[1], vals)
+	case KindStruct:
+		for i, child := range tp.Children {
+			fields := make([]interface{}, 0, len(nonNulls))
+			for _, v := range nonNulls {
+				fields = append(fields, v.(map[string]interface{})[tp.FieldNames[i]])
+			}
+			s.writeColumn(child, fields)
+		}
+	case KindUnion:
+		tags := make([]byte, 0, len(nonNulls))
+		members := make([][]interface{}, len(tp.Children))
+		for _, v := range nonNulls {
+			u := v.(unionValue)
+			tags = append(tags, byte(u.tag))
+			members[u.tag] = append(members[u.tag], u.value)
+		}
+		s.addStream(streamData, tp, encodeByteRLE(tags))
+		for i, child := range tp.Children {
+			s.writeColumn(child, members[i])
+		}
+	}
+}
+
+func (s *testStripe) writeStrings(tp *Type, strs []string) {
+	if !s.w.dictionary || tp.Kind == KindBinary {
+		var data []byte
+		lengths := make([]int64, 0, len(strs))
+		for _, str := range strs {
+			data = append(data, str...)
+			lengths = append(lengths, int64(len(str)))
+		}
+		s.addStream(streamData, tp, data)
+		s.addStream(streamLength, tp, s.encodeInts(lengths, false))
+		return
+	}
+
+	// the dictionary is sorted like the java writer.
+	ids := make(map[string]int64)
+	for _, str := range strs {
+		ids[str] = 0
+	}
+	dict := make([]string, 0, len(ids))
+	for str := range ids {
+		dict = append(dict, str)
+	}
+	sort.Strings(dict)
+	var dictData []byte
+	lengths := make([]int64, 0, len(dict))
+	for i, str := range dict {
+		ids[str] = int64(i)
+		dictData = append(dictData, str...)
+		lengths = append(lengths, int64(len(str)))
+	}
+	data := make([]int64, 0, len(strs))
+	for _, str := range strs {
+		data = append(data, ids[str])
+	}
+	s.addStream(streamData, tp, s.encodeInts(data, false))
+	s.addStream(streamDictionaryData, tp, dictData)
+	s.addStream(streamLength, tp, s.encodeInts(lengths, false))
+	kind := encodingDictionaryV2
+	if s.w.rleV1 {
+		kind = encodingDictionary
+	}
+	s.encodings[tp.ID] = columnEncoding{kind: kind, dictionarySize: uint64(len(dict))}
+}
+
+func encodeNanos(nanos int64) int64 {
+	if nanos == 0 || nanos%100 != 0 {
+		return nanos << 3
+	}
+	nanos /= 100
+	zeros := int64(1)
+	for nanos%10 == 0 && zeros < 7 {
+		nanos /= 10
+		zeros++
+	}
+	return nanos<<3 | zeros
+}
+
+func appendBigVarint(b []byte, v *big.Int) []byte {
+	// zigzag encode
+	u := new(big.Int).Lsh(v, 1)
+	if v.Sign() < 0 {
+		u.Neg(u).Sub(u, big.NewInt(1))
+	}
+	for {
+		low := byte(new(big.Int).And(u, big.NewInt(0x7f)).Uint64())
+		u.Rsh(u, 7)
+		if u.Sign() == 0 {
+			return append(b, low)
+		}
+		b = append(b, low|0x80)
+	}
+}
+
+func encodeBools(bools []bool) []byte {
+	bs := make([]byte, (len(bools)+7)/8)
+	for i, v := range bools {
+		if v {
+			bs[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return encodeByteRLE(bs)
+}
+
+// encodeByteRLE writes runs of at least 3 same bytes and literals otherwise.
+func encodeByteRLE(bs []byte) []byte {
+	var out []byte
+	for len(bs) > 0 {
+		run := 1
+		for run < len(bs) && run < 130 && bs[run] == bs[0] {
+			run++
+		}
+		if run >= 3 {
+			out = append(out, byte(run-3), bs[0])
+			bs = bs[run:]
+			continue
+		}
+		n := 1
+		for n < len(bs) && n < 128 && !(n+2 < len(bs) && bs[n] == bs[n+1] && bs[n] == bs[n+2]) {
+			n++
+		}
+		out = append(out, byte(0x100-n))
+		out = append(out, bs[:n]...)
+		bs = bs[n:]
+	}
+	return out
+}
+
+func zigzagEncode(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
+
+func appendValue(b []byte, v int64, signed bool) []byte {
+	if signed {
+		return binary.AppendUvarint(b, zigzagEncode(v))
+	}
+	return binary.AppendUvarint(b, uint64(v))
+}
+
+// encodeIntsV1 writes runs of at least 3 same values and literals otherwise.
+func encodeIntsV1(values []int64, signed bool) []byte {
+	var out []byte
+	for len(values) > 0 {
+		run := 1
+		for run < len(values) && run < 130 && values[run] == values[0] {
+			run++
+		}
+		if run >= 3 {
+			out = append(out, byte(run-3), 0)
+			out = appendValue(out, values[0], signed)
+			values = values[run:]
+			continue
+		}
+		n := min(len(values), 128)
+		out = append(out, byte(0x100-n))
+		for _, v := range values[:n] {
+			out = appendValue(out, v, signed)
+		}
+		values = values[n:]
+	}
+	return out
+}
+
+// encodeIntsV2 writes short repeat runs for 3 to 10 same values and direct
+// runs otherwise.
+func encodeIntsV2(values []int64, signed bool) []byte {
+	var out []byte
+	encode := func(v int64) uint64 {
+		if signed {
+			return zigzagEncode(v)
+		}
+		return uint64(v)
+	}
+	for len(values) > 0 {
+		run := 1
+		for run < len(values) && run < 10 && values[run] == values[0] {
+			run++
+		}
+		if run >= 3 {
+			u := encode(values[0])
+			width := max(1, (bits.Len64(u)+7)/8)
+			out = append(out, byte((width-1)<<3|(run-3)))
+			for i := width - 1; i >= 0; i-- {
+				out = append(out, byte(u>>(8*i)))
+			}
+			values = values[run:]
+			continue
+		}
+		n := min(len(values), 512)
+		maxBits := 0
+		for _, v := range values[:n] {
+			maxBits = max(maxBits, bits.Len64(encode(v)))
+		}
+		width := closestFixedBits(maxBits)
+		out = append(out, byte(rleV2Direct<<6|encodeBitWidth(width)<<1|(n-1)>>8), byte(n-1))
+		var acc uint64
+		accBits := 0
+		for _, v := range values[:n] {
+			u := encode(v)
+			for need := width; need > 0; {
+				take := min(need, 8-accBits)
+				acc = acc<<take | (u>>(need-take))&(1<<take-1)
+				accBits += take
+				need -= take
+				if accBits == 8 {
+					out = append(out, byte(acc))
+					acc, accBits = 0, 0
+				}
+			}
+		}
+		if accBits > 0 {
+			out = append(out, byte(acc<<(8-accBits)))
+		}
+		values = values[n:]
+	}
+	return out
+}
+
+func encodeBitWidth(width int) int {
+	for code := 0; code < 32; code++ {
+		if decodeBitWidth(code) == width {
+			return code
+		}
+	}
+	panic("invalid bit width")
+}
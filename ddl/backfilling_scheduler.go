@@ -174,8 +174,15 @@ func initSessCtx(
 	return nil
 }
 
-func (*txnBackfillScheduler) expectedWorkerSize() (size int) {
+func (b *txnBackfillScheduler) expectedWorkerSize() (size int) {
 	workerCnt := int(variable.GetDDLReorgWorkerCounter())
+	if b.tp == typeAddIndexWorker && b.reorgInfo.currElement != nil {
+		idxInfo := model.FindIndexInfoByID(b.tbl.Meta().Indices, b.reorgInfo.currElement.ID)
+		if idxInfo != nil && idxInfo.Tp == model.IndexTypeHNSW {
+			// The insertions into an HNSW graph conflict with each other, so a vector index is backfilled by one worker.
+			return 1
+		}
+	}
 	return mathutil.Min(workerCnt, maxBackfillWorkerSize)
 }
 
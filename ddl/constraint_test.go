@@ -1198,3 +1198,24 @@ func TestCheckConstraintSwitch(t *testing.T) {
 	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1105 the switch of check constraint is off"))
 	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n  `a` int(11) DEFAULT NULL,\nCONSTRAINT `t_chk_1` CHECK ((`a` > 0))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
 }
+
+func TestJSONSchemaValidCheckConstraint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@global.tidb_enable_check_constraint = 1")
+	tk.MustExec(`create table t(j json, check (json_schema_valid('{"type": "object", "properties": {"a": {"maximum": 10}}}', j)))`)
+	tk.MustExec(`insert into t values ('{"a": 1}')`)
+	tk.MustGetErrMsg(`insert into t values ('{"a": 11}')`, "[table:3819]Check constraint 't_chk_1' is violated.")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Error 3934 The JSON document location '#/a' failed requirement 'maximum' at JSON Schema location '#/properties/a'.",
+		"Error 3819 Check constraint 't_chk_1' is violated."))
+	tk.MustGetErrMsg(`update t set j = '[]'`, "[table:3819]Check constraint 't_chk_1' is violated.")
+	tk.MustQuery("select * from t").Check(testkit.Rows(`{"a": 1}`))
+
+	// a constraint which is not a bare JSON_SCHEMA_VALID call doesn't report the details
+	tk.MustExec(`create table t1(j json, check (json_schema_valid('{"required": ["a"]}', j) or j is null))`)
+	tk.MustGetErrMsg(`insert into t1 values ('{}')`, "[table:3819]Check constraint 't1_chk_1' is violated.")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Error 3819 Check constraint 't1_chk_1' is violated."))
+	tk.MustExec("set @@global.tidb_enable_check_constraint = 0")
+}
@@ -1871,21 +1871,26 @@ func TestAlterTableAddUniqueOnPartionRangeColumn(t *testing.T) {
 	tk.MustGetErrCode("alter table t add unique index idx_b(b)", errno.ErrUniqueKeyNeedAllFieldsInPf)
 }
 
-func TestFulltextIndexIgnore(t *testing.T) {
+func TestFulltextIndex(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
 	tk.MustExec("use test")
 	tk.MustExec("drop table if exists t_ft")
 	defer tk.MustExec("drop table if exists t_ft")
-	// Make sure that creating and altering to add a fulltext key gives the correct warning
-	assertWarningExec(tk, t, "create table t_ft (a text, fulltext key (a))", dbterror.ErrTableCantHandleFt)
-	assertWarningExec(tk, t, "alter table t_ft add fulltext key (a)", dbterror.ErrTableCantHandleFt)
-
-	// Make sure table t_ft still has no indexes even after it was created and altered
-	r := tk.MustQuery("show index from t_ft")
-	require.Len(t, r.Rows(), 0)
-	r = tk.MustQuery("select * from information_schema.statistics where table_schema='test' and table_name='t_ft'")
-	require.Len(t, r.Rows(), 0)
+	tk.MustExec("create table t_ft (a text, b varchar(10), fulltext key (a))")
+	tk.MustExec("alter table t_ft add fulltext key fb (a, b) with parser ngram")
+
+	tk.MustQuery("show index from t_ft").Check(testkit.Rows(
+		"t_ft 1 a 1 a A 0 <nil> <nil> YES FULLTEXT   YES <nil> NO",
+		"t_ft 1 fb 1 a A 0 <nil> <nil> YES FULLTEXT   YES <nil> NO",
+		"t_ft 1 fb 2 b A 0 <nil> <nil> YES FULLTEXT   YES <nil> NO"))
+	tk.MustQuery("select index_name, index_type from information_schema.statistics where table_schema='test' and table_name='t_ft'").
+		Check(testkit.Rows("a FULLTEXT", "fb FULLTEXT", "fb FULLTEXT"))
+
+	tk.MustGetErrCode("alter table t_ft add fulltext key (a(10))", errno.ErrWrongSubKey)
+	tk.MustGetErrCode("alter table t_ft modify column b int", errno.ErrBadFtColumn)
+	tk.MustExec("alter table t_ft drop index fb")
+	tk.MustExec("alter table t_ft modify column b int")
 }
 
 func TestTreatOldVersionUTF8AsUTF8MB4(t *testing.T) {
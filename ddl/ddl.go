@@ -188,6 +188,15 @@ type DDL interface {
 	CreateSequence(ctx sessionctx.Context, stmt *ast.CreateSequenceStmt) error
 	DropSequence(ctx sessionctx.Context, stmt *ast.DropSequenceStmt) (err error)
 	AlterSequence(ctx sessionctx.Context, stmt *ast.AlterSequenceStmt) error
+	CreateTrigger(ctx sessionctx.Context, stmt *ast.CreateTriggerStmt, info *model.TriggerInfo) error
+	DropTrigger(ctx sessionctx.Context, stmt *ast.DropTriggerStmt) error
+	CreateMaterializedView(ctx sessionctx.Context, stmt *ast.CreateMaterializedViewStmt, info *model.MaterializedViewInfo) error
+	DropMaterializedView(ctx sessionctx.Context, stmt *ast.DropMaterializedViewStmt) error
+	CreateProcedure(ctx sessionctx.Context, stmt *ast.ProcedureInfo, info *model.ProcedureInfo) error
+	DropProcedure(ctx sessionctx.Context, stmt *ast.DropProcedureStmt) error
+	CreateEvent(ctx sessionctx.Context, stmt *ast.CreateEventStmt, data []byte) error
+	AlterEvent(ctx sessionctx.Context, stmt *ast.AlterEventStmt, data []byte) error
+	DropEvent(ctx sessionctx.Context, stmt *ast.DropEventStmt) error
 	CreatePlacementPolicy(ctx sessionctx.Context, stmt *ast.CreatePlacementPolicyStmt) error
 	DropPlacementPolicy(ctx sessionctx.Context, stmt *ast.DropPlacementPolicyStmt) error
 	AlterPlacementPolicy(ctx sessionctx.Context, stmt *ast.AlterPlacementPolicyStmt) error
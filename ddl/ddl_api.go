@@ -994,7 +994,7 @@ func buildColumnAndConstraint(
 // In NO_ZERO_DATE SQL mode, TIMESTAMP/DATE/DATETIME type can't have zero date like '0000-00-00' or '0000-00-00 00:00:00'.
 func checkColumnDefaultValue(ctx sessionctx.Context, col *table.Column, value interface{}) (bool, interface{}, error) {
 	hasDefaultValue := true
-	if value != nil && (col.GetType() == mysql.TypeJSON ||
+	if value != nil && (col.GetType() == mysql.TypeJSON || col.GetType() == mysql.TypeGeometry || col.GetType() == mysql.TypeVector ||
 		col.GetType() == mysql.TypeTinyBlob || col.GetType() == mysql.TypeMediumBlob ||
 		col.GetType() == mysql.TypeLongBlob || col.GetType() == mysql.TypeBlob) {
 		// In non-strict SQL mode.
@@ -1965,10 +1965,15 @@ func BuildTableInfo(
 	}
 	foreignKeyID := tbInfo.MaxForeignKeyID
 	for _, constr := range constraints {
-		// Build hidden columns if necessary.
-		hiddenCols, err := buildHiddenColumnInfoWithCheck(ctx, constr.Keys, model.NewCIStr(constr.Name), tbInfo, tblColumns)
-		if err != nil {
-			return nil, err
+		// Build hidden columns if necessary. The expression of a vector index is its distance
+		// function, which is not stored in any column.
+		var hiddenCols []*model.ColumnInfo
+		if constr.Tp != ast.ConstraintVector {
+			var err error
+			hiddenCols, err = buildHiddenColumnInfoWithCheck(ctx, constr.Keys, model.NewCIStr(constr.Name), tbInfo, tblColumns)
+			if err != nil {
+				return nil, err
+			}
 		}
 		for _, hiddenCol := range hiddenCols {
 			hiddenCol.State = model.StatePublic
@@ -2027,13 +2032,9 @@ func BuildTableInfo(
 			}
 		}
 
-		if constr.Tp == ast.ConstraintFulltext {
-			ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt.GenWithStackByArgs())
-			continue
-		}
-
 		var (
 			indexName       = constr.Name
+			indexOption     = constr.Option
 			primary, unique bool
 		)
 
@@ -2045,6 +2046,13 @@ func BuildTableInfo(
 			indexName = mysql.PrimaryKeyName
 		case ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintUniqIndex:
 			unique = true
+		case ast.ConstraintFulltext:
+			indexOption = FullTextIndexOption(indexOption)
+		case ast.ConstraintVector:
+			var err error
+			if indexOption, err = VectorIndexOption(indexOption); err != nil {
+				return nil, errors.Trace(err)
+			}
 		}
 
 		// check constraint
@@ -2119,7 +2127,7 @@ func BuildTableInfo(
 			unique,
 			false,
 			constr.Keys,
-			constr.Option,
+			indexOption,
 			model.StatePublic,
 		)
 		if err != nil {
@@ -2248,6 +2256,18 @@ func checkTableInfoValidExtra(tbInfo *model.TableInfo) error {
 	if err := checkTooManyIndexes(tbInfo.Indices); err != nil {
 		return errors.Trace(err)
 	}
+	for _, idx := range tbInfo.Indices {
+		switch idx.Tp {
+		case model.IndexTypeFullText:
+			if err := checkTableSupportFullText(tbInfo); err != nil {
+				return errors.Trace(err)
+			}
+		case model.IndexTypeHNSW:
+			if err := checkTableSupportVectorIndex(tbInfo); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
 	if err := checkColumnsAttributes(tbInfo.Columns); err != nil {
 		return errors.Trace(err)
 	}
@@ -3064,7 +3084,7 @@ func checkPartitionByList(ctx sessionctx.Context, tbInfo *model.TableInfo) error
 
 func isValidKeyPartitionColType(fieldType types.FieldType) bool {
 	switch fieldType.GetType() {
-	case mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeJSON, mysql.TypeGeometry:
+	case mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeJSON, mysql.TypeGeometry, mysql.TypeVector:
 		return false
 	default:
 		return true
@@ -3623,6 +3643,14 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 	if tb.Meta().IsView() || tb.Meta().IsSequence() {
 		return dbterror.ErrWrongObject.GenWithStackByArgs(ident.Schema, ident.Name, "BASE TABLE")
 	}
+	if tb.Meta().IsMaterializedView() || tb.Meta().MaterializedViewLog != nil {
+		return checkMaterializedViewDependency(tb.Meta(), "ALTER TABLE")
+	}
+	for _, spec := range validSpecs {
+		if err = checkAlterMaterializedViewBaseTable(tb.Meta(), spec); err != nil {
+			return err
+		}
+	}
 	if tb.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 		if len(validSpecs) != 1 {
 			return dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Alter Table")
@@ -3720,7 +3748,11 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			case ast.ConstraintPrimaryKey:
 				err = d.CreatePrimaryKey(sctx, ident, model.NewCIStr(constr.Name), spec.Constraint.Keys, constr.Option)
 			case ast.ConstraintFulltext:
-				sctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt)
+				err = d.createIndex(sctx, ident, ast.IndexKeyTypeFullText, model.NewCIStr(constr.Name),
+					spec.Constraint.Keys, constr.Option, constr.IfNotExists)
+			case ast.ConstraintVector:
+				err = d.createIndex(sctx, ident, ast.IndexKeyTypeVector, model.NewCIStr(constr.Name),
+					spec.Constraint.Keys, constr.Option, constr.IfNotExists)
 			case ast.ConstraintCheck:
 				if !variable.EnableCheckConstraint.Load() {
 					sctx.GetSessionVars().StmtCtx.AppendWarning(errors.New("the switch of check constraint is off"))
@@ -5712,6 +5744,18 @@ func checkColumnWithIndexConstraint(tbInfo *model.TableInfo, originalCol, newCol
 		if !modified {
 			return
 		}
+		switch indexInfo.Tp {
+		case model.IndexTypeFullText:
+			return checkFullTextIndexColumn(newCol)
+		case model.IndexTypeHNSW:
+			if err = checkVectorIndexColumn(newCol); err != nil {
+				return err
+			}
+			if newCol.GetFlen() != originalCol.GetFlen() {
+				return dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs("changing the dimension of a vector column with vector index")
+			}
+			return nil
+		}
 		err = checkIndexInModifiableColumns(columns, indexInfo.Columns)
 		if err != nil {
 			return
@@ -6651,6 +6695,9 @@ func (d *ddl) dropTableObject(
 			if tableInfo.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 				return dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Drop Table")
 			}
+			if err = checkMaterializedViewDependency(tableInfo.Meta(), "DROP TABLE"); err != nil {
+				return err
+			}
 		case viewObject:
 			if !tableInfo.Meta().IsView() {
 				return dbterror.ErrWrongObject.GenWithStackByArgs(fullti.Schema, fullti.Name, "VIEW")
@@ -6730,6 +6777,9 @@ func (d *ddl) TruncateTable(ctx sessionctx.Context, ti ast.Ident) error {
 	if tb.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 		return dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Truncate Table")
 	}
+	if err = checkMaterializedViewDependency(tb.Meta(), "TRUNCATE TABLE"); err != nil {
+		return err
+	}
 	fkCheck := ctx.GetSessionVars().ForeignKeyChecks
 	referredFK := checkTableHasForeignKeyReferred(d.GetInfoSchemaWithInterceptor(ctx), ti.Schema.L, ti.Name.L, []ast.Ident{{Name: ti.Name, Schema: ti.Schema}}, fkCheck)
 	if referredFK != nil {
@@ -6817,6 +6867,9 @@ func (d *ddl) renameTable(ctx sessionctx.Context, oldIdent, newIdent ast.Ident,
 		if tbl.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 			return errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Rename Table"))
 		}
+		if err = checkMaterializedViewDependency(tbl.Meta(), "RENAME TABLE"); err != nil {
+			return err
+		}
 	}
 
 	job := &model.Job{
@@ -6859,6 +6912,9 @@ func (d *ddl) renameTables(ctx sessionctx.Context, oldIdents, newIdents []ast.Id
 			if t.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 				return errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Rename Tables"))
 			}
+			if err = checkMaterializedViewDependency(t.Meta(), "RENAME TABLE"); err != nil {
+				return err
+			}
 		}
 
 		tableIDs = append(tableIDs, tableID)
@@ -7207,15 +7263,29 @@ func (*ddl) addHypoIndexIntoCtx(ctx sessionctx.Context, schemaName, tableName mo
 
 func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.IndexKeyType, indexName model.CIStr,
 	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption, ifNotExists bool) error {
-	// not support Spatial and FullText index
-	if keyType == ast.IndexKeyTypeFullText || keyType == ast.IndexKeyTypeSpatial {
-		return dbterror.ErrUnsupportedIndexType.GenWithStack("FULLTEXT and SPATIAL index is not supported")
+	// not support Spatial index
+	if keyType == ast.IndexKeyTypeSpatial {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack("SPATIAL index is not supported")
 	}
 	unique := keyType == ast.IndexKeyTypeUnique
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if keyType == ast.IndexKeyTypeFullText {
+		if err = checkTableSupportFullText(t.Meta()); err != nil {
+			return errors.Trace(err)
+		}
+		indexOption = FullTextIndexOption(indexOption)
+	}
+	if keyType == ast.IndexKeyTypeVector {
+		if err = checkTableSupportVectorIndex(t.Meta()); err != nil {
+			return errors.Trace(err)
+		}
+		if indexOption, err = VectorIndexOption(indexOption); err != nil {
+			return errors.Trace(err)
+		}
+	}
 
 	if t.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 		return errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Create Index"))
@@ -7252,9 +7322,12 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 	tblInfo := t.Meta()
 
 	// Build hidden columns if necessary.
-	hiddenCols, err := buildHiddenColumnInfoWithCheck(ctx, indexPartSpecifications, indexName, t.Meta(), t.Cols())
-	if err != nil {
-		return err
+	var hiddenCols []*model.ColumnInfo
+	if keyType != ast.IndexKeyTypeVector {
+		hiddenCols, err = buildHiddenColumnInfoWithCheck(ctx, indexPartSpecifications, indexName, t.Meta(), t.Cols())
+		if err != nil {
+			return err
+		}
 	}
 	if err = checkAddColumnTooManyColumns(len(t.Cols()) + len(hiddenCols)); err != nil {
 		return errors.Trace(err)
@@ -7269,7 +7342,15 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 	// After DDL job is put to the queue, and if the check fail, TiDB will run the DDL cancel logic.
 	// The recover step causes DDL wait a few seconds, makes the unit test painfully slow.
 	// For same reason, decide whether index is global here.
-	indexColumns, _, err := buildIndexColumns(ctx, finalColumns, indexPartSpecifications)
+	var indexColumns []*model.IndexColumn
+	switch keyType {
+	case ast.IndexKeyTypeFullText:
+		indexColumns, err = buildFullTextIndexColumns(finalColumns, indexPartSpecifications, indexOption)
+	case ast.IndexKeyTypeVector:
+		indexColumns, _, err = buildVectorIndexColumns(finalColumns, indexPartSpecifications)
+	default:
+		indexColumns, _, err = buildIndexColumns(ctx, finalColumns, indexPartSpecifications)
+	}
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -7304,6 +7385,12 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 		return d.addHypoIndexIntoCtx(ctx, ti.Schema, ti.Name, indexInfo)
 	}
 
+	args := []interface{}{unique, indexName, indexPartSpecifications, indexOption, hiddenCols, global}
+	if keyType == ast.IndexKeyTypeVector {
+		part, distanceFunc := encodeVectorIndexPart(indexPartSpecifications[0])
+		args = []interface{}{unique, indexName, []*ast.IndexPartSpecification{part}, indexOption, hiddenCols, global, distanceFunc}
+	}
+
 	chs, coll := ctx.GetSessionVars().GetCharsetInfo()
 	job := &model.Job{
 		SchemaID:   schema.ID,
@@ -7313,7 +7400,7 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 		Type:       model.ActionAddIndex,
 		BinlogInfo: &model.HistoryInfo{},
 		ReorgMeta:  NewDDLReorgMeta(ctx),
-		Args:       []interface{}{unique, indexName, indexPartSpecifications, indexOption, hiddenCols, global},
+		Args:       args,
 		Priority:   ctx.GetSessionVars().DDLReorgPriority,
 		Charset:    chs,
 		Collate:    coll,
@@ -560,7 +560,7 @@ func jobNeedGC(job *model.Job) bool {
 			model.ActionDropColumn, model.ActionModifyColumn,
 			model.ActionAddIndex, model.ActionAddPrimaryKey,
 			model.ActionReorganizePartition, model.ActionRemovePartitioning,
-			model.ActionAlterTablePartitioning:
+			model.ActionAlterTablePartitioning, model.ActionDropMaterializedView:
 			return true
 		case model.ActionMultiSchemaChange:
 			for _, sub := range job.MultiSchemaInfo.SubJobs {
@@ -1152,6 +1152,24 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 		ver, err = onDropCheckConstraint(d, t, job)
 	case model.ActionAlterCheckConstraint:
 		ver, err = w.onAlterCheckConstraint(d, t, job)
+	case model.ActionCreateTrigger:
+		ver, err = onCreateTrigger(d, t, job)
+	case model.ActionDropTrigger:
+		ver, err = onDropTrigger(d, t, job)
+	case model.ActionCreateMaterializedView:
+		ver, err = onCreateMaterializedView(d, t, job)
+	case model.ActionDropMaterializedView:
+		ver, err = onDropMaterializedView(d, t, job)
+	case model.ActionCreateProcedure, model.ActionCreateFunction:
+		ver, err = onCreateRoutine(d, t, job)
+	case model.ActionDropProcedure, model.ActionDropFunction:
+		ver, err = onDropRoutine(d, t, job)
+	case model.ActionCreateEvent:
+		ver, err = onCreateEvent(d, t, job)
+	case model.ActionAlterEvent:
+		ver, err = onAlterEvent(d, t, job)
+	case model.ActionDropEvent:
+		ver, err = onDropEvent(d, t, job)
 	default:
 		// Invalid job, cancel it.
 		job.State = model.JobStateCancelled
@@ -1528,6 +1546,18 @@ func updateSchemaVersion(d *ddlCtx, t *meta.Meta, job *model.Job, multiInfos ...
 		if job.SchemaState == model.StatePublic {
 			diff.RegenerateSchemaMap = true
 		}
+	case model.ActionDropMaterializedView:
+		// The first dropped table is the materialized view, the others are the log tables whose TableID is 0 in the
+		// affected options.
+		droppedIDs := job.Args[0].([]int64)
+		diff.TableID = droppedIDs[0]
+		for _, id := range droppedIDs[1:] {
+			diff.AffectedOpts = append(diff.AffectedOpts, &model.AffectedOption{
+				SchemaID:    job.SchemaID,
+				OldSchemaID: job.SchemaID,
+				OldTableID:  id,
+			})
+		}
 	default:
 		diff.TableID = job.TableID
 	}
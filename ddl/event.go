@@ -0,0 +1,260 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+// CreateEvent creates the event of the `CREATE EVENT` statement, data is the encoded definition of the event.
+func (d *ddl) CreateEvent(ctx sessionctx.Context, stmt *ast.CreateEventStmt, data []byte) error {
+	schema, err := d.getEventSchema(ctx, stmt.EventName.Schema)
+	if err != nil {
+		return err
+	}
+
+	existing, err := d.eventExists(schema.ID, stmt.EventName.Name.L)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if existing {
+		err = exeerrors.ErrEventAlreadyExists.GenWithStackByArgs(stmt.EventName.Name.O)
+		if stmt.IfNotExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		SchemaName: schema.Name.L,
+		Type:       model.ActionCreateEvent,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{stmt.EventName.Name, data},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// AlterEvent replaces the event of the `ALTER EVENT` statement with data, the encoded definition of the altered
+// event. The event is renamed in the same job if `RENAME TO` is specified.
+func (d *ddl) AlterEvent(ctx sessionctx.Context, stmt *ast.AlterEventStmt, data []byte) error {
+	schema, err := d.getEventSchema(ctx, stmt.EventName.Schema)
+	if err != nil {
+		return err
+	}
+
+	newSchema, newName := schema, stmt.EventName.Name
+	if stmt.RenameTo != nil {
+		if newSchema, err = d.getEventSchema(ctx, stmt.RenameTo.Schema); err != nil {
+			return err
+		}
+		newName = stmt.RenameTo.Name
+	}
+
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		SchemaName: schema.Name.L,
+		Type:       model.ActionAlterEvent,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{stmt.EventName.Name, newSchema.ID, newName, data},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// DropEvent drops the event of the `DROP EVENT` statement.
+func (d *ddl) DropEvent(ctx sessionctx.Context, stmt *ast.DropEventStmt) error {
+	schema, err := d.getEventSchema(ctx, stmt.EventName.Schema)
+	if err != nil {
+		return err
+	}
+
+	existing, err := d.eventExists(schema.ID, stmt.EventName.Name.L)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !existing {
+		err = exeerrors.ErrEventDoesNotExist.GenWithStackByArgs(stmt.EventName.Name.O)
+		if stmt.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		SchemaName: schema.Name.L,
+		Type:       model.ActionDropEvent,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{stmt.EventName.Name},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+func (d *ddl) getEventSchema(ctx sessionctx.Context, name model.CIStr) (*model.DBInfo, error) {
+	schema, ok := d.GetInfoSchemaWithInterceptor(ctx).SchemaByName(name)
+	if !ok {
+		return nil, infoschema.ErrDatabaseNotExists.GenWithStackByArgs(name.O)
+	}
+	return schema, nil
+}
+
+func (d *ddl) eventExists(schemaID int64, name string) (existing bool, err error) {
+	err = kv.RunInNewTxn(kv.WithInternalSourceType(d.ctx, kv.InternalTxnDDL), d.store, false,
+		func(_ context.Context, txn kv.Transaction) error {
+			data, err := meta.NewMeta(txn).GetEvent(schemaID, name)
+			existing = data != nil
+			return err
+		})
+	return existing, err
+}
+
+func onCreateEvent(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var (
+		name model.CIStr
+		data []byte
+	)
+	if err := job.DecodeArgs(&name, &data); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	existing, err := t.GetEvent(dbInfo.ID, name.L)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if existing != nil {
+		job.State = model.JobStateCancelled
+		return ver, exeerrors.ErrEventAlreadyExists.GenWithStackByArgs(name.O)
+	}
+
+	if err = t.SetEvent(dbInfo.ID, name.L, data); err != nil {
+		return ver, errors.Trace(err)
+	}
+	if ver, err = updateSchemaVersion(d, t, job); err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishDBJob(model.JobStateDone, model.StatePublic, ver, dbInfo)
+	return ver, nil
+}
+
+func onAlterEvent(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var (
+		name        model.CIStr
+		newSchemaID int64
+		newName     model.CIStr
+		data        []byte
+	)
+	if err := job.DecodeArgs(&name, &newSchemaID, &newName, &data); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	existing, err := t.GetEvent(dbInfo.ID, name.L)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if existing == nil {
+		job.State = model.JobStateCancelled
+		return ver, exeerrors.ErrEventDoesNotExist.GenWithStackByArgs(name.O)
+	}
+
+	renamed := newSchemaID != dbInfo.ID || newName.L != name.L
+	if renamed {
+		newDBInfo, err := t.GetDatabase(newSchemaID)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		if newDBInfo == nil {
+			job.State = model.JobStateCancelled
+			return ver, infoschema.ErrDatabaseNotExists.GenWithStackByArgs("")
+		}
+
+		if existing, err = t.GetEvent(newSchemaID, newName.L); err != nil {
+			return ver, errors.Trace(err)
+		}
+		if existing != nil {
+			job.State = model.JobStateCancelled
+			return ver, exeerrors.ErrEventAlreadyExists.GenWithStackByArgs(newName.O)
+		}
+
+		if err = t.DropEvent(dbInfo.ID, name.L); err != nil {
+			return ver, errors.Trace(err)
+		}
+	}
+
+	if err = t.SetEvent(newSchemaID, newName.L, data); err != nil {
+		return ver, errors.Trace(err)
+	}
+	if ver, err = updateSchemaVersion(d, t, job); err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishDBJob(model.JobStateDone, model.StatePublic, ver, dbInfo)
+	return ver, nil
+}
+
+func onDropEvent(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var name model.CIStr
+	if err := job.DecodeArgs(&name); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	existing, err := t.GetEvent(dbInfo.ID, name.L)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if existing == nil {
+		job.State = model.JobStateCancelled
+		return ver, exeerrors.ErrEventDoesNotExist.GenWithStackByArgs(name.O)
+	}
+
+	if err = t.DropEvent(dbInfo.ID, name.L); err != nil {
+		return ver, errors.Trace(err)
+	}
+	if ver, err = updateSchemaVersion(d, t, job); err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishDBJob(model.JobStateDone, model.StatePublic, ver, dbInfo)
+	return ver, nil
+}
@@ -289,9 +289,9 @@ type illegalFunctionChecker struct {
 func (c *illegalFunctionChecker) Enter(inNode ast.Node) (outNode ast.Node, skipChildren bool) {
 	switch node := inNode.(type) {
 	case *ast.FuncCallExpr:
-		// Blocked functions & non-builtin functions is not allowed
+		// Blocked functions & non-builtin functions (including the stored functions) is not allowed
 		_, isFunctionBlocked := expression.IllegalFunctions4GeneratedColumns[node.FnName.L]
-		if isFunctionBlocked || !expression.IsFunctionSupported(node.FnName.L) {
+		if isFunctionBlocked || node.Schema.L != "" || !expression.IsFunctionSupported(node.FnName.L) {
 			c.hasIllegalFunc = true
 			return inNode, true
 		}
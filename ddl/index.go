@@ -57,8 +57,10 @@ import (
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/fulltext"
 	"github.com/pingcap/tidb/util/logutil"
 	decoder "github.com/pingcap/tidb/util/rowDecoder"
+	"github.com/pingcap/tidb/util/vectorindex"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/tikv/client-go/v2/oracle"
 	"github.com/tikv/client-go/v2/tikv"
@@ -172,6 +174,11 @@ func checkIndexColumn(ctx sessionctx.Context, col *model.ColumnInfo, indexColumn
 		return errors.Trace(dbterror.ErrWrongKeyColumn.GenWithStackByArgs(col.Name))
 	}
 
+	// Vector column can only be indexed by a vector index.
+	if col.FieldType.GetType() == mysql.TypeVector {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack("vector column %s can only be indexed by a vector index", col.Name.O)
+	}
+
 	// JSON column cannot index.
 	if col.FieldType.GetType() == mysql.TypeJSON && !col.FieldType.IsArray() {
 		if col.Hidden {
@@ -180,8 +187,8 @@ func checkIndexColumn(ctx sessionctx.Context, col *model.ColumnInfo, indexColumn
 		return errors.Trace(dbterror.ErrJSONUsedAsKey.GenWithStackByArgs(col.Name.O))
 	}
 
-	// Length must be specified and non-zero for BLOB and TEXT column indexes.
-	if types.IsTypeBlob(col.FieldType.GetType()) {
+	// Length must be specified and non-zero for BLOB, TEXT and GEOMETRY column indexes.
+	if types.IsTypeBlob(col.FieldType.GetType()) || col.FieldType.GetType() == mysql.TypeGeometry {
 		if indexColumnLen == types.UnspecifiedLength {
 			if col.Hidden {
 				return dbterror.ErrFunctionalIndexOnBlob
@@ -282,7 +289,20 @@ func BuildIndexInfo(
 		return nil, errors.Trace(err)
 	}
 
-	idxColumns, mvIndex, err := buildIndexColumns(ctx, allTableColumns, indexPartSpecifications)
+	var (
+		idxColumns []*model.IndexColumn
+		mvIndex    bool
+		err        error
+	)
+	var vectorMetric string
+	switch {
+	case indexOption != nil && indexOption.Tp == model.IndexTypeFullText:
+		idxColumns, err = buildFullTextIndexColumns(allTableColumns, indexPartSpecifications, indexOption)
+	case indexOption != nil && indexOption.Tp == model.IndexTypeHNSW:
+		idxColumns, vectorMetric, err = buildVectorIndexColumns(allTableColumns, indexPartSpecifications)
+	default:
+		idxColumns, mvIndex, err = buildIndexColumns(ctx, allTableColumns, indexPartSpecifications)
+	}
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -314,9 +334,181 @@ func BuildIndexInfo(
 		idxInfo.Tp = model.IndexTypeBtree
 	}
 
+	switch idxInfo.Tp {
+	case model.IndexTypeFullText:
+		idxInfo.FullTextParser = indexOption.ParserName.L
+	case model.IndexTypeHNSW:
+		idxInfo.VectorDistanceMetric = vectorMetric
+	}
+
 	return idxInfo, nil
 }
 
+// FullTextIndexOption returns a copy of the index option whose index type is FULLTEXT, which tells
+// BuildIndexInfo to build a full-text index.
+func FullTextIndexOption(indexOption *ast.IndexOption) *ast.IndexOption {
+	opt := &ast.IndexOption{}
+	if indexOption != nil {
+		*opt = *indexOption
+	}
+	opt.Tp = model.IndexTypeFullText
+	return opt
+}
+
+// buildFullTextIndexColumns builds the columns of a full-text index. Only the whole values of
+// CHAR, VARCHAR or TEXT columns can be part of a full-text index, so there is no prefix length or
+// key length limit.
+func buildFullTextIndexColumns(columns []*model.ColumnInfo, indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption) ([]*model.IndexColumn, error) {
+	if _, ok := fulltext.NewTokenizer(indexOption.ParserName.L); !ok {
+		return nil, dbterror.ErrPluginIsNotLoaded.GenWithStackByArgs(indexOption.ParserName.O)
+	}
+	idxParts := make([]*model.IndexColumn, 0, len(indexPartSpecifications))
+	for _, ip := range indexPartSpecifications {
+		if ip.Expr != nil {
+			return nil, dbterror.ErrFulltextFunctionalIndex
+		}
+		col := model.FindColumnInfo(columns, ip.Column.Name.L)
+		if col == nil {
+			return nil, dbterror.ErrKeyColumnDoesNotExits.GenWithStack("column does not exist: %s", ip.Column.Name)
+		}
+		if err := checkFullTextIndexColumn(col); err != nil {
+			return nil, err
+		}
+		if ip.Length != types.UnspecifiedLength {
+			return nil, dbterror.ErrIncorrectPrefixKey
+		}
+		idxParts = append(idxParts, &model.IndexColumn{
+			Name:   col.Name,
+			Offset: col.Offset,
+			Length: types.UnspecifiedLength,
+		})
+	}
+	return idxParts, nil
+}
+
+// checkFullTextIndexColumn checks whether the column can be part of a full-text index.
+func checkFullTextIndexColumn(col *model.ColumnInfo) error {
+	switch col.GetType() {
+	case mysql.TypeString, mysql.TypeVarchar, mysql.TypeVarString,
+		mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
+		if col.GetCharset() != charset.CharsetBin {
+			return nil
+		}
+	}
+	return dbterror.ErrBadFtColumn.GenWithStackByArgs(col.Name.O)
+}
+
+// checkTableSupportFullText checks whether full-text indexes can be created on the table.
+func checkTableSupportFullText(tblInfo *model.TableInfo) error {
+	if tblInfo.Partition != nil {
+		return dbterror.ErrFulltextNotSupportedWithPartitioning
+	}
+	if tblInfo.TempTableType != model.TempTableNone {
+		return dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("fulltext index")
+	}
+	return nil
+}
+
+// rebuildFullTextStats rebuilds the statistics of a backfilled full-text index, the DML keeps them
+// up to date once the index is public.
+func rebuildFullTextStats(d *ddlCtx, tbl table.Table, indexInfo *model.IndexInfo) error {
+	ctx := kv.WithInternalSourceType(d.ctx, kv.InternalTxnDDL)
+	return kv.RunInNewTxn(ctx, d.store, true, func(ctx context.Context, txn kv.Transaction) error {
+		return fulltext.RebuildStats(ctx, txn, tbl.Meta().ID, indexInfo.ID)
+	})
+}
+
+// VectorIndexOption returns a copy of the index option whose index type is HNSW, which tells
+// BuildIndexInfo to build a vector index. HNSW is the only index type of the vector indexes.
+func VectorIndexOption(indexOption *ast.IndexOption) (*ast.IndexOption, error) {
+	opt := &ast.IndexOption{}
+	if indexOption != nil {
+		*opt = *indexOption
+	}
+	if opt.Tp != model.IndexTypeInvalid && opt.Tp != model.IndexTypeHNSW {
+		return nil, dbterror.ErrUnsupportedIndexType.GenWithStack("%s is not supported by vector index", opt.Tp)
+	}
+	opt.Tp = model.IndexTypeHNSW
+	return opt, nil
+}
+
+// buildVectorIndexColumns builds the column of a vector index, which is defined by the distance
+// function of a vector column, such as `VECTOR INDEX ((VEC_COSINE_DISTANCE(v)))`. It also returns
+// the distance metric that the index is built for.
+func buildVectorIndexColumns(columns []*model.ColumnInfo, indexPartSpecifications []*ast.IndexPartSpecification) ([]*model.IndexColumn, string, error) {
+	errDefinition := dbterror.ErrUnsupportedIndexType.GenWithStack(
+		"vector index must be defined by the distance function of a vector column, such as VEC_COSINE_DISTANCE(v)")
+	if len(indexPartSpecifications) != 1 {
+		return nil, "", errDefinition
+	}
+	fn, ok := indexPartSpecifications[0].Expr.(*ast.FuncCallExpr)
+	if !ok || len(fn.Args) != 1 {
+		return nil, "", errDefinition
+	}
+	metric, ok := vectorindex.MetricOfDistanceFunc(fn.FnName.L)
+	if !ok {
+		return nil, "", errDefinition
+	}
+	colExpr, ok := fn.Args[0].(*ast.ColumnNameExpr)
+	if !ok {
+		return nil, "", errDefinition
+	}
+	col := model.FindColumnInfo(columns, colExpr.Name.Name.L)
+	if col == nil {
+		return nil, "", dbterror.ErrKeyColumnDoesNotExits.GenWithStack("column does not exist: %s", colExpr.Name.Name)
+	}
+	if err := checkVectorIndexColumn(col); err != nil {
+		return nil, "", err
+	}
+	idxParts := []*model.IndexColumn{{
+		Name:   col.Name,
+		Offset: col.Offset,
+		Length: types.UnspecifiedLength,
+	}}
+	return idxParts, metric, nil
+}
+
+// encodeVectorIndexPart returns the index part of a vector index to be encoded in the job arguments
+// along with the name of its distance function, since the expression can't be decoded from JSON.
+// The part must have been checked by buildVectorIndexColumns.
+func encodeVectorIndexPart(part *ast.IndexPartSpecification) (*ast.IndexPartSpecification, string) {
+	fn := part.Expr.(*ast.FuncCallExpr)
+	col := fn.Args[0].(*ast.ColumnNameExpr)
+	return &ast.IndexPartSpecification{Column: col.Name, Length: types.UnspecifiedLength}, fn.FnName.L
+}
+
+// decodeVectorIndexPart rebuilds the index part of a vector index encoded by encodeVectorIndexPart.
+func decodeVectorIndexPart(part *ast.IndexPartSpecification, distanceFunc string) *ast.IndexPartSpecification {
+	return &ast.IndexPartSpecification{
+		Expr: &ast.FuncCallExpr{
+			FnName: model.NewCIStr(distanceFunc),
+			Args:   []ast.ExprNode{&ast.ColumnNameExpr{Name: part.Column}},
+		},
+		Length: types.UnspecifiedLength,
+	}
+}
+
+// checkVectorIndexColumn checks whether the column can be indexed by a vector index. The vectors
+// in a vector index must have the same dimension, so the dimension of the column must be specified.
+func checkVectorIndexColumn(col *model.ColumnInfo) error {
+	if col.GetType() != mysql.TypeVector || col.GetFlen() == types.UnspecifiedLength {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack(
+			"vector index can only be built on a vector column with a fixed dimension, but %s is not", col.Name.O)
+	}
+	return nil
+}
+
+// checkTableSupportVectorIndex checks whether vector indexes can be created on the table.
+func checkTableSupportVectorIndex(tblInfo *model.TableInfo) error {
+	if tblInfo.Partition != nil {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack("vector index is not supported on partitioned tables")
+	}
+	if tblInfo.TempTableType != model.TempTableNone {
+		return dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("vector index")
+	}
+	return nil
+}
+
 // AddIndexColumnFlag aligns the column flags of columns in TableInfo to IndexInfo.
 func AddIndexColumnFlag(tblInfo *model.TableInfo, indexInfo *model.IndexInfo) {
 	if indexInfo.Primary {
@@ -542,12 +734,13 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 		sqlMode                 mysql.SQLMode
 		warnings                []string
 		hiddenCols              []*model.ColumnInfo
+		vectorDistanceFunc      string
 	)
 	if isPK {
 		// Notice: sqlMode and warnings is used to support non-strict mode.
 		err = job.DecodeArgs(&unique, &indexName, &indexPartSpecifications, &indexOption, &sqlMode, &warnings, &global)
 	} else {
-		err = job.DecodeArgs(&unique, &indexName, &indexPartSpecifications, &indexOption, &hiddenCols, &global)
+		err = job.DecodeArgs(&unique, &indexName, &indexPartSpecifications, &indexOption, &hiddenCols, &global, &vectorDistanceFunc)
 	}
 	if err != nil {
 		job.State = model.JobStateCancelled
@@ -586,6 +779,11 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 			job.State = model.JobStateCancelled
 			return ver, errors.Trace(err)
 		}
+		partSpecs := indexPartSpecifications
+		if len(vectorDistanceFunc) > 0 && len(partSpecs) == 1 {
+			// The decoded arguments are encoded again with the job, so they are not modified.
+			partSpecs = []*ast.IndexPartSpecification{decodeVectorIndexPart(partSpecs[0], vectorDistanceFunc)}
+		}
 		indexInfo, err = BuildIndexInfo(
 			nil,
 			tblInfo.Columns,
@@ -593,7 +791,7 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 			isPK,
 			unique,
 			global,
-			indexPartSpecifications,
+			partSpecs,
 			indexOption,
 			model.StateNone,
 		)
@@ -626,6 +824,11 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 	switch indexInfo.State {
 	case model.StateNone:
 		// none -> delete only
+		if indexInfo.Tp == model.IndexTypeHNSW && job.ReorgMeta.ReorgTp == model.ReorgTypeNone {
+			// Inserting into an HNSW graph reads and updates the neighbours of the vector,
+			// so a vector index can only be backfilled in transactions.
+			job.ReorgMeta.ReorgTp = model.ReorgTypeTxn
+		}
 		var reorgTp model.ReorgType
 		reorgTp, err = pickBackfillType(w.ctx, job, indexInfo.Unique, d)
 		if err != nil {
@@ -688,6 +891,11 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 		if !done {
 			return ver, err
 		}
+		if indexInfo.Tp == model.IndexTypeFullText {
+			if err = rebuildFullTextStats(d, tbl, indexInfo); err != nil {
+				return ver, errors.Trace(err)
+			}
+		}
 
 		// Set column index flag.
 		AddIndexColumnFlag(tblInfo, indexInfo)
@@ -522,7 +522,8 @@ func job2TableIDs(job *model.Job) string {
 
 func job2UniqueIDs(job *model.Job, schema bool) string {
 	switch job.Type {
-	case model.ActionExchangeTablePartition, model.ActionRenameTables, model.ActionRenameTable:
+	case model.ActionExchangeTablePartition, model.ActionRenameTables, model.ActionRenameTable,
+		model.ActionCreateMaterializedView, model.ActionDropMaterializedView:
 		var ids []int64
 		if schema {
 			ids = job.CtxVars[0].([]int64)
@@ -0,0 +1,301 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/ddl/util"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/util/dbterror"
+)
+
+// CreateMaterializedView creates the table which stores the data of a materialized view. If the view can be refreshed
+// incrementally, the log table which records the changes of the base table is created in the same job. The view is
+// empty after it's created.
+func (d *ddl) CreateMaterializedView(ctx sessionctx.Context, s *ast.CreateMaterializedViewStmt, info *model.MaterializedViewInfo) error {
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	schema, ok := is.SchemaByName(s.ViewName.Schema)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(s.ViewName.Schema)
+	}
+	if is.TableExists(schema.Name, s.ViewName.Name) {
+		err := infoschema.ErrTableExists.GenWithStackByArgs(ast.Ident{Schema: schema.Name, Name: s.ViewName.Name})
+		if s.IfNotExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	colDefs := make([]*ast.ColumnDef, len(s.Cols))
+	for i, col := range s.Cols {
+		colDefs[i] = &ast.ColumnDef{Name: &ast.ColumnName{Name: col}, Tp: s.ColTypes[i]}
+	}
+	tbInfo, err := buildTableInfoWithCheck(ctx, &ast.CreateTableStmt{Table: s.ViewName, Cols: colDefs},
+		schema.Charset, schema.Collate, schema.PlacementPolicyRef)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tbInfo.MaterializedView = info
+
+	idCnt := 1
+	if info.BaseTableID != 0 {
+		idCnt++
+	}
+	genIDs, err := d.genGlobalIDs(idCnt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tbInfo.ID = genIDs[0]
+	tableIDs := genIDs
+
+	var logInfo *model.TableInfo
+	if info.BaseTableID != 0 {
+		base, ok := is.TableByID(info.BaseTableID)
+		if !ok {
+			return infoschema.ErrTableNotExists.GenWithStackByArgs(schema.Name, fmt.Sprintf("(Table ID %d)", info.BaseTableID))
+		}
+		logName := model.NewCIStr(fmt.Sprintf("_tidb_mvlog_%d", tbInfo.ID))
+		if logInfo, err = buildMaterializedViewLogInfo(ctx, schema, logName, base.Meta()); err != nil {
+			return errors.Trace(err)
+		}
+		logInfo.ID = genIDs[1]
+		logInfo.MaterializedViewLog = &model.MaterializedViewLogInfo{MViewID: tbInfo.ID}
+		info.LogTableID = logInfo.ID
+		tableIDs = append(tableIDs, info.BaseTableID)
+	}
+
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    tbInfo.ID,
+		SchemaName: schema.Name.L,
+		TableName:  tbInfo.Name.L,
+		Type:       model.ActionCreateMaterializedView,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{tbInfo, logInfo},
+		CtxVars:    []interface{}{[]int64{schema.ID}, tableIDs},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// buildMaterializedViewLogInfo builds the log table of a materialized view. The log table has the public columns of
+// the base table without any constraint, and a sign column which tells whether the row is inserted or deleted.
+func buildMaterializedViewLogInfo(ctx sessionctx.Context, schema *model.DBInfo, name model.CIStr, base *model.TableInfo) (*model.TableInfo, error) {
+	cols := base.Cols()
+	colDefs := make([]*ast.ColumnDef, 0, len(cols)+1)
+	for _, col := range cols {
+		tp := col.FieldType.Clone()
+		tp.SetFlag(tp.GetFlag() & (mysql.UnsignedFlag | mysql.BinaryFlag | mysql.ZerofillFlag))
+		colDefs = append(colDefs, &ast.ColumnDef{Name: &ast.ColumnName{Name: col.Name}, Tp: tp})
+	}
+	colDefs = append(colDefs, &ast.ColumnDef{
+		Name:    &ast.ColumnName{Name: model.NewCIStr(model.MaterializedViewLogSignColumn)},
+		Tp:      types.NewFieldType(mysql.TypeTiny),
+		Options: []*ast.ColumnOption{{Tp: ast.ColumnOptionNotNull}},
+	})
+	stmt := &ast.CreateTableStmt{
+		Table: &ast.TableName{Schema: schema.Name, Name: name},
+		Cols:  colDefs,
+	}
+	return buildTableInfoWithCheck(ctx, stmt, schema.Charset, schema.Collate, schema.PlacementPolicyRef)
+}
+
+// DropMaterializedView drops a materialized view along with its log table.
+func (d *ddl) DropMaterializedView(ctx sessionctx.Context, s *ast.DropMaterializedViewStmt) error {
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	ident := ast.Ident{Schema: s.ViewName.Schema, Name: s.ViewName.Name}
+	schema, ok := is.SchemaByName(ident.Schema)
+	tbl, err := is.TableByName(ident.Schema, ident.Name)
+	if !ok || err != nil {
+		err = infoschema.ErrTableDropExists.GenWithStackByArgs(ident.String())
+		if s.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+	tbInfo := tbl.Meta()
+	if !tbInfo.IsMaterializedView() {
+		return dbterror.ErrWrongObject.GenWithStackByArgs(ident.Schema, ident.Name, "MATERIALIZED VIEW")
+	}
+
+	tableIDs := []int64{tbInfo.ID}
+	if mv := tbInfo.MaterializedView; mv.LogTableID != 0 {
+		tableIDs = append(tableIDs, mv.LogTableID, mv.BaseTableID)
+	}
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    tbInfo.ID,
+		SchemaName: schema.Name.L,
+		TableName:  tbInfo.Name.L,
+		Type:       model.ActionDropMaterializedView,
+		BinlogInfo: &model.HistoryInfo{},
+		CtxVars:    []interface{}{[]int64{schema.ID}, tableIDs},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// checkMaterializedViewDependency returns an error if the DDL operation `op` would break a materialized view. The
+// tables of the materialized views and their logs can only be changed by the statements of materialized views, and the
+// base tables can't be dropped, renamed or truncated since the views depend on them.
+func checkMaterializedViewDependency(tbInfo *model.TableInfo, op string) error {
+	switch {
+	case tbInfo.IsMaterializedView():
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(op + " on materialized view")
+	case tbInfo.MaterializedViewLog != nil:
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(op + " on materialized view log")
+	case len(tbInfo.MaterializedViews) > 0:
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(op + " on base table of materialized view")
+	}
+	return nil
+}
+
+// checkAlterMaterializedViewBaseTable returns an error if the ALTER TABLE spec changes the columns of the base table
+// of a materialized view or removes the data of the base table without logging the changes.
+func checkAlterMaterializedViewBaseTable(tbInfo *model.TableInfo, spec *ast.AlterTableSpec) error {
+	if len(tbInfo.MaterializedViews) == 0 {
+		return nil
+	}
+	switch spec.Tp {
+	case ast.AlterTableDropColumn, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn, ast.AlterTableRenameColumn,
+		ast.AlterTableDropPartition, ast.AlterTableDropFirstPartition, ast.AlterTableTruncatePartition,
+		ast.AlterTableExchangePartition:
+		return checkMaterializedViewDependency(tbInfo, "ALTER TABLE")
+	}
+	return nil
+}
+
+func onCreateMaterializedView(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var tbInfo, logInfo *model.TableInfo
+	if err := job.DecodeArgs(&tbInfo, &logInfo); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	schemaID := job.SchemaID
+	tblInfos := []*model.TableInfo{tbInfo}
+	if logInfo != nil {
+		tblInfos = append(tblInfos, logInfo)
+	}
+	for _, tblInfo := range tblInfos {
+		if err := checkTableNotExists(d, t, schemaID, tblInfo.Name.L); err != nil {
+			if infoschema.ErrDatabaseNotExists.Equal(err) || infoschema.ErrTableExists.Equal(err) {
+				job.State = model.JobStateCancelled
+			}
+			return ver, errors.Trace(err)
+		}
+	}
+
+	var multiInfos []schemaIDAndTableInfo
+	if logInfo != nil {
+		baseInfo, err := getTableInfo(t, tbInfo.MaterializedView.BaseTableID, schemaID)
+		if err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(err)
+		}
+		baseInfo.MaterializedViews = append(baseInfo.MaterializedViews, tbInfo.ID)
+		if err = updateTable(t, schemaID, baseInfo); err != nil {
+			return ver, errors.Trace(err)
+		}
+		multiInfos = append(multiInfos, schemaIDAndTableInfo{schemaID: schemaID, tblInfo: logInfo},
+			schemaIDAndTableInfo{schemaID: schemaID, tblInfo: baseInfo})
+	}
+	for _, tblInfo := range tblInfos {
+		tblInfo.State = model.StatePublic
+		tblInfo.UpdateTS = t.StartTS
+		if err := createTableOrViewWithCheck(t, job, schemaID, tblInfo); err != nil {
+			return ver, errors.Trace(err)
+		}
+	}
+
+	ver, err := updateSchemaVersion(d, t, job, multiInfos...)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tbInfo)
+	for _, tblInfo := range tblInfos {
+		asyncNotifyEvent(d, &util.Event{Tp: model.ActionCreateTable, TableInfo: tblInfo})
+	}
+	return ver, nil
+}
+
+func onDropMaterializedView(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	tbInfo, err := checkTableExistAndCancelNonExistJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	mv := tbInfo.MaterializedView
+	if mv == nil {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrWrongObject.GenWithStackByArgs(job.SchemaName, tbInfo.Name, "MATERIALIZED VIEW")
+	}
+
+	tblInfos := []*model.TableInfo{tbInfo}
+	var multiInfos []schemaIDAndTableInfo
+	if mv.LogTableID != 0 {
+		logInfo, err := getTableInfo(t, mv.LogTableID, job.SchemaID)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		tblInfos = append(tblInfos, logInfo)
+		baseInfo, err := getTableInfo(t, mv.BaseTableID, job.SchemaID)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		baseInfo.MaterializedViews = slices.DeleteFunc(slices.Clone(baseInfo.MaterializedViews), func(id int64) bool {
+			return id == tbInfo.ID
+		})
+		if err = updateTable(t, job.SchemaID, baseInfo); err != nil {
+			return ver, errors.Trace(err)
+		}
+		multiInfos = append(multiInfos, schemaIDAndTableInfo{schemaID: job.SchemaID, tblInfo: baseInfo})
+	}
+
+	droppedIDs := make([]int64, 0, len(tblInfos))
+	for _, tblInfo := range tblInfos {
+		if err = t.DropTableOrView(job.SchemaID, tblInfo.ID); err != nil {
+			return ver, errors.Trace(err)
+		}
+		if err = t.GetAutoIDAccessors(job.SchemaID, tblInfo.ID).Del(); err != nil {
+			return ver, errors.Trace(err)
+		}
+		droppedIDs = append(droppedIDs, tblInfo.ID)
+	}
+	// The dropped table IDs are used to build the schema diff and the delete ranges.
+	job.Args = []interface{}{droppedIDs}
+	ver, err = updateSchemaVersion(d, t, job, multiInfos...)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	tbInfo.State = model.StateNone
+	job.FinishTableJob(model.JobStateDone, model.StateNone, ver, tbInfo)
+	for _, tblInfo := range tblInfos {
+		asyncNotifyEvent(d, &util.Event{Tp: model.ActionDropTable, TableInfo: tblInfo})
+	}
+	return ver, nil
+}
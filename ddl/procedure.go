@@ -0,0 +1,202 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+// CreateProcedure creates the stored procedure or function of the `CREATE PROCEDURE` or `CREATE FUNCTION`
+// statement.
+func (d *ddl) CreateProcedure(ctx sessionctx.Context, stmt *ast.ProcedureInfo, info *model.ProcedureInfo) error {
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	schema, ok := is.SchemaByName(stmt.ProcedureName.Schema)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(stmt.ProcedureName.Schema.O)
+	}
+
+	var existing *model.ProcedureInfo
+	err := kv.RunInNewTxn(kv.WithInternalSourceType(d.ctx, kv.InternalTxnDDL), d.store, false,
+		func(_ context.Context, txn kv.Transaction) (err error) {
+			existing, err = getRoutine(meta.NewMeta(txn), schema.ID, info.Name.L, stmt.IsFunction)
+			return err
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if existing != nil {
+		err = exeerrors.ErrSpAlreadyExists.GenWithStackByArgs(routineKind(stmt.IsFunction), info.Name.O)
+		if stmt.IfNotExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	tp := model.ActionCreateProcedure
+	if stmt.IsFunction {
+		tp = model.ActionCreateFunction
+	}
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		SchemaName: schema.Name.L,
+		Type:       tp,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{info},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// DropProcedure drops the stored procedure or function of the `DROP PROCEDURE` or `DROP FUNCTION` statement.
+func (d *ddl) DropProcedure(ctx sessionctx.Context, stmt *ast.DropProcedureStmt) error {
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	schema, ok := is.SchemaByName(stmt.ProcedureName.Schema)
+	var existing *model.ProcedureInfo
+	if ok {
+		err := kv.RunInNewTxn(kv.WithInternalSourceType(d.ctx, kv.InternalTxnDDL), d.store, false,
+			func(_ context.Context, txn kv.Transaction) (err error) {
+				existing, err = getRoutine(meta.NewMeta(txn), schema.ID, stmt.ProcedureName.Name.L, stmt.IsFunction)
+				return err
+			})
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if existing == nil {
+		err := exeerrors.ErrSpDoesNotExist.GenWithStackByArgs(routineKind(stmt.IsFunction),
+			fmt.Sprintf("%s.%s", stmt.ProcedureName.Schema.O, stmt.ProcedureName.Name.O))
+		if stmt.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	tp := model.ActionDropProcedure
+	if stmt.IsFunction {
+		tp = model.ActionDropFunction
+	}
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		SchemaName: schema.Name.L,
+		Type:       tp,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{existing.Name},
+	}
+	err := d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// getRoutine returns the stored procedure or function in the schema, it returns nil if the routine doesn't exist.
+func getRoutine(t *meta.Meta, dbID int64, name string, isFunction bool) (*model.ProcedureInfo, error) {
+	if isFunction {
+		return t.GetFunction(dbID, name)
+	}
+	return t.GetProcedure(dbID, name)
+}
+
+// routineKind returns the kind of the routine used in the messages.
+func routineKind(isFunction bool) string {
+	if isFunction {
+		return "FUNCTION"
+	}
+	return "PROCEDURE"
+}
+
+func onCreateRoutine(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var info *model.ProcedureInfo
+	if err := job.DecodeArgs(&info); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	isFunction := job.Type == model.ActionCreateFunction
+	existing, err := getRoutine(t, dbInfo.ID, info.Name.L, isFunction)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if existing != nil {
+		job.State = model.JobStateCancelled
+		return ver, exeerrors.ErrSpAlreadyExists.GenWithStackByArgs(routineKind(isFunction), info.Name.O)
+	}
+
+	if isFunction {
+		err = t.SetFunction(dbInfo.ID, info)
+	} else {
+		err = t.SetProcedure(dbInfo.ID, info)
+	}
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if ver, err = updateSchemaVersion(d, t, job); err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishDBJob(model.JobStateDone, model.StatePublic, ver, dbInfo)
+	return ver, nil
+}
+
+func onDropRoutine(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var name model.CIStr
+	if err := job.DecodeArgs(&name); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	isFunction := job.Type == model.ActionDropFunction
+	existing, err := getRoutine(t, dbInfo.ID, name.L, isFunction)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if existing == nil {
+		job.State = model.JobStateCancelled
+		return ver, exeerrors.ErrSpDoesNotExist.GenWithStackByArgs(routineKind(isFunction),
+			fmt.Sprintf("%s.%s", dbInfo.Name.O, name.O))
+	}
+
+	if isFunction {
+		err = t.DropFunction(dbInfo.ID, name.L)
+	} else {
+		err = t.DropProcedure(dbInfo.ID, name.L)
+	}
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if ver, err = updateSchemaVersion(d, t, job); err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishDBJob(model.JobStateDone, model.StatePublic, ver, dbInfo)
+	return ver, nil
+}
@@ -415,6 +415,124 @@ func (*Checker) AlterSequence(_ sessionctx.Context, _ *ast.AlterSequenceStmt) er
 	panic("implement me")
 }
 
+// CreateTrigger implements the DDL interface.
+func (d *Checker) CreateTrigger(ctx sessionctx.Context, stmt *ast.CreateTriggerStmt, info *model.TriggerInfo) error {
+	err := d.realDDL.CreateTrigger(ctx, stmt, info)
+	if err != nil {
+		return err
+	}
+	err = d.tracker.CreateTrigger(ctx, stmt, info)
+	if err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// DropTrigger implements the DDL interface.
+func (d *Checker) DropTrigger(ctx sessionctx.Context, stmt *ast.DropTriggerStmt) error {
+	err := d.realDDL.DropTrigger(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	err = d.tracker.DropTrigger(ctx, stmt)
+	if err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// CreateMaterializedView implements the DDL interface.
+// SchemaTracker doesn't track materialized views, so the tables of the view are not checked.
+func (d *Checker) CreateMaterializedView(ctx sessionctx.Context, stmt *ast.CreateMaterializedViewStmt, info *model.MaterializedViewInfo) error {
+	err := d.realDDL.CreateMaterializedView(ctx, stmt, info)
+	if err != nil {
+		return err
+	}
+	err = d.tracker.CreateMaterializedView(ctx, stmt, info)
+	if err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// DropMaterializedView implements the DDL interface.
+func (d *Checker) DropMaterializedView(ctx sessionctx.Context, stmt *ast.DropMaterializedViewStmt) error {
+	err := d.realDDL.DropMaterializedView(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	err = d.tracker.DropMaterializedView(ctx, stmt)
+	if err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// CreateProcedure implements the DDL interface.
+func (d *Checker) CreateProcedure(ctx sessionctx.Context, stmt *ast.ProcedureInfo, info *model.ProcedureInfo) error {
+	err := d.realDDL.CreateProcedure(ctx, stmt, info)
+	if err != nil {
+		return err
+	}
+	err = d.tracker.CreateProcedure(ctx, stmt, info)
+	if err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// DropProcedure implements the DDL interface.
+func (d *Checker) DropProcedure(ctx sessionctx.Context, stmt *ast.DropProcedureStmt) error {
+	err := d.realDDL.DropProcedure(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	err = d.tracker.DropProcedure(ctx, stmt)
+	if err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// CreateEvent implements the DDL interface.
+func (d *Checker) CreateEvent(ctx sessionctx.Context, stmt *ast.CreateEventStmt, data []byte) error {
+	err := d.realDDL.CreateEvent(ctx, stmt, data)
+	if err != nil {
+		return err
+	}
+	err = d.tracker.CreateEvent(ctx, stmt, data)
+	if err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// AlterEvent implements the DDL interface.
+func (d *Checker) AlterEvent(ctx sessionctx.Context, stmt *ast.AlterEventStmt, data []byte) error {
+	err := d.realDDL.AlterEvent(ctx, stmt, data)
+	if err != nil {
+		return err
+	}
+	err = d.tracker.AlterEvent(ctx, stmt, data)
+	if err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// DropEvent implements the DDL interface.
+func (d *Checker) DropEvent(ctx sessionctx.Context, stmt *ast.DropEventStmt) error {
+	err := d.realDDL.DropEvent(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	err = d.tracker.DropEvent(ctx, stmt)
+	if err != nil {
+		panic(err)
+	}
+	return nil
+}
+
 // CreatePlacementPolicy implements the DDL interface.
 func (*Checker) CreatePlacementPolicy(_ sessionctx.Context, _ *ast.CreatePlacementPolicyStmt) error {
 	//TODO implement me
@@ -391,6 +391,14 @@ func (d SchemaTracker) createIndex(
 	ifNotExists bool,
 ) (err error) {
 	unique := keyType == ast.IndexKeyTypeUnique
+	if keyType == ast.IndexKeyTypeFullText {
+		indexOption = ddl.FullTextIndexOption(indexOption)
+	}
+	if keyType == ast.IndexKeyTypeVector {
+		if indexOption, err = ddl.VectorIndexOption(indexOption); err != nil {
+			return err
+		}
+	}
 	tblInfo, err := d.TableClonedByName(ti.Schema, ti.Name)
 	if err != nil {
 		return err
@@ -416,9 +424,12 @@ func (d SchemaTracker) createIndex(
 		return dbterror.ErrDupKeyName.GenWithStack("index already exist %s", indexName)
 	}
 
-	hiddenCols, err := ddl.BuildHiddenColumnInfo(ctx, indexPartSpecifications, indexName, t.Meta(), t.Cols())
-	if err != nil {
-		return err
+	var hiddenCols []*model.ColumnInfo
+	if keyType != ast.IndexKeyTypeVector {
+		hiddenCols, err = ddl.BuildHiddenColumnInfo(ctx, indexPartSpecifications, indexName, t.Meta(), t.Cols())
+		if err != nil {
+			return err
+		}
 	}
 	finalColumns := make([]*model.ColumnInfo, len(tblInfo.Columns), len(tblInfo.Columns)+len(hiddenCols))
 	copy(finalColumns, tblInfo.Columns)
@@ -949,8 +960,13 @@ func (d SchemaTracker) AlterTable(ctx context.Context, sctx sessionctx.Context,
 					spec.Constraint.Keys, constr.Option, false) // IfNotExists should be not applied
 			case ast.ConstraintPrimaryKey:
 				err = d.createPrimaryKey(sctx, ident, model.NewCIStr(constr.Name), spec.Constraint.Keys, constr.Option)
+			case ast.ConstraintFulltext:
+				err = d.createIndex(sctx, ident, ast.IndexKeyTypeFullText, model.NewCIStr(constr.Name),
+					spec.Constraint.Keys, constr.Option, constr.IfNotExists)
+			case ast.ConstraintVector:
+				err = d.createIndex(sctx, ident, ast.IndexKeyTypeVector, model.NewCIStr(constr.Name),
+					spec.Constraint.Keys, constr.Option, constr.IfNotExists)
 			case ast.ConstraintForeignKey,
-				ast.ConstraintFulltext,
 				ast.ConstraintCheck:
 			default:
 				// Nothing to do now.
@@ -1157,6 +1173,51 @@ func (SchemaTracker) AlterSequence(_ sessionctx.Context, _ *ast.AlterSequenceStm
 	return nil
 }
 
+// CreateTrigger implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) CreateTrigger(_ sessionctx.Context, _ *ast.CreateTriggerStmt, _ *model.TriggerInfo) error {
+	return nil
+}
+
+// DropTrigger implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) DropTrigger(_ sessionctx.Context, _ *ast.DropTriggerStmt) error {
+	return nil
+}
+
+// CreateMaterializedView implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) CreateMaterializedView(_ sessionctx.Context, _ *ast.CreateMaterializedViewStmt, _ *model.MaterializedViewInfo) error {
+	return nil
+}
+
+// DropMaterializedView implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) DropMaterializedView(_ sessionctx.Context, _ *ast.DropMaterializedViewStmt) error {
+	return nil
+}
+
+// CreateProcedure implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) CreateProcedure(_ sessionctx.Context, _ *ast.ProcedureInfo, _ *model.ProcedureInfo) error {
+	return nil
+}
+
+// DropProcedure implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) DropProcedure(_ sessionctx.Context, _ *ast.DropProcedureStmt) error {
+	return nil
+}
+
+// CreateEvent implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) CreateEvent(_ sessionctx.Context, _ *ast.CreateEventStmt, _ []byte) error {
+	return nil
+}
+
+// AlterEvent implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) AlterEvent(_ sessionctx.Context, _ *ast.AlterEventStmt, _ []byte) error {
+	return nil
+}
+
+// DropEvent implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) DropEvent(_ sessionctx.Context, _ *ast.DropEventStmt) error {
+	return nil
+}
+
 // CreatePlacementPolicy implements the DDL interface, it's no-op in DM's case.
 func (SchemaTracker) CreatePlacementPolicy(_ sessionctx.Context, _ *ast.CreatePlacementPolicyStmt) error {
 	return nil
@@ -0,0 +1,211 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/dbterror"
+)
+
+// CreateTrigger creates a trigger on the table of the `CREATE TRIGGER` statement.
+func (d *ddl) CreateTrigger(ctx sessionctx.Context, stmt *ast.CreateTriggerStmt, info *model.TriggerInfo) error {
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	schema, ok := is.SchemaByName(stmt.Table.Schema)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(stmt.Table.Schema)
+	}
+	tb, err := is.TableByName(stmt.Table.Schema, stmt.Table.Name)
+	if err != nil {
+		return errors.Trace(infoschema.ErrTableNotExists.GenWithStackByArgs(stmt.Table.Schema, stmt.Table.Name))
+	}
+	if stmt.TriggerName.Schema.L != schema.Name.L {
+		return dbterror.ErrTrgInWrongSchema
+	}
+	if util.IsMemOrSysDB(schema.Name.L) {
+		return dbterror.ErrNoTriggersOnSystemSchema
+	}
+	tbInfo := tb.Meta()
+	if !tbInfo.IsBaseTable() || tbInfo.TempTableType != model.TempTableNone {
+		return dbterror.ErrTrgOnViewOrTempTable.GenWithStackByArgs(tbInfo.Name.O)
+	}
+
+	if findTriggerTable(is, schema.Name, info.Name) != nil {
+		if stmt.IfNotExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(dbterror.ErrTrgAlreadyExists)
+			return nil
+		}
+		return dbterror.ErrTrgAlreadyExists
+	}
+
+	var (
+		precedes bool
+		ref      model.CIStr
+	)
+	if stmt.Order != nil {
+		precedes, ref = stmt.Order.Precedes, stmt.Order.Trigger
+		if _, err = triggerInsertOffset(tbInfo, info, precedes, ref); err != nil {
+			return err
+		}
+	}
+
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    tbInfo.ID,
+		SchemaName: schema.Name.L,
+		TableName:  tbInfo.Name.L,
+		Type:       model.ActionCreateTrigger,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{info, precedes, ref},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// DropTrigger drops the trigger of the `DROP TRIGGER` statement.
+func (d *ddl) DropTrigger(ctx sessionctx.Context, stmt *ast.DropTriggerStmt) error {
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	var tb table.Table
+	schema, ok := is.SchemaByName(stmt.TriggerName.Schema)
+	if ok {
+		tb = findTriggerTable(is, schema.Name, stmt.TriggerName.Name)
+	}
+	if tb == nil {
+		if stmt.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(dbterror.ErrTrgDoesNotExist)
+			return nil
+		}
+		return dbterror.ErrTrgDoesNotExist
+	}
+
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    tb.Meta().ID,
+		SchemaName: schema.Name.L,
+		TableName:  tb.Meta().Name.L,
+		Type:       model.ActionDropTrigger,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{stmt.TriggerName.Name},
+	}
+	err := d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// findTriggerTable returns the table on which the trigger is created, the names of triggers are unique in a schema.
+func findTriggerTable(is infoschema.InfoSchema, schema, name model.CIStr) table.Table {
+	for _, tb := range is.SchemaTables(schema) {
+		if findTrigger(tb.Meta(), name) >= 0 {
+			return tb
+		}
+	}
+	return nil
+}
+
+func findTrigger(tbInfo *model.TableInfo, name model.CIStr) int {
+	for i, trigger := range tbInfo.Triggers {
+		if trigger.Name.L == name.L {
+			return i
+		}
+	}
+	return -1
+}
+
+// triggerInsertOffset returns the offset in `tbInfo.Triggers` where the new trigger is inserted, the trigger is
+// appended if `ref` is empty, otherwise it's placed next to the trigger `ref`, which must have the same timing and
+// event as the new trigger.
+func triggerInsertOffset(tbInfo *model.TableInfo, info *model.TriggerInfo, precedes bool, ref model.CIStr) (int, error) {
+	if ref.L == "" {
+		return len(tbInfo.Triggers), nil
+	}
+	offset := findTrigger(tbInfo, ref)
+	if offset < 0 || tbInfo.Triggers[offset].Timing != info.Timing || tbInfo.Triggers[offset].Event != info.Event {
+		return 0, dbterror.ErrReferencedTrgDoesNotExist.GenWithStackByArgs(ref.O)
+	}
+	if !precedes {
+		offset++
+	}
+	return offset, nil
+}
+
+func onCreateTrigger(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var (
+		info     *model.TriggerInfo
+		precedes bool
+		ref      model.CIStr
+	)
+	if err := job.DecodeArgs(&info, &precedes, &ref); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if findTrigger(tblInfo, info.Name) >= 0 {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrTrgAlreadyExists
+	}
+	offset, err := triggerInsertOffset(tblInfo, info, precedes, ref)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	triggers := make([]*model.TriggerInfo, 0, len(tblInfo.Triggers)+1)
+	triggers = append(triggers, tblInfo.Triggers[:offset]...)
+	triggers = append(triggers, info)
+	tblInfo.Triggers = append(triggers, tblInfo.Triggers[offset:]...)
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+func onDropTrigger(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var name model.CIStr
+	if err := job.DecodeArgs(&name); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	offset := findTrigger(tblInfo, name)
+	if offset < 0 {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrTrgDoesNotExist
+	}
+
+	tblInfo.Triggers = append(tblInfo.Triggers[:offset:offset], tblInfo.Triggers[offset+1:]...)
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
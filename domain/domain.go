@@ -47,6 +47,7 @@ import (
 	"github.com/pingcap/tidb/domain/infosync"
 	"github.com/pingcap/tidb/domain/resourcegroup"
 	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/event"
 	"github.com/pingcap/tidb/infoschema"
 	infoschema_metrics "github.com/pingcap/tidb/infoschema/metrics"
 	"github.com/pingcap/tidb/infoschema/perfschema"
@@ -163,10 +164,12 @@ type Domain struct {
 		sync.RWMutex
 		expiredTimeStamp types.Time
 	}
+	instancePlanCache sessionctx.InstancePlanCache
 
 	logBackupAdvancer        *daemon.OwnerDaemon
 	historicalStatsWorker    *HistoricalStatsWorker
 	ttlJobManager            atomic.Pointer[ttlworker.JobManager]
+	eventManager             atomic.Pointer[event.Manager]
 	runawayManager           *resourcegroup.RunawayManager
 	runawaySyncer            *runawaySyncer
 	resourceGroupsController *rmclient.ResourceGroupsController
@@ -510,6 +513,17 @@ func (do *Domain) SetExpiredTimeStamp4PC(time types.Time) {
 	do.expiredTimeStamp4PC.expiredTimeStamp = time
 }
 
+// InstancePlanCache returns the plan cache shared by all the sessions in the instance.
+func (do *Domain) InstancePlanCache() sessionctx.InstancePlanCache {
+	return do.instancePlanCache
+}
+
+// SetInstancePlanCache sets the plan cache shared by all the sessions in the instance.
+// It should be called before the domain is used by any session.
+func (do *Domain) SetInstancePlanCache(c sessionctx.InstancePlanCache) {
+	do.instancePlanCache = c
+}
+
 // DDL gets DDL from domain.
 func (do *Domain) DDL() ddl.DDL {
 	return do.ddl
@@ -1009,8 +1023,14 @@ func (do *Domain) Close() {
 			logutil.BgLogger().Info("ttlJobManager exited.")
 		}
 	}
+	if eventManager := do.eventManager.Load(); eventManager != nil {
+		logutil.BgLogger().Info("stopping eventManager")
+		eventManager.Stop()
+		logutil.BgLogger().Info("eventManager exited.")
+	}
 	do.releaseServerID(context.Background())
 	close(do.exit)
+
 	if do.etcdClient != nil {
 		terror.Log(errors.Trace(do.etcdClient.Close()))
 	}
@@ -1262,6 +1282,7 @@ func (do *Domain) Init(
 	do.wg.Run(do.globalConfigSyncerKeeper, "globalConfigSyncerKeeper")
 	do.wg.Run(do.runawayRecordFlushLoop, "runawayRecordFlushLoop")
 	do.wg.Run(do.runawayWatchSyncLoop, "runawayWatchSyncLoop")
+	do.wg.Run(do.fullTextStatsMergeLoop, "fullTextStatsMergeLoop")
 	if !skipRegisterToDashboard {
 		do.wg.Run(do.topologySyncerKeeper, "topologySyncerKeeper")
 	}
@@ -1857,6 +1878,7 @@ func (do *Domain) globalBindHandleWorkerLoop(owner owner.Manager) {
 				if err == nil && variable.TiDBOptOn(optVal) {
 					bindHandle.CaptureBaselines()
 				}
+				do.capturePlanRegressions(bindHandle)
 				bindHandle.SaveEvolveTasksToStore()
 			case <-gcBindTicker.C:
 				if !owner.IsOwner() {
@@ -1871,6 +1893,24 @@ func (do *Domain) globalBindHandleWorkerLoop(owner owner.Manager) {
 	}, "globalBindHandleWorkerLoop")
 }
 
+// capturePlanRegressions fixes the plan regressions according to tidb_capture_plan_regression.
+func (do *Domain) capturePlanRegressions(bindHandle *bindinfo.BindHandle) {
+	policy, err := do.GetGlobalVar(variable.TiDBCapturePlanRegression)
+	if err != nil || policy == variable.PlanRegressionOff {
+		return
+	}
+	ratio, minExecCount := variable.DefTiDBPlanRegressionLatencyRatio, int64(variable.DefTiDBPlanRegressionMinExecCount)
+	if val, err := do.GetGlobalVar(variable.TiDBPlanRegressionLatencyRatio); err == nil {
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			ratio = f
+		}
+	}
+	if val, err := do.GetGlobalVar(variable.TiDBPlanRegressionMinExecCount); err == nil {
+		minExecCount = variable.TidbOptInt64(val, minExecCount)
+	}
+	bindHandle.CapturePlanRegressions(policy, ratio, minExecCount)
+}
+
 func (do *Domain) handleEvolvePlanTasksLoop(ctx sessionctx.Context, owner owner.Manager) {
 	do.wg.Run(func() {
 		defer func() {
@@ -2430,6 +2470,9 @@ func (do *Domain) updateStatsWorker(ctx sessionctx.Context, owner owner.Manager)
 			if err != nil {
 				logutil.BgLogger().Debug("dump stats delta failed", zap.Error(err))
 			}
+			if err := statsHandle.DumpQueryFeedbackToKV(); err != nil {
+				logutil.BgLogger().Debug("dump query feedback failed", zap.Error(err))
+			}
 		case <-gcStatsTicker.C:
 			if !owner.IsOwner() {
 				continue
@@ -2438,6 +2481,9 @@ func (do *Domain) updateStatsWorker(ctx sessionctx.Context, owner owner.Manager)
 			if err != nil {
 				logutil.BgLogger().Debug("GC stats failed", zap.Error(err))
 			}
+			if err := statsHandle.GCQueryFeedback(handle.QueryFeedbackKeepDuration); err != nil {
+				logutil.BgLogger().Debug("GC query feedback failed", zap.Error(err))
+			}
 		case <-dumpColStatsUsageTicker.C:
 			err := statsHandle.DumpColStatsUsageToKV()
 			if err != nil {
@@ -2924,6 +2970,18 @@ func (do *Domain) TTLJobManager() *ttlworker.JobManager {
 	return do.ttlJobManager.Load()
 }
 
+// StartEventManager creates and starts the event manager
+func (do *Domain) StartEventManager(sessFactory event.SessionFactory) {
+	eventManager := event.NewManager(do.sysSessionPool, do.store, do.etcdClient, sessFactory, do.ddl.OwnerManager().IsOwner)
+	do.eventManager.Store(eventManager)
+	eventManager.Start()
+}
+
+// EventManager returns the event manager on this domain
+func (do *Domain) EventManager() *event.Manager {
+	return do.eventManager.Load()
+}
+
 // StopAutoAnalyze stops (*Domain).autoAnalyzeWorker to launch new auto analyze jobs.
 func (do *Domain) StopAutoAnalyze() {
 	do.stopAutoAnalyze.Store(true)
@@ -0,0 +1,84 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/fulltext"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+// fullTextStatsMergeInterval is the interval of merging the deltas of the statistics of the full-text indexes.
+const fullTextStatsMergeInterval = time.Minute
+
+// fullTextStatsMergeLoop merges the deltas of the statistics of the full-text indexes, which are written by
+// the DML, on the DDL owner.
+func (do *Domain) fullTextStatsMergeLoop() {
+	defer util.Recover(metrics.LabelDomain, "fullTextStatsMergeLoop", nil, false)
+	ticker := time.NewTicker(fullTextStatsMergeInterval)
+	defer func() {
+		ticker.Stop()
+		logutil.BgLogger().Info("fullTextStatsMergeLoop exited.")
+	}()
+	for {
+		select {
+		case <-ticker.C:
+		case <-do.exit:
+			return
+		}
+		if do.ddl == nil || !do.ddl.OwnerManager().IsOwner() {
+			continue
+		}
+		do.MergeFullTextStats()
+	}
+}
+
+// MergeFullTextStats merges the deltas of the statistics of all the full-text indexes. Every index is merged in
+// its own transaction, and the index that fails to merge is merged next time.
+func (do *Domain) MergeFullTextStats() {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnOthers)
+	is := do.InfoSchema()
+	for _, db := range is.AllSchemas() {
+		for _, tbl := range is.SchemaTables(db.Name) {
+			tblInfo := tbl.Meta()
+			for _, idxInfo := range tblInfo.Indices {
+				if idxInfo.Tp != model.IndexTypeFullText {
+					continue
+				}
+				var merged int
+				err := kv.RunInNewTxn(ctx, do.store, false, func(_ context.Context, txn kv.Transaction) (err error) {
+					merged, err = fulltext.MergeStats(txn, tblInfo.ID, idxInfo.ID)
+					return err
+				})
+				if err != nil {
+					logutil.BgLogger().Warn("merge the statistics of the full-text index failed",
+						zap.String("table", tblInfo.Name.O), zap.String("index", idxInfo.Name.O), zap.Error(err))
+					continue
+				}
+				if merged > 0 {
+					logutil.BgLogger().Debug("merge the statistics of the full-text index",
+						zap.String("table", tblInfo.Name.O), zap.String("index", idxInfo.Name.O), zap.Int("deltas", merged))
+				}
+			}
+		}
+	}
+}
@@ -828,8 +828,11 @@ const (
 	ErrInvalidArgumentForLogarithm                           = 3020
 	ErrMaxExecTimeExceeded                                   = 3024
 	ErrAggregateOrderNonAggQuery                             = 3029
+	ErrGISDifferentSRIDs                                     = 3033
+	ErrGISInvalidData                                        = 3037
 	ErrUserLockWrongName                                     = 3057
 	ErrUserLockDeadlock                                      = 3058
+	ErrReferencedTrgDoesNotExist                             = 3062
 	ErrIncorrectType                                         = 3064
 	ErrFieldInOrderNotSelect                                 = 3065
 	ErrAggregateInOrderNotSelect                             = 3066
@@ -860,9 +863,12 @@ const (
 	ErrInvalidJSONPathArrayCell                              = 3165
 	ErrInvalidEncryptionOption                               = 3184
 	ErrTooLongValueForType                                   = 3505
+	ErrGISUnsupportedArgument                                = 3516
 	ErrPKIndexCantBeInvisible                                = 3522
 	ErrGrantRole                                             = 3523
 	ErrRoleNotGranted                                        = 3530
+	ErrSRSNotFound                                           = 3548
+	ErrNonpositiveRadius                                     = 3552
 	ErrLockAcquireFailAndNoWaitSet                           = 3572
 	ErrCTERecursiveRequiresUnion                             = 3573
 	ErrCTERecursiveRequiresNonRecursiveFirst                 = 3574
@@ -892,10 +898,16 @@ const (
 	ErrWindowFunctionIgnoresFrame                            = 3599
 	ErrInvalidNumberOfArgs                                   = 3601
 	ErrFieldInGroupingNotGroupBy                             = 3602
+	ErrLongitudeOutOfRange                                   = 3616
+	ErrLatitudeOutOfRange                                    = 3617
 	ErrIllegalPrivilegeLevel                                 = 3619
 	ErrCTEMaxRecursionDepth                                  = 3636
 	ErrNotHintUpdatable                                      = 3637
 	ErrExistsInHistoryPassword                               = 3638
+	ErrMissingJSONTableValue                                 = 3665
+	ErrWrongJSONTableValue                                   = 3666
+	ErrTableFunctionMustHaveAlias                            = 3667
+	ErrTableFunctionForbiddenJoinType                        = 3668
 	ErrInvalidDefaultUTF8MB4Collation                        = 3721
 	ErrForeignKeyCannotDropParent                            = 3730
 	ErrForeignKeyCannotUseVirtualColumn                      = 3733
@@ -927,6 +939,7 @@ const (
 	ErrCheckConstraintDupName                                = 3822
 	ErrCheckConstraintClauseUsingFKReferActionColumn         = 3823
 	ErrDependentByFunctionalIndex                            = 3837
+	ErrInvalidJSONType                                       = 3853
 	ErrCannotConvertString                                   = 3854
 	ErrDependentByPartitionFunctional                        = 3855
 	ErrInvalidJSONValueForFuncIndex                          = 3903
@@ -934,6 +947,7 @@ const (
 	ErrFunctionalIndexDataIsTooLong                          = 3907
 	ErrFunctionalIndexNotApplicable                          = 3909
 	ErrDynamicPrivilegeNotRegistered                         = 3929
+	ErrJSONSchemaValidationErrorWithDetailedReport           = 3934
 	ErrConstraintNotFound                                    = 3940
 	ErUserAccessDeniedForUserAccountBlockedByPasswordLock    = 3955
 	ErrDependentByCheckConstraint                            = 3959
@@ -1134,6 +1148,11 @@ const (
 	ErrCannotResumeDDLJob = 8261
 	ErrPausedDDLJob       = 8262
 
+	// Vector errors.
+	ErrVectorInvalidValue      = 8263
+	ErrVectorDimensionMismatch = 8264
+	ErrVectorDifferentDims     = 8265
+
 	// Resource group errors.
 	ErrResourceGroupExists                    = 8248
 	ErrResourceGroupNotExists                 = 8249
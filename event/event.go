@@ -0,0 +1,218 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	timerapi "github.com/pingcap/tidb/timer/api"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+const (
+	timerKeyPrefix = "/tidb/event/"
+	timerHookClass = "tidb.event"
+)
+
+// Info is the meta information of an event. It is persisted in the meta of its schema by the DDL jobs, and copied
+// to the data of the timer which schedules the event.
+type Info struct {
+	SchemaID    int64  `json:"schema_id"`
+	Name        string `json:"name"`
+	DefinerUser string `json:"definer_user"`
+	DefinerHost string `json:"definer_host"`
+	// Body is the original text of the statement executed when the event is triggered.
+	Body string `json:"body"`
+	// ExecuteAt is the execution time of a one-time event, it is nil for a recurring event.
+	ExecuteAt *time.Time `json:"execute_at,omitempty"`
+	// IntervalValue and IntervalField describe the interval of a recurring event, for example: '1' and 'DAY'.
+	IntervalValue string     `json:"interval_value,omitempty"`
+	IntervalField string     `json:"interval_field,omitempty"`
+	Starts        *time.Time `json:"starts,omitempty"`
+	Ends          *time.Time `json:"ends,omitempty"`
+	// Preserve indicates whether the event should be kept after it will never be triggered again.
+	Preserve            bool      `json:"preserve"`
+	Comment             string    `json:"comment,omitempty"`
+	SQLMode             string    `json:"sql_mode"`
+	CharsetClient       string    `json:"charset_client"`
+	CollationConnection string    `json:"collation_connection"`
+	CollationDatabase   string    `json:"collation_database"`
+	Created             time.Time `json:"created"`
+	LastAltered         time.Time `json:"last_altered"`
+	// Enabled indicates whether the event is enabled.
+	Enabled bool `json:"enabled"`
+	// TimeZone is the time zone of the session which specified the schedule of the event.
+	TimeZone string `json:"time_zone"`
+}
+
+// Encode encodes the event to be persisted in the meta.
+func (e *Info) Encode() ([]byte, error) {
+	data, err := json.Marshal(e)
+	return data, errors.Trace(err)
+}
+
+// DecodeInfo decodes an event persisted in the meta.
+func DecodeInfo(data []byte) (*Info, error) {
+	info := &Info{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return info, nil
+}
+
+// IsOneTime returns whether the event is a one-time event.
+func (e *Info) IsOneTime() bool {
+	return e.ExecuteAt != nil
+}
+
+// Interval returns the interval of a recurring event.
+func (e *Info) Interval() (time.Duration, error) {
+	return ParseInterval(e.IntervalValue, e.IntervalField)
+}
+
+// Validate validates the schedule of the event.
+func (e *Info) Validate() error {
+	if e.IsOneTime() {
+		return nil
+	}
+
+	if _, err := e.Interval(); err != nil {
+		return err
+	}
+
+	if e.Starts != nil && e.Ends != nil && !e.Ends.After(*e.Starts) {
+		return exeerrors.ErrEventEndsBeforeStarts
+	}
+	return nil
+}
+
+// Expired returns whether the event will never be triggered after the specified time.
+func (e *Info) Expired(now time.Time) bool {
+	if e.IsOneTime() {
+		return e.ExecuteAt.Before(now)
+	}
+	return e.Ends != nil && e.Ends.Before(now)
+}
+
+// Event is an event with its runtime status.
+type Event struct {
+	*Info
+	// LastExecuted is the time when the event was triggered for the last time, it is nil if never triggered.
+	LastExecuted *time.Time
+	// LastError is the error of the last execution if it failed.
+	LastError string
+
+	timerID string
+}
+
+// eventSummary is persisted as the summary data of the timer after an event is executed.
+type eventSummary struct {
+	LastEventID  string    `json:"last_event_id"`
+	LastExecuted time.Time `json:"last_executed"`
+	LastError    string    `json:"last_error,omitempty"`
+}
+
+// ParseInterval converts the interval of a recurring event to a duration.
+// Intervals with months or years are not supported because they do not have a fixed length.
+func ParseInterval(value string, field string) (time.Duration, error) {
+	unit := strings.ToUpper(field)
+	switch unit {
+	case "MONTH", "QUARTER", "YEAR", "YEAR_MONTH":
+		return 0, errors.Errorf("interval unit '%s' for event is not supported", unit)
+	}
+
+	years, months, days, nanos, _, err := types.ParseDurationValue(unit, value)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	if years != 0 || months != 0 {
+		return 0, errors.Errorf("interval unit '%s' for event is not supported", unit)
+	}
+
+	interval := time.Duration(days)*24*time.Hour + time.Duration(nanos)
+	if interval <= 0 {
+		return 0, exeerrors.ErrEventIntervalNotPositiveOrTooBig
+	}
+
+	if interval%time.Second != 0 {
+		return 0, errors.Errorf("interval with fractional seconds for event is not supported")
+	}
+	return interval, nil
+}
+
+func buildTimerKey(schemaID int64, name string) string {
+	return fmt.Sprintf("%s%d/%s", timerKeyPrefix, schemaID, strings.ToLower(name))
+}
+
+// buildSchedPolicy returns the schedule policy and the initial watermark of the timer for an event.
+func buildSchedPolicy(info *Info) (tp timerapi.SchedPolicyType, expr string, watermark time.Time, err error) {
+	if info.IsOneTime() {
+		return timerapi.SchedEventOnce, info.ExecuteAt.UTC().Format(time.RFC3339), time.Time{}, nil
+	}
+
+	interval, err := info.Interval()
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+
+	if info.Starts != nil {
+		// The next event time of an interval timer is `watermark + interval`, so the first execution is at `STARTS`.
+		watermark = info.Starts.Add(-interval)
+		// The watermark is stored as a timestamp in the timer table.
+		if watermark.Unix() > math.MaxInt32 {
+			return "", "", time.Time{}, errors.Errorf("STARTS of event '%s' is out of the range of TIMESTAMP", info.Name)
+		}
+	}
+	return timerapi.SchedEventInterval, strconv.FormatInt(int64(interval/time.Second), 10) + "s", watermark, nil
+}
+
+func eventFromTimer(timer *timerapi.TimerRecord) (*Event, error) {
+	info, err := DecodeInfo(timer.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Event{
+		Info:    info,
+		timerID: timer.ID,
+	}
+	if err = fillSummary(e, timer); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// fillSummary fills the runtime status of the event from the summary data of its timer.
+func fillSummary(e *Event, timer *timerapi.TimerRecord) error {
+	if len(timer.SummaryData) == 0 {
+		return nil
+	}
+
+	var summary eventSummary
+	if err := json.Unmarshal(timer.SummaryData, &summary); err != nil {
+		return errors.Trace(err)
+	}
+	e.LastExecuted = &summary.LastExecuted
+	e.LastError = summary.LastError
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"testing"
+	"time"
+
+	timerapi "github.com/pingcap/tidb/timer/api"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterval(t *testing.T) {
+	cases := []struct {
+		value    string
+		field    string
+		interval time.Duration
+		err      string
+	}{
+		{value: "1", field: "SECOND", interval: time.Second},
+		{value: "5", field: "MINUTE", interval: 5 * time.Minute},
+		{value: "2", field: "HOUR", interval: 2 * time.Hour},
+		{value: "1", field: "DAY", interval: 24 * time.Hour},
+		{value: "1", field: "WEEK", interval: 7 * 24 * time.Hour},
+		{value: "1:30", field: "HOUR_MINUTE", interval: 90 * time.Minute},
+		{value: "1", field: "MONTH", err: "interval unit 'MONTH' for event is not supported"},
+		{value: "1", field: "YEAR_MONTH", err: "interval unit 'YEAR_MONTH' for event is not supported"},
+		{value: "0", field: "SECOND", err: exeerrors.ErrEventIntervalNotPositiveOrTooBig.GenWithStackByArgs().Error()},
+		{value: "0.5", field: "SECOND", err: "interval with fractional seconds for event is not supported"},
+	}
+
+	for _, c := range cases {
+		interval, err := ParseInterval(c.value, c.field)
+		if c.err != "" {
+			require.EqualError(t, err, c.err, "%s %s", c.value, c.field)
+			continue
+		}
+		require.NoError(t, err, "%s %s", c.value, c.field)
+		require.Equal(t, c.interval, interval, "%s %s", c.value, c.field)
+	}
+}
+
+func TestBuildSchedPolicy(t *testing.T) {
+	executeAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.FixedZone("", 8*3600))
+	tp, expr, watermark, err := buildSchedPolicy(&Info{Name: "e1", ExecuteAt: &executeAt})
+	require.NoError(t, err)
+	require.Equal(t, timerapi.SchedEventOnce, tp)
+	require.Equal(t, "2023-01-01T19:04:05Z", expr)
+	require.True(t, watermark.IsZero())
+
+	tp, expr, watermark, err = buildSchedPolicy(&Info{Name: "e1", IntervalValue: "1", IntervalField: "HOUR"})
+	require.NoError(t, err)
+	require.Equal(t, timerapi.SchedEventInterval, tp)
+	require.Equal(t, "3600s", expr)
+	require.True(t, watermark.IsZero())
+
+	starts := time.Date(2023, 1, 2, 3, 0, 0, 0, time.UTC)
+	tp, expr, watermark, err = buildSchedPolicy(&Info{Name: "e1", IntervalValue: "10", IntervalField: "MINUTE", Starts: &starts})
+	require.NoError(t, err)
+	require.Equal(t, timerapi.SchedEventInterval, tp)
+	require.Equal(t, "600s", expr)
+	require.Equal(t, starts.Add(-10*time.Minute), watermark)
+
+	starts = time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, _, err = buildSchedPolicy(&Info{Name: "e1", IntervalValue: "1", IntervalField: "DAY", Starts: &starts})
+	require.EqualError(t, err, "STARTS of event 'e1' is out of the range of TIMESTAMP")
+}
+
+func TestTimerKey(t *testing.T) {
+	require.Equal(t, "/tidb/event/12/e1", buildTimerKey(12, "E1"))
+	require.NotEqual(t, buildTimerKey(1, "e1"), buildTimerKey(12, "e1"))
+}
@@ -0,0 +1,234 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	timerapi "github.com/pingcap/tidb/timer/api"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+// Session is the session to execute the body of an event.
+type Session interface {
+	sessionctx.Context
+	// ExecuteStmt executes a parsed statement.
+	ExecuteStmt(ctx context.Context, stmt ast.StmtNode) (sqlexec.RecordSet, error)
+	// Close closes the session.
+	Close()
+}
+
+// SessionFactory creates a new session authenticated as the definer of an event.
+type SessionFactory func(definer *auth.UserIdentity) (Session, error)
+
+type eventTimerHook struct {
+	cli         timerapi.TimerClient
+	sessFactory SessionFactory
+	// complete is called when an event will never be triggered again.
+	complete func(ctx context.Context, e *Event) error
+	ctx      context.Context
+	cancel   func()
+	wg       sync.WaitGroup
+	nowFunc  func() time.Time
+
+	mu struct {
+		sync.Mutex
+		// running contains the ids of the timer events whose body is being executed
+		running map[string]struct{}
+	}
+}
+
+func newEventTimerHook(cli timerapi.TimerClient, sessFactory SessionFactory,
+	complete func(ctx context.Context, e *Event) error) *eventTimerHook {
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &eventTimerHook{
+		cli:         cli,
+		sessFactory: sessFactory,
+		complete:    complete,
+		ctx:         ctx,
+		cancel:      cancel,
+		nowFunc:     time.Now,
+	}
+	h.mu.running = make(map[string]struct{})
+	return h
+}
+
+func (h *eventTimerHook) Start() {}
+
+func (h *eventTimerHook) Stop() {
+	h.cancel()
+	h.wg.Wait()
+}
+
+func (h *eventTimerHook) OnPreSchedEvent(ctx context.Context, event timerapi.TimerShedEvent) (r timerapi.PreSchedEventResult, err error) {
+	timer := event.Timer()
+	e, err := eventFromTimer(timer)
+	if err != nil {
+		logutil.BgLogger().Error("invalid event timer data",
+			zap.String("timerID", timer.ID),
+			zap.String("timerKey", timer.Key),
+			zap.ByteString("data", timer.Data),
+		)
+		r.Delay = time.Minute
+		return r, nil
+	}
+
+	if !e.IsOneTime() && e.Expired(h.nowFunc()) {
+		// A recurring event passes its `ENDS` time, do not trigger it anymore.
+		if err = h.complete(ctx, e); err != nil {
+			return r, err
+		}
+		r.Delay = time.Minute
+	}
+	return r, nil
+}
+
+func (h *eventTimerHook) OnSchedEvent(_ context.Context, event timerapi.TimerShedEvent) error {
+	if err := h.ctx.Err(); err != nil {
+		return err
+	}
+
+	timer := event.Timer()
+	eventID := event.EventID()
+	e, err := eventFromTimer(timer)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.mu.running[eventID]; ok {
+		return nil
+	}
+	h.mu.running[eventID] = struct{}{}
+
+	logger := logutil.BgLogger().With(
+		zap.String("key", timer.Key),
+		zap.String("eventID", eventID),
+		zap.Time("eventStart", timer.EventStart),
+	)
+	logger.Info("timer triggered to execute event")
+	h.wg.Add(1)
+	go h.executeEvent(logger, e, eventID, timer.EventStart)
+	return nil
+}
+
+func (h *eventTimerHook) executeEvent(logger *zap.Logger, e *Event, eventID string, eventStart time.Time) {
+	defer func() {
+		h.mu.Lock()
+		delete(h.mu.running, eventID)
+		h.mu.Unlock()
+		h.wg.Done()
+	}()
+
+	summary := &eventSummary{
+		LastEventID:  eventID,
+		LastExecuted: h.nowFunc(),
+	}
+
+	if err := h.executeEventBody(e); err != nil {
+		logger.Warn("fail to execute event", zap.Error(err))
+		summary.LastError = err.Error()
+	}
+
+	summaryData, err := json.Marshal(summary)
+	if err != nil {
+		logger.Error("marshal summary error", zap.Error(err))
+		return
+	}
+
+	err = h.cli.CloseTimerEvent(h.ctx, e.timerID, eventID, timerapi.WithSetWatermark(eventStart), timerapi.WithSetSummaryData(summaryData))
+	if err != nil {
+		logger.Error("CloseTimerEvent error", zap.Error(err))
+		return
+	}
+
+	if e.IsOneTime() {
+		if err = h.complete(h.ctx, e); err != nil {
+			logger.Error("fail to complete one-time event", zap.Error(err))
+		}
+	}
+}
+
+func (h *eventTimerHook) executeEventBody(e *Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic when executing event: %v", r)
+		}
+	}()
+
+	se, err := h.sessFactory(&auth.UserIdentity{Username: e.DefinerUser, Hostname: e.DefinerHost})
+	if err != nil {
+		return err
+	}
+	defer se.Close()
+
+	is, ok := se.GetDomainInfoSchema().(infoschema.InfoSchema)
+	if !ok {
+		return errors.New("fail to get info schema")
+	}
+
+	schema, ok := is.SchemaByID(e.SchemaID)
+	if !ok {
+		return errors.Errorf("schema of event '%s' does not exist", e.Name)
+	}
+
+	vars := se.GetSessionVars()
+	vars.CurrentDB = schema.Name.O
+	if err = vars.SetSystemVar(variable.SQLModeVar, e.SQLMode); err != nil {
+		return err
+	}
+
+	if e.TimeZone != "" {
+		if err = vars.SetSystemVar(variable.TimeZone, e.TimeZone); err != nil {
+			return err
+		}
+	}
+
+	p := parser.New()
+	p.SetSQLMode(vars.SQLMode)
+	stmt, err := p.ParseOneStmt(e.Body, e.CharsetClient, e.CollationConnection)
+	if err != nil {
+		return err
+	}
+
+	rs, err := se.ExecuteStmt(h.ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	if rs != nil {
+		defer func() {
+			closeErr := rs.Close()
+			if err == nil {
+				err = closeErr
+			}
+		}()
+		_, err = sqlexec.DrainRecordSet(h.ctx, rs, vars.MaxChunkSize)
+	}
+	return err
+}
@@ -0,0 +1,379 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ngaut/pools"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx"
+	timerapi "github.com/pingcap/tidb/timer/api"
+	timerrt "github.com/pingcap/tidb/timer/runtime"
+	"github.com/pingcap/tidb/timer/tablestore"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const checkOwnerInterval = time.Second
+
+type sessionPool interface {
+	Get() (pools.Resource, error)
+	Put(pools.Resource)
+}
+
+// Manager manages the scheduled events. The meta of each event is stored in the meta of its schema by the DDL jobs.
+// The manager on the DDL owner keeps a timer for each event in sync with the meta and schedules the timers.
+type Manager struct {
+	ctx         context.Context
+	cancel      func()
+	wg          sync.WaitGroup
+	pool        sessionPool
+	kvStore     kv.Storage
+	store       *timerapi.TimerStore
+	cli         timerapi.TimerClient
+	sessFactory SessionFactory
+	isOwner     func() bool
+	// syncedVersion is the schema version with which the timers are synced last time.
+	syncedVersion int64
+}
+
+// NewManager creates a new event manager.
+func NewManager(pool sessionPool, kvStore kv.Storage, etcd *clientv3.Client, sessFactory SessionFactory, isOwner func() bool) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	store := tablestore.NewTableTimerStore(1, pool, "mysql", "tidb_timers", etcd)
+	return &Manager{
+		ctx:         ctx,
+		cancel:      cancel,
+		pool:        pool,
+		kvStore:     kvStore,
+		store:       store,
+		cli:         timerapi.NewDefaultTimerClient(store),
+		sessFactory: sessFactory,
+		isOwner:     isOwner,
+	}
+}
+
+// Start starts the manager.
+func (m *Manager) Start() {
+	m.wg.Add(1)
+	go m.loop()
+}
+
+// Stop stops the manager and waits for the running events to exit.
+func (m *Manager) Stop() {
+	m.cancel()
+	m.wg.Wait()
+	m.store.Close()
+}
+
+func (m *Manager) loop() {
+	defer m.wg.Done()
+	var rt *timerrt.TimerGroupRuntime
+	defer func() {
+		if rt != nil {
+			rt.Stop()
+		}
+		logutil.BgLogger().Info("event manager loop exited.")
+	}()
+
+	ticker := time.NewTicker(checkOwnerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		isOwner := m.isOwner != nil && m.isOwner()
+		if isOwner && rt == nil {
+			logutil.BgLogger().Info("start to schedule events")
+			rt = timerrt.NewTimerRuntimeBuilder("event", m.store).
+				SetCond(&timerapi.TimerCond{Key: timerapi.NewOptionalVal(timerKeyPrefix), KeyPrefix: true}).
+				RegisterHookFactory(timerHookClass, func(_ string, cli timerapi.TimerClient) timerapi.Hook {
+					return newEventTimerHook(cli, m.sessFactory, m.completeEvent)
+				}).
+				Build()
+			rt.Start()
+		} else if !isOwner && rt != nil {
+			logutil.BgLogger().Info("stop scheduling events because the current node is not the owner")
+			rt.Stop()
+			rt = nil
+			m.syncedVersion = 0
+		}
+
+		if isOwner {
+			ctx := kv.WithInternalSourceType(m.ctx, kv.InternalTxnDDL)
+			if err := m.syncTimers(ctx); err != nil {
+				logutil.BgLogger().Warn("fail to sync the timers of events", zap.Error(err))
+			}
+		}
+	}
+}
+
+// AttachStatus fills the runtime status of the events from the timers which schedule them.
+func (m *Manager) AttachStatus(ctx context.Context, events []*Event) error {
+	timers, err := m.cli.GetTimers(ctx, timerapi.WithKeyPrefix(timerKeyPrefix))
+	if err != nil {
+		return err
+	}
+
+	timerByKey := make(map[string]*timerapi.TimerRecord, len(timers))
+	for _, timer := range timers {
+		timerByKey[timer.Key] = timer
+	}
+
+	for _, e := range events {
+		timer, ok := timerByKey[buildTimerKey(e.SchemaID, e.Name)]
+		if !ok {
+			continue
+		}
+
+		if err = fillSummary(e, timer); err != nil {
+			logutil.BgLogger().Warn("invalid event timer summary", zap.String("timerKey", timer.Key), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// syncTimers makes the timers consistent with the events in the meta. The events are loaded only when the schema
+// version changes because every DDL job of an event bumps it.
+func (m *Manager) syncTimers(ctx context.Context) error {
+	var (
+		ver    int64
+		events []*Info
+	)
+	err := kv.RunInNewTxn(ctx, m.kvStore, false, func(_ context.Context, txn kv.Transaction) (err error) {
+		t := meta.NewMeta(txn)
+		if ver, err = t.GetSchemaVersion(); err != nil || ver == m.syncedVersion {
+			return err
+		}
+
+		dbs, err := t.ListDatabases()
+		if err != nil {
+			return err
+		}
+
+		events = events[:0]
+		for _, db := range dbs {
+			values, err := t.ListEvents(db.ID)
+			if err != nil {
+				return err
+			}
+
+			for _, value := range values {
+				info, err := DecodeInfo(value)
+				if err != nil {
+					logutil.BgLogger().Warn("invalid event meta", zap.Int64("schemaID", db.ID), zap.Error(err))
+					continue
+				}
+				events = append(events, info)
+			}
+		}
+		return nil
+	})
+	if err != nil || ver == m.syncedVersion {
+		return err
+	}
+
+	timers, err := m.cli.GetTimers(ctx, timerapi.WithKeyPrefix(timerKeyPrefix))
+	if err != nil {
+		return err
+	}
+
+	orphans := make(map[string]*timerapi.TimerRecord, len(timers))
+	for _, timer := range timers {
+		orphans[timer.Key] = timer
+	}
+
+	for _, info := range events {
+		key := buildTimerKey(info.SchemaID, info.Name)
+		timer, ok := orphans[key]
+		delete(orphans, key)
+
+		spec, err := buildTimerSpec(info)
+		if err != nil {
+			logutil.BgLogger().Warn("invalid event schedule", zap.String("timerKey", key), zap.Error(err))
+			continue
+		}
+
+		if !ok {
+			_, err = m.cli.CreateTimer(ctx, *spec)
+		} else if !bytes.Equal(timer.Data, spec.Data) {
+			err = m.updateTimer(ctx, timer, spec)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, timer := range orphans {
+		if _, err = m.cli.DeleteTimer(ctx, timer.ID); err != nil {
+			return err
+		}
+	}
+	m.syncedVersion = ver
+	return nil
+}
+
+// updateTimer updates the timer of an altered event. The schedule of the event restarts if it is changed.
+func (m *Manager) updateTimer(ctx context.Context, timer *timerapi.TimerRecord, spec *timerapi.TimerSpec) error {
+	opts := []timerapi.UpdateTimerOption{
+		timerapi.WithSetData(spec.Data),
+		timerapi.WithSetEnable(spec.Enable),
+	}
+
+	if schedChanged(timer, spec) {
+		opts = append(opts,
+			timerapi.WithSetSchedExpr(spec.SchedPolicyType, spec.SchedPolicyExpr),
+			timerapi.WithSetWatermark(spec.Watermark),
+			timerapi.WithSetTimeZone(spec.TimeZone),
+		)
+	}
+	return m.cli.UpdateTimer(ctx, timer.ID, opts...)
+}
+
+func schedChanged(timer *timerapi.TimerRecord, spec *timerapi.TimerSpec) bool {
+	old, err := DecodeInfo(timer.Data)
+	if err != nil {
+		return true
+	}
+
+	tp, expr, watermark, err := buildSchedPolicy(old)
+	return err != nil || tp != spec.SchedPolicyType || expr != spec.SchedPolicyExpr ||
+		!watermark.Equal(spec.Watermark) || old.TimeZone != spec.TimeZone
+}
+
+// completeEvent is called on the owner when an event will never be triggered again. The event is disabled if
+// `ON COMPLETION PRESERVE` is specified, otherwise it is dropped. Both are done by the DDL statements so that the
+// change is persisted in the meta of the event.
+func (m *Manager) completeEvent(ctx context.Context, e *Event) error {
+	if e.Preserve && !e.Enabled {
+		return nil
+	}
+
+	r, err := m.pool.Get()
+	if err != nil {
+		return err
+	}
+	defer m.pool.Put(r)
+
+	sctx, ok := r.(sessionctx.Context)
+	if !ok {
+		return errors.New("session is not the type sessionctx.Context")
+	}
+
+	is, ok := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+	if !ok {
+		return errors.New("fail to get info schema")
+	}
+
+	schema, ok := is.SchemaByID(e.SchemaID)
+	if !ok {
+		// The event has been dropped with its schema.
+		return nil
+	}
+
+	sql := "DROP EVENT IF EXISTS %n.%n"
+	if e.Preserve {
+		sql = "ALTER EVENT %n.%n DISABLE"
+	}
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnDDL)
+	rs, err := sctx.(sqlexec.SQLExecutor).ExecuteInternal(ctx, sql, schema.Name.O, e.Name)
+	if rs != nil {
+		terror.Call(rs.Close)
+	}
+	return err
+}
+
+// LoadEvent loads an event from the meta, nil is returned if the event does not exist.
+func LoadEvent(ctx context.Context, store kv.Storage, schemaID int64, name string) (info *Info, err error) {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnDDL)
+	err = kv.RunInNewTxn(ctx, store, false, func(_ context.Context, txn kv.Transaction) error {
+		value, err := meta.NewMeta(txn).GetEvent(schemaID, name)
+		if err != nil || value == nil {
+			return err
+		}
+		info, err = DecodeInfo(value)
+		return err
+	})
+	return info, err
+}
+
+// LoadEvents loads all the events in the specified schemas from the meta, the schemas not in the meta are skipped.
+func LoadEvents(ctx context.Context, store kv.Storage, schemaIDs []int64) (events []*Info, err error) {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnDDL)
+	err = kv.RunInNewTxn(ctx, store, false, func(_ context.Context, txn kv.Transaction) error {
+		t := meta.NewMeta(txn)
+		events = events[:0]
+		for _, schemaID := range schemaIDs {
+			values, err := t.ListEvents(schemaID)
+			if meta.ErrDBNotExists.Equal(err) {
+				// The memory schemas such as `INFORMATION_SCHEMA` are not in the meta.
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			for _, value := range values {
+				info, err := DecodeInfo(value)
+				if err != nil {
+					return err
+				}
+				events = append(events, info)
+			}
+		}
+		return nil
+	})
+	return events, err
+}
+
+func buildTimerSpec(e *Info) (*timerapi.TimerSpec, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+
+	tp, expr, watermark, err := buildSchedPolicy(e)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := e.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &timerapi.TimerSpec{
+		Key:             buildTimerKey(e.SchemaID, e.Name),
+		Data:            data,
+		TimeZone:        e.TimeZone,
+		SchedPolicyType: tp,
+		SchedPolicyExpr: expr,
+		HookClass:       timerHookClass,
+		Watermark:       watermark,
+		Enable:          e.Enabled,
+	}, nil
+}
@@ -373,8 +373,13 @@ func (a *ExecStmt) IsPrepared() bool {
 // IsReadOnly returns true if a statement is read only.
 // If current StmtNode is an ExecuteStmt, we can get its prepared stmt,
 // then using ast.IsReadOnly function to determine a statement is read only or not.
+// The statement isn't read only either if the stored functions called by it have modified the data.
 func (a *ExecStmt) IsReadOnly(vars *variable.SessionVars) bool {
-	return planner.IsReadOnly(a.StmtNode, vars)
+	if _, ok := a.Plan.(*plannercore.Call); ok {
+		// The statements of the procedure are committed and recorded in the retry history by themselves.
+		return true
+	}
+	return planner.IsReadOnly(a.StmtNode, vars) && !vars.StmtCtx.StoredFunctions.ModifiesData
 }
 
 // RebuildPlan rebuilds current execute statement plan.
@@ -787,7 +792,8 @@ func (a *ExecStmt) handleNoDelay(ctx context.Context, e exec.Executor, isPessimi
 	// If the executor doesn't return any result to the client, we execute it without delay.
 	if toCheck.Schema().Len() == 0 {
 		handled = !isExplainAnalyze
-		if isPessimistic {
+		// The statements of the procedure called by the `CALL` statement handle the pessimistic locks by themselves.
+		if _, isCall := toCheck.(*CallExec); isPessimistic && !isCall {
 			err := a.handlePessimisticDML(ctx, toCheck)
 			return handled, nil, err
 		}
@@ -1373,6 +1379,7 @@ func (a *ExecStmt) FinishExecuteStmt(txnTS uint64, err error, hasMoreResults boo
 	// `LowSlowQuery` and `SummaryStmt` must be called before recording `PrevStmt`.
 	a.LogSlowQuery(txnTS, succ, hasMoreResults)
 	a.SummaryStmt(succ)
+	a.recordQueryFeedback(succ)
 	a.observeStmtFinishedForTopSQL()
 	if sessVars.StmtCtx.IsTiFlash.Load() {
 		if succ {
@@ -1745,6 +1752,35 @@ func getPlanTree(stmtCtx *stmtctx.StatementContext) string {
 	return variable.SlowLogPlanPrefix + planTree + variable.SlowLogPlanSuffix
 }
 
+// recordQueryFeedback records the operators whose estimated row counts are far from the actual ones when
+// tidb_enable_query_feedback is on.
+func (a *ExecStmt) recordQueryFeedback(succ bool) {
+	sessVars := a.Ctx.GetSessionVars()
+	if !succ || !sessVars.EnableQueryFeedback || sessVars.InRestrictedSQL || a.Plan == nil {
+		return
+	}
+	feedback := plannercore.CollectQueryFeedback(a.Ctx, a.Plan)
+	if len(feedback) == 0 {
+		return
+	}
+	h := domain.GetDomain(a.Ctx).StatsHandle()
+	if h == nil {
+		return
+	}
+	stmtCtx := sessVars.StmtCtx
+	_, sqlDigest := stmtCtx.SQLDigest()
+	_, planDigest := GetPlanDigest(stmtCtx)
+	for _, fb := range feedback {
+		if sqlDigest != nil {
+			fb.SQLDigest = sqlDigest.String()
+		}
+		if planDigest != nil {
+			fb.PlanDigest = planDigest.String()
+		}
+	}
+	h.RecordQueryFeedback(feedback)
+}
+
 // GetPlanDigest will try to get the select plan tree if the plan is select or the select plan of delete/update/insert statement.
 func GetPlanDigest(stmtCtx *stmtctx.StatementContext) (string, *parser.Digest) {
 	normalized, planDigest := stmtCtx.GetPlanDigest()
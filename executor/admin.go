@@ -624,7 +624,7 @@ func (e *CleanupIndexExec) batchGetRecord(txn kv.Transaction) (map[string][]byte
 	return values, nil
 }
 
-func (e *CleanupIndexExec) deleteDanglingIdx(txn kv.Transaction, values map[string][]byte) error {
+func (e *CleanupIndexExec) deleteDanglingIdx(ctx context.Context, txn kv.Transaction, values map[string][]byte) error {
 	for _, k := range e.batchKeys {
 		if _, found := values[string(k)]; !found {
 			_, handle, err := tablecodec.DecodeRecordKey(k)
@@ -636,7 +636,7 @@ func (e *CleanupIndexExec) deleteDanglingIdx(txn kv.Transaction, values map[stri
 				return errors.Trace(errors.Errorf("batch keys are inconsistent with handles"))
 			}
 			for _, handleIdxVals := range handleIdxValsGroup.([][]types.Datum) {
-				if err := e.index.Delete(e.Ctx().GetSessionVars().StmtCtx, txn, handleIdxVals, handle); err != nil {
+				if err := e.index.Delete(e.Ctx().GetSessionVars().StmtCtx, txn, handleIdxVals, handle, table.DeleteWithCtx(ctx)); err != nil {
 					return err
 				}
 				e.removeCnt++
@@ -764,7 +764,7 @@ func (e *CleanupIndexExec) cleanTableIndex(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
-			err = e.deleteDanglingIdx(txn, values)
+			err = e.deleteDanglingIdx(ctx, txn, values)
 			if err != nil {
 				return err
 			}
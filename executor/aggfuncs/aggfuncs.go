@@ -212,3 +212,46 @@ type MaxMinSlidingWindowAggFunc interface {
 	// SetWindowStart sets the start position of window
 	SetWindowStart(start uint64)
 }
+
+// PartitionRows provides random access to the rows of a window partition, the rows may have been spilled to disk.
+type PartitionRows interface {
+	// NumRows returns the number of rows in the partition.
+	NumRows() uint64
+	// GetRow returns the idx-th row of the partition.
+	GetRow(idx uint64) (chunk.Row, error)
+}
+
+// PartitionRowsWindowFunc is the interface of the window functions which access the other rows of the partition to
+// calculate the result of the current row, e.g. LEAD and LAG. Instead of keeping the rows in the partial result by
+// UpdatePartialResult, the window executor sets the rows of the whole partition by SetPartitionRows, so that the
+// executor can spill the rows to disk.
+type PartitionRowsWindowFunc interface {
+	// SetPartitionRows sets the rows of the partition, which are read by AppendFinalResult2Chunk later.
+	SetPartitionRows(rows PartitionRows, pr PartialResult)
+}
+
+// windowRows keeps the rows of a partition for the window functions, which are either appended by
+// UpdatePartialResult or set by SetPartitionRows.
+type windowRows struct {
+	rows      []chunk.Row
+	partition PartitionRows
+}
+
+func (w *windowRows) numRows() uint64 {
+	if w.partition != nil {
+		return w.partition.NumRows()
+	}
+	return uint64(len(w.rows))
+}
+
+func (w *windowRows) getRow(idx uint64) (chunk.Row, error) {
+	if w.partition != nil {
+		return w.partition.GetRow(idx)
+	}
+	return w.rows[idx], nil
+}
+
+func (w *windowRows) reset() {
+	w.rows = w.rows[:0]
+	w.partition = nil
+}
@@ -32,9 +32,9 @@ type cumeDist struct {
 }
 
 type partialResult4CumeDist struct {
-	curIdx   int
-	lastRank int
-	rows     []chunk.Row
+	windowRows
+	curIdx   uint64
+	lastRank uint64
 }
 
 func (*cumeDist) AllocPartialResult() (pr PartialResult, memDelta int64) {
@@ -45,7 +45,7 @@ func (*cumeDist) ResetPartialResult(pr PartialResult) {
 	p := (*partialResult4CumeDist)(pr)
 	p.curIdx = 0
 	p.lastRank = 0
-	p.rows = p.rows[:0]
+	p.reset()
 }
 
 func (*cumeDist) UpdatePartialResult(_ sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) (memDelta int64, err error) {
@@ -55,10 +55,26 @@ func (*cumeDist) UpdatePartialResult(_ sessionctx.Context, rowsInGroup []chunk.R
 	return memDelta, nil
 }
 
+func (*cumeDist) SetPartitionRows(rows PartitionRows, pr PartialResult) {
+	p := (*partialResult4CumeDist)(pr)
+	p.partition = rows
+}
+
 func (r *cumeDist) AppendFinalResult2Chunk(_ sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
 	p := (*partialResult4CumeDist)(pr)
-	numRows := len(p.rows)
-	for p.lastRank < numRows && r.compareRows(p.rows[p.curIdx], p.rows[p.lastRank]) == 0 {
+	numRows := p.numRows()
+	curRow, err := p.getRow(p.curIdx)
+	if err != nil {
+		return err
+	}
+	for p.lastRank < numRows {
+		row, err := p.getRow(p.lastRank)
+		if err != nil {
+			return err
+		}
+		if r.compareRows(curRow, row) != 0 {
+			break
+		}
 		p.lastRank++
 	}
 	p.curIdx++
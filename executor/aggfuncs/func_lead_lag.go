@@ -36,7 +36,7 @@ type baseLeadLag struct {
 }
 
 type partialResult4LeadLag struct {
-	rows   []chunk.Row
+	windowRows
 	curIdx uint64
 }
 
@@ -46,7 +46,7 @@ func (*baseLeadLag) AllocPartialResult() (pr PartialResult, memDelta int64) {
 
 func (*baseLeadLag) ResetPartialResult(pr PartialResult) {
 	p := (*partialResult4LeadLag)(pr)
-	p.rows = p.rows[:0]
+	p.reset()
 	p.curIdx = 0
 }
 
@@ -57,21 +57,28 @@ func (*baseLeadLag) UpdatePartialResult(_ sessionctx.Context, rowsInGroup []chun
 	return memDelta, nil
 }
 
+func (*baseLeadLag) SetPartitionRows(rows PartitionRows, pr PartialResult) {
+	p := (*partialResult4LeadLag)(pr)
+	p.partition = rows
+}
+
 type lead struct {
 	baseLeadLag
 }
 
 func (v *lead) AppendFinalResult2Chunk(sctx sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
 	p := (*partialResult4LeadLag)(pr)
-	var err error
-	if p.curIdx+v.offset < uint64(len(p.rows)) {
-		_, err = v.evaluateRow(sctx, v.args[0], p.rows[p.curIdx+v.offset])
-	} else {
-		_, err = v.evaluateRow(sctx, v.defaultExpr, p.rows[p.curIdx])
+	expr, idx := v.defaultExpr, p.curIdx
+	if p.curIdx+v.offset < p.numRows() {
+		expr, idx = v.args[0], p.curIdx+v.offset
 	}
+	row, err := p.getRow(idx)
 	if err != nil {
 		return err
 	}
+	if _, err = v.evaluateRow(sctx, expr, row); err != nil {
+		return err
+	}
 	v.appendResult(chk, v.ordinal)
 	p.curIdx++
 	return nil
@@ -83,15 +90,17 @@ type lag struct {
 
 func (v *lag) AppendFinalResult2Chunk(sctx sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
 	p := (*partialResult4LeadLag)(pr)
-	var err error
+	expr, idx := v.defaultExpr, p.curIdx
 	if p.curIdx >= v.offset {
-		_, err = v.evaluateRow(sctx, v.args[0], p.rows[p.curIdx-v.offset])
-	} else {
-		_, err = v.evaluateRow(sctx, v.defaultExpr, p.rows[p.curIdx])
+		expr, idx = v.args[0], p.curIdx-v.offset
 	}
+	row, err := p.getRow(idx)
 	if err != nil {
 		return err
 	}
+	if _, err = v.evaluateRow(sctx, expr, row); err != nil {
+		return err
+	}
 	v.appendResult(chk, v.ordinal)
 	p.curIdx++
 	return nil
@@ -34,7 +34,7 @@ func (*percentRank) ResetPartialResult(partial PartialResult) {
 	p := (*partialResult4Rank)(partial)
 	p.curIdx = 0
 	p.lastRank = 0
-	p.rows = p.rows[:0]
+	p.reset()
 }
 
 func (*percentRank) UpdatePartialResult(_ sessionctx.Context, rowsInGroup []chunk.Row, partial PartialResult) (memDelta int64, err error) {
@@ -44,16 +44,25 @@ func (*percentRank) UpdatePartialResult(_ sessionctx.Context, rowsInGroup []chun
 	return memDelta, nil
 }
 
+func (*percentRank) SetPartitionRows(rows PartitionRows, partial PartialResult) {
+	p := (*partialResult4Rank)(partial)
+	p.partition = rows
+}
+
 func (pr *percentRank) AppendFinalResult2Chunk(_ sessionctx.Context, partial PartialResult, chk *chunk.Chunk) error {
 	p := (*partialResult4Rank)(partial)
-	numRows := int64(len(p.rows))
+	numRows := int64(p.numRows())
 	p.curIdx++
 	if p.curIdx == 1 {
 		p.lastRank = 1
 		chk.AppendFloat64(pr.ordinal, 0)
 		return nil
 	}
-	if pr.compareRows(p.rows[p.curIdx-2], p.rows[p.curIdx-1]) == 0 {
+	same, err := p.isSameAsPrev(&pr.rowComparer)
+	if err != nil {
+		return err
+	}
+	if same {
 		chk.AppendFloat64(pr.ordinal, float64(p.lastRank-1)/float64(numRows-1))
 		return nil
 	}
@@ -34,9 +34,22 @@ type rank struct {
 }
 
 type partialResult4Rank struct {
+	windowRows
 	curIdx   int64
 	lastRank int64
-	rows     []chunk.Row
+}
+
+// isSameAsPrev reports whether the current row has the same order by values as the previous row.
+func (p *partialResult4Rank) isSameAsPrev(rc *rowComparer) (bool, error) {
+	prev, err := p.getRow(uint64(p.curIdx - 2))
+	if err != nil {
+		return false, err
+	}
+	cur, err := p.getRow(uint64(p.curIdx - 1))
+	if err != nil {
+		return false, err
+	}
+	return rc.compareRows(prev, cur) == 0, nil
 }
 
 func (*rank) AllocPartialResult() (pr PartialResult, memDelta int64) {
@@ -47,7 +60,7 @@ func (*rank) ResetPartialResult(pr PartialResult) {
 	p := (*partialResult4Rank)(pr)
 	p.curIdx = 0
 	p.lastRank = 0
-	p.rows = p.rows[:0]
+	p.reset()
 }
 
 func (*rank) UpdatePartialResult(_ sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) (memDelta int64, err error) {
@@ -57,6 +70,11 @@ func (*rank) UpdatePartialResult(_ sessionctx.Context, rowsInGroup []chunk.Row,
 	return memDelta, nil
 }
 
+func (*rank) SetPartitionRows(rows PartitionRows, pr PartialResult) {
+	p := (*partialResult4Rank)(pr)
+	p.partition = rows
+}
+
 func (r *rank) AppendFinalResult2Chunk(_ sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
 	p := (*partialResult4Rank)(pr)
 	p.curIdx++
@@ -65,7 +83,11 @@ func (r *rank) AppendFinalResult2Chunk(_ sessionctx.Context, pr PartialResult, c
 		chk.AppendInt64(r.ordinal, p.lastRank)
 		return nil
 	}
-	if r.compareRows(p.rows[p.curIdx-2], p.rows[p.curIdx-1]) == 0 {
+	same, err := p.isSameAsPrev(&r.rowComparer)
+	if err != nil {
+		return err
+	}
+	if same {
 		chk.AppendInt64(r.ordinal, p.lastRank)
 		return nil
 	}
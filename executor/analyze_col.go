@@ -60,6 +60,8 @@ type AnalyzeColumnsExec struct {
 	schemaForVirtualColEval *expression.Schema
 	baseCount               int64
 	baseModifyCnt           int64
+	// jointStatsColIDs are the IDs of the columns whose joint statistics need to be collected.
+	jointStatsColIDs []int64
 
 	memTracker *memory.Tracker
 }
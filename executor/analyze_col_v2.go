@@ -41,6 +41,7 @@ import (
 	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/mathutil"
 	"github.com/pingcap/tidb/util/memory"
 	"github.com/pingcap/tidb/util/ranger"
 	"github.com/pingcap/tidb/util/timeutil"
@@ -439,6 +440,21 @@ func (e *AnalyzeColumnsExecV2) buildSamplingStats(
 			return 0, nil, nil, nil, nil, err
 		}
 	}
+	if len(e.jointStatsColIDs) > 0 {
+		numTopN := mathutil.Min(int(e.opts[ast.AnalyzeOptNumTopN]), statistics.MaxJointStatsTopN)
+		jointStats, err := statistics.BuildJointStats(sc, e.colsInfo, e.jointStatsColIDs, rootRowCollector.Base().Samples, count, numTopN)
+		if err != nil {
+			return 0, nil, nil, nil, nil, err
+		}
+		item := &statistics.ExtendedStatsItem{Tp: ast.StatsTypeJoint, ColIDs: e.jointStatsColIDs}
+		if item.StringVals, err = jointStats.Encode(); err != nil {
+			return 0, nil, nil, nil, nil, err
+		}
+		if extStats == nil {
+			extStats = statistics.NewExtendedStatsColl()
+		}
+		extStats.Stats[statistics.JointStatsName(e.jointStatsColIDs)] = item
+	}
 
 	return
 }
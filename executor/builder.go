@@ -42,6 +42,7 @@ import (
 	"github.com/pingcap/tidb/executor/internal/builder"
 	"github.com/pingcap/tidb/executor/internal/calibrateresource"
 	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/executor/internal/indexadvisor"
 	"github.com/pingcap/tidb/executor/internal/pdhelper"
 	"github.com/pingcap/tidb/executor/internal/querywatch"
 	"github.com/pingcap/tidb/executor/internal/vecgroupchecker"
@@ -112,6 +113,10 @@ type executorBuilder struct {
 
 	// Used when building MPPGather.
 	encounterUnionScan bool
+
+	// triggerTables are the IDs of the tables whose triggers are being fired when building the executors for the
+	// statements in the triggers.
+	triggerTables []int64
 }
 
 // CTEStorages stores resTbl and iterInTbl for CTEExec.
@@ -247,6 +252,8 @@ func (b *executorBuilder) build(p plannercore.Plan) exec.Executor {
 		return b.buildSet(v)
 	case *plannercore.SetConfig:
 		return b.buildSetConfig(v)
+	case *plannercore.Call:
+		return b.buildCall(v)
 	case *plannercore.PhysicalSort:
 		return b.buildSort(v)
 	case *plannercore.PhysicalTopN:
@@ -279,6 +286,8 @@ func (b *executorBuilder) build(p plannercore.Plan) exec.Executor {
 		return b.buildMemTable(v)
 	case *plannercore.PhysicalTableDual:
 		return b.buildTableDual(v)
+	case *plannercore.PhysicalJSONTable:
+		return b.buildJSONTable(v)
 	case *plannercore.PhysicalApply:
 		return b.buildApply(v)
 	case *plannercore.PhysicalMaxOneRow:
@@ -289,6 +298,10 @@ func (b *executorBuilder) build(p plannercore.Plan) exec.Executor {
 		return b.buildTableReader(v)
 	case *plannercore.PhysicalTableSample:
 		return b.buildTableSample(v)
+	case *plannercore.PhysicalFullTextIndexReader:
+		return b.buildFullTextIndexReader(v)
+	case *plannercore.PhysicalVectorIndexReader:
+		return b.buildVectorIndexReader(v)
 	case *plannercore.PhysicalIndexReader:
 		return b.buildIndexReader(v)
 	case *plannercore.PhysicalIndexLookUpReader:
@@ -595,6 +608,10 @@ func (b *executorBuilder) buildRecoverIndex(v *plannercore.RecoverIndex) exec.Ex
 		b.err = errors.Errorf("secondary index `%v` is not found in table `%v`", v.IndexName, v.Table.Name.O)
 		return nil
 	}
+	if index.Meta().IsSearchIndex() {
+		b.err = errors.Errorf("recovering full-text or vector index `%v` is not supported", v.IndexName)
+		return nil
+	}
 	var hasGenedCol bool
 	for _, iCol := range index.Meta().Columns {
 		if tblInfo.Columns[iCol.Offset].IsGenerated() {
@@ -663,6 +680,10 @@ func (b *executorBuilder) buildCleanupIndex(v *plannercore.CleanupIndex) exec.Ex
 		b.err = errors.Errorf("secondary index `%v` is not found in table `%v`", v.IndexName, v.Table.Name.O)
 		return nil
 	}
+	if index.Meta().IsSearchIndex() {
+		b.err = errors.Errorf("cleaning up full-text or vector index `%v` is not supported", v.IndexName)
+		return nil
+	}
 	e := &CleanupIndexExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
 		columns:      buildIdxColsConcatHandleCols(tblInfo, index.Meta(), false),
@@ -858,6 +879,7 @@ func (b *executorBuilder) buildShow(v *plannercore.PhysicalShow) exec.Executor {
 		CountWarningsOrErrors: v.CountWarningsOrErrors,
 		DBName:                model.NewCIStr(v.DBName),
 		Table:                 v.Table,
+		Procedure:             v.Procedure,
 		Partition:             v.Partition,
 		Column:                v.Column,
 		IndexName:             v.IndexName,
@@ -919,6 +941,12 @@ func (b *executorBuilder) buildSimple(v *plannercore.Simple) exec.Executor {
 			WorkloadType: s.Tp,
 			OptionList:   s.DynamicCalibrateResourceOptionList,
 		}
+	case *ast.RecommendIndexStmt:
+		return &indexadvisor.Executor{
+			BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), 0),
+			SQL:          s.SQL,
+			Options:      s.Options,
+		}
 	case *ast.AddQueryWatchStmt:
 		return &querywatch.AddExecutor{
 			BaseExecutor:         exec.NewBaseExecutor(b.ctx, v.Schema(), 0),
@@ -966,6 +994,17 @@ func (b *executorBuilder) buildSetConfig(v *plannercore.SetConfig) exec.Executor
 	}
 }
 
+func (b *executorBuilder) buildCall(v *plannercore.Call) exec.Executor {
+	return &CallExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		is:           b.is,
+		dbName:       v.DBName,
+		name:         v.Name,
+		args:         v.Args,
+		outVars:      v.OutVars,
+	}
+}
+
 func (b *executorBuilder) buildInsert(v *plannercore.Insert) exec.Executor {
 	b.inInsertStmt = true
 	if b.err = b.updateForUpdateTS(); b.err != nil {
@@ -1008,6 +1047,17 @@ func (b *executorBuilder) buildInsert(v *plannercore.Insert) exec.Executor {
 	if b.err != nil {
 		return nil
 	}
+	ivs.triggers, b.err = b.buildTriggerExec(ivs.Table)
+	if b.err != nil {
+		return nil
+	}
+	op := "INSERT"
+	if v.IsReplace {
+		op = "REPLACE"
+	}
+	if b.err = checkMaterializedViewWrite(b.ctx, ivs.Table.Meta(), op); b.err != nil {
+		return nil
+	}
 
 	if v.IsReplace {
 		return b.buildReplace(ivs)
@@ -1029,6 +1079,12 @@ func (b *executorBuilder) buildImportInto(v *plannercore.ImportInto) exec.Execut
 		b.err = plannercore.ErrNonUpdatableTable.GenWithStackByArgs(tbl.Meta().Name.O, "LOAD")
 		return nil
 	}
+	// The rows are imported into the storage directly without being recorded in the logs of the materialized views,
+	// so the base tables of the views are not allowed either.
+	if tbl.Meta().IsMaterializedView() || tbl.Meta().MaterializedViewLog != nil || len(tbl.Meta().MaterializedViews) > 0 {
+		b.err = plannercore.ErrNonUpdatableTable.GenWithStackByArgs(tbl.Meta().Name.O, "LOAD")
+		return nil
+	}
 
 	base := exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID())
 	exec, err := newImportIntoExec(base, b.ctx, v, tbl)
@@ -1050,6 +1106,9 @@ func (b *executorBuilder) buildLoadData(v *plannercore.LoadData) exec.Executor {
 		b.err = plannercore.ErrNonUpdatableTable.GenWithStackByArgs(tbl.Meta().Name.O, "LOAD")
 		return nil
 	}
+	if b.err = checkMaterializedViewWrite(b.ctx, tbl.Meta(), "LOAD"); b.err != nil {
+		return nil
+	}
 
 	base := exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID())
 	worker, err := NewLoadDataWorker(b.ctx, v, tbl)
@@ -1057,6 +1116,10 @@ func (b *executorBuilder) buildLoadData(v *plannercore.LoadData) exec.Executor {
 		b.err = err
 		return nil
 	}
+	worker.triggers, b.err = b.buildTriggerExec(tbl)
+	if b.err != nil {
+		return nil
+	}
 
 	return &LoadDataExec{
 		BaseExecutor:   base,
@@ -1470,6 +1533,16 @@ func (b *executorBuilder) buildUnionScanFromReader(reader exec.Executor, v *plan
 		us.columns = x.columns
 		us.table = x.table
 		us.virtualColumnIndex = buildVirtualColumnIndex(us.Schema(), us.columns)
+	case *FullTextIndexReaderExec:
+		us.conditions, us.conditionsWithVirCol = plannercore.SplitSelCondsWithVirtualColumn(v.Conditions)
+		us.columns = x.columns
+		us.table = x.table
+		us.virtualColumnIndex = buildVirtualColumnIndex(us.Schema(), us.columns)
+	case *VectorIndexReaderExec:
+		us.conditions, us.conditionsWithVirCol = plannercore.SplitSelCondsWithVirtualColumn(v.Conditions)
+		us.columns = x.columns
+		us.table = x.table
+		us.virtualColumnIndex = buildVirtualColumnIndex(us.Schema(), us.columns)
 	default:
 		// The mem table will not be written by sql directly, so we can omit the union scan to avoid err reporting.
 		return originReader
@@ -1687,6 +1760,9 @@ func (b *executorBuilder) buildHashJoin(v *plannercore.PhysicalHashJoin) exec.Ex
 	}
 	e.buildWorker.buildKeyColIdx, e.buildWorker.buildNAKeyColIdx, e.buildWorker.buildSideExec, e.buildWorker.hashJoinCtx = buildKeyColIdx, buildNAKeyColIdx, buildSideExec, e.hashJoinCtx
 	e.hashJoinCtx.isNullAware = isNAJoin
+	if !v.UseOuterToBuild {
+		e.hotKeys = v.HotKeys
+	}
 	executor_metrics.ExecutorCountHashJoinExec.Inc()
 
 	// We should use JoinKey to construct the type information using by hashing, instead of using the child's schema directly.
@@ -1906,6 +1982,56 @@ func (b *executorBuilder) buildTableDual(v *plannercore.PhysicalTableDual) exec.
 	return e
 }
 
+func (b *executorBuilder) buildJSONTable(v *plannercore.PhysicalJSONTable) exec.Executor {
+	path, err := types.ParseJSONPathExpr(v.Path)
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	columns, err := buildJSONTableColumns(v.Columns, v.Schema())
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	return &JSONTableExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		expr:         v.Expr,
+		path:         path,
+		columns:      columns,
+	}
+}
+
+func buildJSONTableColumns(cols []*plannercore.JSONTableColumn, schema *expression.Schema) ([]*jsonTableColumn, error) {
+	result := make([]*jsonTableColumn, 0, len(cols))
+	for _, col := range cols {
+		c := &jsonTableColumn{
+			tp:      col.Tp,
+			name:    col.Name,
+			offset:  col.Offset,
+			onEmpty: col.OnEmpty,
+			onError: col.OnError,
+		}
+		if col.Tp != ast.JSONTableColumnOrdinality {
+			path, err := types.ParseJSONPathExpr(col.Path)
+			if err != nil {
+				return nil, err
+			}
+			c.path = path
+		}
+		if col.Tp == ast.JSONTableColumnNested {
+			nested, err := buildJSONTableColumns(col.NestedColumns, schema)
+			if err != nil {
+				return nil, err
+			}
+			c.nestedColumns = nested
+		} else {
+			c.retTp = schema.Columns[col.Offset].RetType
+		}
+		result = append(result, c)
+	}
+	return result, nil
+}
+
 // `getSnapshotTS` returns for-update-ts if in insert/update/delete/lock statement otherwise the isolation read ts
 // Please notice that in RC isolation, the above two ts are the same
 func (b *executorBuilder) getSnapshotTS() (ts uint64, err error) {
@@ -2079,6 +2205,9 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Ex
 			strings.ToLower(infoschema.TableStatistics),
 			strings.ToLower(infoschema.TableTiDBIndexes),
 			strings.ToLower(infoschema.TableViews),
+			strings.ToLower(infoschema.TableEvents),
+			strings.ToLower(infoschema.TableRoutines),
+			strings.ToLower(infoschema.TableTriggers),
 			strings.ToLower(infoschema.TableTables),
 			strings.ToLower(infoschema.TableReferConst),
 			strings.ToLower(infoschema.TableSequences),
@@ -2117,7 +2246,10 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Ex
 			strings.ToLower(infoschema.ClusterTableMemoryUsageOpsHistory),
 			strings.ToLower(infoschema.TableResourceGroups),
 			strings.ToLower(infoschema.TableRunawayWatches),
-			strings.ToLower(infoschema.TableCheckConstraints):
+			strings.ToLower(infoschema.TableCheckConstraints),
+			strings.ToLower(infoschema.TableQueryFeedback),
+			strings.ToLower(infoschema.ClusterTableQueryFeedback),
+			strings.ToLower(infoschema.TableInstancePlanCache):
 			return &MemTableReaderExec{
 				BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
 				table:        v.Table,
@@ -2244,6 +2376,7 @@ func (b *executorBuilder) buildSort(v *plannercore.PhysicalSort) exec.Executor {
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID(), childExec),
 		ByItems:      v.ByItems,
 		schema:       v.Schema(),
+		concurrency:  b.ctx.GetSessionVars().SortConcurrency(),
 	}
 	executor_metrics.ExecutorCounterSortExec.Inc()
 	return &sortExec
@@ -2505,6 +2638,15 @@ func (b *executorBuilder) buildUpdate(v *plannercore.Update) exec.Executor {
 	if b.err != nil {
 		return nil
 	}
+	updateExec.triggers, b.err = b.buildTblID2TriggerExec(tblID2table)
+	if b.err != nil {
+		return nil
+	}
+	for _, tbl := range tblID2table {
+		if b.err = checkMaterializedViewWrite(b.ctx, tbl.Meta(), "UPDATE"); b.err != nil {
+			return nil
+		}
+	}
 	return updateExec
 }
 
@@ -2557,6 +2699,15 @@ func (b *executorBuilder) buildDelete(v *plannercore.Delete) exec.Executor {
 	if b.err != nil {
 		return nil
 	}
+	deleteExec.triggers, b.err = b.buildTblID2TriggerExec(tblID2table)
+	if b.err != nil {
+		return nil
+	}
+	for _, tbl := range tblID2table {
+		if b.err = checkMaterializedViewWrite(b.ctx, tbl.Meta(), "DELETE"); b.err != nil {
+			return nil
+		}
+	}
 	return deleteExec
 }
 
@@ -2771,6 +2922,7 @@ func (b *executorBuilder) buildAnalyzeSamplingPushdown(
 		schemaForVirtualColEval: schemaForVirtualColEval,
 		baseCount:               count,
 		baseModifyCnt:           modifyCount,
+		jointStatsColIDs:        task.JointStatsColIDs,
 	}
 	e.analyzePB.ColReq = &tipb.AnalyzeColumnsReq{
 		BucketSize:   int64(opts[ast.AnalyzeOptNumBuckets]),
@@ -3799,11 +3951,14 @@ func (builder *dataReaderBuilder) prunePartitionForInnerExecutor(tbl table.Table
 }
 
 func buildNoRangeIndexReader(b *executorBuilder, v *plannercore.PhysicalIndexReader) (*IndexReaderExecutor, error) {
+	is := v.IndexPlans[0].(*plannercore.PhysicalIndexScan)
+	if err := validCanReadIndex(is.Index); err != nil {
+		return nil, err
+	}
 	dagReq, err := builder.ConstructDAGReq(b.ctx, v.IndexPlans, kv.TiKV)
 	if err != nil {
 		return nil, err
 	}
-	is := v.IndexPlans[0].(*plannercore.PhysicalIndexScan)
 	tbl, _ := b.is.TableByID(is.Table.ID)
 	isPartition, physicalTableID := is.IsPartition()
 	if isPartition {
@@ -3975,6 +4130,9 @@ func buildIndexReq(ctx sessionctx.Context, columns []*model.IndexColumn, handleL
 
 func buildNoRangeIndexLookUpReader(b *executorBuilder, v *plannercore.PhysicalIndexLookUpReader) (*IndexLookUpExecutor, error) {
 	is := v.IndexPlans[0].(*plannercore.PhysicalIndexScan)
+	if err := validCanReadIndex(is.Index); err != nil {
+		return nil, err
+	}
 	var handleLen int
 	if len(v.CommonHandleCols) != 0 {
 		handleLen = len(v.CommonHandleCols)
@@ -4131,6 +4289,9 @@ func buildNoRangeIndexMergeReader(b *executorBuilder, v *plannercore.PhysicalInd
 		var err error
 
 		if is, ok := v.PartialPlans[i][0].(*plannercore.PhysicalIndexScan); ok {
+			if err = validCanReadIndex(is.Index); err != nil {
+				return nil, err
+			}
 			tempReq, err = buildIndexReq(b.ctx, is.Index.Columns, ts.HandleCols.NumCols(), v.PartialPlans[i])
 			descs = append(descs, is.Desc)
 			indexes = append(indexes, is.Index)
@@ -5019,7 +5180,14 @@ func (b *executorBuilder) buildShuffle(v *plannercore.PhysicalShuffle) *ShuffleE
 	switch v.SplitterType {
 	case plannercore.PartitionHashSplitterType:
 		for i, byItems := range v.ByItemArrays {
-			splitters[i] = buildPartitionHashSplitter(shuffle.concurrency, byItems)
+			splitter := buildPartitionHashSplitter(shuffle.concurrency, byItems)
+			if len(v.HotKeys) > 0 {
+				if err := splitter.setHotKeys(b.ctx, v.HotKeys, i == v.HotKeySpreadIdx); err != nil {
+					b.err = err
+					return nil
+				}
+			}
+			splitters[i] = splitter
 		}
 	case plannercore.PartitionRangeSplitterType:
 		for i, byItems := range v.ByItemArrays {
@@ -5166,6 +5334,10 @@ func (b *executorBuilder) buildBatchPointGet(plan *plannercore.BatchPointGetPlan
 		b.err = err
 		return nil
 	}
+	if err = validCanReadIndex(plan.IndexInfo); err != nil {
+		b.err = err
+		return nil
+	}
 
 	if plan.Lock && !b.inSelectLockStmt {
 		b.inSelectLockStmt = true
@@ -5443,6 +5615,57 @@ func (b *executorBuilder) buildTableSample(v *plannercore.PhysicalTableSample) *
 	return e
 }
 
+func (b *executorBuilder) buildFullTextIndexReader(v *plannercore.PhysicalFullTextIndexReader) exec.Executor {
+	tableReader, err := buildNoRangeTableReader(b, v.TableReader)
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	snapshot, err := b.getSnapshot()
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	ts := v.TableReader.GetTablePlan().(*plannercore.PhysicalTableScan)
+	return &FullTextIndexReaderExec{
+		BaseExecutor:      exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		table:             tableReader.table,
+		tableID:           v.Table.ID,
+		match:             v.Match,
+		columns:           ts.Columns,
+		snapshot:          snapshot,
+		dataReaderBuilder: &dataReaderBuilder{executorBuilder: b},
+		tableReader:       tableReader,
+	}
+}
+
+func (b *executorBuilder) buildVectorIndexReader(v *plannercore.PhysicalVectorIndexReader) exec.Executor {
+	tableReader, err := buildNoRangeTableReader(b, v.TableReader)
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	snapshot, err := b.getSnapshot()
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	ts := v.TableReader.GetTablePlan().(*plannercore.PhysicalTableScan)
+	return &VectorIndexReaderExec{
+		BaseExecutor:      exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		table:             tableReader.table,
+		tblInfo:           v.Table,
+		tableID:           v.Table.ID,
+		index:             v.Index,
+		distance:          v.Distance,
+		topK:              v.TopK,
+		columns:           ts.Columns,
+		snapshot:          snapshot,
+		dataReaderBuilder: &dataReaderBuilder{executorBuilder: b},
+		tableReader:       tableReader,
+	}
+}
+
 func (b *executorBuilder) buildCTE(v *plannercore.PhysicalCTE) exec.Executor {
 	if b.Ti != nil {
 		b.Ti.UseNonRecursive = true
@@ -5565,6 +5788,15 @@ func (b *executorBuilder) validCanReadTemporaryOrCacheTable(tbl *model.TableInfo
 	return b.validCanReadCacheTable(tbl)
 }
 
+// validCanReadIndex checks whether the index has data to read. A hypothetical index only lives in the session to be
+// evaluated by EXPLAIN, so a plan accessing it must not be executed.
+func validCanReadIndex(idx *model.IndexInfo) error {
+	if idx != nil && idx.Tp == model.IndexTypeHypo {
+		return plannercore.ErrNotSupportedYet.GenWithStackByArgs(fmt.Sprintf("executing a plan with the hypothetical index '%s'", idx.Name.O))
+	}
+	return nil
+}
+
 func (b *executorBuilder) validCanReadCacheTable(tbl *model.TableInfo) error {
 	if tbl.TableCacheStatusType == model.TableCacheStatusDisable {
 		return nil
@@ -50,6 +50,8 @@ type DeleteExec struct {
 	fkChecks map[int64][]*FKCheckExec
 	// fkCascades contains the foreign key cascade. the map is tableID -> []*FKCascadeExec
 	fkCascades map[int64][]*FKCascadeExec
+	// triggers contains the triggers of the tables. the map is tableID -> *triggerExec
+	triggers map[int64]*triggerExec
 }
 
 // Next implements the Executor Next interface.
@@ -61,7 +63,7 @@ func (e *DeleteExec) Next(ctx context.Context, req *chunk.Chunk) error {
 	return e.deleteSingleTableByChunk(ctx)
 }
 
-func (e *DeleteExec) deleteOneRow(tbl table.Table, handleCols plannercore.HandleCols, isExtraHandle bool, row []types.Datum) error {
+func (e *DeleteExec) deleteOneRow(ctx context.Context, tbl table.Table, handleCols plannercore.HandleCols, isExtraHandle bool, row []types.Datum) error {
 	end := len(row)
 	if isExtraHandle {
 		end--
@@ -70,7 +72,7 @@ func (e *DeleteExec) deleteOneRow(tbl table.Table, handleCols plannercore.Handle
 	if err != nil {
 		return err
 	}
-	err = e.removeRow(e.Ctx(), tbl, handle, row[:end])
+	err = e.removeRow(ctx, tbl, handle, row[:end])
 	if err != nil {
 		return err
 	}
@@ -137,7 +139,7 @@ func (e *DeleteExec) deleteSingleTableByChunk(ctx context.Context) error {
 				datumRow = append(datumRow, datum)
 			}
 
-			err = e.deleteOneRow(tbl, handleCols, isExtrahandle, datumRow)
+			err = e.deleteOneRow(ctx, tbl, handleCols, isExtrahandle, datumRow)
 			if err != nil {
 				return err
 			}
@@ -224,14 +226,14 @@ func (e *DeleteExec) deleteMultiTablesByChunk(ctx context.Context) error {
 		chk = exec.TryNewCacheChunk(e.Children(0))
 	}
 
-	return e.removeRowsInTblRowMap(tblRowMap)
+	return e.removeRowsInTblRowMap(ctx, tblRowMap)
 }
 
-func (e *DeleteExec) removeRowsInTblRowMap(tblRowMap tableRowMapType) error {
+func (e *DeleteExec) removeRowsInTblRowMap(ctx context.Context, tblRowMap tableRowMapType) error {
 	for id, rowMap := range tblRowMap {
 		var err error
 		rowMap.Range(func(h kv.Handle, val []types.Datum) bool {
-			err = e.removeRow(e.Ctx(), e.tblID2Table[id], h, val)
+			err = e.removeRow(ctx, e.tblID2Table[id], h, val)
 			return err == nil
 		})
 		if err != nil {
@@ -242,17 +244,26 @@ func (e *DeleteExec) removeRowsInTblRowMap(tblRowMap tableRowMapType) error {
 	return nil
 }
 
-func (e *DeleteExec) removeRow(ctx sessionctx.Context, t table.Table, h kv.Handle, data []types.Datum) error {
-	err := t.RemoveRecord(ctx, h, data)
+func (e *DeleteExec) removeRow(ctx context.Context, t table.Table, h kv.Handle, data []types.Datum) error {
+	sctx := e.Ctx()
+	tid := t.Meta().ID
+	err := e.triggers[tid].fire(ctx, model.TriggerTimingBefore, model.TriggerEventDelete, data, nil)
 	if err != nil {
 		return err
 	}
-	tid := t.Meta().ID
-	err = onRemoveRowForFK(ctx, data, e.fkChecks[tid], e.fkCascades[tid])
+	err = t.RemoveRecord(sctx, h, data, table.DeleteWithCtx(ctx))
+	if err != nil {
+		return err
+	}
+	err = onRemoveRowForFK(sctx, data, e.fkChecks[tid], e.fkCascades[tid])
+	if err != nil {
+		return err
+	}
+	err = e.triggers[tid].fire(ctx, model.TriggerTimingAfter, model.TriggerEventDelete, data, nil)
 	if err != nil {
 		return err
 	}
-	ctx.GetSessionVars().StmtCtx.AddAffectedRows(1)
+	sctx.GetSessionVars().StmtCtx.AddAffectedRows(1)
 	return nil
 }
 
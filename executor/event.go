@@ -0,0 +1,374 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/event"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/privilege"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/util/timeutil"
+)
+
+func (e *SimpleExec) getEventSchema(name model.CIStr) (*model.DBInfo, error) {
+	schema, ok := e.is.SchemaByName(name)
+	if !ok {
+		return nil, infoschema.ErrDatabaseNotExists.GenWithStackByArgs(name.O)
+	}
+	return schema, nil
+}
+
+func (e *SimpleExec) executeCreateEvent(ctx context.Context, s *ast.CreateEventStmt) error {
+	schema, err := e.getEventSchema(s.EventName.Schema)
+	if err != nil {
+		return err
+	}
+
+	if err = checkEventBody(s.Body); err != nil {
+		return err
+	}
+
+	sessVars := e.Ctx().GetSessionVars()
+	now := time.Now()
+	info := &event.Info{
+		SchemaID:          schema.ID,
+		Name:              s.EventName.Name.O,
+		DefinerUser:       s.Definer.Username,
+		DefinerHost:       s.Definer.Hostname,
+		Body:              s.Body.Text(),
+		Preserve:          s.Completion == ast.EventCompletionPreserve,
+		CollationDatabase: schema.Collate,
+		Created:           now,
+		LastAltered:       now,
+	}
+	if info.DefinerHost == "" {
+		info.DefinerHost = "%"
+	}
+	if s.Comment != nil {
+		info.Comment = *s.Comment
+	}
+	setEventCreationContext(sessVars, info)
+
+	if err = e.setEventSchedule(info, s.Schedule); err != nil {
+		return err
+	}
+
+	if err = info.Validate(); err != nil {
+		return err
+	}
+
+	if info.TimeZone, err = sessVars.GetSessionOrGlobalSystemVar(ctx, variable.TimeZone); err != nil {
+		return err
+	}
+
+	info.Enabled = s.Status != ast.EventStatusDisable
+	if info.Expired(now) {
+		if !info.Preserve {
+			sessVars.StmtCtx.AppendNote(exeerrors.ErrEventCannotCreateInThePast)
+			return nil
+		}
+		info.Enabled = false
+		sessVars.StmtCtx.AppendWarning(exeerrors.ErrEventExecTimeInThePast)
+	}
+
+	data, err := info.Encode()
+	if err != nil {
+		return err
+	}
+	return domain.GetDomain(e.Ctx()).DDL().CreateEvent(e.Ctx(), s, data)
+}
+
+func (e *SimpleExec) executeAlterEvent(ctx context.Context, s *ast.AlterEventStmt) error {
+	schema, err := e.getEventSchema(s.EventName.Schema)
+	if err != nil {
+		return err
+	}
+
+	old, err := event.LoadEvent(ctx, e.Ctx().GetStore(), schema.ID, s.EventName.Name.L)
+	if err != nil {
+		return err
+	}
+
+	if old == nil {
+		return exeerrors.ErrEventDoesNotExist.GenWithStackByArgs(s.EventName.Name.O)
+	}
+
+	sessVars := e.Ctx().GetSessionVars()
+	info := *old
+
+	if s.Definer != nil {
+		info.DefinerUser, info.DefinerHost = s.Definer.Username, s.Definer.Hostname
+		if info.DefinerHost == "" {
+			info.DefinerHost = "%"
+		}
+	}
+
+	if s.Schedule != nil {
+		info.ExecuteAt, info.IntervalValue, info.IntervalField, info.Starts, info.Ends = nil, "", "", nil, nil
+		if err = e.setEventSchedule(&info, s.Schedule); err != nil {
+			return err
+		}
+
+		if info.TimeZone, err = sessVars.GetSessionOrGlobalSystemVar(ctx, variable.TimeZone); err != nil {
+			return err
+		}
+	}
+
+	switch s.Completion {
+	case ast.EventCompletionPreserve:
+		info.Preserve = true
+	case ast.EventCompletionNotPreserve:
+		info.Preserve = false
+	}
+
+	if s.RenameTo != nil {
+		newSchema, err := e.getEventSchema(s.RenameTo.Schema)
+		if err != nil {
+			return err
+		}
+
+		if newSchema.ID == schema.ID && s.RenameTo.Name.L == s.EventName.Name.L {
+			return exeerrors.ErrEventSameName
+		}
+
+		info.SchemaID, info.Name, info.CollationDatabase = newSchema.ID, s.RenameTo.Name.O, newSchema.Collate
+	}
+
+	switch s.Status {
+	case ast.EventStatusEnable:
+		info.Enabled = true
+	case ast.EventStatusDisable:
+		info.Enabled = false
+	}
+
+	if s.Comment != nil {
+		info.Comment = *s.Comment
+	}
+
+	if s.Body != nil {
+		if err = checkEventBody(s.Body); err != nil {
+			return err
+		}
+		info.Body = s.Body.Text()
+		setEventCreationContext(sessVars, &info)
+	}
+
+	if err = info.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if s.Schedule != nil && info.Expired(now) {
+		if !info.Preserve {
+			sessVars.StmtCtx.AppendNote(exeerrors.ErrEventCannotAlterInThePast)
+			return nil
+		}
+		info.Enabled = false
+		sessVars.StmtCtx.AppendWarning(exeerrors.ErrEventExecTimeInThePast)
+	}
+
+	info.LastAltered = now
+	data, err := info.Encode()
+	if err != nil {
+		return err
+	}
+	return domain.GetDomain(e.Ctx()).DDL().AlterEvent(e.Ctx(), s, data)
+}
+
+func (e *SimpleExec) executeDropEvent(s *ast.DropEventStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().DropEvent(e.Ctx(), s)
+}
+
+// setEventSchedule evaluates the `ON SCHEDULE` clause and fills the schedule of an event.
+func (e *SimpleExec) setEventSchedule(info *event.Info, schedule *ast.EventSchedule) error {
+	if schedule.At != nil {
+		at, err := e.evalEventTime(schedule.At)
+		if err != nil {
+			return err
+		}
+		info.ExecuteAt = &at
+		return nil
+	}
+
+	val, err := expression.EvalAstExpr(e.Ctx(), schedule.Every)
+	if err != nil {
+		return err
+	}
+
+	if val.IsNull() {
+		return exeerrors.ErrEventIntervalNotPositiveOrTooBig
+	}
+
+	if info.IntervalValue, err = val.ToString(); err != nil {
+		return err
+	}
+	info.IntervalField = schedule.Unit.String()
+
+	if schedule.Starts != nil {
+		starts, err := e.evalEventTime(schedule.Starts)
+		if err != nil {
+			return err
+		}
+		info.Starts = &starts
+	}
+
+	if schedule.Ends != nil {
+		ends, err := e.evalEventTime(schedule.Ends)
+		if err != nil {
+			return err
+		}
+		info.Ends = &ends
+	}
+	return nil
+}
+
+func (e *SimpleExec) evalEventTime(expr ast.ExprNode) (time.Time, error) {
+	val, err := expression.EvalAstExpr(e.Ctx(), expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if val.IsNull() {
+		return time.Time{}, types.ErrWrongValue.GenWithStackByArgs(types.DateTimeStr, "NULL")
+	}
+
+	sessVars := e.Ctx().GetSessionVars()
+	datetime, err := val.ConvertTo(sessVars.StmtCtx, types.NewFieldType(mysql.TypeDatetime))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return datetime.GetMysqlTime().GoTime(sessVars.Location())
+}
+
+func setEventCreationContext(sessVars *variable.SessionVars, info *event.Info) {
+	info.SQLMode, _ = sessVars.GetSystemVar(variable.SQLModeVar)
+	info.CharsetClient, _ = sessVars.GetSystemVar(variable.CharacterSetClient)
+	info.CollationConnection, _ = sessVars.GetSystemVar(variable.CollationConnection)
+}
+
+func checkEventBody(body ast.StmtNode) error {
+	switch body.(type) {
+	case *ast.CreateEventStmt, *ast.AlterEventStmt, *ast.DropEventStmt:
+		return exeerrors.ErrEventRecursionForbidden
+	case *ast.ProcedureBlock, *ast.ProcedureLabelBlock, *ast.ProcedureIfInfo, *ast.SimpleCaseStmt, *ast.SearchCaseStmt,
+		*ast.ProcedureRepeatStmt, *ast.ProcedureWhileStmt, *ast.ProcedureLabelLoop, *ast.ProcedureOpenCur,
+		*ast.ProcedureCloseCur, *ast.ProcedureFetchInto, *ast.ProcedureJump:
+		return dbterror.ErrNotSupportedYet.GenWithStackByArgs("compound statement as the body of an event")
+	}
+	return nil
+}
+
+// listVisibleEvents returns the events whose schema is visible to the current user and the schemas of them.
+func listVisibleEvents(ctx context.Context, sctx sessionctx.Context, is infoschema.InfoSchema) ([]*event.Event, []*model.DBInfo, error) {
+	checker := privilege.GetPrivilegeManager(sctx)
+	schemaByID := make(map[int64]*model.DBInfo)
+	schemaIDs := make([]int64, 0)
+	for _, schema := range is.AllSchemas() {
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schema.Name.L, "", "", mysql.EventPriv) {
+			continue
+		}
+		schemaByID[schema.ID] = schema
+		schemaIDs = append(schemaIDs, schema.ID)
+	}
+
+	infos, err := event.LoadEvents(ctx, sctx.GetStore(), schemaIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := make([]*event.Event, 0, len(infos))
+	schemas := make([]*model.DBInfo, 0, len(infos))
+	for _, info := range infos {
+		events = append(events, &event.Event{Info: info})
+		schemas = append(schemas, schemaByID[info.SchemaID])
+	}
+
+	if manager := domain.GetDomain(sctx).EventManager(); manager != nil {
+		if err = manager.AttachStatus(ctx, events); err != nil {
+			return nil, nil, err
+		}
+	}
+	return events, schemas, nil
+}
+
+func eventDefiner(ev *event.Event) string {
+	return fmt.Sprintf("%s@%s", ev.DefinerUser, ev.DefinerHost)
+}
+
+func eventType(ev *event.Event) string {
+	if ev.IsOneTime() {
+		return "ONE TIME"
+	}
+	return "RECURRING"
+}
+
+func eventStatus(ev *event.Event) string {
+	if ev.Enabled {
+		return "ENABLED"
+	}
+	return "DISABLED"
+}
+
+func eventOnCompletion(ev *event.Event) string {
+	if ev.Preserve {
+		return "PRESERVE"
+	}
+	return "NOT PRESERVE"
+}
+
+// eventTime converts a time of an event to a datetime value in the specified location, nil is returned if the
+// time is not set.
+func eventTime(t *time.Time, loc *time.Location) any {
+	if t == nil {
+		return nil
+	}
+	return types.NewTime(types.FromGoTime(t.In(loc)), mysql.TypeDatetime, 0)
+}
+
+// eventLocation returns the location of the time zone in which the schedule of an event is specified.
+func eventLocation(ev *event.Event) *time.Location {
+	loc, err := timeutil.ParseTimeZone(ev.TimeZone)
+	if err != nil {
+		return timeutil.SystemLocation()
+	}
+	return loc
+}
+
+func eventIntervalValue(ev *event.Event) any {
+	if ev.IsOneTime() {
+		return nil
+	}
+	return ev.IntervalValue
+}
+
+func eventIntervalField(ev *event.Event) any {
+	if ev.IsOneTime() {
+		return nil
+	}
+	return ev.IntervalField
+}
@@ -1927,6 +1927,8 @@ func (e *UnionExec) Close() error {
 // Before every execution, we must clear statement context.
 func ResetContextOfStmt(ctx sessionctx.Context, s ast.StmtNode) (err error) {
 	vars := ctx.GetSessionVars()
+	// The result sets of the last `CALL` statement which aren't taken are discarded.
+	ctx.ClearValue(CallResultsVarKey)
 	for name, val := range vars.StmtCtx.SetVarHintRestore {
 		err := vars.SetSystemVar(name, val)
 		if err != nil {
@@ -1935,9 +1937,10 @@ func ResetContextOfStmt(ctx sessionctx.Context, s ast.StmtNode) (err error) {
 	}
 	vars.StmtCtx.SetVarHintRestore = nil
 	var sc *stmtctx.StatementContext
-	if vars.TxnCtx.CouldRetry || mysql.HasCursorExistsFlag(vars.Status) {
+	if vars.TxnCtx.CouldRetry || mysql.HasCursorExistsFlag(vars.Status) || len(vars.CallingProcedures) > 0 {
 		// Must construct new statement context object, the retry history need context for every statement.
 		// TODO: Maybe one day we can get rid of transaction retry, then this logic can be deleted.
+		// The statement context of the `CALL` statement is also kept until the statements of the procedure are done.
 		sc = &stmtctx.StatementContext{}
 	} else {
 		sc = vars.InitStatementContext()
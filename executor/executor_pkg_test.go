@@ -274,20 +274,13 @@ func TestSortSpillDisk(t *testing.T) {
 		}
 	}
 	// Test 2 partitions and all data in disk.
-	// Now spilling is in parallel.
-	// Maybe the second add() will called before spilling, depends on
-	// Golang goroutine scheduling. So the result has two possibilities.
-	if len(exe.partitionList) == 2 {
-		require.Len(t, exe.partitionList, 2)
-		require.Equal(t, true, exe.partitionList[0].AlreadySpilledSafeForTest())
-		require.Equal(t, true, exe.partitionList[1].AlreadySpilledSafeForTest())
-		require.Equal(t, 1024, exe.partitionList[0].NumRow())
-		require.Equal(t, 1024, exe.partitionList[1].NumRow())
-	} else {
-		require.Len(t, exe.partitionList, 1)
-		require.Equal(t, true, exe.partitionList[0].AlreadySpilledSafeForTest())
-		require.Equal(t, 2048, exe.partitionList[0].NumRow())
-	}
+	// The spilling is in parallel, but the partition is sealed once the spilling is triggered,
+	// so the second chunk is always added to a new partition.
+	require.Len(t, exe.partitionList, 2)
+	require.Equal(t, true, exe.partitionList[0].AlreadySpilledSafeForTest())
+	require.Equal(t, true, exe.partitionList[1].AlreadySpilledSafeForTest())
+	require.Equal(t, 1024, exe.partitionList[0].NumRow())
+	require.Equal(t, 1024, exe.partitionList[1].NumRow())
 
 	err = exe.Close()
 	require.NoError(t, err)
@@ -348,8 +341,59 @@ func TestSortSpillDisk(t *testing.T) {
 			break
 		}
 	}
-	// Don't spill too many partitions.
-	require.True(t, len(exe.partitionList) <= 4)
+	// Don't spill too many partitions, every partition is at least 10% of the quota.
+	require.Len(t, exe.partitionList, 5)
+	for _, partition := range exe.partitionList {
+		require.True(t, partition.AlreadySpilledSafeForTest())
+		require.Equal(t, 4096, partition.NumRow())
+	}
 	err = exe.Close()
 	require.NoError(t, err)
 }
+
+func TestSortParallelMergeClose(t *testing.T) {
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/executor/testSortedRowContainerSpill", "return(true)"))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/executor/testSortedRowContainerSpill"))
+	}()
+	ctx := mock.NewContext()
+	ctx.GetSessionVars().InitChunkSize = 32
+	ctx.GetSessionVars().MaxChunkSize = 32
+	ctx.GetSessionVars().MemTracker = memory.NewTracker(memory.LabelForSession, 1)
+	ctx.GetSessionVars().StmtCtx.MemTracker = memory.NewTracker(memory.LabelForSQLText, -1)
+	ctx.GetSessionVars().StmtCtx.MemTracker.AttachTo(ctx.GetSessionVars().MemTracker)
+	cas := &sortCase{rows: 2048, orderByIdx: []int{0, 1}, ndvs: []int{0, 0}, ctx: ctx}
+	dataSource := buildMockDataSource(mockDataSourceParameters{
+		schema: expression.NewSchema(cas.columns()...),
+		rows:   cas.rows,
+		ctx:    cas.ctx,
+		ndvs:   cas.ndvs,
+	})
+	exe := &SortExec{
+		BaseExecutor: exec.NewBaseExecutor(cas.ctx, dataSource.Schema(), 0, dataSource),
+		schema:       dataSource.Schema(),
+		concurrency:  4,
+	}
+	for _, idx := range cas.orderByIdx {
+		exe.ByItems = append(exe.ByItems, &plannerutil.ByItems{Expr: cas.columns()[idx]})
+	}
+	tmpCtx := context.Background()
+	chk := exec.NewFirstChunk(exe)
+	dataSource.prepareChunks()
+	require.NoError(t, exe.Open(tmpCtx))
+	tracker := exe.memTracker
+	// Stop reading before the merge workers are finished, the chunks queued by them are released by Close.
+	require.NoError(t, exe.Next(tmpCtx, chk))
+	require.Equal(t, 32, chk.NumRows())
+	require.NotNil(t, exe.parallelMerge)
+	require.Eventually(t, func() bool {
+		for _, s := range exe.parallelMerge.allStreams {
+			if len(s.resultCh) == 0 {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, exe.Close())
+	require.Equal(t, int64(0), tracker.BytesConsumed())
+}
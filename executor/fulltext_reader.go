@@ -0,0 +1,122 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/fulltext"
+	"github.com/pingcap/tidb/util/tracing"
+)
+
+var _ exec.Executor = &FullTextIndexReaderExec{}
+
+// FullTextIndexReaderExec reads the rows that contain any token of a full-text query. It collects the
+// handles from the postings of the full-text index, and then reads the rows by the handles.
+// Whether a row actually matches the query is checked by the Selection above it.
+type FullTextIndexReaderExec struct {
+	exec.BaseExecutor
+
+	table   table.Table
+	tableID int64
+	match   *expression.FullTextMatch
+	// columns are only required by union scan.
+	columns  []*model.ColumnInfo
+	snapshot kv.Snapshot
+
+	dataReaderBuilder *dataReaderBuilder
+	tableReader       *TableReaderExecutor
+	reader            exec.Executor
+}
+
+// Open implements the Executor Open interface.
+func (e *FullTextIndexReaderExec) Open(ctx context.Context) error {
+	defer tracing.StartRegion(ctx, "FullTextIndexReaderExec.Open").End()
+	handles, err := e.readHandles(ctx)
+	if err != nil {
+		return err
+	}
+	e.reader, err = e.dataReaderBuilder.buildTableReaderFromHandles(ctx, e.tableReader, handles, true)
+	return err
+}
+
+func (e *FullTextIndexReaderExec) readHandles(ctx context.Context) ([]kv.Handle, error) {
+	queryText, isNull, err := e.match.Query.EvalString(e.Ctx(), chunk.Row{})
+	if err != nil || isNull {
+		return nil, err
+	}
+	tokenizer, ok := fulltext.NewTokenizer(e.match.Index.FullTextParser)
+	if !ok {
+		return nil, errors.Errorf("unknown full-text parser %s", e.match.Index.FullTextParser)
+	}
+	tokens, prefixes := fulltext.ParseQuery(tokenizer, queryText, e.match.BooleanMode).IndexTokens()
+
+	handles := make([]kv.Handle, 0)
+	seen := kv.NewHandleMap()
+	collect := func(_ string, h kv.Handle, _ int64) error {
+		if _, ok := seen.Get(h); !ok {
+			seen.Set(h, struct{}{})
+			handles = append(handles, h)
+		}
+		return nil
+	}
+	reader := fulltext.NewIndexReader(e.snapshot, e.tableID, e.match.Index.ID)
+	for _, token := range tokens {
+		if err := reader.Scan(ctx, token, false, collect); err != nil {
+			return nil, err
+		}
+	}
+	for _, prefix := range prefixes {
+		if err := reader.Scan(ctx, prefix, true, collect); err != nil {
+			return nil, err
+		}
+	}
+	return handles, nil
+}
+
+// memTableRanges returns the record range of the table. The rows added in the transaction are not in
+// the postings read from the snapshot, so union scan reads all of them and filters them by MATCH.
+func (e *FullTextIndexReaderExec) memTableRanges() []kv.KeyRange {
+	prefix := tablecodec.GenTableRecordPrefix(e.tableID)
+	return []kv.KeyRange{{StartKey: prefix, EndKey: prefix.PrefixNext()}}
+}
+
+// Next implements the Executor Next interface.
+func (e *FullTextIndexReaderExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	return exec.Next(ctx, e.reader, req)
+}
+
+// Close implements the Executor Close interface.
+func (e *FullTextIndexReaderExec) Close() error {
+	if e.reader == nil {
+		return nil
+	}
+	err := e.reader.Close()
+	e.reader = nil
+	return err
+}
+
+// Table implements the dataSourceExecutor interface.
+func (e *FullTextIndexReaderExec) Table() table.Table {
+	return e.table
+}
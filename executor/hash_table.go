@@ -83,12 +83,16 @@ func (hc *hashContext) initHash(rows int) {
 }
 
 type hashStatistic struct {
-	// NOTE: probeCollision may be accessed from multiple goroutines concurrently.
+	// NOTE: probeCollision and hotKeyProbe may be accessed from multiple goroutines concurrently.
 	probeCollision   int64
+	hotKeyProbe      int64
 	buildTableElapse time.Duration
 }
 
 func (s *hashStatistic) String() string {
+	if s.hotKeyProbe > 0 {
+		return fmt.Sprintf("probe_collision:%v, hot_key_probe:%v, build:%v", s.probeCollision, s.hotKeyProbe, execdetails.FormatDuration(s.buildTableElapse))
+	}
 	return fmt.Sprintf("probe_collision:%v, build:%v", s.probeCollision, execdetails.FormatDuration(s.buildTableElapse))
 }
 
@@ -116,6 +120,19 @@ type hashRowContainer struct {
 	// chkBuf buffer the data reads from the disk if rowContainer is spilled.
 	chkBuf                *chunk.Chunk
 	chkBufSizeForOneProbe int64
+
+	// hotKeys stores the build rows of the heavy hitters of the join key, it's nil if there's no hot key.
+	// After build process, hotKeys is read only here for multi probe worker.
+	hotKeys *hashHotKeys
+}
+
+// hashHotKeys stores the build rows of the hot keys. The rows of a hot key are collected once after the hash table is
+// built, so the probe rows with the hot key needn't traverse and compare all the build rows in the bucket one by one,
+// which is expensive when a few keys dominate the build side, especially after the rows are spilled to disk.
+type hashHotKeys struct {
+	keys []types.Datum
+	// rows are the build rows of the hot keys, indexed by the hash values of the keys.
+	rows map[uint64][]chunk.Row
 }
 
 func newHashRowContainer(sCtx sessionctx.Context, hCtx *hashContext, allTypes []*types.FieldType) *hashRowContainer {
@@ -136,6 +153,98 @@ func newHashRowContainer(sCtx sessionctx.Context, hCtx *hashContext, allTypes []
 	return c
 }
 
+// setHotKeys sets the hot keys of the join key, whose build rows are collected by collectHotKeyRows. It should be
+// called before the hashRowContainer is shallow copied. The hot keys are ignored if there're several join keys, whose
+// heavy hitters can't be got from the statistics of the single columns.
+func (c *hashRowContainer) setHotKeys(hotKeys []types.Datum) {
+	if len(hotKeys) == 0 || len(c.hCtx.keyColIdx) != 1 || len(c.hCtx.naKeyColIdx) > 0 {
+		return
+	}
+	c.hotKeys = &hashHotKeys{keys: hotKeys}
+}
+
+// collectHotKeyRows collects the build rows of the hot keys after the hash table is built. The hot keys are hashed
+// in the same way as the build rows.
+func (c *hashRowContainer) collectHotKeyRows() error {
+	if c.hotKeys == nil {
+		return nil
+	}
+	keyTypes := c.hCtx.allTypes[:1]
+	keyChk := chunk.NewChunkWithCapacity(keyTypes, len(c.hotKeys.keys))
+	for i := range c.hotKeys.keys {
+		keyChk.AppendDatum(0, &c.hotKeys.keys[i])
+	}
+	hCtx := &hashContext{allTypes: keyTypes, keyColIdx: []int{0}}
+	hCtx.initHash(keyChk.NumRows())
+	err := codec.HashChunkColumns(c.sc, hCtx.hashVals, keyChk, keyTypes[0], 0, hCtx.buf, hCtx.hasNull)
+	if err != nil {
+		return err
+	}
+
+	var (
+		chkBuf   *chunk.Chunk
+		memUsage int64
+	)
+	c.hotKeys.rows = make(map[uint64][]chunk.Row, keyChk.NumRows())
+	for i := 0; i < keyChk.NumRows(); i++ {
+		if hCtx.hasNull[i] {
+			continue
+		}
+		hashKey := hCtx.hashVals[i].Sum64()
+		if _, ok := c.hotKeys.rows[hashKey]; ok {
+			continue
+		}
+		var rows []chunk.Row
+		for _, ptr := range c.hashTable.Get(hashKey) {
+			// The rows read from the disk are appended to chkBuf, a new chunk is allocated once it's full, so the
+			// rows are kept valid.
+			row, newBuf, err := c.rowContainer.GetRowAndAppendToChunkIfInDisk(ptr, chkBuf)
+			if err != nil {
+				return err
+			}
+			if newBuf != chkBuf && chkBuf != nil {
+				memUsage += chkBuf.MemoryUsage()
+			}
+			chkBuf = newBuf
+			ok, err := c.matchJoinKey(row, keyChk.GetRow(i), hCtx)
+			if err != nil {
+				return err
+			}
+			if ok {
+				rows = append(rows, row)
+			}
+		}
+		if len(rows) > 0 {
+			c.hotKeys.rows[hashKey] = rows
+			memUsage += int64(cap(rows)) * rowSize
+		}
+	}
+	if chkBuf != nil {
+		memUsage += chkBuf.MemoryUsage()
+	}
+	c.memTracker.Consume(memUsage)
+	return nil
+}
+
+// GetHotKeyRows gets the build rows of the hot key of the probe row, it returns false if the key of the probe row isn't
+// a hot key. The returned rows are shared by all the probe workers and shouldn't be modified.
+func (c *hashRowContainer) GetHotKeyRows(probeKey uint64, probeRow chunk.Row, hCtx *hashContext) ([]chunk.Row, bool, error) {
+	if c.hotKeys == nil {
+		return nil, false, nil
+	}
+	rows, ok := c.hotKeys.rows[probeKey]
+	if !ok {
+		return nil, false, nil
+	}
+	// The probe row may collide with the hot key.
+	ok, err := c.matchJoinKey(rows[0], probeRow, hCtx)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	atomic.AddInt64(&c.stat.hotKeyProbe, 1)
+	return rows, true, nil
+}
+
 func (c *hashRowContainer) ShallowCopy() *hashRowContainer {
 	newHRC := *c
 	newHRC.rowContainer = c.rowContainer.ShallowCopyWithNewMutex()
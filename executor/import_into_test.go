@@ -137,6 +137,8 @@ func TestImportIntoOptionsNegativeCase(t *testing.T) {
 		{OptionStr: "cloud_storage_uri=':'", Err: exeerrors.ErrInvalidOptionVal},
 		{OptionStr: "cloud_storage_uri='sdsd'", Err: exeerrors.ErrInvalidOptionVal},
 		{OptionStr: "cloud_storage_uri='http://sdsd'", Err: exeerrors.ErrInvalidOptionVal},
+
+		{OptionStr: "json_paths='$.id'", Err: exeerrors.ErrLoadDataUnsupportedOption},
 	}
 
 	sqlTemplate := "import into t from '/file.csv' with %s"
@@ -162,13 +164,35 @@ func TestImportIntoOptionsNegativeCase(t *testing.T) {
 
 	sqlTemplate = "import into t from '/file.csv' format '%s' with %s"
 	for _, c := range nonCSVCases {
-		for _, format := range []string{importer.DataFormatParquet, importer.DataFormatSQL} {
+		for _, format := range []string{
+			importer.DataFormatParquet, importer.DataFormatSQL, importer.DataFormatJSONL,
+			importer.DataFormatAvro, importer.DataFormatORC,
+		} {
 			sql := fmt.Sprintf(sqlTemplate, format, c.OptionStr)
 			err := tk.ExecToErr(sql)
 			require.ErrorIs(t, err, c.Err, sql)
 		}
 	}
 
+	jsonlCases := []struct {
+		OptionStr string
+		Err       error
+	}{
+		{OptionStr: "json_paths=null", Err: exeerrors.ErrInvalidOptionVal},
+		{OptionStr: "json_paths=1", Err: exeerrors.ErrInvalidOptionVal},
+		{OptionStr: "json_paths=''", Err: exeerrors.ErrInvalidOptionVal},
+		{OptionStr: "json_paths='id'", Err: exeerrors.ErrInvalidOptionVal},
+		{OptionStr: "json_paths='$.id,$.a['", Err: exeerrors.ErrInvalidOptionVal},
+		{OptionStr: "json_paths='$.id, $.name'", Err: exeerrors.ErrInvalidOptionVal},
+		{OptionStr: `json_paths='$."id, $.name'`, Err: exeerrors.ErrInvalidOptionVal},
+	}
+	sqlTemplate = "import into t from '/file.jsonl' format 'jsonl' with %s"
+	for _, c := range jsonlCases {
+		sql := fmt.Sprintf(sqlTemplate, c.OptionStr)
+		err := tk.ExecToErr(sql)
+		require.ErrorIs(t, err, c.Err, sql)
+	}
+
 	parameterCheck := []struct {
 		sql string
 		Err error
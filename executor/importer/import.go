@@ -71,6 +71,12 @@ const (
 	DataFormatSQL = "sql"
 	// DataFormatParquet represents the data source file of IMPORT INTO is parquet.
 	DataFormatParquet = "parquet"
+	// DataFormatJSONL represents the data source file of IMPORT INTO is JSON Lines(newline-delimited JSON).
+	DataFormatJSONL = "jsonl"
+	// DataFormatAvro represents the data source file of IMPORT INTO is avro object container file.
+	DataFormatAvro = "avro"
+	// DataFormatORC represents the data source file of IMPORT INTO is ORC file.
+	DataFormatORC = "orc"
 
 	// DefaultDiskQuota is the default disk quota for IMPORT INTO
 	DefaultDiskQuota = config.ByteSize(50 << 30) // 50GiB
@@ -94,6 +100,7 @@ const (
 	detachedOption              = "detached"
 	disableTiKVImportModeOption = "disable_tikv_import_mode"
 	cloudStorageURIOption       = "cloud_storage_uri"
+	jsonPathsOption             = "json_paths"
 	// used for test
 	maxEngineSizeOption = "__max_engine_size"
 )
@@ -118,6 +125,7 @@ var (
 		disableTiKVImportModeOption: false,
 		maxEngineSizeOption:         true,
 		cloudStorageURIOption:       true,
+		jsonPathsOption:             true,
 	}
 
 	csvOnlyOptions = map[string]struct{}{
@@ -131,6 +139,10 @@ var (
 		splitFileOption:           {},
 	}
 
+	jsonlOnlyOptions = map[string]struct{}{
+		jsonPathsOption: {},
+	}
+
 	// LoadDataReadBlockSize is exposed for test.
 	LoadDataReadBlockSize = int64(config.ReadBlockSize)
 )
@@ -199,6 +211,10 @@ type Plan struct {
 	DisableTiKVImportMode bool
 	MaxEngineSize         config.ByteSize
 	CloudStorageURI       string
+	// JSONPaths are the JSON paths of the input fields when the format is JSON
+	// Lines, in the same order as FieldMappings. When it's empty, the value of
+	// each field is read from the top-level key with the same name.
+	JSONPaths []string
 
 	// used for checksum in physical mode
 	DistSQLScanConcurrency int
@@ -298,6 +314,13 @@ func NewPlanFromLoadDataPlan(userSctx sessionctx.Context, plan *plannercore.Load
 		ignoreLines = *plan.IgnoreLines
 	}
 
+	format := DataFormatDelimitedData
+	// only JSON Lines is supported besides delimited data, other formats are
+	// ignored for compatibility.
+	if plan.Format != nil && strings.ToLower(*plan.Format) == DataFormatJSONL {
+		format = DataFormatJSONL
+	}
+
 	var (
 		nullDef              []string
 		nullValueOptEnclosed = false
@@ -321,7 +344,7 @@ func NewPlanFromLoadDataPlan(userSctx sessionctx.Context, plan *plannercore.Load
 		DBID:   plan.Table.DBInfo.ID,
 
 		Path:                 plan.Path,
-		Format:               DataFormatDelimitedData,
+		Format:               format,
 		Restrictive:          restrictive,
 		FieldNullDef:         nullDef,
 		NullValueOptEnclosed: nullValueOptEnclosed,
@@ -455,6 +478,10 @@ func NewLoadDataController(plan *Plan, tbl table.Table, astArgs *ASTArgs) (*Load
 	if err := c.initLoadColumns(columnNames); err != nil {
 		return nil, err
 	}
+	if len(c.JSONPaths) > 0 && len(c.JSONPaths) != len(c.FieldMappings) {
+		return nil, exeerrors.ErrInvalidOptionVal.FastGenByArgs(
+			"json_paths, the number of JSON paths should be equal to the number of input fields")
+	}
 	return c, nil
 }
 
@@ -463,7 +490,8 @@ func (e *LoadDataController) checkFieldParams() error {
 		return exeerrors.ErrLoadDataEmptyPath
 	}
 	if e.InImportInto {
-		if e.Format != DataFormatCSV && e.Format != DataFormatParquet && e.Format != DataFormatSQL {
+		if e.Format != DataFormatCSV && e.Format != DataFormatParquet && e.Format != DataFormatSQL && e.Format != DataFormatJSONL &&
+			e.Format != DataFormatAvro && e.Format != DataFormatORC {
 			return exeerrors.ErrLoadDataUnsupportedFormat.GenWithStackByArgs(e.Format)
 		}
 	} else {
@@ -534,6 +562,13 @@ func (p *Plan) initOptions(seCtx sessionctx.Context, options []*plannercore.Load
 			}
 		}
 	}
+	if p.Format != DataFormatJSONL {
+		for k := range jsonlOnlyOptions {
+			if _, ok := specifiedOptions[k]; ok {
+				return exeerrors.ErrLoadDataUnsupportedOption.FastGenByArgs(k, "non-JSONL format")
+			}
+		}
+	}
 
 	optAsString := func(opt *plannercore.LoadDataOpt) (string, error) {
 		if opt.Value.GetType().GetType() != mysql.TypeVarString {
@@ -680,6 +715,22 @@ func (p *Plan) initOptions(seCtx sessionctx.Context, options []*plannercore.Load
 		}
 		p.CloudStorageURI = v
 	}
+	if opt, ok := specifiedOptions[jsonPathsOption]; ok {
+		v, err := optAsString(opt)
+		if err != nil || v == "" {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		paths, err := mydump.SplitJSONPaths(v)
+		if err != nil {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		for _, path := range paths {
+			if err = mydump.ValidateJSONPath(path); err != nil {
+				return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+			}
+		}
+		p.JSONPaths = paths
+	}
 	if opt, ok := specifiedOptions[maxEngineSizeOption]; ok {
 		v, err := optAsString(opt)
 		if err != nil {
@@ -966,7 +1017,7 @@ func (e *LoadDataController) InitDataFiles(ctx context.Context) error {
 		}
 		// we add this check for security, we don't want user import any sensitive system files,
 		// most of which is readable text file and don't have a suffix, such as /etc/passwd
-		if !slices.Contains([]string{".csv", ".sql", ".parquet"}, strings.ToLower(filepath.Ext(e.Path))) {
+		if !slices.Contains([]string{".csv", ".sql", ".parquet", ".jsonl", ".ndjson", ".avro", ".orc"}, strings.ToLower(filepath.Ext(e.Path))) {
 			return exeerrors.ErrLoadDataInvalidURI.GenWithStackByArgs(plannercore.ImportIntoDataSource,
 				"the file suffix is not supported when import from server disk")
 		}
@@ -1079,6 +1130,12 @@ func (e *LoadDataController) getSourceType() mydump.SourceType {
 	switch e.Format {
 	case DataFormatParquet:
 		return mydump.SourceTypeParquet
+	case DataFormatJSONL:
+		return mydump.SourceTypeJSONL
+	case DataFormatAvro:
+		return mydump.SourceTypeAvro
+	case DataFormatORC:
+		return mydump.SourceTypeORC
 	case DataFormatDelimitedData, DataFormatCSV:
 		return mydump.SourceTypeCSV
 	default:
@@ -1157,6 +1214,20 @@ func (e *LoadDataController) GetParser(
 			reader,
 			dataFileInfo.Remote.Path,
 		)
+	case DataFormatAvro:
+		parser, err = mydump.NewAvroParser(ctx, reader)
+	case DataFormatORC:
+		parser, err = mydump.NewORCParser(ctx, reader)
+	case DataFormatJSONL:
+		columns, cfg := e.GenerateJSONLConfig()
+		parser, err = mydump.NewJSONLParser(
+			ctx,
+			cfg,
+			reader,
+			LoadDataReadBlockSize,
+			nil,
+			columns,
+		)
 	}
 	if err != nil {
 		return nil, exeerrors.ErrLoadDataWrongFormatConfig.GenWithStack(err.Error())
@@ -1166,6 +1237,29 @@ func (e *LoadDataController) GetParser(
 	return parser, nil
 }
 
+// GenerateJSONLConfig returns the names of the input fields and the config to
+// read their values from JSON Lines files.
+func (e *LoadDataController) GenerateJSONLConfig() ([]string, *config.JSONLConfig) {
+	columns := make([]string, 0, len(e.FieldMappings))
+	cfg := &config.JSONLConfig{}
+	if len(e.JSONPaths) > 0 {
+		cfg.ColumnPaths = make(map[string]string, len(e.JSONPaths))
+	}
+	for i, fieldMapping := range e.FieldMappings {
+		var name string
+		if fieldMapping.Column != nil {
+			name = fieldMapping.Column.Name.O
+		} else {
+			name = fieldMapping.UserVar.Name
+		}
+		columns = append(columns, name)
+		if len(e.JSONPaths) > 0 {
+			cfg.ColumnPaths[name] = e.JSONPaths[i]
+		}
+	}
+	return columns, cfg
+}
+
 // HandleSkipNRows skips the first N rows of the data file.
 func (e *LoadDataController) HandleSkipNRows(parser mydump.Parser) error {
 	// handle IGNORE N LINES
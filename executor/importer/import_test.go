@@ -158,6 +158,23 @@ func TestInitOptionsPositiveCase(t *testing.T) {
 	err = plan.initOptions(ctx, convertOptions(stmt.(*ast.ImportIntoStmt).Options))
 	require.NoError(t, err, sql4)
 	require.Equal(t, "", plan.CloudStorageURI, sql4)
+
+	// JSON paths of JSON Lines format
+	sql = "import into t from '/file.jsonl' format 'jsonl' with " + jsonPathsOption + "='$.id, $.user.name,$.tags[0]'"
+	stmt, err = p.ParseOneStmt(sql, "", "")
+	require.NoError(t, err, sql)
+	plan = &Plan{Format: DataFormatJSONL}
+	err = plan.initOptions(ctx, convertOptions(stmt.(*ast.ImportIntoStmt).Options))
+	require.NoError(t, err, sql)
+	require.Equal(t, []string{"$.id", "$.user.name", "$.tags[0]"}, plan.JSONPaths, sql)
+	// commas inside quoted keys don't separate the paths
+	sql = "import into t from '/file.jsonl' format 'jsonl' with " + jsonPathsOption + `='$."a,b", $.c."d,\\"e,"[1]'`
+	stmt, err = p.ParseOneStmt(sql, "", "")
+	require.NoError(t, err, sql)
+	plan = &Plan{Format: DataFormatJSONL}
+	err = plan.initOptions(ctx, convertOptions(stmt.(*ast.ImportIntoStmt).Options))
+	require.NoError(t, err, sql)
+	require.Equal(t, []string{`$."a,b"`, `$.c."d,\"e,"[1]`}, plan.JSONPaths, sql)
 }
 
 func TestAdjustOptions(t *testing.T) {
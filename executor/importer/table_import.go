@@ -427,8 +427,8 @@ func (ti *TableImporter) getTotalRawFileSize(indexCnt int64) int64 {
 	var totalSize int64
 	for _, file := range ti.dataFiles {
 		size := file.RealSize
-		if file.Type == mydump.SourceTypeParquet {
-			// parquet file is compressed, thus estimates with a factor of 2
+		if file.Type.IsWholeFile() {
+			// parquet, avro and ORC file are compressed, thus estimates with a factor of 2
 			size *= 2
 		}
 		totalSize += size
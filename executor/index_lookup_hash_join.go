@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/executor/internal/exec"
 	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/terror"
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
@@ -135,6 +136,7 @@ func (e *IndexNestedLoopHashJoin) Open(ctx context.Context) error {
 		e.memTracker = memory.NewTracker(e.ID(), -1)
 	}
 	e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
+	e.openSpill()
 	e.cancelFunc = nil
 	e.innerPtrBytes = make([][]byte, 0, 8)
 	if e.RuntimeStats() != nil {
@@ -310,9 +312,13 @@ func (e *IndexNestedLoopHashJoin) Close() error {
 		close(e.joinChkResourceCh[i])
 	}
 	e.joinChkResourceCh = nil
+	err := e.closeSpill()
 	e.finished.Store(false)
 	e.prepared = false
-	return e.BaseExecutor.Close()
+	if closeErr := e.BaseExecutor.Close(); err == nil {
+		err = closeErr
+	}
+	return err
 }
 
 func (ow *indexHashJoinOuterWorker) run(ctx context.Context) {
@@ -402,6 +408,7 @@ func (e *IndexNestedLoopHashJoin) newOuterWorker(innerCh chan *indexHashJoinTask
 			maxBatchSize:     e.Ctx().GetSessionVars().IndexJoinBatchSize,
 			parentMemTracker: e.memTracker,
 			lookup:           &e.IndexLookUpJoin,
+			stats:            e.stats,
 		},
 		innerCh:        innerCh,
 		keepOuterOrder: e.keepOuterOrder,
@@ -476,6 +483,7 @@ func (iw *indexHashJoinInnerWorker) run(ctx context.Context, cancelFunc context.
 		// The previous task has been processed, so release the occupied memory
 		if task != nil {
 			task.memTracker.Detach()
+			terror.Log(iw.lookup.spilledInnerResults.close(task.innerResult))
 		}
 		select {
 		case <-ctx.Done():
@@ -676,13 +684,16 @@ func (iw *indexHashJoinInnerWorker) handleTask(ctx context.Context, task *indexH
 
 func (iw *indexHashJoinInnerWorker) doJoinUnordered(ctx context.Context, task *indexHashJoinTask, joinResult *indexHashJoinResult, h hash.Hash64, resultCh chan *indexHashJoinResult) error {
 	var ok bool
-	iter := chunk.NewIterator4List(task.innerResult)
+	iter := chunk.NewIterator4RowContainer(task.innerResult)
 	for row := iter.Begin(); row != iter.End(); row = iter.Next() {
 		ok, joinResult = iw.joinMatchedInnerRow2Chunk(ctx, row, task, joinResult, h, iw.joinKeyBuf)
 		if !ok {
 			return joinResult.err
 		}
 	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
 	for chkIdx, outerRowStatus := range task.outerRowStatus {
 		chk := task.outerResult.GetChunk(chkIdx)
 		for rowIdx, val := range outerRowStatus {
@@ -810,7 +821,11 @@ func (iw *indexHashJoinInnerWorker) doJoinInOrder(ctx context.Context, task *ind
 		}
 	}()
 	for i, numChunks := 0, task.innerResult.NumChunks(); i < numChunks; i++ {
-		for j, chk := 0, task.innerResult.GetChunk(i); j < chk.NumRows(); j++ {
+		chk, err := task.innerResult.GetChunk(i)
+		if err != nil {
+			return err
+		}
+		for j := 0; j < chk.NumRows(); j++ {
 			row := chk.GetRow(j)
 			ptr := chunk.RowPtr{ChkIdx: uint32(i), RowIdx: uint32(j)}
 			err = iw.collectMatchedInnerPtrs4OuterRows(row, ptr, task, h, iw.joinKeyBuf)
@@ -830,7 +845,11 @@ func (iw *indexHashJoinInnerWorker) doJoinInOrder(ctx context.Context, task *ind
 			matchedInnerRows, hasMatched, hasNull = matchedInnerRows[:0], false, false
 			outerRow := task.outerResult.GetChunk(chkIdx).GetRow(outerRowIdx)
 			for _, ptr := range innerRowPtrs {
-				matchedInnerRows = append(matchedInnerRows, task.innerResult.GetRow(ptr))
+				innerRow, err := task.innerResult.GetRow(ptr)
+				if err != nil {
+					return err
+				}
+				matchedInnerRows = append(matchedInnerRows, innerRow)
 			}
 			iw.rowIter.Reset(matchedInnerRows)
 			iter := iw.rowIter
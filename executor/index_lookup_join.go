@@ -34,10 +34,12 @@ import (
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/codec"
 	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/disk"
 	"github.com/pingcap/tidb/util/execdetails"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/memory"
@@ -82,7 +84,16 @@ type IndexLookUpJoin struct {
 	// lastColHelper store the information for last col if there's complicated filter like col > x_col and col < x_col + 100.
 	lastColHelper *plannercore.ColWithCmpFuncManager
 
-	memTracker *memory.Tracker // track memory usage.
+	memTracker  *memory.Tracker // track memory usage.
+	diskTracker *disk.Tracker   // track disk usage.
+
+	// inSpillMode is set by indexLookUpJoinSpillAction once the memory quota of the query is exceeded. After that, the
+	// outer worker shrinks the batch size, and the inner workers spill the inner rows of the tasks to disk.
+	inSpillMode uint32
+	spillAction *indexLookUpJoinSpillAction
+	// spilledInnerResults records the spilled inner results, which are closed when their tasks are finished or the
+	// executor is closed.
+	spilledInnerResults *spilledInnerResults
 
 	stats    *indexLookUpJoinRuntimeStats
 	finished *atomic.Value
@@ -114,7 +125,7 @@ type lookUpJoinTask struct {
 	outerResult *chunk.List
 	outerMatch  [][]bool
 
-	innerResult       *chunk.List
+	innerResult       *chunk.RowContainer
 	encodedLookUpKeys []*chunk.Chunk
 	lookupMap         *mvmap.MVMap
 	matchedInners     []chunk.Row
@@ -142,6 +153,7 @@ type outerWorker struct {
 	innerCh  chan<- *lookUpJoinTask
 
 	parentMemTracker *memory.Tracker
+	stats            *indexLookUpJoinRuntimeStats
 }
 
 type innerWorker struct {
@@ -168,6 +180,7 @@ func (e *IndexLookUpJoin) Open(ctx context.Context) error {
 	}
 	e.memTracker = memory.NewTracker(e.ID(), -1)
 	e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
+	e.openSpill()
 	e.innerPtrBytes = make([][]byte, 0, 8)
 	e.finished.Store(false)
 	if e.RuntimeStats() != nil {
@@ -177,6 +190,30 @@ func (e *IndexLookUpJoin) Open(ctx context.Context) error {
 	return nil
 }
 
+// openSpill creates the disk tracker, and registers the spill action if spilling to disk is enabled.
+func (e *IndexLookUpJoin) openSpill() {
+	e.diskTracker = disk.NewTracker(e.ID(), -1)
+	e.diskTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.DiskTracker)
+	e.spilledInnerResults = &spilledInnerResults{containers: make(map[*chunk.RowContainer]struct{})}
+	atomic.StoreUint32(&e.inSpillMode, 0)
+	e.spillAction = nil
+	if variable.EnableTmpStorageOnOOM.Load() {
+		e.spillAction = &indexLookUpJoinSpillAction{memTracker: e.memTracker, inSpillMode: &e.inSpillMode}
+		e.Ctx().GetSessionVars().MemTracker.FallbackOldAndSetNewAction(e.spillAction)
+	}
+}
+
+// closeSpill closes the inner results remained on disk and finishes the spill action.
+func (e *IndexLookUpJoin) closeSpill() error {
+	if e.spillAction != nil {
+		e.spillAction.SetFinished()
+	}
+	if e.spilledInnerResults == nil {
+		return nil
+	}
+	return e.spilledInnerResults.closeAll()
+}
+
 func (e *IndexLookUpJoin) startWorkers(ctx context.Context) {
 	concurrency := e.Ctx().GetSessionVars().IndexLookupJoinConcurrency()
 	if e.stats != nil {
@@ -207,6 +244,7 @@ func (e *IndexLookUpJoin) newOuterWorker(resultCh, innerCh chan *lookUpJoinTask)
 		maxBatchSize:     e.Ctx().GetSessionVars().IndexJoinBatchSize,
 		parentMemTracker: e.memTracker,
 		lookup:           e,
+		stats:            e.stats,
 	}
 	return ow
 }
@@ -282,7 +320,9 @@ func (e *IndexLookUpJoin) Next(ctx context.Context, req *chunk.Chunk) error {
 		}
 		startTime := time.Now()
 		if e.innerIter == nil || e.innerIter.Current() == e.innerIter.End() {
-			e.lookUpMatchedInners(task, task.cursor)
+			if err := e.lookUpMatchedInners(task, task.cursor); err != nil {
+				return err
+			}
 			if e.innerIter == nil {
 				e.innerIter = chunk.NewIterator4Slice(task.matchedInners).(*chunk.Iterator4Slice)
 			}
@@ -329,6 +369,9 @@ func (e *IndexLookUpJoin) getFinishedTask(ctx context.Context) (*lookUpJoinTask,
 	// The previous task has been processed, so release the occupied memory
 	if task != nil {
 		task.memTracker.Detach()
+		if err := e.spilledInnerResults.close(task.innerResult); err != nil {
+			return nil, err
+		}
 	}
 	select {
 	case task = <-e.resultCh:
@@ -352,16 +395,20 @@ func (e *IndexLookUpJoin) getFinishedTask(ctx context.Context) (*lookUpJoinTask,
 	return task, nil
 }
 
-func (e *IndexLookUpJoin) lookUpMatchedInners(task *lookUpJoinTask, rowPtr chunk.RowPtr) {
+func (e *IndexLookUpJoin) lookUpMatchedInners(task *lookUpJoinTask, rowPtr chunk.RowPtr) error {
 	outerKey := task.encodedLookUpKeys[rowPtr.ChkIdx].GetRow(int(rowPtr.RowIdx)).GetBytes(0)
 	e.innerPtrBytes = task.lookupMap.Get(outerKey, e.innerPtrBytes[:0])
 	task.matchedInners = task.matchedInners[:0]
 
 	for _, b := range e.innerPtrBytes {
 		ptr := *(*chunk.RowPtr)(unsafe.Pointer(&b[0]))
-		matchedInner := task.innerResult.GetRow(ptr)
+		matchedInner, err := task.innerResult.GetRow(ptr)
+		if err != nil {
+			return err
+		}
 		task.matchedInners = append(task.matchedInners, matchedInner)
 	}
+	return nil
 }
 
 func (ow *outerWorker) run(ctx context.Context, wg *sync.WaitGroup) {
@@ -474,6 +521,10 @@ func (ow *outerWorker) buildTask(ctx context.Context) (*lookUpJoinTask, error) {
 }
 
 func (ow *outerWorker) increaseBatchSize() {
+	if atomic.LoadUint32(&ow.lookup.inSpillMode) == 1 {
+		ow.shrinkBatchSize()
+		return
+	}
 	if ow.batchSize < ow.maxBatchSize {
 		ow.batchSize *= 2
 	}
@@ -482,6 +533,18 @@ func (ow *outerWorker) increaseBatchSize() {
 	}
 }
 
+// shrinkBatchSize halves the batch size in spill mode to reduce the memory held by each task.
+func (ow *outerWorker) shrinkBatchSize() {
+	minBatchSize := min(minIndexJoinBatchSizeInSpillMode, ow.maxBatchSize)
+	if ow.batchSize <= minBatchSize {
+		return
+	}
+	ow.batchSize = max(ow.batchSize/2, minBatchSize)
+	if ow.stats != nil {
+		atomic.AddInt64(&ow.stats.batchShrink, 1)
+	}
+}
+
 func (iw *innerWorker) run(ctx context.Context, wg *sync.WaitGroup) {
 	defer trace.StartRegion(ctx, "IndexLookupJoinInnerWorker").End()
 	var task *lookUpJoinTask
@@ -702,9 +765,11 @@ func (iw *innerWorker) fetchInnerResults(ctx context.Context, task *lookUpJoinTa
 		return err
 	}
 
-	innerResult := chunk.NewList(exec.RetTypes(innerExec), iw.ctx.GetSessionVars().MaxChunkSize, iw.ctx.GetSessionVars().MaxChunkSize)
+	innerResult := chunk.NewRowContainer(exec.RetTypes(innerExec), iw.ctx.GetSessionVars().MaxChunkSize)
 	innerResult.GetMemTracker().SetLabel(memory.LabelForBuildSideResult)
 	innerResult.GetMemTracker().AttachTo(task.memTracker)
+	innerResult.GetDiskTracker().AttachTo(iw.lookup.diskTracker)
+	spilled := false
 	for {
 		select {
 		case <-ctx.Done():
@@ -719,8 +784,18 @@ func (iw *innerWorker) fetchInnerResults(ctx context.Context, task *lookUpJoinTa
 		if iw.executorChk.NumRows() == 0 {
 			break
 		}
-		innerResult.Add(iw.executorChk)
+		if err := innerResult.Add(iw.executorChk); err != nil {
+			return err
+		}
 		iw.executorChk = exec.TryNewCacheChunk(innerExec)
+		if !spilled && atomic.LoadUint32(&iw.lookup.inSpillMode) == 1 {
+			iw.lookup.spilledInnerResults.add(innerResult)
+			innerResult.SpillToDisk()
+			spilled = true
+			if iw.stats != nil {
+				atomic.AddInt64(&iw.stats.spill, 1)
+			}
+		}
 	}
 	task.innerResult = innerResult
 	return nil
@@ -736,7 +811,10 @@ func (iw *innerWorker) buildLookUpMap(task *lookUpJoinTask) error {
 	keyBuf := make([]byte, 0, 64)
 	valBuf := make([]byte, 8)
 	for i := 0; i < task.innerResult.NumChunks(); i++ {
-		chk := task.innerResult.GetChunk(i)
+		chk, err := task.innerResult.GetChunk(i)
+		if err != nil {
+			return err
+		}
 		for j := 0; j < chk.NumRows(); j++ {
 			innerRow := chk.GetRow(j)
 			if iw.hasNullInJoinKey(innerRow) {
@@ -778,16 +856,92 @@ func (e *IndexLookUpJoin) Close() error {
 		e.cancelFunc()
 	}
 	e.workerWg.Wait()
+	err := e.closeSpill()
 	e.memTracker = nil
 	e.task = nil
 	e.finished.Store(false)
 	e.prepared = false
-	return e.BaseExecutor.Close()
+	if closeErr := e.BaseExecutor.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// minIndexJoinBatchSizeInSpillMode is the lower bound of the batch size when the outer worker shrinks it in spill mode.
+const minIndexJoinBatchSizeInSpillMode = 32
+
+// indexLookUpJoinSpillAction implements memory.ActionOnExceed for the index lookup joins. If the memory quota of a
+// query is exceeded, it sets the join to spill mode so that the following tasks use smaller batches and keep their
+// inner rows on disk.
+type indexLookUpJoinSpillAction struct {
+	memory.BaseOOMAction
+	memTracker  *memory.Tracker
+	inSpillMode *uint32
+}
+
+// Action sets the index lookup join to spill mode.
+func (a *indexLookUpJoinSpillAction) Action(t *memory.Tracker) {
+	if atomic.LoadUint32(a.inSpillMode) == 0 && a.memTracker.BytesConsumed() > 0 {
+		logutil.BgLogger().Info("memory exceeds quota, set index lookup join to spill mode",
+			zap.Int64("consumed", t.BytesConsumed()),
+			zap.Int64("quota", t.GetBytesLimit()))
+		atomic.StoreUint32(a.inSpillMode, 1)
+		memory.QueryForceDisk.Add(1)
+		return
+	}
+	if fallback := a.GetFallback(); fallback != nil {
+		fallback.Action(t)
+	}
+}
+
+// GetPriority get the priority of the Action
+func (*indexLookUpJoinSpillAction) GetPriority() int64 {
+	return memory.DefSpillPriority
+}
+
+// spilledInnerResults records the inner results spilled to disk, so the ones of the unfinished tasks can still be
+// closed when the executor is closed.
+type spilledInnerResults struct {
+	sync.Mutex
+	containers map[*chunk.RowContainer]struct{}
+}
+
+func (s *spilledInnerResults) add(c *chunk.RowContainer) {
+	s.Lock()
+	s.containers[c] = struct{}{}
+	s.Unlock()
+}
+
+// close closes the inner result if it's spilled.
+func (s *spilledInnerResults) close(c *chunk.RowContainer) error {
+	s.Lock()
+	_, ok := s.containers[c]
+	delete(s.containers, c)
+	s.Unlock()
+	if !ok {
+		return nil
+	}
+	return c.Close()
+}
+
+func (s *spilledInnerResults) closeAll() error {
+	s.Lock()
+	defer s.Unlock()
+	var firstErr error
+	for c := range s.containers {
+		if err := c.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	s.containers = make(map[*chunk.RowContainer]struct{})
+	return firstErr
 }
 
 type indexLookUpJoinRuntimeStats struct {
 	concurrency int
 	probe       int64
+	// batchShrink is the number of times the outer worker shrinks the batch size in spill mode.
+	batchShrink int64
 	innerWorker innerWorkerRuntimeStats
 }
 
@@ -798,6 +952,8 @@ type innerWorkerRuntimeStats struct {
 	fetch     int64
 	build     int64
 	join      int64
+	// spill is the number of the tasks whose inner rows are spilled to disk.
+	spill int64
 }
 
 func (e *indexLookUpJoinRuntimeStats) String() string {
@@ -829,6 +985,13 @@ func (e *indexLookUpJoinRuntimeStats) String() string {
 		buf.WriteString(", probe:")
 		buf.WriteString(execdetails.FormatDuration(time.Duration(e.probe)))
 	}
+	if e.innerWorker.spill > 0 || e.batchShrink > 0 {
+		buf.WriteString(", spill:{round:")
+		buf.WriteString(strconv.FormatInt(e.innerWorker.spill, 10))
+		buf.WriteString(", batch_shrink:")
+		buf.WriteString(strconv.FormatInt(e.batchShrink, 10))
+		buf.WriteString("}")
+	}
 	return buf.String()
 }
 
@@ -836,6 +999,7 @@ func (e *indexLookUpJoinRuntimeStats) Clone() execdetails.RuntimeStats {
 	return &indexLookUpJoinRuntimeStats{
 		concurrency: e.concurrency,
 		probe:       e.probe,
+		batchShrink: e.batchShrink,
 		innerWorker: e.innerWorker,
 	}
 }
@@ -846,12 +1010,14 @@ func (e *indexLookUpJoinRuntimeStats) Merge(rs execdetails.RuntimeStats) {
 		return
 	}
 	e.probe += tmp.probe
+	e.batchShrink += tmp.batchShrink
 	e.innerWorker.totalTime += tmp.innerWorker.totalTime
 	e.innerWorker.task += tmp.innerWorker.task
 	e.innerWorker.construct += tmp.innerWorker.construct
 	e.innerWorker.fetch += tmp.innerWorker.fetch
 	e.innerWorker.build += tmp.innerWorker.build
 	e.innerWorker.join += tmp.innerWorker.join
+	e.innerWorker.spill += tmp.innerWorker.spill
 }
 
 // Tp implements the RuntimeStats interface.
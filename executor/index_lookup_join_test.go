@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/stretchr/testify/require"
 )
@@ -498,3 +499,58 @@ func TestIssue45716(t *testing.T) {
 	err := tk.QueryToErr("select /*+ inl_join(t2) */ * from t1 join t2 on t1.a = t2.a;")
 	tk.MustContainErrMsg(err.Error(), "test inlNewInnerPanic")
 }
+
+func TestIndexJoinSpill(t *testing.T) {
+	restore := config.RestoreFunc()
+	defer restore()
+	config.UpdateGlobal(func(conf *config.Config) {
+		conf.TempStoragePath = t.TempDir()
+	})
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	defer tk.MustExec("SET GLOBAL tidb_mem_oom_action = DEFAULT")
+	tk.MustExec("SET GLOBAL tidb_mem_oom_action='LOG'")
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(a int, b int, key(a))")
+	tk.MustExec("create table t2(a int, b int, key(a))")
+	var buf1, buf2 strings.Builder
+	buf1.WriteString("insert into t1 values ")
+	buf2.WriteString("insert into t2 values ")
+	for i := 0; i < 3000; i++ {
+		if i > 0 {
+			buf1.WriteString(", ")
+			buf2.WriteString(", ")
+		}
+		buf1.WriteString(fmt.Sprintf("(%d, %d)", i%1500, i))
+		buf2.WriteString(fmt.Sprintf("(%d, %d)", i%1000, i))
+	}
+	tk.MustExec(buf1.String())
+	tk.MustExec(buf2.String())
+
+	queries := []string{
+		"select /*+ INL_JOIN(t2) */ t1.a, t1.b, t2.b from t1 join t2 on t1.a = t2.a",
+		"select /*+ INL_JOIN(t2) */ t1.a, t1.b, t2.b from t1 left join t2 on t1.a = t2.a and t1.b > t2.b",
+		"select /*+ INL_HASH_JOIN(t2) */ t1.a, t1.b, t2.b from t1 join t2 on t1.a = t2.a",
+		"select /*+ INL_HASH_JOIN(t2) */ t1.a, t1.b, t2.b from t1 left join t2 on t1.a = t2.a and t1.b > t2.b",
+		// The outer order is kept by the index hash join.
+		"select /*+ INL_HASH_JOIN(t2) */ t1.a, t2.b from t1 use index(a) join t2 on t1.a = t2.a order by t1.a",
+		"select /*+ INL_HASH_JOIN(t2@sel_2) */ t1.a, t1.b from t1 where exists (select 1 from t2 where t1.a = t2.a and t1.b < t2.b)",
+	}
+	for _, sql := range queries {
+		tk.MustExec("set @@tidb_mem_quota_query = default")
+		expected := tk.MustQuery(sql).Sort().Rows()
+		tk.MustExec("set @@tidb_mem_quota_query = 1")
+		tk.MustQuery(sql).Sort().Check(expected)
+
+		spilled := false
+		for _, row := range tk.MustQuery("explain analyze " + sql).Rows() {
+			if strings.Contains(fmt.Sprintf("%v", row[0]), "IndexJoin") || strings.Contains(fmt.Sprintf("%v", row[0]), "IndexHashJoin") {
+				spilled = strings.Contains(fmt.Sprintf("%v", row[5]), "spill:{round:")
+				break
+			}
+		}
+		require.True(t, spilled, sql)
+	}
+	tk.MustExec("set @@tidb_mem_quota_query = default")
+}
@@ -132,6 +132,12 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			e.setDataFromIndexes(sctx, dbs)
 		case infoschema.TableViews:
 			e.setDataFromViews(sctx, dbs)
+		case infoschema.TableEvents:
+			err = e.setDataFromEvents(ctx, sctx, is)
+		case infoschema.TableRoutines:
+			err = e.setDataFromRoutines(ctx, sctx, is)
+		case infoschema.TableTriggers:
+			e.setDataFromTriggers(sctx, dbs)
 		case infoschema.TableEngines:
 			e.setDataFromEngines()
 		case infoschema.TableCharacterSets:
@@ -196,6 +202,12 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			err = e.setDataFromRunawayWatches(sctx)
 		case infoschema.TableCheckConstraints:
 			err = e.setDataFromCheckConstraints(sctx, dbs)
+		case infoschema.TableQueryFeedback:
+			e.setDataForQueryFeedback(sctx)
+		case infoschema.ClusterTableQueryFeedback:
+			err = e.setDataForClusterQueryFeedback(sctx)
+		case infoschema.TableInstancePlanCache:
+			err = e.setDataForInstancePlanCache(sctx)
 		}
 		if err != nil {
 			return nil, err
@@ -400,6 +412,10 @@ func (e *memtableRetriever) setDataForStatisticsInTable(schema *model.DBInfo, ta
 		if index.Unique {
 			nonUnique = "0"
 		}
+		indexType := "BTREE"
+		if index.IsSearchIndex() {
+			indexType = index.Tp.String()
+		}
 		for i, key := range index.Columns {
 			col := nameToCol[key.Name.L]
 			nullable := "YES"
@@ -435,7 +451,7 @@ func (e *memtableRetriever) setDataForStatisticsInTable(schema *model.DBInfo, ta
 				nil,                   // SUB_PART
 				nil,                   // PACKED
 				nullable,              // NULLABLE
-				"BTREE",               // INDEX_TYPE
+				indexType,             // INDEX_TYPE
 				"",                    // COMMENT
 				index.Comment,         // INDEX_COMMENT
 				visible,               // IS_VISIBLE
@@ -657,6 +673,72 @@ func (e *memtableRetriever) setDataFromCheckConstraints(sctx sessionctx.Context,
 	return nil
 }
 
+func (e *memtableRetriever) setDataForQueryFeedback(sctx sessionctx.Context) {
+	h := domain.GetDomain(sctx).StatsHandle()
+	if h == nil {
+		return
+	}
+	feedback := h.QueryFeedback()
+	rows := make([][]types.Datum, 0, len(feedback))
+	checker := privilege.GetPrivilegeManager(sctx)
+	for _, fb := range feedback {
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, fb.DBName, fb.TableName, "", mysql.SelectPriv) {
+			continue
+		}
+		rows = append(rows, types.MakeDatums(
+			fb.DBName,     // TABLE_SCHEMA
+			fb.TableName,  // TABLE_NAME
+			fb.TableID,    // TABLE_ID
+			fb.PlanDigest, // PLAN_DIGEST
+			fb.SQLDigest,  // DIGEST
+			fb.Operator,   // OPERATOR
+			fb.Predicate,  // PREDICATE
+			fb.EstRows,    // EST_ROWS
+			fb.ActRows,    // ACT_ROWS
+			fb.Count,      // EXEC_COUNT
+			types.NewTime(types.FromGoTime(fb.LastSeen.In(sctx.GetSessionVars().TimeZone)), mysql.TypeDatetime, types.DefaultFsp), // LAST_SEEN
+		))
+	}
+	e.rows = rows
+}
+
+func (e *memtableRetriever) setDataForClusterQueryFeedback(sctx sessionctx.Context) error {
+	e.setDataForQueryFeedback(sctx)
+	rows, err := infoschema.AppendHostInfoToRows(sctx, e.rows)
+	if err != nil {
+		return err
+	}
+	e.rows = rows
+	return nil
+}
+
+func (e *memtableRetriever) setDataForInstancePlanCache(sctx sessionctx.Context) error {
+	if !hasPriv(sctx, mysql.ProcessPriv) {
+		return plannercore.ErrSpecificAccessDenied.GenWithStackByArgs("PROCESS")
+	}
+	c := domain.GetDomain(sctx).InstancePlanCache()
+	if c == nil {
+		return nil
+	}
+	entries := c.Entries()
+	rows := make([][]types.Datum, 0, len(entries))
+	loc := sctx.GetSessionVars().TimeZone
+	for _, entry := range entries {
+		rows = append(rows, types.MakeDatums(
+			entry.SchemaName,  // SCHEMA_NAME
+			entry.SQLDigest,   // SQL_DIGEST
+			entry.SQLText,     // SQL_TEXT
+			entry.PlanDigest,  // PLAN_DIGEST
+			entry.MemoryUsage, // MEM_SIZE
+			entry.HitCount,    // HIT_COUNT
+			types.NewTime(types.FromGoTime(entry.LoadTime.In(loc)), mysql.TypeDatetime, types.DefaultFsp),       // LOAD_TIME
+			types.NewTime(types.FromGoTime(entry.LastActiveTime.In(loc)), mysql.TypeDatetime, types.DefaultFsp), // LAST_ACTIVE_TIME
+		))
+	}
+	e.rows = rows
+	return nil
+}
+
 func (e *hugeMemTableRetriever) setDataForColumns(ctx context.Context, sctx sessionctx.Context, extractor *plannercore.ColumnsTableExtractor) error {
 	checker := privilege.GetPrivilegeManager(sctx)
 	e.rows = e.rows[:0]
@@ -864,6 +946,10 @@ ForColumnsTag:
 		if colType == mysql.TypeVarString {
 			colType = mysql.TypeVarchar
 		}
+		dataType := types.TypeToStr(colType, ft.GetCharset())
+		if colType == mysql.TypeGeometry {
+			dataType = types.GeometryTypeStr(ft.GetGeometryType())
+		}
 		record := types.MakeDatums(
 			infoschema.CatalogVal, // TABLE_CATALOG
 			schema.Name.O,         // TABLE_SCHEMA
@@ -872,17 +958,17 @@ ForColumnsTag:
 			i,                     // ORDINAL_POSITION
 			columnDefault,         // COLUMN_DEFAULT
 			columnDesc.Null,       // IS_NULLABLE
-			types.TypeToStr(colType, ft.GetCharset()), // DATA_TYPE
-			charMaxLen,           // CHARACTER_MAXIMUM_LENGTH
-			charOctLen,           // CHARACTER_OCTET_LENGTH
-			numericPrecision,     // NUMERIC_PRECISION
-			numericScale,         // NUMERIC_SCALE
-			datetimePrecision,    // DATETIME_PRECISION
-			columnDesc.Charset,   // CHARACTER_SET_NAME
-			columnDesc.Collation, // COLLATION_NAME
-			columnType,           // COLUMN_TYPE
-			columnDesc.Key,       // COLUMN_KEY
-			columnDesc.Extra,     // EXTRA
+			dataType,              // DATA_TYPE
+			charMaxLen,            // CHARACTER_MAXIMUM_LENGTH
+			charOctLen,            // CHARACTER_OCTET_LENGTH
+			numericPrecision,      // NUMERIC_PRECISION
+			numericScale,          // NUMERIC_SCALE
+			datetimePrecision,     // DATETIME_PRECISION
+			columnDesc.Charset,    // CHARACTER_SET_NAME
+			columnDesc.Collation,  // COLLATION_NAME
+			columnType,            // COLUMN_TYPE
+			columnDesc.Key,        // COLUMN_KEY
+			columnDesc.Extra,      // EXTRA
 			strings.ToLower(privileges.PrivToString(priv, mysql.AllColumnPrivs, mysql.Priv2Str)), // PRIVILEGES
 			columnDesc.Comment,      // COLUMN_COMMENT
 			col.GeneratedExprString, // GENERATION_EXPRESSION
@@ -1173,6 +1259,48 @@ func (e *memtableRetriever) setDataFromViews(ctx sessionctx.Context, schemas []*
 	e.rows = rows
 }
 
+func (e *memtableRetriever) setDataFromEvents(ctx context.Context, sctx sessionctx.Context, is infoschema.InfoSchema) error {
+	events, schemas, err := listVisibleEvents(ctx, sctx, is)
+	if err != nil {
+		return err
+	}
+
+	sessLoc := sctx.GetSessionVars().Location()
+	rows := make([][]types.Datum, 0, len(events))
+	for i, ev := range events {
+		loc := eventLocation(ev)
+		record := types.MakeDatums(
+			infoschema.CatalogVal,               // EVENT_CATALOG
+			schemas[i].Name.O,                   // EVENT_SCHEMA
+			ev.Name,                             // EVENT_NAME
+			eventDefiner(ev),                    // DEFINER
+			ev.TimeZone,                         // TIME_ZONE
+			"SQL",                               // EVENT_BODY
+			ev.Body,                             // EVENT_DEFINITION
+			eventType(ev),                       // EVENT_TYPE
+			eventTime(ev.ExecuteAt, loc),        // EXECUTE_AT
+			eventIntervalValue(ev),              // INTERVAL_VALUE
+			eventIntervalField(ev),              // INTERVAL_FIELD
+			ev.SQLMode,                          // SQL_MODE
+			eventTime(ev.Starts, loc),           // STARTS
+			eventTime(ev.Ends, loc),             // ENDS
+			eventStatus(ev),                     // STATUS
+			eventOnCompletion(ev),               // ON_COMPLETION
+			eventTime(&ev.Created, sessLoc),     // CREATED
+			eventTime(&ev.LastAltered, sessLoc), // LAST_ALTERED
+			eventTime(ev.LastExecuted, sessLoc), // LAST_EXECUTED
+			ev.Comment,                          // EVENT_COMMENT
+			0,                                   // ORIGINATOR
+			ev.CharsetClient,                    // CHARACTER_SET_CLIENT
+			ev.CollationConnection,              // COLLATION_CONNECTION
+			ev.CollationDatabase,                // DATABASE_COLLATION
+		)
+		rows = append(rows, record)
+	}
+	e.rows = rows
+	return nil
+}
+
 func (e *memtableRetriever) dataForTiKVStoreStatus(ctx sessionctx.Context) (err error) {
 	tikvStore, ok := ctx.GetStore().(helper.Storage)
 	if !ok {
@@ -95,6 +95,8 @@ type InsertValues struct {
 	// fkChecks contains the foreign key checkers.
 	fkChecks   []*FKCheckExec
 	fkCascades []*FKCascadeExec
+	// triggers contains the triggers of the table, it's nil if there's no trigger.
+	triggers *triggerExec
 }
 
 type defaultVal struct {
@@ -229,7 +231,7 @@ func insertRows(ctx context.Context, base insertCommon) (err error) {
 			if err != nil {
 				return err
 			}
-			if err = base.exec(ctx, rows); err != nil {
+			if err = execInsertWithTriggers(ctx, base, rows); err != nil {
 				return err
 			}
 			rows = rows[:0]
@@ -249,7 +251,7 @@ func insertRows(ctx context.Context, base insertCommon) (err error) {
 	if err != nil {
 		return err
 	}
-	err = base.exec(ctx, rows)
+	err = execInsertWithTriggers(ctx, base, rows)
 	if err != nil {
 		return err
 	}
@@ -484,7 +486,7 @@ func insertRowsFromSelect(ctx context.Context, base insertCommon) error {
 				memUsageOfExtraCols = types.EstimatedMemUsage(extraColsInSel[0], len(extraColsInSel))
 				memTracker.Consume(memUsageOfRows + memUsageOfExtraCols)
 				e.Ctx().GetSessionVars().CurrInsertBatchExtraCols = extraColsInSel
-				if err = base.exec(ctx, rows); err != nil {
+				if err = execInsertWithTriggers(ctx, base, rows); err != nil {
 					return err
 				}
 				rows = rows[:0]
@@ -504,7 +506,7 @@ func insertRowsFromSelect(ctx context.Context, base insertCommon) error {
 			memTracker.Consume(memUsageOfRows + memUsageOfExtraCols)
 			e.Ctx().GetSessionVars().CurrInsertBatchExtraCols = extraColsInSel
 		}
-		err = base.exec(ctx, rows)
+		err = execInsertWithTriggers(ctx, base, rows)
 		if err != nil {
 			return err
 		}
@@ -1345,7 +1347,11 @@ func (e *InsertValues) removeRow(
 		return true, nil
 	}
 
-	err = r.t.RemoveRecord(e.Ctx(), handle, oldRow)
+	err = e.triggers.fire(ctx, model.TriggerTimingBefore, model.TriggerEventDelete, oldRow, nil)
+	if err != nil {
+		return false, err
+	}
+	err = r.t.RemoveRecord(e.Ctx(), handle, oldRow, table.DeleteWithCtx(ctx))
 	if err != nil {
 		return false, err
 	}
@@ -1353,6 +1359,10 @@ func (e *InsertValues) removeRow(
 	if err != nil {
 		return false, err
 	}
+	err = e.triggers.fire(ctx, model.TriggerTimingAfter, model.TriggerEventDelete, oldRow, nil)
+	if err != nil {
+		return false, err
+	}
 	if inReplace {
 		e.Ctx().GetSessionVars().StmtCtx.AddAffectedRows(1)
 	} else {
@@ -1399,6 +1409,9 @@ func (e *InsertValues) addRecordWithAutoIDHint(
 	if err != nil {
 		return err
 	}
+	if err = e.triggers.fire(ctx, model.TriggerTimingAfter, model.TriggerEventInsert, nil, row); err != nil {
+		return err
+	}
 	vars.StmtCtx.AddAffectedRows(1)
 	if e.lastInsertID != 0 {
 		vars.SetLastInsertID(e.lastInsertID)
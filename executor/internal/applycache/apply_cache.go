@@ -15,21 +15,42 @@
 package applycache
 
 import (
+	"sync/atomic"
+
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/disk"
 	"github.com/pingcap/tidb/util/kvcache"
+	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/mathutil"
 	"github.com/pingcap/tidb/util/memory"
 	"github.com/pingcap/tidb/util/syncutil"
+	"go.uber.org/zap"
 )
 
 // ApplyCache is used in the apply executor. When we get the same value of the outer row.
 // We fetch the inner rows in the cache not to fetch them in the inner executor.
+//
+// Once the memory quota of the query is exceeded, the spill action sets the cache to spill mode. After that, the cached
+// items in memory are moved to disk by the next Set, and all the new items are written to disk directly.
 type ApplyCache struct {
 	cache       *kvcache.SimpleLRUCache // cache.Get/Put are not thread-safe, so it's protected by the lock above
 	memTracker  *memory.Tracker         // track memory usage.
+	diskTracker *disk.Tracker           // track disk usage.
 	memCapacity int64
 	lock        syncutil.Mutex
+
+	// inSpillMode is set by the spill action to notify the cache to move the items to disk.
+	inSpillMode uint32
+	actionSpill *spillDiskAction
+	// inDisk holds the chunks of the spilled items, and diskIndex maps the key of a spilled item to the indexes of its
+	// chunks in inDisk. They are protected by the lock.
+	inDisk     *chunk.ListInDisk
+	diskIndex  map[string][]int
+	fieldTypes []*types.FieldType
+	// spilledCount is the number of items written to disk.
+	spilledCount int64
 }
 
 type applyCacheKey []byte
@@ -51,6 +72,7 @@ func NewApplyCache(ctx sessionctx.Context) (*ApplyCache, error) {
 		cache:       cache,
 		memCapacity: ctx.GetSessionVars().MemQuotaApplyCache,
 		memTracker:  memory.NewTracker(memory.LabelForApplyCache, -1),
+		diskTracker: disk.NewTracker(memory.LabelForApplyCache, -1),
 	}
 	return &c, nil
 }
@@ -71,14 +93,39 @@ func (c *ApplyCache) put(key applyCacheKey, val kvcache.Value) {
 func (c *ApplyCache) Get(key applyCacheKey) (*chunk.List, error) {
 	value, hit := c.get(key)
 	if !hit {
+		if atomic.LoadUint32(&c.inSpillMode) == 1 {
+			return c.getFromDisk(key)
+		}
 		return nil, nil
 	}
 	typedValue := value.(*chunk.List)
 	return typedValue, nil
 }
 
+// getFromDisk reads a spilled item from disk. It returns nil if the item isn't spilled.
+func (c *ApplyCache) getFromDisk(key applyCacheKey) (*chunk.List, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	chkIdxs, ok := c.diskIndex[string(key)]
+	if !ok {
+		return nil, nil
+	}
+	value := chunk.NewList(c.fieldTypes, 1, 1)
+	for _, chkIdx := range chkIdxs {
+		chk, err := c.inDisk.GetChunk(chkIdx)
+		if err != nil {
+			return nil, err
+		}
+		value.Add(chk)
+	}
+	return value, nil
+}
+
 // Set inserts an item to the cache. It's thread-safe.
 func (c *ApplyCache) Set(key applyCacheKey, value *chunk.List) (bool, error) {
+	if atomic.LoadUint32(&c.inSpillMode) == 1 {
+		return c.setToDisk(key, value)
+	}
 	mem := applyCacheKVMem(key, value)
 	if mem > c.memCapacity { // ignore this kv pair if its size is too large
 		return false, nil
@@ -95,7 +142,116 @@ func (c *ApplyCache) Set(key applyCacheKey, value *chunk.List) (bool, error) {
 	return true, nil
 }
 
+// setToDisk writes an item to disk. The items cached in memory are moved to disk at the first time.
+func (c *ApplyCache) setToDisk(key applyCacheKey, value *chunk.List) (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.inDisk == nil {
+		c.fieldTypes = value.FieldTypes()
+		c.inDisk = chunk.NewListInDisk(c.fieldTypes)
+		c.inDisk.GetDiskTracker().AttachTo(c.diskTracker)
+		c.diskIndex = make(map[string][]int)
+		for {
+			evictedKey, evictedValue, evicted := c.cache.RemoveOldest()
+			if !evicted {
+				break
+			}
+			if err := c.writeToDisk(evictedKey.(applyCacheKey), evictedValue.(*chunk.List)); err != nil {
+				return false, err
+			}
+			c.memTracker.Consume(-applyCacheKVMem(evictedKey.(applyCacheKey), evictedValue.(*chunk.List)))
+		}
+	}
+	if _, ok := c.diskIndex[string(key)]; ok {
+		return true, nil
+	}
+	if err := c.writeToDisk(key, value); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *ApplyCache) writeToDisk(key applyCacheKey, value *chunk.List) error {
+	chkIdxs := make([]int, 0, value.NumChunks())
+	for i := 0; i < value.NumChunks(); i++ {
+		chk := value.GetChunk(i)
+		// ListInDisk doesn't accept empty chunks.
+		if chk.NumRows() == 0 {
+			continue
+		}
+		if err := c.inDisk.Add(chk); err != nil {
+			return err
+		}
+		chkIdxs = append(chkIdxs, c.inDisk.NumChunks()-1)
+	}
+	c.diskIndex[string(key)] = chkIdxs
+	c.spilledCount++
+	return nil
+}
+
 // GetMemTracker returns the memory tracker of this apply cache.
 func (c *ApplyCache) GetMemTracker() *memory.Tracker {
 	return c.memTracker
 }
+
+// GetDiskTracker returns the disk tracker of this apply cache.
+func (c *ApplyCache) GetDiskTracker() *disk.Tracker {
+	return c.diskTracker
+}
+
+// SpilledCount returns the number of items written to disk. It's thread-safe.
+func (c *ApplyCache) SpilledCount() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.spilledCount
+}
+
+// ActionSpill returns a memory.ActionOnExceed which sets the cache to spill mode.
+func (c *ApplyCache) ActionSpill() memory.ActionOnExceed {
+	if c.actionSpill == nil {
+		c.actionSpill = &spillDiskAction{c: c}
+	}
+	return c.actionSpill
+}
+
+// Close releases the items spilled to disk.
+func (c *ApplyCache) Close() error {
+	if c.actionSpill != nil {
+		c.actionSpill.SetFinished()
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.inDisk == nil {
+		return nil
+	}
+	err := c.inDisk.Close()
+	c.inDisk = nil
+	c.diskIndex = nil
+	return err
+}
+
+// spillDiskAction implements memory.ActionOnExceed for the apply cache.
+type spillDiskAction struct {
+	memory.BaseOOMAction
+	c *ApplyCache
+}
+
+// Action sets the apply cache to spill mode.
+func (a *spillDiskAction) Action(t *memory.Tracker) {
+	if atomic.LoadUint32(&a.c.inSpillMode) == 0 && a.c.memTracker.BytesConsumed() > 0 {
+		logutil.BgLogger().Info("memory exceeds quota, set apply cache to spill mode",
+			zap.Int64("consumed", t.BytesConsumed()),
+			zap.Int64("quota", t.GetBytesLimit()))
+		atomic.StoreUint32(&a.c.inSpillMode, 1)
+		memory.QueryForceDisk.Add(1)
+		return
+	}
+	if fallback := a.GetFallback(); fallback != nil {
+		fallback.Action(t)
+	}
+}
+
+// GetPriority get the priority of the Action
+func (*spillDiskAction) GetPriority() int64 {
+	return memory.DefSpillPriority
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/memory"
 	"github.com/pingcap/tidb/util/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -77,3 +78,72 @@ func TestApplyCache(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, result)
 }
+
+func TestApplyCacheSpill(t *testing.T) {
+	ctx := mock.NewContext()
+	ctx.GetSessionVars().MemQuotaApplyCache = 1000
+	applyCache, err := NewApplyCache(ctx)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, applyCache.Close())
+	}()
+
+	fields := []*types.FieldType{types.NewFieldType(mysql.TypeLonglong)}
+	newValue := func(v int64) *chunk.List {
+		value := chunk.NewList(fields, 2, 2)
+		srcChunk := chunk.NewChunkWithCapacity(fields, 3)
+		for i := int64(0); i < 3; i++ {
+			srcChunk.AppendInt64(0, v+i)
+		}
+		for i := 0; i < 3; i++ {
+			value.AppendRow(srcChunk.GetRow(i))
+		}
+		return value
+	}
+	checkValue := func(v int64, value *chunk.List) {
+		require.NotNil(t, value)
+		require.Equal(t, 3, value.Len())
+		iter := chunk.NewIterator4List(value)
+		i := int64(0)
+		for row := iter.Begin(); row != iter.End(); row = iter.Next() {
+			require.Equal(t, v+i, row.GetInt64(0))
+			i++
+		}
+	}
+	key := func(i int) []byte {
+		return []byte(strconv.Itoa(i))
+	}
+
+	ok, err := applyCache.Set(key(0), newValue(0))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Greater(t, applyCache.GetMemTracker().BytesConsumed(), int64(0))
+
+	tracker := memory.NewTracker(-1, -1)
+	applyCache.ActionSpill().Action(tracker)
+	ok, err = applyCache.Set(key(1), newValue(10))
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = applyCache.Set(key(2), chunk.NewList(fields, 2, 2))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The item cached in memory is moved to disk as well.
+	require.Equal(t, int64(0), applyCache.GetMemTracker().BytesConsumed())
+	require.Greater(t, applyCache.GetDiskTracker().BytesConsumed(), int64(0))
+	require.Equal(t, int64(3), applyCache.SpilledCount())
+
+	result, err := applyCache.Get(key(0))
+	require.NoError(t, err)
+	checkValue(0, result)
+	result, err = applyCache.Get(key(1))
+	require.NoError(t, err)
+	checkValue(10, result)
+	result, err = applyCache.Get(key(2))
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 0, result.Len())
+	result, err = applyCache.Get(key(3))
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
@@ -0,0 +1,514 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexadvisor
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/opcode"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/util"
+)
+
+// The kinds of the clauses where the columns of a candidate index appear, they're shown in the reason of a
+// recommended index.
+const (
+	usageEqual   = "Equal Predicate"
+	usageRange   = "Range Predicate"
+	usageJoin    = "Join Condition"
+	usageOrderBy = "Order By"
+	usageGroupBy = "Group By"
+)
+
+// indexCandidate is an index which may benefit the workload.
+type indexCandidate struct {
+	schema  model.CIStr
+	tblInfo *model.TableInfo
+	columns []*model.ColumnInfo
+	// usages are the kinds of the clauses where the columns appear.
+	usages []string
+}
+
+// key identifies the candidate, the candidates with the same key are merged.
+func (c *indexCandidate) key() string {
+	var sb strings.Builder
+	sb.WriteString(c.schema.L)
+	sb.WriteByte('.')
+	sb.WriteString(c.tblInfo.Name.L)
+	for _, col := range c.columns {
+		sb.WriteByte(',')
+		sb.WriteString(col.Name.L)
+	}
+	return sb.String()
+}
+
+func (c *indexCandidate) columnNames() []string {
+	names := make([]string, 0, len(c.columns))
+	for _, col := range c.columns {
+		names = append(names, col.Name.O)
+	}
+	return names
+}
+
+// tableRef is a table referenced by a query.
+type tableRef struct {
+	schema  model.CIStr
+	alias   string
+	tblInfo *model.TableInfo
+}
+
+// tableColumnUsage records how the columns of a table are used by a query.
+type tableColumnUsage struct {
+	ref *tableRef
+	// eqCols are the columns in the equal predicates and the join conditions, in the order they appear.
+	eqCols    []*model.ColumnInfo
+	eqUsages  []string
+	rangeCols []*model.ColumnInfo
+	// orderCols are the columns of the ORDER BY and GROUP BY clauses whose items are all columns of this table.
+	orderCols   [][]*model.ColumnInfo
+	orderUsages []string
+}
+
+func (u *tableColumnUsage) addEqCol(col *model.ColumnInfo, usage string) {
+	if !slices.Contains(u.eqCols, col) {
+		u.eqCols = append(u.eqCols, col)
+		u.eqUsages = append(u.eqUsages, usage)
+	}
+}
+
+func (u *tableColumnUsage) addRangeCol(col *model.ColumnInfo) {
+	if !slices.Contains(u.rangeCols, col) {
+		u.rangeCols = append(u.rangeCols, col)
+	}
+}
+
+// columnCollector visits a statement to find the columns which could be indexed.
+type columnCollector struct {
+	tables []*tableRef
+	usages map[*tableRef]*tableColumnUsage
+}
+
+// extractCandidates finds the candidate indexes of a query, the columns appearing in the predicates, the join
+// conditions, the ORDER BY and the GROUP BY clauses of the query are considered. defaultDB is the database to
+// resolve the table names without database.
+func extractCandidates(is infoschema.InfoSchema, stmt ast.StmtNode, defaultDB string, maxIndexColumns int) []*indexCandidate {
+	collector := &columnCollector{usages: make(map[*tableRef]*tableColumnUsage)}
+	collector.tables = collectTableRefs(is, stmt, defaultDB)
+	if len(collector.tables) == 0 {
+		return nil
+	}
+	stmt.Accept(collector)
+
+	var candidates []*indexCandidate
+	for _, ref := range collector.tables {
+		usage, ok := collector.usages[ref]
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, usage.candidates(maxIndexColumns)...)
+	}
+	return filterCandidates(candidates)
+}
+
+// collectTableRefs collects the tables referenced by the statement which could be indexed.
+func collectTableRefs(is infoschema.InfoSchema, stmt ast.StmtNode, defaultDB string) []*tableRef {
+	var refs []*tableRef
+	visitor := &tableSourceVisitor{fn: func(ts *ast.TableSource) {
+		tn, ok := ts.Source.(*ast.TableName)
+		if !ok {
+			return
+		}
+		schema := tn.Schema
+		if schema.L == "" {
+			schema = model.NewCIStr(defaultDB)
+		}
+		if schema.L == "" || util.IsMemOrSysDB(schema.L) {
+			return
+		}
+		tbl, err := is.TableByName(schema, tn.Name)
+		if err != nil {
+			// It may be a CTE.
+			return
+		}
+		tblInfo := tbl.Meta()
+		if tblInfo.IsView() || tblInfo.IsSequence() || tblInfo.TempTableType != model.TempTableNone {
+			return
+		}
+		alias := tn.Name.L
+		if ts.AsName.L != "" {
+			alias = ts.AsName.L
+		}
+		refs = append(refs, &tableRef{schema: schema, alias: alias, tblInfo: tblInfo})
+	}}
+	stmt.Accept(visitor)
+	return refs
+}
+
+type tableSourceVisitor struct {
+	fn func(ts *ast.TableSource)
+}
+
+func (v *tableSourceVisitor) Enter(in ast.Node) (ast.Node, bool) {
+	if ts, ok := in.(*ast.TableSource); ok {
+		v.fn(ts)
+	}
+	return in, false
+}
+
+func (*tableSourceVisitor) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// Enter implements the ast.Visitor interface.
+func (c *columnCollector) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.SelectStmt:
+		c.collectPredicates(x.Where)
+		if x.GroupBy != nil {
+			c.collectByItems(x.GroupBy.Items, usageGroupBy)
+		}
+		if x.OrderBy != nil {
+			c.collectByItems(x.OrderBy.Items, usageOrderBy)
+		}
+	case *ast.Join:
+		if x.On != nil {
+			c.collectPredicates(x.On.Expr)
+		}
+	case *ast.UpdateStmt:
+		c.collectPredicates(x.Where)
+		if x.Order != nil {
+			c.collectByItems(x.Order.Items, usageOrderBy)
+		}
+	case *ast.DeleteStmt:
+		c.collectPredicates(x.Where)
+		if x.Order != nil {
+			c.collectByItems(x.Order.Items, usageOrderBy)
+		}
+	}
+	return in, false
+}
+
+// Leave implements the ast.Visitor interface.
+func (*columnCollector) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+func (c *columnCollector) usageOf(ref *tableRef) *tableColumnUsage {
+	usage, ok := c.usages[ref]
+	if !ok {
+		usage = &tableColumnUsage{ref: ref}
+		c.usages[ref] = usage
+	}
+	return usage
+}
+
+// resolveColumn finds the table and the column which the column name refers to. It returns nil if the column
+// can't be resolved unambiguously.
+func (c *columnCollector) resolveColumn(expr ast.ExprNode) (*tableRef, *model.ColumnInfo) {
+	for {
+		paren, ok := expr.(*ast.ParenthesesExpr)
+		if !ok {
+			break
+		}
+		expr = paren.Expr
+	}
+	colExpr, ok := expr.(*ast.ColumnNameExpr)
+	if !ok {
+		return nil, nil
+	}
+	name := colExpr.Name
+	var (
+		resolvedRef *tableRef
+		resolvedCol *model.ColumnInfo
+	)
+	for _, ref := range c.tables {
+		if name.Table.L != "" && (name.Table.L != ref.alias || (name.Schema.L != "" && name.Schema.L != ref.schema.L)) {
+			continue
+		}
+		col := model.FindColumnInfo(ref.tblInfo.Columns, name.Name.L)
+		if col == nil || col.Hidden {
+			continue
+		}
+		if resolvedCol != nil {
+			// ambiguous column
+			return nil, nil
+		}
+		resolvedRef, resolvedCol = ref, col
+	}
+	return resolvedRef, resolvedCol
+}
+
+// isConstant checks whether the expression doesn't reference any column, so it's a constant during the execution.
+func isConstant(expr ast.ExprNode) bool {
+	checker := &columnRefChecker{}
+	expr.Accept(checker)
+	return !checker.hasColumn
+}
+
+type columnRefChecker struct {
+	hasColumn bool
+}
+
+func (c *columnRefChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch in.(type) {
+	case *ast.ColumnNameExpr, *ast.SubqueryExpr, *ast.DefaultExpr:
+		c.hasColumn = true
+	}
+	return in, c.hasColumn
+}
+
+func (*columnRefChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+func (c *columnCollector) collectPredicates(expr ast.ExprNode) {
+	switch x := expr.(type) {
+	case nil:
+		return
+	case *ast.ParenthesesExpr:
+		c.collectPredicates(x.Expr)
+	case *ast.BinaryOperationExpr:
+		switch x.Op {
+		case opcode.LogicAnd, opcode.LogicOr:
+			c.collectPredicates(x.L)
+			c.collectPredicates(x.R)
+		case opcode.EQ, opcode.NullEQ:
+			lRef, lCol := c.resolveColumn(x.L)
+			rRef, rCol := c.resolveColumn(x.R)
+			switch {
+			case lCol != nil && rCol != nil:
+				if lRef != rRef {
+					c.usageOf(lRef).addEqCol(lCol, usageJoin)
+					c.usageOf(rRef).addEqCol(rCol, usageJoin)
+				}
+			case lCol != nil && isConstant(x.R):
+				c.usageOf(lRef).addEqCol(lCol, usageEqual)
+			case rCol != nil && isConstant(x.L):
+				c.usageOf(rRef).addEqCol(rCol, usageEqual)
+			}
+		case opcode.LT, opcode.LE, opcode.GT, opcode.GE:
+			if ref, col := c.resolveColumn(x.L); col != nil && isConstant(x.R) {
+				c.usageOf(ref).addRangeCol(col)
+			} else if ref, col := c.resolveColumn(x.R); col != nil && isConstant(x.L) {
+				c.usageOf(ref).addRangeCol(col)
+			}
+		}
+	case *ast.PatternInExpr:
+		if x.Not || x.Sel != nil || !isConstant(&ast.RowExpr{Values: x.List}) {
+			return
+		}
+		if ref, col := c.resolveColumn(x.Expr); col != nil {
+			c.usageOf(ref).addEqCol(col, usageEqual)
+		}
+	case *ast.BetweenExpr:
+		if x.Not || !isConstant(x.Left) || !isConstant(x.Right) {
+			return
+		}
+		if ref, col := c.resolveColumn(x.Expr); col != nil {
+			c.usageOf(ref).addRangeCol(col)
+		}
+	case *ast.IsNullExpr:
+		if x.Not {
+			return
+		}
+		if ref, col := c.resolveColumn(x.Expr); col != nil {
+			c.usageOf(ref).addEqCol(col, usageEqual)
+		}
+	case *ast.PatternLikeOrIlikeExpr:
+		if x.Not || !x.IsLike {
+			return
+		}
+		// Only the patterns with a constant prefix can be converted to ranges.
+		pattern, ok := x.Pattern.(ast.ValueExpr)
+		if !ok {
+			return
+		}
+		str, ok := pattern.GetValue().(string)
+		if !ok || len(str) == 0 || str[0] == '%' || str[0] == '_' {
+			return
+		}
+		if ref, col := c.resolveColumn(x.Expr); col != nil {
+			c.usageOf(ref).addRangeCol(col)
+		}
+	}
+}
+
+// collectByItems collects the columns of the ORDER BY or GROUP BY clause, the clause is considered only if all of
+// its items are columns of the same table and have the same order.
+func (c *columnCollector) collectByItems(items []*ast.ByItem, usage string) {
+	var (
+		ref  *tableRef
+		cols []*model.ColumnInfo
+	)
+	for _, item := range items {
+		itemRef, col := c.resolveColumn(item.Expr)
+		if col == nil || (ref != nil && itemRef != ref) || item.Desc != items[0].Desc {
+			return
+		}
+		ref = itemRef
+		if !slices.Contains(cols, col) {
+			cols = append(cols, col)
+		}
+	}
+	if ref == nil {
+		return
+	}
+	u := c.usageOf(ref)
+	u.orderCols = append(u.orderCols, cols)
+	u.orderUsages = append(u.orderUsages, usage)
+}
+
+// candidates generates the candidate indexes of the table with at most maxColumns columns.
+func (u *tableColumnUsage) candidates(maxColumns int) []*indexCandidate {
+	var candidates []*indexCandidate
+	add := func(cols []*model.ColumnInfo, usages ...string) {
+		if len(cols) > maxColumns {
+			cols = cols[:maxColumns]
+		}
+		candidates = append(candidates, &indexCandidate{
+			schema:  u.ref.schema,
+			tblInfo: u.ref.tblInfo,
+			columns: slices.Clone(cols),
+			usages:  dedupStrings(usages),
+		})
+	}
+	// single column indexes
+	for i, col := range u.eqCols {
+		add([]*model.ColumnInfo{col}, u.eqUsages[i])
+	}
+	for _, col := range u.rangeCols {
+		if !slices.Contains(u.eqCols, col) {
+			add([]*model.ColumnInfo{col}, usageRange)
+		}
+	}
+	// the columns in the equal predicates are followed by a column in a range predicate
+	if len(u.eqCols) > 1 {
+		add(u.eqCols, u.eqUsages...)
+	}
+	if len(u.eqCols) > 0 && maxColumns > 1 {
+		prefix := u.eqCols
+		if len(prefix) > maxColumns-1 {
+			prefix = prefix[:maxColumns-1]
+		}
+		for _, col := range u.rangeCols {
+			if slices.Contains(u.eqCols, col) {
+				continue
+			}
+			add(append(slices.Clone(prefix), col), append(slices.Clone(u.eqUsages[:len(prefix)]), usageRange)...)
+		}
+	}
+	// the columns in the equal predicates are followed by the columns to keep order
+	for i, orderCols := range u.orderCols {
+		if len(orderCols) > 1 || len(u.eqCols) == 0 {
+			add(orderCols, u.orderUsages[i])
+		}
+		if len(u.eqCols) == 0 {
+			continue
+		}
+		var prefix []*model.ColumnInfo
+		var usages []string
+		for j, col := range u.eqCols {
+			if !slices.Contains(orderCols, col) {
+				prefix = append(prefix, col)
+				usages = append(usages, u.eqUsages[j])
+			}
+		}
+		if len(prefix) > 0 && len(prefix) < maxColumns {
+			add(append(prefix, orderCols...), append(usages, u.orderUsages[i])...)
+		}
+	}
+	return candidates
+}
+
+// filterCandidates removes the duplicated candidates, the candidates which can't be created and the candidates
+// covered by the existing indexes.
+func filterCandidates(candidates []*indexCandidate) []*indexCandidate {
+	filtered := make([]*indexCandidate, 0, len(candidates))
+	keys := make(map[string]*indexCandidate, len(candidates))
+	for _, c := range candidates {
+		if existing, ok := keys[c.key()]; ok {
+			existing.usages = dedupStrings(append(existing.usages, c.usages...))
+			continue
+		}
+		if !isIndexable(c) || isCoveredByExistingIndex(c) {
+			continue
+		}
+		keys[c.key()] = c
+		filtered = append(filtered, c)
+	}
+	return filtered
+}
+
+// isIndexable checks whether all the columns of the candidate can be indexed without a prefix length.
+func isIndexable(c *indexCandidate) bool {
+	for _, col := range c.columns {
+		if col.IsGenerated() && !col.GeneratedStored {
+			continue
+		}
+		switch col.GetType() {
+		case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong,
+			mysql.TypeFloat, mysql.TypeDouble, mysql.TypeNewDecimal, mysql.TypeYear,
+			mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration,
+			mysql.TypeEnum, mysql.TypeSet, mysql.TypeBit:
+		case mysql.TypeVarchar, mysql.TypeVarString, mysql.TypeString:
+			// Each character takes up to 4 bytes, and the max length of an index key is 3072 bytes.
+			if col.GetFlen() > 768 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isCoveredByExistingIndex checks whether the columns of the candidate are a prefix of an existing index or the
+// clustered primary key.
+func isCoveredByExistingIndex(c *indexCandidate) bool {
+	if c.tblInfo.PKIsHandle && len(c.columns) == 1 && mysql.HasPriKeyFlag(c.columns[0].GetFlag()) {
+		return true
+	}
+	for _, idx := range c.tblInfo.Indices {
+		if idx.State != model.StatePublic || len(idx.Columns) < len(c.columns) {
+			continue
+		}
+		covered := true
+		for i, col := range c.columns {
+			if idx.Columns[i].Offset != col.Offset || idx.Columns[i].Length != types.UnspecifiedLength && idx.Columns[i].Length < col.GetFlen() {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return true
+		}
+	}
+	return false
+}
+
+func dedupStrings(strs []string) []string {
+	deduped := make([]string, 0, len(strs))
+	for _, s := range strs {
+		if !slices.Contains(deduped, s) {
+			deduped = append(deduped, s)
+		}
+	}
+	return deduped
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexadvisor
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractCandidates(t *testing.T) {
+	p := parser.New()
+	var tables []*model.TableInfo
+	for i, sql := range []string{
+		"create table t1 (a int primary key, b int, c int, d varchar(20), e text, key idx_b_c(b, c))",
+		"create table t2 (a int, b int, c int)",
+	} {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err)
+		tblInfo, err := ddl.BuildTableInfoFromAST(stmt.(*ast.CreateTableStmt))
+		require.NoError(t, err)
+		tblInfo.ID = int64(i + 1)
+		for _, idx := range tblInfo.Indices {
+			idx.State = model.StatePublic
+		}
+		tables = append(tables, tblInfo)
+	}
+	is := infoschema.MockInfoSchema(tables)
+
+	tests := []struct {
+		sql        string
+		candidates []string
+	}{
+		// the clustered primary key and the existing index cover the columns
+		{"select * from t1 where a = 1", nil},
+		{"select * from t1 where b = 1 and c > 1", []string{"test.t1,c"}},
+		// the text column can't be indexed without a prefix length
+		{"select * from t1 where e = 'x'", nil},
+		{"select * from t1 where d = 'x' and c > 1", []string{"test.t1,d", "test.t1,c", "test.t1,d,c"}},
+		{"select * from t2 where a = 1 and b = 2 order by c", []string{"test.t2,a", "test.t2,b", "test.t2,a,b", "test.t2,a,b,c"}},
+		{"select * from t2 where b in (1, 2) group by c", []string{"test.t2,b", "test.t2,b,c"}},
+		{"select * from t1 join t2 on t1.d = t2.c where t2.a < 10", []string{"test.t1,d", "test.t2,c", "test.t2,a", "test.t2,c,a"}},
+		{"update t2 set c = 1 where a = 1", []string{"test.t2,a"}},
+		{"delete from t2 where b > 1", []string{"test.t2,b"}},
+	}
+	for _, tt := range tests {
+		stmt, err := p.ParseOneStmt(tt.sql, "", "")
+		require.NoError(t, err)
+		var keys []string
+		for _, c := range extractCandidates(is, stmt, "test", 3) {
+			keys = append(keys, c.key())
+		}
+		require.ElementsMatch(t, tt.candidates, keys, tt.sql)
+	}
+}
+
+func TestIndexName(t *testing.T) {
+	used := map[string]struct{}{"idx_a": {}, "idx_a_1": {}}
+	require.Equal(t, "idx_a_2", indexName([]string{"a"}, used))
+	require.Equal(t, "idx_A_b", indexName([]string{"A", "b"}, used))
+}
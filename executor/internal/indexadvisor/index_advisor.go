@@ -0,0 +1,325 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexadvisor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessiontxn"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/logutil"
+	stmtsummaryv2 "github.com/pingcap/tidb/util/stmtsummary/v2"
+	"github.com/pingcap/tidb/util/stringutil"
+	"go.uber.org/zap"
+)
+
+// The options of RECOMMEND INDEX and their default values.
+const (
+	optMaxNumIndex     = "max_num_index"
+	optMaxIndexColumns = "max_index_columns"
+	optMaxNumQuery     = "max_num_query"
+
+	defMaxNumIndex     = 5
+	defMaxIndexColumns = 3
+	defMaxNumQuery     = 1000
+
+	// maxTopImpactedQueries is the max number of queries shown in the TOP_IMPACTED_QUERIES column.
+	maxTopImpactedQueries = 3
+)
+
+// optionRanges are the valid ranges of the options.
+var optionRanges = map[string][2]uint64{
+	optMaxNumIndex:     {1, 100},
+	optMaxIndexColumns: {1, 16},
+	optMaxNumQuery:     {1, 10000},
+}
+
+// Executor is the executor of RECOMMEND INDEX. It enumerates the candidate indexes from the predicates, the join
+// conditions and the ORDER BY / GROUP BY clauses of the workload, evaluates them as hypothetical indexes with the
+// cost model of the optimizer, and outputs the indexes which reduce the cost of the workload most.
+type Executor struct {
+	exec.BaseExecutor
+
+	// SQL is the query to recommend indexes for, the statements summary is used as the workload if it's empty.
+	SQL     string
+	Options []ast.RecommendIndexOption
+
+	opts            map[string]uint64
+	workload        []*workloadStmt
+	done            bool
+	recommendations []*recommendation
+	cursor          int
+}
+
+// Open implements the interface of Executor.
+func (e *Executor) Open(ctx context.Context) error {
+	e.opts = map[string]uint64{
+		optMaxNumIndex:     defMaxNumIndex,
+		optMaxIndexColumns: defMaxIndexColumns,
+		optMaxNumQuery:     defMaxNumQuery,
+	}
+	for _, opt := range e.Options {
+		valid, ok := optionRanges[opt.Option]
+		if !ok {
+			return errors.Errorf("unknown option %s for RECOMMEND INDEX", opt.Option)
+		}
+		if opt.Value < valid[0] || opt.Value > valid[1] {
+			return errors.Errorf("invalid value %d for option %s, it should be in [%d, %d]", opt.Value, opt.Option, valid[0], valid[1])
+		}
+		e.opts[opt.Option] = opt.Value
+	}
+	workload, err := e.loadWorkload(int(e.opts[optMaxNumQuery]))
+	if err != nil {
+		return err
+	}
+	e.workload = workload
+	return e.BaseExecutor.Open(ctx)
+}
+
+// Next implements the interface of Executor.
+func (e *Executor) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if !e.done {
+		e.done = true
+		ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+		recommendations, err := e.recommend(ctx)
+		if err != nil {
+			return err
+		}
+		e.recommendations = recommendations
+	}
+	sqlMode := e.Ctx().GetSessionVars().SQLMode
+	for ; e.cursor < len(e.recommendations) && !req.IsFull(); e.cursor++ {
+		if err := appendRecommendation(req, e.recommendations[e.cursor], sqlMode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Executor) recommend(ctx context.Context) ([]*recommendation, error) {
+	is := sessiontxn.GetTxnManager(e.Ctx()).GetTxnInfoSchema()
+	queries, candidates := prepareWorkload(is, e.workload, int(e.opts[optMaxIndexColumns]))
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	o := &whatIfOptimizer{sctx: e.Ctx()}
+	recommendations, err := selectIndexes(ctx, o, queries, newHypoIndexes(candidates), int(e.opts[optMaxNumIndex]))
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(recommendations, func(a, b *recommendation) int {
+		switch {
+		case a.benefit > b.benefit:
+			return -1
+		case a.benefit < b.benefit:
+			return 1
+		}
+		return 0
+	})
+	return recommendations, nil
+}
+
+// workloadStmt is a parsed statement of the workload.
+type workloadStmt struct {
+	schemaName string
+	stmt       ast.StmtNode
+	frequency  int64
+}
+
+// loadWorkload parses the given SQL or the statements in the statements summary. At most maxNumQuery most frequent
+// statements are loaded from the statements summary.
+func (e *Executor) loadWorkload(maxNumQuery int) ([]*workloadStmt, error) {
+	sessVars := e.Ctx().GetSessionVars()
+	charset, collation := sessVars.GetCharsetInfo()
+	p := parser.New()
+	p.SetSQLMode(sessVars.SQLMode)
+	p.SetParserConfig(sessVars.BuildParserConfig())
+	if e.SQL != "" {
+		stmts, _, err := p.ParseSQL(e.SQL, parser.CharsetConnection(charset), parser.CollationConnection(collation))
+		if err != nil {
+			return nil, err
+		}
+		workload := make([]*workloadStmt, 0, len(stmts))
+		for _, stmt := range stmts {
+			if !isAdvisable(stmt) {
+				return nil, errors.Errorf("RECOMMEND INDEX only supports SELECT, UPDATE and DELETE statements")
+			}
+			workload = append(workload, &workloadStmt{schemaName: sessVars.CurrentDB, stmt: stmt, frequency: 1})
+		}
+		return workload, nil
+	}
+
+	type digestKey struct {
+		schema string
+		digest string
+	}
+	var (
+		keys   []digestKey
+		digest = make(map[digestKey]*workloadStmt)
+	)
+	for _, s := range stmtsummaryv2.GetBindablePlans() {
+		key := digestKey{schema: s.Schema, digest: s.Digest}
+		if w, ok := digest[key]; ok {
+			// A statement may have several plans.
+			w.frequency += s.ExecCount
+			continue
+		}
+		stmt, err := p.ParseOneStmt(s.Query, s.Charset, s.Collation)
+		if err != nil {
+			// The sample SQL may be truncated.
+			logutil.BgLogger().Debug("parse the workload for index advisor failed",
+				zap.String("sql", s.Query), zap.Error(err))
+			continue
+		}
+		if !isAdvisable(stmt) {
+			continue
+		}
+		keys = append(keys, key)
+		digest[key] = &workloadStmt{schemaName: s.Schema, stmt: stmt, frequency: s.ExecCount}
+	}
+	workload := make([]*workloadStmt, 0, len(keys))
+	for _, key := range keys {
+		workload = append(workload, digest[key])
+	}
+	slices.SortStableFunc(workload, func(a, b *workloadStmt) int {
+		switch {
+		case a.frequency > b.frequency:
+			return -1
+		case a.frequency < b.frequency:
+			return 1
+		}
+		return 0
+	})
+	if len(workload) > maxNumQuery {
+		workload = workload[:maxNumQuery]
+	}
+	return workload, nil
+}
+
+func isAdvisable(stmt ast.StmtNode) bool {
+	switch stmt.(type) {
+	case *ast.SelectStmt, *ast.SetOprStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+		return true
+	}
+	return false
+}
+
+// prepareWorkload extracts the candidate indexes of the workload and restores the statements to be explained.
+func prepareWorkload(is infoschema.InfoSchema, workload []*workloadStmt, maxIndexColumns int) ([]*query, []*indexCandidate) {
+	var (
+		queries    = make([]*query, 0, len(workload))
+		candidates []*indexCandidate
+	)
+	for _, w := range workload {
+		var sb strings.Builder
+		if err := w.stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+			continue
+		}
+		q := &query{schemaName: w.schemaName, sql: sb.String(), frequency: w.frequency, tables: make(map[string]struct{})}
+		for _, ref := range collectTableRefs(is, w.stmt, w.schemaName) {
+			q.tables[ref.schema.L+"."+ref.tblInfo.Name.L] = struct{}{}
+		}
+		if len(q.tables) == 0 {
+			continue
+		}
+		queries = append(queries, q)
+		candidates = append(candidates, extractCandidates(is, w.stmt, w.schemaName, maxIndexColumns)...)
+	}
+	return queries, filterCandidates(candidates)
+}
+
+// impactedQuery is shown in the TOP_IMPACTED_QUERIES column.
+type impactedQuery struct {
+	Query       string  `json:"query"`
+	Frequency   int64   `json:"frequency"`
+	Improvement float64 `json:"improvement"`
+}
+
+// appendRecommendation appends a recommended index to the chunk, the identifiers in the CREATE INDEX statement are
+// quoted according to the SQL mode.
+func appendRecommendation(req *chunk.Chunk, r *recommendation, sqlMode mysql.SQLMode) error {
+	idx := r.index
+	columns := idx.columnNames()
+
+	impacts := slices.Clone(r.impacts)
+	slices.SortStableFunc(impacts, func(a, b *queryImpact) int {
+		switch {
+		case a.benefit() > b.benefit():
+			return -1
+		case a.benefit() < b.benefit():
+			return 1
+		}
+		return 0
+	})
+	if len(impacts) > maxTopImpactedQueries {
+		impacts = impacts[:maxTopImpactedQueries]
+	}
+	topQueries := make([]impactedQuery, 0, len(impacts))
+	for _, impact := range impacts {
+		improvement := 0.0
+		if impact.oldCost > 0 {
+			improvement = (impact.oldCost - impact.newCost) / impact.oldCost
+		}
+		topQueries = append(topQueries, impactedQuery{
+			Query:       impact.query.sql,
+			Frequency:   impact.query.frequency,
+			Improvement: float64(int64(improvement*10000)) / 10000,
+		})
+	}
+	topQueriesJSON, err := json.Marshal(topQueries)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	quotedColumns := make([]string, 0, len(columns))
+	for _, col := range columns {
+		quotedColumns = append(quotedColumns, stringutil.Escape(col, sqlMode))
+	}
+	createStmt := fmt.Sprintf("CREATE INDEX %s ON %s.%s(%s)",
+		stringutil.Escape(idx.info.Name.O, sqlMode), stringutil.Escape(idx.schema.O, sqlMode),
+		stringutil.Escape(idx.tblInfo.Name.O, sqlMode), strings.Join(quotedColumns, ", "))
+	reason := fmt.Sprintf("Column [%s] appear in %s clause(s) in the workload, and the index reduces the estimated cost of %d quer%s",
+		strings.Join(columns, " "), strings.Join(idx.usages, ", "), len(r.impacts), pluralSuffix(len(r.impacts)))
+
+	req.AppendString(0, idx.schema.O)
+	req.AppendString(1, idx.tblInfo.Name.O)
+	req.AppendString(2, idx.info.Name.O)
+	req.AppendString(3, strings.Join(columns, ","))
+	req.AppendFloat64(4, float64(int64(r.benefit*100))/100)
+	req.AppendString(5, reason)
+	req.AppendString(6, string(topQueriesJSON))
+	req.AppendString(7, createStmt)
+	return nil
+}
+
+func pluralSuffix(n int) string {
+	if n == 1 {
+		return "y"
+	}
+	return "ies"
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexadvisor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+// fillTable doubles the rows of the table (a, b, c, d) several times with distinct values and analyzes it, so that
+// the indexes are cheaper than the full table scan.
+func fillTable(tk *testkit.TestKit, tbl string) {
+	for i := 0; i < 10; i++ {
+		n := tk.MustQuery("select count(*) from " + tbl).Rows()[0][0]
+		tk.MustExec(fmt.Sprintf("insert into %[1]s select a + %[2]v, b + %[2]v, c + %[2]v, d + %[2]v from %[1]s", tbl, n))
+	}
+	tk.MustExec("analyze table " + tbl)
+}
+
+func TestRecommendIndex(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int, d int)")
+	tk.MustExec("insert into t values (1, 1, 1, 1), (2, 2, 2, 2), (3, 3, 3, 3)")
+	fillTable(tk, "t")
+
+	rows := tk.MustQuery("recommend index run for 'select * from t where a = 1'").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, []any{"test", "t", "idx_a", "a"}, rows[0][:4])
+	require.Equal(t, "CREATE INDEX `idx_a` ON `test`.`t`(`a`)", rows[0][7])
+	require.Contains(t, rows[0][6], "SELECT * FROM `t` WHERE `a`=1")
+
+	// the recommended indexes are hypothetical, they are not created
+	tbl, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	require.Empty(t, tbl.Meta().Indices)
+	require.Nil(t, tk.Session().GetSessionVars().HypoIndexes)
+
+	// the index on b is preferred since it supports both queries
+	rows = tk.MustQuery("recommend index run for 'select * from t where b = 1; select * from t where b < 10 order by b' with max_num_index = 1").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "b", rows[0][3])
+
+	rows = tk.MustQuery("recommend index run for 'select * from t where c = 1 and d > 1' with max_num_index = 3, max_index_columns = 1").Rows()
+	for _, row := range rows {
+		require.NotContains(t, row[3], ",")
+	}
+
+	tk.MustQuery("recommend index run for 'select * from t'").Check(testkit.Rows())
+	tk.MustGetErrMsg("recommend index run for 'insert into t values (1, 1, 1, 1)'",
+		"RECOMMEND INDEX only supports SELECT, UPDATE and DELETE statements")
+	tk.MustGetErrMsg("recommend index run for 'select * from t where a = 1' with unknown = 1",
+		"unknown option unknown for RECOMMEND INDEX")
+	tk.MustGetErrMsg("recommend index run for 'select * from t where a = 1' with max_num_index = 0",
+		"invalid value 0 for option max_num_index, it should be in [1, 100]")
+}
+
+func TestRecommendIndexFromWorkload(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_enable_stmt_summary = 1")
+	defer tk.MustExec("set global tidb_enable_stmt_summary = default")
+	tk.MustExec("create table t (a int, b int, c int, d int)")
+	tk.MustExec("insert into t values (1, 1, 1, 1), (2, 2, 2, 2), (3, 3, 3, 3)")
+	fillTable(tk, "t")
+	for i := 0; i < 3; i++ {
+		tk.MustQuery("select * from t where b = 1")
+	}
+
+	rows := tk.MustQuery("recommend index run").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, []any{"test", "t", "idx_b", "b"}, rows[0][:4])
+	require.Contains(t, rows[0][6], `"frequency":3`)
+}
+
+func TestRecommendIndexPrivilege(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create user u")
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u", Hostname: "localhost"}, nil, nil, nil))
+	tk1.MustGetErrMsg("recommend index run", "[planner:1227]Access denied; you need (at least one of) the PROCESS privilege(s) for this operation")
+	tk.MustExec("grant process on *.* to u")
+	tk1.MustExec("recommend index run")
+}
@@ -0,0 +1,255 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexadvisor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+// query is a statement of the workload.
+type query struct {
+	schemaName string
+	// sql is the restored statement, it's explained to get the cost of its plan.
+	sql       string
+	frequency int64
+	// tables are the lower case names of the tables referenced by the query, in the format of `db.tbl`.
+	tables map[string]struct{}
+}
+
+// hypoIndex is a candidate index evaluated as a hypothetical index.
+type hypoIndex struct {
+	*indexCandidate
+	info *model.IndexInfo
+}
+
+func (idx *hypoIndex) tableKey() string {
+	return idx.schema.L + "." + idx.tblInfo.Name.L
+}
+
+// newHypoIndexes builds the hypothetical index infos of the candidates. The indexes on the same table get different
+// names and IDs, which don't conflict with the existing indexes.
+func newHypoIndexes(candidates []*indexCandidate) []*hypoIndex {
+	hypoIndexes := make([]*hypoIndex, 0, len(candidates))
+	nextIDs := make(map[*model.TableInfo]int64)
+	usedNames := make(map[*model.TableInfo]map[string]struct{})
+	for _, c := range candidates {
+		names, ok := usedNames[c.tblInfo]
+		if !ok {
+			names = make(map[string]struct{}, len(c.tblInfo.Indices))
+			for _, idx := range c.tblInfo.Indices {
+				names[idx.Name.L] = struct{}{}
+			}
+			usedNames[c.tblInfo] = names
+			nextIDs[c.tblInfo] = c.tblInfo.MaxIndexID
+		}
+		name := indexName(c.columnNames(), names)
+		names[strings.ToLower(name)] = struct{}{}
+		nextIDs[c.tblInfo]++
+
+		columns := make([]*model.IndexColumn, 0, len(c.columns))
+		for _, col := range c.columns {
+			columns = append(columns, &model.IndexColumn{
+				Name:   col.Name,
+				Offset: col.Offset,
+				Length: types.UnspecifiedLength,
+			})
+		}
+		hypoIndexes = append(hypoIndexes, &hypoIndex{
+			indexCandidate: c,
+			info: &model.IndexInfo{
+				ID:      nextIDs[c.tblInfo],
+				Name:    model.NewCIStr(name),
+				Table:   c.tblInfo.Name,
+				Columns: columns,
+				State:   model.StatePublic,
+				Tp:      model.IndexTypeHypo,
+			},
+		})
+	}
+	return hypoIndexes
+}
+
+// indexName generates the name of an index like `idx_a_b`, which isn't used by the table.
+func indexName(columns []string, used map[string]struct{}) string {
+	name := "idx_" + strings.Join(columns, "_")
+	if len(name) > 60 {
+		name = name[:60]
+	}
+	candidate := name
+	for i := 1; ; i++ {
+		if _, ok := used[strings.ToLower(candidate)]; !ok {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+}
+
+// whatIfOptimizer gets the costs of the queries' plans as if the hypothetical indexes existed.
+type whatIfOptimizer struct {
+	sctx sessionctx.Context
+}
+
+// queryCost explains the query with the hypothetical indexes, and returns the estimated cost of its plan and whether
+// the plan uses the given index.
+func (o *whatIfOptimizer) queryCost(ctx context.Context, q *query, indexes []*hypoIndex, target *hypoIndex) (cost float64, used bool, err error) {
+	sessVars := o.sctx.GetSessionVars()
+	origHypoIndexes, origDB, origStmtCtx := sessVars.HypoIndexes, sessVars.CurrentDB, sessVars.StmtCtx
+	defer func() {
+		sessVars.HypoIndexes, sessVars.CurrentDB, sessVars.StmtCtx = origHypoIndexes, origDB, origStmtCtx
+	}()
+	sessVars.HypoIndexes = buildHypoIndexMap(indexes)
+	sessVars.CurrentDB = q.schemaName
+
+	// Usually passing a sprintf to ExecuteInternal is not recommended, but in this case
+	// it is safe because ExecuteInternal does not permit MultiStatement execution. Thus,
+	// the statement won't be able to "break out" from EXPLAIN.
+	rs, err := o.sctx.(sqlexec.SQLExecutor).ExecuteInternal(ctx, fmt.Sprintf("EXPLAIN FORMAT = 'verbose' %s", q.sql))
+	if err != nil {
+		return 0, false, err
+	}
+	rows, err := sqlexec.DrainRecordSet(ctx, rs, sessVars.MaxChunkSize)
+	if closeErr := rs.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if len(rows) == 0 {
+		return 0, false, errors.Errorf("no plan is explained for %s", q.sql)
+	}
+	// The columns are id, estRows, estCost, task, access object and operator info.
+	cost, err = strconv.ParseFloat(rows[0].GetString(2), 64)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	if target != nil {
+		accessedIndex := fmt.Sprintf("index:%s(", target.info.Name.O)
+		for _, row := range rows {
+			if strings.Contains(row.GetString(4), accessedIndex) {
+				used = true
+				break
+			}
+		}
+	}
+	return cost, used, nil
+}
+
+func buildHypoIndexMap(indexes []*hypoIndex) map[string]map[string]map[string]*model.IndexInfo {
+	if len(indexes) == 0 {
+		return nil
+	}
+	hypoIndexes := make(map[string]map[string]map[string]*model.IndexInfo)
+	for _, idx := range indexes {
+		db, tbl := idx.schema.L, idx.tblInfo.Name.L
+		if hypoIndexes[db] == nil {
+			hypoIndexes[db] = make(map[string]map[string]*model.IndexInfo)
+		}
+		if hypoIndexes[db][tbl] == nil {
+			hypoIndexes[db][tbl] = make(map[string]*model.IndexInfo)
+		}
+		hypoIndexes[db][tbl][idx.info.Name.L] = idx.info
+	}
+	return hypoIndexes
+}
+
+// queryImpact is the improvement of a query brought by a recommended index.
+type queryImpact struct {
+	query   *query
+	oldCost float64
+	newCost float64
+}
+
+func (i *queryImpact) benefit() float64 {
+	return float64(i.query.frequency) * (i.oldCost - i.newCost)
+}
+
+// recommendation is a recommended index.
+type recommendation struct {
+	index   *hypoIndex
+	benefit float64
+	impacts []*queryImpact
+}
+
+// selectIndexes chooses at most maxNumIndex indexes greedily. In each round, every remaining index is evaluated
+// together with the chosen ones, and the index reducing the total cost of the workload most is chosen.
+func selectIndexes(ctx context.Context, o *whatIfOptimizer, queries []*query, indexes []*hypoIndex, maxNumIndex int) ([]*recommendation, error) {
+	costs := make(map[*query]float64, len(queries))
+	valid := make([]*query, 0, len(queries))
+	for _, q := range queries {
+		cost, _, err := o.queryCost(ctx, q, nil, nil)
+		if err != nil {
+			// The query may be truncated or can't be explained, ignore it.
+			continue
+		}
+		costs[q] = cost
+		valid = append(valid, q)
+	}
+
+	var (
+		chosen      []*hypoIndex
+		recommended []*recommendation
+	)
+	remaining := indexes
+	for len(recommended) < maxNumIndex && len(remaining) > 0 {
+		var best *recommendation
+		useful := make([]*hypoIndex, 0, len(remaining))
+		for _, idx := range remaining {
+			r := &recommendation{index: idx}
+			evaluated := append(slices.Clip(chosen), idx)
+			for _, q := range valid {
+				if _, ok := q.tables[idx.tableKey()]; !ok {
+					continue
+				}
+				cost, used, err := o.queryCost(ctx, q, evaluated, idx)
+				if err != nil {
+					return nil, err
+				}
+				if used && cost < costs[q] {
+					impact := &queryImpact{query: q, oldCost: costs[q], newCost: cost}
+					r.impacts = append(r.impacts, impact)
+					r.benefit += impact.benefit()
+				}
+			}
+			if r.benefit <= 0 {
+				// The index doesn't help even if it's evaluated with fewer indexes, skip it in the later rounds.
+				continue
+			}
+			useful = append(useful, idx)
+			if best == nil || r.benefit > best.benefit {
+				best = r
+			}
+		}
+		if best == nil {
+			break
+		}
+		for _, impact := range best.impacts {
+			costs[impact.query] = impact.newCost
+		}
+		chosen = append(chosen, best.index)
+		recommended = append(recommended, best)
+		remaining = slices.DeleteFunc(useful, func(idx *hypoIndex) bool { return idx == best.index })
+	}
+	return recommended, nil
+}
@@ -129,6 +129,9 @@ type HashJoinExec struct {
 	workerWg util.WaitGroupWrapper
 	waiterWg util.WaitGroupWrapper
 
+	// hotKeys are the heavy hitters of the join key of the build side.
+	hotKeys []types.Datum
+
 	prepared bool
 }
 
@@ -924,9 +927,11 @@ func (w *probeWorker) joinNAAJMatchProbeSideRow2Chunk(probeKey uint64, probeKeyN
 
 func (w *probeWorker) joinMatchedProbeSideRow2Chunk(probeKey uint64, probeSideRow chunk.Row, hCtx *hashContext,
 	joinResult *hashjoinWorkerResult) (bool, *hashjoinWorkerResult) {
-	var err error
-	w.buildSideRows, err = w.rowContainerForProbe.GetMatchedRows(probeKey, probeSideRow, hCtx, w.buildSideRows)
-	buildSideRows := w.buildSideRows
+	buildSideRows, isHotKey, err := w.rowContainerForProbe.GetHotKeyRows(probeKey, probeSideRow, hCtx)
+	if err == nil && !isHotKey {
+		w.buildSideRows, err = w.rowContainerForProbe.GetMatchedRows(probeKey, probeSideRow, hCtx, w.buildSideRows)
+		buildSideRows = w.buildSideRows
+	}
 	if err != nil {
 		joinResult.err = err
 		return false, joinResult
@@ -1122,6 +1127,7 @@ func (e *HashJoinExec) Next(ctx context.Context, req *chunk.Chunk) (err error) {
 			naKeyColIdx: e.buildWorker.buildNAKeyColIdx,
 		}
 		e.rowContainer = newHashRowContainer(e.Ctx(), hCtx, exec.RetTypes(e.buildWorker.buildSideExec))
+		e.rowContainer.setHotKeys(e.hotKeys)
 		// we shallow copies rowContainer for each probe worker to avoid lock contention
 		for i := uint(0); i < e.concurrency; i++ {
 			if i == 0 {
@@ -1205,6 +1211,11 @@ func (e *HashJoinExec) fetchAndBuildHashTable(ctx context.Context) {
 			e.buildFinished <- err
 		}
 	}
+	if err == nil {
+		if err = e.rowContainer.collectHotKeyRows(); err != nil {
+			e.buildFinished <- errors.Trace(err)
+		}
+	}
 }
 
 // buildHashTableForList builds hash table from `list`.
@@ -1302,14 +1313,24 @@ func (e *NestedLoopApplyExec) Close() error {
 			if e.cacheAccessCounter > 0 {
 				hitRatio = float64(e.cacheHitCounter) / float64(e.cacheAccessCounter)
 			}
-			runtimeStats.setCacheInfo(true, hitRatio)
+			var spilled int64
+			if e.cache != nil {
+				spilled = e.cache.SpilledCount()
+			}
+			runtimeStats.setCacheInfo(true, hitRatio, spilled)
 		} else {
-			runtimeStats.setCacheInfo(false, 0)
+			runtimeStats.setCacheInfo(false, 0, 0)
 		}
 		runtimeStats.SetConcurrencyInfo(execdetails.NewConcurrencyInfo("Concurrency", 0))
 		defer e.Ctx().GetSessionVars().StmtCtx.RuntimeStatsColl.RegisterStats(e.ID(), runtimeStats)
 	}
-	return e.outerExec.Close()
+	err := e.outerExec.Close()
+	if e.cache != nil {
+		if closeErr := e.cache.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
 }
 
 // Open implements the Executor interface.
@@ -1331,17 +1352,31 @@ func (e *NestedLoopApplyExec) Open(ctx context.Context) error {
 	e.innerList.GetMemTracker().AttachTo(e.memTracker)
 
 	if e.canUseCache {
-		e.cache, err = applycache.NewApplyCache(e.ctx)
+		e.cache, err = newApplyCache(e.ctx, e.memTracker)
 		if err != nil {
 			return err
 		}
 		e.cacheHitCounter = 0
 		e.cacheAccessCounter = 0
-		e.cache.GetMemTracker().AttachTo(e.memTracker)
 	}
 	return nil
 }
 
+// newApplyCache creates the apply cache for the apply executors, and registers its spill action if spilling to disk is
+// enabled.
+func newApplyCache(sctx sessionctx.Context, memTracker *memory.Tracker) (*applycache.ApplyCache, error) {
+	cache, err := applycache.NewApplyCache(sctx)
+	if err != nil {
+		return nil, err
+	}
+	cache.GetMemTracker().AttachTo(memTracker)
+	cache.GetDiskTracker().AttachTo(sctx.GetSessionVars().StmtCtx.DiskTracker)
+	if variable.EnableTmpStorageOnOOM.Load() {
+		sctx.GetSessionVars().MemTracker.FallbackOldAndSetNewAction(cache.ActionSpill())
+	}
+	return cache, nil
+}
+
 // aggExecutorTreeInputEmpty checks whether the executor tree returns empty if without aggregate operators.
 // Note that, the prerequisite is that this executor tree has been executed already and it returns one row.
 func aggExecutorTreeInputEmpty(e exec.Executor) bool {
@@ -1517,6 +1552,8 @@ func (e *NestedLoopApplyExec) Next(ctx context.Context, req *chunk.Chunk) (err e
 type cacheInfo struct {
 	hitRatio float64
 	useCache bool
+	// spilled is the number of the cache items spilled to disk.
+	spilled int64
 }
 
 type joinRuntimeStats struct {
@@ -1536,11 +1573,12 @@ func newJoinRuntimeStats() *joinRuntimeStats {
 }
 
 // setCacheInfo sets the cache information. Only used for apply executor.
-func (e *joinRuntimeStats) setCacheInfo(useCache bool, hitRatio float64) {
+func (e *joinRuntimeStats) setCacheInfo(useCache bool, hitRatio float64, spilled int64) {
 	e.Lock()
 	e.applyCache = true
 	e.cache.useCache = useCache
 	e.cache.hitRatio = hitRatio
+	e.cache.spilled = spilled
 	e.Unlock()
 }
 
@@ -1550,6 +1588,9 @@ func (e *joinRuntimeStats) String() string {
 	if e.applyCache {
 		if e.cache.useCache {
 			fmt.Fprintf(buf, ", cache:ON, cacheHitRatio:%.3f%%", e.cache.hitRatio*100)
+			if e.cache.spilled > 0 {
+				fmt.Fprintf(buf, ", cacheSpilled:%d", e.cache.spilled)
+			}
 		} else {
 			buf.WriteString(", cache:OFF")
 		}
@@ -1628,6 +1669,10 @@ func (e *hashJoinRuntimeStats) String() string {
 			buf.WriteString(", probe_collision:")
 			buf.WriteString(strconv.FormatInt(e.hashStat.probeCollision, 10))
 		}
+		if e.hashStat.hotKeyProbe > 0 {
+			buf.WriteString(", hot_key_probe:")
+			buf.WriteString(strconv.FormatInt(e.hashStat.hotKeyProbe, 10))
+		}
 		buf.WriteString("}")
 	}
 	return buf.String()
@@ -1652,6 +1697,7 @@ func (e *hashJoinRuntimeStats) Merge(rs execdetails.RuntimeStats) {
 	e.fetchAndBuildHashTable += tmp.fetchAndBuildHashTable
 	e.hashStat.buildTableElapse += tmp.hashStat.buildTableElapse
 	e.hashStat.probeCollision += tmp.hashStat.probeCollision
+	e.hashStat.hotKeyProbe += tmp.hashStat.hotKeyProbe
 	e.fetchAndProbe += tmp.fetchAndProbe
 	e.probe += tmp.probe
 	if e.maxFetchAndProbe < tmp.maxFetchAndProbe {
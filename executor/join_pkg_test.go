@@ -127,6 +127,12 @@ func TestHashJoinRuntimeStats(t *testing.T) {
 	require.Equal(t, stats.Clone().String(), stats.String())
 	stats.Merge(stats.Clone())
 	require.Equal(t, "build_hash_table:{total:4s, fetch:3.8s, build:200ms}, probe:{concurrency:4, total:10s, max:2s, probe:8s, fetch:2s, probe_collision:2}", stats.String())
+
+	stats.hashStat.hotKeyProbe = 3
+	require.Equal(t, "build_hash_table:{total:4s, fetch:3.8s, build:200ms}, probe:{concurrency:4, total:10s, max:2s, probe:8s, fetch:2s, probe_collision:2, hot_key_probe:3}", stats.String())
+	require.Equal(t, stats.Clone().String(), stats.String())
+	stats.Merge(stats.Clone())
+	require.Equal(t, "build_hash_table:{total:8s, fetch:7.6s, build:400ms}, probe:{concurrency:4, total:20s, max:2s, probe:16s, fetch:4s, probe_collision:4, hot_key_probe:6}", stats.String())
 }
 
 func TestIndexJoinRuntimeStats(t *testing.T) {
@@ -146,4 +152,11 @@ func TestIndexJoinRuntimeStats(t *testing.T) {
 	require.Equal(t, stats.Clone().String(), stats.String())
 	stats.Merge(stats.Clone())
 	require.Equal(t, "inner:{total:10s, concurrency:5, task:32, construct:200ms, fetch:600ms, build:500ms, join:300ms}, probe:2s", stats.String())
+
+	stats.batchShrink = 2
+	stats.innerWorker.spill = 3
+	require.Equal(t, "inner:{total:10s, concurrency:5, task:32, construct:200ms, fetch:600ms, build:500ms, join:300ms}, probe:2s, spill:{round:3, batch_shrink:2}", stats.String())
+	require.Equal(t, stats.Clone().String(), stats.String())
+	stats.Merge(stats.Clone())
+	require.Equal(t, "inner:{total:20s, concurrency:5, task:64, construct:400ms, fetch:1.2s, build:1s, join:600ms}, probe:4s, spill:{round:6, batch_shrink:4}", stats.String())
 }
@@ -0,0 +1,260 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+var _ exec.Executor = &JSONTableExec{}
+
+// jsonTableColumn is a column or a nested path of JSON_TABLE with its path parsed.
+type jsonTableColumn struct {
+	tp     ast.JSONTableColumnType
+	name   model.CIStr
+	offset int
+	path   types.JSONPathExpression
+	retTp  *types.FieldType
+
+	onEmpty *ast.JSONTableOnResponse
+	onError *ast.JSONTableOnResponse
+
+	nestedColumns []*jsonTableColumn
+}
+
+// JSONTableExec represents the JSON_TABLE table function. It evaluates the JSON document once when it's opened,
+// and then produces one row for each value matched by the row path and the nested paths.
+type JSONTableExec struct {
+	exec.BaseExecutor
+
+	expr    expression.Expression
+	path    types.JSONPathExpression
+	columns []*jsonTableColumn
+
+	rows   [][]types.Datum
+	cursor int
+}
+
+// Open implements the Executor Open interface.
+func (e *JSONTableExec) Open(ctx context.Context) error {
+	if err := e.BaseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	e.rows = e.rows[:0]
+	e.cursor = 0
+
+	d, err := e.expr.Eval(chunk.Row{})
+	if err != nil {
+		return err
+	}
+	if d.IsNull() {
+		return nil
+	}
+
+	var doc types.BinaryJSON
+	if d.Kind() == types.KindMysqlJSON {
+		doc = d.GetMysqlJSON()
+	} else {
+		doc, err = types.ParseBinaryJSONFromString(d.GetString())
+		if err != nil {
+			return err
+		}
+	}
+
+	base := make([]types.Datum, e.Schema().Len())
+	e.rows, err = e.evalPath(e.rows, doc, e.path, e.columns, base)
+	return err
+}
+
+// Next implements the Executor Next interface.
+func (e *JSONTableExec) Next(_ context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	for ; e.cursor < len(e.rows) && !req.IsFull(); e.cursor++ {
+		row := e.rows[e.cursor]
+		for i := range row {
+			req.AppendDatum(i, &row[i])
+		}
+	}
+	return nil
+}
+
+// Close implements the Executor Close interface.
+func (e *JSONTableExec) Close() error {
+	e.rows = nil
+	return e.BaseExecutor.Close()
+}
+
+// evalPath appends the rows produced by the values matched by `path` in `doc` to `rows`. The columns which are
+// not in `cols` are copied from `base`.
+func (e *JSONTableExec) evalPath(rows [][]types.Datum, doc types.BinaryJSON, path types.JSONPathExpression,
+	cols []*jsonTableColumn, base []types.Datum) ([][]types.Datum, error) {
+	for i, value := range extractJSONTableValues(doc, path) {
+		row := make([]types.Datum, len(base))
+		copy(row, base)
+
+		var nested []*jsonTableColumn
+		for _, col := range cols {
+			var err error
+			switch col.tp {
+			case ast.JSONTableColumnOrdinality:
+				row[col.offset].SetUint64(uint64(i + 1))
+			case ast.JSONTableColumnPath:
+				row[col.offset], err = e.evalPathColumn(value, col)
+			case ast.JSONTableColumnExistsPath:
+				row[col.offset], err = e.evalExistsColumn(value, col)
+			case ast.JSONTableColumnNested:
+				nested = append(nested, col)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// The sibling nested paths are joined by union, so only the columns of one nested path are filled in
+		// each row, and the row itself is produced if none of the nested paths matches any value.
+		produced := len(rows)
+		for _, col := range nested {
+			var err error
+			rows, err = e.evalPath(rows, value, col.path, col.nestedColumns, row)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(rows) == produced {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (e *JSONTableExec) evalPathColumn(value types.BinaryJSON, col *jsonTableColumn) (types.Datum, error) {
+	values := extractJSONTableValues(value, col.path)
+	switch {
+	case len(values) == 0:
+		if col.onEmpty == nil || col.onEmpty.Tp == ast.JSONTableOnResponseNull {
+			return types.Datum{}, nil
+		}
+		if col.onEmpty.Tp == ast.JSONTableOnResponseError {
+			return types.Datum{}, exeerrors.ErrMissingJSONTableValue.GenWithStackByArgs(col.name.O)
+		}
+		return e.evalDefault(col.onEmpty.Default, col)
+	case len(values) > 1:
+		return e.onColumnError(col, exeerrors.ErrWrongJSONTableValue.GenWithStackByArgs(col.name.O))
+	}
+
+	d, err := e.convertJSONValue(values[0], col)
+	if err != nil {
+		return e.onColumnError(col, err)
+	}
+	return d, nil
+}
+
+func (e *JSONTableExec) evalExistsColumn(value types.BinaryJSON, col *jsonTableColumn) (types.Datum, error) {
+	exists := int64(0)
+	if len(extractJSONTableValues(value, col.path)) > 0 {
+		exists = 1
+	}
+	d := types.NewIntDatum(exists)
+	return d.ConvertTo(e.Ctx().GetSessionVars().StmtCtx, col.retTp)
+}
+
+// onColumnError handles the error occurred when evaluating a column according to its `ON ERROR` clause.
+func (e *JSONTableExec) onColumnError(col *jsonTableColumn, err error) (types.Datum, error) {
+	if col.onError == nil || col.onError.Tp == ast.JSONTableOnResponseNull {
+		e.Ctx().GetSessionVars().StmtCtx.AppendWarning(err)
+		return types.Datum{}, nil
+	}
+	if col.onError.Tp == ast.JSONTableOnResponseError {
+		return types.Datum{}, err
+	}
+	e.Ctx().GetSessionVars().StmtCtx.AppendWarning(err)
+	return e.evalDefault(col.onError.Default, col)
+}
+
+func (e *JSONTableExec) evalDefault(s string, col *jsonTableColumn) (types.Datum, error) {
+	value, err := types.ParseBinaryJSONFromString(s)
+	if err != nil {
+		return types.Datum{}, err
+	}
+	return e.convertJSONValue(value, col)
+}
+
+// convertJSONValue converts a JSON value to the type of the column. Only JSON columns can hold arrays and objects.
+func (e *JSONTableExec) convertJSONValue(value types.BinaryJSON, col *jsonTableColumn) (types.Datum, error) {
+	if col.retTp.GetType() == mysql.TypeJSON {
+		return types.NewJSONDatum(value), nil
+	}
+
+	var d types.Datum
+	switch value.TypeCode {
+	case types.JSONTypeCodeObject, types.JSONTypeCodeArray:
+		return types.Datum{}, exeerrors.ErrWrongJSONTableValue.GenWithStackByArgs(col.name.O)
+	case types.JSONTypeCodeLiteral:
+		switch value.Value[0] {
+		case types.JSONLiteralNil:
+			return types.Datum{}, nil
+		case types.JSONLiteralTrue:
+			d.SetInt64(1)
+		default:
+			d.SetInt64(0)
+		}
+		if col.retTp.EvalType() == types.ETString {
+			d.SetString(value.String(), col.retTp.GetCollate())
+		}
+	case types.JSONTypeCodeInt64:
+		d.SetInt64(value.GetInt64())
+	case types.JSONTypeCodeUint64:
+		d.SetUint64(value.GetUint64())
+	case types.JSONTypeCodeFloat64:
+		d.SetFloat64(value.GetFloat64())
+	case types.JSONTypeCodeString:
+		d.SetString(string(value.GetString()), col.retTp.GetCollate())
+	default:
+		s, err := value.Unquote()
+		if err != nil {
+			return types.Datum{}, err
+		}
+		d.SetString(s, col.retTp.GetCollate())
+	}
+
+	res, err := d.ConvertTo(e.Ctx().GetSessionVars().StmtCtx, col.retTp)
+	return res, errors.Trace(err)
+}
+
+// extractJSONTableValues returns the values matched by `path` in `doc`.
+func extractJSONTableValues(doc types.BinaryJSON, path types.JSONPathExpression) []types.BinaryJSON {
+	ret, found := doc.Extract([]types.JSONPathExpression{path})
+	if !found {
+		return nil
+	}
+	if !path.CouldMatchMultipleValues() {
+		return []types.BinaryJSON{ret}
+	}
+	values := make([]types.BinaryJSON, 0, ret.GetElemCount())
+	for i := 0; i < ret.GetElemCount(); i++ {
+		values = append(values, ret.ArrayGetElem(i))
+	}
+	return values
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/testkit"
+)
+
+func TestJSONTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustQuery(`select * from json_table('[{"a": 1, "b": "x"}, {"a": 2}, {"b": "z"}]', '$[*]' columns (` +
+		`id for ordinality, a int path '$.a', b varchar(10) path '$.b' default '"none"' on empty, ` +
+		`has_a int exists path '$.a')) as jt`).Check(testkit.Rows(
+		"1 1 x 1",
+		"2 2 none 1",
+		"3 <nil> z 0",
+	))
+
+	// a scalar path matches one row
+	tk.MustQuery(`select * from json_table('{"a": [1, 2]}', '$' columns (a json path '$.a')) t`).Check(testkit.Rows("[1, 2]"))
+	tk.MustQuery(`select * from json_table(null, '$[*]' columns (a int path '$')) t`).Check(testkit.Rows())
+	tk.MustQuery(`select * from json_table('[true, null, "2020-01-01"]', '$[*]' columns (a varchar(20) path '$')) t`).
+		Check(testkit.Rows("true", "<nil>", "2020-01-01"))
+
+	// nested paths
+	tk.MustQuery(`select * from json_table('[{"a": 1, "b": [1, 2], "c": [3]}, {"a": 2}]', '$[*]' columns (` +
+		`a int path '$.a', nested path '$.b[*]' columns (b int path '$', ob for ordinality), ` +
+		`nested path '$.c[*]' columns (c int path '$'))) t`).Check(testkit.Rows(
+		"1 1 1 <nil>",
+		"1 2 2 <nil>",
+		"1 <nil> <nil> 3",
+		"2 <nil> <nil> <nil>",
+	))
+
+	// on empty and on error
+	tk.MustGetErrCode(`select * from json_table('[{}]', '$[*]' columns (a int path '$.a' error on empty)) t`,
+		errno.ErrMissingJSONTableValue)
+	tk.MustGetErrCode(`select * from json_table('[{"a": [1]}]', '$[*]' columns (a int path '$.a' error on error)) t`,
+		errno.ErrWrongJSONTableValue)
+	tk.MustQuery(`select * from json_table('[{"a": [1]}]', '$[*]' columns (a int path '$.a' default '-1' on error)) t`).
+		Check(testkit.Rows("-1"))
+	tk.MustQuery(`select * from json_table('[{"a": [1]}]', '$[*]' columns (a int path '$.a')) t`).Check(testkit.Rows("<nil>"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 3666 Can't store an array or an object in the scalar column 'a' of JSON_TABLE"))
+
+	// invalid usages
+	tk.MustGetErrCode(`select * from json_table('[]', '$[*]' columns (a int path '$'))`, errno.ErrTableFunctionMustHaveAlias)
+	tk.MustGetErrCode(`select * from json_table('[]', '$[*' columns (a int path '$')) t`, errno.ErrInvalidJSONPath)
+	tk.MustGetErrCode(`select * from json_table(1, '$[*]' columns (a int path '$')) t`, errno.ErrWrongArguments)
+}
+
+func TestLateralJSONTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int, j json)")
+	tk.MustExec(`insert into t values (1, '[1, 2]'), (2, '[]'), (3, '[3]')`)
+
+	tk.MustQuery(`select t.id, jt.a from t, json_table(t.j, '$[*]' columns (a int path '$')) jt order by t.id, jt.a`).
+		Check(testkit.Rows("1 1", "1 2", "3 3"))
+	tk.MustQuery(`select t.id, jt.a from t join json_table(t.j, '$[*]' columns (a int path '$')) jt on jt.a > 1 order by t.id, jt.a`).
+		Check(testkit.Rows("1 2", "3 3"))
+	tk.MustQuery(`select t.id, jt.a from t left join json_table(t.j, '$[*]' columns (a int path '$')) jt on true order by t.id, jt.a`).
+		Check(testkit.Rows("1 1", "1 2", "2 <nil>", "3 3"))
+	tk.MustQuery(`select t.id, (select count(*) from json_table(t.j, '$[*]' columns (a int path '$')) jt) from t order by t.id`).
+		Check(testkit.Rows("1 2", "2 0", "3 1"))
+	tk.MustGetErrCode(`select * from t right join json_table(t.j, '$[*]' columns (a int path '$')) jt on true`,
+		errno.ErrTableFunctionForbiddenJoinType)
+	tk.MustGetErrCode(`select * from json_table(t.j, '$[*]' columns (a int path '$')) jt, t`, errno.ErrBadField)
+}
@@ -93,6 +93,8 @@ type LoadDataWorker struct {
 	planInfo   planInfo
 
 	table table.Table
+	// triggers are the triggers and the materialized view logs of the table, it's nil if there are neither.
+	triggers *triggerExec
 }
 
 func setNonRestrictiveFlags(stmtCtx *stmtctx.StatementContext) {
@@ -252,6 +254,12 @@ func initEncodeCommitWorkers(e *LoadDataWorker) (*encodeWorker, *commitWorker, e
 		InsertValues: insertValues,
 		controller:   e.controller,
 	}
+	// The statements in the triggers are executed in the user session, which is also used to encode the rows, so a
+	// batch is encoded after the previous one is committed.
+	if e.triggers != nil && len(e.table.Meta().Triggers) > 0 {
+		done := make(chan struct{}, 1)
+		enc.commitDone, com.commitDone = done, done
+	}
 	return enc, com, nil
 }
 
@@ -277,6 +285,7 @@ func createInsertValues(e *LoadDataWorker) (insertVal *InsertValues, err error)
 		insertColumns:  insertColumns,
 		rowLen:         len(insertColumns),
 		hasExtraHandle: hasExtraHandle,
+		triggers:       e.triggers,
 	}
 	if len(insertColumns) > 0 {
 		ret.initEvalBuffer()
@@ -295,6 +304,8 @@ type encodeWorker struct {
 	exprWarnings []stmtctx.SQLWarn
 	killed       *uint32
 	rows         [][]types.Datum
+	// commitDone is set if the batches are committed one by one, see initEncodeCommitWorkers.
+	commitDone <-chan struct{}
 }
 
 // commitTask is used for passing data from processStream goroutine to commitWork goroutine.
@@ -378,6 +389,13 @@ func (w *encodeWorker) processOneStream(
 			rows: w.rows,
 		}:
 		}
+		if w.commitDone != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-w.commitDone:
+			}
+		}
 		// reset rows buffer, will reallocate buffer but NOT reuse
 		w.resetBatch()
 	}
@@ -401,7 +419,7 @@ func (w *encodeWorker) readOneBatchRows(ctx context.Context, parser mydump.Parse
 			}
 			return exeerrors.ErrLoadDataCantRead.GenWithStackByArgs(
 				err.Error(),
-				"Only the following formats delimited text file (csv, tsv), parquet, sql are supported. Please provide the valid source file(s)",
+				"Only the following formats delimited text file (csv, tsv), parquet, sql, jsonl are supported. Please provide the valid source file(s)",
 			)
 		}
 		// rowCount will be used in fillRow(), last insert ID will be assigned according to the rowCount = 1.
@@ -517,6 +535,8 @@ func (w *encodeWorker) parserData2TableData(
 type commitWorker struct {
 	*InsertValues
 	controller *importer.LoadDataController
+	// commitDone is notified after a batch is committed if it's set, see initEncodeCommitWorkers.
+	commitDone chan<- struct{}
 }
 
 // commitWork commit batch sequentially. When returns nil, it means the job is
@@ -557,6 +577,9 @@ func (w *commitWorker) commitWork(ctx context.Context, inCh <-chan commitTask) (
 			if err = w.commitOneTask(ctx, task); err != nil {
 				return err
 			}
+			if w.commitDone != nil {
+				w.commitDone <- struct{}{}
+			}
 			taskCnt++
 			logutil.Logger(ctx).Info("commit one task success",
 				zap.Duration("commit time usage", time.Since(start)),
@@ -597,17 +620,37 @@ func (w *commitWorker) checkAndInsertOneBatch(ctx context.Context, rows [][]type
 	}
 	w.Ctx().GetSessionVars().StmtCtx.AddRecordRows(cnt)
 
+	if !w.triggers.hasTriggers(model.TriggerTimingBefore, model.TriggerEventInsert) {
+		return w.insertRows(ctx, rows[0:cnt])
+	}
+	// Like execInsertWithTriggers, the rows are written one by one right after the BEFORE INSERT triggers are fired
+	// for them.
+	for i, row := range rows[0:cnt] {
+		if row != nil {
+			if err = w.fireBeforeInsertTriggers(ctx, row); err != nil {
+				return err
+			}
+		}
+		if err = w.insertRows(ctx, rows[i:i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *commitWorker) insertRows(ctx context.Context, rows [][]types.Datum) error {
+	var err error
 	switch w.controller.OnDuplicate {
 	case ast.OnDuplicateKeyHandlingReplace:
-		return w.batchCheckAndInsert(ctx, rows[0:cnt], w.addRecordLD, true)
+		return w.batchCheckAndInsert(ctx, rows, w.addRecordLD, true)
 	case ast.OnDuplicateKeyHandlingIgnore:
-		return w.batchCheckAndInsert(ctx, rows[0:cnt], w.addRecordLD, false)
+		return w.batchCheckAndInsert(ctx, rows, w.addRecordLD, false)
 	case ast.OnDuplicateKeyHandlingError:
-		for i, row := range rows[0:cnt] {
+		for i, row := range rows {
 			sizeHintStep := int(w.Ctx().GetSessionVars().ShardAllocateStep)
 			if sizeHintStep > 0 && i%sizeHintStep == 0 {
 				sizeHint := sizeHintStep
-				remain := len(rows[0:cnt]) - i
+				remain := len(rows) - i
 				if sizeHint > remain {
 					sizeHint = remain
 				}
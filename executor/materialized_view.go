@@ -0,0 +1,410 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	plannercore "github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"github.com/pingcap/tidb/util/stringutil"
+	"go.uber.org/zap"
+)
+
+func (e *DDLExec) executeCreateMaterializedView(ctx context.Context, s *ast.CreateMaterializedViewStmt) error {
+	if e.is.TableExists(s.ViewName.Schema, s.ViewName.Name) && s.IfNotExists {
+		err := infoschema.ErrTableExists.GenWithStackByArgs(ast.Ident{Schema: s.ViewName.Schema, Name: s.ViewName.Name})
+		e.Ctx().GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+
+	// Always use `format.RestoreNameBackQuotes` to restore the query like the views, the table names in the query
+	// are qualified by the preprocessor.
+	restoreFlags := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreNameBackQuotes
+	var sb strings.Builder
+	if err := s.Select.Restore(format.NewRestoreCtx(restoreFlags, &sb)); err != nil {
+		return err
+	}
+	info := &model.MaterializedViewInfo{SelectStmt: sb.String()}
+	if q := analyzeMaterializedViewQuery(e.Ctx(), info); q != nil {
+		// The view can be refreshed incrementally only if the base table is a normal table in the same schema, since
+		// the log table is created in the schema of the view.
+		base, err := e.is.TableByName(q.Table.Schema, q.Table.Name)
+		if err == nil && q.Table.Schema.L == s.ViewName.Schema.L && base.Meta().IsBaseTable() &&
+			!base.Meta().IsMaterializedView() && base.Meta().MaterializedViewLog == nil &&
+			base.Meta().TempTableType == model.TempTableNone {
+			info.BaseTableID = base.Meta().ID
+		}
+	}
+
+	if err := domain.GetDomain(e.Ctx()).DDL().CreateMaterializedView(e.Ctx(), s, info); err != nil {
+		return err
+	}
+	// Populate the view, which is empty after it's created.
+	is := domain.GetDomain(e.Ctx()).InfoSchema()
+	tbl, err := is.TableByName(s.ViewName.Schema, s.ViewName.Name)
+	if err != nil {
+		return err
+	}
+	return refreshMaterializedView(ctx, &e.BaseExecutor, is, s.ViewName.Schema, tbl.Meta(), ast.RefreshMaterializedViewComplete)
+}
+
+func (e *DDLExec) executeDropMaterializedView(s *ast.DropMaterializedViewStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().DropMaterializedView(e.Ctx(), s)
+}
+
+func (e *SimpleExec) executeRefreshMaterializedView(ctx context.Context, s *ast.RefreshMaterializedViewStmt) error {
+	tbl, err := e.is.TableByName(s.ViewName.Schema, s.ViewName.Name)
+	if err != nil {
+		return err
+	}
+	if !tbl.Meta().IsMaterializedView() {
+		return exeerrors.ErrWrongObject.GenWithStackByArgs(s.ViewName.Schema.O, s.ViewName.Name.O, "MATERIALIZED VIEW")
+	}
+	return refreshMaterializedView(ctx, &e.BaseExecutor, e.is, s.ViewName.Schema, tbl.Meta(), s.Type)
+}
+
+// analyzeMaterializedViewQuery parses and analyzes the query of a materialized view, it returns nil if the view
+// can't be refreshed incrementally.
+func analyzeMaterializedViewQuery(sctx sessionctx.Context, info *model.MaterializedViewInfo) *plannercore.MaterializedViewQuery {
+	charset, collation := sctx.GetSessionVars().GetCharsetInfo()
+	node, err := parser.New().ParseOneStmt(info.SelectStmt, charset, collation)
+	if err != nil {
+		return nil
+	}
+	return plannercore.AnalyzeMaterializedViewQuery(node)
+}
+
+// refreshMaterializedView refreshes the view in an internal session. The statements are executed in an optimistic
+// transaction, so the rows which are written into the log during the refresh are kept and applied next time.
+func refreshMaterializedView(ctx context.Context, e *exec.BaseExecutor, is infoschema.InfoSchema, schema model.CIStr, tbInfo *model.TableInfo, tp ast.RefreshMaterializedViewType) error {
+	mv := tbInfo.MaterializedView
+	if tp == ast.RefreshMaterializedViewIncremental && mv.LogTableID == 0 {
+		return plannercore.ErrNotSupportedYet.GenWithStackByArgs(
+			fmt.Sprintf("INCREMENTAL refresh of materialized view '%s'", tbInfo.Name.O))
+	}
+	r := &mvRefresher{schema: schema, mv: tbInfo}
+	if mv.LogTableID != 0 {
+		logTbl, ok := is.TableByID(mv.LogTableID)
+		if !ok {
+			return infoschema.ErrTableNotExists.GenWithStackByArgs(schema.O, fmt.Sprintf("(Table ID %d)", mv.LogTableID))
+		}
+		baseTbl, ok := is.TableByID(mv.BaseTableID)
+		if !ok {
+			return infoschema.ErrTableNotExists.GenWithStackByArgs(schema.O, fmt.Sprintf("(Table ID %d)", mv.BaseTableID))
+		}
+		r.log, r.base = logTbl.Meta(), baseTbl.Meta()
+	}
+
+	var sqls []string
+	if tp == ast.RefreshMaterializedViewComplete || mv.LogTableID == 0 {
+		sqls = r.completeRefreshSQLs()
+	} else {
+		q := analyzeMaterializedViewQuery(e.Ctx(), mv)
+		if q == nil {
+			return plannercore.ErrNotSupportedYet.GenWithStackByArgs(
+				fmt.Sprintf("INCREMENTAL refresh of materialized view '%s'", tbInfo.Name.O))
+		}
+		var err error
+		if sqls, err = r.incrementalRefreshSQLs(q); err != nil {
+			return err
+		}
+	}
+
+	sysSession, err := e.GetSysSession()
+	if err != nil {
+		return err
+	}
+	internalCtx := kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	defer e.ReleaseSysSession(internalCtx, sysSession)
+	sqlExecutor := sysSession.(sqlexec.SQLExecutor)
+	if _, err = sqlExecutor.ExecuteInternal(internalCtx, "BEGIN OPTIMISTIC"); err != nil {
+		return err
+	}
+	for _, sql := range sqls {
+		if _, err = sqlExecutor.ExecuteInternal(internalCtx, sql); err != nil {
+			logutil.Logger(ctx).Warn("refresh materialized view failed", zap.String("sql", sql), zap.Error(err))
+			if _, rollbackErr := sqlExecutor.ExecuteInternal(internalCtx, "ROLLBACK"); rollbackErr != nil {
+				return rollbackErr
+			}
+			return err
+		}
+	}
+	_, err = sqlExecutor.ExecuteInternal(internalCtx, "COMMIT")
+	return err
+}
+
+// mvRefresher builds the statements to refresh a materialized view.
+type mvRefresher struct {
+	schema model.CIStr
+	mv     *model.TableInfo
+	// log and base are nil if the view can't be refreshed incrementally.
+	log  *model.TableInfo
+	base *model.TableInfo
+}
+
+func (r *mvRefresher) tableName(tbInfo *model.TableInfo) string {
+	var sb strings.Builder
+	sqlexec.MustFormatSQL(&sb, "%n.%n", r.schema.O, tbInfo.Name.O)
+	return sb.String()
+}
+
+// completeRefreshSQLs recomputes the view from its query and clears the log.
+func (r *mvRefresher) completeRefreshSQLs() []string {
+	sqls := []string{"DELETE FROM " + r.tableName(r.mv)}
+	if r.log != nil {
+		sqls = append(sqls, "DELETE FROM "+r.tableName(r.log))
+	}
+	return append(sqls, "INSERT INTO "+r.tableName(r.mv)+" "+r.mv.MaterializedView.SelectStmt)
+}
+
+// incrementalRefreshSQLs applies the changes in the log to the view. The changes are aggregated into a delta for
+// every group, then the delta is merged into the existing groups, the new groups are inserted, and the empty groups
+// are deleted. Since MIN and MAX can't be maintained by the deleted rows, they are recomputed from the base table
+// for the groups which have deleted rows.
+func (r *mvRefresher) incrementalRefreshSQLs(q *plannercore.MaterializedViewQuery) ([]string, error) {
+	cols := r.mv.Cols()
+	name := func(col *model.ColumnInfo) string {
+		var sb strings.Builder
+		sqlexec.MustFormatSQL(&sb, "%n", col.Name.O)
+		return sb.String()
+	}
+	where := ""
+	if q.Where != nil {
+		text, err := plannercore.RestoreMaterializedViewExpr(q.Where)
+		if err != nil {
+			return nil, err
+		}
+		where = " WHERE " + text
+	}
+	groupBy := make([]string, 0, len(q.GroupBy))
+	for _, col := range q.GroupBy {
+		var sb strings.Builder
+		sqlexec.MustFormatSQL(&sb, "%n", col.O)
+		groupBy = append(groupBy, sb.String())
+	}
+	// onGroups joins the view `m` with the derived table `alias` on the grouping columns.
+	onGroups := func(alias string) string {
+		conds := make([]string, 0, len(q.GroupBy))
+		for i, offset := range q.GroupByOffsets {
+			conds = append(conds, fmt.Sprintf("m.%s <=> %s._g%d", name(cols[offset]), alias, i))
+		}
+		return strings.Join(conds, " AND ")
+	}
+	// aggregated builds a derived table which aggregates the rows of the table grouped by the grouping columns.
+	aggregated := func(tbInfo *model.TableInfo, aggs []string) string {
+		fields := make([]string, 0, len(groupBy)+len(aggs))
+		for i, col := range groupBy {
+			fields = append(fields, fmt.Sprintf("%s AS _g%d", col, i))
+		}
+		fields = append(fields, aggs...)
+		return fmt.Sprintf("(SELECT %s FROM %s%s GROUP BY %s)", strings.Join(fields, ", "), r.tableName(tbInfo),
+			where, strings.Join(groupBy, ", "))
+	}
+
+	var sign strings.Builder
+	sqlexec.MustFormatSQL(&sign, "%n", model.MaterializedViewLogSignColumn)
+	deltaAggs := make([]string, 0, len(q.Aggs)+1)
+	var recomputeAggs, recomputeAssigns []string
+	args := make([]string, len(q.Aggs))
+	for i, agg := range q.Aggs {
+		if agg.Arg != nil {
+			text, err := plannercore.RestoreMaterializedViewExpr(agg.Arg)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = text
+		}
+		var delta string
+		switch agg.Name {
+		case ast.AggFuncCount:
+			if agg.Arg == nil {
+				delta = fmt.Sprintf("SUM(%s)", sign.String())
+			} else {
+				delta = fmt.Sprintf("SUM(IF((%s) IS NULL, 0, %s))", args[i], sign.String())
+			}
+		case ast.AggFuncSum:
+			delta = fmt.Sprintf("SUM(%s * (%s))", sign.String(), args[i])
+		default:
+			delta = fmt.Sprintf("%s(IF(%s > 0, %s, NULL))", strings.ToUpper(agg.Name), sign.String(), args[i])
+			recomputeAggs = append(recomputeAggs, fmt.Sprintf("%s(%s) AS _a%d", strings.ToUpper(agg.Name), args[i], i))
+			recomputeAssigns = append(recomputeAssigns, fmt.Sprintf("m.%s = r._a%d", name(cols[agg.Offset]), i))
+		}
+		deltaAggs = append(deltaAggs, fmt.Sprintf("%s AS _a%d", delta, i))
+	}
+	deltaAggs = append(deltaAggs, fmt.Sprintf("SUM(%s < 0) AS _del", sign.String()))
+	delta := aggregated(r.log, deltaAggs)
+
+	countIdx := func(arg ast.ExprNode) int {
+		count := q.FindAgg(ast.AggFuncCount, arg)
+		for i, agg := range q.Aggs {
+			if agg == count {
+				return i
+			}
+		}
+		return -1
+	}
+	// The assignments of the counts are the last ones, since the other assignments refer to the old counts.
+	var assigns, countAssigns []string
+	values := make([]string, len(cols))
+	for i, offset := range q.GroupByOffsets {
+		values[offset] = fmt.Sprintf("d._g%d", i)
+	}
+	for i, agg := range q.Aggs {
+		col := name(cols[agg.Offset])
+		switch agg.Name {
+		case ast.AggFuncCount:
+			countAssigns = append(countAssigns, fmt.Sprintf("m.%s = m.%s + d._a%d", col, col, i))
+			values[agg.Offset] = fmt.Sprintf("d._a%d", i)
+		case ast.AggFuncSum:
+			// The sum is NULL if all the arguments are NULL.
+			c := countIdx(agg.Arg)
+			assigns = append(assigns, fmt.Sprintf("m.%s = IF(m.%s + d._a%d = 0, NULL, IFNULL(m.%s, 0) + IFNULL(d._a%d, 0))",
+				col, name(cols[q.Aggs[c].Offset]), c, col, i))
+			values[agg.Offset] = fmt.Sprintf("IF(d._a%d = 0, NULL, d._a%d)", c, i)
+		default:
+			fn := "LEAST"
+			if agg.Name == ast.AggFuncMax {
+				fn = "GREATEST"
+			}
+			assigns = append(assigns, fmt.Sprintf("m.%s = CASE WHEN m.%s IS NULL THEN d._a%d WHEN d._a%d IS NULL THEN m.%s ELSE %s(m.%s, d._a%d) END",
+				col, col, i, i, col, fn, col, i))
+			values[agg.Offset] = fmt.Sprintf("d._a%d", i)
+		}
+	}
+	assigns = append(assigns, countAssigns...)
+	countStar := name(cols[q.FindAgg(ast.AggFuncCount, nil).Offset])
+	countStarIdx := countIdx(nil)
+
+	mvName, logName := r.tableName(r.mv), r.tableName(r.log)
+	sqls := []string{
+		fmt.Sprintf("UPDATE %s AS m JOIN %s AS d ON %s SET %s", mvName, delta, onGroups("d"), strings.Join(assigns, ", ")),
+		fmt.Sprintf("INSERT INTO %s SELECT %s FROM %s AS d WHERE d._a%d > 0 AND NOT EXISTS (SELECT 1 FROM %s AS m WHERE %s)",
+			mvName, strings.Join(values, ", "), delta, countStarIdx, mvName, onGroups("d")),
+		fmt.Sprintf("DELETE FROM %s WHERE %s <= 0", mvName, countStar),
+	}
+	if len(recomputeAggs) > 0 {
+		sqls = append(sqls, fmt.Sprintf("UPDATE %s AS m JOIN %s AS d ON %s JOIN %s AS r ON %s SET %s WHERE d._del > 0",
+			mvName, delta, onGroups("d"), aggregated(r.base, recomputeAggs), onGroups("r"), strings.Join(recomputeAssigns, ", ")))
+	}
+	return append(sqls, "DELETE FROM "+logName), nil
+}
+
+// mvLogWriter records the changes of a base table in the log table of a materialized view.
+type mvLogWriter struct {
+	tbl table.Table
+	// offsets are the offsets of the log columns in the rows of the base table, the offset of the sign column is
+	// mvLogSignOffset, and the offset of a column which is not in the base table is mvLogMissingOffset.
+	offsets []int
+}
+
+const (
+	mvLogSignOffset    = -1
+	mvLogMissingOffset = -2
+)
+
+// buildMVLogWriters builds the writers of the logs of the materialized views on the table.
+func (b *executorBuilder) buildMVLogWriters(tbInfo *model.TableInfo) ([]*mvLogWriter, error) {
+	var writers []*mvLogWriter
+	for _, mvID := range tbInfo.MaterializedViews {
+		mvTbl, ok := b.is.TableByID(mvID)
+		if !ok || !mvTbl.Meta().IsMaterializedView() {
+			continue
+		}
+		logTbl, ok := b.is.TableByID(mvTbl.Meta().MaterializedView.LogTableID)
+		if !ok {
+			return nil, infoschema.ErrTableNotExists.GenWithStackByArgs("", fmt.Sprintf("(Table ID %d)", mvTbl.Meta().MaterializedView.LogTableID))
+		}
+		w := &mvLogWriter{tbl: logTbl}
+		for _, col := range logTbl.Cols() {
+			offset := mvLogMissingOffset
+			if col.Name.L == model.MaterializedViewLogSignColumn {
+				offset = mvLogSignOffset
+			} else if baseCol := model.FindColumnInfo(tbInfo.Columns, col.Name.L); baseCol != nil && baseCol.State == model.StatePublic {
+				offset = baseCol.Offset
+			}
+			w.offsets = append(w.offsets, offset)
+		}
+		writers = append(writers, w)
+	}
+	return writers, nil
+}
+
+// write records the old row as a deleted row and the new row as an inserted row.
+func (w *mvLogWriter) write(ctx context.Context, sctx sessionctx.Context, oldRow, newRow []types.Datum) error {
+	for _, change := range []struct {
+		row  []types.Datum
+		sign int64
+	}{{oldRow, -1}, {newRow, 1}} {
+		if change.row == nil {
+			continue
+		}
+		record := make([]types.Datum, len(w.offsets))
+		for i, offset := range w.offsets {
+			switch offset {
+			case mvLogSignOffset:
+				record[i].SetInt64(change.sign)
+			case mvLogMissingOffset:
+				record[i].SetNull()
+			default:
+				record[i] = change.row[offset]
+			}
+		}
+		if _, err := w.tbl.AddRecord(sctx, record, table.WithCtx(ctx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkMaterializedViewWrite returns an error if the table is a materialized view or a log of it, which can only
+// be written by REFRESH MATERIALIZED VIEW.
+func checkMaterializedViewWrite(sctx sessionctx.Context, tbInfo *model.TableInfo, op string) error {
+	if sctx.GetSessionVars().InRestrictedSQL {
+		return nil
+	}
+	if tbInfo.IsMaterializedView() || tbInfo.MaterializedViewLog != nil {
+		return plannercore.ErrNonUpdatableTable.GenWithStackByArgs(tbInfo.Name.O, op)
+	}
+	return nil
+}
+
+func fetchShowCreateTable4MaterializedView(sctx sessionctx.Context, tb *model.TableInfo, buf *bytes.Buffer) {
+	sqlMode := sctx.GetSessionVars().SQLMode
+	fmt.Fprintf(buf, "CREATE MATERIALIZED VIEW %s (", stringutil.Escape(tb.Name.O, sqlMode))
+	for i, col := range tb.Cols() {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(stringutil.Escape(col.Name.O, sqlMode))
+	}
+	fmt.Fprintf(buf, ") AS %s", tb.MaterializedView.SelectStmt)
+}
@@ -276,11 +276,9 @@ func (iter *txnMemBufferIter) Next() ([]types.Datum, error) {
 	for iter.idx < len(iter.kvRanges) {
 		if iter.curr == nil {
 			rg := iter.kvRanges[iter.idx]
-			var tmp kv.Iterator
-			if !iter.reverse {
-				tmp = iter.txn.GetMemBuffer().SnapshotIter(rg.StartKey, rg.EndKey)
-			} else {
-				tmp = iter.txn.GetMemBuffer().SnapshotIterReverse(rg.EndKey, rg.StartKey)
+			tmp, err := iterMemBuffer(iter.ctx, iter.txn.GetMemBuffer(), rg, iter.reverse)
+			if err != nil {
+				return nil, err
 			}
 			snapCacheIter, err := getSnapIter(iter.ctx, iter.cacheTable, rg, iter.reverse)
 			if err != nil {
@@ -543,6 +541,22 @@ func hasColVal(data [][]byte, colIDs map[int64]int, id int64) bool {
 
 type processKVFunc func(key, value []byte) error
 
+// iterMemBuffer returns an iterator over the range of the transaction memory buffer. Statements only read the buffer as
+// of the start of the statement, except for the statements in triggers and stored functions, which read the changes
+// made by the triggering or calling statement so far.
+func iterMemBuffer(ctx sessionctx.Context, memBuffer kv.MemBuffer, rg kv.KeyRange, reverse bool) (kv.Iterator, error) {
+	if ctx.GetSessionVars().StmtCtx.InHandleRoutine {
+		if !reverse {
+			return memBuffer.Iter(rg.StartKey, rg.EndKey)
+		}
+		return memBuffer.IterReverse(rg.EndKey, rg.StartKey)
+	}
+	if !reverse {
+		return memBuffer.SnapshotIter(rg.StartKey, rg.EndKey), nil
+	}
+	return memBuffer.SnapshotIterReverse(rg.EndKey, rg.StartKey), nil
+}
+
 func iterTxnMemBuffer(ctx sessionctx.Context, cacheTable kv.MemBuffer, kvRanges []kv.KeyRange, reverse bool, fn processKVFunc) error {
 	txn, err := ctx.Txn(true)
 	if err != nil {
@@ -550,11 +564,9 @@ func iterTxnMemBuffer(ctx sessionctx.Context, cacheTable kv.MemBuffer, kvRanges
 	}
 
 	for _, rg := range kvRanges {
-		var iter kv.Iterator
-		if !reverse {
-			iter = txn.GetMemBuffer().SnapshotIter(rg.StartKey, rg.EndKey)
-		} else {
-			iter = txn.GetMemBuffer().SnapshotIterReverse(rg.EndKey, rg.StartKey)
+		iter, err := iterMemBuffer(ctx, txn.GetMemBuffer(), rg, reverse)
+		if err != nil {
+			return err
 		}
 		snapCacheIter, err := getSnapIter(ctx, cacheTable, rg, reverse)
 		if err != nil {
@@ -1002,3 +1002,44 @@ func TestShuffleMergeJoinWithOtherConditions(t *testing.T) {
 		`2`,
 	))
 }
+
+func TestShuffleMergeJoinWithHotKeys(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@session.tidb_merge_join_concurrency = 4;")
+	tk.MustExec("create table t1 (a int, b int)")
+	tk.MustExec("create table t2 (a int, b int)")
+	var buf bytes.Buffer
+	buf.WriteString("insert into t1 values (0, 0)")
+	for i := 1; i <= 200; i++ {
+		buf.WriteString(fmt.Sprintf(", (1, %d)", i))
+	}
+	for i := 2; i <= 100; i++ {
+		buf.WriteString(fmt.Sprintf(", (%d, %d)", i, i))
+	}
+	tk.MustExec(buf.String())
+	tk.MustExec("insert into t2 values (1, 1), (1, 2), (3, 3), (5, 5), (200, 200), (null, null)")
+	tk.MustExec("analyze table t1, t2")
+
+	queries := []string{
+		"select /*+ merge_join(t1, t2) */ * from t1 join t2 on t1.a = t2.a",
+		"select /*+ merge_join(t1, t2) */ * from t1 left join t2 on t1.a = t2.a",
+		"select /*+ merge_join(t1, t2) */ * from t2 right join t1 on t1.a = t2.a",
+		"select /*+ merge_join(t1, t2) */ * from t1 left join t2 on t1.a = t2.a and t1.b > t2.b",
+		"select /*+ merge_join(t1, t2@sel_2) */ * from t1 where exists (select 1 from t2 where t1.a = t2.a)",
+		"select /*+ merge_join(t1, t2@sel_2) */ * from t1 where not exists (select 1 from t2 where t1.a = t2.a)",
+		"select /*+ merge_join(t1, t2@sel_2) */ t1.b, exists (select 1 from t2 where t1.a = t2.a) from t1",
+	}
+	expected := make([][][]any, 0, len(queries))
+	for _, query := range queries {
+		expected = append(expected, checkMergeAndRun(tk, t, query).Sort().Rows())
+	}
+
+	tk.MustExec("set @@session.tidb_opt_join_skew_threshold = 0.3")
+	for i, query := range queries {
+		explain := fmt.Sprintf("%v", tk.MustQuery("explain format = 'brief' "+query).Rows())
+		require.Contains(t, explain, "hot keys:1", query)
+		checkMergeAndRun(tk, t, query).Sort().Check(expected[i])
+	}
+}
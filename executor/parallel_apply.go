@@ -124,10 +124,9 @@ func (e *ParallelNestedLoopApplyExec) Open(ctx context.Context) error {
 	}
 
 	if e.useCache {
-		if e.cache, err = applycache.NewApplyCache(e.Ctx()); err != nil {
+		if e.cache, err = newApplyCache(e.Ctx(), e.memTracker); err != nil {
 			return err
 		}
-		e.cache.GetMemTracker().AttachTo(e.memTracker)
 	}
 	return nil
 }
@@ -175,6 +174,11 @@ func (e *ParallelNestedLoopApplyExec) Close() error {
 	// Wait all workers to finish before Close() is called.
 	// Otherwise we may got data race.
 	err := e.outerExec.Close()
+	if e.cache != nil {
+		if closeErr := e.cache.Close(); err == nil {
+			err = closeErr
+		}
+	}
 
 	if e.RuntimeStats() != nil {
 		runtimeStats := newJoinRuntimeStats()
@@ -183,9 +187,13 @@ func (e *ParallelNestedLoopApplyExec) Close() error {
 			if e.cacheAccessCounter > 0 {
 				hitRatio = float64(e.cacheHitCounter) / float64(e.cacheAccessCounter)
 			}
-			runtimeStats.setCacheInfo(true, hitRatio)
+			var spilled int64
+			if e.cache != nil {
+				spilled = e.cache.SpilledCount()
+			}
+			runtimeStats.setCacheInfo(true, hitRatio, spilled)
 		} else {
-			runtimeStats.setCacheInfo(false, 0)
+			runtimeStats.setCacheInfo(false, 0, 0)
 		}
 		runtimeStats.SetConcurrencyInfo(execdetails.NewConcurrencyInfo("Concurrency", e.concurrency))
 		defer e.Ctx().GetSessionVars().StmtCtx.RuntimeStatsColl.RegisterStats(e.ID(), runtimeStats)
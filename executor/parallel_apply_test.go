@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/util/collate"
@@ -593,6 +594,56 @@ func TestApplyCacheRatio(t *testing.T) {
 	require.False(t, checkRatio(""))
 }
 
+func TestApplyCacheSpill(t *testing.T) {
+	restore := config.RestoreFunc()
+	defer restore()
+	config.UpdateGlobal(func(conf *config.Config) {
+		conf.TempStoragePath = t.TempDir()
+	})
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	defer tk.MustExec("SET GLOBAL tidb_mem_oom_action = DEFAULT")
+	tk.MustExec("SET GLOBAL tidb_mem_oom_action='LOG'")
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int)")
+	var buf1, buf2 strings.Builder
+	buf1.WriteString("insert into t1 values ")
+	buf2.WriteString("insert into t2 values ")
+	for i := 0; i < 500; i++ {
+		if i > 0 {
+			buf1.WriteString(", ")
+			buf2.WriteString(", ")
+		}
+		buf1.WriteString(fmt.Sprintf("(%d, %d)", i%50, i))
+		buf2.WriteString(fmt.Sprintf("(%d, %d)", i%100, i))
+	}
+	tk.MustExec(buf1.String())
+	tk.MustExec(buf2.String())
+
+	sql := "select * from t1 where t1.b > (select max(t2.b) from t2 where t2.a > t1.a)"
+	for _, parallel := range []string{"OFF", "ON"} {
+		tk.MustExec("set tidb_enable_parallel_apply = " + parallel)
+		tk.MustExec("set @@tidb_mem_quota_query = default")
+		expected := tk.MustQuery(sql).Sort().Rows()
+		tk.MustExec("set @@tidb_mem_quota_query = 1")
+		tk.MustQuery(sql).Sort().Check(expected)
+
+		spilled := false
+		for _, row := range tk.MustQuery("explain analyze " + sql).Rows() {
+			line := fmt.Sprintf("%v", row)
+			if strings.Contains(line, "Apply") {
+				spilled = strings.Contains(line, "cacheSpilled:")
+				break
+			}
+		}
+		require.True(t, spilled, parallel)
+	}
+	tk.MustExec("set @@tidb_mem_quota_query = default")
+	tk.MustExec("set tidb_enable_parallel_apply = default")
+}
+
 func TestApplyGoroutinePanic(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -26,13 +26,14 @@ import (
 	"github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/disk"
 	"github.com/pingcap/tidb/util/mathutil"
+	"github.com/pingcap/tidb/util/memory"
 )
 
 type dataInfo struct {
-	chk         *chunk.Chunk
-	remaining   uint64
-	accumulated uint64
+	chk       *chunk.Chunk
+	remaining uint64
 }
 
 // PipelinedWindowExec is the executor for window functions.
@@ -46,15 +47,15 @@ type PipelinedWindowExec struct {
 	end                *core.FrameBound
 	groupChecker       *vecgroupchecker.VecGroupChecker
 
-	// childResult stores the child chunk. Note that even if remaining is 0, e.rows might still references rows in data[0].chk after returned it to upper executor, since there is no guarantee what the upper executor will do to the returned chunk, it might destroy the data (as in the benchmark test, it reused the chunk to pull data, and it will be chk.Reset(), causing panicking). So dataIdx, accumulated and dropped are added to ensure that chunk will only be returned if there is no row reference.
+	// childResult stores the child chunk.
 	childResult *chunk.Chunk
-	data        []dataInfo
-	dataIdx     int
+	// data stores the chunks to return, the child columns are copied from the partition when the window functions
+	// are evaluated, so the chunks don't reference the child chunks.
+	data    []dataInfo
+	dataIdx int
 
 	// done indicates the child executor is drained or something unexpected happened.
 	done         bool
-	accumulated  uint64
-	dropped      uint64
 	rowToConsume uint64
 	newPartition bool
 
@@ -64,22 +65,31 @@ type PipelinedWindowExec struct {
 	lastEndRow     uint64
 	stagedStartRow uint64
 	stagedEndRow   uint64
-	rowStart       uint64
 	orderByCols    []*expression.Column
 	// expectedCmpResult is used to decide if one value is included in the frame.
 	expectedCmpResult int64
 
-	// rows keeps rows starting from curStartRow
-	rows                     []chunk.Row
+	// partition keeps rows starting from curStartRow, which may be spilled to disk.
+	partition                *windowPartition
+	childColIdxs             []int
 	rowCnt                   uint64
 	whole                    bool
 	isRangeFrame             bool
 	emptyFrame               bool
 	initializedSlidingWindow bool
+
+	memTracker  *memory.Tracker
+	diskTracker *disk.Tracker
 }
 
 // Close implements the Executor Close interface.
 func (e *PipelinedWindowExec) Close() error {
+	if e.partition != nil {
+		if err := e.partition.close(); err != nil {
+			return err
+		}
+		e.partition = nil
+	}
 	return errors.Trace(e.BaseExecutor.Close())
 }
 
@@ -87,8 +97,6 @@ func (e *PipelinedWindowExec) Close() error {
 func (e *PipelinedWindowExec) Open(ctx context.Context) (err error) {
 	e.rowToConsume = 0
 	e.done = false
-	e.accumulated = 0
-	e.dropped = 0
 	e.data = make([]dataInfo, 0)
 	e.dataIdx = 0
 	e.slidingWindowFuncs = make([]aggfuncs.SlidingWindowAggFunc, len(e.windowFuncs))
@@ -97,21 +105,28 @@ func (e *PipelinedWindowExec) Open(ctx context.Context) (err error) {
 			e.slidingWindowFuncs[i] = slidingWindowAggFunc
 		}
 	}
-	e.rows = make([]chunk.Row, 0)
-	return e.BaseExecutor.Open(ctx)
+	if err = e.BaseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	e.childColIdxs = windowChildColIdxs(e.Schema(), e.numWindowFuncs)
+	e.memTracker, e.diskTracker, e.partition = openWindowPartition(&e.BaseExecutor)
+	return nil
 }
 
 func (e *PipelinedWindowExec) firstResultChunkNotReady() bool {
 	if !e.done && len(e.data) == 0 {
 		return true
 	}
-	// chunk can't be ready unless, 1. all of the rows in the chunk is filled, 2. e.rows doesn't contain rows in the chunk
-	return len(e.data) > 0 && (e.data[0].remaining != 0 || e.data[0].accumulated > e.dropped)
+	// chunk can't be ready unless all of the rows in the chunk is filled
+	return len(e.data) > 0 && e.data[0].remaining != 0
 }
 
 // Next implements the Executor Next interface.
 func (e *PipelinedWindowExec) Next(ctx context.Context, chk *chunk.Chunk) (err error) {
 	chk.Reset()
+	if err = e.partition.spillIfNeeded(); err != nil {
+		return err
+	}
 
 	for e.firstResultChunkNotReady() {
 		// we firstly gathering enough rows and consume them, until we are able to produce.
@@ -140,7 +155,9 @@ func (e *PipelinedWindowExec) Next(ctx context.Context, chk *chunk.Chunk) (err e
 					continue
 				}
 				e.newPartition = false
-				e.reset()
+				if err = e.reset(); err != nil {
+					return err
+				}
 				if e.rowToConsume == 0 {
 					// no more data
 					break
@@ -172,7 +189,7 @@ func (e *PipelinedWindowExec) Next(ctx context.Context, chk *chunk.Chunk) (err e
 
 func (e *PipelinedWindowExec) getRowsInPartition(ctx context.Context) (err error) {
 	e.newPartition = true
-	if len(e.rows) == 0 {
+	if e.partition.NumRows() == e.partition.start {
 		// if getRowsInPartition is called for the first time, we ignore it as a new partition
 		e.newPartition = false
 	}
@@ -199,10 +216,7 @@ func (e *PipelinedWindowExec) getRowsInPartition(ctx context.Context) (err error
 	}
 	begin, end := e.groupChecker.GetNextGroup()
 	e.rowToConsume += uint64(end - begin)
-	for i := begin; i < end; i++ {
-		e.rows = append(e.rows, e.childResult.GetRow(i))
-	}
-	return
+	return e.partition.appendRows(e.childResult, begin, end)
 }
 
 func (e *PipelinedWindowExec) fetchChild(ctx context.Context) (eof bool, err error) {
@@ -219,36 +233,13 @@ func (e *PipelinedWindowExec) fetchChild(ctx context.Context) (eof bool, err err
 	}
 
 	// TODO: reuse chunks
-	resultChk := e.Ctx().GetSessionVars().GetNewChunkWithCapacity(e.RetFieldTypes(), 0, numRows, e.AllocPool)
-	err = e.copyChk(childResult, resultChk)
-	if err != nil {
-		return false, err
-	}
-	e.accumulated += uint64(numRows)
-	e.data = append(e.data, dataInfo{chk: resultChk, remaining: uint64(numRows), accumulated: e.accumulated})
+	resultChk := e.Ctx().GetSessionVars().GetNewChunkWithCapacity(e.RetFieldTypes(), numRows, numRows, e.AllocPool)
+	e.data = append(e.data, dataInfo{chk: resultChk, remaining: uint64(numRows)})
 
 	e.childResult = childResult
 	return false, nil
 }
 
-func (e *PipelinedWindowExec) copyChk(src, dst *chunk.Chunk) error {
-	columns := e.Schema().Columns[:len(e.Schema().Columns)-e.numWindowFuncs]
-	for i, col := range columns {
-		if err := dst.MakeRefTo(i, src, col.Index); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (e *PipelinedWindowExec) getRow(i uint64) chunk.Row {
-	return e.rows[i-e.rowStart]
-}
-
-func (e *PipelinedWindowExec) getRows(start, end uint64) []chunk.Row {
-	return e.rows[start-e.rowStart : end-e.rowStart]
-}
-
 // finish is called upon a whole partition is consumed
 func (e *PipelinedWindowExec) finish() {
 	e.whole = true
@@ -259,12 +250,19 @@ func (e *PipelinedWindowExec) getStart(ctx sessionctx.Context) (uint64, error) {
 		return 0, nil
 	}
 	if e.isRangeFrame {
+		curRow, err := e.partition.GetRow(e.curRowIdx)
+		if err != nil {
+			return 0, err
+		}
 		var start uint64
 		for start = mathutil.Max(e.lastStartRow, e.stagedStartRow); start < e.rowCnt; start++ {
+			row, err := e.partition.GetRow(start)
+			if err != nil {
+				return 0, err
+			}
 			var res int64
-			var err error
 			for i := range e.orderByCols {
-				res, _, err = e.start.CmpFuncs[i](ctx, e.start.CompareCols[i], e.start.CalcFuncs[i], e.getRow(start), e.getRow(e.curRowIdx))
+				res, _, err = e.start.CmpFuncs[i](ctx, e.start.CompareCols[i], e.start.CalcFuncs[i], row, curRow)
 				if err != nil {
 					return 0, err
 				}
@@ -299,12 +297,19 @@ func (e *PipelinedWindowExec) getEnd(ctx sessionctx.Context) (uint64, error) {
 		return e.rowCnt, nil
 	}
 	if e.isRangeFrame {
+		curRow, err := e.partition.GetRow(e.curRowIdx)
+		if err != nil {
+			return 0, err
+		}
 		var end uint64
 		for end = mathutil.Max(e.lastEndRow, e.stagedEndRow); end < e.rowCnt; end++ {
+			row, err := e.partition.GetRow(end)
+			if err != nil {
+				return 0, err
+			}
 			var res int64
-			var err error
 			for i := range e.orderByCols {
-				res, _, err = e.end.CmpFuncs[i](ctx, e.end.CalcFuncs[i], e.end.CompareCols[i], e.getRow(e.curRowIdx), e.getRow(end))
+				res, _, err = e.end.CmpFuncs[i](ctx, e.end.CalcFuncs[i], e.end.CompareCols[i], curRow, row)
 				if err != nil {
 					return 0, err
 				}
@@ -364,6 +369,12 @@ func (e *PipelinedWindowExec) produce(ctx sessionctx.Context, chk *chunk.Chunk,
 		if start >= e.rowCnt {
 			start = e.rowCnt
 		}
+		var row chunk.Row
+		row, err = e.partition.GetRow(e.curRowIdx)
+		if err != nil {
+			return
+		}
+		chk.AppendPartialRowByColIdxs(0, row, e.childColIdxs)
 		// if start >= end, we should return a default value, and we reset the frame to empty.
 		if start >= end {
 			for i, wf := range e.windowFuncs {
@@ -385,17 +396,14 @@ func (e *PipelinedWindowExec) produce(ctx sessionctx.Context, chk *chunk.Chunk,
 				slidingWindowAggFunc := e.slidingWindowFuncs[i]
 				if e.lastStartRow != start || e.lastEndRow != end {
 					if slidingWindowAggFunc != nil && e.initializedSlidingWindow {
-						err = slidingWindowAggFunc.Slide(ctx, e.getRow, e.lastStartRow, e.lastEndRow, start-e.lastStartRow, end-e.lastEndRow, e.partialResults[i])
+						err = e.partition.slide(ctx, slidingWindowAggFunc, e.lastStartRow, e.lastEndRow, start-e.lastStartRow, end-e.lastEndRow, e.partialResults[i])
+					} else if partitionRowsWindowFunc, ok := wf.(aggfuncs.PartitionRowsWindowFunc); ok && start == 0 {
+						wf.ResetPartialResult(e.partialResults[i])
+						partitionRowsWindowFunc.SetPartitionRows(partitionRowsView{e.partition, end}, e.partialResults[i])
 					} else {
-						// For MinMaxSlidingWindowAggFuncs, it needs the absolute value of each start of window, to compare
-						// whether elements inside deque are out of current window.
-						if minMaxSlidingWindowAggFunc, ok := wf.(aggfuncs.MaxMinSlidingWindowAggFunc); ok {
-							// Store start inside MaxMinSlidingWindowAggFunc.windowInfo
-							minMaxSlidingWindowAggFunc.SetWindowStart(start)
-						}
 						// TODO(zhifeng): track memory usage here
 						wf.ResetPartialResult(e.partialResults[i])
-						_, err = wf.UpdatePartialResult(ctx, e.getRows(start, end), e.partialResults[i])
+						err = e.partition.updatePartialResult(ctx, wf, e.partialResults[i], start, end)
 					}
 				}
 				if err != nil {
@@ -414,13 +422,7 @@ func (e *PipelinedWindowExec) produce(ctx sessionctx.Context, chk *chunk.Chunk,
 		produced++
 		remained--
 	}
-	extend := mathutil.Min(e.curRowIdx, e.lastEndRow, e.lastStartRow)
-	if extend > e.rowStart {
-		numDrop := extend - e.rowStart
-		e.dropped += numDrop
-		e.rows = e.rows[numDrop:]
-		e.rowStart = extend
-	}
+	e.partition.drop(mathutil.Min(e.curRowIdx, e.lastEndRow, e.lastStartRow))
 	return
 }
 
@@ -443,7 +445,7 @@ func (e *PipelinedWindowExec) enoughToProduce(ctx sessionctx.Context) (enough bo
 }
 
 // reset resets the processor
-func (e *PipelinedWindowExec) reset() {
+func (e *PipelinedWindowExec) reset() error {
 	e.lastStartRow = 0
 	e.lastEndRow = 0
 	e.stagedStartRow = 0
@@ -451,13 +453,26 @@ func (e *PipelinedWindowExec) reset() {
 	e.emptyFrame = false
 	e.curRowIdx = 0
 	e.whole = false
-	numDrop := e.rowCnt - e.rowStart
-	e.dropped += numDrop
-	e.rows = e.rows[numDrop:]
-	e.rowStart = 0
+	// The rows after rowCnt belong to the next partition.
+	if err := e.partition.reset(e.rowCnt); err != nil {
+		return err
+	}
 	e.rowCnt = 0
 	e.initializedSlidingWindow = false
 	for i, windowFunc := range e.windowFuncs {
 		windowFunc.ResetPartialResult(e.partialResults[i])
 	}
+	return nil
+}
+
+// partitionRowsView is the rows in [0, numRows) of the windowPartition. The windowPartition of PipelinedWindowExec
+// may contain the rows of the next partition.
+type partitionRowsView struct {
+	*windowPartition
+	numRows uint64
+}
+
+// NumRows implements the aggfuncs.PartitionRows interface.
+func (v partitionRowsView) NumRows() uint64 {
+	return v.numRows
 }
@@ -48,6 +48,10 @@ func (b *executorBuilder) buildPointGet(p *plannercore.PointGetPlan) exec.Execut
 		b.err = err
 		return nil
 	}
+	if err = validCanReadIndex(p.IndexInfo); err != nil {
+		b.err = err
+		return nil
+	}
 
 	if p.Lock && !b.inSelectLockStmt {
 		b.inSelectLockStmt = true
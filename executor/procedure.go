@@ -0,0 +1,745 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/planner"
+	plannercore "github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/privilege"
+	"github.com/pingcap/tidb/procedure"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/sessiontxn"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+func (e *SimpleExec) executeCreateProcedure(s *ast.ProcedureInfo) error {
+	schema, ok := e.is.SchemaByName(s.ProcedureName.Schema)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(s.ProcedureName.Schema.O)
+	}
+
+	// Compile the routine to report the errors in the body when it's created.
+	var err error
+	if s.IsFunction {
+		_, err = procedure.CompileFunction(s, schema.Collate)
+	} else {
+		_, err = procedure.Compile(s)
+	}
+	if err != nil {
+		return err
+	}
+
+	sessVars := e.Ctx().GetSessionVars()
+	now := time.Now()
+	info := &model.ProcedureInfo{
+		Name:              s.ProcedureName.Name,
+		ParamList:         s.ProcedureParamStr,
+		Body:              s.ProcedureBody.Text(),
+		CollationDatabase: schema.Collate,
+		Created:           now,
+		LastAltered:       now,
+	}
+	if s.IsFunction {
+		var sb strings.Builder
+		if err = s.ReturnType.Restore(format.NewRestoreCtx(format.RestoreKeyWordLowercase|format.RestoreNameBackQuotes|
+			format.RestoreStringSingleQuotes, &sb)); err != nil {
+			return err
+		}
+		info.Returns = sb.String()
+		info.Deterministic = s.Deterministic
+		info.Comment = s.Comment
+		if s.DataAccess != ast.RoutineContainsSQL {
+			info.DataAccess = s.DataAccess.String()
+		}
+	}
+	if user := sessVars.User; user != nil {
+		info.Definer = &auth.UserIdentity{Username: user.AuthUsername, Hostname: user.AuthHostname}
+	}
+	info.SQLMode, _ = sessVars.GetSystemVar(variable.SQLModeVar)
+	info.CharsetClient, _ = sessVars.GetSystemVar(variable.CharacterSetClient)
+	info.CollationConnection, _ = sessVars.GetSystemVar(variable.CollationConnection)
+
+	return e.doRoutineDDL(func(d ddl.DDL) error { return d.CreateProcedure(e.Ctx(), s, info) })
+}
+
+func (e *SimpleExec) executeDropProcedure(s *ast.DropProcedureStmt) error {
+	return e.doRoutineDDL(func(d ddl.DDL) error { return d.DropProcedure(e.Ctx(), s) })
+}
+
+// doRoutineDDL runs the DDL job which creates or drops a stored routine. The statement has committed the previous
+// transaction, like the DDL statements.
+func (e *SimpleExec) doRoutineDDL(fn func(ddl.DDL) error) error {
+	defer func() {
+		e.Ctx().GetSessionVars().StmtCtx.IsDDLJobInQueue = false
+		e.Ctx().GetSessionVars().StmtCtx.DDLJobID = 0
+	}()
+	return fn(domain.GetDomain(e.Ctx()).DDL())
+}
+
+// CallResultsVarKeyType is a dummy type to avoid naming collision in context.
+type CallResultsVarKeyType int
+
+// String defines a Stringer function for debugging and pretty printing.
+func (CallResultsVarKeyType) String() string {
+	return "call_results_var"
+}
+
+// CallResultsVarKey is a variable key for the result sets produced by the procedure called by a `CALL` statement.
+const CallResultsVarKey CallResultsVarKeyType = 0
+
+// CallResults are the result sets produced by the procedure called by a `CALL` statement. They are kept in the
+// session until they're taken by the client connection or the procedure executing the `CALL` statement, or the
+// next statement starts.
+type CallResults struct {
+	// Procedure is the qualified name of the called procedure.
+	Procedure  string
+	ResultSets []sqlexec.RecordSet
+}
+
+// TakeCallResults returns the result sets produced by the procedure called by the last `CALL` statement and clears
+// them from the session, it returns nil if the last statement isn't a `CALL` statement.
+func TakeCallResults(sctx sessionctx.Context) *CallResults {
+	results, ok := sctx.Value(CallResultsVarKey).(*CallResults)
+	if !ok {
+		return nil
+	}
+	sctx.ClearValue(CallResultsVarKey)
+	return results
+}
+
+// CallExec executes the `CALL` statement. Each statement of the procedure is executed by the session as a separate
+// statement, and the result sets are buffered and kept in the session as CallResults.
+type CallExec struct {
+	exec.BaseExecutor
+
+	is      infoschema.InfoSchema
+	dbName  model.CIStr
+	name    model.CIStr
+	args    []expression.Expression
+	outVars []string
+	done    bool
+}
+
+// Next implements the Executor Next interface.
+func (e *CallExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+
+	sctx := e.Ctx()
+	sessVars := sctx.GetSessionVars()
+	qualifiedName := fmt.Sprintf("%s.%s", e.dbName.O, e.name.O)
+	for _, calling := range sessVars.CallingProcedures {
+		if strings.EqualFold(calling, qualifiedName) {
+			return exeerrors.ErrSpRecursionLimit.GenWithStackByArgs(0, e.name.O)
+		}
+	}
+	proc, info, err := e.loadProcedure(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := make([]procedure.Arg, 0, len(e.args))
+	for i, arg := range e.args {
+		d, err := arg.Eval(chunk.Row{})
+		if err != nil {
+			return err
+		}
+		args = append(args, procedure.Arg{Value: d, Type: arg.GetType(), Var: e.outVars[i]})
+	}
+
+	// The statements of the procedure replace the statement context of the session, the context of the `CALL`
+	// statement is restored after the procedure returns, with the warnings and the affected rows of the last
+	// statement of the procedure.
+	sc, startTime := sessVars.StmtCtx, sessVars.StartTime
+	durationParse, durationCompile := sessVars.DurationParse, sessVars.DurationCompile
+	originDB := sessVars.CurrentDB
+	originSQLMode, err := sessVars.GetSessionOrGlobalSystemVar(ctx, variable.SQLModeVar)
+	if err != nil {
+		return err
+	}
+	if err = sessVars.SetSystemVar(variable.SQLModeVar, info.SQLMode); err != nil {
+		return err
+	}
+	sessVars.CurrentDB = e.dbName.O
+	sessVars.CallingProcedures = append(sessVars.CallingProcedures, qualifiedName)
+	defer func() {
+		sessVars.CallingProcedures = sessVars.CallingProcedures[:len(sessVars.CallingProcedures)-1]
+		sessVars.CurrentDB = originDB
+		terror.Log(sessVars.SetSystemVar(variable.SQLModeVar, originSQLMode))
+		if last := sessVars.StmtCtx; last != sc {
+			sc.AppendWarnings(last.GetWarnings())
+			sc.AddAffectedRows(last.AffectedRows())
+		}
+		sessVars.StmtCtx, sessVars.StartTime = sc, startTime
+		sessVars.DurationParse, sessVars.DurationCompile = durationParse, durationCompile
+	}()
+
+	results, err := proc.Call(ctx, &callSession{sctx: sctx}, args)
+	sctx.SetValue(CallResultsVarKey, &CallResults{Procedure: proc.Name(), ResultSets: results})
+	return err
+}
+
+// procedureCacheKeyType is a dummy type to avoid naming collision in context.
+type procedureCacheKeyType int
+
+// String defines a Stringer function for debugging and pretty printing.
+func (procedureCacheKeyType) String() string {
+	return "procedure_cache"
+}
+
+// procedureCacheKey is a variable key for the procedures compiled by the session.
+const procedureCacheKey procedureCacheKeyType = 0
+
+// procedureCache caches the procedures compiled by a session, so the body isn't parsed and compiled again when the
+// procedure is called later. The procedures are created and dropped by DDL jobs, so the cache is discarded when the
+// schema version changes.
+type procedureCache struct {
+	schemaVersion int64
+	procs         map[string]*cachedProcedure
+}
+
+type cachedProcedure struct {
+	proc *procedure.Procedure
+	info *model.ProcedureInfo
+}
+
+// loadProcedure reads the procedure from the meta and compiles it, or returns the one cached by the session.
+func (e *CallExec) loadProcedure(ctx context.Context) (*procedure.Procedure, *model.ProcedureInfo, error) {
+	schema, ok := e.is.SchemaByName(e.dbName)
+	if !ok {
+		return nil, nil, infoschema.ErrDatabaseNotExists.GenWithStackByArgs(e.dbName.O)
+	}
+	cache, ok := e.Ctx().Value(procedureCacheKey).(*procedureCache)
+	if !ok || cache.schemaVersion != e.is.SchemaMetaVersion() {
+		cache = &procedureCache{schemaVersion: e.is.SchemaMetaVersion(), procs: make(map[string]*cachedProcedure)}
+		e.Ctx().SetValue(procedureCacheKey, cache)
+	}
+	key := schema.Name.L + "." + e.name.L
+	if cached, ok := cache.procs[key]; ok {
+		return cached.proc, cached.info, nil
+	}
+
+	var info *model.ProcedureInfo
+	err := kv.RunInNewTxn(kv.WithInternalSourceType(ctx, kv.InternalTxnMeta), e.Ctx().GetStore(), false,
+		func(_ context.Context, txn kv.Transaction) (err error) {
+			info, err = meta.NewMeta(txn).GetProcedure(schema.ID, e.name.L)
+			return err
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+	if info == nil {
+		return nil, nil, exeerrors.ErrSpDoesNotExist.GenWithStackByArgs("PROCEDURE",
+			fmt.Sprintf("%s.%s", schema.Name.O, e.name.O))
+	}
+	sqlMode, err := mysql.GetSQLMode(info.SQLMode)
+	if err != nil {
+		return nil, nil, err
+	}
+	proc, err := procedure.Load(schema.Name, info, sqlMode)
+	if err != nil {
+		return nil, nil, err
+	}
+	cache.procs[key] = &cachedProcedure{proc: proc, info: info}
+	return proc, info, nil
+}
+
+// callSession executes the statements of a procedure called by the `CALL` statement, it implements the
+// procedure.Session interface.
+type callSession struct {
+	sctx sessionctx.Context
+}
+
+var _ procedure.Session = &callSession{}
+
+// ExecuteStmt implements the procedure.Session interface.
+func (s *callSession) ExecuteStmt(ctx context.Context, stmt ast.StmtNode) (sqlexec.RecordSet, error) {
+	return s.sctx.(sqlexec.SQLExecutor).ExecuteStmt(ctx, stmt)
+}
+
+// GetSessionVars implements the procedure.Session interface.
+func (s *callSession) GetSessionVars() *variable.SessionVars {
+	return s.sctx.GetSessionVars()
+}
+
+// CallResults implements the procedure.Session interface.
+func (s *callSession) CallResults() []sqlexec.RecordSet {
+	if results := TakeCallResults(s.sctx); results != nil {
+		return results.ResultSets
+	}
+	return nil
+}
+
+// routineKind returns the kind of the routine used in the messages.
+func routineKind(isFunction bool) string {
+	if isFunction {
+		return "FUNCTION"
+	}
+	return "PROCEDURE"
+}
+
+// listVisibleRoutines returns the procedures and the functions on which the current user has any routine privilege,
+// along with their schemas. The functions are distinguished by the non-empty return types.
+func listVisibleRoutines(ctx context.Context, sctx sessionctx.Context, is infoschema.InfoSchema) ([]*model.ProcedureInfo, []*model.DBInfo, error) {
+	checker := privilege.GetPrivilegeManager(sctx)
+	activeRoles := sctx.GetSessionVars().ActiveRoles
+	var (
+		procedures []*model.ProcedureInfo
+		schemas    []*model.DBInfo
+	)
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnMeta)
+	err := kv.RunInNewTxn(ctx, sctx.GetStore(), false, func(ctx context.Context, txn kv.Transaction) error {
+		procedures, schemas = procedures[:0], schemas[:0]
+		m := meta.NewMeta(txn)
+		for _, schema := range is.AllSchemas() {
+			// The memory databases are not stored in meta, so there are no routines in them.
+			if util.IsMemDB(schema.Name.L) {
+				continue
+			}
+			if checker != nil && !checker.RequestVerification(activeRoles, schema.Name.L, "", "", mysql.CreateRoutinePriv) &&
+				!checker.RequestVerification(activeRoles, schema.Name.L, "", "", mysql.AlterRoutinePriv) &&
+				!checker.RequestVerification(activeRoles, schema.Name.L, "", "", mysql.ExecutePriv) {
+				continue
+			}
+
+			for _, listRoutines := range []func(int64) ([]*model.ProcedureInfo, error){m.ListProcedures, m.ListFunctions} {
+				list, err := listRoutines(schema.ID)
+				if err != nil {
+					return err
+				}
+				for _, info := range list {
+					procedures = append(procedures, info)
+					schemas = append(schemas, schema)
+				}
+			}
+		}
+		return nil
+	})
+	return procedures, schemas, err
+}
+
+func procedureDefiner(info *model.ProcedureInfo) string {
+	if info.Definer == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s@%s", info.Definer.Username, info.Definer.Hostname)
+}
+
+func (e *ShowExec) fetchShowRoutineStatus(ctx context.Context, isFunction bool) error {
+	procedures, schemas, err := listVisibleRoutines(ctx, e.Ctx(), e.is)
+	if err != nil {
+		return err
+	}
+
+	loc := e.Ctx().GetSessionVars().Location()
+	for i, info := range procedures {
+		if (info.Returns != "") != isFunction {
+			continue
+		}
+		e.appendRow([]interface{}{
+			schemas[i].Name.O,
+			info.Name.O,
+			routineKind(isFunction),
+			procedureDefiner(info),
+			eventTime(&info.LastAltered, loc),
+			eventTime(&info.Created, loc),
+			"INVOKER",
+			info.Comment,
+			info.CharsetClient,
+			info.CollationConnection,
+			info.CollationDatabase,
+		})
+	}
+	return nil
+}
+
+func (e *ShowExec) fetchShowCreateRoutine(ctx context.Context, isFunction bool) error {
+	procedures, schemas, err := listVisibleRoutines(ctx, e.Ctx(), e.is)
+	if err != nil {
+		return err
+	}
+
+	for i, info := range procedures {
+		if (info.Returns != "") != isFunction || schemas[i].Name.L != e.Procedure.Schema.L ||
+			info.Name.L != e.Procedure.Name.L {
+			continue
+		}
+		e.appendRow([]interface{}{
+			info.Name.O,
+			info.SQLMode,
+			procedure.CreateStmtText(model.CIStr{}, info),
+			info.CharsetClient,
+			info.CollationConnection,
+			info.CollationDatabase,
+		})
+		return nil
+	}
+	return exeerrors.ErrSpDoesNotExist.GenWithStackByArgs(routineKind(isFunction),
+		fmt.Sprintf("%s.%s", e.Procedure.Schema.O, e.Procedure.Name.O))
+}
+
+func (e *memtableRetriever) setDataFromRoutines(ctx context.Context, sctx sessionctx.Context, is infoschema.InfoSchema) error {
+	procedures, schemas, err := listVisibleRoutines(ctx, sctx, is)
+	if err != nil {
+		return err
+	}
+
+	loc := sctx.GetSessionVars().Location()
+	rows := make([][]types.Datum, 0, len(procedures))
+	for i, info := range procedures {
+		routineType, deterministic, dataAccess := "PROCEDURE", "NO", "CONTAINS SQL"
+		var dataType, charsetName, collationName, dtdIdentifier interface{} = "", nil, nil, nil
+		if info.Returns != "" {
+			routineType, dtdIdentifier = "FUNCTION", info.Returns
+			if info.Deterministic {
+				deterministic = "YES"
+			}
+			if info.DataAccess != "" {
+				dataAccess = info.DataAccess
+			}
+			// The return type is resolved by compiling the function, the type columns are left empty if the
+			// function can't be compiled any more.
+			if fn, err := procedure.LoadFunction(schemas[i].Name, info); err == nil {
+				tp := fn.RetType()
+				dataType = types.TypeToStr(tp.GetType(), tp.GetCharset())
+				if tp.GetCharset() != charset.CharsetBin {
+					charsetName, collationName = tp.GetCharset(), tp.GetCollate()
+				}
+			}
+		}
+		record := types.MakeDatums(
+			info.Name.O,                       // SPECIFIC_NAME
+			infoschema.CatalogVal,             // ROUTINE_CATALOG
+			schemas[i].Name.O,                 // ROUTINE_SCHEMA
+			info.Name.O,                       // ROUTINE_NAME
+			routineType,                       // ROUTINE_TYPE
+			dataType,                          // DATA_TYPE
+			nil,                               // CHARACTER_MAXIMUM_LENGTH
+			nil,                               // CHARACTER_OCTET_LENGTH
+			nil,                               // NUMERIC_PRECISION
+			nil,                               // NUMERIC_SCALE
+			nil,                               // DATETIME_PRECISION
+			charsetName,                       // CHARACTER_SET_NAME
+			collationName,                     // COLLATION_NAME
+			dtdIdentifier,                     // DTD_IDENTIFIER
+			"SQL",                             // ROUTINE_BODY
+			info.Body,                         // ROUTINE_DEFINITION
+			nil,                               // EXTERNAL_NAME
+			"SQL",                             // EXTERNAL_LANGUAGE
+			"SQL",                             // PARAMETER_STYLE
+			deterministic,                     // IS_DETERMINISTIC
+			dataAccess,                        // SQL_DATA_ACCESS
+			nil,                               // SQL_PATH
+			"INVOKER",                         // SECURITY_TYPE
+			eventTime(&info.Created, loc),     // CREATED
+			eventTime(&info.LastAltered, loc), // LAST_ALTERED
+			info.SQLMode,                      // SQL_MODE
+			info.Comment,                      // ROUTINE_COMMENT
+			procedureDefiner(info),            // DEFINER
+			info.CharsetClient,                // CHARACTER_SET_CLIENT
+			info.CollationConnection,          // COLLATION_CONNECTION
+			info.CollationDatabase,            // DATABASE_COLLATION
+		)
+		rows = append(rows, record)
+	}
+	e.rows = rows
+	return nil
+}
+
+func init() {
+	// The stored functions are called in the expressions, but the expression package can't import the executor
+	// package, so the function loading the stored functions is assigned to the expression package.
+	expression.LoadStoredFunction = loadStoredFunction
+}
+
+// loadStoredFunction loads a stored function called in a statement, it returns nil if the function doesn't exist.
+// Like the stored procedures, the privilege to execute the function is checked when it's loaded.
+func loadStoredFunction(sctx sessionctx.Context, schemaName, name model.CIStr) (expression.StoredFunction, error) {
+	is := sessiontxn.GetTxnManager(sctx).GetTxnInfoSchema()
+	if is == nil {
+		is = domain.GetDomain(sctx).InfoSchema()
+	}
+	schema, ok := is.SchemaByName(schemaName)
+	if !ok || util.IsMemDB(schema.Name.L) {
+		return nil, nil
+	}
+
+	var info *model.ProcedureInfo
+	err := kv.RunInNewTxn(kv.WithInternalSourceType(context.Background(), kv.InternalTxnMeta), sctx.GetStore(), false,
+		func(_ context.Context, txn kv.Transaction) (err error) {
+			info, err = meta.NewMeta(txn).GetFunction(schema.ID, name.L)
+			return err
+		})
+	if err != nil || info == nil {
+		return nil, err
+	}
+
+	sessVars := sctx.GetSessionVars()
+	if checker := privilege.GetPrivilegeManager(sctx); checker != nil && sessVars.User != nil &&
+		!checker.RequestVerification(sessVars.ActiveRoles, schema.Name.L, "", "", mysql.ExecutePriv) {
+		return nil, exeerrors.ErrProcaccessDenied.GenWithStackByArgs("execute", sessVars.User.AuthUsername,
+			sessVars.User.AuthHostname, fmt.Sprintf("%s.%s", schema.Name.O, info.Name.O))
+	}
+	sqlMode, err := mysql.GetSQLMode(info.SQLMode)
+	if err != nil {
+		return nil, err
+	}
+	fn, err := procedure.LoadFunction(schema.Name, info)
+	if err != nil {
+		return nil, err
+	}
+	return &storedFunction{
+		Function: fn,
+		schema:   schema.Name,
+		sqlMode:  sqlMode,
+		is:       is,
+		level:    len(sessVars.StmtCtx.StoredFunctions.Calling),
+	}, nil
+}
+
+// storedFunction calls a stored function in the statement context of the calling statement. The statements in the
+// function are executed with the database of the function as the current database, and the sql_mode set to the one
+// when the function was created.
+type storedFunction struct {
+	*procedure.Function
+	schema  model.CIStr
+	sqlMode mysql.SQLMode
+	is      infoschema.InfoSchema
+	// level is the nesting level of the call, which is the number of the stored functions being called when the
+	// function is loaded by the statement calling it.
+	level int
+}
+
+// Call implements the expression.StoredFunction interface.
+func (f *storedFunction) Call(sctx sessionctx.Context, args []types.Datum) (types.Datum, error) {
+	sessVars := sctx.GetSessionVars()
+	calls := &sessVars.StmtCtx.StoredFunctions
+
+	// The calls made by the parallel executors are serialized since they share the session. Each nesting level has
+	// its own lock, so the functions called by the statements in a function don't wait for the function itself.
+	calls.Lock()
+	for len(calls.Locks) <= f.level {
+		calls.Locks = append(calls.Locks, &sync.Mutex{})
+	}
+	lock := calls.Locks[f.level]
+	calls.Unlock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	calls.Lock()
+	recursive := slices.Contains(calls.Calling, f.Name())
+	if !recursive {
+		calls.Calling = append(calls.Calling, f.Name())
+	}
+	calls.Unlock()
+	if recursive {
+		return types.Datum{}, exeerrors.ErrSpNoRecursion.GenWithStackByArgs()
+	}
+
+	sc := sessVars.StmtCtx
+	inHandleRoutine, originDB, originSQLMode := sc.InHandleRoutine, sessVars.CurrentDB, sessVars.SQLMode
+	sc.InHandleRoutine, sessVars.CurrentDB, sessVars.SQLMode = true, f.schema.O, f.sqlMode
+	defer func() {
+		sc.InHandleRoutine, sessVars.CurrentDB, sessVars.SQLMode = inHandleRoutine, originDB, originSQLMode
+		calls.Lock()
+		calls.Calling = calls.Calling[:len(calls.Calling)-1]
+		calls.Unlock()
+	}()
+	return f.Function.Call(context.TODO(), &routineSession{sctx: sctx, is: f.is}, args)
+}
+
+// routineSession executes the statements in the triggers and the stored functions, it implements the
+// procedure.Session interface.
+type routineSession struct {
+	sctx sessionctx.Context
+	is   infoschema.InfoSchema
+	// tables are the IDs of the tables whose triggers are being fired.
+	tables []int64
+	// isTrigger is set for the triggers, whose statements are executed without checking the privileges.
+	isTrigger bool
+}
+
+var _ procedure.Session = &routineSession{}
+
+// GetSessionVars implements the procedure.Session interface.
+func (s *routineSession) GetSessionVars() *variable.SessionVars {
+	return s.sctx.GetSessionVars()
+}
+
+// CallResults implements the procedure.Session interface. The `CALL` statements aren't allowed in the triggers and
+// the stored functions, so there are no result sets.
+func (*routineSession) CallResults() []sqlexec.RecordSet {
+	return nil
+}
+
+// ExecuteStmt implements the procedure.Session interface. Unlike the statements executed by the session, the
+// statement is executed in the statement context of the triggering or calling statement, and its changes are kept
+// in the statement buffer of that statement.
+func (s *routineSession) ExecuteStmt(ctx context.Context, stmt ast.StmtNode) (sqlexec.RecordSet, error) {
+	if err := plannercore.Preprocess(ctx, s.sctx, stmt); err != nil {
+		return nil, err
+	}
+	if !ast.IsReadOnly(stmt) {
+		s.sctx.GetSessionVars().StmtCtx.StoredFunctions.ModifiesData = true
+	}
+	optimize := planner.OptimizeForStoredFunction
+	if s.isTrigger {
+		optimize = planner.OptimizeForTrigger
+	}
+	p, names, err := optimize(ctx, s.sctx, stmt, s.is)
+	if err != nil {
+		return nil, err
+	}
+	b := newExecutorBuilder(s.sctx, s.is, nil)
+	b.triggerTables = s.tables
+	e := b.build(p)
+	if b.err != nil {
+		return nil, b.err
+	}
+	if err = e.Open(ctx); err != nil {
+		terror.Call(e.Close)
+		return nil, err
+	}
+
+	switch stmt.(type) {
+	case *ast.SelectStmt, *ast.SetOprStmt:
+		return &routineRecordSet{
+			executor: e,
+			fields:   colNames2ResultFields(e.Schema(), names, s.sctx.GetSessionVars().CurrentDB),
+		}, nil
+	}
+	err = exec.Next(ctx, e, exec.NewFirstChunk(e))
+	if err == nil {
+		err = s.handleForeignKeys(ctx, e, 1)
+	}
+	if err != nil {
+		terror.Call(e.Close)
+		return nil, err
+	}
+	return nil, e.Close()
+}
+
+// handleForeignKeys checks the foreign keys and executes the foreign key cascades for the rows modified by a
+// statement in the triggers or the stored functions. Unlike ExecStmt.handleForeignKeyTrigger, the statement buffer
+// isn't committed since the changes are rolled back along with the triggering or calling statement.
+func (s *routineSession) handleForeignKeys(ctx context.Context, e exec.Executor, depth int) error {
+	fkExec, ok := e.(WithForeignKeyTrigger)
+	if !ok {
+		return nil
+	}
+	for _, fkCheck := range fkExec.GetFKChecks() {
+		if err := fkCheck.doCheck(ctx); err != nil {
+			return err
+		}
+	}
+	if !fkExec.HasFKCascades() {
+		return nil
+	}
+
+	sc := s.sctx.GetSessionVars().StmtCtx
+	inHandleForeignKeyTrigger := sc.InHandleForeignKeyTrigger
+	sc.InHandleForeignKeyTrigger = true
+	defer func() {
+		sc.InHandleForeignKeyTrigger = inHandleForeignKeyTrigger
+	}()
+	for _, fkCascade := range fkExec.GetFKCascades() {
+		for {
+			ce, err := fkCascade.buildExecutor(ctx)
+			if err != nil || ce == nil {
+				if err != nil {
+					return err
+				}
+				break
+			}
+			if depth > maxForeignKeyCascadeDepth {
+				return exeerrors.ErrForeignKeyCascadeDepthExceeded.GenWithStackByArgs(maxForeignKeyCascadeDepth)
+			}
+			if err = ce.Open(ctx); err != nil {
+				terror.Call(ce.Close)
+				return err
+			}
+			if err = exec.Next(ctx, ce, exec.NewFirstChunk(ce)); err != nil {
+				terror.Call(ce.Close)
+				return err
+			}
+			if err = ce.Close(); err != nil {
+				return err
+			}
+			if err = s.handleForeignKeys(ctx, ce, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// routineRecordSet is the result set of a query in the triggers or the stored functions.
+type routineRecordSet struct {
+	executor exec.Executor
+	fields   []*ast.ResultField
+}
+
+// Fields implements the sqlexec.RecordSet interface.
+func (r *routineRecordSet) Fields() []*ast.ResultField {
+	return r.fields
+}
+
+// Next implements the sqlexec.RecordSet interface.
+func (r *routineRecordSet) Next(ctx context.Context, req *chunk.Chunk) error {
+	return exec.Next(ctx, r.executor, req)
+}
+
+// NewChunk implements the sqlexec.RecordSet interface.
+func (r *routineRecordSet) NewChunk(alloc chunk.Allocator) *chunk.Chunk {
+	if alloc == nil {
+		return exec.NewFirstChunk(r.executor)
+	}
+	base := r.executor.Base()
+	return alloc.Alloc(base.RetFieldTypes(), base.InitCap(), base.MaxChunkSize())
+}
+
+// Close implements the sqlexec.RecordSet interface.
+func (r *routineRecordSet) Close() error {
+	return r.executor.Close()
+}
@@ -81,6 +81,7 @@ import (
 	"github.com/pingcap/tidb/util/set"
 	"github.com/pingcap/tidb/util/sqlexec"
 	"github.com/pingcap/tidb/util/stringutil"
+	"github.com/pingcap/tidb/util/vectorindex"
 	"github.com/tikv/client-go/v2/oracle"
 )
 
@@ -93,6 +94,7 @@ type ShowExec struct {
 	Tp                ast.ShowStmtType // Databases/Tables/Columns/....
 	DBName            model.CIStr
 	Table             *ast.TableName       // Used for showing columns.
+	Procedure         *ast.TableName       // Used for showing create procedure.
 	Partition         model.CIStr          // Used for showing partition
 	Column            *ast.ColumnName      // Used for `desc table column`.
 	IndexName         model.CIStr          // Used for show table regions.
@@ -192,6 +194,10 @@ func (e *ShowExec) fetchAll(ctx context.Context) error {
 		return e.fetchShowCreateView()
 	case ast.ShowCreateDatabase:
 		return e.fetchShowCreateDatabase()
+	case ast.ShowCreateProcedure:
+		return e.fetchShowCreateRoutine(ctx, false)
+	case ast.ShowCreateFunction:
+		return e.fetchShowCreateRoutine(ctx, true)
 	case ast.ShowCreatePlacementPolicy:
 		return e.fetchShowCreatePlacementPolicy()
 	case ast.ShowCreateResourceGroup:
@@ -207,7 +213,9 @@ func (e *ShowExec) fetchAll(ctx context.Context) error {
 	case ast.ShowIndex:
 		return e.fetchShowIndex()
 	case ast.ShowProcedureStatus:
-		return e.fetchShowProcedureStatus()
+		return e.fetchShowRoutineStatus(ctx, false)
+	case ast.ShowFunctionStatus:
+		return e.fetchShowRoutineStatus(ctx, true)
 	case ast.ShowPumpStatus:
 		return e.fetchShowPumpOrDrainerStatus(node.PumpNode)
 	case ast.ShowStatus:
@@ -229,7 +237,7 @@ func (e *ShowExec) fetchAll(ctx context.Context) error {
 	case ast.ShowProcessList:
 		return e.fetchShowProcessList()
 	case ast.ShowEvents:
-		// empty result
+		return e.fetchShowEvents(ctx)
 	case ast.ShowStatsExtended:
 		return e.fetchShowStatsExtended()
 	case ast.ShowStatsMeta:
@@ -1151,6 +1159,10 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *model.CISt
 			buf.WriteString("  PRIMARY KEY ")
 		} else if idxInfo.Unique {
 			fmt.Fprintf(buf, "  UNIQUE KEY %s ", stringutil.Escape(idxInfo.Name.O, sqlMode))
+		} else if idxInfo.Tp == model.IndexTypeFullText {
+			fmt.Fprintf(buf, "  FULLTEXT KEY %s ", stringutil.Escape(idxInfo.Name.O, sqlMode))
+		} else if idxInfo.Tp == model.IndexTypeHNSW {
+			fmt.Fprintf(buf, "  VECTOR KEY %s ", stringutil.Escape(idxInfo.Name.O, sqlMode))
 		} else {
 			fmt.Fprintf(buf, "  KEY %s ", stringutil.Escape(idxInfo.Name.O, sqlMode))
 		}
@@ -1160,6 +1172,8 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *model.CISt
 		for _, c := range idxInfo.Columns {
 			if tableInfo.Columns[c.Offset].Hidden {
 				colInfo = fmt.Sprintf("(%s)", tableInfo.Columns[c.Offset].GeneratedExprString)
+			} else if idxInfo.Tp == model.IndexTypeHNSW {
+				colInfo = fmt.Sprintf("(%s(%s))", strings.ToUpper(vectorindex.DistanceFuncName(idxInfo.VectorDistanceMetric)), stringutil.Escape(c.Name.O, sqlMode))
 			} else {
 				colInfo = stringutil.Escape(c.Name.O, sqlMode)
 				if c.Length != types.UnspecifiedLength {
@@ -1169,6 +1183,12 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *model.CISt
 			cols = append(cols, colInfo)
 		}
 		fmt.Fprintf(buf, "(%s)", strings.Join(cols, ","))
+		if idxInfo.FullTextParser != "" {
+			fmt.Fprintf(buf, ` /*!50100 WITH PARSER %s */`, stringutil.Escape(idxInfo.FullTextParser, sqlMode))
+		}
+		if idxInfo.Tp == model.IndexTypeHNSW {
+			buf.WriteString(" USING HNSW")
+		}
 		if idxInfo.Invisible {
 			fmt.Fprintf(buf, ` /*!80000 INVISIBLE */`)
 		}
@@ -1446,6 +1466,11 @@ func (e *ShowExec) fetchShowCreateTable() error {
 
 	tableInfo := tb.Meta()
 	var buf bytes.Buffer
+	if tableInfo.IsMaterializedView() {
+		fetchShowCreateTable4MaterializedView(e.Ctx(), tableInfo, &buf)
+		e.appendRow([]interface{}{tableInfo.Name.O, buf.String()})
+		return nil
+	}
 	// TODO: let the result more like MySQL.
 	if err = constructResultOfShowCreateTable(e.Ctx(), &e.DBName, tableInfo, tb.Allocators(e.Ctx()), &buf); err != nil {
 		return err
@@ -1863,11 +1888,40 @@ func (e *ShowExec) fetchShowPrivileges() error {
 	return nil
 }
 
-func (*ShowExec) fetchShowTriggers() error {
-	return nil
-}
+func (e *ShowExec) fetchShowEvents(ctx context.Context) error {
+	dbName := e.DBName
+	if _, ok := e.is.SchemaByName(dbName); !ok {
+		return exeerrors.ErrBadDB.GenWithStackByArgs(dbName.O)
+	}
 
-func (*ShowExec) fetchShowProcedureStatus() error {
+	events, schemas, err := listVisibleEvents(ctx, e.Ctx(), e.is)
+	if err != nil {
+		return err
+	}
+
+	for i, ev := range events {
+		if schemas[i].Name.L != dbName.L {
+			continue
+		}
+		loc := eventLocation(ev)
+		e.appendRow([]interface{}{
+			schemas[i].Name.O,
+			ev.Name,
+			ev.TimeZone,
+			eventDefiner(ev),
+			eventType(ev),
+			eventTime(ev.ExecuteAt, loc),
+			eventIntervalValue(ev),
+			eventIntervalField(ev),
+			eventTime(ev.Starts, loc),
+			eventTime(ev.Ends, loc),
+			eventStatus(ev),
+			0,
+			ev.CharsetClient,
+			ev.CollationConnection,
+			ev.CollationDatabase,
+		})
+	}
 	return nil
 }
 
@@ -91,6 +91,12 @@ func (e *ShowExec) appendTableForStatsExtended(dbName string, tbl *model.TableIn
 		case ast.StatsTypeCardinality:
 			statsType = "cardinality"
 			statsVal = item.StringVals
+		case ast.StatsTypeJoint:
+			statsType = "joint"
+			statsVal = item.StringVals
+			if js, err := statistics.DecodeJointStats(item.ColIDs, item.StringVals); err == nil {
+				statsVal = js.String()
+			}
 		}
 		e.appendRow([]interface{}{
 			dbName,
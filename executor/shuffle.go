@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/executor/internal/vecgroupchecker"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/channel"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/execdetails"
@@ -294,21 +295,16 @@ func (e *ShuffleExec) fetchDataAndSplit(ctx context.Context, dataSourceIndex int
 		numRows := chk.NumRows()
 		for i := 0; i < numRows; i++ {
 			workerIdx := workerIndices[i]
-			w := e.workers[workerIdx]
-
-			if results[workerIdx] == nil {
-				select {
-				case <-e.finishCh:
+			if workerIdx != broadcastWorkerIdx {
+				if !e.sendRow(dataSourceIndex, workerIdx, chk.GetRow(i), results) {
 					return
-				case results[workerIdx] = <-w.receivers[dataSourceIndex].inputHolderCh:
-					//nolint: revive
-					break
 				}
+				continue
 			}
-			results[workerIdx].AppendRow(chk.GetRow(i))
-			if results[workerIdx].IsFull() {
-				w.receivers[dataSourceIndex].inputCh <- results[workerIdx]
-				results[workerIdx] = nil
+			for idx := range e.workers {
+				if !e.sendRow(dataSourceIndex, idx, chk.GetRow(i), results) {
+					return
+				}
 			}
 		}
 	}
@@ -320,6 +316,25 @@ func (e *ShuffleExec) fetchDataAndSplit(ctx context.Context, dataSourceIndex int
 	}
 }
 
+// sendRow appends the row to the result chunk of the worker, and sends the chunk to the worker once it's full.
+// It returns false if the ShuffleExec is finished.
+func (e *ShuffleExec) sendRow(dataSourceIndex, workerIdx int, row chunk.Row, results []*chunk.Chunk) bool {
+	w := e.workers[workerIdx]
+	if results[workerIdx] == nil {
+		select {
+		case <-e.finishCh:
+			return false
+		case results[workerIdx] = <-w.receivers[dataSourceIndex].inputHolderCh:
+		}
+	}
+	results[workerIdx].AppendRow(row)
+	if results[workerIdx].IsFull() {
+		w.receivers[dataSourceIndex].inputCh <- results[workerIdx]
+		results[workerIdx] = nil
+	}
+	return true
+}
+
 var _ exec.Executor = &shuffleReceiver{}
 
 // shuffleReceiver receives chunk from dataSource through inputCh
@@ -416,10 +431,19 @@ type partitionSplitter interface {
 	split(ctx sessionctx.Context, input *chunk.Chunk, workerIndices []int) ([]int, error)
 }
 
+// broadcastWorkerIdx is the worker index of the rows which should be sent to all the workers.
+const broadcastWorkerIdx = -1
+
 type partitionHashSplitter struct {
 	byItems    []expression.Expression
 	numWorkers int
 	hashKeys   [][]byte
+
+	// hotKeys are the hash keys of the heavy hitters. The rows with hot keys are spread to the workers in a
+	// round-robin manner if spreadHotKeys is true, or sent to all the workers otherwise.
+	hotKeys       map[string]struct{}
+	spreadHotKeys bool
+	nextWorkerIdx int
 }
 
 func (s *partitionHashSplitter) split(ctx sessionctx.Context, input *chunk.Chunk, workerIndices []int) ([]int, error) {
@@ -431,11 +455,39 @@ func (s *partitionHashSplitter) split(ctx sessionctx.Context, input *chunk.Chunk
 	workerIndices = workerIndices[:0]
 	numRows := input.NumRows()
 	for i := 0; i < numRows; i++ {
+		if _, ok := s.hotKeys[string(s.hashKeys[i])]; ok {
+			if !s.spreadHotKeys {
+				workerIndices = append(workerIndices, broadcastWorkerIdx)
+				continue
+			}
+			workerIndices = append(workerIndices, s.nextWorkerIdx)
+			s.nextWorkerIdx = (s.nextWorkerIdx + 1) % s.numWorkers
+			continue
+		}
 		workerIndices = append(workerIndices, int(murmur3.Sum32(s.hashKeys[i]))%s.numWorkers)
 	}
 	return workerIndices, nil
 }
 
+// setHotKeys sets the heavy hitters of the partition key, they're encoded in the same way as the rows to split.
+func (s *partitionHashSplitter) setHotKeys(ctx sessionctx.Context, hotKeys []types.Datum, spread bool) error {
+	tp := s.byItems[0].GetType()
+	chk := chunk.NewChunkWithCapacity([]*types.FieldType{tp}, len(hotKeys))
+	for i := range hotKeys {
+		chk.AppendDatum(0, &hotKeys[i])
+	}
+	keys, err := aggregate.GetGroupKey(ctx, chk, nil, []expression.Expression{&expression.Column{Index: 0, RetType: tp}})
+	if err != nil {
+		return err
+	}
+	s.hotKeys = make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		s.hotKeys[string(key)] = struct{}{}
+	}
+	s.spreadHotKeys = spread
+	return nil
+}
+
 func buildPartitionHashSplitter(concurrency int, byItems []expression.Expression) *partitionHashSplitter {
 	return &partitionHashSplitter{
 		byItems:    byItems,
@@ -63,3 +63,45 @@ func TestPartitionRangeSplitter(t *testing.T) {
 		require.Equal(t, expected[i], obtained[i])
 	}
 }
+
+func TestPartitionHashSplitterHotKeys(t *testing.T) {
+	ctx := mock.NewContext()
+	concurrency := 3
+
+	tp := types.NewFieldTypeBuilder().SetType(mysql.TypeLonglong).BuildP()
+	col0 := &expression.Column{
+		RetType: tp,
+		Index:   0,
+	}
+	byItems := []expression.Expression{col0}
+
+	input := chunk.New([]*types.FieldType{tp}, 1024, 1024)
+	for i := 0; i < 6; i++ {
+		input.Column(0).AppendInt64(1)
+	}
+	input.Column(0).AppendInt64(2)
+	input.Column(0).AppendInt64(3)
+	hotKeys := []types.Datum{types.NewIntDatum(1)}
+
+	// The rows with hot keys are spread to all the workers.
+	spreadSplitter := buildPartitionHashSplitter(concurrency, byItems)
+	require.NoError(t, spreadSplitter.setHotKeys(ctx, hotKeys, true))
+	obtained, err := spreadSplitter.split(ctx, input, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 2, 0, 1, 2}, obtained[:6])
+
+	// The rows with hot keys are sent to all the workers.
+	broadcastSplitter := buildPartitionHashSplitter(concurrency, byItems)
+	require.NoError(t, broadcastSplitter.setHotKeys(ctx, hotKeys, false))
+	obtained, err = broadcastSplitter.split(ctx, input, obtained)
+	require.NoError(t, err)
+	for i := 0; i < 6; i++ {
+		require.Equal(t, broadcastWorkerIdx, obtained[i])
+	}
+
+	// The other rows are split by hash as usual.
+	hashSplitter := buildPartitionHashSplitter(concurrency, byItems)
+	expected, err := hashSplitter.split(ctx, input, nil)
+	require.NoError(t, err)
+	require.Equal(t, expected[6:], obtained[6:])
+}
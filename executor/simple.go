@@ -193,6 +193,18 @@ func (e *SimpleExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 		err = e.executeSetResourceGroupName(x)
 	case *ast.DropQueryWatchStmt:
 		err = e.executeDropQueryWatch(x)
+	case *ast.CreateEventStmt:
+		err = e.executeCreateEvent(ctx, x)
+	case *ast.AlterEventStmt:
+		err = e.executeAlterEvent(ctx, x)
+	case *ast.DropEventStmt:
+		err = e.executeDropEvent(x)
+	case *ast.ProcedureInfo:
+		err = e.executeCreateProcedure(x)
+	case *ast.DropProcedureStmt:
+		err = e.executeDropProcedure(x)
+	case *ast.RefreshMaterializedViewStmt:
+		err = e.executeRefreshMaterializedView(ctx, x)
 	}
 	e.done = true
 	return err
@@ -2722,6 +2734,12 @@ func (e *SimpleExec) autoNewTxn() bool {
 	// Administrative statements. TODO: ANALYZE TABLE, CACHE INDEX, CHECK TABLE, FLUSH, LOAD INDEX INTO CACHE, OPTIMIZE TABLE, REPAIR TABLE, RESET (but not RESET PERSIST).
 	case *ast.FlushStmt:
 		return true
+	// Statements that define or modify events.
+	case *ast.CreateEventStmt, *ast.AlterEventStmt, *ast.DropEventStmt:
+		return true
+	// Statements that define or modify stored routines.
+	case *ast.ProcedureInfo, *ast.DropProcedureStmt:
+		return true
 	}
 	return false
 }
@@ -2814,6 +2832,9 @@ func (e *SimpleExec) executeAdminFlushPlanCache(s *ast.AdminStmt) error {
 		// Record the timestamp. When other sessions want to use the plan cache,
 		// it will check the timestamp first to decide whether the plan cache should be flushed.
 		domain.GetDomain(e.Ctx()).SetExpiredTimeStamp4PC(now)
+		if instanceCache := domain.GetDomain(e.Ctx()).InstancePlanCache(); instanceCache != nil {
+			instanceCache.DeleteAll()
+		}
 	}
 	return nil
 }
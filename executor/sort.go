@@ -18,7 +18,9 @@ import (
 	"container/heap"
 	"context"
 	"errors"
+	"fmt"
 	"slices"
+	"sync"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/executor/internal/exec"
@@ -26,10 +28,13 @@ import (
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/planner/util"
 	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/disk"
+	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/mathutil"
 	"github.com/pingcap/tidb/util/memory"
+	"go.uber.org/zap"
 )
 
 // SortExec represents sorting executor.
@@ -57,12 +62,24 @@ type SortExec struct {
 	// multiWayMerge uses multi-way merge for spill disk.
 	// The multi-way merge algorithm can refer to https://en.wikipedia.org/wiki/K-way_merge_algorithm
 	multiWayMerge *multiWayMerge
+	// parallelMerge merges the partitions in several workers if concurrency is larger than 1.
+	parallelMerge *parallelMerge
 	// spillAction save the Action for spill disk.
 	spillAction *chunk.SortAndSpillDiskAction
+
+	// concurrency is the number of workers to sort the rows of a partition in memory and to merge the partitions.
+	// The partitions spilled by spillAction are sorted and spilled in their own goroutines, while the rows of the
+	// next partition are fetched.
+	concurrency int
 }
 
 // Close implements the Executor Close interface.
 func (e *SortExec) Close() error {
+	if e.parallelMerge != nil {
+		// The merge workers should exit before the partitions are closed.
+		e.parallelMerge.close()
+		e.parallelMerge = nil
+	}
 	for _, container := range e.partitionList {
 		err := container.Close()
 		if err != nil {
@@ -104,8 +121,8 @@ func (e *SortExec) Open(ctx context.Context) error {
 // Next implements the Executor Next interface.
 // Sort constructs the result following these step:
 //  1. Read as mush as rows into memory.
-//  2. If memory quota is triggered, sort these rows in memory and put them into disk as partition 1, then reset
-//     the memory quota trigger and return to step 1
+//  2. If memory quota is triggered, sort these rows in memory and put them into disk as partition 1 in the
+//     background, then reset the memory quota trigger and return to step 1 without waiting for the spilling
 //  3. If memory quota is not triggered and child is consumed, sort these rows in memory as partition N.
 //  4. Merge sort if the count of partitions is larger than 1. If there is only one partition in step 4, it works
 //     just like in-memory sort before.
@@ -141,39 +158,67 @@ func (e *SortExec) Next(ctx context.Context, req *chunk.Chunk) error {
 }
 
 func (e *SortExec) externalSorting(req *chunk.Chunk) (err error) {
-	if e.multiWayMerge == nil {
-		e.multiWayMerge = &multiWayMerge{e.lessRow, e.compressRow, make([]partitionPointer, 0, len(e.partitionList))}
-		for i := 0; i < len(e.partitionList); i++ {
-			chk := chunk.New(exec.RetTypes(e), 1, 1)
-
-			row, _, err := e.partitionList[i].GetSortedRowAndAlwaysAppendToChunk(0, chk)
-			if err != nil {
-				return err
-			}
-			e.multiWayMerge.elements = append(e.multiWayMerge.elements, partitionPointer{chk: chk, row: row, partitionID: i, consumed: 0})
+	if e.multiWayMerge == nil && e.parallelMerge == nil {
+		// Every merge worker merges at least two partitions.
+		if workers := mathutil.Min(e.concurrency, len(e.partitionList)/2); workers > 1 {
+			e.parallelMerge, err = e.startParallelMerge(workers)
+		} else {
+			e.multiWayMerge, err = e.newMultiWayMerge(e.partitionList)
 		}
-		heap.Init(e.multiWayMerge)
+		if err != nil {
+			return err
+		}
+	}
+	if e.parallelMerge != nil {
+		return e.parallelMerge.next(req)
 	}
+	return e.multiWayMerge.next(req)
+}
 
-	for !req.IsFull() && e.multiWayMerge.Len() > 0 {
-		partitionPtr := e.multiWayMerge.elements[0]
-		req.AppendRow(partitionPtr.row)
-		partitionPtr.consumed++
-		partitionPtr.chk.Reset()
-		if partitionPtr.consumed >= e.partitionList[partitionPtr.partitionID].NumRow() {
-			heap.Remove(e.multiWayMerge, 0)
-			continue
+func (e *SortExec) newMultiWayMerge(partitions []*chunk.SortedRowContainer) (*multiWayMerge, error) {
+	h := &multiWayMerge{e.lessRow, e.compressRow, partitions, make([]partitionPointer, 0, len(partitions))}
+	for i := 0; i < len(partitions); i++ {
+		chk := chunk.New(exec.RetTypes(e), 1, 1)
+
+		row, _, err := partitions[i].GetSortedRowAndAlwaysAppendToChunk(0, chk)
+		if err != nil {
+			return nil, err
 		}
+		h.elements = append(h.elements, partitionPointer{chk: chk, row: row, partitionID: i, consumed: 0})
+	}
+	heap.Init(h)
+	return h, nil
+}
 
-		partitionPtr.row, _, err = e.partitionList[partitionPtr.partitionID].
-			GetSortedRowAndAlwaysAppendToChunk(partitionPtr.consumed, partitionPtr.chk)
+// startParallelMerge splits the partitions into groups, and starts a worker for every group to merge its partitions.
+func (e *SortExec) startParallelMerge(workers int) (*parallelMerge, error) {
+	m := &parallelMerge{
+		lessRowFunction: e.lessRow,
+		memTracker:      e.memTracker,
+		finishCh:        make(chan struct{}),
+	}
+	mergers := make([]*multiWayMerge, 0, workers)
+	for i := 0; i < workers; i++ {
+		begin, end := len(e.partitionList)*i/workers, len(e.partitionList)*(i+1)/workers
+		merger, err := e.newMultiWayMerge(e.partitionList[begin:end])
 		if err != nil {
-			return err
+			return nil, err
 		}
-		e.multiWayMerge.elements[0] = partitionPtr
-		heap.Fix(e.multiWayMerge, 0)
+		mergers = append(mergers, merger)
 	}
-	return nil
+	fields := exec.RetTypes(e)
+	for _, merger := range mergers {
+		stream := &mergeStream{resultCh: make(chan *mergeResult, 1)}
+		m.streams = append(m.streams, stream)
+		m.allStreams = append(m.allStreams, stream)
+		m.wg.Add(1)
+		go m.runWorker(merger, stream.resultCh, fields, e.MaxChunkSize())
+	}
+	if err := m.init(); err != nil {
+		m.close()
+		return nil, err
+	}
+	return m, nil
 }
 
 func (e *SortExec) fetchRowChunks(ctx context.Context) error {
@@ -183,6 +228,7 @@ func (e *SortExec) fetchRowChunks(ctx context.Context) error {
 		byItemsDesc[i] = byItem.Desc
 	}
 	e.rowChunks = chunk.NewSortedRowContainer(fields, e.MaxChunkSize(), byItemsDesc, e.keyColumns, e.keyCmpFuncs)
+	e.rowChunks.SetConcurrency(e.concurrency)
 	e.rowChunks.GetMemTracker().AttachTo(e.memTracker)
 	e.rowChunks.GetMemTracker().SetLabel(memory.LabelForRowChunks)
 	if variable.EnableTmpStorageOnOOM.Load() {
@@ -211,6 +257,7 @@ func (e *SortExec) fetchRowChunks(ctx context.Context) error {
 			if errors.Is(err, chunk.ErrCannotAddBecauseSorted) {
 				e.partitionList = append(e.partitionList, e.rowChunks)
 				e.rowChunks = chunk.NewSortedRowContainer(fields, e.MaxChunkSize(), byItemsDesc, e.keyColumns, e.keyCmpFuncs)
+				e.rowChunks.SetConcurrency(e.concurrency)
 				e.rowChunks.GetMemTracker().AttachTo(e.memTracker)
 				e.rowChunks.GetMemTracker().SetLabel(memory.LabelForRowChunks)
 				e.rowChunks.GetDiskTracker().AttachTo(e.diskTracker)
@@ -241,6 +288,10 @@ func (e *SortExec) fetchRowChunks(ctx context.Context) error {
 		e.rowChunks.Sort()
 		e.partitionList = append(e.partitionList, e.rowChunks)
 	}
+	// The partitions may still be being sorted and spilled in the background.
+	for _, partition := range e.partitionList {
+		partition.WaitForSpill()
+	}
 	return nil
 }
 
@@ -300,9 +351,33 @@ type partitionPointer struct {
 type multiWayMerge struct {
 	lessRowFunction     func(rowI chunk.Row, rowJ chunk.Row) bool
 	compressRowFunction func(rowI chunk.Row, rowJ chunk.Row) int
+	partitions          []*chunk.SortedRowContainer
 	elements            []partitionPointer
 }
 
+// next appends the merged rows to req until it's full or all the partitions are consumed.
+func (h *multiWayMerge) next(req *chunk.Chunk) (err error) {
+	for !req.IsFull() && h.Len() > 0 {
+		partitionPtr := h.elements[0]
+		req.AppendRow(partitionPtr.row)
+		partitionPtr.consumed++
+		partitionPtr.chk.Reset()
+		if partitionPtr.consumed >= h.partitions[partitionPtr.partitionID].NumRow() {
+			heap.Remove(h, 0)
+			continue
+		}
+
+		partitionPtr.row, _, err = h.partitions[partitionPtr.partitionID].
+			GetSortedRowAndAlwaysAppendToChunk(partitionPtr.consumed, partitionPtr.chk)
+		if err != nil {
+			return err
+		}
+		h.elements[0] = partitionPtr
+		heap.Fix(h, 0)
+	}
+	return nil
+}
+
 func (h *multiWayMerge) Less(i, j int) bool {
 	rowI := h.elements[i].row
 	rowJ := h.elements[j].row
@@ -326,6 +401,164 @@ func (h *multiWayMerge) Swap(i, j int) {
 	h.elements[i], h.elements[j] = h.elements[j], h.elements[i]
 }
 
+// parallelMerge merges the sorted partitions in several workers. Every worker merges a group of the partitions into
+// a sorted stream of chunks, and the streams are merged into the final result in the main goroutine.
+type parallelMerge struct {
+	lessRowFunction func(rowI chunk.Row, rowJ chunk.Row) bool
+	memTracker      *memory.Tracker
+	// streams is the heap of the streams that are not exhausted.
+	streams []*mergeStream
+	// allStreams is all the streams, their queued chunks are released when closing.
+	allStreams []*mergeStream
+
+	finishCh chan struct{}
+	wg       sync.WaitGroup
+}
+
+type mergeResult struct {
+	chk *chunk.Chunk
+	err error
+}
+
+// mergeStream is the output of a merge worker.
+type mergeStream struct {
+	resultCh chan *mergeResult
+	chk      *chunk.Chunk
+	idx      int
+}
+
+func (m *parallelMerge) runWorker(merger *multiWayMerge, resultCh chan<- *mergeResult, fields []*types.FieldType, maxChunkSize int) {
+	defer func() {
+		if r := recover(); r != nil {
+			err := fmt.Errorf("%v", r)
+			logutil.BgLogger().Error("parallel merge of sort panicked", zap.Error(err), zap.Stack("stack"))
+			select {
+			case resultCh <- &mergeResult{err: err}:
+			case <-m.finishCh:
+			}
+		}
+		close(resultCh)
+		m.wg.Done()
+	}()
+	for {
+		chk := chunk.New(fields, maxChunkSize, maxChunkSize)
+		err := merger.next(chk)
+		if err == nil && chk.NumRows() == 0 {
+			return
+		}
+		if err == nil {
+			m.memTracker.Consume(chk.MemoryUsage())
+		}
+		select {
+		case resultCh <- &mergeResult{chk: chk, err: err}:
+		case <-m.finishCh:
+			if err == nil {
+				m.memTracker.Consume(-chk.MemoryUsage())
+			}
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// fetch receives the next chunk of the stream, it returns false if the stream is exhausted.
+func (m *parallelMerge) fetch(s *mergeStream) (bool, error) {
+	if s.chk != nil {
+		m.memTracker.Consume(-s.chk.MemoryUsage())
+		s.chk = nil
+	}
+	result, ok := <-s.resultCh
+	if !ok {
+		return false, nil
+	}
+	if result.err != nil {
+		return false, result.err
+	}
+	s.chk, s.idx = result.chk, 0
+	return true, nil
+}
+
+func (m *parallelMerge) init() error {
+	streams := m.streams[:0]
+	for _, s := range m.streams {
+		ok, err := m.fetch(s)
+		if err != nil {
+			return err
+		}
+		if ok {
+			streams = append(streams, s)
+		}
+	}
+	m.streams = streams
+	heap.Init(m)
+	return nil
+}
+
+// next appends the merged rows to req until it's full or all the streams are consumed.
+func (m *parallelMerge) next(req *chunk.Chunk) error {
+	for !req.IsFull() && m.Len() > 0 {
+		s := m.streams[0]
+		req.AppendRow(s.chk.GetRow(s.idx))
+		s.idx++
+		if s.idx >= s.chk.NumRows() {
+			ok, err := m.fetch(s)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				heap.Remove(m, 0)
+				continue
+			}
+		}
+		heap.Fix(m, 0)
+	}
+	return nil
+}
+
+// close stops the workers and waits for them to exit, then it releases the chunks that are not consumed.
+func (m *parallelMerge) close() {
+	close(m.finishCh)
+	m.wg.Wait()
+	for _, s := range m.allStreams {
+		if s.chk != nil {
+			m.memTracker.Consume(-s.chk.MemoryUsage())
+			s.chk = nil
+		}
+		// The channel is closed by the exited worker, so the loop ends after the queued results are drained.
+		for result := range s.resultCh {
+			if result.chk != nil {
+				m.memTracker.Consume(-result.chk.MemoryUsage())
+			}
+		}
+	}
+	m.streams, m.allStreams = nil, nil
+}
+
+func (m *parallelMerge) Less(i, j int) bool {
+	rowI := m.streams[i].chk.GetRow(m.streams[i].idx)
+	rowJ := m.streams[j].chk.GetRow(m.streams[j].idx)
+	return m.lessRowFunction(rowI, rowJ)
+}
+
+func (m *parallelMerge) Len() int {
+	return len(m.streams)
+}
+
+func (*parallelMerge) Push(interface{}) {
+	// Should never be called.
+}
+
+func (m *parallelMerge) Pop() interface{} {
+	m.streams = m.streams[:len(m.streams)-1]
+	return nil
+}
+
+func (m *parallelMerge) Swap(i, j int) {
+	m.streams[i], m.streams[j] = m.streams[j], m.streams[i]
+}
+
 // TopNExec implements a Top-N algorithm and it is built from a SELECT statement with ORDER BY and LIMIT.
 // Instead of sorting all the rows fetched from the table, it keeps the Top-N elements only in a heap to reduce memory usage.
 type TopNExec struct {
@@ -133,3 +133,43 @@ func TestIssue16696(t *testing.T) {
 		}
 	}
 }
+
+func TestParallelSort(t *testing.T) {
+	restore := config.RestoreFunc()
+	defer restore()
+	config.UpdateGlobal(func(conf *config.Config) {
+		conf.TempStoragePath = t.TempDir()
+	})
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	defer tk.MustExec("SET GLOBAL tidb_mem_oom_action = DEFAULT")
+	tk.MustExec("SET GLOBAL tidb_mem_oom_action='LOG'")
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, b varchar(20))")
+	var buf bytes.Buffer
+	buf.WriteString("insert into t values ")
+	for i := 0; i < 5000; i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("(%v, '%v')", i*7919%5000, i%13))
+	}
+	tk.MustExec(buf.String())
+
+	sql := "select * from t order by b desc, a"
+	expected := tk.MustQuery(sql).Rows()
+	tk.MustExec("set @@tidb_sort_concurrency = 4")
+	tk.MustQuery(sql).Check(expected)
+
+	// Spill the rows to disk, so that the partitions are merged in parallel.
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/executor/testSortedRowContainerSpill", "return(true)"))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/executor/testSortedRowContainerSpill"))
+	}()
+	tk.MustExec("set @@tidb_mem_quota_query = 1")
+	tk.MustExec("set @@tidb_max_chunk_size = 32")
+	tk.MustQuery(sql).Check(expected)
+	require.Equal(t, int64(0), tk.Session().GetSessionVars().StmtCtx.DiskTracker.BytesConsumed())
+	require.Greater(t, tk.Session().GetSessionVars().StmtCtx.DiskTracker.MaxConsumed(), int64(0))
+}
@@ -0,0 +1,166 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullTextIndex(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, title varchar(100), body text, fulltext key ft (title, body))")
+	tk.MustExec(`insert into t values
+		(1, 'MySQL Tutorial', 'DBMS stands for DataBase'),
+		(2, 'How To Use MySQL Well', 'After you went through a tutorial'),
+		(3, 'Optimizing MySQL', 'In this tutorial, we show how to optimize'),
+		(4, '1001 MySQL Tricks', 'Never run mysqld as root'),
+		(5, 'MySQL vs. YourSQL', 'In the following database comparison'),
+		(6, 'MySQL Security', 'When configured properly, MySQL is secure')`)
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `id` int(11) NOT NULL,\n" +
+		"  `title` varchar(100) DEFAULT NULL,\n" +
+		"  `body` text DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  FULLTEXT KEY `ft` (`title`,`body`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+
+	checkMatch := func(sql string, ids ...string) {
+		tk.MustQuery(sql).Sort().Check(testkit.Rows(ids...))
+		tk.MustHavePlan(sql, "FullTextIndexReader")
+	}
+	checkMatch("select id from t where match (title, body) against ('database')", "1", "5")
+	checkMatch("select id from t where match (title, body) against ('tutorial database' in natural language mode)", "1", "2", "3", "5")
+	checkMatch("select id from t where match (title, body) against ('+mysql -yoursql' in boolean mode)", "1", "2", "3", "4", "6")
+	checkMatch("select id from t where match (title, body) against ('+tutorial +optimiz*' in boolean mode)", "3")
+	checkMatch(`select id from t where match (title, body) against ('"following database"' in boolean mode)`, "5")
+	checkMatch("select id from t where match (title, body) against ('postgres')")
+	tk.MustQuery("explain format='brief' select id from t where match (title, body) against ('+mysql' in boolean mode) and id > 1").Check(testkit.Rows(
+		"Projection 2666.67 root  test.t.id",
+		"└─Selection 2666.67 root  gt(test.t.id, 1), match(\"+mysql\", 1, test.t.title, test.t.body)",
+		"  └─FullTextIndexReader 2666.67 root table:t, index:ft(title, body) against:\"+mysql\", mode:boolean"))
+	// The rows are ranked by relevance.
+	tk.MustQuery("select id, match (title, body) against ('security mysql') as score from t where match (title, body) against ('security mysql') order by score desc limit 1").
+		CheckAt([]int{0}, testkit.Rows("6"))
+	tk.MustQuery("select id, (match (title, body) against ('security')) > 0 from t where id in (5, 6) order by id").
+		Check(testkit.Rows("5 0", "6 1"))
+
+	// The index is maintained by DML.
+	tk.MustExec("update t set body = 'PostgreSQL tutorial' where id = 1")
+	tk.MustExec("delete from t where id = 5")
+	tk.MustExec("insert into t values (7, 'Database Systems', 'Concepts')")
+	checkMatch("select id from t where match (title, body) against ('database')", "7")
+	checkMatch("select id from t where match (title, body) against ('postgresql')", "1")
+	tk.MustExec("admin check table t")
+
+	// The uncommitted changes are visible in the transaction.
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (8, 'Distributed Database', 'TiDB')")
+	tk.MustExec("delete from t where id = 7")
+	checkMatch("select id from t where match (title, body) against ('database')", "8")
+	tk.MustExec("rollback")
+	checkMatch("select id from t where match (title, body) against ('database')", "7")
+
+	tk.MustGetErrCode("select id from t where match (title) against ('database')", errno.ErrFtMatchingKeyNotFound)
+	tk.MustGetErrCode("select id from t where match (title, body) against (title)", errno.ErrWrongArguments)
+}
+
+func TestFullTextIndexStats(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	// The statistics of t1 are maintained by DML, and those of t2 are rebuilt after the index is backfilled.
+	tk.MustExec("create table t1 (id int primary key, c text, fulltext key ft (c))")
+	tk.MustQuery("select id from t1 where match (c) against ('database')").Check(testkit.Rows())
+	tk.MustExec("insert into t1 values (1, 'relational database'), (2, 'distributed database systems'), (3, 'key value store')")
+	tk.MustExec("insert into t1 values (4, 'document store'), (5, 'graph database')")
+	tk.MustExec("update t1 set c = 'time series database for metrics' where id = 3")
+	tk.MustExec("update t1 set id = 6 where id = 4")
+	tk.MustExec("delete from t1 where id = 5")
+	tk.MustExec("admin check table t1")
+
+	tk.MustExec("create table t2 (id int primary key, c text)")
+	tk.MustExec("insert into t2 select * from t1")
+	tk.MustExec("alter table t2 add fulltext index ft (c)")
+	tk.MustExec("insert into t1 values (7, 'vector database')")
+	tk.MustExec("insert into t2 values (7, 'vector database')")
+	tk.MustExec("admin check table t2")
+
+	check := func() {
+		for _, q := range []string{"database", "store", "+database -relational", "distribut*"} {
+			sql := "select id, match (c) against ('%s' in boolean mode) from %s where match (c) against ('%s' in boolean mode) order by id"
+			expected := tk.MustQuery(fmt.Sprintf(sql, q, "t2", q)).Rows()
+			require.NotEmpty(t, expected)
+			tk.MustQuery(fmt.Sprintf(sql, q, "t1", q)).Check(expected)
+		}
+	}
+	check()
+
+	// Every transaction writes its own delta of the statistics, so the concurrent transactions don't conflict.
+	tk1 := testkit.NewTestKit(t, store)
+	tk1.MustExec("use test")
+	for _, tbl := range []string{"t1", "t2"} {
+		tk.MustExec("begin optimistic")
+		tk1.MustExec("begin optimistic")
+		tk.MustExec(fmt.Sprintf("insert into %s values (8, 'embedded database')", tbl))
+		tk1.MustExec(fmt.Sprintf("delete from %s where id = 7", tbl))
+		tk.MustExec("commit")
+		tk1.MustExec("commit")
+	}
+	check()
+
+	// The deltas are merged without changing the statistics.
+	domain.GetDomain(tk.Session()).MergeFullTextStats()
+	check()
+	tk.MustExec("insert into t1 values (9, 'in-memory database')")
+	tk.MustExec("insert into t2 values (9, 'in-memory database')")
+	check()
+
+	// The statistics include the uncommitted changes of the transaction.
+	tk.MustExec("begin")
+	tk.MustExec("delete from t1 where id = 1")
+	tk.MustExec("delete from t2 where id = 1")
+	tk.MustQuery("select id, match (c) against ('database') from t1 where id = 2").
+		Check(tk.MustQuery("select id, match (c) against ('database') from t2 where id = 2").Rows())
+	tk.MustExec("rollback")
+}
+
+func TestFullTextIndexNgramParser(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, content varchar(100))")
+	tk.MustExec("insert into t values (1, '分布式数据库'), (2, '数据仓库'), (3, '关系型数据库')")
+	tk.MustExec("alter table t add fulltext index ft (content) with parser ngram")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `id` int(11) NOT NULL,\n" +
+		"  `content` varchar(100) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  FULLTEXT KEY `ft` (`content`) /*!50100 WITH PARSER `ngram` */\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustQuery("select id from t where match (content) against ('数据库' in boolean mode)").Sort().Check(testkit.Rows("1", "3"))
+	tk.MustQuery("select id from t where match (content) against ('+数据 -仓库' in boolean mode)").Sort().Check(testkit.Rows("1", "3"))
+
+	tk.MustGetErrCode("create table t1 (id int, c int, fulltext key (c))", errno.ErrBadFtColumn)
+	tk.MustGetErrCode("create table t2 (id int, c text, fulltext key (c)) partition by hash(id) partitions 2", errno.ErrFulltextNotSupportedWithPartitioning)
+	tk.MustGetErrCode("create table t3 (c text, fulltext key (c) with parser mecab)", errno.ErrPluginIsNotLoaded)
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/pingcap/tidb/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorType(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, v vector(3), w vector)")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `id` int(11) NOT NULL,\n" +
+		"  `v` vector(3) DEFAULT NULL,\n" +
+		"  `w` vector DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`) /*T![clustered_index] CLUSTERED */\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustExec("insert into t values (1, '[1, 2, 3]', '[1]'), (2, vec_from_text('[0,0,1]'), '[1,2,3,4]'), (3, null, null)")
+	tk.MustQuery("select id, vec_as_text(v), vec_as_text(w), vec_dims(w) from t order by id").Check(testkit.Rows(
+		"1 [1,2,3] [1] 1", "2 [0,0,1] [1,2,3,4] 4", "3 <nil> <nil> <nil>"))
+	tk.MustQuery("select id, vec_l2_distance(v, '[0,0,0]'), vec_l1_distance(v, '[0,0,0]'), vec_negative_inner_product(v, '[1,1,1]') from t order by id").Check(testkit.Rows(
+		"1 3.7416573867739413 6 -6", "2 1 1 -1", "3 <nil> <nil> <nil>"))
+	tk.MustQuery("select vec_cosine_distance('[1,0]', '[0,1]'), vec_cosine_distance('[1,1]', '[2,2]'), vec_cosine_distance('[1,1]', '[0,0]'), vec_l2_norm('[3,4]')").Check(testkit.Rows(
+		"1 0 <nil> 5"))
+
+	// The brute-force nearest neighbor search sorts all the rows by distance, NULL first.
+	tk.MustQuery("select id from t order by vec_l2_distance(v, '[0,0,2]') limit 3").Check(testkit.Rows("3", "2", "1"))
+
+	tk.MustGetErrCode("insert into t values (4, '[1, 2]', null)", errno.ErrVectorDimensionMismatch)
+	tk.MustGetErrCode("insert into t values (4, '[1, 2, a]', null)", errno.ErrVectorInvalidValue)
+	err := tk.QueryToErr("select vec_l2_distance('[1,2]', '[1,2,3]')")
+	require.True(t, types.ErrVectorDifferentDims.Equal(err))
+	tk.MustGetErrCode("create table t1 (v vector(3), key (v))", errno.ErrUnsupportedDDLOperation)
+}
+
+func TestVectorIndex(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, v vector(2))")
+	values := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		values = append(values, fmt.Sprintf("(%d, '[%d, %d]')", i, i%10, i/10))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ", "))
+	tk.MustExec("alter table t add vector index idx ((vec_l2_distance(v))) using hnsw")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `id` int(11) NOT NULL,\n" +
+		"  `v` vector(2) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  VECTOR KEY `idx` ((VEC_L2_DISTANCE(`v`))) USING HNSW\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+
+	sql := "select id from t order by vec_l2_distance(v, '[3.1, 4.2]') limit 3"
+	tk.MustQuery("explain format='brief' " + sql).Check(testkit.Rows(
+		"Projection 3.00 root  test.t.id",
+		"└─Projection 3.00 root  test.t.id, test.t.v",
+		"  └─TopN 3.00 root  Column#4, offset:0, count:3",
+		"    └─Projection 3.00 root  test.t.id, test.t.v, vec_l2_distance(test.t.v, [3.1, 4.2])->Column#4",
+		"      └─VectorIndexReader 3.00 root table:t, index:idx(v) distance:vec_l2_distance, query:[3.1,4.2], top k:3"))
+	tk.MustQuery(sql).Check(testkit.Rows("43", "53", "44"))
+	// The index is built for the l2 distance only, and the nearest rows can't be filtered.
+	tk.MustHavePlan("select id from t order by vec_cosine_distance(v, '[3, 4]') limit 3", "TableReader")
+	tk.MustHavePlan("select id from t where id > 10 order by vec_l2_distance(v, '[3, 4]') limit 3", "TableReader")
+
+	// The index is maintained by DML.
+	tk.MustExec("delete from t where id = 43")
+	tk.MustExec("update t set v = '[3, 4]' where id = 0")
+	tk.MustQuery(sql).Check(testkit.Rows("0", "53", "44"))
+	tk.MustExec("admin check table t")
+
+	// The uncommitted changes are visible in the transaction.
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (100, '[3.1, 4.2]')")
+	tk.MustQuery(sql).Check(testkit.Rows("100", "0", "53"))
+	tk.MustExec("rollback")
+	tk.MustQuery(sql).Check(testkit.Rows("0", "53", "44"))
+
+	tk.MustExec("create table t1 (id int, v vector(3), vector index idx ((vec_cosine_distance(v))))")
+	tk.MustExec("insert into t1 values (1, '[1, 0, 0]'), (2, '[0, 1, 0]'), (3, '[1, 1, 0]'), (4, null)")
+	tk.MustQuery("select id from t1 order by vec_cosine_distance(v, '[2, 1, 0]') limit 2").Check(testkit.Rows("3", "1"))
+	tk.MustHavePlan("select id from t1 order by vec_cosine_distance(v, '[2, 1, 0]') limit 2", "VectorIndexReader")
+
+	tk.MustGetErrCode("create table t2 (v vector, vector index ((vec_l2_distance(v))))", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("create table t2 (v vector(3), vector index ((vec_l2_norm(v))))", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("create table t2 (id int, v vector(3), vector index ((vec_l2_distance(v)))) partition by hash(id) partitions 2", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t1 modify column v vector(4)", errno.ErrUnsupportedDDLOperation)
+}
@@ -2186,3 +2186,156 @@ func TestOuterJoin(t *testing.T) {
 		),
 	)
 }
+
+func TestLateralDerivedTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table c (id int primary key, name varchar(10))")
+	tk.MustExec("create table o (id int primary key, cid int, amount int)")
+	tk.MustExec("insert into c values (1, 'a'), (2, 'b'), (3, 'c')")
+	tk.MustExec("insert into o values (1, 1, 10), (2, 1, 30), (3, 1, 20), (4, 2, 5), (5, 2, 15)")
+
+	// top-N per group
+	tk.MustQuery("select c.name, dt.amount from c, lateral (select amount from o where o.cid = c.id order by amount desc limit 2) dt " +
+		"order by c.name, dt.amount").Check(testkit.Rows("a 20", "a 30", "b 5", "b 15"))
+	tk.MustQuery("select c.name, dt.amount from c left join lateral (select amount from o where o.cid = c.id order by amount desc limit 1) dt on true " +
+		"order by c.name").Check(testkit.Rows("a 30", "b 15", "c <nil>"))
+	tk.MustQuery("select c.name, dt.cnt, dt.total from c, lateral (select count(*) cnt, sum(amount) total from o where o.cid = c.id) dt " +
+		"order by c.name").Check(testkit.Rows("a 3 60", "b 2 20", "c 0 <nil>"))
+	tk.MustQuery("select c.name, dt.x from c join lateral (select c.id * 10 + o.id as x from o where o.cid = c.id) dt on dt.x > 12 " +
+		"order by dt.x").Check(testkit.Rows("a 13", "b 24", "b 25"))
+	tk.MustQuery("select c.name, dt.amount from c, lateral (select amount from o where o.cid = c.id union all select 0) dt " +
+		"where c.id = 2 order by dt.amount").Check(testkit.Rows("b 0", "b 5", "b 15"))
+
+	tk.MustGetErrMsg("select * from c right join lateral (select o.amount from o where o.cid = c.id) dt on true",
+		"[planner:3668]INNER or LEFT JOIN must be used for LATERAL references made by 'dt'")
+	tk.MustGetErrMsg("select * from lateral (select o.amount from o where o.cid = c.id) dt, c",
+		"[planner:1054]Unknown column 'c.id' in 'where clause'")
+	// the derived tables without LATERAL can't refer to the preceding tables
+	tk.MustGetErrMsg("select * from c, (select o.amount from o where o.cid = c.id) dt",
+		"[planner:1054]Unknown column 'c.id' in 'where clause'")
+}
+
+func TestHashJoinWithHotKeys(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int, b int)")
+	tk.MustExec("create table t2 (a int, b int)")
+	var buf strings.Builder
+	buf.WriteString("insert into t1 values (0, 0)")
+	for i := 1; i <= 200; i++ {
+		buf.WriteString(fmt.Sprintf(", (1, %d)", i))
+	}
+	for i := 2; i <= 100; i++ {
+		buf.WriteString(fmt.Sprintf(", (%d, %d)", i, i))
+	}
+	tk.MustExec(buf.String())
+	tk.MustExec("insert into t2 values (1, 1), (1, 2), (1, 300), (3, 3), (5, 5), (200, 200), (null, null)")
+	tk.MustExec("analyze table t1, t2")
+
+	// t1 is the build side, whose join key has a heavy hitter 1.
+	queries := []string{
+		"select /*+ hash_join_build(t1) */ * from t1 join t2 on t1.a = t2.a",
+		"select /*+ hash_join_build(t1) */ * from t1 join t2 on t1.a = t2.a and t1.b < t2.b",
+		"select /*+ hash_join_build(t1) */ * from t2 left join t1 on t1.a = t2.a and t1.b > 150",
+		"select /*+ hash_join(t2, t1@sel_2) */ * from t2 where exists (select 1 from t1 where t1.a = t2.a and t1.b < t2.b)",
+		"select /*+ hash_join(t2, t1@sel_2) */ * from t2 where not exists (select 1 from t1 where t1.a = t2.a and t1.b > t2.b)",
+		"select /*+ hash_join(t2, t1@sel_2) */ * from t2 where t2.a in (select a from t1 where t1.b > 100)",
+	}
+	expected := make([][][]any, 0, len(queries))
+	for _, query := range queries {
+		expected = append(expected, tk.MustQuery(query).Sort().Rows())
+	}
+
+	tk.MustExec("set @@session.tidb_opt_join_skew_threshold = 0.3")
+	for i, query := range queries {
+		explain := fmt.Sprintf("%v", tk.MustQuery("explain format = 'brief' "+query).Rows())
+		require.Contains(t, explain, "hot keys:1", query)
+		tk.MustQuery(query).Sort().Check(expected[i])
+	}
+	// The 3 probe rows with the hot key get the build rows collected after the hash table is built.
+	rows := tk.MustQuery("explain analyze " + queries[0]).Rows()
+	found := false
+	for _, row := range rows {
+		if strings.Contains(row[0].(string), "HashJoin") {
+			require.Contains(t, row[5].(string), "hot_key_probe:3")
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	// The build side is spilled with a tiny memory quota, then the hot key rows are read from the disk.
+	defer tk.MustExec("SET GLOBAL tidb_mem_oom_action = DEFAULT")
+	tk.MustExec("SET GLOBAL tidb_mem_oom_action='LOG'")
+	tk.MustExec("set @@tidb_mem_quota_query = 1")
+	for i, query := range queries {
+		tk.MustQuery(query).Sort().Check(expected[i])
+	}
+}
+
+func TestHashJoinHotKeysOfDifferentJoinKeys(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	// The join keys aren't the first columns of the tables.
+	tk.MustExec("create table t1 (id int, a varchar(10) collate utf8mb4_bin, b varchar(10) collate utf8mb4_general_ci, c int)")
+	tk.MustExec("create table t2 (id int, a varchar(10) collate utf8mb4_bin, b varchar(10) collate utf8mb4_general_ci, c int)")
+	var buf strings.Builder
+	buf.WriteString("insert into t1 values (0, 'x ', 'X', 0)")
+	for i := 1; i <= 200; i++ {
+		buf.WriteString(fmt.Sprintf(", (%d, 'x', 'x', %d)", i, i%2))
+	}
+	for i := 201; i <= 300; i++ {
+		buf.WriteString(fmt.Sprintf(", (%d, 'k%d', 'k%d', %d)", i, i, i, i))
+	}
+	tk.MustExec(buf.String())
+	tk.MustExec("insert into t2 values (1, 'x', 'X', 1), (2, 'x ', 'x', 0), (3, 'X', 'x ', 2), (4, 'k201', 'K201', 201), (5, 'y', 'y', 5), (6, null, null, null)")
+	tk.MustExec("analyze table t1, t2")
+
+	hotQueries := []string{
+		"select /*+ hash_join_build(t1) */ * from t1 join t2 on t1.a = t2.a",
+		"select /*+ hash_join_build(t1) */ * from t2 left join t1 on t1.a = t2.a and t1.id > 150",
+	}
+	// The TopN of the column with a non-binary collation stores the collation keys rather than the original values,
+	// and the heavy hitters of a composite key can't be got from the statistics of the single columns.
+	noHotQueries := []string{
+		"select /*+ hash_join_build(t1) */ * from t1 join t2 on t1.b = t2.b",
+		"select /*+ hash_join_build(t1) */ * from t1 join t2 on t1.a = t2.a and t1.c = t2.c",
+	}
+	queries := append(hotQueries, noHotQueries...)
+	expected := make([][][]any, 0, len(queries))
+	for _, query := range queries {
+		expected = append(expected, tk.MustQuery(query).Sort().Rows())
+	}
+
+	tk.MustExec("set @@session.tidb_opt_join_skew_threshold = 0.3")
+	for i, query := range queries {
+		explain := fmt.Sprintf("%v", tk.MustQuery("explain format = 'brief' "+query).Rows())
+		if i < len(hotQueries) {
+			require.Contains(t, explain, "hot keys:1", query)
+		} else {
+			require.NotContains(t, explain, "hot keys", query)
+		}
+		tk.MustQuery(query).Sort().Check(expected[i])
+	}
+	// 'x', 'x ' of t2 match the hot key 'x' of t1 with the PAD SPACE collation, but 'X' doesn't.
+	rows := tk.MustQuery("explain analyze " + hotQueries[0]).Rows()
+	found := false
+	for _, row := range rows {
+		if strings.Contains(row[0].(string), "HashJoin") {
+			require.Contains(t, row[5].(string), "hot_key_probe:2")
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	// The TopN doesn't cover all the rows in stats ver1.
+	tk.MustExec("set @@session.tidb_analyze_version = 1")
+	tk.MustExec("analyze table t1")
+	for _, query := range hotQueries {
+		explain := fmt.Sprintf("%v", tk.MustQuery("explain format = 'brief' "+query).Rows())
+		require.NotContains(t, explain, "hot keys", query)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/errno"
 	"github.com/pingcap/tidb/executor"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/testkit"
@@ -416,6 +417,58 @@ func TestLoadDataReplace(t *testing.T) {
 	checkCases(tests, ld, t, tk, ctx, selectSQL, deleteSQL)
 }
 
+func TestLoadDataWithTriggers(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test; drop table if exists load_data_test, load_data_log;")
+	tk.MustExec("create table load_data_test (id int primary key, c int)")
+	tk.MustExec("create table load_data_log (id int, c int)")
+	// the BEFORE trigger of a row sees the rows loaded before it
+	tk.MustExec("create trigger load_data_bi before insert on load_data_test for each row set new.c = new.c + (select count(*) from load_data_test)")
+	tk.MustExec("create trigger load_data_ai after insert on load_data_test for each row insert into load_data_log values (new.id, new.c)")
+	tk.MustExec("create trigger load_data_ad after delete on load_data_test for each row insert into load_data_log values (-old.id, old.c)")
+	tk.MustExec("load data local infile '/tmp/nonexistence.csv' replace into table load_data_test")
+	ctx := tk.Session().(sessionctx.Context)
+	ld, ok := ctx.Value(executor.LoadDataVarKey).(*executor.LoadDataWorker)
+	require.True(t, ok)
+	defer ctx.SetValue(executor.LoadDataVarKey, nil)
+	require.NotNil(t, ld)
+	tests := []testCase{
+		{[]byte("1\t10\n2\t20\n"), []string{"1|10", "2|21"}, "Records: 2  Deleted: 0  Skipped: 0  Warnings: 0"},
+		{[]byte("2\t30\n3\t40\n"), []string{"1|10", "2|32", "3|42"}, "Records: 2  Deleted: 1  Skipped: 0  Warnings: 0"},
+	}
+	deleteSQL := "DO 1"
+	selectSQL := "TABLE load_data_test;"
+	checkCases(tests, ld, t, tk, ctx, selectSQL, deleteSQL)
+	tk.MustQuery("select * from load_data_log").Check(testkit.Rows("1 10", "2 21", "-2 21", "2 32", "3 42"))
+}
+
+func TestLoadDataWithMaterializedView(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test; drop table if exists load_data_test;")
+	tk.MustExec("create table load_data_test (id int primary key, a int, b int)")
+	tk.MustExec("insert into load_data_test values (1, 1, 1), (2, 2, 2)")
+	tk.MustExec("create materialized view load_data_mv as select a, count(*) as cnt, sum(b) as s, count(b) as cb from load_data_test group by a")
+	tk.MustGetErrCode("load data local infile '/tmp/nonexistence.csv' into table load_data_mv", errno.ErrNonUpdatableTable)
+	tk.MustGetErrCode("import into load_data_test from '/tmp/nonexistence.csv'", errno.ErrNonUpdatableTable)
+
+	tk.MustExec("load data local infile '/tmp/nonexistence.csv' replace into table load_data_test")
+	ctx := tk.Session().(sessionctx.Context)
+	ld, ok := ctx.Value(executor.LoadDataVarKey).(*executor.LoadDataWorker)
+	require.True(t, ok)
+	defer ctx.SetValue(executor.LoadDataVarKey, nil)
+	require.NotNil(t, ld)
+	tests := []testCase{
+		{[]byte("2\t1\t20\n3\t3\t30\n4\t1\t\\N\n"), []string{"1|1|1", "2|1|20", "3|3|30", "4|1|<nil>"}, "Records: 3  Deleted: 1  Skipped: 0  Warnings: 0"},
+	}
+	checkCases(tests, ld, t, tk, ctx, "TABLE load_data_test;", "DO 1")
+
+	// the loaded rows are applied to the view by the incremental refresh
+	tk.MustExec("refresh materialized view load_data_mv incremental")
+	tk.MustQuery("select * from load_data_mv order by a").Check(testkit.Rows("1 3 21 2", "3 1 30 1"))
+}
+
 // TestLoadDataOverflowBigintUnsigned related to issue 6360
 func TestLoadDataOverflowBigintUnsigned(t *testing.T) {
 	store := testkit.CreateMockStore(t)
@@ -476,3 +529,32 @@ func TestLoadDataIntoPartitionedTable(t *testing.T) {
 	selectSQL := "select * from range_t order by a;"
 	checkCases(tests, ld, t, tk, ctx, selectSQL, deleteSQL)
 }
+
+func TestLoadDataJSONL(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test; drop table if exists load_data_test;")
+	tk.MustExec("CREATE TABLE load_data_test (id int PRIMARY KEY, name varchar(20), score decimal(30, 10), tags json, b int);")
+	tk.MustExec("load data local infile '/tmp/nonexistence.jsonl' format 'jsonl' into table load_data_test (id, name, score, tags, @ok) set b = @ok * 10")
+	ctx := tk.Session().(sessionctx.Context)
+	ld, ok := ctx.Value(executor.LoadDataVarKey).(*executor.LoadDataWorker)
+	require.True(t, ok)
+	defer ctx.SetValue(executor.LoadDataVarKey, nil)
+	require.NotNil(t, ld)
+
+	data := `{"id": 1, "Name": "a\tb", "score": 12345678901234567890.0123456789, "tags": ["x", {"y": 1}], "ok": true}
+
+{"ok": false, "id": 2, "extra": "ignored"}
+{"id": 3, "name": null, "score": -1.5, "tags": null}`
+	columns, cfg := ld.GetController().GenerateJSONLConfig()
+	require.Equal(t, []string{"id", "name", "score", "tags", "ok"}, columns)
+	parser, err := mydump.NewJSONLParser(context.Background(), cfg, mydump.NewStringReader(data), 1, nil, columns)
+	require.NoError(t, err)
+	require.NoError(t, ld.TestLoadLocal(parser))
+	require.Equal(t, "Records: 3  Deleted: 0  Skipped: 0  Warnings: 0", tk.Session().LastMessage())
+	tk.MustQuery("select * from load_data_test").Check(testkit.RowsWithSep("|",
+		`1|a	b|12345678901234567890.0123456789|["x", {"y": 1}]|10`,
+		"2|<nil>|<nil>|<nil>|0",
+		"3|<nil>|-1.5000000000|<nil>|<nil>",
+	))
+}
@@ -0,0 +1,141 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simpletest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAlterDropEvent(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("create database test2")
+	tk.MustExec("use test")
+	tk.MustExec("set @@time_zone = '+00:00'")
+
+	tk.MustExec("create event e1 on schedule every 1 day starts '2037-01-01 00:00:00' disable comment 'c1' do insert into t values (1)")
+	tk.MustQuery("show events").Check(testkit.Rows(
+		"test e1 +00:00 root@% RECURRING <nil> 1 DAY 2037-01-01 00:00:00 <nil> DISABLED 0 utf8mb4 utf8mb4_bin utf8mb4_bin"))
+	tk.MustQuery("select event_schema, event_name, event_definition, event_type, interval_value, interval_field, status, on_completion, event_comment " +
+		"from information_schema.events").Check(testkit.Rows(
+		"test e1 insert into t values (1) RECURRING 1 DAY DISABLED NOT PRESERVE c1"))
+
+	// create an existing event
+	tk.MustGetErrCode("create event e1 on schedule at '2099-01-01 00:00:00' do select 1", errno.ErrEventAlreadyExists)
+	tk.MustExec("create event if not exists E1 on schedule at '2099-01-01 00:00:00' do select 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1537 Event 'E1' already exists"))
+
+	// invalid schedules
+	tk.MustGetErrCode("create event e2 on schedule every 0 second do select 1", errno.ErrEventIntervalNotPositiveOrTooBig)
+	tk.MustGetErrCode("create event e2 on schedule every 1 hour starts '2037-01-02' ends '2037-01-01' do select 1", errno.ErrEventEndsBeforeStarts)
+	tk.MustContainErrMsg("create event e2 on schedule every 1 month do select 1", "interval unit 'MONTH' for event is not supported")
+	tk.MustGetErrCode("create event e2 on schedule every 1 second do begin select 1; end", errno.ErrNotSupportedYet)
+	tk.MustGetErrCode("create event not_exists_db.e2 on schedule every 1 second do select 1", errno.ErrBadDB)
+
+	// one-time events in the past
+	tk.MustExec("create event e2 on schedule at '2000-01-01 00:00:00' do select 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1588 Event execution time is in the past and ON COMPLETION NOT PRESERVE is set. The event was dropped immediately after creation."))
+	tk.MustExec("create event e2 on schedule at '2000-01-01 00:00:00' on completion preserve do select 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1544 Event execution time is in the past. Event has been disabled"))
+	tk.MustQuery("show events like 'e2'").Check(testkit.Rows(
+		"test e2 +00:00 root@% ONE TIME 2000-01-01 00:00:00 <nil> <nil> <nil> <nil> DISABLED 0 utf8mb4 utf8mb4_bin utf8mb4_bin"))
+
+	// alter events
+	tk.MustExec("alter event e1 on schedule every 2 hour enable comment 'c2' do delete from t")
+	tk.MustQuery("select event_definition, interval_value, interval_field, starts is null, status, event_comment from information_schema.events where event_name = 'e1'").
+		Check(testkit.Rows("delete from t 2 HOUR 1 ENABLED c2"))
+	tk.MustExec("alter event e1 on completion preserve disable")
+	tk.MustQuery("select status, on_completion from information_schema.events where event_name = 'e1'").Check(testkit.Rows("DISABLED PRESERVE"))
+	tk.MustGetErrCode("alter event e1 rename to e1", errno.ErrEventSameName)
+	tk.MustGetErrCode("alter event e1 rename to e2", errno.ErrEventAlreadyExists)
+	tk.MustGetErrCode("alter event e3 disable", errno.ErrEventDoesNotExist)
+	tk.MustExec("alter event e1 rename to test2.e3")
+	// the event is renamed by a single DDL job
+	require.Equal(t, "alter event", tk.MustQuery("admin show ddl jobs 1").Rows()[0][3])
+	tk.MustQuery("select event_schema, event_name, status from information_schema.events order by event_name").
+		Check(testkit.Rows("test e2 DISABLED", "test2 e3 DISABLED"))
+	tk.MustQuery("show events from test2").Check(testkit.Rows(
+		"test2 e3 +00:00 root@% RECURRING <nil> 2 HOUR <nil> <nil> DISABLED 0 utf8mb4 utf8mb4_bin utf8mb4_bin"))
+
+	// drop events
+	tk.MustGetErrCode("drop event e1", errno.ErrEventDoesNotExist)
+	tk.MustExec("drop event if exists e1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1539 Unknown event 'e1'"))
+	tk.MustExec("drop event e2")
+	tk.MustQuery("show events").Check(testkit.Rows())
+	tk.MustExec("drop database test2")
+	tk.MustQuery("select count(*) from information_schema.events").Check(testkit.Rows("0"))
+
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustGetErrCode("show events", errno.ErrNoDB)
+}
+
+func TestEventPrivilege(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("create user u1")
+	tk.MustExec("create database db1")
+	tk.MustExec("create database db2")
+	tk.MustExec("grant event on db1.* to u1")
+	tk.MustExec("create event db1.e1 on schedule every 1 day do select 1")
+	tk.MustExec("create event db2.e2 on schedule every 1 day do select 1")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustQuery("select event_schema, event_name, definer from information_schema.events").Check(testkit.Rows("db1 e1 root@%"))
+	tk1.MustExec("create event db1.e3 on schedule every 1 day do select 1")
+	tk1.MustGetErrCode("create event db2.e3 on schedule every 1 day do select 1", errno.ErrDBaccessDenied)
+	tk1.MustGetErrCode("drop event db2.e2", errno.ErrDBaccessDenied)
+	tk1.MustGetErrCode("create definer = root event db1.e4 on schedule every 1 day do select 1", errno.ErrSpecificAccessDenied)
+	tk1.MustGetErrCode("alter event db1.e1 rename to db2.e1", errno.ErrDBaccessDenied)
+	tk1.MustExec("drop event db1.e1")
+	tk1.MustQuery("select event_schema, event_name, definer from information_schema.events").Check(testkit.Rows("db1 e3 u1@%"))
+}
+
+func TestExecuteEvent(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("create table t2 (a int)")
+
+	tk.MustExec("create event e1 on schedule every 1 second do insert into t values (1)")
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery("select * from t").Rows()) >= 2
+	}, 30*time.Second, 100*time.Millisecond)
+	tk.MustExec("alter event e1 disable")
+	tk.MustQuery("select last_executed is not null from information_schema.events where event_name = 'e1'").Check(testkit.Rows("1"))
+
+	tk.MustExec("create event e2 on schedule at now() + interval 1 second do insert into t2 values (2)")
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery("select * from t2").Rows()) == 1 &&
+			len(tk.MustQuery("select * from information_schema.events where event_name = 'e2'").Rows()) == 0
+	}, 30*time.Second, 100*time.Millisecond)
+
+	tk.MustExec("create event e3 on schedule at now() + interval 1 second on completion preserve do insert into t2 values (3)")
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery("select status from information_schema.events where event_name = 'e3' and status = 'DISABLED'").Rows()) == 1
+	}, 30*time.Second, 100*time.Millisecond)
+	tk.MustQuery("select * from t2 order by a").Check(testkit.Rows("2", "3"))
+}
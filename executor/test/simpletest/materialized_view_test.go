@@ -0,0 +1,169 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simpletest
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDropMaterializedView(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c varchar(10))")
+	tk.MustExec("insert into t values (1, 1, 'x'), (1, 2, 'y'), (2, 3, 'z'), (null, 4, null)")
+
+	tk.MustExec("create materialized view mv as select a, count(*), sum(b), count(b) from t group by a")
+	tk.MustQuery("select * from mv order by a").Check(testkit.Rows("<nil> 1 4 1", "1 2 3 2", "2 1 3 1"))
+	tk.MustQuery("show create table mv").Check(testkit.Rows(
+		"mv CREATE MATERIALIZED VIEW `mv` (`a`, `count(*)`, `sum(b)`, `count(b)`) AS SELECT `a` AS `a`,COUNT(1) AS `count(*)`,SUM(`b`) AS `sum(b)`,COUNT(`b`) AS `count(b)` FROM `test`.`t` GROUP BY `a`"))
+	// the log table is created for the views which can be refreshed incrementally
+	tk.MustQuery("select count(*) from information_schema.tables where table_schema = 'test' and table_name like '\\_tidb\\_mvlog\\_%'").Check(testkit.Rows("1"))
+	tk.MustExec("create materialized view mv2 (x, cnt) as select c, count(*) from t where b > 1 group by c")
+	tk.MustQuery("select * from mv2 order by x").Check(testkit.Rows("<nil> 1", "y 1", "z 1"))
+	// a view which can't be refreshed incrementally has no log table
+	tk.MustExec("create materialized view mv3 as select max(b) from t")
+	tk.MustQuery("select * from mv3").Check(testkit.Rows("4"))
+	tk.MustQuery("select count(*) from information_schema.tables where table_schema = 'test' and table_name like '\\_tidb\\_mvlog\\_%'").Check(testkit.Rows("2"))
+
+	tk.MustGetErrCode("create materialized view mv as select a, count(*) from t group by a", errno.ErrTableExists)
+	tk.MustExec("create materialized view if not exists mv as select a, count(*) from t group by a")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1050 Table 'test.mv' already exists"))
+	tk.MustGetErrCode("create materialized view mv4 (x) as select a, count(*) from t group by a", errno.ErrViewWrongList)
+	tk.MustGetErrCode("create materialized view mv4 as select a, count(*) from t1 group by a", errno.ErrNoSuchTable)
+
+	// the views, their logs and their base tables can't be changed by the other statements
+	tk.MustGetErrCode("insert into mv values (3, 1, 1, 1)", errno.ErrNonUpdatableTable)
+	tk.MustGetErrCode("update mv set a = 3", errno.ErrNonUpdatableTable)
+	tk.MustGetErrCode("delete from mv", errno.ErrNonUpdatableTable)
+	tk.MustGetErrCode("drop table mv", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("truncate table mv", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table mv add column d int", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("drop table t", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("truncate table t", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("rename table t to t2", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t drop column b", errno.ErrUnsupportedDDLOperation)
+	tk.MustExec("alter table t add column d int")
+	tk.MustExec("alter table t add index idx(a)")
+
+	tk.MustGetErrCode("drop materialized view t", errno.ErrWrongObject)
+	tk.MustGetErrCode("drop materialized view mv4", errno.ErrBadTable)
+	tk.MustExec("drop materialized view if exists mv4")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1051 Unknown table 'test.mv4'"))
+	tk.MustExec("drop materialized view mv")
+	tk.MustExec("drop materialized view mv2")
+	tk.MustExec("drop materialized view test.mv3")
+	tk.MustQuery("select count(*) from information_schema.tables where table_schema = 'test'").Check(testkit.Rows("1"))
+	tk.MustExec("drop table t")
+}
+
+func TestRefreshMaterializedView(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int, b int)")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 1, 2), (3, 2, 3), (4, 2, null)")
+	tk.MustExec("create materialized view mv as select a, count(*) as cnt, sum(b) as s, count(b) as cb, min(b) as mn, max(b) as mx from t where id < 100 group by a")
+	check := func() {
+		// the incrementally refreshed view is the same as the result of its query
+		tk.MustQuery("select * from mv order by a").Check(tk.MustQuery(
+			"select a, count(*), sum(b), count(b), min(b), max(b) from t where id < 100 group by a order by a").Rows())
+	}
+	check()
+
+	tk.MustExec("insert into t values (5, 1, 10), (6, 3, 5), (100, 1, 100)")
+	tk.MustQuery("select * from mv order by a").Check(testkit.Rows("1 2 3 2 1 2", "2 2 3 1 3 3"))
+	tk.MustExec("refresh materialized view mv")
+	check()
+
+	// deleting the minimum and maximum recomputes them from the base table
+	tk.MustExec("delete from t where id = 1 or id = 5")
+	tk.MustExec("update t set b = null where id = 3")
+	tk.MustExec("update t set a = 4 where id = 6")
+	tk.MustExec("refresh materialized view mv incremental")
+	check()
+	tk.MustQuery("select * from mv order by a").Check(testkit.Rows("1 1 2 1 2 2", "2 2 <nil> 0 <nil> <nil>", "4 1 5 1 5 5"))
+
+	// the empty groups are deleted
+	tk.MustExec("delete from t where a = 2")
+	tk.MustExec("insert into t values (7, null, 7)")
+	tk.MustExec("insert into t values (8, 1, 3) on duplicate key update b = 1")
+	tk.MustExec("replace into t values (2, 1, 9)")
+	tk.MustExec("refresh materialized view test.mv")
+	check()
+
+	// the changes in a rolled back transaction are not recorded
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (9, 1, 1)")
+	tk.MustExec("rollback")
+	tk.MustExec("refresh materialized view mv")
+	check()
+
+	tk.MustExec("refresh materialized view mv complete")
+	check()
+	tk.MustExec("create materialized view mv2 as select a, max(b) from t group by a having max(b) > 1")
+	tk.MustExec("insert into t values (10, 5, 5)")
+	tk.MustGetErrCode("refresh materialized view mv2 incremental", errno.ErrNotSupportedYet)
+	tk.MustExec("refresh materialized view mv2")
+	tk.MustQuery("select * from mv2 order by a").Check(testkit.Rows("<nil> 7", "1 100", "4 5", "5 5"))
+	tk.MustGetErrCode("refresh materialized view t", errno.ErrWrongObject)
+	tk.MustGetErrCode("refresh materialized view mv3", errno.ErrNoSuchTable)
+}
+
+func TestMaterializedViewRewrite(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int)")
+	tk.MustExec("insert into t values (1, 1, 1), (1, 2, 2), (2, 1, 3), (2, 2, 4)")
+	tk.MustExec("create materialized view mv (x, y, cnt, s, cc) as select a, b, count(*), sum(c), count(c) from t where c > 0 group by a, b")
+	usesView := func(sql string) bool {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			for _, col := range row {
+				if s, ok := col.(string); ok && s == "table:mv" {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	sql := "select a, sum(c) from t where c > 0 group by a order by a"
+	require.False(t, usesView(sql))
+	tk.MustExec("set @@tidb_enable_materialized_view_rewrite = on")
+	require.True(t, usesView(sql))
+	tk.MustQuery(sql).Check(testkit.Rows("1 3", "2 7"))
+	tk.MustQuery("select b, count(*) as n from t where c > 0 group by b having n > 1 order by b").Check(testkit.Rows("1 2", "2 2"))
+	tk.MustQuery("select count(*), sum(c) from t where c > 0").Check(testkit.Rows("4 10"))
+	require.True(t, usesView("select a, b, count(*) from t where c > 0 group by a, b having count(*) > 0"))
+
+	// the view is stale until it's refreshed
+	tk.MustExec("insert into t values (3, 1, 5)")
+	tk.MustQuery(sql).Check(testkit.Rows("1 3", "2 7"))
+	tk.MustExec("refresh materialized view mv")
+	tk.MustQuery(sql).Check(testkit.Rows("1 3", "2 7", "3 5"))
+
+	// the queries which can't be answered by the view
+	require.False(t, usesView("select a, sum(c) from t where c > 1 group by a"))
+	require.False(t, usesView("select a, avg(c) from t where c > 0 group by a"))
+	require.False(t, usesView("select c, count(*) from t where c > 0 group by c"))
+	require.False(t, usesView("select a from t where c > 0"))
+	require.False(t, usesView("select a, sum(c) from t where c > 0 group by a for update"))
+	tk.MustExec("set @@tidb_enable_materialized_view_rewrite = default")
+}
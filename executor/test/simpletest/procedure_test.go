@@ -0,0 +1,482 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simpletest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/executor"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/session"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDropProcedure(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+
+	tk.MustExec("create procedure p1(in a int, out b varchar(10)) begin set b = concat('v', a); end")
+	tk.MustQuery("show procedure status like 'p1'").CheckAt([]int{0, 1, 2, 3, 6, 8, 9, 10}, testkit.Rows(
+		"test p1 PROCEDURE root@% INVOKER utf8mb4 utf8mb4_bin utf8mb4_bin"))
+	tk.MustQuery("show procedure status like 'p2'").Check(testkit.Rows())
+	rows := tk.MustQuery("show create procedure p1").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, []interface{}{"p1", "CREATE PROCEDURE `p1`(in a int, out b varchar(10))\nbegin set b = concat('v', a); end",
+		"utf8mb4", "utf8mb4_bin", "utf8mb4_bin"}, []interface{}{rows[0][0], rows[0][2], rows[0][3], rows[0][4], rows[0][5]})
+	tk.MustQuery("select routine_schema, routine_name, routine_type, routine_definition, definer from information_schema.routines").Check(testkit.Rows(
+		"test p1 PROCEDURE begin set b = concat('v', a); end root@%"))
+
+	// create an existing procedure
+	tk.MustGetErrCode("create procedure p1() select 1", errno.ErrSpAlreadyExists)
+	tk.MustExec("create procedure if not exists P1() select 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1304 PROCEDURE P1 already exists"))
+	tk.MustGetErrCode("create procedure not_exists_db.p2() select 1", errno.ErrBadDB)
+
+	// errors in the body are reported when the procedure is created
+	tk.MustGetErrCode("create procedure p2() begin declare a int; declare a int; end", errno.ErrSpDupVar)
+	tk.MustGetErrCode("create procedure p2() begin leave l; end", errno.ErrSpLilabelMismatch)
+
+	// drop
+	tk.MustGetErrCode("drop procedure p2", errno.ErrSpDoesNotExist)
+	tk.MustExec("drop procedure if exists p2")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1305 PROCEDURE test.p2 does not exist"))
+	tk.MustExec("drop procedure P1")
+	tk.MustQuery("show procedure status").Check(testkit.Rows())
+	require.ErrorContains(t, tk.QueryToErr("show create procedure p1"), "PROCEDURE test.p1 does not exist")
+	tk.MustGetErrCode("call p1(1, @b)", errno.ErrSpDoesNotExist)
+
+	// procedures are created and dropped by DDL jobs, the procedures compiled by other sessions are reloaded
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustExec("use test")
+	tk.MustExec("create procedure p3(out a int) set a = 1")
+	tk2.MustExec("call p3(@a)")
+	tk2.MustQuery("select @a").Check(testkit.Rows("1"))
+	tk.MustExec("drop procedure p3")
+	tk2.MustGetErrCode("call p3(@a)", errno.ErrSpDoesNotExist)
+	tk.MustExec("create procedure p3(out a int) set a = 2")
+	tk2.MustExec("call p3(@a)")
+	tk2.MustQuery("select @a").Check(testkit.Rows("2"))
+	tk.MustQuery("admin show ddl jobs 3").CheckAt([]int{1, 3}, testkit.RowsWithSep("|",
+		"test|create procedure", "test|drop procedure", "test|create procedure"))
+	tk.MustExec("drop procedure p3")
+
+	// procedures are dropped with the database
+	tk.MustExec("create database test2")
+	tk.MustExec("create procedure test2.p1() select 1")
+	tk.MustQuery("show procedure status").CheckAt([]int{0, 1}, testkit.Rows("test2 p1"))
+	tk.MustExec("drop database test2")
+	tk.MustQuery("show procedure status").Check(testkit.Rows())
+}
+
+func TestCallProcedure(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b varchar(10))")
+	tk.MustExec("insert into t values (1, 'a'), (2, 'b'), (3, 'c')")
+
+	// IN, OUT and INOUT parameters
+	tk.MustExec("create procedure p1(in a int, out b varchar(10), inout c int) begin set b = concat('v', a); set c = c + a; end")
+	tk.MustExec("set @b = 'x', @c = 10")
+	tk.MustExec("call p1(5, @b, @c)")
+	tk.MustQuery("select @b, @c").Check(testkit.Rows("v5 15"))
+	tk.MustExec("call p1(null, @b, @c)")
+	tk.MustQuery("select @b, @c").Check(testkit.Rows("<nil> <nil>"))
+	tk.MustGetErrCode("call p1(1, @b)", errno.ErrSpWrongNoOfArgs)
+	tk.MustGetErrCode("call p1(1, 'x', @c)", errno.ErrSpNotVarArg)
+
+	// DECLARE, IF and WHILE
+	tk.MustExec(`create procedure p2(in n int, out s int)
+begin
+	declare i int default 0;
+	set s = 0;
+	l: while i < n do
+		set i = i + 1;
+		if i % 2 = 0 then
+			iterate l;
+		elseif i > 7 then
+			leave l;
+		end if;
+		set s = s + i;
+	end while l;
+end`)
+	tk.MustExec("call p2(10, @s)")
+	tk.MustQuery("select @s").Check(testkit.Rows("16"))
+
+	// REPEAT and CASE
+	tk.MustExec(`create procedure p3(in n int, out s varchar(100))
+begin
+	declare i int default 0;
+	set s = '';
+	repeat
+		set i = i + 1;
+		case i % 3
+			when 0 then set s = concat(s, 'a');
+			when 1 then set s = concat(s, 'b');
+			else set s = concat(s, 'c');
+		end case;
+	until i >= n end repeat;
+end`)
+	tk.MustExec("call p3(5, @s)")
+	tk.MustQuery("select @s").Check(testkit.Rows("bcabc"))
+	tk.MustExec("create procedure p4(in a int) begin case when a > 0 then select 1; end case; end")
+	tk.MustGetErrCode("call p4(0)", errno.ErrSpCaseNotFound)
+
+	// the local variables are used in the statements, and shadow the columns
+	tk.MustExec(`create procedure p5(in a int)
+begin
+	declare b varchar(10) default 'x';
+	update t set b = b where a = a;
+	insert into t values (a + 10, concat(b, a));
+end`)
+	tk.MustExec("call p5(2)")
+	tk.MustQuery("select * from t order by a").Check(testkit.Rows("1 x", "2 x", "3 x", "12 x2"))
+
+	// cursors and a CONTINUE handler for NOT FOUND
+	tk.MustExec(`create procedure p6(out s varchar(100))
+begin
+	declare done int default 0;
+	declare x int;
+	declare y varchar(10);
+	declare c cursor for select * from t where a < 10 order by a;
+	declare continue handler for not found set done = 1;
+	set s = '';
+	open c;
+	fetch_loop: while done = 0 do
+		fetch c into x, y;
+		if done = 0 then
+			set s = concat(s, x, y, ',');
+		end if;
+	end while fetch_loop;
+	close c;
+end`)
+	tk.MustExec("call p6(@s)")
+	tk.MustQuery("select @s").Check(testkit.Rows("1x,2x,3x,"))
+	tk.MustExec("create procedure p7() begin declare c cursor for select 1; close c; end")
+	tk.MustGetErrCode("call p7()", errno.ErrSpCursorNotOpen)
+	tk.MustExec("create procedure p8() begin declare a int; declare c cursor for select 1 from t where 0; open c; fetch c into a; end")
+	tk.MustGetErrCode("call p8()", errno.ErrSpFetchNoData)
+
+	// EXIT and CONTINUE handlers for errors
+	tk.MustExec(`create procedure p9(out s varchar(100))
+begin
+	set s = 'start';
+	begin
+		declare exit handler for 1062 set s = concat(s, ',dup');
+		insert into t values (1, 'a');
+		set s = concat(s, ',unreachable');
+	end;
+	begin
+		declare continue handler for sqlexception set s = concat(s, ',exception');
+		insert into t values (1, 'a');
+		set s = concat(s, ',continue');
+	end;
+	set s = concat(s, ',end');
+end`)
+	tk.MustExec("call p9(@s)")
+	tk.MustQuery("select @s").Check(testkit.Rows("start,dup,exception,continue,end"))
+	tk.MustExec("create procedure p10() begin declare exit handler for 1146 begin end; insert into t values (1, 'a'); end")
+	tk.MustGetErrCode("call p10()", errno.ErrDupEntry)
+
+	// the statements are executed in the database of the procedure
+	tk.MustExec("create database test2")
+	tk.MustExec("use test2")
+	tk.MustExec("call test.p2(3, @s)")
+	tk.MustQuery("select @s, database()").Check(testkit.Rows("4 test2"))
+}
+
+func TestCallProcedureResultSets(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t values (1), (2)")
+	tk.MustExec(`create procedure p1(in n int)
+begin
+	select a from t order by a;
+	insert into t values (n);
+	select count(*), n from t;
+	select 1 from t where a = 100;
+end`)
+
+	results, err := callProcedure(tk, "call p1(10)")
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	expected := [][]string{{"1", "2"}, {"3 10"}, {}}
+	for i, rs := range results {
+		rows, err := session.ResultSetToStringSlice(context.Background(), tk.Session(), rs)
+		require.NoError(t, err)
+		actual := make([]string, 0, len(rows))
+		for _, row := range rows {
+			actual = append(actual, strings.Join(row, " "))
+		}
+		require.Equal(t, expected[i], actual)
+	}
+
+	// the result sets before an unhandled error are returned along with the error
+	tk.MustExec("create procedure p2() begin select 1; select * from not_exists; select 2; end")
+	results, err = callProcedure(tk, "call p2()")
+	require.Error(t, err)
+	require.Len(t, results, 1)
+	closeResultSets(t, results)
+
+	// the result sets of the nested procedures are returned by the outer procedure
+	tk.MustExec("create procedure p3() begin select 0; call p1(20); end")
+	results, err = callProcedure(tk, "call p3()")
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	closeResultSets(t, results)
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("4"))
+}
+
+func TestCallProcedureNested(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create procedure inner_p(inout a int) begin set a = a * 2; end")
+
+	// the local variables are passed to the nested procedure
+	tk.MustExec(`create procedure outer_p(in n int, out s int)
+begin
+	declare x int default n;
+	call inner_p(x);
+	call test.inner_p(x);
+	set s = x;
+end`)
+	tk.MustExec("call outer_p(3, @s)")
+	tk.MustQuery("select @s").Check(testkit.Rows("12"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+
+	// CALL is executed in the prepared statements and the internal sessions
+	tk.MustExec("set @y = 5")
+	tk.MustExec("prepare s from 'call inner_p(@y)'")
+	tk.MustExec("execute s")
+	tk.MustExec("execute s")
+	tk.MustQuery("select @y").Check(testkit.Rows("20"))
+	tk.MustExec("prepare s from 'call inner_p(?)'")
+	tk.MustGetErrCode("execute s using @y", errno.ErrSpNotVarArg)
+	tk.MustExec("set @z = 1")
+	_, err := tk.Session().Execute(context.Background(), "call inner_p(@z)")
+	require.NoError(t, err)
+	tk.MustQuery("select @z").Check(testkit.Rows("2"))
+
+	// the statements of the procedures are committed in the explicit transaction
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("create procedure ins(in a int) insert into t values (a)")
+	tk.MustExec("begin")
+	tk.MustExec("call ins(1)")
+	tk.MustExec("call ins(2)")
+	tk.MustExec("rollback")
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("0"))
+	tk.MustExec("call ins(3)")
+	tk.MustQuery("select a from t").Check(testkit.Rows("3"))
+
+	// recursion isn't allowed
+	tk.MustExec("create procedure rec(in n int) begin if n > 0 then call rec(n - 1); end if; end")
+	tk.MustExec("call rec(0)")
+	tk.MustGetErrCode("call rec(1)", errno.ErrSpRecursionLimit)
+
+	// CALL isn't allowed in the functions and the triggers
+	tk.MustGetErrCode("create function f() returns int begin call inner_p(@y); return 1; end", errno.ErrNotSupportedYet)
+}
+
+func TestCallProcedurePrivilege(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create user 'u1'@'%'")
+	tk.MustExec("create database test2")
+	tk.MustExec("create procedure test2.p1() select 1")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustGetErrCode("create procedure test2.p2() select 1", errno.ErrDBaccessDenied)
+	tk1.MustGetErrCode("drop procedure test2.p1", errno.ErrDBaccessDenied)
+	tk1.MustGetErrCode("call test2.p1()", errno.ErrProcaccessDenied)
+	tk1.MustQuery("show procedure status").Check(testkit.Rows())
+
+	tk.MustExec("grant execute on test2.* to 'u1'@'%'")
+	tk1.MustQuery("call test2.p1()").Check(testkit.Rows("1"))
+	tk1.MustQuery("show procedure status").CheckAt([]int{0, 1}, testkit.Rows("test2 p1"))
+
+	tk.MustExec("grant create routine, alter routine on test2.* to 'u1'@'%'")
+	tk1.MustExec("create procedure test2.p2() select 2")
+	tk1.MustExec("drop procedure test2.p2")
+}
+
+func TestCreateDropFunction(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+
+	tk.MustExec("create function f1(a int, b varchar(10)) returns varchar(20) deterministic comment 'concat' return concat(b, a)")
+	tk.MustExec("create procedure f1() select 1")
+	tk.MustQuery("show function status like 'f1'").CheckAt([]int{0, 1, 2, 3, 6, 7, 8, 9, 10}, testkit.Rows(
+		"test f1 FUNCTION root@% INVOKER concat utf8mb4 utf8mb4_bin utf8mb4_bin"))
+	tk.MustQuery("show procedure status").CheckAt([]int{0, 1, 2}, testkit.Rows("test f1 PROCEDURE"))
+	rows := tk.MustQuery("show create function f1").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, []interface{}{"f1", "CREATE FUNCTION `f1`(a int, b varchar(10)) RETURNS varchar(20)\n    DETERMINISTIC\n    COMMENT 'concat'\nreturn concat(b, a)",
+		"utf8mb4", "utf8mb4_bin", "utf8mb4_bin"}, []interface{}{rows[0][0], rows[0][2], rows[0][3], rows[0][4], rows[0][5]})
+	tk.MustQuery("select routine_name, routine_type, data_type, character_set_name, collation_name, dtd_identifier, " +
+		"is_deterministic, sql_data_access, routine_comment from information_schema.routines where routine_type = 'FUNCTION'").Check(testkit.Rows(
+		"f1 FUNCTION varchar utf8mb4 utf8mb4_bin varchar(20) YES CONTAINS SQL concat"))
+
+	// create an existing function
+	tk.MustGetErrCode("create function f1() returns int return 1", errno.ErrSpAlreadyExists)
+	tk.MustExec("create function if not exists F1() returns int return 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1304 FUNCTION F1 already exists"))
+
+	// errors in the body are reported when the function is created
+	tk.MustGetErrCode("create function f2() returns int begin end", errno.ErrSpNoreturn)
+	tk.MustGetErrCode("create function f2() returns int begin select 1; return 1; end", errno.ErrSpNoRetset)
+	tk.MustGetErrCode("create procedure p2() return 1", errno.ErrSpBadreturn)
+
+	// drop
+	tk.MustGetErrCode("drop function f2", errno.ErrSpDoesNotExist)
+	tk.MustExec("drop function if exists f2")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1305 FUNCTION test.f2 does not exist"))
+	tk.MustExec("drop function F1")
+	tk.MustQuery("show function status").Check(testkit.Rows())
+	tk.MustQuery("show procedure status").CheckAt([]int{0, 1, 2}, testkit.Rows("test f1 PROCEDURE"))
+	require.ErrorContains(t, tk.QueryToErr("show create function f1"), "FUNCTION test.f1 does not exist")
+	tk.MustGetErrCode("select f1()", errno.ErrSpDoesNotExist)
+}
+
+func TestCallFunction(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b varchar(10))")
+	tk.MustExec("insert into t values (1, 'a'), (2, 'b'), (3, 'c')")
+
+	tk.MustExec("create function add1(a int) returns int deterministic return a + 1")
+	tk.MustQuery("select add1(1), test.add1(null), add1('2')").Check(testkit.Rows("2 <nil> 3"))
+	tk.MustQuery("select a from t where add1(a) > 2 order by add1(a) desc").Check(testkit.Rows("3", "2"))
+	tk.MustGetErrCode("select add1()", errno.ErrSpWrongNoOfArgs)
+	tk.MustGetErrCode("select not_exists_db.add1(1)", errno.ErrSpDoesNotExist)
+	// the builtin functions take precedence over the stored functions unless the database is specified
+	tk.MustExec("create function abs(a int) returns int return a")
+	tk.MustQuery("select abs(-1), test.abs(-1)").Check(testkit.Rows("1 -1"))
+
+	// the value returned is converted to the return type
+	tk.MustExec("create function half(a int) returns decimal(5, 1) return a / 2")
+	tk.MustQuery("select half(3), half(3) + 1").Check(testkit.Rows("1.5 2.5"))
+	tk.MustExec("create function hello(s varchar(10)) returns varchar(20) return concat('hello, ', s)")
+	tk.MustQuery("select hello(b) from t order by a").Check(testkit.Rows("hello, a", "hello, b", "hello, c"))
+
+	// the function reads the tables and uses the control flow statements
+	tk.MustExec(`create function count_greater(x int) returns int
+begin
+	declare done int default 0;
+	declare v, n int default 0;
+	declare cur cursor for select a from t;
+	declare continue handler for not found set done = 1;
+	open cur;
+	fetch_loop: while done = 0 do
+		fetch cur into v;
+		if done = 0 and v > x then
+			set n = n + 1;
+		end if;
+	end while fetch_loop;
+	close cur;
+	return n;
+end`)
+	tk.MustQuery("select a, count_greater(a) from t order by a").Check(testkit.Rows("1 2", "2 1", "3 0"))
+	tk.MustExec("create function sum_b(x int) returns varchar(10) begin return (select b from t where a = x); end")
+	tk.MustQuery("select sum_b(2), add1(count_greater(0))").Check(testkit.Rows("b 4"))
+
+	// the function modifies the tables in the transaction of the calling statement
+	tk.MustExec("create table log (id int primary key auto_increment, v int)")
+	tk.MustExec("create function log_it(v int) returns int modifies sql data begin insert into log (v) values (v); return v; end")
+	tk.MustExec("begin")
+	tk.MustQuery("select log_it(a) from t order by a").Check(testkit.Rows("1", "2", "3"))
+	tk.MustQuery("select v from log order by id").Check(testkit.Rows("1", "2", "3"))
+	tk.MustExec("rollback")
+	tk.MustQuery("select count(*) from log").Check(testkit.Rows("0"))
+	tk.MustExec("update t set b = log_it(a) where a = 2")
+	tk.MustQuery("select b from t where a = 2").Check(testkit.Rows("2"))
+	tk.MustQuery("select log_it(5)").Check(testkit.Rows("5"))
+	tk.MustQuery("select v from log order by id").Check(testkit.Rows("2", "5"))
+
+	// errors
+	tk.MustExec("create function no_return(a int) returns int begin if a > 0 then return a; end if; end")
+	tk.MustQuery("select no_return(1)").Check(testkit.Rows("1"))
+	require.ErrorContains(t, tk.QueryToErr("select no_return(0)"), "FUNCTION test.no_return ended without RETURN")
+	tk.MustExec("create function fact(n int) returns int begin if n <= 1 then return 1; end if; return n * fact(n - 1); end")
+	require.ErrorContains(t, tk.QueryToErr("select fact(3)"), "Recursive stored functions and triggers are not allowed")
+	tk.MustGetErrCode("create table t2 (a int, b int as (add1(a)))", errno.ErrGeneratedColumnFunctionIsNotAllowed)
+	tk.MustGetErrCode("create table t2 (a int, b int as (test.abs(a)))", errno.ErrGeneratedColumnFunctionIsNotAllowed)
+
+	// the statements in the function are executed in the database of the function
+	tk.MustExec("create database test2")
+	tk.MustExec("create table test2.t (a int primary key, b varchar(10))")
+	tk.MustExec("insert into test2.t values (1, 'x')")
+	tk.MustExec("create function test2.get_b(x int) returns varchar(10) return (select b from t where a = x)")
+	tk.MustQuery("select test2.get_b(1), sum_b(1)").Check(testkit.Rows("x a"))
+	tk.MustQuery("select database()").Check(testkit.Rows("test"))
+}
+
+func TestCallFunctionPrivilege(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create user 'u1'@'%'")
+	tk.MustExec("create database test2")
+	tk.MustExec("create table test2.t (a int)")
+	tk.MustExec("insert into test2.t values (1)")
+	tk.MustExec("create function test2.f1() returns int return 1")
+	tk.MustExec("create function test2.f2() returns int return (select a from t)")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustGetErrCode("create function test2.f3() returns int return 1", errno.ErrDBaccessDenied)
+	tk1.MustGetErrCode("drop function test2.f1", errno.ErrDBaccessDenied)
+	tk1.MustGetErrCode("select test2.f1()", errno.ErrProcaccessDenied)
+	tk1.MustQuery("show function status").Check(testkit.Rows())
+
+	tk.MustExec("grant execute on test2.* to 'u1'@'%'")
+	tk1.MustQuery("select test2.f1()").Check(testkit.Rows("1"))
+	tk1.MustQuery("show function status").CheckAt([]int{0, 1}, testkit.Rows("test2 f1", "test2 f2"))
+	// the statements in the functions are executed with the privileges of the invoker
+	require.ErrorContains(t, tk1.QueryToErr("select test2.f2()"), "SELECT command denied to user 'u1'@'%' for table 't'")
+	tk.MustExec("grant select on test2.t to 'u1'@'%'")
+	tk1.MustQuery("select test2.f2()").Check(testkit.Rows("1"))
+}
+
+func closeResultSets(t *testing.T, results []sqlexec.RecordSet) {
+	for _, rs := range results {
+		require.NoError(t, rs.Close())
+	}
+}
+
+func callProcedure(tk *testkit.TestKit, sql string) ([]sqlexec.RecordSet, error) {
+	stmts, err := tk.Session().Parse(context.Background(), sql)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tk.Session().ExecuteStmt(context.Background(), stmts[0])
+	results := executor.TakeCallResults(tk.Session())
+	if results == nil {
+		return nil, err
+	}
+	return results.ResultSets, err
+}
@@ -0,0 +1,209 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simpletest
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDropTrigger(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("create table t1 (a int)")
+
+	tk.MustExec("create trigger tr1 after insert on t for each row insert into t1 values (new.a)")
+	tk.MustExec("create trigger tr2 after insert on t for each row insert into t1 values (new.b)")
+	tk.MustExec("create trigger tr3 after insert on t for each row precedes tr1 insert into t1 values (0)")
+	tk.MustExec("create trigger test.tr4 before delete on t for each row insert into t1 values (old.a)")
+	tk.MustQuery("show triggers").CheckAt([]int{0, 1, 2, 4, 7, 8}, testkit.Rows(
+		"tr3 INSERT t AFTER root@% utf8mb4",
+		"tr1 INSERT t AFTER root@% utf8mb4",
+		"tr2 INSERT t AFTER root@% utf8mb4",
+		"tr4 DELETE t BEFORE root@% utf8mb4"))
+	tk.MustQuery("show triggers like 't1'").Check(testkit.Rows())
+	tk.MustQuery("select trigger_name, action_order, action_statement from information_schema.triggers where event_manipulation = 'INSERT'").Check(testkit.Rows(
+		"tr3 1 insert into t1 values (0)",
+		"tr1 2 insert into t1 values (new.a)",
+		"tr2 3 insert into t1 values (new.b)"))
+
+	// the names of triggers are unique in the schema
+	tk.MustGetErrCode("create trigger tr1 after insert on t1 for each row set @a = 1", errno.ErrTrgAlreadyExists)
+	tk.MustExec("create trigger if not exists tr1 after insert on t1 for each row set @a = 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1359 Trigger already exists"))
+	tk.MustGetErrCode("create trigger tr5 after update on t for each row follows tr4 set @a = 1", errno.ErrReferencedTrgDoesNotExist)
+	tk.MustGetErrCode("create trigger test.tr5 after insert on mysql.user for each row set @a = 1", errno.ErrTrgInWrongSchema)
+	tk.MustGetErrCode("create trigger tr5 after insert on t2 for each row set @a = 1", errno.ErrNoSuchTable)
+	tk.MustExec("create view v as select * from t")
+	tk.MustGetErrCode("create trigger tr5 after insert on v for each row set @a = 1", errno.ErrTrgOnViewOrTempTable)
+
+	// errors in the body are reported when the trigger is created
+	tk.MustGetErrCode("create trigger tr5 before insert on t for each row set new.c = 1", errno.ErrBadField)
+	tk.MustGetErrCode("create trigger tr5 after insert on t for each row set new.a = 1", errno.ErrTrgCantChangeRow)
+	tk.MustGetErrCode("create trigger tr5 after insert on t for each row select 1", errno.ErrSpNoRetset)
+
+	// drop
+	tk.MustGetErrCode("drop trigger tr5", errno.ErrTrgDoesNotExist)
+	tk.MustExec("drop trigger if exists tr5")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1360 Trigger does not exist"))
+	tk.MustExec("drop trigger tr1")
+	tk.MustExec("drop trigger test.TR2")
+	tk.MustQuery("show triggers").CheckAt([]int{0}, testkit.Rows("tr3", "tr4"))
+
+	// triggers are dropped with the table
+	tk.MustExec("drop table t")
+	tk.MustQuery("show triggers").Check(testkit.Rows())
+	tk.MustQuery("select * from information_schema.triggers").Check(testkit.Rows())
+}
+
+func TestTriggerExecution(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int not null, b varchar(10))")
+	tk.MustExec("create table log (id int, event varchar(10), old_a int, new_a int)")
+
+	// BEFORE triggers can change the NEW row
+	tk.MustExec(`create trigger t_bi before insert on t for each row
+begin
+	if new.a < 0 then
+		set new.a = 0;
+	end if;
+	set new.b = concat('v', new.a);
+end`)
+	tk.MustExec("create trigger t_ai after insert on t for each row insert into log values (new.id, 'insert', null, new.a)")
+	tk.MustExec("create trigger t_bu before update on t for each row set new.b = concat(old.b, '->', new.a)")
+	tk.MustExec("create trigger t_au after update on t for each row insert into log values (new.id, 'update', old.a, new.a)")
+	tk.MustExec("create trigger t_ad after delete on t for each row insert into log values (old.id, 'delete', old.a, null)")
+
+	tk.MustExec("insert into t (id, a) values (1, 1), (2, -2)")
+	require.Equal(t, uint64(2), tk.Session().AffectedRows())
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1 v1", "2 0 v0"))
+	tk.MustExec("update t set a = a + 10")
+	require.Equal(t, uint64(2), tk.Session().AffectedRows())
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 11 v1->11", "2 10 v0->10"))
+	tk.MustExec("insert into t (id, a) values (1, 5) on duplicate key update a = 20")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 20 v1->11->20", "2 10 v0->10"))
+	tk.MustExec("delete from t where id = 2")
+	tk.MustExec("replace into t (id, a) values (1, 30)")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 30 v30"))
+	tk.MustQuery("select id, event, old_a, new_a from log").Check(testkit.Rows(
+		"1 insert <nil> 1",
+		"2 insert <nil> 0",
+		"1 update 1 11",
+		"2 update 0 10",
+		"1 update 11 20",
+		"2 delete 10 <nil>",
+		"1 delete 20 <nil>",
+		"1 insert <nil> 30"))
+
+	// NOT NULL constraints are checked after the BEFORE triggers
+	tk.MustExec("create trigger t_bi2 before insert on t for each row follows t_bi set new.a = null")
+	tk.MustGetErrCode("insert into t (id, a) values (3, 3)", errno.ErrBadNull)
+	tk.MustExec("drop trigger t_bi2")
+
+	// the statements in triggers can't modify the tables whose triggers are being fired
+	tk.MustExec("create trigger log_ai after insert on log for each row insert into t (id, a) values (new.id + 100, 0)")
+	tk.MustGetErrCode("insert into t (id, a) values (4, 4)", errno.ErrCantUpdateUsedTableInSfOrTrg)
+	tk.MustQuery("select count(*) from t where id = 4").Check(testkit.Rows("0"))
+	tk.MustExec("drop trigger log_ai")
+}
+
+func TestBeforeInsertTriggerPerRow(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, n int, b int)")
+	tk.MustExec("create table log (id int, n int)")
+	// the BEFORE trigger of a row sees the rows written before it
+	tk.MustExec(`create trigger t_bi before insert on t for each row
+begin
+	set new.n = (select count(*) from t);
+	set new.b = (select count(*) from log);
+end`)
+	tk.MustExec("create trigger t_ai after insert on t for each row insert into log values (new.id, new.n)")
+
+	tk.MustExec("insert into t (id) values (1), (2), (3)")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 0 0", "2 1 1", "3 2 2"))
+	tk.MustExec("insert into t (id) select id + 10 from t")
+	tk.MustQuery("select * from t where id > 10").Check(testkit.Rows("11 3 3", "12 4 4", "13 5 5"))
+	tk.MustExec("replace into t (id) values (1), (4)")
+	tk.MustQuery("select * from t where id < 10").Check(testkit.Rows("1 6 6", "2 1 1", "3 2 2", "4 6 7"))
+	tk.MustExec("insert into t (id) select id from t where id < 3 on duplicate key update b = -1")
+	tk.MustQuery("select * from t where id < 3").Check(testkit.Rows("1 6 -1", "2 1 -1"))
+	tk.MustExec("insert ignore into t (id) values (5), (5), (6)")
+	tk.MustQuery("select * from t where id in (5, 6)").Check(testkit.Rows("5 7 8", "6 8 9"))
+	tk.MustQuery("select count(*) from log").Check(testkit.Rows("10"))
+
+	// the keys of a row are checked after the trigger changes it
+	tk.MustExec("create table t1 (id int primary key)")
+	tk.MustExec("create trigger t1_bi before insert on t1 for each row set new.id = (select count(*) from t1)")
+	tk.MustExec("insert into t1 values (100), (100), (100)")
+	tk.MustQuery("select * from t1").Check(testkit.Rows("0", "1", "2"))
+}
+
+func TestTriggerInTransaction(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key)")
+	tk.MustExec("create table t1 (a int primary key)")
+	tk.MustExec("create table cnt (n int)")
+	tk.MustExec("insert into cnt values (0)")
+	tk.MustExec(`create trigger tr after insert on t for each row
+begin
+	declare c int;
+	set c = (select n from cnt);
+	update cnt set n = c + 1;
+	insert into t1 values (new.a);
+end`)
+
+	// the changes made by triggers are rolled back along with the triggering statement
+	tk.MustExec("insert into t1 values (3)")
+	tk.MustGetErrCode("insert into t values (1), (2), (3)", errno.ErrDupEntry)
+	tk.MustQuery("select * from t").Check(testkit.Rows())
+	tk.MustQuery("select * from t1").Check(testkit.Rows("3"))
+	tk.MustQuery("select * from cnt").Check(testkit.Rows("0"))
+
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (1), (2)")
+	tk.MustQuery("select * from cnt").Check(testkit.Rows("2"))
+	tk.MustExec("rollback")
+	tk.MustQuery("select * from t1").Check(testkit.Rows("3"))
+	tk.MustQuery("select * from cnt").Check(testkit.Rows("0"))
+
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (1), (2)")
+	tk.MustExec("commit")
+	tk.MustQuery("select * from t1").Check(testkit.Rows("1", "2", "3"))
+	tk.MustQuery("select * from cnt").Check(testkit.Rows("2"))
+
+	// the triggers are not activated by the foreign key cascades
+	tk.MustExec("create table parent (id int primary key)")
+	tk.MustExec("create table child (id int, foreign key (id) references parent (id) on delete cascade)")
+	tk.MustExec("create trigger child_ad after delete on child for each row update cnt set n = n + 100")
+	tk.MustExec("insert into parent values (1)")
+	tk.MustExec("insert into child values (1)")
+	tk.MustExec("delete from parent")
+	tk.MustQuery("select * from child").Check(testkit.Rows())
+	tk.MustQuery("select * from cnt").Check(testkit.Rows("2"))
+}
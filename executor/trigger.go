@@ -0,0 +1,338 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/privilege"
+	"github.com/pingcap/tidb/procedure"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+func (e *DDLExec) executeCreateTrigger(s *ast.CreateTriggerStmt) error {
+	schema, ok := e.is.SchemaByName(s.Table.Schema)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(s.Table.Schema.O)
+	}
+	tbl, err := e.is.TableByName(s.Table.Schema, s.Table.Name)
+	if err != nil {
+		return err
+	}
+
+	// Compile the trigger to report the errors in the body when it's created.
+	if _, err = procedure.CompileTrigger(s, tbl.Meta().Cols()); err != nil {
+		return err
+	}
+
+	sessVars := e.Ctx().GetSessionVars()
+	info := &model.TriggerInfo{
+		Name:              s.TriggerName.Name,
+		Timing:            s.Timing,
+		Event:             s.Event,
+		Body:              s.Body.Text(),
+		CollationDatabase: schema.Collate,
+		Created:           time.Now(),
+	}
+	if definer := s.Definer; definer != nil {
+		info.Definer = &auth.UserIdentity{Username: definer.Username, Hostname: definer.Hostname}
+		if definer.AuthUsername != "" || definer.AuthHostname != "" {
+			info.Definer = &auth.UserIdentity{Username: definer.AuthUsername, Hostname: definer.AuthHostname}
+		}
+	}
+	info.SQLMode, _ = sessVars.GetSystemVar(variable.SQLModeVar)
+	info.CharsetClient, _ = sessVars.GetSystemVar(variable.CharacterSetClient)
+	info.CollationConnection, _ = sessVars.GetSystemVar(variable.CollationConnection)
+	return domain.GetDomain(e.Ctx()).DDL().CreateTrigger(e.Ctx(), s, info)
+}
+
+func (e *DDLExec) executeDropTrigger(s *ast.DropTriggerStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().DropTrigger(e.Ctx(), s)
+}
+
+// visibleTrigger is a trigger on which the current user has the TRIGGER privilege.
+type visibleTrigger struct {
+	schema *model.DBInfo
+	table  *model.TableInfo
+	info   *model.TriggerInfo
+	// order is the ordinal position of the trigger among the triggers with the same timing and event.
+	order int
+}
+
+// listVisibleTriggers returns the triggers of the tables in the schemas on which the current user has the TRIGGER
+// privilege.
+func listVisibleTriggers(sctx sessionctx.Context, schemas []*model.DBInfo) []visibleTrigger {
+	checker := privilege.GetPrivilegeManager(sctx)
+	activeRoles := sctx.GetSessionVars().ActiveRoles
+	var triggers []visibleTrigger
+	for _, schema := range schemas {
+		for _, tbl := range schema.Tables {
+			if len(tbl.Triggers) == 0 {
+				continue
+			}
+			if checker != nil && !checker.RequestVerification(activeRoles, schema.Name.L, tbl.Name.L, "", mysql.TriggerPriv) {
+				continue
+			}
+			for i, info := range tbl.Triggers {
+				order := 1
+				for _, prev := range tbl.Triggers[:i] {
+					if prev.Timing == info.Timing && prev.Event == info.Event {
+						order++
+					}
+				}
+				triggers = append(triggers, visibleTrigger{schema: schema, table: tbl, info: info, order: order})
+			}
+		}
+	}
+	return triggers
+}
+
+func triggerDefiner(info *model.TriggerInfo) string {
+	if info.Definer == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s@%s", info.Definer.Username, info.Definer.Hostname)
+}
+
+func (e *ShowExec) fetchShowTriggers() error {
+	schema, ok := e.is.SchemaByName(e.DBName)
+	if !ok {
+		return exeerrors.ErrBadDB.GenWithStackByArgs(e.DBName.O)
+	}
+
+	loc := e.Ctx().GetSessionVars().Location()
+	for _, trigger := range listVisibleTriggers(e.Ctx(), []*model.DBInfo{schema}) {
+		e.appendRow([]interface{}{
+			trigger.info.Name.O,
+			trigger.info.Event.String(),
+			trigger.table.Name.O,
+			trigger.info.Body,
+			trigger.info.Timing.String(),
+			eventTime(&trigger.info.Created, loc),
+			trigger.info.SQLMode,
+			triggerDefiner(trigger.info),
+			trigger.info.CharsetClient,
+			trigger.info.CollationConnection,
+			trigger.info.CollationDatabase,
+		})
+	}
+	return nil
+}
+
+func (e *memtableRetriever) setDataFromTriggers(sctx sessionctx.Context, schemas []*model.DBInfo) {
+	loc := sctx.GetSessionVars().Location()
+	triggers := listVisibleTriggers(sctx, schemas)
+	rows := make([][]types.Datum, 0, len(triggers))
+	for _, trigger := range triggers {
+		record := types.MakeDatums(
+			infoschema.CatalogVal,                 // TRIGGER_CATALOG
+			trigger.schema.Name.O,                 // TRIGGER_SCHEMA
+			trigger.info.Name.O,                   // TRIGGER_NAME
+			trigger.info.Event.String(),           // EVENT_MANIPULATION
+			infoschema.CatalogVal,                 // EVENT_OBJECT_CATALOG
+			trigger.schema.Name.O,                 // EVENT_OBJECT_SCHEMA
+			trigger.table.Name.O,                  // EVENT_OBJECT_TABLE
+			trigger.order,                         // ACTION_ORDER
+			nil,                                   // ACTION_CONDITION
+			trigger.info.Body,                     // ACTION_STATEMENT
+			"ROW",                                 // ACTION_ORIENTATION
+			trigger.info.Timing.String(),          // ACTION_TIMING
+			nil,                                   // ACTION_REFERENCE_OLD_TABLE
+			nil,                                   // ACTION_REFERENCE_NEW_TABLE
+			"OLD",                                 // ACTION_REFERENCE_OLD_ROW
+			"NEW",                                 // ACTION_REFERENCE_NEW_ROW
+			eventTime(&trigger.info.Created, loc), // CREATED
+			trigger.info.SQLMode,                  // SQL_MODE
+			triggerDefiner(trigger.info),          // DEFINER
+			trigger.info.CharsetClient,            // CHARACTER_SET_CLIENT
+			trigger.info.CollationConnection,      // COLLATION_CONNECTION
+			trigger.info.CollationDatabase,        // DATABASE_COLLATION
+		)
+		rows = append(rows, record)
+	}
+	e.rows = rows
+}
+
+// triggerExec fires the triggers of a table for the rows modified by a write executor. The statements in the
+// triggers are executed in the transaction and the statement context of the triggering statement, so the changes
+// made by them are committed or rolled back along with the triggering statement. The changes are also recorded in
+// the logs of the materialized views on the table after the rows are modified.
+type triggerExec struct {
+	se     *routineSession
+	schema model.CIStr
+	// triggers are indexed by the timing and the event of the triggers.
+	triggers [2][3][]*procedure.Trigger
+	mvLogs   []*mvLogWriter
+}
+
+// buildTriggerExec loads the triggers of a table modified by a write executor, it returns nil if the table has
+// neither triggers nor materialized view logs. The tables whose triggers are being fired can't be modified by the
+// statements in the triggers.
+func (b *executorBuilder) buildTriggerExec(tbl table.Table) (*triggerExec, error) {
+	tbInfo := tbl.Meta()
+	if slices.Contains(b.triggerTables, tbInfo.ID) {
+		return nil, exeerrors.ErrCantUpdateUsedTableInSfOrTrg.GenWithStackByArgs(tbInfo.Name.O)
+	}
+	mvLogs, err := b.buildMVLogWriters(tbInfo)
+	if err != nil {
+		return nil, err
+	}
+	// Like MySQL, the triggers are not activated by the foreign key cascades, but the changes made by the cascades
+	// are still recorded in the materialized view logs.
+	inFKCascade := b.ctx.GetSessionVars().StmtCtx.InHandleForeignKeyTrigger
+	if (len(tbInfo.Triggers) == 0 || inFKCascade) && len(mvLogs) == 0 {
+		return nil, nil
+	}
+	schema, ok := b.is.SchemaByTable(tbInfo)
+	if !ok {
+		return nil, errors.Errorf("can not find the schema of table %s", tbInfo.Name.O)
+	}
+
+	e := &triggerExec{
+		se: &routineSession{
+			sctx:      b.ctx,
+			is:        b.is,
+			tables:    append(slices.Clone(b.triggerTables), tbInfo.ID),
+			isTrigger: true,
+		},
+		schema: schema.Name,
+		mvLogs: mvLogs,
+	}
+	if inFKCascade {
+		return e, nil
+	}
+	for _, info := range tbInfo.Triggers {
+		trigger, err := procedure.LoadTrigger(schema.Name, tbInfo, info)
+		if err != nil {
+			return nil, err
+		}
+		e.triggers[info.Timing][info.Event] = append(e.triggers[info.Timing][info.Event], trigger)
+	}
+	return e, nil
+}
+
+func (b *executorBuilder) buildTblID2TriggerExec(tblID2Table map[int64]table.Table) (map[int64]*triggerExec, error) {
+	var tblID2TriggerExec map[int64]*triggerExec
+	for tid, tbl := range tblID2Table {
+		e, err := b.buildTriggerExec(tbl)
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			continue
+		}
+		if tblID2TriggerExec == nil {
+			tblID2TriggerExec = make(map[int64]*triggerExec)
+		}
+		tblID2TriggerExec[tid] = e
+	}
+	return tblID2TriggerExec, nil
+}
+
+// fire fires the triggers with the timing and the event for a row, `oldRow` and `newRow` are nil if there's no such
+// row for the event. The statements in the triggers are executed with the schema of the table as the current
+// database.
+func (e *triggerExec) fire(ctx context.Context, timing model.TriggerTiming, event model.TriggerEvent, oldRow, newRow []types.Datum) error {
+	if e == nil {
+		return nil
+	}
+	if timing == model.TriggerTimingAfter {
+		for _, w := range e.mvLogs {
+			if err := w.write(ctx, e.se.sctx, oldRow, newRow); err != nil {
+				return err
+			}
+		}
+	}
+	if len(e.triggers[timing][event]) == 0 {
+		return nil
+	}
+	sessVars := e.se.sctx.GetSessionVars()
+	sc := sessVars.StmtCtx
+	inHandleRoutine, originDB := sc.InHandleRoutine, sessVars.CurrentDB
+	sc.InHandleRoutine, sessVars.CurrentDB = true, e.schema.O
+	defer func() {
+		sc.InHandleRoutine, sessVars.CurrentDB = inHandleRoutine, originDB
+	}()
+
+	for _, trigger := range e.triggers[timing][event] {
+		if err := trigger.Fire(ctx, e.se, oldRow, newRow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasTriggers returns whether there are triggers with the timing and the event.
+func (e *triggerExec) hasTriggers(timing model.TriggerTiming, event model.TriggerEvent) bool {
+	return e != nil && len(e.triggers[timing][event]) > 0
+}
+
+// execInsertWithTriggers writes the rows by the insert executor. If there are `BEFORE INSERT` triggers, the rows are
+// written one by one right after the triggers are fired for them, like MySQL, so the triggers of a row can see the rows
+// written before it, and the keys of the row are checked after the NEW row is changed by the triggers.
+func execInsertWithTriggers(ctx context.Context, base insertCommon, rows [][]types.Datum) error {
+	e := base.insertCommon()
+	if !e.triggers.hasTriggers(model.TriggerTimingBefore, model.TriggerEventInsert) {
+		return base.exec(ctx, rows)
+	}
+	// The statements in the triggers may reset the extra columns of the batch, which are used by
+	// `INSERT ... SELECT ... ON DUPLICATE KEY UPDATE`.
+	sessVars := e.Ctx().GetSessionVars()
+	extraCols := sessVars.CurrInsertBatchExtraCols
+	defer func() {
+		sessVars.CurrInsertBatchExtraCols = extraCols
+	}()
+	for i := range rows {
+		if err := e.fireBeforeInsertTriggers(ctx, rows[i]); err != nil {
+			return err
+		}
+		if len(extraCols) > 0 {
+			sessVars.CurrInsertBatchExtraCols = extraCols[i : i+1]
+		}
+		if err := base.exec(ctx, rows[i:i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fireBeforeInsertTriggers fires the `BEFORE INSERT` triggers for a row to be inserted, the values assigned to the NEW
+// row are checked against the NOT NULL constraints again.
+func (e *InsertValues) fireBeforeInsertTriggers(ctx context.Context, row []types.Datum) error {
+	if err := e.triggers.fire(ctx, model.TriggerTimingBefore, model.TriggerEventInsert, nil, row); err != nil {
+		return err
+	}
+	sc := e.Ctx().GetSessionVars().StmtCtx
+	for i, col := range e.Table.Cols() {
+		if err := col.HandleBadNull(&row[i], sc, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
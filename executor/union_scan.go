@@ -108,6 +108,9 @@ func (us *UnionScanExec) open(ctx context.Context) error {
 
 	us.memBuf = mb
 	us.memBufSnap = mb.SnapshotGetter()
+	if us.Ctx().GetSessionVars().StmtCtx.InHandleRoutine {
+		us.memBufSnap = mb
+	}
 
 	// 1. select without virtual columns
 	// 2. build virtual columns and select with virtual columns
@@ -122,6 +125,10 @@ func (us *UnionScanExec) open(ctx context.Context) error {
 		us.addedRowsIter, err = buildMemIndexMergeReader(ctx, us, x).getMemRowsIter(ctx)
 	case *MPPGather:
 		us.addedRowsIter, err = buildMemTableReader(ctx, us, x.kvRanges).getMemRowsIter(ctx)
+	case *FullTextIndexReaderExec:
+		us.addedRowsIter, err = buildMemTableReader(ctx, us, x.memTableRanges()).getMemRowsIter(ctx)
+	case *VectorIndexReaderExec:
+		us.addedRowsIter, err = buildMemTableReader(ctx, us, x.memTableRanges()).getMemRowsIter(ctx)
 	default:
 		err = fmt.Errorf("unexpected union scan children:%T", reader)
 	}
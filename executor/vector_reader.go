@@ -0,0 +1,105 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/executor/internal/exec"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/mathutil"
+	"github.com/pingcap/tidb/util/tracing"
+	"github.com/pingcap/tidb/util/vectorindex"
+)
+
+var _ exec.Executor = &VectorIndexReaderExec{}
+
+// VectorIndexReaderExec reads the approximate nearest rows of a vector. It searches the HNSW graph
+// of the vector index for the handles of the nearest rows, and then reads the rows by the handles.
+// The rows are sorted by the exact distances and limited by the TopN above it.
+type VectorIndexReaderExec struct {
+	exec.BaseExecutor
+
+	table    table.Table
+	tblInfo  *model.TableInfo
+	tableID  int64
+	index    *model.IndexInfo
+	distance *expression.VectorDistance
+	topK     uint64
+	// columns are only required by union scan.
+	columns  []*model.ColumnInfo
+	snapshot kv.Snapshot
+
+	dataReaderBuilder *dataReaderBuilder
+	tableReader       *TableReaderExecutor
+	reader            exec.Executor
+}
+
+// Open implements the Executor Open interface.
+func (e *VectorIndexReaderExec) Open(ctx context.Context) error {
+	defer tracing.StartRegion(ctx, "VectorIndexReaderExec.Open").End()
+	handles, err := e.readHandles(ctx)
+	if err != nil {
+		return err
+	}
+	e.reader, err = e.dataReaderBuilder.buildTableReaderFromHandles(ctx, e.tableReader, handles, true)
+	return err
+}
+
+func (e *VectorIndexReaderExec) readHandles(ctx context.Context) ([]kv.Handle, error) {
+	k := int(e.topK)
+	graph := vectorindex.NewGraph(e.snapshot, e.tblInfo, e.tableID, e.index)
+	results, err := graph.Search(ctx, e.distance.Query, k, mathutil.Max(k, vectorindex.DefaultEfSearch))
+	if err != nil {
+		return nil, err
+	}
+	handles := make([]kv.Handle, 0, len(results))
+	for _, r := range results {
+		handles = append(handles, r.Handle)
+	}
+	return handles, nil
+}
+
+// memTableRanges returns the record range of the table. The rows added in the transaction are not in
+// the graph read from the snapshot, so union scan reads all of them and the TopN picks the nearest.
+func (e *VectorIndexReaderExec) memTableRanges() []kv.KeyRange {
+	prefix := tablecodec.GenTableRecordPrefix(e.tableID)
+	return []kv.KeyRange{{StartKey: prefix, EndKey: prefix.PrefixNext()}}
+}
+
+// Next implements the Executor Next interface.
+func (e *VectorIndexReaderExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	return exec.Next(ctx, e.reader, req)
+}
+
+// Close implements the Executor Close interface.
+func (e *VectorIndexReaderExec) Close() error {
+	if e.reader == nil {
+		return nil
+	}
+	err := e.reader.Close()
+	e.reader = nil
+	return err
+}
+
+// Table implements the dataSourceExecutor interface.
+func (e *VectorIndexReaderExec) Table() table.Table {
+	return e.table
+}
@@ -25,8 +25,11 @@ import (
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/disk"
 	"github.com/pingcap/tidb/util/mathutil"
+	"github.com/pingcap/tidb/util/memory"
 )
 
 // WindowExec is the executor for window functions.
@@ -38,118 +41,119 @@ type WindowExec struct {
 	childResult *chunk.Chunk
 	// executed indicates the child executor is drained or something unexpected happened.
 	executed bool
-	// resultChunks stores the chunks to return
-	resultChunks []*chunk.Chunk
-	// remainingRowsInChunk indicates how many rows the resultChunks[i] is not prepared.
-	remainingRowsInChunk []int
+	// partition keeps the rows of the current partition, which may be spilled to disk.
+	partition *windowPartition
+	// numProducedRows is the number of rows in the partition whose results have been produced.
+	numProducedRows uint64
+	// childColIdxs are the indexes of the child columns in the output.
+	childColIdxs []int
 
 	numWindowFuncs int
 	processor      windowProcessor
+
+	memTracker  *memory.Tracker
+	diskTracker *disk.Tracker
+}
+
+// Open implements the Executor Open interface.
+func (e *WindowExec) Open(ctx context.Context) error {
+	if err := e.BaseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	e.executed = false
+	e.numProducedRows = 0
+	e.childColIdxs = windowChildColIdxs(e.Schema(), e.numWindowFuncs)
+	e.memTracker, e.diskTracker, e.partition = openWindowPartition(&e.BaseExecutor)
+	return nil
 }
 
 // Close implements the Executor Close interface.
 func (e *WindowExec) Close() error {
+	if e.partition != nil {
+		if err := e.partition.close(); err != nil {
+			return err
+		}
+		e.partition = nil
+	}
 	return errors.Trace(e.BaseExecutor.Close())
 }
 
 // Next implements the Executor Next interface.
 func (e *WindowExec) Next(ctx context.Context, chk *chunk.Chunk) error {
 	chk.Reset()
-	for !e.executed && !e.preparedChunkAvailable() {
-		err := e.consumeOneGroup(ctx)
-		if err != nil {
+	if err := e.partition.spillIfNeeded(); err != nil {
+		return err
+	}
+	for !chk.IsFull() {
+		if e.numProducedRows < e.partition.NumRows() {
+			if err := e.produce(chk); err != nil {
+				e.executed = true
+				return err
+			}
+			continue
+		}
+		if e.executed {
+			break
+		}
+		if err := e.consumeOneGroup(ctx); err != nil {
 			e.executed = true
 			return err
 		}
 	}
-	if len(e.resultChunks) > 0 {
-		chk.SwapColumns(e.resultChunks[0])
-		e.resultChunks[0] = nil // GC it. TODO: Reuse it.
-		e.resultChunks = e.resultChunks[1:]
-		e.remainingRowsInChunk = e.remainingRowsInChunk[1:]
-	}
 	return nil
 }
 
-func (e *WindowExec) preparedChunkAvailable() bool {
-	return len(e.resultChunks) > 0 && e.remainingRowsInChunk[0] == 0
-}
-
+// consumeOneGroup reads the rows of the next partition, and consumes them by the processor.
 func (e *WindowExec) consumeOneGroup(ctx context.Context) error {
-	var groupRows []chunk.Row
-	if e.groupChecker.IsExhausted() {
-		eof, err := e.fetchChild(ctx)
-		if err != nil {
-			return errors.Trace(err)
-		}
-		if eof {
-			e.executed = true
-			return e.consumeGroupRows(groupRows)
-		}
-		_, err = e.groupChecker.SplitIntoGroups(e.childResult)
-		if err != nil {
-			return errors.Trace(err)
-		}
-	}
-	begin, end := e.groupChecker.GetNextGroup()
-	for i := begin; i < end; i++ {
-		groupRows = append(groupRows, e.childResult.GetRow(i))
+	if err := e.partition.reset(e.partition.NumRows()); err != nil {
+		return err
 	}
-
-	for meetLastGroup := end == e.childResult.NumRows(); meetLastGroup; {
-		meetLastGroup = false
-		eof, err := e.fetchChild(ctx)
-		if err != nil {
-			return errors.Trace(err)
-		}
-		if eof {
-			e.executed = true
-			return e.consumeGroupRows(groupRows)
+	e.processor.resetPartialResult()
+	e.numProducedRows = 0
+	for {
+		if e.groupChecker.IsExhausted() {
+			eof, err := e.fetchChild(ctx)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if eof {
+				e.executed = true
+				break
+			}
+			isFirstGroupSameAsPrev, err := e.groupChecker.SplitIntoGroups(e.childResult)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if !isFirstGroupSameAsPrev && e.partition.NumRows() > 0 {
+				break
+			}
 		}
-
-		isFirstGroupSameAsPrev, err := e.groupChecker.SplitIntoGroups(e.childResult)
-		if err != nil {
-			return errors.Trace(err)
+		begin, end := e.groupChecker.GetNextGroup()
+		if err := e.partition.appendRows(e.childResult, begin, end); err != nil {
+			return err
 		}
-
-		if isFirstGroupSameAsPrev {
-			begin, end = e.groupChecker.GetNextGroup()
-			for i := begin; i < end; i++ {
-				groupRows = append(groupRows, e.childResult.GetRow(i))
-			}
-			meetLastGroup = end == e.childResult.NumRows()
+		if end < e.childResult.NumRows() {
+			break
 		}
 	}
-	return e.consumeGroupRows(groupRows)
-}
-
-func (e *WindowExec) consumeGroupRows(groupRows []chunk.Row) (err error) {
-	remainingRowsInGroup := len(groupRows)
-	if remainingRowsInGroup == 0 {
+	if e.partition.NumRows() == 0 {
 		return nil
 	}
-	for i := 0; i < len(e.resultChunks); i++ {
-		remained := mathutil.Min(e.remainingRowsInChunk[i], remainingRowsInGroup)
-		e.remainingRowsInChunk[i] -= remained
-		remainingRowsInGroup -= remained
-
-		// TODO: Combine these three methods.
-		// The old implementation needs the processor has these three methods
-		// but now it does not have to.
-		groupRows, err = e.processor.consumeGroupRows(e.Ctx(), groupRows)
-		if err != nil {
-			return errors.Trace(err)
-		}
-		_, err = e.processor.appendResult2Chunk(e.Ctx(), groupRows, e.resultChunks[i], remained)
+	return e.processor.consumeGroupRows(e.Ctx(), e.partition)
+}
+
+// produce appends the rows of the partition and their window function results to chk.
+func (e *WindowExec) produce(chk *chunk.Chunk) error {
+	remained := mathutil.Min(uint64(chk.RequiredRows()-chk.NumRows()), e.partition.NumRows()-e.numProducedRows)
+	for i := uint64(0); i < remained; i++ {
+		row, err := e.partition.GetRow(e.numProducedRows + i)
 		if err != nil {
-			return errors.Trace(err)
-		}
-		if remainingRowsInGroup == 0 {
-			e.processor.resetPartialResult()
-			break
+			return err
 		}
+		chk.AppendPartialRowByColIdxs(0, row, e.childColIdxs)
 	}
-	return nil
+	e.numProducedRows += remained
+	return e.processor.appendResult2Chunk(e.Ctx(), e.partition, chk, int(remained))
 }
 
 func (e *WindowExec) fetchChild(ctx context.Context) (eof bool, err error) {
@@ -159,41 +163,44 @@ func (e *WindowExec) fetchChild(ctx context.Context) (eof bool, err error) {
 		return false, errors.Trace(err)
 	}
 	// No more data.
-	numRows := childResult.NumRows()
-	if numRows == 0 {
+	if childResult.NumRows() == 0 {
 		return true, nil
 	}
-
-	resultChk := e.Ctx().GetSessionVars().GetNewChunkWithCapacity(e.RetFieldTypes(), 0, numRows, e.AllocPool)
-	err = e.copyChk(childResult, resultChk)
-	if err != nil {
-		return false, err
-	}
-	e.resultChunks = append(e.resultChunks, resultChk)
-	e.remainingRowsInChunk = append(e.remainingRowsInChunk, numRows)
-
 	e.childResult = childResult
 	return false, nil
 }
 
-func (e *WindowExec) copyChk(src, dst *chunk.Chunk) error {
-	columns := e.Schema().Columns[:len(e.Schema().Columns)-e.numWindowFuncs]
-	for i, col := range columns {
-		if err := dst.MakeRefTo(i, src, col.Index); err != nil {
-			return err
-		}
+// windowChildColIdxs returns the indexes of the child columns in the output of the window executor.
+func windowChildColIdxs(schema *expression.Schema, numWindowFuncs int) []int {
+	columns := schema.Columns[:len(schema.Columns)-numWindowFuncs]
+	colIdxs := make([]int, 0, len(columns))
+	for _, col := range columns {
+		colIdxs = append(colIdxs, col.Index)
 	}
-	return nil
+	return colIdxs
+}
+
+// openWindowPartition creates the trackers and the partition for the window executor, and registers the spill action
+// if spilling to disk is enabled.
+func openWindowPartition(e *exec.BaseExecutor) (*memory.Tracker, *disk.Tracker, *windowPartition) {
+	memTracker := memory.NewTracker(e.ID(), -1)
+	memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
+	diskTracker := disk.NewTracker(e.ID(), -1)
+	diskTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.DiskTracker)
+	partition := newWindowPartition(exec.RetTypes(e.Children(0)), e.MaxChunkSize(), memTracker, diskTracker)
+	if variable.EnableTmpStorageOnOOM.Load() {
+		e.Ctx().GetSessionVars().MemTracker.FallbackOldAndSetNewAction(&windowSpillDiskAction{p: partition})
+	}
+	return memTracker, diskTracker, partition
 }
 
 // windowProcessor is the interface for processing different kinds of windows.
 type windowProcessor interface {
-	// consumeGroupRows updates the result for an window function using the input rows
-	// which belong to the same partition.
-	consumeGroupRows(ctx sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error)
-	// appendResult2Chunk appends the final results to chunk.
+	// consumeGroupRows updates the result for an window function using the rows of the partition.
+	consumeGroupRows(ctx sessionctx.Context, rows *windowPartition) error
+	// appendResult2Chunk appends the final results of the next `remained` rows of the partition to chunk.
 	// It is called when there are no more rows in current partition.
-	appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error)
+	appendResult2Chunk(ctx sessionctx.Context, rows *windowPartition, chk *chunk.Chunk, remained int) error
 	// resetPartialResult resets the partial result to the original state for a specific window function.
 	resetPartialResult()
 }
@@ -203,30 +210,32 @@ type aggWindowProcessor struct {
 	partialResults []aggfuncs.PartialResult
 }
 
-func (p *aggWindowProcessor) consumeGroupRows(ctx sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error) {
+func (p *aggWindowProcessor) consumeGroupRows(ctx sessionctx.Context, rows *windowPartition) error {
 	for i, windowFunc := range p.windowFuncs {
+		if partitionRowsWindowFunc, ok := windowFunc.(aggfuncs.PartitionRowsWindowFunc); ok {
+			partitionRowsWindowFunc.SetPartitionRows(rows, p.partialResults[i])
+			continue
+		}
 		// @todo Add memory trace
-		_, err := windowFunc.UpdatePartialResult(ctx, rows, p.partialResults[i])
-		if err != nil {
-			return nil, err
+		if err := rows.updatePartialResult(ctx, windowFunc, p.partialResults[i], 0, rows.NumRows()); err != nil {
+			return err
 		}
 	}
-	rows = rows[:0]
-	return rows, nil
+	return nil
 }
 
-func (p *aggWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error) {
+func (p *aggWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, _ *windowPartition, chk *chunk.Chunk, remained int) error {
 	for remained > 0 {
 		for i, windowFunc := range p.windowFuncs {
 			// TODO: We can extend the agg func interface to avoid the `for` loop  here.
 			err := windowFunc.AppendFinalResult2Chunk(ctx, p.partialResults[i], chk)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 		remained--
 	}
-	return rows, nil
+	return nil
 }
 
 func (p *aggWindowProcessor) resetPartialResult() {
@@ -289,12 +298,12 @@ func (p *rowFrameWindowProcessor) getEndOffset(numRows uint64) uint64 {
 	return 0
 }
 
-func (*rowFrameWindowProcessor) consumeGroupRows(_ sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error) {
-	return rows, nil
+func (*rowFrameWindowProcessor) consumeGroupRows(sessionctx.Context, *windowPartition) error {
+	return nil
 }
 
-func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error) {
-	numRows := uint64(len(rows))
+func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows *windowPartition, chk *chunk.Chunk, remained int) error {
+	numRows := rows.NumRows()
 	var (
 		err                      error
 		initializedSlidingWindow bool
@@ -322,16 +331,14 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 			for i, windowFunc := range p.windowFuncs {
 				slidingWindowAggFunc := slidingWindowAggFuncs[i]
 				if slidingWindowAggFunc != nil && initializedSlidingWindow {
-					err = slidingWindowAggFunc.Slide(ctx, func(u uint64) chunk.Row {
-						return rows[u]
-					}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+					err = rows.slide(ctx, slidingWindowAggFunc, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
 					if err != nil {
-						return nil, err
+						return err
 					}
 				}
 				err = windowFunc.AppendFinalResult2Chunk(ctx, p.partialResults[i], chk)
 				if err != nil {
-					return nil, err
+					return err
 				}
 			}
 			continue
@@ -340,24 +347,16 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 		for i, windowFunc := range p.windowFuncs {
 			slidingWindowAggFunc := slidingWindowAggFuncs[i]
 			if slidingWindowAggFunc != nil && initializedSlidingWindow {
-				err = slidingWindowAggFunc.Slide(ctx, func(u uint64) chunk.Row {
-					return rows[u]
-				}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+				err = rows.slide(ctx, slidingWindowAggFunc, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
 			} else {
-				// For MinMaxSlidingWindowAggFuncs, it needs the absolute value of each start of window, to compare
-				// whether elements inside deque are out of current window.
-				if minMaxSlidingWindowAggFunc, ok := windowFunc.(aggfuncs.MaxMinSlidingWindowAggFunc); ok {
-					// Store start inside MaxMinSlidingWindowAggFunc.windowInfo
-					minMaxSlidingWindowAggFunc.SetWindowStart(start)
-				}
-				_, err = windowFunc.UpdatePartialResult(ctx, rows[start:end], p.partialResults[i])
+				err = rows.updatePartialResult(ctx, windowFunc, p.partialResults[i], start, end)
 			}
 			if err != nil {
-				return nil, err
+				return err
 			}
 			err = windowFunc.AppendFinalResult2Chunk(ctx, p.partialResults[i], chk)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			if slidingWindowAggFunc == nil {
 				windowFunc.ResetPartialResult(p.partialResults[i])
@@ -370,7 +369,7 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 	for i, windowFunc := range p.windowFuncs {
 		windowFunc.ResetPartialResult(p.partialResults[i])
 	}
-	return rows, nil
+	return nil
 }
 
 func (p *rowFrameWindowProcessor) resetPartialResult() {
@@ -390,16 +389,23 @@ type rangeFrameWindowProcessor struct {
 	expectedCmpResult int64
 }
 
-func (p *rangeFrameWindowProcessor) getStartOffset(ctx sessionctx.Context, rows []chunk.Row) (uint64, error) {
+func (p *rangeFrameWindowProcessor) getStartOffset(ctx sessionctx.Context, rows *windowPartition) (uint64, error) {
 	if p.start.UnBounded {
 		return 0, nil
 	}
-	numRows := uint64(len(rows))
+	numRows := rows.NumRows()
+	curRow, err := rows.GetRow(p.curRowIdx)
+	if err != nil {
+		return 0, err
+	}
 	for ; p.lastStartOffset < numRows; p.lastStartOffset++ {
+		row, err := rows.GetRow(p.lastStartOffset)
+		if err != nil {
+			return 0, err
+		}
 		var res int64
-		var err error
 		for i := range p.orderByCols {
-			res, _, err = p.start.CmpFuncs[i](ctx, p.start.CompareCols[i], p.start.CalcFuncs[i], rows[p.lastStartOffset], rows[p.curRowIdx])
+			res, _, err = p.start.CmpFuncs[i](ctx, p.start.CompareCols[i], p.start.CalcFuncs[i], row, curRow)
 			if err != nil {
 				return 0, err
 			}
@@ -416,16 +422,23 @@ func (p *rangeFrameWindowProcessor) getStartOffset(ctx sessionctx.Context, rows
 	return p.lastStartOffset, nil
 }
 
-func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows []chunk.Row) (uint64, error) {
-	numRows := uint64(len(rows))
+func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows *windowPartition) (uint64, error) {
+	numRows := rows.NumRows()
 	if p.end.UnBounded {
 		return numRows, nil
 	}
+	curRow, err := rows.GetRow(p.curRowIdx)
+	if err != nil {
+		return 0, err
+	}
 	for ; p.lastEndOffset < numRows; p.lastEndOffset++ {
+		row, err := rows.GetRow(p.lastEndOffset)
+		if err != nil {
+			return 0, err
+		}
 		var res int64
-		var err error
 		for i := range p.orderByCols {
-			res, _, err = p.end.CmpFuncs[i](ctx, p.end.CalcFuncs[i], p.end.CompareCols[i], rows[p.curRowIdx], rows[p.lastEndOffset])
+			res, _, err = p.end.CmpFuncs[i](ctx, p.end.CalcFuncs[i], p.end.CompareCols[i], curRow, row)
 			if err != nil {
 				return 0, err
 			}
@@ -442,7 +455,7 @@ func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows []
 	return p.lastEndOffset, nil
 }
 
-func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error) {
+func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows *windowPartition, chk *chunk.Chunk, remained int) error {
 	var (
 		err                      error
 		initializedSlidingWindow bool
@@ -462,11 +475,11 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 	for ; remained > 0; lastStart, lastEnd = start, end {
 		start, err = p.getStartOffset(ctx, rows)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		end, err = p.getEndOffset(ctx, rows)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		p.curRowIdx++
 		remained--
@@ -476,16 +489,14 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 			for i, windowFunc := range p.windowFuncs {
 				slidingWindowAggFunc := slidingWindowAggFuncs[i]
 				if slidingWindowAggFunc != nil && initializedSlidingWindow {
-					err = slidingWindowAggFunc.Slide(ctx, func(u uint64) chunk.Row {
-						return rows[u]
-					}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+					err = rows.slide(ctx, slidingWindowAggFunc, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
 					if err != nil {
-						return nil, err
+						return err
 					}
 				}
 				err = windowFunc.AppendFinalResult2Chunk(ctx, p.partialResults[i], chk)
 				if err != nil {
-					return nil, err
+					return err
 				}
 			}
 			continue
@@ -494,21 +505,16 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 		for i, windowFunc := range p.windowFuncs {
 			slidingWindowAggFunc := slidingWindowAggFuncs[i]
 			if slidingWindowAggFunc != nil && initializedSlidingWindow {
-				err = slidingWindowAggFunc.Slide(ctx, func(u uint64) chunk.Row {
-					return rows[u]
-				}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+				err = rows.slide(ctx, slidingWindowAggFunc, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
 			} else {
-				if minMaxSlidingWindowAggFunc, ok := windowFunc.(aggfuncs.MaxMinSlidingWindowAggFunc); ok {
-					minMaxSlidingWindowAggFunc.SetWindowStart(start)
-				}
-				_, err = windowFunc.UpdatePartialResult(ctx, rows[start:end], p.partialResults[i])
+				err = rows.updatePartialResult(ctx, windowFunc, p.partialResults[i], start, end)
 			}
 			if err != nil {
-				return nil, err
+				return err
 			}
 			err = windowFunc.AppendFinalResult2Chunk(ctx, p.partialResults[i], chk)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			if slidingWindowAggFunc == nil {
 				windowFunc.ResetPartialResult(p.partialResults[i])
@@ -521,11 +527,11 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 	for i, windowFunc := range p.windowFuncs {
 		windowFunc.ResetPartialResult(p.partialResults[i])
 	}
-	return rows, nil
+	return nil
 }
 
-func (*rangeFrameWindowProcessor) consumeGroupRows(_ sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error) {
-	return rows, nil
+func (*rangeFrameWindowProcessor) consumeGroupRows(sessionctx.Context, *windowPartition) error {
+	return nil
 }
 
 func (p *rangeFrameWindowProcessor) resetPartialResult() {
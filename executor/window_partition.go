@@ -0,0 +1,365 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/executor/aggfuncs"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/disk"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/memory"
+	"go.uber.org/zap"
+)
+
+// numCachedChunksOfWindowPartition is the number of the spilled chunks cached in memory. The frames read the rows
+// around the start, the end and the current row of the frame, so a few chunks are enough to avoid reading the same
+// chunk from disk again and again.
+const numCachedChunksOfWindowPartition = 4
+
+// windowPartition keeps the rows of the window partition being processed, and provides random access to the rows by
+// their offsets in the partition.
+// The rows are kept in memory by referencing the child chunks at first. Once the memory quota of the query is
+// exceeded, the windowSpillDiskAction notifies the partition to move the rows into a chunk.RowContainer spilled to
+// disk, and the following rows of the partition are added to the container too.
+type windowPartition struct {
+	fieldTypes   []*types.FieldType
+	maxChunkSize int
+	memTracker   *memory.Tracker
+	diskTracker  *disk.Tracker
+
+	// start is the offset of the first row kept, the rows before it have been dropped.
+	start   uint64
+	numRows uint64
+
+	// rows keeps the rows in [start, numRows) before spilled.
+	rows []chunk.Row
+	// refChunks are the child chunks referenced by rows, it's used to release the memory of the chunks after all
+	// their rows are dropped.
+	refChunks []refChunk
+
+	// inSpillMode is set by windowSpillDiskAction to notify the partition to spill the rows.
+	inSpillMode uint32
+	// container keeps the rows in [spillStart, numRows) after spilled. The rows are copied to chk, and chk is
+	// added to the container once it's full.
+	container  *chunk.RowContainer
+	spillStart uint64
+	chk        *chunk.Chunk
+	// chkOffsets[i] is the offset of the first row of the i-th chunk of the container, relative to spillStart.
+	chkOffsets []uint64
+	numAdded   uint64
+	// cachedChunks caches the chunks recently read from the container.
+	cachedChunks [numCachedChunksOfWindowPartition]cachedChunk
+	nextCacheIdx int
+	// nullRow is returned to the window functions in slide if it fails to read a row.
+	nullRow *chunk.Row
+}
+
+type refChunk struct {
+	chk *chunk.Chunk
+	// end is the offset after the last row of chk.
+	end uint64
+}
+
+type cachedChunk struct {
+	chk    *chunk.Chunk
+	chkIdx int
+}
+
+func newWindowPartition(fieldTypes []*types.FieldType, maxChunkSize int, memTracker *memory.Tracker, diskTracker *disk.Tracker) *windowPartition {
+	return &windowPartition{
+		fieldTypes:   fieldTypes,
+		maxChunkSize: maxChunkSize,
+		memTracker:   memTracker,
+		diskTracker:  diskTracker,
+	}
+}
+
+// NumRows implements the aggfuncs.PartitionRows interface.
+func (p *windowPartition) NumRows() uint64 {
+	return p.numRows
+}
+
+// GetRow implements the aggfuncs.PartitionRows interface.
+func (p *windowPartition) GetRow(idx uint64) (chunk.Row, error) {
+	if p.container == nil {
+		return p.rows[idx-p.start], nil
+	}
+	idx -= p.spillStart
+	if idx >= p.numAdded {
+		return p.chk.GetRow(int(idx - p.numAdded)), nil
+	}
+	chkIdx := sort.Search(len(p.chkOffsets), func(i int) bool {
+		return p.chkOffsets[i] > idx
+	}) - 1
+	chk, err := p.getChunk(chkIdx)
+	if err != nil {
+		return chunk.Row{}, err
+	}
+	return chk.GetRow(int(idx - p.chkOffsets[chkIdx])), nil
+}
+
+func (p *windowPartition) getChunk(chkIdx int) (*chunk.Chunk, error) {
+	for _, cached := range p.cachedChunks {
+		if cached.chk != nil && cached.chkIdx == chkIdx {
+			return cached.chk, nil
+		}
+	}
+	chk, err := p.container.GetChunk(chkIdx)
+	if err != nil {
+		return nil, err
+	}
+	p.cachedChunks[p.nextCacheIdx] = cachedChunk{chk: chk, chkIdx: chkIdx}
+	p.nextCacheIdx = (p.nextCacheIdx + 1) % numCachedChunksOfWindowPartition
+	return chk, nil
+}
+
+// slide calls the Slide method of the window function with the rows of the partition. The getRow function of Slide
+// can't return an error, so a row of nulls is returned if it fails to read a row, and the error is returned after
+// Slide finishes.
+func (p *windowPartition) slide(ctx sessionctx.Context, windowFunc aggfuncs.SlidingWindowAggFunc, lastStart, lastEnd, shiftStart, shiftEnd uint64, pr aggfuncs.PartialResult) error {
+	var getRowErr error
+	getRow := func(idx uint64) chunk.Row {
+		row, err := p.GetRow(idx)
+		if err == nil {
+			return row
+		}
+		if getRowErr == nil {
+			getRowErr = err
+		}
+		if p.nullRow == nil {
+			chk := chunk.New(p.fieldTypes, 1, 1)
+			for i := range p.fieldTypes {
+				chk.AppendNull(i)
+			}
+			nullRow := chk.GetRow(0)
+			p.nullRow = &nullRow
+		}
+		return *p.nullRow
+	}
+	if err := windowFunc.Slide(ctx, getRow, lastStart, lastEnd, shiftStart, shiftEnd, pr); err != nil {
+		return err
+	}
+	return getRowErr
+}
+
+// updatePartialResult updates the partial result of the window function with the rows in [start, end). The spilled
+// rows are passed in batches, so that they needn't be loaded in memory at the same time.
+func (p *windowPartition) updatePartialResult(ctx sessionctx.Context, windowFunc aggfuncs.AggFunc, pr aggfuncs.PartialResult, start, end uint64) error {
+	// For MinMaxSlidingWindowAggFuncs, it needs the absolute value of each start of window, to compare
+	// whether elements inside deque are out of current window.
+	minMaxSlidingWindowAggFunc, isMinMax := windowFunc.(aggfuncs.MaxMinSlidingWindowAggFunc)
+	if p.container == nil {
+		if isMinMax {
+			// Store start inside MaxMinSlidingWindowAggFunc.windowInfo
+			minMaxSlidingWindowAggFunc.SetWindowStart(start)
+		}
+		_, err := windowFunc.UpdatePartialResult(ctx, p.rows[start-p.start:end-p.start], pr)
+		return err
+	}
+	rows := make([]chunk.Row, 0, p.maxChunkSize)
+	for batchStart := start; batchStart < end; batchStart += uint64(len(rows)) {
+		rows = rows[:0]
+		for i := batchStart; i < end && len(rows) < p.maxChunkSize; i++ {
+			row, err := p.GetRow(i)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, row)
+		}
+		if isMinMax {
+			minMaxSlidingWindowAggFunc.SetWindowStart(batchStart)
+		}
+		if _, err := windowFunc.UpdatePartialResult(ctx, rows, pr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendRows appends the rows in [begin, end) of chk to the partition.
+func (p *windowPartition) appendRows(chk *chunk.Chunk, begin, end int) (err error) {
+	if begin >= end {
+		return nil
+	}
+	if p.container == nil {
+		for i := begin; i < end; i++ {
+			p.rows = append(p.rows, chk.GetRow(i))
+		}
+		p.numRows += uint64(end - begin)
+		if len(p.refChunks) > 0 && p.refChunks[len(p.refChunks)-1].chk == chk {
+			p.refChunks[len(p.refChunks)-1].end = p.numRows
+		} else {
+			p.refChunks = append(p.refChunks, refChunk{chk: chk, end: p.numRows})
+			p.memTracker.Consume(chk.MemoryUsage())
+		}
+		failpoint.Inject("testWindowPartitionSpill", func(val failpoint.Value) {
+			if val.(bool) {
+				atomic.StoreUint32(&p.inSpillMode, 1)
+			}
+		})
+		return p.spillIfNeeded()
+	}
+	for i := begin; i < end; i++ {
+		if err = p.appendRowToContainer(chk.GetRow(i)); err != nil {
+			return err
+		}
+	}
+	p.numRows += uint64(end - begin)
+	return nil
+}
+
+func (p *windowPartition) appendRowToContainer(row chunk.Row) error {
+	if p.chk == nil {
+		p.chk = chunk.New(p.fieldTypes, p.maxChunkSize, p.maxChunkSize)
+	}
+	p.chk.AppendRow(row)
+	if !p.chk.IsFull() {
+		return nil
+	}
+	if err := p.container.Add(p.chk); err != nil {
+		return err
+	}
+	p.chkOffsets = append(p.chkOffsets, p.numAdded)
+	p.numAdded += uint64(p.chk.NumRows())
+	p.chk = nil
+	return nil
+}
+
+// spillIfNeeded spills the rows if the partition is set to spill mode. The memory action may be triggered after
+// the last rows of the partition are appended, so it's also checked before producing the results.
+func (p *windowPartition) spillIfNeeded() error {
+	if p.container != nil || atomic.LoadUint32(&p.inSpillMode) == 0 || p.numRows == p.start {
+		return nil
+	}
+	return p.spill()
+}
+
+// spill moves the rows kept in memory to a chunk.RowContainer spilled to disk.
+func (p *windowPartition) spill() error {
+	logutil.BgLogger().Info("memory exceeds quota, spill the rows of window partition to disk",
+		zap.Uint64("rows", p.numRows-p.start), zap.Int64("consumed", p.memTracker.BytesConsumed()))
+	p.container = chunk.NewRowContainer(p.fieldTypes, p.maxChunkSize)
+	p.container.GetDiskTracker().AttachTo(p.diskTracker)
+	p.container.GetDiskTracker().SetLabel(memory.LabelForRowChunks)
+	p.container.SpillToDisk()
+	p.spillStart = p.start
+	for _, row := range p.rows {
+		if err := p.appendRowToContainer(row); err != nil {
+			return err
+		}
+	}
+	p.rows = nil
+	p.releaseRefChunks(p.numRows)
+	return nil
+}
+
+func (p *windowPartition) releaseRefChunks(end uint64) {
+	i := 0
+	for ; i < len(p.refChunks) && p.refChunks[i].end <= end; i++ {
+		p.memTracker.Consume(-p.refChunks[i].chk.MemoryUsage())
+	}
+	p.refChunks = p.refChunks[i:]
+}
+
+// drop drops the rows before offset, which won't be accessed anymore. The rows spilled to disk are kept until the
+// partition is reset.
+func (p *windowPartition) drop(offset uint64) {
+	if offset <= p.start || p.container != nil {
+		return
+	}
+	p.rows = p.rows[offset-p.start:]
+	p.start = offset
+	p.releaseRefChunks(offset)
+}
+
+// reset drops the rows before offset, and the row at offset becomes the first row of the new partition.
+func (p *windowPartition) reset(offset uint64) error {
+	var remaining []chunk.Row
+	if p.container != nil {
+		for i := offset; i < p.numRows; i++ {
+			row, err := p.GetRow(i)
+			if err != nil {
+				return err
+			}
+			remaining = append(remaining, row)
+		}
+		if err := p.closeContainer(); err != nil {
+			return err
+		}
+		atomic.StoreUint32(&p.inSpillMode, 0)
+		p.rows = append(p.rows[:0], remaining...)
+	} else {
+		p.rows = p.rows[offset-p.start:]
+		p.releaseRefChunks(offset)
+	}
+	for i := range p.refChunks {
+		p.refChunks[i].end -= offset
+	}
+	p.start = 0
+	p.numRows -= offset
+	return nil
+}
+
+func (p *windowPartition) closeContainer() error {
+	err := p.container.Close()
+	p.container = nil
+	p.chk = nil
+	p.chkOffsets = p.chkOffsets[:0]
+	p.numAdded = 0
+	p.cachedChunks = [numCachedChunksOfWindowPartition]cachedChunk{}
+	return err
+}
+
+func (p *windowPartition) close() error {
+	p.releaseRefChunks(p.numRows)
+	p.rows = nil
+	if p.container != nil {
+		return p.closeContainer()
+	}
+	return nil
+}
+
+// windowSpillDiskAction implements memory.ActionOnExceed for the window executors. If the memory quota of a query
+// is exceeded, it notifies the window partition to spill its rows to disk.
+type windowSpillDiskAction struct {
+	memory.BaseOOMAction
+	p *windowPartition
+}
+
+// Action sets the window partition to spill mode.
+func (a *windowSpillDiskAction) Action(t *memory.Tracker) {
+	if atomic.LoadUint32(&a.p.inSpillMode) == 0 && a.p.memTracker.BytesConsumed() > 0 {
+		logutil.BgLogger().Info("memory exceeds quota, set window partition to spill mode",
+			zap.Int64("consumed", t.BytesConsumed()),
+			zap.Int64("quota", t.GetBytesLimit()))
+		atomic.StoreUint32(&a.p.inSpillMode, 1)
+		memory.QueryForceDisk.Add(1)
+		return
+	}
+	if fallback := a.GetFallback(); fallback != nil {
+		fallback.Action(t)
+	}
+}
+
+// GetPriority get the priority of the Action
+func (*windowSpillDiskAction) GetPriority() int64 {
+	return memory.DefSpillPriority
+}
@@ -17,10 +17,14 @@ package executor_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWindowFunctions(t *testing.T) {
@@ -547,3 +551,87 @@ func TestIssue45964And46050(t *testing.T) {
 	testReturnColumnNullableAttribute(tk, "cume_dist()", false)
 	testReturnColumnNullableAttribute(tk, "percent_rank()", false)
 }
+
+func TestWindowFunctionsSpill(t *testing.T) {
+	restore := config.RestoreFunc()
+	defer restore()
+	config.UpdateGlobal(func(conf *config.Config) {
+		conf.TempStoragePath = t.TempDir()
+	})
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	defer tk.MustExec("SET GLOBAL tidb_mem_oom_action = DEFAULT")
+	tk.MustExec("SET GLOBAL tidb_mem_oom_action='LOG'")
+	tk.MustExec("use test")
+	// Keep a single window executor so that it is the one chosen to spill.
+	tk.MustExec("set @@tidb_window_concurrency = 1")
+	tk.MustExec("drop table if exists t")
+	// The index provides the order required by most of the windows, so that no Sort takes the spill action.
+	tk.MustExec("create table t(p int, o int, v int, key(p, o, v))")
+	// Partition 0 is much larger than the others.
+	var buf strings.Builder
+	buf.WriteString("insert into t values ")
+	for i := 0; i < 3000; i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		p := 0
+		if i%10 == 0 {
+			p = i % 7
+		}
+		buf.WriteString(fmt.Sprintf("(%d, %d, %d)", p, i%1000, i*7919%3000))
+	}
+	tk.MustExec(buf.String())
+
+	queries := []string{
+		"select p, o, v, row_number() over w, rank() over w, dense_rank() over w from t window w as (partition by p order by o, v)",
+		"select p, o, v, cume_dist() over w, percent_rank() over w, ntile(7) over w from t window w as (partition by p order by o, v)",
+		"select p, o, v, lead(v, 3) over w, lag(v, 100, -1) over w, first_value(v) over w from t window w as (partition by p order by o, v)",
+		"select p, o, v, sum(v) over (partition by p order by o, v rows between 100 preceding and 50 following) from t",
+		"select p, o, v, max(v) over (partition by p order by o, v rows between current row and unbounded following) from t",
+		"select p, o, v, count(v) over (partition by p order by o range between 10 preceding and 5 following) from t",
+		"select p, o, v, min(v) over (partition by p order by o desc range between unbounded preceding and 3 following) from t",
+		"select p, o, v, sum(v) over (partition by p), avg(v) over () from t order by p, o, v",
+	}
+	for _, pipelined := range []string{"ON", "OFF"} {
+		tk.MustExec("set @@tidb_enable_pipelined_window_function = " + pipelined)
+		for _, sql := range queries {
+			tk.MustExec("set @@tidb_mem_quota_query = default")
+			expected := tk.MustQuery(sql).Sort().Rows()
+			tk.MustExec("set @@tidb_mem_quota_query = 1")
+			tk.MustQuery(sql).Sort().Check(expected)
+
+			spilled, sorted := false, false
+			for _, row := range tk.MustQuery("explain analyze " + sql).Rows() {
+				op := fmt.Sprintf("%v", row[0])
+				sorted = sorted || strings.Contains(op, "Sort")
+				if strings.Contains(op, "Window") {
+					disk := fmt.Sprintf("%v", row[len(row)-1])
+					spilled = spilled || !strings.Contains(disk, "N/A") && disk != "0 Bytes"
+				}
+			}
+			require.True(t, spilled || sorted, sql)
+		}
+	}
+	tk.MustExec("set @@tidb_mem_quota_query = default")
+	tk.MustExec("set @@tidb_enable_pipelined_window_function = default")
+}
+
+func TestWindowFunctionsSpillAllPartitions(t *testing.T) {
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/executor/testWindowPartitionSpill", "return(true)"))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/executor/testWindowPartitionSpill"))
+	}()
+	for _, pipelined := range []string{"ON", "OFF"} {
+		// doTestWindowFunctions expects a fresh store.
+		store := testkit.CreateMockStore(t)
+		tk := testkit.NewTestKit(t, store)
+		tk.MustExec("set @@tidb_enable_pipelined_window_function = " + pipelined)
+		doTestWindowFunctions(tk)
+		tk.MustExec("use test")
+		tk.MustExec("drop table if exists t")
+		tk.MustExec("CREATE TABLE t (id DOUBLE, sex CHAR(1))")
+		tk.MustExec("set @@tidb_enable_window_function = 1")
+		baseTestSlidingWindowFunctions(tk)
+	}
+}
@@ -56,11 +56,16 @@ var (
 func updateRecord(
 	ctx context.Context, sctx sessionctx.Context, h kv.Handle, oldData, newData []types.Datum, modified []bool,
 	t table.Table,
-	onDup bool, _ *memory.Tracker, fkChecks []*FKCheckExec, fkCascades []*FKCascadeExec,
+	onDup bool, _ *memory.Tracker, fkChecks []*FKCheckExec, fkCascades []*FKCascadeExec, triggers *triggerExec,
 ) (bool, error) {
 	r, ctx := tracing.StartRegionEx(ctx, "executor.updateRecord")
 	defer r.End()
 
+	// The BEFORE UPDATE triggers may change the new row, so they are fired before the new row is checked.
+	if err := triggers.fire(ctx, model.TriggerTimingBefore, model.TriggerEventUpdate, oldData, newData); err != nil {
+		return false, err
+	}
+
 	sc := sctx.GetSessionVars().StmtCtx
 	changed, handleChanged := false, false
 	// onUpdateSpecified is for "UPDATE SET ts_field = old_value", the
@@ -137,8 +142,10 @@ func updateRecord(
 		if sctx.GetSessionVars().LockUnchangedKeys {
 			keySet |= lockUniqueKeys
 		}
-		_, err := addUnchangedKeysForLockByRow(sctx, t, h, oldData, keySet)
-		return false, err
+		if _, err := addUnchangedKeysForLockByRow(sctx, t, h, oldData, keySet); err != nil {
+			return false, err
+		}
+		return false, triggers.fire(ctx, model.TriggerTimingAfter, model.TriggerEventUpdate, oldData, newData)
 	}
 
 	// Fill values into on-update-now fields, only if they are really changed.
@@ -175,7 +182,7 @@ func updateRecord(
 			sh := memBuffer.Staging()
 			defer memBuffer.Cleanup(sh)
 
-			if err = t.RemoveRecord(sctx, h, oldData); err != nil {
+			if err = t.RemoveRecord(sctx, h, oldData, table.DeleteWithCtx(ctx)); err != nil {
 				return false, err
 			}
 
@@ -218,6 +225,9 @@ func updateRecord(
 			return false, err
 		}
 	}
+	if err := triggers.fire(ctx, model.TriggerTimingAfter, model.TriggerEventUpdate, oldData, newData); err != nil {
+		return false, err
+	}
 	if onDup {
 		sc.AddAffectedRows(2)
 	} else {
@@ -339,7 +339,7 @@ func (b *baseBuiltinFunc) isChildrenVectorized() bool {
 }
 
 func (b *baseBuiltinFunc) getRetTp() *types.FieldType {
-	if b.tp.EvalType() == types.ETString {
+	if b.tp.EvalType() == types.ETString && b.tp.GetType() != mysql.TypeGeometry && b.tp.GetType() != mysql.TypeVector {
 		if b.tp.GetFlen() >= mysql.MaxBlobWidth {
 			b.tp.SetType(mysql.TypeLongBlob)
 		} else if b.tp.GetFlen() >= 65536 {
@@ -371,6 +371,10 @@ func (b *baseBuiltinFunc) getCtx() sessionctx.Context {
 	return b.ctx
 }
 
+func (b *baseBuiltinFunc) setCtx(ctx sessionctx.Context) {
+	b.ctx = ctx
+}
+
 func (b *baseBuiltinFunc) cloneFrom(from *baseBuiltinFunc) {
 	b.args = make([]Expression, 0, len(b.args))
 	for _, arg := range from.args {
@@ -484,6 +488,8 @@ type builtinFunc interface {
 	equal(builtinFunc) bool
 	// getCtx returns this function's context.
 	getCtx() sessionctx.Context
+	// setCtx sets this function's context.
+	setCtx(ctx sessionctx.Context)
 	// getRetTp returns the return type of the built-in function.
 	getRetTp() *types.FieldType
 	// setPbCode sets pbCode for signature.
@@ -868,33 +874,79 @@ var funcs = map[string]functionClass{
 	ast.ValidatePasswordStrength: &validatePasswordStrengthFunctionClass{baseFunctionClass{ast.ValidatePasswordStrength, 1, 1}},
 
 	// json functions
-	ast.JSONType:          &jsonTypeFunctionClass{baseFunctionClass{ast.JSONType, 1, 1}},
-	ast.JSONExtract:       &jsonExtractFunctionClass{baseFunctionClass{ast.JSONExtract, 2, -1}},
-	ast.JSONUnquote:       &jsonUnquoteFunctionClass{baseFunctionClass{ast.JSONUnquote, 1, 1}},
-	ast.JSONSet:           &jsonSetFunctionClass{baseFunctionClass{ast.JSONSet, 3, -1}},
-	ast.JSONInsert:        &jsonInsertFunctionClass{baseFunctionClass{ast.JSONInsert, 3, -1}},
-	ast.JSONReplace:       &jsonReplaceFunctionClass{baseFunctionClass{ast.JSONReplace, 3, -1}},
-	ast.JSONRemove:        &jsonRemoveFunctionClass{baseFunctionClass{ast.JSONRemove, 2, -1}},
-	ast.JSONMerge:         &jsonMergeFunctionClass{baseFunctionClass{ast.JSONMerge, 2, -1}},
-	ast.JSONObject:        &jsonObjectFunctionClass{baseFunctionClass{ast.JSONObject, 0, -1}},
-	ast.JSONArray:         &jsonArrayFunctionClass{baseFunctionClass{ast.JSONArray, 0, -1}},
-	ast.JSONMemberOf:      &jsonMemberOfFunctionClass{baseFunctionClass{ast.JSONMemberOf, 2, 2}},
-	ast.JSONContains:      &jsonContainsFunctionClass{baseFunctionClass{ast.JSONContains, 2, 3}},
-	ast.JSONOverlaps:      &jsonOverlapsFunctionClass{baseFunctionClass{ast.JSONOverlaps, 2, 2}},
-	ast.JSONContainsPath:  &jsonContainsPathFunctionClass{baseFunctionClass{ast.JSONContainsPath, 3, -1}},
-	ast.JSONValid:         &jsonValidFunctionClass{baseFunctionClass{ast.JSONValid, 1, 1}},
-	ast.JSONArrayAppend:   &jsonArrayAppendFunctionClass{baseFunctionClass{ast.JSONArrayAppend, 3, -1}},
-	ast.JSONArrayInsert:   &jsonArrayInsertFunctionClass{baseFunctionClass{ast.JSONArrayInsert, 3, -1}},
-	ast.JSONMergePatch:    &jsonMergePatchFunctionClass{baseFunctionClass{ast.JSONMergePatch, 2, -1}},
-	ast.JSONMergePreserve: &jsonMergePreserveFunctionClass{baseFunctionClass{ast.JSONMergePreserve, 2, -1}},
-	ast.JSONPretty:        &jsonPrettyFunctionClass{baseFunctionClass{ast.JSONPretty, 1, 1}},
-	ast.JSONQuote:         &jsonQuoteFunctionClass{baseFunctionClass{ast.JSONQuote, 1, 1}},
-	ast.JSONSearch:        &jsonSearchFunctionClass{baseFunctionClass{ast.JSONSearch, 3, -1}},
-	ast.JSONStorageFree:   &jsonStorageFreeFunctionClass{baseFunctionClass{ast.JSONStorageFree, 1, 1}},
-	ast.JSONStorageSize:   &jsonStorageSizeFunctionClass{baseFunctionClass{ast.JSONStorageSize, 1, 1}},
-	ast.JSONDepth:         &jsonDepthFunctionClass{baseFunctionClass{ast.JSONDepth, 1, 1}},
-	ast.JSONKeys:          &jsonKeysFunctionClass{baseFunctionClass{ast.JSONKeys, 1, 2}},
-	ast.JSONLength:        &jsonLengthFunctionClass{baseFunctionClass{ast.JSONLength, 1, 2}},
+	ast.JSONType:                   &jsonTypeFunctionClass{baseFunctionClass{ast.JSONType, 1, 1}},
+	ast.JSONExtract:                &jsonExtractFunctionClass{baseFunctionClass{ast.JSONExtract, 2, -1}},
+	ast.JSONUnquote:                &jsonUnquoteFunctionClass{baseFunctionClass{ast.JSONUnquote, 1, 1}},
+	ast.JSONSet:                    &jsonSetFunctionClass{baseFunctionClass{ast.JSONSet, 3, -1}},
+	ast.JSONInsert:                 &jsonInsertFunctionClass{baseFunctionClass{ast.JSONInsert, 3, -1}},
+	ast.JSONReplace:                &jsonReplaceFunctionClass{baseFunctionClass{ast.JSONReplace, 3, -1}},
+	ast.JSONRemove:                 &jsonRemoveFunctionClass{baseFunctionClass{ast.JSONRemove, 2, -1}},
+	ast.JSONMerge:                  &jsonMergeFunctionClass{baseFunctionClass{ast.JSONMerge, 2, -1}},
+	ast.JSONObject:                 &jsonObjectFunctionClass{baseFunctionClass{ast.JSONObject, 0, -1}},
+	ast.JSONArray:                  &jsonArrayFunctionClass{baseFunctionClass{ast.JSONArray, 0, -1}},
+	ast.JSONMemberOf:               &jsonMemberOfFunctionClass{baseFunctionClass{ast.JSONMemberOf, 2, 2}},
+	ast.JSONContains:               &jsonContainsFunctionClass{baseFunctionClass{ast.JSONContains, 2, 3}},
+	ast.JSONOverlaps:               &jsonOverlapsFunctionClass{baseFunctionClass{ast.JSONOverlaps, 2, 2}},
+	ast.JSONContainsPath:           &jsonContainsPathFunctionClass{baseFunctionClass{ast.JSONContainsPath, 3, -1}},
+	ast.JSONValid:                  &jsonValidFunctionClass{baseFunctionClass{ast.JSONValid, 1, 1}},
+	ast.JSONArrayAppend:            &jsonArrayAppendFunctionClass{baseFunctionClass{ast.JSONArrayAppend, 3, -1}},
+	ast.JSONArrayInsert:            &jsonArrayInsertFunctionClass{baseFunctionClass{ast.JSONArrayInsert, 3, -1}},
+	ast.JSONMergePatch:             &jsonMergePatchFunctionClass{baseFunctionClass{ast.JSONMergePatch, 2, -1}},
+	ast.JSONMergePreserve:          &jsonMergePreserveFunctionClass{baseFunctionClass{ast.JSONMergePreserve, 2, -1}},
+	ast.JSONPretty:                 &jsonPrettyFunctionClass{baseFunctionClass{ast.JSONPretty, 1, 1}},
+	ast.JSONQuote:                  &jsonQuoteFunctionClass{baseFunctionClass{ast.JSONQuote, 1, 1}},
+	ast.JSONSearch:                 &jsonSearchFunctionClass{baseFunctionClass{ast.JSONSearch, 3, -1}},
+	ast.JSONStorageFree:            &jsonStorageFreeFunctionClass{baseFunctionClass{ast.JSONStorageFree, 1, 1}},
+	ast.JSONStorageSize:            &jsonStorageSizeFunctionClass{baseFunctionClass{ast.JSONStorageSize, 1, 1}},
+	ast.JSONDepth:                  &jsonDepthFunctionClass{baseFunctionClass{ast.JSONDepth, 1, 1}},
+	ast.JSONKeys:                   &jsonKeysFunctionClass{baseFunctionClass{ast.JSONKeys, 1, 2}},
+	ast.JSONLength:                 &jsonLengthFunctionClass{baseFunctionClass{ast.JSONLength, 1, 2}},
+	ast.JSONSchemaValid:            &jsonSchemaValidFunctionClass{baseFunctionClass{ast.JSONSchemaValid, 2, 2}},
+	ast.JSONSchemaValidationReport: &jsonSchemaValidationReportFunctionClass{baseFunctionClass{ast.JSONSchemaValidationReport, 2, 2}},
+
+	// spatial functions
+	ast.Point:                &pointFunctionClass{baseFunctionClass{ast.Point, 2, 2}},
+	ast.STArea:               &geometryMeasureFunctionClass{baseFunctionClass{ast.STArea, 1, 1}, true},
+	ast.STAsBinary:           &geometryAsBinaryFunctionClass{baseFunctionClass{ast.STAsBinary, 1, 1}},
+	ast.STAsText:             &geometryAsTextFunctionClass{baseFunctionClass{ast.STAsText, 1, 1}},
+	ast.STAsWKB:              &geometryAsBinaryFunctionClass{baseFunctionClass{ast.STAsWKB, 1, 1}},
+	ast.STAsWKT:              &geometryAsTextFunctionClass{baseFunctionClass{ast.STAsWKT, 1, 1}},
+	ast.STContains:           &geometryRelationFunctionClass{baseFunctionClass{ast.STContains, 2, 2}, types.GeometryContains},
+	ast.STDisjoint:           &geometryRelationFunctionClass{baseFunctionClass{ast.STDisjoint, 2, 2}, types.GeometryDisjoint},
+	ast.STDistance:           &geometryDistanceFunctionClass{baseFunctionClass{ast.STDistance, 2, 2}},
+	ast.STDistanceSphere:     &geometryDistanceSphereFunctionClass{baseFunctionClass{ast.STDistanceSphere, 2, 3}},
+	ast.STEquals:             &geometryRelationFunctionClass{baseFunctionClass{ast.STEquals, 2, 2}, types.GeometryEquals},
+	ast.STGeomFromText:       &geomFromTextFunctionClass{baseFunctionClass{ast.STGeomFromText, 1, 2}, mysql.GeometryTypeGeometry},
+	ast.STGeomFromWKB:        &geomFromWKBFunctionClass{baseFunctionClass{ast.STGeomFromWKB, 1, 2}},
+	ast.STGeometryFromText:   &geomFromTextFunctionClass{baseFunctionClass{ast.STGeometryFromText, 1, 2}, mysql.GeometryTypeGeometry},
+	ast.STGeometryFromWKB:    &geomFromWKBFunctionClass{baseFunctionClass{ast.STGeometryFromWKB, 1, 2}},
+	ast.STGeometryType:       &geometryTypeFunctionClass{baseFunctionClass{ast.STGeometryType, 1, 1}},
+	ast.STIntersects:         &geometryRelationFunctionClass{baseFunctionClass{ast.STIntersects, 2, 2}, types.GeometryIntersects},
+	ast.STIsEmpty:            &geometryIsEmptyFunctionClass{baseFunctionClass{ast.STIsEmpty, 1, 1}},
+	ast.STLength:             &geometryMeasureFunctionClass{baseFunctionClass{ast.STLength, 1, 1}, false},
+	ast.STLineFromText:       &geomFromTextFunctionClass{baseFunctionClass{ast.STLineFromText, 1, 2}, mysql.GeometryTypeLineString},
+	ast.STLineStringFromText: &geomFromTextFunctionClass{baseFunctionClass{ast.STLineStringFromText, 1, 2}, mysql.GeometryTypeLineString},
+	ast.STNumPoints:          &geometryNumPointsFunctionClass{baseFunctionClass{ast.STNumPoints, 1, 1}},
+	ast.STPointFromText:      &geomFromTextFunctionClass{baseFunctionClass{ast.STPointFromText, 1, 2}, mysql.GeometryTypePoint},
+	ast.STPolyFromText:       &geomFromTextFunctionClass{baseFunctionClass{ast.STPolyFromText, 1, 2}, mysql.GeometryTypePolygon},
+	ast.STPolygonFromText:    &geomFromTextFunctionClass{baseFunctionClass{ast.STPolygonFromText, 1, 2}, mysql.GeometryTypePolygon},
+	ast.STSRID:               &geometrySRIDFunctionClass{baseFunctionClass{ast.STSRID, 1, 2}},
+	ast.STWithin:             &geometryRelationFunctionClass{baseFunctionClass{ast.STWithin, 2, 2}, types.GeometryWithin},
+	ast.STX:                  &geometryCoordinateFunctionClass{baseFunctionClass{ast.STX, 1, 1}, false},
+	ast.STY:                  &geometryCoordinateFunctionClass{baseFunctionClass{ast.STY, 1, 1}, true},
+
+	// full-text search functions
+	ast.Match: &matchFunctionClass{baseFunctionClass{ast.Match, 3, -1}},
+
+	// vector functions
+	ast.VecAsText:               &vecAsTextFunctionClass{baseFunctionClass{ast.VecAsText, 1, 1}},
+	ast.VecCosineDistance:       &vecDistanceFunctionClass{baseFunctionClass{ast.VecCosineDistance, 2, 2}},
+	ast.VecDims:                 &vecDimsFunctionClass{baseFunctionClass{ast.VecDims, 1, 1}},
+	ast.VecFromText:             &vecFromTextFunctionClass{baseFunctionClass{ast.VecFromText, 1, 1}},
+	ast.VecL1Distance:           &vecDistanceFunctionClass{baseFunctionClass{ast.VecL1Distance, 2, 2}},
+	ast.VecL2Distance:           &vecDistanceFunctionClass{baseFunctionClass{ast.VecL2Distance, 2, 2}},
+	ast.VecL2Norm:               &vecL2NormFunctionClass{baseFunctionClass{ast.VecL2Norm, 1, 1}},
+	ast.VecNegativeInnerProduct: &vecDistanceFunctionClass{baseFunctionClass{ast.VecNegativeInnerProduct, 2, 2}},
 
 	// TiDB internal function.
 	ast.TiDBDecodeKey: &tidbDecodeKeyFunctionClass{baseFunctionClass{ast.TiDBDecodeKey, 1, 1}},
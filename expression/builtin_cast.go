@@ -2111,6 +2111,10 @@ func BuildCastFunctionWithCheck(ctx sessionctx.Context, expr Expression, tp *typ
 			fc = &castAsJSONFunctionClass{baseFunctionClass{ast.Cast, 1, 1}, tp}
 		}
 	case types.ETString:
+		if tp.GetType() == mysql.TypeVector {
+			fc = &castAsVectorFunctionClass{baseFunctionClass{ast.Cast, 1, 1}, tp}
+			break
+		}
 		fc = &castAsStringFunctionClass{baseFunctionClass{ast.Cast, 1, 1}, tp}
 		if expr.GetType().GetType() == mysql.TypeBit {
 			tp.SetFlen((expr.GetType().GetFlen() + 7) / 8)
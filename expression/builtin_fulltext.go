@@ -0,0 +1,189 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/fulltext"
+)
+
+var (
+	_ functionClass = &matchFunctionClass{}
+)
+
+var (
+	_ builtinFunc = &builtinMatchSig{}
+)
+
+// The arguments of the match function are the query, the search modifier and the columns to match,
+// so `MATCH(a, b) AGAINST('q' IN BOOLEAN MODE)` is rewritten to `match('q', 1, a, b)`. The full-text
+// index of the columns is set by SetFullTextIndex when rewriting the MATCH ... AGAINST expression.
+type matchFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *matchFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := make([]types.EvalType, 0, len(args))
+	argTps = append(argTps, types.ETString, types.ETInt)
+	for range args[2:] {
+		argTps = append(argTps, types.ETString)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(mysql.MaxRealWidth)
+	bf.tp.SetDecimal(types.UnspecifiedLength)
+	bf.tp.AddFlag(mysql.NotNullFlag)
+	sig := &builtinMatchSig{baseBuiltinFunc: bf}
+	return sig, nil
+}
+
+type builtinMatchSig struct {
+	baseBuiltinFunc
+
+	tableID int64
+	index   *model.IndexInfo
+
+	mu struct {
+		sync.Mutex
+		// query is the parsed query of queryText.
+		queryText string
+		query     *fulltext.Query
+		// stats is the statistics of the index read by the statement stmtCtx, which includes the
+		// document frequencies of the tokens of the query.
+		stmtCtx *stmtctx.StatementContext
+		stats   *fulltext.IndexStats
+	}
+}
+
+// Clone implements the builtinFunc interface.
+func (b *builtinMatchSig) Clone() builtinFunc {
+	newSig := &builtinMatchSig{tableID: b.tableID, index: b.index}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinMatchSig) tokenizer() fulltext.Tokenizer {
+	tokenizer, ok := fulltext.NewTokenizer(b.index.FullTextParser)
+	if !ok {
+		tokenizer, _ = fulltext.NewTokenizer("")
+	}
+	return tokenizer
+}
+
+// prepare returns the parsed query and the statistics of the index read by the current statement. The
+// statistics are loaded once by the first evaluation of the statement, the documents are tokenized and
+// ranked without holding the lock.
+func (b *builtinMatchSig) prepare(queryText string) (*fulltext.Query, *fulltext.IndexStats, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.mu.query == nil || b.mu.queryText != queryText {
+		booleanMode := ast.FulltextSearchModifier(b.args[1].(*Constant).Value.GetInt64()).IsBooleanMode()
+		b.mu.queryText, b.mu.query = queryText, fulltext.ParseQuery(b.tokenizer(), queryText, booleanMode)
+		b.mu.stats = nil
+	}
+	sc := b.ctx.GetSessionVars().StmtCtx
+	if b.mu.stats == nil || b.mu.stmtCtx != sc {
+		txn, err := b.ctx.Txn(true)
+		if err != nil {
+			return nil, nil, err
+		}
+		tokens, _ := b.mu.query.IndexTokens()
+		stats, err := fulltext.LoadIndexStats(context.Background(), fulltext.NewIndexReader(txn, b.tableID, b.index.ID), tokens...)
+		if err != nil {
+			return nil, nil, err
+		}
+		b.mu.stmtCtx, b.mu.stats = sc, stats
+	}
+	return b.mu.query, b.mu.stats, nil
+}
+
+// evalReal evals a builtinMatchSig, it returns the relevance of the row, or 0 if the row does not match.
+// See https://dev.mysql.com/doc/refman/8.0/en/fulltext-search.html#function_match
+func (b *builtinMatchSig) evalReal(row chunk.Row) (float64, bool, error) {
+	if b.index == nil {
+		return 0, false, ErrFtMatchingKeyNotFound
+	}
+	queryText, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return 0, false, err
+	}
+	texts := make([]string, 0, len(b.args)-2)
+	for _, arg := range b.args[2:] {
+		text, isNull, err := arg.EvalString(b.ctx, row)
+		if err != nil {
+			return 0, false, err
+		}
+		if !isNull {
+			texts = append(texts, text)
+		}
+	}
+	query, stats, err := b.prepare(queryText)
+	if err != nil {
+		return 0, false, err
+	}
+	matched, relevance, err := query.Relevance(fulltext.NewDocument(b.tokenizer(), texts...), stats)
+	if err != nil || !matched {
+		return 0, false, errors.Trace(err)
+	}
+	return relevance, false, nil
+}
+
+// SetFullTextIndex sets the full-text index of the columns matched by a match function.
+func SetFullTextIndex(match *ScalarFunction, tableID int64, index *model.IndexInfo) {
+	sig := match.Function.(*builtinMatchSig)
+	sig.tableID, sig.index = tableID, index
+}
+
+// FullTextMatch is a MATCH ... AGAINST expression.
+type FullTextMatch struct {
+	// Index is the full-text index of the matched columns.
+	Index *model.IndexInfo
+	// Query is the search query.
+	Query Expression
+	// BooleanMode indicates whether the query is in the boolean mode.
+	BooleanMode bool
+}
+
+// ExtractFullTextMatch returns the MATCH ... AGAINST expression if expr is a match function.
+func ExtractFullTextMatch(expr Expression) (*FullTextMatch, bool) {
+	f, ok := expr.(*ScalarFunction)
+	if !ok || f.FuncName.L != ast.Match {
+		return nil, false
+	}
+	sig := f.Function.(*builtinMatchSig)
+	if sig.index == nil {
+		return nil, false
+	}
+	return &FullTextMatch{
+		Index:       sig.index,
+		Query:       sig.args[0],
+		BooleanMode: ast.FulltextSearchModifier(sig.args[1].(*Constant).Value.GetInt64()).IsBooleanMode(),
+	}, true
+}
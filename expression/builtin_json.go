@@ -19,6 +19,7 @@ import (
 	goJSON "encoding/json"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/parser/ast"
@@ -59,6 +60,8 @@ var (
 	_ functionClass = &jsonDepthFunctionClass{}
 	_ functionClass = &jsonKeysFunctionClass{}
 	_ functionClass = &jsonLengthFunctionClass{}
+	_ functionClass = &jsonSchemaValidFunctionClass{}
+	_ functionClass = &jsonSchemaValidationReportFunctionClass{}
 
 	_ builtinFunc = &builtinJSONTypeSig{}
 	_ builtinFunc = &builtinJSONQuoteSig{}
@@ -85,6 +88,8 @@ var (
 	_ builtinFunc = &builtinJSONValidJSONSig{}
 	_ builtinFunc = &builtinJSONValidStringSig{}
 	_ builtinFunc = &builtinJSONValidOthersSig{}
+	_ builtinFunc = &builtinJSONSchemaValidSig{}
+	_ builtinFunc = &builtinJSONSchemaValidationReportSig{}
 )
 
 type jsonTypeFunctionClass struct {
@@ -1799,3 +1804,172 @@ func (b *builtinJSONLengthSig) evalInt(row chunk.Row) (res int64, isNull bool, e
 	}
 	return int64(obj.GetElemCount()), false, nil
 }
+
+// jsonSchemaBaseFuncSig is the base of the functions validating JSON documents against JSON Schemas.
+// The compiled schema is memorized if it's a constant.
+type jsonSchemaBaseFuncSig struct {
+	baseBuiltinFunc
+	funcName string
+
+	once           sync.Once
+	memorizedValue *types.JSONSchema
+	memorizedErr   error
+}
+
+func (b *jsonSchemaBaseFuncSig) cloneFrom(from *jsonSchemaBaseFuncSig) {
+	b.baseBuiltinFunc.cloneFrom(&from.baseBuiltinFunc)
+	b.funcName = from.funcName
+}
+
+func (b *jsonSchemaBaseFuncSig) buildSchema(schema types.BinaryJSON) (*types.JSONSchema, error) {
+	if schema.TypeCode != types.JSONTypeCodeObject {
+		return nil, types.ErrInvalidJSONType.GenWithStackByArgs(1, b.funcName, "object")
+	}
+	return types.ParseJSONSchema(schema)
+}
+
+func (b *jsonSchemaBaseFuncSig) getSchema(schema types.BinaryJSON) (*types.JSONSchema, error) {
+	if !b.args[0].ConstItem(b.ctx.GetSessionVars().StmtCtx) {
+		return b.buildSchema(schema)
+	}
+	b.once.Do(func() {
+		b.memorizedValue, b.memorizedErr = b.buildSchema(schema)
+	})
+	return b.memorizedValue, b.memorizedErr
+}
+
+// validate evaluates the arguments and validates the document against the schema.
+func (b *jsonSchemaBaseFuncSig) validate(row chunk.Row) (failure *types.JSONSchemaValidationError, isNull bool, err error) {
+	schema, isNull, err := b.args[0].EvalJSON(b.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	doc, isNull, err := b.args[1].EvalJSON(b.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	s, err := b.getSchema(schema)
+	if err != nil {
+		return nil, true, err
+	}
+	return s.Validate(doc), false, nil
+}
+
+func verifyJSONSchemaArgs(funcName string, args []Expression) error {
+	for i, arg := range args {
+		if evalType := arg.GetType().EvalType(); evalType != types.ETJson && evalType != types.ETString {
+			return ErrInvalidTypeForJSON.GenWithStackByArgs(i+1, funcName)
+		}
+	}
+	return nil
+}
+
+type jsonSchemaValidFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *jsonSchemaValidFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	if err := verifyJSONSchemaArgs(c.funcName, args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETJson, types.ETJson)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(1)
+	sig := &builtinJSONSchemaValidSig{jsonSchemaBaseFuncSig{baseBuiltinFunc: bf, funcName: c.funcName}}
+	return sig, nil
+}
+
+type builtinJSONSchemaValidSig struct {
+	jsonSchemaBaseFuncSig
+}
+
+func (b *builtinJSONSchemaValidSig) Clone() builtinFunc {
+	newSig := &builtinJSONSchemaValidSig{}
+	newSig.cloneFrom(&b.jsonSchemaBaseFuncSig)
+	return newSig
+}
+
+// evalInt evals a builtinJSONSchemaValidSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/json-validation-functions.html#function_json-schema-valid
+func (b *builtinJSONSchemaValidSig) evalInt(row chunk.Row) (res int64, isNull bool, err error) {
+	failure, isNull, err := b.validate(row)
+	if isNull || err != nil {
+		return res, isNull, err
+	}
+	return boolToInt64(failure == nil), false, nil
+}
+
+type jsonSchemaValidationReportFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *jsonSchemaValidationReportFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	if err := verifyJSONSchemaArgs(c.funcName, args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETJson, types.ETJson, types.ETJson)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinJSONSchemaValidationReportSig{jsonSchemaBaseFuncSig{baseBuiltinFunc: bf, funcName: c.funcName}}
+	return sig, nil
+}
+
+type builtinJSONSchemaValidationReportSig struct {
+	jsonSchemaBaseFuncSig
+}
+
+func (b *builtinJSONSchemaValidationReportSig) Clone() builtinFunc {
+	newSig := &builtinJSONSchemaValidationReportSig{}
+	newSig.cloneFrom(&b.jsonSchemaBaseFuncSig)
+	return newSig
+}
+
+// evalJSON evals a builtinJSONSchemaValidationReportSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/json-validation-functions.html#function_json-schema-validation-report
+func (b *builtinJSONSchemaValidationReportSig) evalJSON(row chunk.Row) (res types.BinaryJSON, isNull bool, err error) {
+	failure, isNull, err := b.validate(row)
+	if isNull || err != nil {
+		return res, isNull, err
+	}
+	return buildJSONSchemaValidationReport(failure), false, nil
+}
+
+func buildJSONSchemaValidationReport(failure *types.JSONSchemaValidationError) types.BinaryJSON {
+	if failure == nil {
+		return types.CreateBinaryJSON(map[string]interface{}{"valid": true})
+	}
+	return types.CreateBinaryJSON(map[string]interface{}{
+		"valid":                 false,
+		"reason":                failure.Reason(),
+		"schema-location":       failure.SchemaLocation,
+		"document-location":     failure.DocumentLocation,
+		"schema-failed-keyword": failure.Keyword,
+	})
+}
+
+// GetJSONSchemaValidationFailure returns why the row fails the validation if `expr` is a JSON_SCHEMA_VALID function,
+// it's used to report the details when a check constraint is violated.
+func GetJSONSchemaValidationFailure(expr Expression, row chunk.Row) *types.JSONSchemaValidationError {
+	sf, ok := expr.(*ScalarFunction)
+	if !ok {
+		return nil
+	}
+	sig, ok := sf.Function.(*builtinJSONSchemaValidSig)
+	if !ok {
+		return nil
+	}
+	failure, _, err := sig.validate(row)
+	if err != nil {
+		return nil
+	}
+	return failure
+}
@@ -1091,6 +1091,71 @@ func TestJSONValid(t *testing.T) {
 	}
 }
 
+func TestJSONSchemaValid(t *testing.T) {
+	ctx := createContext(t)
+	fc := funcs[ast.JSONSchemaValid]
+	tbl := []struct {
+		Input    []interface{}
+		Expected interface{}
+	}{
+		{[]interface{}{`{"type": "object", "required": ["a"]}`, `{"a": 1}`}, 1},
+		{[]interface{}{`{"type": "object", "required": ["a"]}`, `{"b": 1}`}, 0},
+		{[]interface{}{`{"properties": {"a": {"maximum": 10}}}`, `{"a": 11}`}, 0},
+		{[]interface{}{`{}`, `[1, 2]`}, 1},
+		{[]interface{}{nil, `{}`}, nil},
+		{[]interface{}{`{}`, nil}, nil},
+	}
+	for _, tt := range tbl {
+		args := types.MakeDatums(tt.Input...)
+		f, err := fc.getFunction(ctx, datumsToConstants(args))
+		require.NoError(t, err)
+		d, err := evalBuiltinFunc(f, chunk.Row{})
+		require.NoError(t, err)
+		testutil.DatumEqual(t, types.NewDatum(tt.Expected), d)
+	}
+
+	// the schema must be an object
+	f, err := fc.getFunction(ctx, datumsToConstants(types.MakeDatums(`[]`, `{}`)))
+	require.NoError(t, err)
+	_, err = evalBuiltinFunc(f, chunk.Row{})
+	require.True(t, types.ErrInvalidJSONType.Equal(err))
+	f, err = fc.getFunction(ctx, datumsToConstants(types.MakeDatums(`{"a"`, `{}`)))
+	require.NoError(t, err)
+	_, err = evalBuiltinFunc(f, chunk.Row{})
+	require.True(t, types.ErrInvalidJSONText.Equal(err))
+	_, err = fc.getFunction(ctx, datumsToConstants(types.MakeDatums(1, `{}`)))
+	require.True(t, ErrInvalidTypeForJSON.Equal(err))
+}
+
+func TestJSONSchemaValidationReport(t *testing.T) {
+	ctx := createContext(t)
+	fc := funcs[ast.JSONSchemaValidationReport]
+	tbl := []struct {
+		Input    []interface{}
+		Expected interface{}
+	}{
+		{[]interface{}{`{"type": "object"}`, `{}`}, `{"valid": true}`},
+		{[]interface{}{`{"properties": {"a": {"maximum": 10}}}`, `{"a": 11}`}, `{"valid": false, ` +
+			`"reason": "The JSON document location '#/a' failed requirement 'maximum' at JSON Schema location '#/properties/a'", ` +
+			`"schema-location": "#/properties/a", "document-location": "#/a", "schema-failed-keyword": "maximum"}`},
+		{[]interface{}{`{}`, nil}, nil},
+	}
+	for _, tt := range tbl {
+		args := types.MakeDatums(tt.Input...)
+		f, err := fc.getFunction(ctx, datumsToConstants(args))
+		require.NoError(t, err)
+		d, err := evalBuiltinFunc(f, chunk.Row{})
+		require.NoError(t, err)
+		if tt.Expected == nil {
+			require.True(t, d.IsNull())
+			continue
+		}
+		expected, err := types.ParseBinaryJSONFromString(tt.Expected.(string))
+		require.NoError(t, err)
+		require.Equal(t, 0, types.CompareBinaryJSON(expected, d.GetMysqlJSON()))
+	}
+}
+
 func TestJSONStorageFree(t *testing.T) {
 	ctx := createContext(t)
 	fc := funcs[ast.JSONStorageFree]
@@ -1395,3 +1395,82 @@ func (b *builtinJSONMergePatchSig) vecEvalJSON(input *chunk.Chunk, result *chunk
 
 	return nil
 }
+
+// vecValidate evaluates the arguments and validates the documents against the schemas.
+func (b *jsonSchemaBaseFuncSig) vecValidate(input *chunk.Chunk) (failures []*types.JSONSchemaValidationError, nulls []bool, err error) {
+	nr := input.NumRows()
+
+	schemaCol, err := b.bufAllocator.get()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer b.bufAllocator.put(schemaCol)
+	if err := b.args[0].VecEvalJSON(b.ctx, input, schemaCol); err != nil {
+		return nil, nil, err
+	}
+
+	docCol, err := b.bufAllocator.get()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer b.bufAllocator.put(docCol)
+	if err := b.args[1].VecEvalJSON(b.ctx, input, docCol); err != nil {
+		return nil, nil, err
+	}
+
+	failures = make([]*types.JSONSchemaValidationError, nr)
+	nulls = make([]bool, nr)
+	for i := 0; i < nr; i++ {
+		if schemaCol.IsNull(i) || docCol.IsNull(i) {
+			nulls[i] = true
+			continue
+		}
+		s, err := b.getSchema(schemaCol.GetJSON(i))
+		if err != nil {
+			return nil, nil, err
+		}
+		failures[i] = s.Validate(docCol.GetJSON(i))
+	}
+	return failures, nulls, nil
+}
+
+func (b *builtinJSONSchemaValidSig) vectorized() bool {
+	return true
+}
+
+func (b *builtinJSONSchemaValidSig) vecEvalInt(input *chunk.Chunk, result *chunk.Column) error {
+	failures, nulls, err := b.vecValidate(input)
+	if err != nil {
+		return err
+	}
+	result.ResizeInt64(input.NumRows(), false)
+	resI64s := result.Int64s()
+	for i, failure := range failures {
+		if nulls[i] {
+			result.SetNull(i, true)
+			continue
+		}
+		resI64s[i] = boolToInt64(failure == nil)
+	}
+	return nil
+}
+
+func (b *builtinJSONSchemaValidationReportSig) vectorized() bool {
+	return true
+}
+
+func (b *builtinJSONSchemaValidationReportSig) vecEvalJSON(input *chunk.Chunk, result *chunk.Column) error {
+	failures, nulls, err := b.vecValidate(input)
+	if err != nil {
+		return err
+	}
+	result.ReserveJSON(input.NumRows())
+	for i, failure := range failures {
+		if nulls[i] {
+			result.AppendNull()
+			continue
+		}
+		result.AppendJSON(buildJSONSchemaValidationReport(failure))
+	}
+	return nil
+}
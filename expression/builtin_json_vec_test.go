@@ -138,6 +138,13 @@ var vecBuiltinJSONCases = map[string][]vecExprBenchCase{
 	ast.JSONQuote: {
 		{retEvalType: types.ETString, childrenTypes: []types.EvalType{types.ETString}},
 	},
+	ast.JSONSchemaValid: {
+		{retEvalType: types.ETInt, childrenTypes: []types.EvalType{types.ETJson, types.ETJson}, geners: []dataGenerator{&constJSONGener{"{\"type\": \"object\"}"}, nil}},
+		{retEvalType: types.ETInt, childrenTypes: []types.EvalType{types.ETJson, types.ETJson}, geners: []dataGenerator{newNullWrappedGener(0.1, &constJSONGener{"{\"required\": [\"a\"]}"}), newNullWrappedGener(0.1, &constJSONGener{"{\"a\": 1}"})}},
+	},
+	ast.JSONSchemaValidationReport: {
+		{retEvalType: types.ETJson, childrenTypes: []types.EvalType{types.ETJson, types.ETJson}, geners: []dataGenerator{&constJSONGener{"{\"maxProperties\": 1}"}, nil}},
+	},
 }
 
 func TestVectorizedBuiltinJSONFunc(t *testing.T) {
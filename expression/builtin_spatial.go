@@ -0,0 +1,745 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"math"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/hack"
+)
+
+var (
+	_ functionClass = &pointFunctionClass{}
+	_ functionClass = &geomFromTextFunctionClass{}
+	_ functionClass = &geomFromWKBFunctionClass{}
+	_ functionClass = &geometryAsTextFunctionClass{}
+	_ functionClass = &geometryAsBinaryFunctionClass{}
+	_ functionClass = &geometryTypeFunctionClass{}
+	_ functionClass = &geometrySRIDFunctionClass{}
+	_ functionClass = &geometryIsEmptyFunctionClass{}
+	_ functionClass = &geometryCoordinateFunctionClass{}
+	_ functionClass = &geometryNumPointsFunctionClass{}
+	_ functionClass = &geometryRelationFunctionClass{}
+	_ functionClass = &geometryDistanceFunctionClass{}
+	_ functionClass = &geometryDistanceSphereFunctionClass{}
+	_ functionClass = &geometryMeasureFunctionClass{}
+)
+
+var (
+	_ builtinFunc = &builtinPointSig{}
+	_ builtinFunc = &builtinGeomFromTextSig{}
+	_ builtinFunc = &builtinGeomFromWKBSig{}
+	_ builtinFunc = &builtinGeometryAsTextSig{}
+	_ builtinFunc = &builtinGeometryAsBinarySig{}
+	_ builtinFunc = &builtinGeometryTypeSig{}
+	_ builtinFunc = &builtinGeometrySRIDSig{}
+	_ builtinFunc = &builtinGeometrySetSRIDSig{}
+	_ builtinFunc = &builtinGeometryIsEmptySig{}
+	_ builtinFunc = &builtinGeometryCoordinateSig{}
+	_ builtinFunc = &builtinGeometryNumPointsSig{}
+	_ builtinFunc = &builtinGeometryRelationSig{}
+	_ builtinFunc = &builtinGeometryDistanceSig{}
+	_ builtinFunc = &builtinGeometryDistanceSphereSig{}
+	_ builtinFunc = &builtinGeometryMeasureSig{}
+)
+
+// setGeometryRetType sets the return type of a function which returns a geometry.
+func setGeometryRetType(tp *types.FieldType, geometryType byte) {
+	tp.SetType(mysql.TypeGeometry)
+	tp.SetGeometryType(geometryType)
+	tp.SetFlen(mysql.MaxBlobWidth)
+	types.SetBinChsClnFlag(tp)
+}
+
+// evalGeometry evaluates the argument and parses it as a geometry.
+func evalGeometry(ctx sessionctx.Context, arg Expression, row chunk.Row, funcName string) (types.Geometry, bool, error) {
+	s, isNull, err := arg.EvalString(ctx, row)
+	if isNull || err != nil {
+		return types.Geometry{}, isNull, err
+	}
+	g, err := types.ParseGeometry(hack.Slice(s), funcName)
+	return g, false, err
+}
+
+// evalGeometrySRID evaluates the SRID argument.
+func evalGeometrySRID(ctx sessionctx.Context, arg Expression, row chunk.Row, funcName string) (uint32, bool, error) {
+	srid, isNull, err := arg.EvalInt(ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if srid < 0 || srid > math.MaxUint32 {
+		return 0, false, types.ErrOverflow.GenWithStackByArgs("SRID", funcName)
+	}
+	return uint32(srid), false, nil
+}
+
+type pointFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *pointFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETReal, types.ETReal)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(bf.tp, mysql.GeometryTypePoint)
+	sig := &builtinPointSig{bf}
+	return sig, nil
+}
+
+type builtinPointSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinPointSig) Clone() builtinFunc {
+	newSig := &builtinPointSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals a builtinPointSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-mysql-specific-functions.html#function_point
+func (b *builtinPointSig) evalString(row chunk.Row) (string, bool, error) {
+	x, isNull, err := b.args[0].EvalReal(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	y, isNull, err := b.args[1].EvalReal(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return string(types.NewGeometryPoint(types.GeometrySRIDCartesian, x, y).Encode()), false, nil
+}
+
+type geomFromTextFunctionClass struct {
+	baseFunctionClass
+	// geometryType is the expected type of the geometry, mysql.GeometryTypeGeometry means any type.
+	geometryType byte
+}
+
+func (c *geomFromTextFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString, types.ETInt}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps[:len(args)]...)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(bf.tp, c.geometryType)
+	sig := &builtinGeomFromTextSig{bf, c.funcName, c.geometryType}
+	return sig, nil
+}
+
+type builtinGeomFromTextSig struct {
+	baseBuiltinFunc
+	funcName     string
+	geometryType byte
+}
+
+func (b *builtinGeomFromTextSig) Clone() builtinFunc {
+	newSig := &builtinGeomFromTextSig{funcName: b.funcName, geometryType: b.geometryType}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals a builtinGeomFromTextSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-wkt-functions.html#function_st-geomfromtext
+func (b *builtinGeomFromTextSig) evalString(row chunk.Row) (string, bool, error) {
+	wkt, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	srid := types.GeometrySRIDCartesian
+	if len(b.args) > 1 {
+		if srid, isNull, err = evalGeometrySRID(b.ctx, b.args[1], row, b.funcName); isNull || err != nil {
+			return "", isNull, err
+		}
+	}
+	g, err := types.ParseGeometryFromWKT(wkt, srid, b.funcName)
+	if err != nil {
+		return "", false, err
+	}
+	if b.geometryType != mysql.GeometryTypeGeometry && g.Type != b.geometryType {
+		return "", false, types.ErrGISInvalidData.GenWithStackByArgs(b.funcName)
+	}
+	return string(g.Encode()), false, nil
+}
+
+type geomFromWKBFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geomFromWKBFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString, types.ETInt}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps[:len(args)]...)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(bf.tp, mysql.GeometryTypeGeometry)
+	sig := &builtinGeomFromWKBSig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeomFromWKBSig struct {
+	baseBuiltinFunc
+	funcName string
+}
+
+func (b *builtinGeomFromWKBSig) Clone() builtinFunc {
+	newSig := &builtinGeomFromWKBSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals a builtinGeomFromWKBSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-wkb-functions.html#function_st-geomfromwkb
+func (b *builtinGeomFromWKBSig) evalString(row chunk.Row) (string, bool, error) {
+	wkb, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	srid := types.GeometrySRIDCartesian
+	if len(b.args) > 1 {
+		if srid, isNull, err = evalGeometrySRID(b.ctx, b.args[1], row, b.funcName); isNull || err != nil {
+			return "", isNull, err
+		}
+	}
+	g, err := types.ParseGeometryFromWKB(hack.Slice(wkb), srid, b.funcName)
+	if err != nil {
+		return "", false, err
+	}
+	return string(g.Encode()), false, nil
+}
+
+type geometryAsTextFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geometryAsTextFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	charset, collate := ctx.GetSessionVars().GetCharsetInfo()
+	bf.tp.SetCharset(charset)
+	bf.tp.SetCollate(collate)
+	bf.tp.DelFlag(mysql.BinaryFlag)
+	bf.tp.SetFlen(mysql.MaxBlobWidth)
+	sig := &builtinGeometryAsTextSig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeometryAsTextSig struct {
+	baseBuiltinFunc
+	funcName string
+}
+
+func (b *builtinGeometryAsTextSig) Clone() builtinFunc {
+	newSig := &builtinGeometryAsTextSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals a builtinGeometryAsTextSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-format-conversion-functions.html#function_st-astext
+func (b *builtinGeometryAsTextSig) evalString(row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return g.String(), false, nil
+}
+
+type geometryAsBinaryFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geometryAsBinaryFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	types.SetBinChsClnFlag(bf.tp)
+	bf.tp.SetFlen(mysql.MaxBlobWidth)
+	sig := &builtinGeometryAsBinarySig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeometryAsBinarySig struct {
+	baseBuiltinFunc
+	funcName string
+}
+
+func (b *builtinGeometryAsBinarySig) Clone() builtinFunc {
+	newSig := &builtinGeometryAsBinarySig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals a builtinGeometryAsBinarySig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-format-conversion-functions.html#function_st-asbinary
+func (b *builtinGeometryAsBinarySig) evalString(row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return string(g.WKB()), false, nil
+}
+
+type geometryTypeFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geometryTypeFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	charset, collate := ctx.GetSessionVars().GetCharsetInfo()
+	bf.tp.SetCharset(charset)
+	bf.tp.SetCollate(collate)
+	bf.tp.DelFlag(mysql.BinaryFlag)
+	bf.tp.SetFlen(len("GEOMCOLLECTION"))
+	sig := &builtinGeometryTypeSig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeometryTypeSig struct {
+	baseBuiltinFunc
+	funcName string
+}
+
+func (b *builtinGeometryTypeSig) Clone() builtinFunc {
+	newSig := &builtinGeometryTypeSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals a builtinGeometryTypeSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-geometrytype
+func (b *builtinGeometryTypeSig) evalString(row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return g.TypeName(), false, nil
+}
+
+type geometrySRIDFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geometrySRIDFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	if len(args) == 2 {
+		bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString, types.ETInt)
+		if err != nil {
+			return nil, err
+		}
+		setGeometryRetType(bf.tp, args[0].GetType().GetGeometryType())
+		sig := &builtinGeometrySetSRIDSig{bf, c.funcName}
+		return sig, nil
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.AddFlag(mysql.UnsignedFlag)
+	bf.tp.SetFlen(10)
+	sig := &builtinGeometrySRIDSig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeometrySRIDSig struct {
+	baseBuiltinFunc
+	funcName string
+}
+
+func (b *builtinGeometrySRIDSig) Clone() builtinFunc {
+	newSig := &builtinGeometrySRIDSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinGeometrySRIDSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-srid
+func (b *builtinGeometrySRIDSig) evalInt(row chunk.Row) (int64, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	return int64(g.SRID), false, nil
+}
+
+type builtinGeometrySetSRIDSig struct {
+	baseBuiltinFunc
+	funcName string
+}
+
+func (b *builtinGeometrySetSRIDSig) Clone() builtinFunc {
+	newSig := &builtinGeometrySetSRIDSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals a builtinGeometrySetSRIDSig, which changes the SRID of the geometry without transforming
+// its coordinates.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-srid
+func (b *builtinGeometrySetSRIDSig) evalString(row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	srid, isNull, err := evalGeometrySRID(b.ctx, b.args[1], row, b.funcName)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	data := g.Encode()
+	data[0], data[1], data[2], data[3] = byte(srid), byte(srid>>8), byte(srid>>16), byte(srid>>24)
+	// Parse it again to check the spatial reference system and the ranges of the coordinates.
+	if _, err = types.ParseGeometry(data, b.funcName); err != nil {
+		return "", false, err
+	}
+	return string(data), false, nil
+}
+
+type geometryIsEmptyFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geometryIsEmptyFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(1)
+	sig := &builtinGeometryIsEmptySig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeometryIsEmptySig struct {
+	baseBuiltinFunc
+	funcName string
+}
+
+func (b *builtinGeometryIsEmptySig) Clone() builtinFunc {
+	newSig := &builtinGeometryIsEmptySig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinGeometryIsEmptySig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-isempty
+func (b *builtinGeometryIsEmptySig) evalInt(row chunk.Row) (int64, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	return boolToInt64(g.IsEmpty()), false, nil
+}
+
+type geometryCoordinateFunctionClass struct {
+	baseFunctionClass
+	// isY indicates whether the second coordinate is returned.
+	isY bool
+}
+
+func (c *geometryCoordinateFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeometryCoordinateSig{bf, c.funcName, c.isY}
+	return sig, nil
+}
+
+type builtinGeometryCoordinateSig struct {
+	baseBuiltinFunc
+	funcName string
+	isY      bool
+}
+
+func (b *builtinGeometryCoordinateSig) Clone() builtinFunc {
+	newSig := &builtinGeometryCoordinateSig{funcName: b.funcName, isY: b.isY}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals a builtinGeometryCoordinateSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-point-property-functions.html#function_st-x
+func (b *builtinGeometryCoordinateSig) evalReal(row chunk.Row) (float64, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if g.Type != mysql.GeometryTypePoint {
+		return 0, false, types.ErrGISUnsupportedArgument.GenWithStackByArgs(b.funcName)
+	}
+	if b.isY {
+		return g.Y(), false, nil
+	}
+	return g.X(), false, nil
+}
+
+type geometryNumPointsFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geometryNumPointsFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeometryNumPointsSig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeometryNumPointsSig struct {
+	baseBuiltinFunc
+	funcName string
+}
+
+func (b *builtinGeometryNumPointsSig) Clone() builtinFunc {
+	newSig := &builtinGeometryNumPointsSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinGeometryNumPointsSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-linestring-property-functions.html#function_st-numpoints
+func (b *builtinGeometryNumPointsSig) evalInt(row chunk.Row) (int64, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if g.Type != mysql.GeometryTypeLineString {
+		return 0, false, types.ErrGISUnsupportedArgument.GenWithStackByArgs(b.funcName)
+	}
+	return int64(g.NumPoints()), false, nil
+}
+
+type geometryRelationFunctionClass struct {
+	baseFunctionClass
+	relation types.GeometryRelation
+}
+
+func (c *geometryRelationFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(1)
+	sig := &builtinGeometryRelationSig{bf, c.funcName, c.relation}
+	return sig, nil
+}
+
+type builtinGeometryRelationSig struct {
+	baseBuiltinFunc
+	funcName string
+	relation types.GeometryRelation
+}
+
+func (b *builtinGeometryRelationSig) Clone() builtinFunc {
+	newSig := &builtinGeometryRelationSig{funcName: b.funcName, relation: b.relation}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinGeometryRelationSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-relation-functions-object-shapes.html
+func (b *builtinGeometryRelationSig) evalInt(row chunk.Row) (int64, bool, error) {
+	g1, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	g2, isNull, err := evalGeometry(b.ctx, b.args[1], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	res, err := types.GeometryRelate(g1, g2, b.relation, b.funcName)
+	return boolToInt64(res), false, err
+}
+
+type geometryDistanceFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geometryDistanceFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeometryDistanceSig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeometryDistanceSig struct {
+	baseBuiltinFunc
+	funcName string
+}
+
+func (b *builtinGeometryDistanceSig) Clone() builtinFunc {
+	newSig := &builtinGeometryDistanceSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals a builtinGeometryDistanceSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-relation-functions-object-shapes.html#function_st-distance
+func (b *builtinGeometryDistanceSig) evalReal(row chunk.Row) (float64, bool, error) {
+	g1, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	g2, isNull, err := evalGeometry(b.ctx, b.args[1], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	res, err := types.GeometryDistance(g1, g2, b.funcName)
+	return res, false, err
+}
+
+type geometryDistanceSphereFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *geometryDistanceSphereFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString, types.ETString, types.ETReal}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, argTps[:len(args)]...)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeometryDistanceSphereSig{bf, c.funcName}
+	return sig, nil
+}
+
+type builtinGeometryDistanceSphereSig struct {
+	baseBuiltinFunc
+	funcName string
+}
+
+func (b *builtinGeometryDistanceSphereSig) Clone() builtinFunc {
+	newSig := &builtinGeometryDistanceSphereSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals a builtinGeometryDistanceSphereSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/spatial-convenience-functions.html#function_st-distance-sphere
+func (b *builtinGeometryDistanceSphereSig) evalReal(row chunk.Row) (float64, bool, error) {
+	g1, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	g2, isNull, err := evalGeometry(b.ctx, b.args[1], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	radius := types.DefaultSphereRadius
+	if len(b.args) > 2 {
+		if radius, isNull, err = b.args[2].EvalReal(b.ctx, row); isNull || err != nil {
+			return 0, isNull, err
+		}
+	}
+	res, err := types.GeometryDistanceSphere(g1, g2, radius, b.funcName)
+	return res, false, err
+}
+
+type geometryMeasureFunctionClass struct {
+	baseFunctionClass
+	// isArea indicates whether the area is measured, otherwise the length is measured.
+	isArea bool
+}
+
+func (c *geometryMeasureFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinGeometryMeasureSig{bf, c.funcName, c.isArea}
+	return sig, nil
+}
+
+type builtinGeometryMeasureSig struct {
+	baseBuiltinFunc
+	funcName string
+	isArea   bool
+}
+
+func (b *builtinGeometryMeasureSig) Clone() builtinFunc {
+	newSig := &builtinGeometryMeasureSig{funcName: b.funcName, isArea: b.isArea}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals a builtinGeometryMeasureSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/gis-polygon-property-functions.html#function_st-area
+// and https://dev.mysql.com/doc/refman/8.0/en/gis-linestring-property-functions.html#function_st-length
+func (b *builtinGeometryMeasureSig) evalReal(row chunk.Row) (float64, bool, error) {
+	g, isNull, err := evalGeometry(b.ctx, b.args[0], row, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	var res float64
+	if b.isArea {
+		res, err = g.Area(b.funcName)
+	} else {
+		res, err = g.Length(b.funcName)
+	}
+	return res, false, err
+}
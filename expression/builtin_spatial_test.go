@@ -0,0 +1,196 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/testkit/testutil"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/stretchr/testify/require"
+)
+
+func newGeometryFromTextForTest(t *testing.T, ctx sessionctx.Context, args ...interface{}) Expression {
+	f, err := newFunctionForTest(ctx, ast.STGeomFromText, datumsToConstants(types.MakeDatums(args...))...)
+	require.NoError(t, err)
+	return f
+}
+
+func TestGeometryFromText(t *testing.T) {
+	ctx := createContext(t)
+	tbl := []struct {
+		funcName string
+		Input    []interface{}
+		Expected interface{}
+		Err      *terror.Error
+	}{
+		{ast.STGeomFromText, []interface{}{"POINT(1 2)"}, "POINT(1 2)", nil},
+		{ast.STGeometryFromText, []interface{}{"LINESTRING(0 0, 1 1)"}, "LINESTRING(0 0,1 1)", nil},
+		{ast.STPointFromText, []interface{}{"POINT(30 120)", 4326}, "POINT(30 120)", nil},
+		{ast.STLineFromText, []interface{}{"LINESTRING(0 0,1 1)", 0}, "LINESTRING(0 0,1 1)", nil},
+		{ast.STPolyFromText, []interface{}{"POLYGON((0 0,1 0,1 1,0 0))"}, "POLYGON((0 0,1 0,1 1,0 0))", nil},
+		{ast.STGeomFromText, []interface{}{nil}, nil, nil},
+		{ast.STGeomFromText, []interface{}{"POINT(1 2)", nil}, nil, nil},
+		{ast.STPointFromText, []interface{}{"LINESTRING(0 0,1 1)"}, nil, types.ErrGISInvalidData},
+		{ast.STGeomFromText, []interface{}{"POINT(1)"}, nil, types.ErrGISInvalidData},
+		{ast.STGeomFromText, []interface{}{"POINT(1 2)", 3}, nil, types.ErrSRSNotFound},
+		{ast.STGeomFromText, []interface{}{"POINT(1 2)", -1}, nil, types.ErrOverflow},
+		{ast.STGeomFromText, []interface{}{"POINT(100 0)", 4326}, nil, types.ErrLatitudeOutOfRange},
+	}
+	for _, tt := range tbl {
+		g, err := newFunctionForTest(ctx, tt.funcName, datumsToConstants(types.MakeDatums(tt.Input...))...)
+		require.NoError(t, err)
+		require.Equal(t, mysql.TypeGeometry, g.GetType().GetType())
+		f, err := newFunctionForTest(ctx, ast.STAsText, g)
+		require.NoError(t, err)
+		d, err := f.Eval(chunk.Row{})
+		if tt.Err != nil {
+			require.True(t, tt.Err.Equal(err), "%v %v", tt.Input, err)
+			continue
+		}
+		require.NoError(t, err)
+		testutil.DatumEqual(t, types.NewDatum(tt.Expected), d)
+	}
+
+	g, err := newFunctionForTest(ctx, ast.STPolygonFromText, datumsToConstants(types.MakeDatums("POLYGON((0 0,1 0,1 1,0 0))"))...)
+	require.NoError(t, err)
+	require.Equal(t, mysql.GeometryTypePolygon, g.GetType().GetGeometryType())
+	require.Equal(t, "polygon", g.GetType().CompactStr())
+}
+
+func TestGeometryWKB(t *testing.T) {
+	ctx := createContext(t)
+	// POINT(1 -1) in big-endian WKB.
+	wkb := "\x00\x00\x00\x00\x01\x3F\xF0\x00\x00\x00\x00\x00\x00\xBF\xF0\x00\x00\x00\x00\x00\x00"
+	g, err := newFunctionForTest(ctx, ast.STGeomFromWKB, datumsToConstants(types.MakeDatums(wkb))...)
+	require.NoError(t, err)
+	f, err := newFunctionForTest(ctx, ast.STAsBinary, g)
+	require.NoError(t, err)
+	d, err := f.Eval(chunk.Row{})
+	require.NoError(t, err)
+	require.Equal(t, "\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\xF0\x3F\x00\x00\x00\x00\x00\x00\xF0\xBF", d.GetString())
+	require.Equal(t, mysql.TypeLongBlob, f.GetType().GetType())
+
+	f, err = newFunctionForTest(ctx, ast.STGeomFromWKB, datumsToConstants(types.MakeDatums("abc"))...)
+	require.NoError(t, err)
+	_, err = f.Eval(chunk.Row{})
+	require.True(t, types.ErrGISInvalidData.Equal(err))
+}
+
+func TestGeometryProperties(t *testing.T) {
+	ctx := createContext(t)
+	tbl := []struct {
+		funcName string
+		wkt      string
+		Expected interface{}
+	}{
+		{ast.STGeometryType, "POINT(1 2)", "POINT"},
+		{ast.STGeometryType, "GEOMETRYCOLLECTION(POINT(1 2))", "GEOMCOLLECTION"},
+		{ast.STSRID, "POINT(1 2)", int64(0)},
+		{ast.STIsEmpty, "GEOMETRYCOLLECTION EMPTY", int64(1)},
+		{ast.STIsEmpty, "POINT(1 2)", int64(0)},
+		{ast.STX, "POINT(1 2)", float64(1)},
+		{ast.STY, "POINT(1 2)", float64(2)},
+		{ast.STNumPoints, "LINESTRING(0 0,1 1,2 2)", int64(3)},
+		{ast.STArea, "POLYGON((0 0,2 0,2 2,0 2,0 0))", float64(4)},
+		{ast.STLength, "LINESTRING(0 0,3 4)", float64(5)},
+	}
+	for _, tt := range tbl {
+		f, err := newFunctionForTest(ctx, tt.funcName, newGeometryFromTextForTest(t, ctx, tt.wkt))
+		require.NoError(t, err)
+		d, err := f.Eval(chunk.Row{})
+		require.NoError(t, err)
+		testutil.DatumEqual(t, types.NewDatum(tt.Expected), d, tt.funcName)
+	}
+
+	f, err := newFunctionForTest(ctx, ast.STX, newGeometryFromTextForTest(t, ctx, "LINESTRING(0 0,1 1)"))
+	require.NoError(t, err)
+	_, err = f.Eval(chunk.Row{})
+	require.True(t, types.ErrGISUnsupportedArgument.Equal(err))
+
+	// ST_SRID with two arguments changes the SRID.
+	g, err := newFunctionForTest(ctx, ast.STSRID, newGeometryFromTextForTest(t, ctx, "POINT(1 2)"), datumsToConstants(types.MakeDatums(4326))[0])
+	require.NoError(t, err)
+	require.Equal(t, mysql.TypeGeometry, g.GetType().GetType())
+	f, err = newFunctionForTest(ctx, ast.STSRID, g)
+	require.NoError(t, err)
+	d, err := f.Eval(chunk.Row{})
+	require.NoError(t, err)
+	require.Equal(t, int64(4326), d.GetInt64())
+	g, err = newFunctionForTest(ctx, ast.STSRID, newGeometryFromTextForTest(t, ctx, "POINT(1 200)"), datumsToConstants(types.MakeDatums(4326))[0])
+	require.NoError(t, err)
+	_, err = g.Eval(chunk.Row{})
+	require.True(t, types.ErrLatitudeOutOfRange.Equal(err))
+}
+
+func TestGeometryRelations(t *testing.T) {
+	ctx := createContext(t)
+	const square = "POLYGON((0 0,4 0,4 4,0 4,0 0))"
+	tbl := []struct {
+		funcName string
+		g1, g2   string
+		Expected int64
+	}{
+		{ast.STContains, square, "POINT(1 1)", 1},
+		{ast.STContains, square, "POINT(5 5)", 0},
+		{ast.STWithin, "POINT(1 1)", square, 1},
+		{ast.STIntersects, square, "LINESTRING(3 3,5 5)", 1},
+		{ast.STDisjoint, square, "LINESTRING(3 3,5 5)", 0},
+		{ast.STEquals, "POINT(1 1)", "MULTIPOINT(1 1)", 1},
+	}
+	for _, tt := range tbl {
+		f, err := newFunctionForTest(ctx, tt.funcName, newGeometryFromTextForTest(t, ctx, tt.g1), newGeometryFromTextForTest(t, ctx, tt.g2))
+		require.NoError(t, err)
+		require.True(t, mysql.HasIsBooleanFlag(f.GetType().GetFlag()))
+		d, err := f.Eval(chunk.Row{})
+		require.NoError(t, err)
+		require.Equal(t, tt.Expected, d.GetInt64(), "%s %s %s", tt.funcName, tt.g1, tt.g2)
+	}
+
+	f, err := newFunctionForTest(ctx, ast.STContains, newGeometryFromTextForTest(t, ctx, square), newGeometryFromTextForTest(t, ctx, "POINT(1 1)", 4326))
+	require.NoError(t, err)
+	_, err = f.Eval(chunk.Row{})
+	require.True(t, types.ErrGISDifferentSRIDs.Equal(err))
+}
+
+func TestGeometryDistance(t *testing.T) {
+	ctx := createContext(t)
+	point := func(x, y float64) Expression {
+		f, err := newFunctionForTest(ctx, ast.Point, datumsToConstants(types.MakeDatums(x, y))...)
+		require.NoError(t, err)
+		return f
+	}
+	f, err := newFunctionForTest(ctx, ast.STDistance, point(0, 0), point(3, 4))
+	require.NoError(t, err)
+	d, err := f.Eval(chunk.Row{})
+	require.NoError(t, err)
+	require.Equal(t, float64(5), d.GetFloat64())
+
+	f, err = newFunctionForTest(ctx, ast.STDistanceSphere, point(0, 0), point(0, 90), datumsToConstants(types.MakeDatums(2))[0])
+	require.NoError(t, err)
+	d, err = f.Eval(chunk.Row{})
+	require.NoError(t, err)
+	require.InDelta(t, 3.141592653589793, d.GetFloat64(), 1e-12)
+
+	f, err = newFunctionForTest(ctx, ast.STDistanceSphere, point(0, 0), point(0, 90), datumsToConstants(types.MakeDatums(-1))[0])
+	require.NoError(t, err)
+	_, err = f.Eval(chunk.Row{})
+	require.True(t, types.ErrNonpositiveRadius.Equal(err))
+}
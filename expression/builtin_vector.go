@@ -0,0 +1,347 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"math"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/hack"
+)
+
+var (
+	_ functionClass = &vecDistanceFunctionClass{}
+	_ functionClass = &vecDimsFunctionClass{}
+	_ functionClass = &vecL2NormFunctionClass{}
+	_ functionClass = &vecFromTextFunctionClass{}
+	_ functionClass = &vecAsTextFunctionClass{}
+	_ functionClass = &castAsVectorFunctionClass{}
+)
+
+var (
+	_ builtinFunc = &builtinVecDistanceSig{}
+	_ builtinFunc = &builtinVecDimsSig{}
+	_ builtinFunc = &builtinVecL2NormSig{}
+	_ builtinFunc = &builtinVecFromTextSig{}
+	_ builtinFunc = &builtinVecAsTextSig{}
+	_ builtinFunc = &builtinCastAsVectorSig{}
+)
+
+// vecDistanceFuncs are the distance functions of the vector distance builtins.
+var vecDistanceFuncs = map[string]types.VectorDistanceFunc{
+	ast.VecCosineDistance:       types.VectorCosineDistance,
+	ast.VecL1Distance:           types.VectorL1Distance,
+	ast.VecL2Distance:           types.VectorL2Distance,
+	ast.VecNegativeInnerProduct: types.VectorNegativeInnerProduct,
+}
+
+// evalVector evaluates the argument as a vector. The values of the vector type and the binary strings
+// are in the internal format, and the other strings are parsed as the text representation.
+func evalVector(ctx sessionctx.Context, arg Expression, row chunk.Row) (types.Vector, bool, error) {
+	s, isNull, err := arg.EvalString(ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	tp := arg.GetType()
+	if tp.GetType() == mysql.TypeVector || tp.GetCharset() == charset.CharsetBin {
+		v, err := types.ParseVectorBinary(hack.Slice(s))
+		return v, false, err
+	}
+	v, err := types.ParseVectorText(s)
+	return v, false, err
+}
+
+type vecDistanceFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *vecDistanceFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinVecDistanceSig{bf, c.funcName, vecDistanceFuncs[c.funcName]}
+	return sig, nil
+}
+
+type builtinVecDistanceSig struct {
+	baseBuiltinFunc
+	funcName string
+	distance types.VectorDistanceFunc
+}
+
+func (b *builtinVecDistanceSig) Clone() builtinFunc {
+	newSig := &builtinVecDistanceSig{funcName: b.funcName, distance: b.distance}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals a builtinVecDistanceSig.
+// The cosine distance involving a zero vector is NULL.
+func (b *builtinVecDistanceSig) evalReal(row chunk.Row) (float64, bool, error) {
+	v1, isNull, err := evalVector(b.ctx, b.args[0], row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	v2, isNull, err := evalVector(b.ctx, b.args[1], row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if len(v1) != len(v2) {
+		return 0, false, types.ErrVectorDifferentDims.GenWithStackByArgs(len(v1), len(v2), b.funcName)
+	}
+	res := b.distance(v1, v2)
+	if math.IsNaN(res) {
+		return 0, true, nil
+	}
+	return res, false, nil
+}
+
+type vecDimsFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *vecDimsFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(5)
+	sig := &builtinVecDimsSig{bf}
+	return sig, nil
+}
+
+type builtinVecDimsSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinVecDimsSig) Clone() builtinFunc {
+	newSig := &builtinVecDimsSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinVecDimsSig.
+func (b *builtinVecDimsSig) evalInt(row chunk.Row) (int64, bool, error) {
+	v, isNull, err := evalVector(b.ctx, b.args[0], row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	return int64(len(v)), false, nil
+}
+
+type vecL2NormFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *vecL2NormFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinVecL2NormSig{bf}
+	return sig, nil
+}
+
+type builtinVecL2NormSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinVecL2NormSig) Clone() builtinFunc {
+	newSig := &builtinVecL2NormSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals a builtinVecL2NormSig.
+func (b *builtinVecL2NormSig) evalReal(row chunk.Row) (float64, bool, error) {
+	v, isNull, err := evalVector(b.ctx, b.args[0], row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	return v.L2Norm(), false, nil
+}
+
+type vecFromTextFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *vecFromTextFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetType(mysql.TypeVector)
+	bf.tp.SetFlen(types.UnspecifiedLength)
+	types.SetBinChsClnFlag(bf.tp)
+	sig := &builtinVecFromTextSig{bf}
+	return sig, nil
+}
+
+type builtinVecFromTextSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinVecFromTextSig) Clone() builtinFunc {
+	newSig := &builtinVecFromTextSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals a builtinVecFromTextSig.
+func (b *builtinVecFromTextSig) evalString(row chunk.Row) (string, bool, error) {
+	s, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	v, err := types.ParseVectorText(s)
+	if err != nil {
+		return "", false, err
+	}
+	return string(v.Encode()), false, nil
+}
+
+// castAsVectorFunctionClass casts the values assigned to the vector columns, the strings other than
+// the binary strings are parsed as the text representation.
+type castAsVectorFunctionClass struct {
+	baseFunctionClass
+
+	tp *types.FieldType
+}
+
+func (c *castAsVectorFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFunc(ctx, c.funcName, args, c.tp)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinCastAsVectorSig{bf}
+	return sig, nil
+}
+
+type builtinCastAsVectorSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastAsVectorSig) Clone() builtinFunc {
+	newSig := &builtinCastAsVectorSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals a builtinCastAsVectorSig.
+func (b *builtinCastAsVectorSig) evalString(row chunk.Row) (string, bool, error) {
+	v, isNull, err := evalVector(b.ctx, b.args[0], row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return string(v.Encode()), false, nil
+}
+
+type vecAsTextFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *vecAsTextFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(mysql.MaxBlobWidth)
+	sig := &builtinVecAsTextSig{bf}
+	return sig, nil
+}
+
+type builtinVecAsTextSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinVecAsTextSig) Clone() builtinFunc {
+	newSig := &builtinVecAsTextSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals a builtinVecAsTextSig.
+func (b *builtinVecAsTextSig) evalString(row chunk.Row) (string, bool, error) {
+	v, isNull, err := evalVector(b.ctx, b.args[0], row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return v.String(), false, nil
+}
+
+// VectorDistance is a vector distance function between a column and a constant vector.
+type VectorDistance struct {
+	// FuncName is the name of the distance function.
+	FuncName string
+	// Column is the vector column.
+	Column *Column
+	// Query is the constant vector.
+	Query types.Vector
+}
+
+// ExtractVectorDistance returns the vector distance if expr is a distance function between
+// a vector column and a constant, which can be evaluated with a vector index.
+func ExtractVectorDistance(ctx sessionctx.Context, expr Expression) (*VectorDistance, bool) {
+	f, ok := expr.(*ScalarFunction)
+	if !ok {
+		return nil, false
+	}
+	if _, ok := vecDistanceFuncs[f.FuncName.L]; !ok {
+		return nil, false
+	}
+	args := f.GetArgs()
+	col, ok := args[0].(*Column)
+	query := args[1]
+	if !ok {
+		col, ok = args[1].(*Column)
+		query = args[0]
+	}
+	if !ok || col.GetType().GetType() != mysql.TypeVector {
+		return nil, false
+	}
+	// The parameters of the prepared statements are not extracted, which can change in the cached plans.
+	if c, ok := query.(*Constant); !ok || c.ParamMarker != nil || c.DeferredExpr != nil {
+		return nil, false
+	}
+	v, isNull, err := evalVector(ctx, query, chunk.Row{})
+	if isNull || err != nil {
+		return nil, false
+	}
+	return &VectorDistance{FuncName: f.FuncName.L, Column: col, Query: v}, true
+}
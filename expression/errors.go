@@ -42,6 +42,7 @@ var (
 	ErrDataOutOfRangeFuncIndex     = dbterror.ClassExpression.NewStd(mysql.ErrDataOutOfRangeFunctionalIndex)
 	ErrFuncIndexDataIsTooLong      = dbterror.ClassExpression.NewStd(mysql.ErrFunctionalIndexDataIsTooLong)
 	ErrFunctionNotExists           = dbterror.ClassExpression.NewStd(mysql.ErrSpDoesNotExist)
+	ErrFtMatchingKeyNotFound       = dbterror.ClassExpression.NewStd(mysql.ErrFtMatchingKeyNotFound)
 
 	// All the un-exported errors are defined here:
 	errZlibZData                     = dbterror.ClassExpression.NewStd(mysql.ErrZlibZData)
@@ -61,6 +62,7 @@ var (
 	errUserLockDeadlock              = dbterror.ClassExpression.NewStd(mysql.ErrUserLockDeadlock)
 	errUserLockWrongName             = dbterror.ClassExpression.NewStd(mysql.ErrUserLockWrongName)
 	errJSONInBooleanContext          = dbterror.ClassExpression.NewStd(mysql.ErrJSONInBooleanContext)
+	errSpWrongNoOfArgs               = dbterror.ClassExpression.NewStd(mysql.ErrSpWrongNoOfArgs)
 
 	// Sequence usage privilege check.
 	errSequenceAccessDenied      = dbterror.ClassExpression.NewStd(mysql.ErrTableaccessDenied)
@@ -191,7 +191,7 @@ func (pc PbConverter) columnToPBExpr(column *Column) *tipb.Expr {
 		if !IsPushDownEnabled(ast.TypeStr(column.GetType().GetType()), kv.TiKV) {
 			return nil
 		}
-	case mysql.TypeSet, mysql.TypeGeometry, mysql.TypeUnspecified:
+	case mysql.TypeSet, mysql.TypeGeometry, mysql.TypeVector, mysql.TypeUnspecified:
 		return nil
 	case mysql.TypeEnum:
 		if !IsPushDownEnabled("enum", kv.UnSpecified) {
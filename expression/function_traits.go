@@ -63,6 +63,7 @@ var unFoldableFunctions = map[string]struct{}{
 	ast.LastVal:   {},
 	ast.SetVal:    {},
 	ast.AnyValue:  {},
+	ast.Match:     {},
 }
 
 // DisableFoldFunctions stores functions which prevent child scope functions from being constant folded.
@@ -268,5 +269,12 @@ var booleanFunctions = map[string]struct{}{
 	ast.IsIPv4Mapped:       {},
 	ast.IsIPv6:             {},
 	ast.JSONValid:          {},
+	ast.JSONSchemaValid:    {},
+	ast.STContains:         {},
+	ast.STDisjoint:         {},
+	ast.STEquals:           {},
+	ast.STIntersects:       {},
+	ast.STIsEmpty:          {},
+	ast.STWithin:           {},
 	ast.RegexpLike:         {},
 }
@@ -2048,3 +2048,65 @@ func TestCompareBuiltin(t *testing.T) {
 	result = tk.MustQuery(`select row(1+3,2,3)<>row(1+3,2,3)`)
 	result.Check(testkit.Rows("0"))
 }
+
+func TestSpatialFunctions(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (id int primary key, g geometry, p point not null, pg polygon)")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `id` int(11) NOT NULL,\n" +
+		"  `g` geometry DEFAULT NULL,\n" +
+		"  `p` point NOT NULL,\n" +
+		"  `pg` polygon DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`) /*T![clustered_index] CLUSTERED */\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustGetErrCode("create table t1 (g geometry default 'abc')", errno.ErrBlobCantHaveDefault)
+	tk.MustGetErrCode("create table t1 (g geometry, key(g))", errno.ErrBlobKeyWithoutLength)
+	tk.MustExec("create table t1 (g geometry, key(g(25)))")
+	tk.MustExec("insert into t1 values (point(1, 1)), (point(2, 2))")
+	tk.MustQuery("select st_astext(g) from t1 use index(g) where g = point(2, 2)").Check(testkit.Rows("POINT(2 2)"))
+	tk.MustExec("drop table t1")
+	tk.MustQuery("select column_name, data_type, column_type from information_schema.columns where table_schema = 'test' and table_name = 't' and column_name != 'id' order by ordinal_position").Check(testkit.Rows(
+		"g geometry geometry", "p point point", "pg polygon polygon"))
+
+	tk.MustExec("insert into t values (1, st_geomfromtext('LINESTRING(0 0,3 4)'), point(1, 1), st_polyfromtext('POLYGON((0 0,4 0,4 4,0 4,0 0))'))")
+	tk.MustExec("insert into t values (2, null, st_pointfromtext('POINT(5 5)'), null)")
+	tk.MustQuery("select id, st_astext(g), st_astext(p), st_astext(pg) from t order by id").Check(testkit.Rows(
+		"1 LINESTRING(0 0,3 4) POINT(1 1) POLYGON((0 0,4 0,4 4,0 4,0 0))",
+		"2 <nil> POINT(5 5) <nil>"))
+	tk.MustQuery("select st_geometrytype(g), st_length(g), st_numpoints(g), st_x(p), st_y(p), st_area(pg), st_srid(p) from t where id = 1").Check(testkit.Rows(
+		"LINESTRING 5 2 1 1 16 0"))
+	tk.MustQuery("select t1.id, t2.id from t t1, t t2 where st_contains(t1.pg, t2.p) order by t1.id, t2.id").Check(testkit.Rows("1 1"))
+	tk.MustQuery("select id from t where st_within(p, st_geomfromtext('POLYGON((0 0,10 0,10 10,0 10,0 0))')) order by id").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("select id, st_distance(p, point(1, 5)) from t order by id").Check(testkit.Rows("1 4", "2 4"))
+	tk.MustQuery("select st_intersects(g, pg), st_disjoint(g, p), st_equals(p, point(1, 1)) from t where id = 1").Check(testkit.Rows("1 1 1"))
+
+	// The values are stored in the internal format of MySQL, which is a little-endian SRID followed by WKB.
+	tk.MustQuery("select hex(p), hex(st_asbinary(p)) from t where id = 1").Check(testkit.Rows(
+		"000000000101000000000000000000F03F000000000000F03F 0101000000000000000000F03F000000000000F03F"))
+	tk.MustQuery("select st_astext(st_geomfromwkb(st_asbinary(g))) from t where id = 1").Check(testkit.Rows("LINESTRING(0 0,3 4)"))
+
+	// The value of a column must be a geometry of the column type.
+	tk.MustGetErrCode("insert into t values (3, null, 'abc', null)", errno.ErrCantCreateGeometryObject)
+	tk.MustGetErrCode("insert into t values (3, null, st_geomfromtext('LINESTRING(0 0,1 1)'), null)", errno.ErrCantCreateGeometryObject)
+	err := tk.QueryToErr("select st_geomfromtext('POINT(1)')")
+	require.True(t, types.ErrGISInvalidData.Equal(err), err)
+	err = tk.QueryToErr("select st_x(st_geomfromtext('LINESTRING(0 0,1 1)'))")
+	require.True(t, types.ErrGISUnsupportedArgument.Equal(err), err)
+
+	// Geographic coordinates are in the latitude-longitude order of SRID 4326.
+	tk.MustQuery("select st_astext(g), st_x(g), st_y(g), st_srid(g) from (select st_geomfromtext('POINT(40.7501 -73.9949)', 4326) as g) t").Check(testkit.Rows(
+		"POINT(40.7501 -73.9949) 40.7501 -73.9949 4326"))
+	tk.MustQuery("select round(st_distance_sphere(point(-73.9949, 40.7501), point(-73.9961, 40.7542)), 2)").Check(testkit.Rows("466.97"))
+	tk.MustQuery("select round(st_distance(st_geomfromtext('POINT(0 0)', 4326), st_geomfromtext('POINT(0 1)', 4326)))").Check(testkit.Rows("111319"))
+	err = tk.QueryToErr("select st_distance(point(0, 0), st_geomfromtext('POINT(0 0)', 4326))")
+	require.True(t, types.ErrGISDifferentSRIDs.Equal(err), err)
+	err = tk.QueryToErr("select st_geomfromtext('POINT(1 1)', 1)")
+	require.True(t, types.ErrSRSNotFound.Equal(err), err)
+	err = tk.QueryToErr("select st_distance_sphere(point(0, 0), point(0, 1), 0)")
+	require.True(t, types.ErrNonpositiveRadius.Equal(err), err)
+	err = tk.QueryToErr("select st_distance_sphere(point(0, 0), point(0, 91))")
+	require.True(t, types.ErrLatitudeOutOfRange.Equal(err), err)
+}
@@ -210,6 +210,10 @@ func newFunctionImpl(ctx sessionctx.Context, fold int, funcName string, retType
 	}
 
 	if !ok {
+		// The scalar functions calling the stored functions are named by the qualified names of the functions.
+		if sf, err := newStoredFunctionByName(ctx, funcName, args); err != nil || sf != nil {
+			return sf, err
+		}
 		db := ctx.GetSessionVars().CurrentDB
 		if db == "" {
 			return nil, errors.Trace(ErrNoDB)
@@ -363,7 +367,7 @@ func (sf *ScalarFunction) IsCorrelated() bool {
 // ConstItem implements Expression interface.
 func (sf *ScalarFunction) ConstItem(sc *stmtctx.StatementContext) bool {
 	// Note: some unfoldable functions are deterministic, we use unFoldableFunctions here for simplification.
-	if _, ok := unFoldableFunctions[sf.FuncName.L]; ok {
+	if isUnFoldableFunction(sf) {
 		return false
 	}
 	for _, arg := range sf.GetArgs() {
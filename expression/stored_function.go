@@ -0,0 +1,192 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+// StoredFunction is a stored function created by `CREATE FUNCTION`.
+type StoredFunction interface {
+	// Name returns the qualified name of the function, for example: test.f1.
+	Name() string
+	// NumParams returns the number of the parameters.
+	NumParams() int
+	// RetType returns the return type of the function.
+	RetType() *types.FieldType
+	// Call executes the function with the arguments and returns the value returned by the function.
+	Call(ctx sessionctx.Context, args []types.Datum) (types.Datum, error)
+}
+
+// LoadStoredFunction loads the stored function in the schema, it returns nil if the function doesn't exist.
+// Note: initialized in executor
+var LoadStoredFunction func(ctx sessionctx.Context, schema, name model.CIStr) (StoredFunction, error)
+
+// NewStoredFunctionCall creates a scalar function which calls the stored function in the schema, it returns nil if
+// the function doesn't exist. The name of the scalar function is the qualified name of the stored function, so that
+// the scalar function can be rebuilt by NewFunction.
+func NewStoredFunctionCall(ctx sessionctx.Context, schema, name model.CIStr, args ...Expression) (Expression, error) {
+	if LoadStoredFunction == nil {
+		return nil, nil
+	}
+	fn, err := LoadStoredFunction(ctx, schema, name)
+	if err != nil || fn == nil {
+		return nil, err
+	}
+	if len(args) != fn.NumParams() {
+		return nil, errSpWrongNoOfArgs.GenWithStackByArgs("FUNCTION", fn.Name(), fn.NumParams(), len(args))
+	}
+
+	funcArgs := make([]Expression, len(args))
+	copy(funcArgs, args)
+	retType := fn.RetType().Clone()
+	bf, err := newBaseBuiltinFuncWithFieldType(ctx, retType, funcArgs)
+	if err != nil {
+		return nil, err
+	}
+	// Like MySQL, the string value returned by a stored function has the implicit coercibility.
+	if retType.EvalType() == types.ETString {
+		bf.SetCoercibility(CoercibilityImplicit)
+	} else {
+		bf.SetCoercibility(CoercibilityNumeric)
+	}
+	bf.SetRepertoire(UNICODE)
+	// The stored function may read the tables, so the result can't be cached in the plan.
+	ctx.GetSessionVars().StmtCtx.SetSkipPlanCache(errors.Errorf("stored function %s is called", fn.Name()))
+	return &ScalarFunction{
+		FuncName: model.NewCIStr(schema.L + "." + name.L),
+		RetType:  retType,
+		Function: &builtinStoredFuncSig{baseBuiltinFunc: bf, fn: fn},
+	}, nil
+}
+
+// newStoredFunctionByName rebuilds the scalar function calling a stored function by its qualified name.
+func newStoredFunctionByName(ctx sessionctx.Context, funcName string, args []Expression) (Expression, error) {
+	schema, name, ok := strings.Cut(funcName, ".")
+	if !ok {
+		return nil, nil
+	}
+	return NewStoredFunctionCall(ctx, model.NewCIStr(schema), model.NewCIStr(name), args...)
+}
+
+// isStoredFunction checks whether the scalar function calls a stored function.
+func isStoredFunction(sf *ScalarFunction) bool {
+	_, ok := sf.Function.(*builtinStoredFuncSig)
+	return ok
+}
+
+// isUnFoldableFunction checks whether the scalar function can't be folded. The stored functions are never folded
+// since they may read the tables.
+func isUnFoldableFunction(sf *ScalarFunction) bool {
+	_, ok := unFoldableFunctions[sf.FuncName.L]
+	return ok || isStoredFunction(sf)
+}
+
+// isMutableEffectsFunction checks whether the scalar function is non-deterministic or has side effects.
+func isMutableEffectsFunction(sf *ScalarFunction) bool {
+	_, ok := mutableEffectsFunctions[sf.FuncName.L]
+	return ok || isStoredFunction(sf)
+}
+
+// builtinStoredFuncSig evaluates the arguments and calls the stored function for each row.
+type builtinStoredFuncSig struct {
+	baseBuiltinFunc
+	fn StoredFunction
+}
+
+func (b *builtinStoredFuncSig) Clone() builtinFunc {
+	newSig := &builtinStoredFuncSig{fn: b.fn}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinStoredFuncSig) call(row chunk.Row) (types.Datum, error) {
+	args := make([]types.Datum, 0, len(b.args))
+	for _, arg := range b.args {
+		d, err := arg.Eval(row)
+		if err != nil {
+			return types.Datum{}, err
+		}
+		args = append(args, d)
+	}
+	return b.fn.Call(b.ctx, args)
+}
+
+func (b *builtinStoredFuncSig) evalInt(row chunk.Row) (int64, bool, error) {
+	d, err := b.call(row)
+	if err != nil || d.IsNull() {
+		return 0, true, err
+	}
+	if d.Kind() == types.KindMysqlBit {
+		v, err := d.GetBinaryLiteral().ToInt(b.ctx.GetSessionVars().StmtCtx)
+		return int64(v), err != nil, err
+	}
+	return d.GetInt64(), false, nil
+}
+
+func (b *builtinStoredFuncSig) evalReal(row chunk.Row) (float64, bool, error) {
+	d, err := b.call(row)
+	if err != nil || d.IsNull() {
+		return 0, true, err
+	}
+	return d.GetFloat64(), false, nil
+}
+
+func (b *builtinStoredFuncSig) evalString(row chunk.Row) (string, bool, error) {
+	d, err := b.call(row)
+	if err != nil || d.IsNull() {
+		return "", true, err
+	}
+	s, err := d.ToString()
+	return s, err != nil, err
+}
+
+func (b *builtinStoredFuncSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	d, err := b.call(row)
+	if err != nil || d.IsNull() {
+		return nil, true, err
+	}
+	return d.GetMysqlDecimal(), false, nil
+}
+
+func (b *builtinStoredFuncSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	d, err := b.call(row)
+	if err != nil || d.IsNull() {
+		return types.ZeroTime, true, err
+	}
+	return d.GetMysqlTime(), false, nil
+}
+
+func (b *builtinStoredFuncSig) evalDuration(row chunk.Row) (types.Duration, bool, error) {
+	d, err := b.call(row)
+	if err != nil || d.IsNull() {
+		return types.Duration{}, true, err
+	}
+	return d.GetMysqlDuration(), false, nil
+}
+
+func (b *builtinStoredFuncSig) evalJSON(row chunk.Row) (types.BinaryJSON, bool, error) {
+	d, err := b.call(row)
+	if err != nil || d.IsNull() {
+		return types.BinaryJSON{}, true, err
+	}
+	return d.GetMysqlJSON(), false, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStoredFunction struct {
+	calls int
+}
+
+func (*mockStoredFunction) Name() string {
+	return "test.add1"
+}
+
+func (*mockStoredFunction) NumParams() int {
+	return 1
+}
+
+func (*mockStoredFunction) RetType() *types.FieldType {
+	return types.NewFieldType(mysql.TypeLonglong)
+}
+
+func (f *mockStoredFunction) Call(_ sessionctx.Context, args []types.Datum) (types.Datum, error) {
+	f.calls++
+	if args[0].IsNull() {
+		return types.Datum{}, nil
+	}
+	return types.NewIntDatum(args[0].GetInt64() + 1), nil
+}
+
+func TestStoredFunctionCall(t *testing.T) {
+	ctx := createContext(t)
+	ctx.GetSessionVars().CurrentDB = "test"
+	fn := &mockStoredFunction{}
+	origin := LoadStoredFunction
+	LoadStoredFunction = func(_ sessionctx.Context, schema, name model.CIStr) (StoredFunction, error) {
+		if schema.L == "test" && name.L == "add1" {
+			return fn, nil
+		}
+		return nil, nil
+	}
+	defer func() {
+		LoadStoredFunction = origin
+	}()
+
+	sf, err := NewStoredFunctionCall(ctx, model.NewCIStr("test"), model.NewCIStr("not_exists"), NewOne())
+	require.NoError(t, err)
+	require.Nil(t, sf)
+	_, err = NewStoredFunctionCall(ctx, model.NewCIStr("test"), model.NewCIStr("add1"))
+	require.True(t, terror.ErrorEqual(err, errSpWrongNoOfArgs), "%v", err)
+
+	sf, err = NewStoredFunctionCall(ctx, model.NewCIStr("Test"), model.NewCIStr("ADD1"), NewOne())
+	require.NoError(t, err)
+	require.Equal(t, "test.add1", sf.(*ScalarFunction).FuncName.L)
+	require.Equal(t, mysql.TypeLonglong, sf.GetType().GetType())
+	v, isNull, err := sf.EvalInt(ctx, chunk.Row{})
+	require.NoError(t, err)
+	require.False(t, isNull)
+	require.Equal(t, int64(2), v)
+	require.Equal(t, 1, fn.calls)
+
+	// the stored functions are neither folded nor treated as constants
+	require.False(t, sf.ConstItem(ctx.GetSessionVars().StmtCtx))
+	require.True(t, IsMutableEffectsExpr(sf))
+	require.False(t, IsInmutableExpr(sf))
+	require.Equal(t, 1, fn.calls)
+
+	// the function can be rebuilt by the qualified name
+	rebuilt, err := NewFunction(ctx, "test.add1", types.NewFieldType(mysql.TypeLonglong), NewNull())
+	require.NoError(t, err)
+	require.True(t, sf.Equal(ctx, sf.Clone()))
+	require.False(t, sf.Equal(ctx, rebuilt))
+	_, isNull, err = rebuilt.EvalInt(ctx, chunk.Row{})
+	require.NoError(t, err)
+	require.True(t, isNull)
+	require.Equal(t, 2, fn.calls)
+	_, err = NewFunction(ctx, "test.not_exists", types.NewFieldType(mysql.TypeLonglong), NewOne())
+	require.True(t, terror.ErrorEqual(err, ErrFunctionNotExists), "%v", err)
+}
@@ -466,6 +466,26 @@ func ColumnSubstituteImpl(expr Expression, schema *Schema, newExprs []Expression
 			}
 			return false, false, v
 		}
+		if v.FuncName.L == ast.Match {
+			// for match function recreation, use clone (full-text index included) instead of newFunction
+			e := v
+			for idx, arg := range v.GetArgs() {
+				changed, failed, newArg := ColumnSubstituteImpl(arg, schema, newExprs, fail1Return)
+				if fail1Return && failed {
+					return substituted, failed, v
+				}
+				hasFail = hasFail || failed
+				if changed {
+					if !substituted {
+						e = v.Clone().(*ScalarFunction)
+						e.hashcode = nil
+						substituted = true
+					}
+					e.Function.getArgs()[idx] = newArg
+				}
+			}
+			return substituted, hasFail, e
+		}
 		// cowExprRef is a copy-on-write util, args array allocation happens only
 		// when expr in args is changed
 		refExprArr := cowExprRef{v.GetArgs(), nil}
@@ -1275,7 +1295,7 @@ func BuildNotNullExpr(ctx sessionctx.Context, expr Expression) Expression {
 func IsRuntimeConstExpr(expr Expression) bool {
 	switch x := expr.(type) {
 	case *ScalarFunction:
-		if _, ok := unFoldableFunctions[x.FuncName.L]; ok {
+		if isUnFoldableFunction(x) {
 			return false
 		}
 		for _, arg := range x.GetArgs() {
@@ -1298,7 +1318,7 @@ func CheckNonDeterministic(e Expression) bool {
 	case *Constant, *Column, *CorrelatedColumn:
 		return false
 	case *ScalarFunction:
-		if _, ok := unFoldableFunctions[x.FuncName.L]; ok {
+		if isUnFoldableFunction(x) {
 			return true
 		}
 		for _, arg := range x.GetArgs() {
@@ -1310,6 +1330,18 @@ func CheckNonDeterministic(e Expression) bool {
 	return false
 }
 
+// IsDeterministicFunction checks whether the function always returns the same result for the same arguments.
+func IsDeterministicFunction(name string) bool {
+	if _, ok := unFoldableFunctions[name]; ok {
+		return false
+	}
+	if _, ok := DeferredFunctions[name]; ok {
+		return false
+	}
+	_, ok := mutableEffectsFunctions[name]
+	return !ok
+}
+
 // CheckFuncInExpr checks whether there's a given function in the expression.
 func CheckFuncInExpr(e Expression, funcName string) bool {
 	switch x := e.(type) {
@@ -1328,11 +1360,50 @@ func CheckFuncInExpr(e Expression, funcName string) bool {
 	return false
 }
 
+// CloneWithNewCtx clones the expression, and binds the functions and parameter markers in the cloned expression
+// to the given context. It's used to share an expression among the sessions.
+func CloneWithNewCtx(ctx sessionctx.Context, expr Expression) Expression {
+	cloned := expr.Clone()
+	setCtxInPlace(ctx, cloned)
+	return cloned
+}
+
+// CloneExprsWithNewCtx uses CloneWithNewCtx to clone a slice of Expression.
+func CloneExprsWithNewCtx(ctx sessionctx.Context, exprs []Expression) []Expression {
+	if exprs == nil {
+		return nil
+	}
+	cloned := make([]Expression, 0, len(exprs))
+	for _, expr := range exprs {
+		cloned = append(cloned, CloneWithNewCtx(ctx, expr))
+	}
+	return cloned
+}
+
+// setCtxInPlace binds a cloned expression to the context. The arguments of a cloned function are cloned as well,
+// but the parameter markers and deferred expressions of a cloned constant are shared with the original one.
+func setCtxInPlace(ctx sessionctx.Context, expr Expression) {
+	switch x := expr.(type) {
+	case *ScalarFunction:
+		x.Function.setCtx(ctx)
+		for _, arg := range x.GetArgs() {
+			setCtxInPlace(ctx, arg)
+		}
+	case *Constant:
+		if x.ParamMarker != nil {
+			x.ParamMarker = &ParamMarker{ctx: ctx, order: x.ParamMarker.order}
+		}
+		if x.DeferredExpr != nil {
+			x.DeferredExpr = CloneWithNewCtx(ctx, x.DeferredExpr)
+		}
+	}
+}
+
 // IsMutableEffectsExpr checks if expr contains function which is mutable or has side effects.
 func IsMutableEffectsExpr(expr Expression) bool {
 	switch x := expr.(type) {
 	case *ScalarFunction:
-		if _, ok := mutableEffectsFunctions[x.FuncName.L]; ok {
+		if isMutableEffectsFunction(x) {
 			return true
 		}
 		for _, arg := range x.GetArgs() {
@@ -1354,10 +1425,10 @@ func IsMutableEffectsExpr(expr Expression) bool {
 func IsInmutableExpr(expr Expression) bool {
 	switch x := expr.(type) {
 	case *ScalarFunction:
-		if _, ok := unFoldableFunctions[x.FuncName.L]; ok {
+		if isUnFoldableFunction(x) {
 			return false
 		}
-		if _, ok := mutableEffectsFunctions[x.FuncName.L]; ok {
+		if isMutableEffectsFunction(x) {
 			return false
 		}
 		for _, arg := range x.GetArgs() {
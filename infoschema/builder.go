@@ -222,6 +222,8 @@ func (b *Builder) ApplyDiff(m *meta.Meta, diff *model.SchemaDiff) ([]int64, erro
 		return b.applyTruncateTableOrPartition(m, diff)
 	case model.ActionDropTable, model.ActionDropTablePartition:
 		return b.applyDropTableOrPartition(m, diff)
+	case model.ActionDropMaterializedView:
+		return b.applyDropMaterializedView(m, diff)
 	case model.ActionRecoverTable:
 		return b.applyRecoverTable(m, diff)
 	case model.ActionCreateTables:
@@ -233,6 +235,13 @@ func (b *Builder) ApplyDiff(m *meta.Meta, diff *model.SchemaDiff) ([]int64, erro
 		return b.applyExchangeTablePartition(m, diff)
 	case model.ActionFlashbackCluster:
 		return []int64{-1}, nil
+	case model.ActionCreateProcedure, model.ActionDropProcedure, model.ActionCreateFunction, model.ActionDropFunction:
+		// The stored routines aren't kept in the info schema, the version is bumped to invalidate the routines
+		// compiled with the old version.
+		return nil, nil
+	case model.ActionCreateEvent, model.ActionAlterEvent, model.ActionDropEvent:
+		// The events aren't kept in the info schema, the event manager reloads them when the version changes.
+		return nil, nil
 	default:
 		return b.applyDefaultAction(m, diff)
 	}
@@ -297,6 +306,38 @@ func (b *Builder) applyDropTableOrPartition(m *meta.Meta, diff *model.SchemaDiff
 	return tblIDs, nil
 }
 
+// applyDropMaterializedView drops the materialized view and its log table, and updates the base table. The dropped log
+// table has a zero TableID in the affected options.
+func (b *Builder) applyDropMaterializedView(m *meta.Meta, diff *model.SchemaDiff) ([]int64, error) {
+	dropDiff := *diff
+	dropDiff.Type = model.ActionDropTable
+	dropDiff.AffectedOpts = nil
+	tblIDs, err := b.applyTableUpdate(m, &dropDiff)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, opt := range diff.AffectedOpts {
+		affectedDiff := &model.SchemaDiff{
+			Version:     diff.Version,
+			Type:        model.ActionDropTable,
+			SchemaID:    opt.SchemaID,
+			TableID:     opt.OldTableID,
+			OldSchemaID: opt.OldSchemaID,
+			OldTableID:  opt.OldTableID,
+		}
+		if opt.TableID != 0 {
+			affectedDiff.Type = diff.Type
+			affectedDiff.TableID = opt.TableID
+		}
+		affectedIDs, err := b.applyTableUpdate(m, affectedDiff)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		tblIDs = append(tblIDs, affectedIDs...)
+	}
+	return tblIDs, nil
+}
+
 func (b *Builder) applyReorganizePartition(m *meta.Meta, diff *model.SchemaDiff) ([]int64, error) {
 	tblIDs, err := b.applyTableUpdate(m, diff)
 	if err != nil {
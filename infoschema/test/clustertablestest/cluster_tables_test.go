@@ -644,6 +644,42 @@ func TestStmtSummaryResultRows(t *testing.T) {
 		Check(testkit.Rows("10 30 20"))
 }
 
+func TestQueryFeedbackClusterTable(t *testing.T) {
+	// setup suite
+	s := new(clusterTablesSuite)
+	s.store, s.dom = testkit.CreateMockStoreAndDomain(t)
+	s.rpcserver, s.listenAddr = s.setUpRPCService(t, "127.0.0.1:0", nil)
+	s.httpServer, s.mockAddr = s.setUpMockPDHTTPServer()
+	s.startTime = time.Now()
+	defer s.httpServer.Close()
+	defer s.rpcserver.Stop()
+
+	tk := s.newTestKitWithRoot(t)
+	if !config.GetGlobalConfig().Instance.EnableCollectExecutionInfo.Load() {
+		tk.MustExec("set @@tidb_enable_collect_execution_info=1")
+		defer tk.MustExec("set @@tidb_enable_collect_execution_info=0")
+	}
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int, b int, key idx(a))")
+	var sql strings.Builder
+	sql.WriteString("insert into t values (1, 1)")
+	for i := 2; i <= 1000; i++ {
+		sql.WriteString(fmt.Sprintf(", (%d, %d)", i, i))
+	}
+	tk.MustExec(sql.String())
+	tk.MustExec("analyze table t")
+	tk.MustExec("update t set a = a + 10000 where a <= 400")
+
+	tk.MustExec("set @@tidb_enable_query_feedback = on")
+	tk.MustQuery("select count(b) from t use index(idx) where a > 5000").Check(testkit.Rows("400"))
+	tk.MustQuery("select count(b) from t use index(idx) where a > 5000").Check(testkit.Rows("400"))
+	tk.MustQuery("select table_schema, table_name, act_rows, exec_count from information_schema.cluster_query_feedback").
+		Check(testkit.Rows("test t 400 2", "test t 400 2"))
+	tk.MustQuery("select count(distinct instance) from information_schema.cluster_query_feedback where instance != ''").
+		Check(testkit.Rows("1"))
+}
+
 func TestSlowQueryOOM(t *testing.T) {
 	s := new(clusterTablesSuite)
 	s.store, s.dom = testkit.CreateMockStoreAndDomain(t)
@@ -54,6 +54,9 @@ var (
 //		Table:2 -> table meta data []byte
 //		TID:1 -> int64
 //		TID:2 -> int64
+//		Procedure:p1 -> procedure meta data []byte
+//		Function:f1 -> stored function meta data []byte
+//		Event:e1 -> event meta data []byte
 //	}
 //
 
@@ -66,6 +69,9 @@ var (
 	mTablePrefix         = "Table"
 	mSequencePrefix      = "SID"
 	mSeqCyclePrefix      = "SequenceCycle"
+	mProcedurePrefix     = "Procedure"
+	mFunctionPrefix      = "Function"
+	mEventPrefix         = "Event"
 	mTableIDPrefix       = "TID"
 	mIncIDPrefix         = "IID"
 	mRandomIDPrefix      = "TARID"
@@ -953,6 +959,156 @@ func (m *Meta) ListTables(dbID int64) ([]*model.TableInfo, error) {
 	return tables, nil
 }
 
+func (*Meta) routineKey(prefix string, name string) []byte {
+	return []byte(fmt.Sprintf("%s:%s", prefix, strings.ToLower(name)))
+}
+
+// SetProcedure creates or replaces the procedure in database.
+func (m *Meta) SetProcedure(dbID int64, info *model.ProcedureInfo) error {
+	return m.setRoutine(dbID, mProcedurePrefix, info)
+}
+
+// GetProcedure gets the procedure in database by name, nil is returned if the procedure doesn't exist.
+func (m *Meta) GetProcedure(dbID int64, name string) (*model.ProcedureInfo, error) {
+	return m.getRoutine(dbID, mProcedurePrefix, name)
+}
+
+// DropProcedure drops the procedure in database by name.
+func (m *Meta) DropProcedure(dbID int64, name string) error {
+	return m.dropRoutine(dbID, mProcedurePrefix, name)
+}
+
+// ListProcedures shows all procedures in database.
+func (m *Meta) ListProcedures(dbID int64) ([]*model.ProcedureInfo, error) {
+	return m.listRoutines(dbID, mProcedurePrefix)
+}
+
+// SetFunction creates or replaces the stored function in database.
+func (m *Meta) SetFunction(dbID int64, info *model.ProcedureInfo) error {
+	return m.setRoutine(dbID, mFunctionPrefix, info)
+}
+
+// GetFunction gets the stored function in database by name, nil is returned if the function doesn't exist.
+func (m *Meta) GetFunction(dbID int64, name string) (*model.ProcedureInfo, error) {
+	return m.getRoutine(dbID, mFunctionPrefix, name)
+}
+
+// DropFunction drops the stored function in database by name.
+func (m *Meta) DropFunction(dbID int64, name string) error {
+	return m.dropRoutine(dbID, mFunctionPrefix, name)
+}
+
+// ListFunctions shows all stored functions in database.
+func (m *Meta) ListFunctions(dbID int64) ([]*model.ProcedureInfo, error) {
+	return m.listRoutines(dbID, mFunctionPrefix)
+}
+
+func (m *Meta) setRoutine(dbID int64, prefix string, info *model.ProcedureInfo) error {
+	// Check if db exists.
+	dbKey := m.dbKey(dbID)
+	if err := m.checkDBExists(dbKey); err != nil {
+		return errors.Trace(err)
+	}
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(m.txn.HSet(dbKey, m.routineKey(prefix, info.Name.L), data))
+}
+
+func (m *Meta) getRoutine(dbID int64, prefix string, name string) (*model.ProcedureInfo, error) {
+	// Check if db exists.
+	dbKey := m.dbKey(dbID)
+	if err := m.checkDBExists(dbKey); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	value, err := m.txn.HGet(dbKey, m.routineKey(prefix, name))
+	if err != nil || value == nil {
+		return nil, errors.Trace(err)
+	}
+
+	info := &model.ProcedureInfo{}
+	err = json.Unmarshal(value, info)
+	return info, errors.Trace(err)
+}
+
+func (m *Meta) dropRoutine(dbID int64, prefix string, name string) error {
+	// Check if db exists.
+	dbKey := m.dbKey(dbID)
+	if err := m.checkDBExists(dbKey); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(m.txn.HDel(dbKey, m.routineKey(prefix, name)))
+}
+
+func (m *Meta) listRoutines(dbID int64, prefix string) ([]*model.ProcedureInfo, error) {
+	dbKey := m.dbKey(dbID)
+	if err := m.checkDBExists(dbKey); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var routines []*model.ProcedureInfo
+	err := m.txn.HGetIter(dbKey, func(r structure.HashPair) error {
+		if !strings.HasPrefix(string(r.Field), prefix+":") {
+			return nil
+		}
+
+		info := &model.ProcedureInfo{}
+		if err := json.Unmarshal(r.Value, info); err != nil {
+			return errors.Trace(err)
+		}
+		routines = append(routines, info)
+		return nil
+	})
+	return routines, errors.Trace(err)
+}
+
+// SetEvent creates or replaces the event in database, data is the encoded definition of the event.
+func (m *Meta) SetEvent(dbID int64, name string, data []byte) error {
+	// Check if db exists.
+	dbKey := m.dbKey(dbID)
+	if err := m.checkDBExists(dbKey); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(m.txn.HSet(dbKey, m.routineKey(mEventPrefix, name), data))
+}
+
+// GetEvent gets the encoded definition of the event in database by name, nil is returned if the event doesn't exist.
+func (m *Meta) GetEvent(dbID int64, name string) ([]byte, error) {
+	// Check if db exists.
+	dbKey := m.dbKey(dbID)
+	if err := m.checkDBExists(dbKey); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	value, err := m.txn.HGet(dbKey, m.routineKey(mEventPrefix, name))
+	return value, errors.Trace(err)
+}
+
+// DropEvent drops the event in database by name.
+func (m *Meta) DropEvent(dbID int64, name string) error {
+	return m.dropRoutine(dbID, mEventPrefix, name)
+}
+
+// ListEvents shows the encoded definitions of all events in database.
+func (m *Meta) ListEvents(dbID int64) ([][]byte, error) {
+	dbKey := m.dbKey(dbID)
+	if err := m.checkDBExists(dbKey); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var events [][]byte
+	err := m.txn.HGetIter(dbKey, func(r structure.HashPair) error {
+		if strings.HasPrefix(string(r.Field), mEventPrefix+":") {
+			events = append(events, r.Value)
+		}
+		return nil
+	})
+	return events, errors.Trace(err)
+}
+
 // ListDatabases shows all databases.
 func (m *Meta) ListDatabases() ([]*model.DBInfo, error) {
 	res, err := m.txn.HGetAll(mDBs)
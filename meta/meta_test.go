@@ -149,6 +149,104 @@ func TestResourceGroup(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestProcedure(t *testing.T) {
+	store, err := mockstore.NewMockStore()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	txn, err := store.Begin()
+	require.NoError(t, err)
+
+	m := meta.NewMeta(txn)
+	dbInfo := &model.DBInfo{ID: 1, Name: model.NewCIStr("a")}
+	require.NoError(t, m.CreateDatabase(dbInfo))
+	require.NoError(t, m.CreateTableOrView(1, &model.TableInfo{ID: 2, Name: model.NewCIStr("t")}))
+
+	info := &model.ProcedureInfo{Name: model.NewCIStr("P1"), ParamList: "IN a INT", Body: "SELECT a"}
+	require.NoError(t, m.SetProcedure(1, info))
+	require.NoError(t, m.SetProcedure(1, &model.ProcedureInfo{Name: model.NewCIStr("p2"), Body: "SELECT 1"}))
+
+	got, err := m.GetProcedure(1, "p1")
+	require.NoError(t, err)
+	require.Equal(t, info, got)
+	got, err = m.GetProcedure(1, "p3")
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	// procedures are stored along with the tables but not listed as tables
+	procedures, err := m.ListProcedures(1)
+	require.NoError(t, err)
+	require.Len(t, procedures, 2)
+	tables, err := m.ListTables(1)
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+
+	require.NoError(t, m.DropProcedure(1, "P2"))
+	procedures, err = m.ListProcedures(1)
+	require.NoError(t, err)
+	require.Equal(t, []*model.ProcedureInfo{info}, procedures)
+
+	_, err = m.GetProcedure(3, "p1")
+	require.True(t, meta.ErrDBNotExists.Equal(err))
+
+	// procedures are dropped along with the database
+	require.NoError(t, m.DropDatabase(1))
+	require.NoError(t, m.CreateDatabase(dbInfo))
+	procedures, err = m.ListProcedures(1)
+	require.NoError(t, err)
+	require.Empty(t, procedures)
+
+	require.NoError(t, txn.Rollback())
+}
+
+func TestFunction(t *testing.T) {
+	store, err := mockstore.NewMockStore()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	txn, err := store.Begin()
+	require.NoError(t, err)
+
+	m := meta.NewMeta(txn)
+	require.NoError(t, m.CreateDatabase(&model.DBInfo{ID: 1, Name: model.NewCIStr("a")}))
+
+	info := &model.ProcedureInfo{Name: model.NewCIStr("F1"), ParamList: "a INT", Returns: "INT", Body: "RETURN a"}
+	require.NoError(t, m.SetFunction(1, info))
+	require.NoError(t, m.SetProcedure(1, &model.ProcedureInfo{Name: model.NewCIStr("f1"), Body: "SELECT 1"}))
+
+	got, err := m.GetFunction(1, "f1")
+	require.NoError(t, err)
+	require.Equal(t, info, got)
+
+	// functions and procedures are in different namespaces
+	functions, err := m.ListFunctions(1)
+	require.NoError(t, err)
+	require.Equal(t, []*model.ProcedureInfo{info}, functions)
+	procedures, err := m.ListProcedures(1)
+	require.NoError(t, err)
+	require.Len(t, procedures, 1)
+	require.Empty(t, procedures[0].Returns)
+
+	require.NoError(t, m.DropFunction(1, "F1"))
+	got, err = m.GetFunction(1, "f1")
+	require.NoError(t, err)
+	require.Nil(t, got)
+	got, err = m.GetProcedure(1, "f1")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	_, err = m.GetFunction(3, "f1")
+	require.True(t, meta.ErrDBNotExists.Equal(err))
+
+	require.NoError(t, txn.Rollback())
+}
+
 func TestBackupAndRestoreAutoIDs(t *testing.T) {
 	store, err := mockstore.NewMockStore()
 	require.NoError(t, err)
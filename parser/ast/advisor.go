@@ -83,3 +83,52 @@ func (n *MaxIndexNumClause) Restore(ctx *format.RestoreCtx) error {
 	}
 	return nil
 }
+
+var _ StmtNode = &RecommendIndexStmt{}
+
+// RecommendIndexStmt is used to recommend indexes for the workload.
+type RecommendIndexStmt struct {
+	stmtNode
+
+	// Action is the action of the statement, only "run" is supported now.
+	Action string
+	// SQL is the query to recommend indexes for. The workload in the statements summary is used if it's empty.
+	SQL     string
+	Options []RecommendIndexOption
+}
+
+// RecommendIndexOption is an option of RecommendIndexStmt, such as `max_num_index = 5`.
+type RecommendIndexOption struct {
+	Option string
+	Value  uint64
+}
+
+// Restore implements Node interface.
+func (n *RecommendIndexStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RECOMMEND INDEX ")
+	ctx.WriteKeyWord(n.Action)
+	if n.SQL != "" {
+		ctx.WriteKeyWord(" FOR ")
+		ctx.WriteString(n.SQL)
+	}
+	for i, option := range n.Options {
+		if i == 0 {
+			ctx.WriteKeyWord(" WITH ")
+		} else {
+			ctx.WritePlain(", ")
+		}
+		ctx.WritePlain(option.Option)
+		ctx.WritePlainf(" = %d", option.Value)
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *RecommendIndexStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*RecommendIndexStmt)
+	return v.Leave(n)
+}
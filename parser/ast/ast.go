@@ -136,7 +136,7 @@ type ResultField struct {
 }
 
 // ResultSetNode interface has a ResultFields property, represents a Node that returns result set.
-// Implementations include SelectStmt, SubqueryExpr, TableSource, TableName, Join and SetOprStmt.
+// Implementations include SelectStmt, SubqueryExpr, TableSource, TableName, Join, SetOprStmt and JSONTable.
 type ResultSetNode interface {
 	Node
 
@@ -245,6 +245,8 @@ func GetStmtLabel(stmtNode StmtNode) string {
 		return "CreateBinding"
 	case *IndexAdviseStmt:
 		return "IndexAdvise"
+	case *RecommendIndexStmt:
+		return "RecommendIndex"
 	case *DropBindingStmt:
 		return "DropBinding"
 	case *TraceStmt:
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tidb/parser/format"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
 )
 
 var (
@@ -50,6 +51,7 @@ var (
 	_ Node = &TableName{}
 	_ Node = &TableRefsClause{}
 	_ Node = &TableSource{}
+	_ Node = &JSONTable{}
 	_ Node = &SetOprSelectList{}
 	_ Node = &WildCardField{}
 	_ Node = &WindowSpec{}
@@ -531,6 +533,10 @@ type TableSource struct {
 
 	// AsName is the alias name of the table source.
 	AsName model.CIStr
+
+	// Lateral indicates whether the derived table is a LATERAL derived table,
+	// which can refer to the columns of the preceding tables in the FROM clause.
+	Lateral bool
 }
 
 func (*TableSource) resultSet() {}
@@ -576,6 +582,9 @@ func (n *TableSource) Restore(ctx *format.RestoreCtx) error {
 			ctx.WritePlain(")")
 		}
 	} else {
+		if n.Lateral {
+			ctx.WriteKeyWord("LATERAL ")
+		}
 		if needParen {
 			ctx.WritePlain("(")
 		}
@@ -609,6 +618,171 @@ func (n *TableSource) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// JSONTableColumnType is the type of a column in JSON_TABLE.
+type JSONTableColumnType int
+
+// JSONTable column types.
+const (
+	// JSONTableColumnOrdinality is a column defined by `name FOR ORDINALITY`.
+	JSONTableColumnOrdinality JSONTableColumnType = iota
+	// JSONTableColumnPath is a column defined by `name type PATH path [on_empty] [on_error]`.
+	JSONTableColumnPath
+	// JSONTableColumnExistsPath is a column defined by `name type EXISTS PATH path`.
+	JSONTableColumnExistsPath
+	// JSONTableColumnNested is a nested path defined by `NESTED [PATH] path COLUMNS (column_list)`.
+	JSONTableColumnNested
+)
+
+// JSONTableOnResponseType is the type of the ON EMPTY and ON ERROR clauses of a JSON_TABLE column.
+type JSONTableOnResponseType int
+
+// JSONTable response types.
+const (
+	JSONTableOnResponseNull JSONTableOnResponseType = iota
+	JSONTableOnResponseError
+	JSONTableOnResponseDefault
+)
+
+// JSONTableOnResponse represents the `{NULL | ERROR | DEFAULT json_string} ON {EMPTY | ERROR}` clause.
+type JSONTableOnResponse struct {
+	Tp JSONTableOnResponseType
+	// Default is the JSON string used when Tp is JSONTableOnResponseDefault.
+	Default string
+}
+
+// Restore writes the response without the trailing `ON EMPTY` or `ON ERROR`.
+func (n *JSONTableOnResponse) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case JSONTableOnResponseNull:
+		ctx.WriteKeyWord("NULL")
+	case JSONTableOnResponseError:
+		ctx.WriteKeyWord("ERROR")
+	case JSONTableOnResponseDefault:
+		ctx.WriteKeyWord("DEFAULT ")
+		ctx.WriteString(n.Default)
+	default:
+		return errors.Errorf("invalid JSON_TABLE response type %d", n.Tp)
+	}
+	return nil
+}
+
+// JSONTableColumn represents a column definition in JSON_TABLE.
+type JSONTableColumn struct {
+	Tp   JSONTableColumnType
+	Name model.CIStr
+	// Type is the type of the column, it is nil for ordinality and nested columns.
+	Type *types.FieldType
+	Path string
+
+	OnEmpty *JSONTableOnResponse
+	OnError *JSONTableOnResponse
+
+	// NestedColumns is the columns of a nested path.
+	NestedColumns []*JSONTableColumn
+}
+
+// Restore writes the column definition.
+func (n *JSONTableColumn) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case JSONTableColumnOrdinality:
+		ctx.WriteName(n.Name.O)
+		ctx.WriteKeyWord(" FOR ORDINALITY")
+	case JSONTableColumnPath, JSONTableColumnExistsPath:
+		ctx.WriteName(n.Name.O)
+		ctx.WritePlain(" ")
+		if err := n.Type.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore JSONTableColumn.Type")
+		}
+		if n.Tp == JSONTableColumnExistsPath {
+			ctx.WriteKeyWord(" EXISTS")
+		}
+		ctx.WriteKeyWord(" PATH ")
+		ctx.WriteString(n.Path)
+		if n.OnEmpty != nil {
+			ctx.WritePlain(" ")
+			if err := n.OnEmpty.Restore(ctx); err != nil {
+				return errors.Annotate(err, "An error occurred while restore JSONTableColumn.OnEmpty")
+			}
+			ctx.WriteKeyWord(" ON EMPTY")
+		}
+		if n.OnError != nil {
+			ctx.WritePlain(" ")
+			if err := n.OnError.Restore(ctx); err != nil {
+				return errors.Annotate(err, "An error occurred while restore JSONTableColumn.OnError")
+			}
+			ctx.WriteKeyWord(" ON ERROR")
+		}
+	case JSONTableColumnNested:
+		ctx.WriteKeyWord("NESTED PATH ")
+		ctx.WriteString(n.Path)
+		if err := restoreJSONTableColumns(ctx, n.NestedColumns); err != nil {
+			return errors.Annotate(err, "An error occurred while restore JSONTableColumn.NestedColumns")
+		}
+	default:
+		return errors.Errorf("invalid JSON_TABLE column type %d", n.Tp)
+	}
+	return nil
+}
+
+func restoreJSONTableColumns(ctx *format.RestoreCtx, cols []*JSONTableColumn) error {
+	ctx.WriteKeyWord(" COLUMNS ")
+	ctx.WritePlain("(")
+	for i, col := range cols {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := col.Restore(ctx); err != nil {
+			return err
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// JSONTable represents the JSON_TABLE table function, which extracts the data from a JSON document
+// and returns it as a relational table. It can only be used as the source of a TableSource.
+// See https://dev.mysql.com/doc/refman/8.0/en/json-table-functions.html
+type JSONTable struct {
+	node
+
+	Expr    ExprNode
+	Path    string
+	Columns []*JSONTableColumn
+}
+
+func (*JSONTable) resultSet() {}
+
+// Restore implements Node interface.
+func (n *JSONTable) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("JSON_TABLE")
+	ctx.WritePlain("(")
+	if err := n.Expr.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore JSONTable.Expr")
+	}
+	ctx.WritePlain(", ")
+	ctx.WriteString(n.Path)
+	if err := restoreJSONTableColumns(ctx, n.Columns); err != nil {
+		return errors.Annotate(err, "An error occurred while restore JSONTable.Columns")
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *JSONTable) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*JSONTable)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	return v.Leave(n)
+}
+
 // SelectLockType is the lock type for SelectStmt.
 type SelectLockType int
 
@@ -2978,6 +3152,7 @@ const (
 	ShowCreateResourceGroup
 	ShowImportJobs
 	ShowCreateProcedure
+	ShowCreateFunction
 )
 
 const (
@@ -3077,6 +3252,11 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 		if err := n.Procedure.Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore ShowStmt.Procedure")
 		}
+	case ShowCreateFunction:
+		ctx.WriteKeyWord("CREATE FUNCTION ")
+		if err := n.Procedure.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore ShowStmt.Procedure")
+		}
 	case ShowCreateView:
 		ctx.WriteKeyWord("CREATE VIEW ")
 		if err := n.Table.Restore(ctx); err != nil {
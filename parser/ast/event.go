@@ -0,0 +1,354 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/parser/format"
+)
+
+var (
+	_ Node = &EventSchedule{}
+
+	_ StmtNode = &CreateEventStmt{}
+	_ StmtNode = &AlterEventStmt{}
+	_ StmtNode = &DropEventStmt{}
+)
+
+// EventCompletionType is the `ON COMPLETION` clause of an event.
+type EventCompletionType int
+
+// Event completion types.
+const (
+	EventCompletionUnspecified EventCompletionType = iota
+	EventCompletionNotPreserve
+	EventCompletionPreserve
+)
+
+// String implements fmt.Stringer interface.
+func (t EventCompletionType) String() string {
+	switch t {
+	case EventCompletionNotPreserve:
+		return "NOT PRESERVE"
+	case EventCompletionPreserve:
+		return "PRESERVE"
+	}
+	return ""
+}
+
+// EventStatusType is the status of an event specified by `ENABLE` or `DISABLE`.
+type EventStatusType int
+
+// Event status types.
+const (
+	EventStatusUnspecified EventStatusType = iota
+	EventStatusEnable
+	EventStatusDisable
+)
+
+// String implements fmt.Stringer interface.
+func (t EventStatusType) String() string {
+	switch t {
+	case EventStatusEnable:
+		return "ENABLE"
+	case EventStatusDisable:
+		return "DISABLE"
+	}
+	return ""
+}
+
+// EventSchedule is the `ON SCHEDULE` clause of an event.
+// It is a one-time schedule if `At` is not nil, otherwise it is a recurring schedule described by
+// `Every`, `Unit`, `Starts` and `Ends`.
+type EventSchedule struct {
+	node
+
+	At     ExprNode
+	Every  ExprNode
+	Unit   TimeUnitType
+	Starts ExprNode
+	Ends   ExprNode
+}
+
+// Restore implements Node interface.
+func (n *EventSchedule) Restore(ctx *format.RestoreCtx) error {
+	if n.At != nil {
+		ctx.WriteKeyWord("AT ")
+		if err := n.At.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.At")
+		}
+		return nil
+	}
+
+	ctx.WriteKeyWord("EVERY ")
+	if err := n.Every.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore EventSchedule.Every")
+	}
+	ctx.WritePlain(" ")
+	ctx.WriteKeyWord(n.Unit.String())
+	if n.Starts != nil {
+		ctx.WriteKeyWord(" STARTS ")
+		if err := n.Starts.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.Starts")
+		}
+	}
+	if n.Ends != nil {
+		ctx.WriteKeyWord(" ENDS ")
+		if err := n.Ends.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.Ends")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *EventSchedule) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*EventSchedule)
+	exprs := []*ExprNode{&n.At, &n.Every, &n.Starts, &n.Ends}
+	for _, expr := range exprs {
+		if *expr == nil {
+			continue
+		}
+		node, ok := (*expr).Accept(v)
+		if !ok {
+			return n, false
+		}
+		*expr = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
+func restoreEventOptions(ctx *format.RestoreCtx, completion EventCompletionType, status EventStatusType, comment *string) {
+	if completion != EventCompletionUnspecified {
+		ctx.WriteKeyWord(" ON COMPLETION ")
+		ctx.WriteKeyWord(completion.String())
+	}
+	if status != EventStatusUnspecified {
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(status.String())
+	}
+	if comment != nil {
+		ctx.WriteKeyWord(" COMMENT ")
+		ctx.WriteString(*comment)
+	}
+}
+
+// CreateEventStmt is a statement to create a scheduled event.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-event.html
+type CreateEventStmt struct {
+	stmtNode
+
+	Definer     *auth.UserIdentity
+	IfNotExists bool
+	EventName   *TableName
+	Schedule    *EventSchedule
+	Completion  EventCompletionType
+	Status      EventStatusType
+	Comment     *string
+	// Body is the statement executed when the event is triggered, its original text can be fetched by `Body.Text()`.
+	Body StmtNode
+}
+
+// Restore implements Node interface.
+func (n *CreateEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if n.Definer != nil && !n.Definer.CurrentUser {
+		ctx.WriteKeyWord("DEFINER")
+		ctx.WritePlain(" = ")
+		if err := n.Definer.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Definer")
+		}
+		ctx.WritePlain(" ")
+	}
+	ctx.WriteKeyWord("EVENT ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	if err := n.EventName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.EventName")
+	}
+	ctx.WriteKeyWord(" ON SCHEDULE ")
+	if err := n.Schedule.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Schedule")
+	}
+	restoreEventOptions(ctx, n.Completion, n.Status, n.Comment)
+	ctx.WriteKeyWord(" DO ")
+	if err := n.Body.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Body")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateEventStmt)
+	node, ok := n.EventName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.EventName = node.(*TableName)
+	node, ok = n.Schedule.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Schedule = node.(*EventSchedule)
+	node, ok = n.Body.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Body = node.(StmtNode)
+	return v.Leave(n)
+}
+
+// AlterEventStmt is a statement to change the characteristics of an existing event.
+// See https://dev.mysql.com/doc/refman/8.0/en/alter-event.html
+type AlterEventStmt struct {
+	stmtNode
+
+	// Definer is nil if the `DEFINER` clause is not specified.
+	Definer   *auth.UserIdentity
+	EventName *TableName
+	// Schedule is nil if the `ON SCHEDULE` clause is not specified.
+	Schedule   *EventSchedule
+	Completion EventCompletionType
+	// RenameTo is nil if the `RENAME TO` clause is not specified.
+	RenameTo *TableName
+	Status   EventStatusType
+	Comment  *string
+	// Body is nil if the `DO` clause is not specified.
+	Body StmtNode
+}
+
+// Restore implements Node interface.
+func (n *AlterEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ALTER ")
+	if n.Definer != nil {
+		ctx.WriteKeyWord("DEFINER")
+		ctx.WritePlain(" = ")
+		if err := n.Definer.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Definer")
+		}
+		ctx.WritePlain(" ")
+	}
+	ctx.WriteKeyWord("EVENT ")
+	if err := n.EventName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterEventStmt.EventName")
+	}
+	if n.Schedule != nil {
+		ctx.WriteKeyWord(" ON SCHEDULE ")
+		if err := n.Schedule.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Schedule")
+		}
+	}
+	if n.Completion != EventCompletionUnspecified {
+		ctx.WriteKeyWord(" ON COMPLETION ")
+		ctx.WriteKeyWord(n.Completion.String())
+	}
+	if n.RenameTo != nil {
+		ctx.WriteKeyWord(" RENAME TO ")
+		if err := n.RenameTo.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.RenameTo")
+		}
+	}
+	restoreEventOptions(ctx, EventCompletionUnspecified, n.Status, n.Comment)
+	if n.Body != nil {
+		ctx.WriteKeyWord(" DO ")
+		if err := n.Body.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Body")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterEventStmt)
+	node, ok := n.EventName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.EventName = node.(*TableName)
+	if n.Schedule != nil {
+		node, ok = n.Schedule.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Schedule = node.(*EventSchedule)
+	}
+	if n.RenameTo != nil {
+		node, ok = n.RenameTo.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.RenameTo = node.(*TableName)
+	}
+	if n.Body != nil {
+		node, ok = n.Body.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Body = node.(StmtNode)
+	}
+	return v.Leave(n)
+}
+
+// DropEventStmt is a statement to drop an event.
+// See https://dev.mysql.com/doc/refman/8.0/en/drop-event.html
+type DropEventStmt struct {
+	stmtNode
+
+	IfExists  bool
+	EventName *TableName
+}
+
+// Restore implements Node interface.
+func (n *DropEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP EVENT ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	if err := n.EventName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropEventStmt.EventName")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropEventStmt)
+	node, ok := n.EventName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.EventName = node.(*TableName)
+	return v.Leave(n)
+}
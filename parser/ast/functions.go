@@ -327,33 +327,79 @@ const (
 	ValidatePasswordStrength = "validate_password_strength"
 
 	// json functions
-	JSONType          = "json_type"
-	JSONExtract       = "json_extract"
-	JSONUnquote       = "json_unquote"
-	JSONArray         = "json_array"
-	JSONObject        = "json_object"
-	JSONMerge         = "json_merge"
-	JSONSet           = "json_set"
-	JSONInsert        = "json_insert"
-	JSONReplace       = "json_replace"
-	JSONRemove        = "json_remove"
-	JSONOverlaps      = "json_overlaps"
-	JSONContains      = "json_contains"
-	JSONMemberOf      = "json_memberof"
-	JSONContainsPath  = "json_contains_path"
-	JSONValid         = "json_valid"
-	JSONArrayAppend   = "json_array_append"
-	JSONArrayInsert   = "json_array_insert"
-	JSONMergePatch    = "json_merge_patch"
-	JSONMergePreserve = "json_merge_preserve"
-	JSONPretty        = "json_pretty"
-	JSONQuote         = "json_quote"
-	JSONSearch        = "json_search"
-	JSONStorageFree   = "json_storage_free"
-	JSONStorageSize   = "json_storage_size"
-	JSONDepth         = "json_depth"
-	JSONKeys          = "json_keys"
-	JSONLength        = "json_length"
+	JSONType                   = "json_type"
+	JSONExtract                = "json_extract"
+	JSONUnquote                = "json_unquote"
+	JSONArray                  = "json_array"
+	JSONObject                 = "json_object"
+	JSONMerge                  = "json_merge"
+	JSONSet                    = "json_set"
+	JSONInsert                 = "json_insert"
+	JSONReplace                = "json_replace"
+	JSONRemove                 = "json_remove"
+	JSONOverlaps               = "json_overlaps"
+	JSONContains               = "json_contains"
+	JSONMemberOf               = "json_memberof"
+	JSONContainsPath           = "json_contains_path"
+	JSONValid                  = "json_valid"
+	JSONArrayAppend            = "json_array_append"
+	JSONArrayInsert            = "json_array_insert"
+	JSONMergePatch             = "json_merge_patch"
+	JSONMergePreserve          = "json_merge_preserve"
+	JSONPretty                 = "json_pretty"
+	JSONQuote                  = "json_quote"
+	JSONSchemaValid            = "json_schema_valid"
+	JSONSchemaValidationReport = "json_schema_validation_report"
+	JSONSearch                 = "json_search"
+	JSONStorageFree            = "json_storage_free"
+	JSONStorageSize            = "json_storage_size"
+	JSONDepth                  = "json_depth"
+	JSONKeys                   = "json_keys"
+	JSONLength                 = "json_length"
+
+	// spatial functions
+	Point                = "point"
+	STArea               = "st_area"
+	STAsBinary           = "st_asbinary"
+	STAsText             = "st_astext"
+	STAsWKB              = "st_aswkb"
+	STAsWKT              = "st_aswkt"
+	STContains           = "st_contains"
+	STDisjoint           = "st_disjoint"
+	STDistance           = "st_distance"
+	STDistanceSphere     = "st_distance_sphere"
+	STEquals             = "st_equals"
+	STGeomFromText       = "st_geomfromtext"
+	STGeomFromWKB        = "st_geomfromwkb"
+	STGeometryFromText   = "st_geometryfromtext"
+	STGeometryFromWKB    = "st_geometryfromwkb"
+	STGeometryType       = "st_geometrytype"
+	STIntersects         = "st_intersects"
+	STIsEmpty            = "st_isempty"
+	STLength             = "st_length"
+	STLineFromText       = "st_linefromtext"
+	STLineStringFromText = "st_linestringfromtext"
+	STNumPoints          = "st_numpoints"
+	STPointFromText      = "st_pointfromtext"
+	STPolyFromText       = "st_polyfromtext"
+	STPolygonFromText    = "st_polygonfromtext"
+	STSRID               = "st_srid"
+	STWithin             = "st_within"
+	STX                  = "st_x"
+	STY                  = "st_y"
+
+	// full-text search functions
+	Match = "match"
+
+	// vector functions
+	VecAsText               = "vec_as_text"
+	VecCosineDistance       = "vec_cosine_distance"
+	VecDims                 = "vec_dims"
+	VecFromText             = "vec_from_text"
+	VecL1Distance           = "vec_l1_distance"
+	VecL2Distance           = "vec_l2_distance"
+	VecL2Norm               = "vec_l2_norm"
+	VecNegativeInnerProduct = "vec_negative_inner_product"
 
 	// TiDB internal function.
 	TiDBDecodeKey       = "tidb_decode_key"
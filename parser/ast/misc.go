@@ -2112,6 +2112,8 @@ const (
 	StatsTypeCardinality uint8 = iota
 	StatsTypeDependency
 	StatsTypeCorrelation
+	// StatsTypeJoint is collected by ANALYZE TABLE ... WITH JOINT STATS rather than by ALTER TABLE ... ADD STATS_EXTENDED.
+	StatsTypeJoint
 )
 
 // StatisticsSpec is the specification for ADD /DROP STATISTICS.
@@ -3605,6 +3607,7 @@ type TableOptimizerHint struct {
 	// - READ_FROM_STORAGE   => model.CIStr
 	// - USE_TOJA            => bool
 	// - NTH_PLAN            => int64
+	// - CARDINALITY         => ast.HintCardinality
 	HintData interface{}
 	// QBName is the default effective query block of this hint.
 	QBName  model.CIStr
@@ -3624,6 +3627,25 @@ type HintSetVar struct {
 	Value   string
 }
 
+// CardinalityOperator is the operator of the `CARDINALITY` hint.
+type CardinalityOperator byte
+
+// Operators of the `CARDINALITY` hint.
+const (
+	// CardinalityAbsolute sets the row count to the value.
+	CardinalityAbsolute CardinalityOperator = iota
+	// CardinalityMultiply multiplies the estimated row count by the value.
+	CardinalityMultiply
+	// CardinalityDivide divides the estimated row count by the value.
+	CardinalityDivide
+)
+
+// HintCardinality is the payload of `CARDINALITY` hint
+type HintCardinality struct {
+	Operator CardinalityOperator
+	Value    uint64
+}
+
 // HintTable is table in the hint. It may have query block info.
 type HintTable struct {
 	DBName        model.CIStr
@@ -3745,6 +3767,22 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain(hintData.VarName)
 		ctx.WritePlain(" = ")
 		ctx.WritePlain(hintData.Value)
+	case "cardinality":
+		for i, table := range n.Tables {
+			if i != 0 {
+				ctx.WritePlain(" ")
+			}
+			table.Restore(ctx)
+		}
+		ctx.WritePlain(", ")
+		hintData := n.HintData.(HintCardinality)
+		switch hintData.Operator {
+		case CardinalityMultiply:
+			ctx.WritePlain("*")
+		case CardinalityDivide:
+			ctx.WritePlain("/")
+		}
+		ctx.WritePlainf("%d", hintData.Value)
 	}
 	ctx.WritePlain(")")
 	return nil
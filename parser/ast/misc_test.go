@@ -306,6 +306,9 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"TIME_RANGE('2020-02-02 10:10:10','2020-02-02 11:10:10')", "TIME_RANGE('2020-02-02 10:10:10', '2020-02-02 11:10:10')"},
 		{"RESOURCE_GROUP(rg1)", "RESOURCE_GROUP(`rg1`)"},
 		{"RESOURCE_GROUP(`default`)", "RESOURCE_GROUP(`default`)"},
+		{"CARDINALITY(t1, 100)", "CARDINALITY(`t1`, 100)"},
+		{"CARDINALITY(@sel1 t1 test.t2, *10)", "CARDINALITY(@`sel1` `t1` `test`.`t2`, *10)"},
+		{"CARDINALITY(t1@sel1 t2@sel1, /5)", "CARDINALITY(`t1`@`sel1` `t2`@`sel1`, /5)"},
 	}
 	extractNodeFunc := func(node ast.Node) ast.Node {
 		return node.(*ast.SelectStmt).TableHints[0]
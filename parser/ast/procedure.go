@@ -36,6 +36,7 @@ var (
 	_ StmtNode = &ProcedureLabelBlock{}
 	_ StmtNode = &ProcedureLabelLoop{}
 	_ StmtNode = &ProcedureJump{}
+	_ StmtNode = &ProcedureReturn{}
 
 	_ DeclNode = &ProcedureErrorControl{}
 	_ DeclNode = &ProcedureCursor{}
@@ -69,6 +70,49 @@ const (
 	PROCEDUR_END
 )
 
+// RoutineDataAccess is the SQL data access characteristic of stored function.
+type RoutineDataAccess int
+
+// stored function SQL data access characteristic.
+const (
+	RoutineContainsSQL RoutineDataAccess = iota
+	RoutineNoSQL
+	RoutineReadsSQLData
+	RoutineModifiesSQLData
+)
+
+// String implements fmt.Stringer interface.
+func (a RoutineDataAccess) String() string {
+	switch a {
+	case RoutineNoSQL:
+		return "NO SQL"
+	case RoutineReadsSQLData:
+		return "READS SQL DATA"
+	case RoutineModifiesSQLData:
+		return "MODIFIES SQL DATA"
+	default:
+		return "CONTAINS SQL"
+	}
+}
+
+// FunctionCharacteristicType is the type of stored function characteristic.
+type FunctionCharacteristicType int
+
+// stored function characteristic type.
+const (
+	FunctionCharacteristicComment FunctionCharacteristicType = iota
+	FunctionCharacteristicDeterministic
+	FunctionCharacteristicDataAccess
+)
+
+// FunctionCharacteristic is a characteristic of `create function` statement.
+type FunctionCharacteristic struct {
+	Tp            FunctionCharacteristicType
+	Comment       string
+	Deterministic bool
+	DataAccess    RoutineDataAccess
+}
+
 // DeclNode expresses procedure block variable interface(include handler\cursor\sp variable)
 type DeclNode interface {
 	Node
@@ -230,6 +274,7 @@ func (n *ProcedureBlock) Accept(v Visitor) (Node, bool) {
 }
 
 // ProcedureInfo stores all procedure information.
+// It also stores the stored function when IsFunction is true.
 type ProcedureInfo struct {
 	stmtNode
 	IfNotExists       bool
@@ -237,11 +282,21 @@ type ProcedureInfo struct {
 	ProcedureParam    []*StoreParameter //procedure param
 	ProcedureBody     StmtNode          //procedure body statement
 	ProcedureParamStr string            //procedure parameter string
+
+	IsFunction    bool
+	ReturnType    *types.FieldType //function return type
+	Deterministic bool
+	DataAccess    RoutineDataAccess
+	Comment       string
 }
 
 // Restore implements Node interface.
 func (n *ProcedureInfo) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord("CREATE PROCEDURE ")
+	if n.IsFunction {
+		ctx.WriteKeyWord("CREATE FUNCTION ")
+	} else {
+		ctx.WriteKeyWord("CREATE PROCEDURE ")
+	}
 	if n.IfNotExists {
 		ctx.WriteKeyWord("IF NOT EXISTS ")
 	}
@@ -254,12 +309,38 @@ func (n *ProcedureInfo) Restore(ctx *format.RestoreCtx) error {
 		if i > 0 {
 			ctx.WritePlain(",")
 		}
+		if n.IsFunction {
+			// The parameters of function are always IN parameters and don't have the mode.
+			ctx.WriteName(ProcedureParam.ParamName)
+			ctx.WritePlain(" ")
+			ctx.WriteKeyWord(ProcedureParam.ParamType.CompactStr())
+			continue
+		}
 		err := ProcedureParam.Restore(ctx)
 		if err != nil {
 			return err
 		}
 	}
 	ctx.WritePlain(") ")
+	if n.IsFunction {
+		ctx.WriteKeyWord("RETURNS ")
+		if err := n.ReturnType.Restore(ctx); err != nil {
+			return err
+		}
+		ctx.WritePlain(" ")
+		if n.Deterministic {
+			ctx.WriteKeyWord("DETERMINISTIC ")
+		}
+		if n.DataAccess != RoutineContainsSQL {
+			ctx.WriteKeyWord(n.DataAccess.String())
+			ctx.WritePlain(" ")
+		}
+		if n.Comment != "" {
+			ctx.WriteKeyWord("COMMENT ")
+			ctx.WriteString(n.Comment)
+			ctx.WritePlain(" ")
+		}
+	}
 	err = (n.ProcedureBody).Restore(ctx)
 	if err != nil {
 		return err
@@ -289,17 +370,22 @@ func (n *ProcedureInfo) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
-// DropProcedureStmt represents the ast of `drop procedure`
+// DropProcedureStmt represents the ast of `drop procedure` and `drop function`
 type DropProcedureStmt struct {
 	stmtNode
 
 	IfExists      bool
+	IsFunction    bool
 	ProcedureName *TableName
 }
 
 // Restore implements DropProcedureStmt interface.
 func (n *DropProcedureStmt) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord("DROP PROCEDURE ")
+	if n.IsFunction {
+		ctx.WriteKeyWord("DROP FUNCTION ")
+	} else {
+		ctx.WriteKeyWord("DROP PROCEDURE ")
+	}
 	if n.IfExists {
 		ctx.WriteKeyWord("IF EXISTS ")
 	}
@@ -1174,3 +1260,30 @@ func (n *ProcedureJump) Accept(v Visitor) (Node, bool) {
 	n = newNode.(*ProcedureJump)
 	return v.Leave(n)
 }
+
+// ProcedureReturn stores the `return expr` statement of stored function.
+type ProcedureReturn struct {
+	stmtNode
+	Expr ExprNode
+}
+
+// Restore implements ProcedureReturn interface.
+func (n *ProcedureReturn) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RETURN ")
+	return n.Expr.Restore(ctx)
+}
+
+// Accept implements ProcedureReturn Accept interface.
+func (n *ProcedureReturn) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ProcedureReturn)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	return v.Leave(n)
+}
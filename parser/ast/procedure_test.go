@@ -99,6 +99,7 @@ func TestProcedure(t *testing.T) {
 		`create procedure proc_2() begin labelname: while id < 10 do set id = id + 1; select 1; end while; end`,
 		`create procedure proc_2() begin labelname: while id < 10 do set id = id + 1; select 1; end while labelname; end`,
 		`create procedure proc_2(id int) begin labelname: REPEAT set id = id + 1; select 1; UNTIL id < 10 end REPEAT labelname; end`,
+		`create procedure proc_2(id int) begin call proc_1(id, @a); call test.proc_1; end`,
 	}
 	for _, testcase := range testcases {
 		stmt, _, err := p.Parse(testcase, "", "")
@@ -217,9 +218,84 @@ func TestProcedureRestore(t *testing.T) {
 			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) BEGIN `labelname`: REPEAT SET @@SESSION.`id`=`id`+1;SELECT 1;UNTIL `id`<10 END REPEAT `labelname`; END",
 			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) BEGIN `labelname`: REPEAT SET @@SESSION.`id`=`id`+1;SELECT 1;UNTIL `id`<10 END REPEAT `labelname`; END",
 		},
+		{
+			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) BEGIN CALL `proc_1`(`id`, @`a`);CALL `test`.`proc_1`(); END",
+			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) BEGIN CALL `proc_1`(`id`, @`a`);CALL `test`.`proc_1`(); END",
+		},
 	}
 	extractNodeFunc := func(node ast.Node) ast.Node {
 		return node.(*ast.ProcedureInfo)
 	}
 	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
 }
+
+func TestFunction(t *testing.T) {
+	p := parser.New()
+	testcases := []string{
+		`create function f1() returns int return 1`,
+		`create function if not exists f1(a int, b varchar(10)) returns varchar(20) charset utf8mb4 begin declare c int default 1; return concat(a, b, c); end`,
+		`create function f1(a int) returns int deterministic no sql comment 'add one' return a + 1`,
+		`create function f1(a int) returns int not deterministic reads sql data begin return (select count(*) from t where id = a); end`,
+		`create function f1(a int) returns int modifies sql data contains sql begin if a > 1 then return 1; end if; return 0; end`,
+		`create function f1() returns int(11) (select 1)`,
+	}
+	for _, testcase := range testcases {
+		stmt, _, err := p.Parse(testcase, "", "")
+		require.NoError(t, err, testcase)
+		info, ok := stmt[0].(*ast.ProcedureInfo)
+		require.True(t, ok, testcase)
+		require.True(t, info.IsFunction, testcase)
+		require.NotNil(t, info.ReturnType, testcase)
+	}
+
+	stmt, _, err := p.Parse(`create function f1(a int) returns int deterministic reads sql data comment 'c' return a`, "", "")
+	require.NoError(t, err)
+	info := stmt[0].(*ast.ProcedureInfo)
+	require.True(t, info.Deterministic)
+	require.Equal(t, ast.RoutineReadsSQLData, info.DataAccess)
+	require.Equal(t, "c", info.Comment)
+	require.Equal(t, "a int", info.ProcedureParamStr)
+	require.Equal(t, "return a", info.ProcedureBody.Text())
+
+	// The parameters of function don't have the mode.
+	_, _, err = p.Parse(`create function f1(in a int) returns int return a`, "", "")
+	require.Error(t, err)
+	// RETURNS is required.
+	_, _, err = p.Parse(`create function f1(a int) return a`, "", "")
+	require.Error(t, err)
+
+	stmt, _, err = p.Parse("drop function if exists f1", "", "")
+	require.NoError(t, err)
+	drop, ok := stmt[0].(*ast.DropProcedureStmt)
+	require.True(t, ok)
+	require.True(t, drop.IsFunction)
+	require.True(t, drop.IfExists)
+	stmt, _, err = p.Parse("show create function test.f1", "", "")
+	require.NoError(t, err)
+	require.Equal(t, ast.ShowCreateFunction, int(stmt[0].(*ast.ShowStmt).Tp))
+}
+
+func TestFunctionRestore(t *testing.T) {
+	testCases := []NodeRestoreTestCase{
+		{
+			"CREATE FUNCTION `f1`(`a` INT(11),`b` VARCHAR(10)) RETURNS VARCHAR(20) DETERMINISTIC READS SQL DATA COMMENT 'c' BEGIN DECLARE `c` INT(11) DEFAULT 1;RETURN CONCAT(`a`, `b`, `c`); END",
+			"CREATE FUNCTION `f1`(`a` INT(11),`b` VARCHAR(10)) RETURNS VARCHAR(20) DETERMINISTIC READS SQL DATA COMMENT 'c' BEGIN DECLARE `c` INT(11) DEFAULT 1;RETURN CONCAT(`a`, `b`, `c`); END",
+		},
+		{
+			"CREATE FUNCTION IF NOT EXISTS `f1`() RETURNS INT(11) RETURN 1",
+			"CREATE FUNCTION IF NOT EXISTS `f1`() RETURNS INT(11) RETURN 1",
+		},
+		{
+			"DROP FUNCTION IF EXISTS `test`.`f1`",
+			"DROP FUNCTION IF EXISTS `test`.`f1`",
+		},
+		{
+			"SHOW CREATE FUNCTION `test`.`f1`",
+			"SHOW CREATE FUNCTION `test`.`f1`",
+		},
+	}
+	extractNodeFunc := func(node ast.Node) ast.Node {
+		return node
+	}
+	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
+}
@@ -55,6 +55,8 @@ const (
 	AnalyzeOptCMSketchWidth
 	AnalyzeOptNumSamples
 	AnalyzeOptSampleRate
+	// AnalyzeOptJointStats collects the joint statistics of the analyzed columns. It has no value.
+	AnalyzeOptJointStats
 )
 
 // AnalyzeOptionString stores the string form of analyze options.
@@ -65,6 +67,7 @@ var AnalyzeOptionString = map[AnalyzeOptionType]string{
 	AnalyzeOptCMSketchDepth: "CMSKETCH DEPTH",
 	AnalyzeOptNumSamples:    "SAMPLES",
 	AnalyzeOptSampleRate:    "SAMPLERATE",
+	AnalyzeOptJointStats:    "JOINT STATS",
 }
 
 // HistogramOperationType is the type for histogram operation.
@@ -164,6 +167,11 @@ func (n *AnalyzeTableStmt) Restore(ctx *format.RestoreCtx) error {
 			if i != 0 {
 				ctx.WritePlain(",")
 			}
+			if opt.Value == nil {
+				ctx.WritePlain(" ")
+				ctx.WriteKeyWord(AnalyzeOptionString[opt.Type])
+				continue
+			}
 			ctx.WritePlainf(" %v ", opt.Value.GetValue())
 			ctx.WritePlain(AnalyzeOptionString[opt.Type])
 		}
@@ -34,6 +34,7 @@ type yyhintSymType struct {
 	hints       []*ast.TableOptimizerHint
 	table       ast.HintTable
 	modelIdents []model.CIStr
+	cardinality ast.HintCardinality
 }
 
 type yyhintXError struct {
@@ -41,18 +42,19 @@ type yyhintXError struct {
 }
 
 const (
-	yyhintDefault             = 57433
+	yyhintDefault             = 57434
 	yyhintEOFCode             = 57344
 	yyhintErrCode             = 57345
 	hintAggToCop              = 57379
 	hintBCJoin                = 57401
 	hintBKA                   = 57355
 	hintBNL                   = 57357
-	hintDupsWeedOut           = 57429
-	hintFalse                 = 57425
-	hintFirstMatch            = 57430
+	hintCardinality           = 57420
+	hintDupsWeedOut           = 57430
+	hintFalse                 = 57426
+	hintFirstMatch            = 57431
 	hintForceIndex            = 57415
-	hintGB                    = 57428
+	hintGB                    = 57429
 	hintHashAgg               = 57381
 	hintHashJoin              = 57359
 	hintHashJoinBuild         = 57360
@@ -75,10 +77,10 @@ const (
 	hintJoinSuffix            = 57354
 	hintLeading               = 57417
 	hintLimitToCop            = 57414
-	hintLooseScan             = 57431
-	hintMB                    = 57427
+	hintLooseScan             = 57432
+	hintMB                    = 57428
 	hintMRR                   = 57367
-	hintMaterialization       = 57432
+	hintMaterialization       = 57433
 	hintMaxExecutionTime      = 57375
 	hintMemoryQuota           = 57394
 	hintMerge                 = 57363
@@ -102,10 +104,10 @@ const (
 	hintNoSkipScan            = 57372
 	hintNoSwapJoinInputs      = 57395
 	hintNthPlan               = 57413
-	hintOLAP                  = 57420
-	hintOLTP                  = 57421
+	hintOLAP                  = 57421
+	hintOLTP                  = 57422
 	hintOrderIndex            = 57407
-	hintPartition             = 57422
+	hintPartition             = 57423
 	hintQBName                = 57378
 	hintQueryType             = 57396
 	hintReadConsistentReplica = 57397
@@ -122,10 +124,10 @@ const (
 	hintStreamAgg             = 57403
 	hintStringLit             = 57350
 	hintSwapJoinInputs        = 57404
-	hintTiFlash               = 57424
-	hintTiKV                  = 57423
+	hintTiFlash               = 57425
+	hintTiKV                  = 57424
 	hintTimeRange             = 57411
-	hintTrue                  = 57426
+	hintTrue                  = 57427
 	hintUseCascades           = 57412
 	hintUseIndex              = 57406
 	hintUseIndexMerge         = 57405
@@ -133,145 +135,151 @@ const (
 	hintUseToja               = 57410
 
 	yyhintMaxDepth = 200
-	yyhintTabOfs   = -213
+	yyhintTabOfs   = -222
 )
 
 var (
 	yyhintXLAT = map[int]int{
-		41:    0,   // ')' (157x)
-		57379: 1,   // hintAggToCop (142x)
-		57401: 2,   // hintBCJoin (142x)
-		57355: 3,   // hintBKA (142x)
-		57357: 4,   // hintBNL (142x)
-		57415: 5,   // hintForceIndex (142x)
-		57381: 6,   // hintHashAgg (142x)
-		57359: 7,   // hintHashJoin (142x)
-		57360: 8,   // hintHashJoinBuild (142x)
-		57361: 9,   // hintHashJoinProbe (142x)
-		57384: 10,  // hintIgnoreIndex (142x)
-		57380: 11,  // hintIgnorePlanCache (142x)
-		57388: 12,  // hintIndexHashJoin (142x)
-		57385: 13,  // hintIndexJoin (142x)
-		57365: 14,  // hintIndexMerge (142x)
-		57392: 15,  // hintIndexMergeJoin (142x)
-		57387: 16,  // hintInlHashJoin (142x)
-		57390: 17,  // hintInlJoin (142x)
-		57391: 18,  // hintInlMergeJoin (142x)
-		57351: 19,  // hintJoinFixedOrder (142x)
-		57352: 20,  // hintJoinOrder (142x)
-		57353: 21,  // hintJoinPrefix (142x)
-		57354: 22,  // hintJoinSuffix (142x)
-		57417: 23,  // hintLeading (142x)
-		57414: 24,  // hintLimitToCop (142x)
-		57375: 25,  // hintMaxExecutionTime (142x)
-		57394: 26,  // hintMemoryQuota (142x)
-		57363: 27,  // hintMerge (142x)
-		57382: 28,  // hintMpp1PhaseAgg (142x)
-		57383: 29,  // hintMpp2PhaseAgg (142x)
-		57367: 30,  // hintMRR (142x)
-		57356: 31,  // hintNoBKA (142x)
-		57358: 32,  // hintNoBNL (142x)
-		57419: 33,  // hintNoDecorrelate (142x)
-		57362: 34,  // hintNoHashJoin (142x)
-		57369: 35,  // hintNoICP (142x)
-		57389: 36,  // hintNoIndexHashJoin (142x)
-		57386: 37,  // hintNoIndexJoin (142x)
-		57366: 38,  // hintNoIndexMerge (142x)
-		57393: 39,  // hintNoIndexMergeJoin (142x)
-		57364: 40,  // hintNoMerge (142x)
-		57368: 41,  // hintNoMRR (142x)
-		57408: 42,  // hintNoOrderIndex (142x)
-		57370: 43,  // hintNoRangeOptimization (142x)
-		57374: 44,  // hintNoSemijoin (142x)
-		57372: 45,  // hintNoSkipScan (142x)
-		57400: 46,  // hintNoSMJoin (142x)
-		57395: 47,  // hintNoSwapJoinInputs (142x)
-		57413: 48,  // hintNthPlan (142x)
-		57407: 49,  // hintOrderIndex (142x)
-		57378: 50,  // hintQBName (142x)
-		57396: 51,  // hintQueryType (142x)
-		57397: 52,  // hintReadConsistentReplica (142x)
-		57398: 53,  // hintReadFromStorage (142x)
-		57377: 54,  // hintResourceGroup (142x)
-		57373: 55,  // hintSemijoin (142x)
-		57418: 56,  // hintSemiJoinRewrite (142x)
-		57376: 57,  // hintSetVar (142x)
-		57402: 58,  // hintShuffleJoin (142x)
-		57371: 59,  // hintSkipScan (142x)
-		57399: 60,  // hintSMJoin (142x)
-		57416: 61,  // hintStraightJoin (142x)
-		57403: 62,  // hintStreamAgg (142x)
-		57404: 63,  // hintSwapJoinInputs (142x)
-		57411: 64,  // hintTimeRange (142x)
-		57412: 65,  // hintUseCascades (142x)
-		57406: 66,  // hintUseIndex (142x)
-		57405: 67,  // hintUseIndexMerge (142x)
-		57409: 68,  // hintUsePlanCache (142x)
-		57410: 69,  // hintUseToja (142x)
-		44:    70,  // ',' (139x)
-		57429: 71,  // hintDupsWeedOut (119x)
-		57430: 72,  // hintFirstMatch (119x)
-		57431: 73,  // hintLooseScan (119x)
-		57432: 74,  // hintMaterialization (119x)
-		57424: 75,  // hintTiFlash (119x)
-		57423: 76,  // hintTiKV (119x)
-		57425: 77,  // hintFalse (118x)
-		57420: 78,  // hintOLAP (118x)
-		57421: 79,  // hintOLTP (118x)
-		57426: 80,  // hintTrue (118x)
-		57428: 81,  // hintGB (117x)
-		57427: 82,  // hintMB (117x)
-		57347: 83,  // hintIdentifier (116x)
-		57349: 84,  // hintSingleAtIdentifier (102x)
-		93:    85,  // ']' (93x)
-		46:    86,  // '.' (92x)
-		57422: 87,  // hintPartition (87x)
-		61:    88,  // '=' (83x)
-		40:    89,  // '(' (78x)
-		57344: 90,  // $end (25x)
-		57453: 91,  // QueryBlockOpt (20x)
-		57445: 92,  // Identifier (15x)
-		57346: 93,  // hintIntLit (10x)
-		57350: 94,  // hintStringLit (5x)
-		57435: 95,  // CommaOpt (4x)
-		57441: 96,  // HintTable (4x)
-		57442: 97,  // HintTableList (4x)
-		91:    98,  // '[' (3x)
-		57434: 99,  // BooleanHintName (2x)
-		57436: 100, // HintIndexList (2x)
-		57438: 101, // HintStorageType (2x)
-		57439: 102, // HintStorageTypeAndTable (2x)
-		57443: 103, // HintTableListOpt (2x)
-		57448: 104, // JoinOrderOptimizerHintName (2x)
-		57449: 105, // NullaryHintName (2x)
-		57452: 106, // PartitionListOpt (2x)
-		57455: 107, // StorageOptimizerHintOpt (2x)
-		57456: 108, // SubqueryOptimizerHintName (2x)
-		57459: 109, // SubqueryStrategy (2x)
-		57460: 110, // SupportedIndexLevelOptimizerHintName (2x)
-		57461: 111, // SupportedTableLevelOptimizerHintName (2x)
-		57462: 112, // TableOptimizerHintOpt (2x)
-		57464: 113, // UnsupportedIndexLevelOptimizerHintName (2x)
-		57465: 114, // UnsupportedTableLevelOptimizerHintName (2x)
-		57467: 115, // ViewName (2x)
-		43:    116, // '+' (1x)
-		45:    117, // '-' (1x)
-		57437: 118, // HintQueryType (1x)
-		57440: 119, // HintStorageTypeAndTableList (1x)
-		57444: 120, // HintTrueOrFalse (1x)
-		57446: 121, // IndexNameList (1x)
-		57447: 122, // IndexNameListOpt (1x)
-		57450: 123, // OptimizerHintList (1x)
-		57451: 124, // PartitionList (1x)
-		57454: 125, // Start (1x)
-		57457: 126, // SubqueryStrategies (1x)
-		57458: 127, // SubqueryStrategiesOpt (1x)
-		57463: 128, // UnitOfBytes (1x)
-		57466: 129, // Value (1x)
-		57468: 130, // ViewNameList (1x)
-		57433: 131, // $default (0x)
-		57345: 132, // error (0x)
-		57348: 133, // hintInvalid (0x)
+		41:    0,   // ')' (162x)
+		57379: 1,   // hintAggToCop (154x)
+		57401: 2,   // hintBCJoin (154x)
+		57355: 3,   // hintBKA (154x)
+		57357: 4,   // hintBNL (154x)
+		57420: 5,   // hintCardinality (154x)
+		57415: 6,   // hintForceIndex (154x)
+		57381: 7,   // hintHashAgg (154x)
+		57359: 8,   // hintHashJoin (154x)
+		57360: 9,   // hintHashJoinBuild (154x)
+		57361: 10,  // hintHashJoinProbe (154x)
+		57384: 11,  // hintIgnoreIndex (154x)
+		57380: 12,  // hintIgnorePlanCache (154x)
+		57388: 13,  // hintIndexHashJoin (154x)
+		57385: 14,  // hintIndexJoin (154x)
+		57365: 15,  // hintIndexMerge (154x)
+		57392: 16,  // hintIndexMergeJoin (154x)
+		57387: 17,  // hintInlHashJoin (154x)
+		57390: 18,  // hintInlJoin (154x)
+		57391: 19,  // hintInlMergeJoin (154x)
+		57351: 20,  // hintJoinFixedOrder (154x)
+		57352: 21,  // hintJoinOrder (154x)
+		57353: 22,  // hintJoinPrefix (154x)
+		57354: 23,  // hintJoinSuffix (154x)
+		57417: 24,  // hintLeading (154x)
+		57414: 25,  // hintLimitToCop (154x)
+		57375: 26,  // hintMaxExecutionTime (154x)
+		57394: 27,  // hintMemoryQuota (154x)
+		57363: 28,  // hintMerge (154x)
+		57382: 29,  // hintMpp1PhaseAgg (154x)
+		57383: 30,  // hintMpp2PhaseAgg (154x)
+		57367: 31,  // hintMRR (154x)
+		57356: 32,  // hintNoBKA (154x)
+		57358: 33,  // hintNoBNL (154x)
+		57419: 34,  // hintNoDecorrelate (154x)
+		57362: 35,  // hintNoHashJoin (154x)
+		57369: 36,  // hintNoICP (154x)
+		57389: 37,  // hintNoIndexHashJoin (154x)
+		57386: 38,  // hintNoIndexJoin (154x)
+		57366: 39,  // hintNoIndexMerge (154x)
+		57393: 40,  // hintNoIndexMergeJoin (154x)
+		57364: 41,  // hintNoMerge (154x)
+		57368: 42,  // hintNoMRR (154x)
+		57408: 43,  // hintNoOrderIndex (154x)
+		57370: 44,  // hintNoRangeOptimization (154x)
+		57374: 45,  // hintNoSemijoin (154x)
+		57372: 46,  // hintNoSkipScan (154x)
+		57400: 47,  // hintNoSMJoin (154x)
+		57395: 48,  // hintNoSwapJoinInputs (154x)
+		57413: 49,  // hintNthPlan (154x)
+		57407: 50,  // hintOrderIndex (154x)
+		57378: 51,  // hintQBName (154x)
+		57396: 52,  // hintQueryType (154x)
+		57397: 53,  // hintReadConsistentReplica (154x)
+		57398: 54,  // hintReadFromStorage (154x)
+		57377: 55,  // hintResourceGroup (154x)
+		57373: 56,  // hintSemijoin (154x)
+		57418: 57,  // hintSemiJoinRewrite (154x)
+		57376: 58,  // hintSetVar (154x)
+		57402: 59,  // hintShuffleJoin (154x)
+		57371: 60,  // hintSkipScan (154x)
+		57399: 61,  // hintSMJoin (154x)
+		57416: 62,  // hintStraightJoin (154x)
+		57403: 63,  // hintStreamAgg (154x)
+		57404: 64,  // hintSwapJoinInputs (154x)
+		57411: 65,  // hintTimeRange (154x)
+		57412: 66,  // hintUseCascades (154x)
+		57406: 67,  // hintUseIndex (154x)
+		57405: 68,  // hintUseIndexMerge (154x)
+		57409: 69,  // hintUsePlanCache (154x)
+		57410: 70,  // hintUseToja (154x)
+		44:    71,  // ',' (148x)
+		57430: 72,  // hintDupsWeedOut (130x)
+		57431: 73,  // hintFirstMatch (130x)
+		57432: 74,  // hintLooseScan (130x)
+		57433: 75,  // hintMaterialization (130x)
+		57425: 76,  // hintTiFlash (130x)
+		57424: 77,  // hintTiKV (130x)
+		57426: 78,  // hintFalse (129x)
+		57421: 79,  // hintOLAP (129x)
+		57422: 80,  // hintOLTP (129x)
+		57427: 81,  // hintTrue (129x)
+		57429: 82,  // hintGB (128x)
+		57428: 83,  // hintMB (128x)
+		57347: 84,  // hintIdentifier (127x)
+		57349: 85,  // hintSingleAtIdentifier (106x)
+		46:    86,  // '.' (94x)
+		93:    87,  // ']' (94x)
+		57423: 88,  // hintPartition (88x)
+		61:    89,  // '=' (84x)
+		40:    90,  // '(' (79x)
+		57344: 91,  // $end (26x)
+		57457: 92,  // QueryBlockOpt (23x)
+		57449: 93,  // Identifier (18x)
+		57346: 94,  // hintIntLit (13x)
+		57350: 95,  // hintStringLit (5x)
+		57439: 96,  // CommaOpt (4x)
+		57445: 97,  // HintTable (4x)
+		57446: 98,  // HintTableList (4x)
+		91:    99,  // '[' (3x)
+		57435: 100, // BooleanHintName (2x)
+		57436: 101, // CardinalityHintTable (2x)
+		57440: 102, // HintIndexList (2x)
+		57442: 103, // HintStorageType (2x)
+		57443: 104, // HintStorageTypeAndTable (2x)
+		57447: 105, // HintTableListOpt (2x)
+		57452: 106, // JoinOrderOptimizerHintName (2x)
+		57453: 107, // NullaryHintName (2x)
+		57456: 108, // PartitionListOpt (2x)
+		57459: 109, // StorageOptimizerHintOpt (2x)
+		57460: 110, // SubqueryOptimizerHintName (2x)
+		57463: 111, // SubqueryStrategy (2x)
+		57464: 112, // SupportedIndexLevelOptimizerHintName (2x)
+		57465: 113, // SupportedTableLevelOptimizerHintName (2x)
+		57466: 114, // TableOptimizerHintOpt (2x)
+		57468: 115, // UnsupportedIndexLevelOptimizerHintName (2x)
+		57469: 116, // UnsupportedTableLevelOptimizerHintName (2x)
+		57471: 117, // ViewName (2x)
+		42:    118, // '*' (1x)
+		43:    119, // '+' (1x)
+		45:    120, // '-' (1x)
+		47:    121, // '/' (1x)
+		57437: 122, // CardinalityHintTableList (1x)
+		57438: 123, // CardinalityValue (1x)
+		57441: 124, // HintQueryType (1x)
+		57444: 125, // HintStorageTypeAndTableList (1x)
+		57448: 126, // HintTrueOrFalse (1x)
+		57450: 127, // IndexNameList (1x)
+		57451: 128, // IndexNameListOpt (1x)
+		57454: 129, // OptimizerHintList (1x)
+		57455: 130, // PartitionList (1x)
+		57458: 131, // Start (1x)
+		57461: 132, // SubqueryStrategies (1x)
+		57462: 133, // SubqueryStrategiesOpt (1x)
+		57467: 134, // UnitOfBytes (1x)
+		57470: 135, // Value (1x)
+		57472: 136, // ViewNameList (1x)
+		57434: 137, // $default (0x)
+		57345: 138, // error (0x)
+		57348: 139, // hintInvalid (0x)
 	}
 
 	yyhintSymNames = []string{
@@ -280,6 +288,7 @@ var (
 		"hintBCJoin",
 		"hintBKA",
 		"hintBNL",
+		"hintCardinality",
 		"hintForceIndex",
 		"hintHashAgg",
 		"hintHashJoin",
@@ -360,8 +369,8 @@ var (
 		"hintMB",
 		"hintIdentifier",
 		"hintSingleAtIdentifier",
-		"']'",
 		"'.'",
+		"']'",
 		"hintPartition",
 		"'='",
 		"'('",
@@ -375,6 +384,7 @@ var (
 		"HintTableList",
 		"'['",
 		"BooleanHintName",
+		"CardinalityHintTable",
 		"HintIndexList",
 		"HintStorageType",
 		"HintStorageTypeAndTable",
@@ -391,8 +401,12 @@ var (
 		"UnsupportedIndexLevelOptimizerHintName",
 		"UnsupportedTableLevelOptimizerHintName",
 		"ViewName",
+		"'*'",
 		"'+'",
 		"'-'",
+		"'/'",
+		"CardinalityHintTableList",
+		"CardinalityValue",
 		"HintQueryType",
 		"HintStorageTypeAndTableList",
 		"HintTrueOrFalse",
@@ -413,98 +427,85 @@ var (
 
 	yyhintReductions = []struct{ xsym, components int }{
 		{0, 1},
+		{131, 1},
+		{129, 1},
+		{129, 3},
+		{129, 1},
+		{129, 3},
+		{114, 4},
+		{114, 4},
+		{114, 4},
+		{114, 4},
+		{114, 4},
+		{114, 4},
+		{114, 5},
+		{114, 5},
+		{114, 5},
+		{114, 6},
+		{114, 4},
+		{114, 4},
+		{114, 6},
+		{114, 6},
+		{114, 6},
+		{114, 5},
+		{114, 4},
+		{114, 5},
+		{114, 7},
+		{109, 5},
 		{125, 1},
-		{123, 1},
-		{123, 3},
-		{123, 1},
-		{123, 3},
-		{112, 4},
-		{112, 4},
-		{112, 4},
-		{112, 4},
-		{112, 4},
-		{112, 4},
-		{112, 5},
-		{112, 5},
-		{112, 5},
-		{112, 6},
-		{112, 4},
-		{112, 4},
-		{112, 6},
-		{112, 6},
-		{112, 6},
-		{112, 5},
-		{112, 4},
-		{112, 5},
-		{107, 5},
-		{119, 1},
-		{119, 3},
-		{102, 4},
-		{91, 0},
-		{91, 1},
-		{95, 0},
-		{95, 1},
-		{106, 0},
-		{106, 4},
-		{124, 1},
-		{124, 3},
-		{103, 1},
-		{103, 1},
-		{97, 2},
-		{97, 3},
-		{96, 3},
-		{96, 5},
-		{130, 3},
+		{125, 3},
+		{104, 4},
+		{92, 0},
+		{92, 1},
+		{96, 0},
+		{96, 1},
+		{108, 0},
+		{108, 4},
 		{130, 1},
-		{115, 2},
-		{115, 1},
-		{100, 4},
-		{122, 0},
+		{130, 3},
+		{105, 1},
+		{105, 1},
+		{98, 2},
+		{98, 3},
+		{97, 3},
+		{97, 5},
+		{136, 3},
+		{136, 1},
+		{117, 2},
+		{117, 1},
 		{122, 1},
-		{121, 1},
-		{121, 3},
-		{127, 0},
+		{122, 2},
+		{101, 2},
+		{101, 4},
+		{123, 1},
+		{123, 2},
+		{123, 2},
+		{102, 4},
+		{128, 0},
+		{128, 1},
 		{127, 1},
+		{127, 3},
+		{133, 0},
+		{133, 1},
+		{132, 1},
+		{132, 3},
+		{135, 1},
+		{135, 1},
+		{135, 1},
+		{135, 2},
+		{135, 2},
+		{134, 1},
+		{134, 1},
 		{126, 1},
-		{126, 3},
-		{129, 1},
-		{129, 1},
-		{129, 1},
-		{129, 2},
-		{129, 2},
-		{128, 1},
-		{128, 1},
-		{120, 1},
-		{120, 1},
-		{104, 1},
-		{104, 1},
-		{104, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
-		{111, 1},
+		{126, 1},
+		{106, 1},
+		{106, 1},
+		{106, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
 		{113, 1},
 		{113, 1},
 		{113, 1},
@@ -512,487 +513,533 @@ var (
 		{113, 1},
 		{113, 1},
 		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{113, 1},
+		{115, 1},
+		{115, 1},
+		{115, 1},
+		{115, 1},
+		{115, 1},
+		{115, 1},
+		{115, 1},
+		{112, 1},
+		{112, 1},
+		{112, 1},
+		{112, 1},
+		{112, 1},
+		{112, 1},
 		{110, 1},
 		{110, 1},
-		{110, 1},
-		{110, 1},
-		{110, 1},
-		{110, 1},
-		{108, 1},
-		{108, 1},
-		{109, 1},
-		{109, 1},
-		{109, 1},
-		{109, 1},
-		{99, 1},
-		{99, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{105, 1},
-		{118, 1},
-		{118, 1},
-		{101, 1},
-		{101, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
-		{92, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{100, 1},
+		{100, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{107, 1},
+		{124, 1},
+		{124, 1},
+		{103, 1},
+		{103, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
+		{93, 1},
 	}
 
 	yyhintXErrors = map[yyhintXError]string{}
 
-	yyhintParseTab = [302][]uint16{
+	yyhintParseTab = [322][]uint16{
 		// 0
-		{1: 287, 246, 239, 241, 275, 283, 260, 262, 263, 273, 291, 253, 249, 265, 258, 252, 248, 257, 218, 236, 237, 238, 264, 288, 225, 230, 251, 284, 285, 266, 240, 242, 294, 261, 268, 254, 250, 289, 259, 243, 267, 277, 269, 279, 271, 245, 256, 226, 276, 229, 234, 290, 235, 228, 278, 293, 227, 247, 270, 244, 292, 286, 255, 231, 281, 272, 274, 282, 280, 99: 232, 104: 219, 233, 107: 217, 224, 110: 223, 221, 216, 222, 220, 123: 215, 125: 214},
-		{90: 213},
-		{1: 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 399, 90: 212, 95: 512},
-		{1: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 90: 211},
-		{1: 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 90: 209},
+		{1: 297, 256, 249, 251, 244, 285, 293, 270, 272, 273, 283, 301, 263, 259, 275, 268, 262, 258, 267, 227, 246, 247, 248, 274, 298, 234, 239, 261, 294, 295, 276, 250, 252, 304, 271, 278, 264, 260, 299, 269, 253, 277, 287, 279, 289, 281, 255, 266, 235, 286, 238, 243, 300, 245, 237, 288, 303, 236, 257, 280, 254, 302, 296, 265, 240, 291, 282, 284, 292, 290, 100: 241, 106: 228, 242, 109: 226, 233, 112: 232, 230, 225, 231, 229, 129: 224, 131: 223},
+		{91: 222},
+		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 410, 91: 221, 96: 541},
+		{1: 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 91: 220},
+		{1: 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 91: 218},
 		// 5
-		{89: 509},
-		{89: 506},
-		{89: 503},
-		{89: 498},
-		{89: 495},
+		{90: 538},
+		{90: 535},
+		{90: 532},
+		{90: 527},
+		{90: 524},
 		// 10
-		{89: 484},
-		{89: 472},
-		{89: 468},
-		{89: 464},
-		{89: 452},
+		{90: 513},
+		{90: 501},
+		{90: 497},
+		{90: 493},
+		{90: 481},
 		// 15
-		{89: 449},
-		{89: 437},
-		{89: 430},
-		{89: 425},
-		{89: 419},
+		{90: 478},
+		{90: 466},
+		{90: 459},
+		{90: 454},
+		{90: 448},
 		// 20
-		{89: 416},
-		{89: 410},
-		{89: 295},
-		{89: 149},
-		{89: 148},
+		{90: 445},
+		{90: 439},
+		{90: 421},
+		{90: 305},
+		{90: 150},
 		// 25
-		{89: 147},
-		{89: 146},
-		{89: 145},
-		{89: 144},
-		{89: 143},
+		{90: 149},
+		{90: 148},
+		{90: 147},
+		{90: 146},
+		{90: 145},
 		// 30
-		{89: 142},
-		{89: 141},
-		{89: 140},
-		{89: 139},
-		{89: 138},
+		{90: 144},
+		{90: 143},
+		{90: 142},
+		{90: 141},
+		{90: 140},
 		// 35
-		{89: 137},
-		{89: 136},
-		{89: 135},
-		{89: 134},
-		{89: 133},
+		{90: 139},
+		{90: 138},
+		{90: 137},
+		{90: 136},
+		{90: 135},
 		// 40
-		{89: 132},
-		{89: 131},
-		{89: 130},
-		{89: 129},
-		{89: 128},
+		{90: 134},
+		{90: 133},
+		{90: 132},
+		{90: 131},
+		{90: 130},
 		// 45
-		{89: 127},
-		{89: 126},
-		{89: 125},
-		{89: 124},
-		{89: 123},
+		{90: 129},
+		{90: 128},
+		{90: 127},
+		{90: 126},
+		{90: 125},
 		// 50
-		{89: 122},
-		{89: 121},
-		{89: 120},
-		{89: 119},
-		{89: 118},
+		{90: 124},
+		{90: 123},
+		{90: 122},
+		{90: 121},
+		{90: 120},
 		// 55
-		{89: 117},
-		{89: 116},
-		{89: 115},
-		{89: 114},
-		{89: 113},
+		{90: 119},
+		{90: 118},
+		{90: 117},
+		{90: 116},
+		{90: 115},
 		// 60
-		{89: 112},
-		{89: 111},
-		{89: 110},
-		{89: 109},
-		{89: 108},
+		{90: 114},
+		{90: 113},
+		{90: 112},
+		{90: 111},
+		{90: 110},
 		// 65
-		{89: 107},
-		{89: 106},
-		{89: 101},
-		{89: 100},
-		{89: 99},
+		{90: 109},
+		{90: 108},
+		{90: 107},
+		{90: 102},
+		{90: 101},
 		// 70
-		{89: 98},
-		{89: 97},
-		{89: 96},
-		{89: 95},
-		{89: 94},
+		{90: 100},
+		{90: 99},
+		{90: 98},
+		{90: 97},
+		{90: 96},
 		// 75
-		{89: 93},
-		{89: 92},
-		{89: 91},
-		{89: 90},
-		{89: 89},
+		{90: 95},
+		{90: 94},
+		{90: 93},
+		{90: 92},
+		{90: 91},
 		// 80
-		{89: 88},
-		{89: 87},
-		{75: 185, 185, 84: 297, 91: 296},
-		{75: 302, 301, 101: 300, 299, 119: 298},
-		{184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 85: 184, 184, 184, 93: 184},
+		{90: 90},
+		{90: 89},
+		{90: 88},
+		{76: 193, 193, 85: 307, 92: 306},
+		{76: 312, 311, 103: 310, 309, 125: 308},
 		// 85
-		{407, 70: 408},
-		{188, 70: 188},
-		{98: 303},
-		{98: 84},
-		{98: 83},
+		{192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 86: 192, 192, 192, 94: 192},
+		{418, 71: 419},
+		{196, 71: 196},
+		{99: 313},
+		{99: 85},
 		// 90
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 71: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 297, 91: 305, 97: 304},
-		{70: 405, 85: 404},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 307, 96: 306},
-		{175, 70: 175, 85: 175},
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 297, 185, 391, 185, 91: 390},
+		{99: 84},
+		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 72: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 92: 315, 98: 314},
+		{71: 416, 87: 415},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 317, 97: 316},
+		{183, 71: 183, 87: 183},
 		// 95
-		{82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82},
-		{81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81},
-		{80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80},
-		{79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79},
-		{78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78},
+		{193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 402, 193, 193, 92: 401},
+		{83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83},
+		{82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82},
+		{81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81},
+		{80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80},
 		// 100
-		{77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77},
-		{76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76},
-		{75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75},
-		{74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74},
-		{73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73},
+		{79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79},
+		{78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78},
+		{77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77},
+		{76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76},
+		{75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75},
 		// 105
-		{72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72},
-		{71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71},
-		{70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70},
-		{69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69},
-		{68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68},
+		{74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74},
+		{73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73},
+		{72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72},
+		{71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71},
+		{70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70},
 		// 110
-		{67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67},
-		{66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66},
-		{65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65},
-		{64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64},
-		{63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63},
+		{69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69},
+		{68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68},
+		{67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67},
+		{66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66},
+		{65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65},
 		// 115
-		{62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62},
-		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
-		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
-		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
-		{58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
+		{64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64},
+		{63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63},
+		{62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62},
+		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
+		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
 		// 120
-		{57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
-		{56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
-		{55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
-		{54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
-		{53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
+		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
+		{58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
+		{57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
+		{56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
+		{55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
 		// 125
-		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
-		{51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
-		{50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
-		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
-		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
+		{54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
+		{53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
+		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
+		{51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
+		{50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
 		// 130
-		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47},
-		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46},
-		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
-		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44},
-		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43},
+		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
+		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
+		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47},
+		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46},
+		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
 		// 135
-		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42},
-		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41},
-		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40},
-		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39},
-		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38},
+		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44},
+		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43},
+		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42},
+		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41},
+		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40},
 		// 140
-		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37},
-		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36},
-		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35},
-		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34},
-		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39},
+		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38},
+		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37},
+		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36},
+		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35},
 		// 145
-		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32},
-		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31},
-		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
-		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29},
-		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34},
+		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33},
+		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32},
+		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31},
+		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
 		// 150
-		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27},
-		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26},
-		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25},
-		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24},
-		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23},
+		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29},
+		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27},
+		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26},
+		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25},
 		// 155
-		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22},
-		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21},
-		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20},
-		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19},
-		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18},
+		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24},
+		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23},
+		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22},
+		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21},
+		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20},
 		// 160
-		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17},
-		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16},
-		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15},
-		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14},
-		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13},
+		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19},
+		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18},
+		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17},
+		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16},
+		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15},
 		// 165
-		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12},
-		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11},
-		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
-		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
-		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8},
+		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14},
+		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13},
+		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12},
+		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11},
+		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
 		// 170
-		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7},
-		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
-		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
-		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
-		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
+		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8},
+		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7},
+		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
+		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
 		// 175
-		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
-		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 85: 181, 87: 394, 106: 403},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 392},
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 297, 185, 87: 185, 91: 393},
+		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		{189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 87: 189, 405, 108: 414},
 		// 180
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 85: 181, 87: 394, 106: 395},
-		{89: 396},
-		{172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 85: 172},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 398, 124: 397},
-		{400, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 399, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 95: 401},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 403},
+		{193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 87: 193, 193, 92: 404},
+		{189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 87: 189, 405, 108: 406},
+		{90: 407},
+		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 87: 180},
 		// 185
-		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179},
-		{182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 71: 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 94: 182},
-		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 85: 180},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 402},
-		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 409, 130: 408},
+		{411, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 410, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 96: 412},
+		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187},
+		{190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 72: 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 95: 190},
+		{188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 87: 188},
 		// 190
-		{173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 85: 173},
-		{186, 70: 186},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 307, 96: 406},
-		{174, 70: 174, 85: 174},
-		{1: 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 90: 189},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 413},
+		{186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186},
+		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 87: 181},
+		{194, 71: 194},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 317, 97: 417},
 		// 195
-		{75: 302, 301, 101: 300, 409},
-		{187, 70: 187},
-		{78: 185, 185, 84: 297, 91: 411},
-		{78: 413, 414, 118: 412},
-		{415},
+		{182, 71: 182, 87: 182},
+		{1: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 91: 197},
+		{76: 312, 311, 103: 310, 420},
+		{195, 71: 195},
+		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 72: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 92: 422},
 		// 200
-		{86},
-		{85},
-		{1: 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 90: 190},
-		{185, 84: 297, 91: 417},
-		{418},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 425, 101: 424, 122: 423},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 430, 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 425, 101: 431},
+		{1: 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175},
+		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 427, 92: 426},
+		{1: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
 		// 205
-		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 90: 191},
-		{77: 185, 80: 185, 84: 297, 91: 420},
-		{77: 423, 80: 422, 120: 421},
-		{424},
-		{151},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 428},
+		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 92: 429},
+		{1: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172},
+		{94: 433, 118: 434, 121: 435, 123: 432},
+		{1: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174},
 		// 210
-		{150},
-		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 90: 192},
-		{94: 426},
-		{70: 399, 94: 183, 427},
-		{94: 428},
+		{438},
+		{171},
+		{94: 437},
+		{94: 436},
+		{169},
 		// 215
-		{429},
-		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 90: 193},
-		{84: 297, 91: 431, 93: 185},
-		{93: 432},
-		{81: 435, 434, 128: 433},
+		{170},
+		{1: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 91: 198},
+		{79: 193, 193, 85: 307, 92: 440},
+		{79: 442, 443, 124: 441},
+		{444},
 		// 220
-		{436},
-		{153},
-		{152},
-		{1: 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 90: 194},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 438},
+		{87},
+		{86},
+		{1: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 91: 199},
+		{193, 85: 307, 92: 446},
+		{447},
 		// 225
-		{439, 70: 440},
-		{1: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 90: 196},
-		{185, 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 297, 86: 185, 91: 444, 443, 115: 442, 130: 441},
-		{446, 86: 447},
-		{170, 86: 170},
+		{1: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 91: 200},
+		{78: 193, 81: 193, 85: 307, 92: 449},
+		{78: 452, 81: 451, 126: 450},
+		{453},
+		{152},
 		// 230
-		{185, 84: 297, 86: 185, 91: 445},
-		{168, 86: 168},
-		{169, 86: 169},
-		{1: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 90: 195},
-		{185, 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 297, 86: 185, 91: 444, 443, 115: 448},
+		{151},
+		{1: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 91: 201},
+		{95: 455},
+		{71: 410, 95: 191, 456},
+		{95: 457},
 		// 235
-		{171, 86: 171},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 450},
-		{451},
-		{1: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 90: 197},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 453},
+		{458},
+		{1: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 91: 202},
+		{85: 307, 92: 460, 94: 193},
+		{94: 461},
+		{82: 464, 463, 134: 462},
 		// 240
-		{88: 454},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 457, 458, 456, 116: 459, 460, 129: 455},
-		{463},
-		{158},
-		{157},
-		// 245
-		{156},
-		{93: 462},
-		{93: 461},
+		{465},
 		{154},
-		{155},
+		{153},
+		{1: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 91: 203},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 467},
+		// 245
+		{468, 71: 469},
+		{1: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 91: 205},
+		{193, 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 307, 193, 92: 473, 472, 117: 471, 136: 470},
+		{475, 86: 476},
+		{178, 86: 178},
 		// 250
-		{1: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 90: 198},
-		{84: 297, 91: 465, 93: 185},
-		{93: 466},
-		{467},
-		{1: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 90: 199},
+		{193, 85: 307, 193, 92: 474},
+		{176, 86: 176},
+		{177, 86: 177},
+		{1: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 91: 204},
+		{193, 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 307, 193, 92: 473, 472, 117: 477},
 		// 255
-		{84: 297, 91: 469, 93: 185},
-		{93: 470},
-		{471},
-		{1: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 90: 200},
-		{185, 71: 185, 185, 185, 185, 84: 297, 91: 473},
+		{179, 86: 179},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 479},
+		{480},
+		{1: 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 91: 206},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 482},
 		// 260
-		{162, 71: 477, 478, 479, 480, 109: 476, 126: 475, 474},
-		{483},
-		{161, 70: 481},
-		{160, 70: 160},
-		{105, 70: 105},
+		{89: 483},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 486, 487, 485, 119: 488, 489, 135: 484},
+		{492},
+		{159},
+		{158},
 		// 265
-		{104, 70: 104},
-		{103, 70: 103},
-		{102, 70: 102},
-		{71: 477, 478, 479, 480, 109: 482},
-		{159, 70: 159},
+		{157},
+		{94: 491},
+		{94: 490},
+		{155},
+		{156},
 		// 270
-		{1: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 90: 201},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 71: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 297, 91: 486, 100: 485},
-		{494},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 307, 96: 487},
-		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 399, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 95: 488},
+		{1: 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 91: 207},
+		{85: 307, 92: 494, 94: 193},
+		{94: 495},
+		{496},
+		{1: 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 91: 208},
 		// 275
-		{166, 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 491, 121: 490, 489},
-		{167},
-		{165, 70: 492},
-		{164, 70: 164},
-		{1: 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 493},
+		{85: 307, 92: 498, 94: 193},
+		{94: 499},
+		{500},
+		{1: 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 91: 209},
+		{193, 72: 193, 193, 193, 193, 85: 307, 92: 502},
 		// 280
-		{163, 70: 163},
-		{1: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 90: 202},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 71: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 297, 91: 486, 100: 496},
-		{497},
-		{1: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 90: 203},
+		{163, 72: 506, 507, 508, 509, 111: 505, 132: 504, 503},
+		{512},
+		{162, 71: 510},
+		{161, 71: 161},
+		{106, 71: 106},
 		// 285
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 71: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 297, 91: 501, 97: 500, 103: 499},
-		{502},
-		{177, 70: 405},
-		{176, 337, 360, 313, 315, 373, 340, 317, 318, 319, 343, 339, 345, 348, 323, 351, 344, 347, 350, 309, 310, 311, 312, 375, 338, 333, 353, 321, 341, 342, 325, 314, 316, 377, 320, 327, 346, 349, 324, 352, 322, 326, 367, 328, 332, 330, 359, 354, 372, 366, 336, 355, 356, 357, 335, 331, 376, 334, 361, 329, 358, 374, 362, 363, 370, 371, 365, 364, 368, 369, 71: 386, 387, 388, 389, 381, 380, 382, 378, 379, 383, 385, 384, 308, 92: 307, 96: 306},
-		{1: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 90: 204},
+		{105, 71: 105},
+		{104, 71: 104},
+		{103, 71: 103},
+		{72: 506, 507, 508, 509, 111: 511},
+		{160, 71: 160},
 		// 290
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 71: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 297, 91: 501, 97: 500, 103: 504},
-		{505},
-		{1: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 90: 205},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 71: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 297, 91: 305, 97: 507},
-		{508, 70: 405},
+		{1: 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 91: 210},
+		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 72: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 92: 515, 102: 514},
+		{523},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 317, 97: 516},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 410, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 96: 517},
 		// 295
-		{1: 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 90: 206},
-		{185, 84: 297, 91: 510},
-		{511},
-		{1: 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 90: 207},
-		{1: 287, 246, 239, 241, 275, 283, 260, 262, 263, 273, 291, 253, 249, 265, 258, 252, 248, 257, 218, 236, 237, 238, 264, 288, 225, 230, 251, 284, 285, 266, 240, 242, 294, 261, 268, 254, 250, 289, 259, 243, 267, 277, 269, 279, 271, 245, 256, 226, 276, 229, 234, 290, 235, 228, 278, 293, 227, 247, 270, 244, 292, 286, 255, 231, 281, 272, 274, 282, 280, 99: 232, 104: 219, 233, 107: 514, 224, 110: 223, 221, 513, 222, 220},
+		{167, 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 520, 127: 519, 518},
+		{168},
+		{166, 71: 521},
+		{165, 71: 165},
+		{1: 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 522},
 		// 300
-		{1: 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 90: 210},
-		{1: 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 90: 208},
+		{164, 71: 164},
+		{1: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 91: 211},
+		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 72: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 92: 515, 102: 525},
+		{526},
+		{1: 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 91: 212},
+		// 305
+		{193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 72: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 92: 530, 98: 529, 105: 528},
+		{531},
+		{185, 71: 416},
+		{184, 347, 370, 323, 325, 388, 383, 350, 327, 328, 329, 353, 349, 355, 358, 333, 361, 354, 357, 360, 319, 320, 321, 322, 385, 348, 343, 363, 331, 351, 352, 335, 324, 326, 387, 330, 337, 356, 359, 334, 362, 332, 336, 377, 338, 342, 340, 369, 364, 382, 376, 346, 365, 366, 367, 345, 341, 386, 344, 371, 339, 368, 384, 372, 373, 380, 381, 375, 374, 378, 379, 72: 397, 398, 399, 400, 392, 391, 393, 389, 390, 394, 396, 395, 318, 93: 317, 97: 316},
+		{1: 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 91: 213},
+		// 310
+		{193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 72: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 92: 530, 98: 529, 105: 533},
+		{534},
+		{1: 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 91: 214},
+		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 72: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 307, 92: 315, 98: 536},
+		{537, 71: 416},
+		// 315
+		{1: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 91: 215},
+		{193, 85: 307, 92: 539},
+		{540},
+		{1: 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 91: 216},
+		{1: 297, 256, 249, 251, 244, 285, 293, 270, 272, 273, 283, 301, 263, 259, 275, 268, 262, 258, 267, 227, 246, 247, 248, 274, 298, 234, 239, 261, 294, 295, 276, 250, 252, 304, 271, 278, 264, 260, 299, 269, 253, 277, 287, 279, 289, 281, 255, 266, 235, 286, 238, 243, 300, 245, 237, 288, 303, 236, 257, 280, 254, 302, 296, 265, 240, 291, 282, 284, 292, 290, 100: 241, 106: 228, 242, 109: 543, 233, 112: 232, 230, 542, 231, 229},
+		// 320
+		{1: 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 91: 219},
+		{1: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 91: 217},
 	}
 )
 
@@ -1032,7 +1079,7 @@ func yyhintlex1(yylex yyhintLexer, lval *yyhintSymType) (n int) {
 }
 
 func yyhintParse(yylex yyhintLexer, parser *hintParser) int {
-	const yyError = 132
+	const yyError = 138
 
 	yyEx, _ := yylex.(yyhintLexerEx)
 	var yyn int
@@ -1366,6 +1413,14 @@ yynewstate:
 			}
 		}
 	case 24:
+		{
+			h := yyS[yypt-3].hint
+			h.HintName = model.NewCIStr(yyS[yypt-6].ident)
+			h.QBName = model.NewCIStr(yyS[yypt-4].ident)
+			h.HintData = yyS[yypt-1].cardinality
+			parser.yyVAL.hint = h
+		}
+	case 25:
 		{
 			hs := yyS[yypt-1].hints
 			name := model.NewCIStr(yyS[yypt-4].ident)
@@ -1376,60 +1431,60 @@ yynewstate:
 			}
 			parser.yyVAL.hints = hs
 		}
-	case 25:
+	case 26:
 		{
 			parser.yyVAL.hints = []*ast.TableOptimizerHint{yyS[yypt-0].hint}
 		}
-	case 26:
+	case 27:
 		{
 			parser.yyVAL.hints = append(yyS[yypt-2].hints, yyS[yypt-0].hint)
 		}
-	case 27:
+	case 28:
 		{
 			h := yyS[yypt-1].hint
 			h.HintData = model.NewCIStr(yyS[yypt-3].ident)
 			parser.yyVAL.hint = h
 		}
-	case 28:
+	case 29:
 		{
 			parser.yyVAL.ident = ""
 		}
-	case 32:
+	case 33:
 		{
 			parser.yyVAL.modelIdents = nil
 		}
-	case 33:
+	case 34:
 		{
 			parser.yyVAL.modelIdents = yyS[yypt-1].modelIdents
 		}
-	case 34:
+	case 35:
 		{
 			parser.yyVAL.modelIdents = []model.CIStr{model.NewCIStr(yyS[yypt-0].ident)}
 		}
-	case 35:
+	case 36:
 		{
 			parser.yyVAL.modelIdents = append(yyS[yypt-2].modelIdents, model.NewCIStr(yyS[yypt-0].ident))
 		}
-	case 37:
+	case 38:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				QBName: model.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 38:
+	case 39:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Tables: []ast.HintTable{yyS[yypt-0].table},
 				QBName: model.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 39:
+	case 40:
 		{
 			h := yyS[yypt-2].hint
 			h.Tables = append(h.Tables, yyS[yypt-0].table)
 			parser.yyVAL.hint = h
 		}
-	case 40:
+	case 41:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				TableName:     model.NewCIStr(yyS[yypt-2].ident),
@@ -1437,7 +1492,7 @@ yynewstate:
 				PartitionList: yyS[yypt-0].modelIdents,
 			}
 		}
-	case 41:
+	case 42:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				DBName:        model.NewCIStr(yyS[yypt-4].ident),
@@ -1446,63 +1501,102 @@ yynewstate:
 				PartitionList: yyS[yypt-0].modelIdents,
 			}
 		}
-	case 42:
+	case 43:
 		{
 			h := yyS[yypt-2].hint
 			h.Tables = append(h.Tables, yyS[yypt-0].table)
 			parser.yyVAL.hint = h
 		}
-	case 43:
+	case 44:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Tables: []ast.HintTable{yyS[yypt-0].table},
 			}
 		}
-	case 44:
+	case 45:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				TableName: model.NewCIStr(yyS[yypt-1].ident),
 				QBName:    model.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 45:
+	case 46:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				QBName: model.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 46:
+	case 47:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				Tables: []ast.HintTable{yyS[yypt-0].table},
+			}
+		}
+	case 48:
+		{
+			h := yyS[yypt-1].hint
+			h.Tables = append(h.Tables, yyS[yypt-0].table)
+			parser.yyVAL.hint = h
+		}
+	case 49:
+		{
+			parser.yyVAL.table = ast.HintTable{
+				TableName: model.NewCIStr(yyS[yypt-1].ident),
+				QBName:    model.NewCIStr(yyS[yypt-0].ident),
+			}
+		}
+	case 50:
+		{
+			parser.yyVAL.table = ast.HintTable{
+				DBName:    model.NewCIStr(yyS[yypt-3].ident),
+				TableName: model.NewCIStr(yyS[yypt-1].ident),
+				QBName:    model.NewCIStr(yyS[yypt-0].ident),
+			}
+		}
+	case 51:
+		{
+			parser.yyVAL.cardinality = ast.HintCardinality{Value: yyS[yypt-0].number}
+		}
+	case 52:
+		{
+			parser.yyVAL.cardinality = ast.HintCardinality{Operator: ast.CardinalityMultiply, Value: yyS[yypt-0].number}
+		}
+	case 53:
+		{
+			parser.yyVAL.cardinality = ast.HintCardinality{Operator: ast.CardinalityDivide, Value: yyS[yypt-0].number}
+		}
+	case 54:
 		{
 			h := yyS[yypt-0].hint
 			h.Tables = []ast.HintTable{yyS[yypt-2].table}
 			h.QBName = model.NewCIStr(yyS[yypt-3].ident)
 			parser.yyVAL.hint = h
 		}
-	case 47:
+	case 55:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{}
 		}
-	case 49:
+	case 57:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Indexes: []model.CIStr{model.NewCIStr(yyS[yypt-0].ident)},
 			}
 		}
-	case 50:
+	case 58:
 		{
 			h := yyS[yypt-2].hint
 			h.Indexes = append(h.Indexes, model.NewCIStr(yyS[yypt-0].ident))
 			parser.yyVAL.hint = h
 		}
-	case 57:
+	case 65:
 		{
 			parser.yyVAL.ident = strconv.FormatUint(yyS[yypt-0].number, 10)
 		}
-	case 58:
+	case 66:
 		{
 			parser.yyVAL.ident = strconv.FormatUint(yyS[yypt-0].number, 10)
 		}
-	case 59:
+	case 67:
 		{
 			if yyS[yypt-0].number > 9223372036854775808 {
 				yylex.AppendError(yylex.Errorf("the Signed Value should be at the range of [-9223372036854775808, 9223372036854775807]."))
@@ -1514,19 +1608,19 @@ yynewstate:
 				parser.yyVAL.ident = strconv.FormatInt(-int64(yyS[yypt-0].number), 10)
 			}
 		}
-	case 60:
+	case 68:
 		{
 			parser.yyVAL.number = 1024 * 1024
 		}
-	case 61:
+	case 69:
 		{
 			parser.yyVAL.number = 1024 * 1024 * 1024
 		}
-	case 62:
+	case 70:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: true}
 		}
-	case 63:
+	case 71:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: false}
 		}
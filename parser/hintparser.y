@@ -32,6 +32,7 @@ import (
 	hints []*ast.TableOptimizerHint
 	table 	ast.HintTable
 	modelIdents []model.CIStr
+	cardinality ast.HintCardinality
 }
 
 %token	<number>
@@ -123,6 +124,7 @@ import (
 	hintLeading               "LEADING"
 	hintSemiJoinRewrite       "SEMI_JOIN_REWRITE"
 	hintNoDecorrelate         "NO_DECORRELATE"
+	hintCardinality           "CARDINALITY"
 
 	/* Other keywords */
 	hintOLAP            "OLAP"
@@ -165,26 +167,31 @@ import (
 	HintStorageTypeAndTableList "storage type and tables list in optimizer hint"
 
 %type	<hint>
-	TableOptimizerHintOpt   "optimizer hint"
-	HintTableList           "table list in optimizer hint"
-	HintTableListOpt        "optional table list in optimizer hint"
-	HintIndexList           "table name with index list in optimizer hint"
-	IndexNameList           "index list in optimizer hint"
-	IndexNameListOpt        "optional index list in optimizer hint"
-	ViewNameList            "view name list in optimizer hint"
-	SubqueryStrategies      "subquery strategies"
-	SubqueryStrategiesOpt   "optional subquery strategies"
-	HintTrueOrFalse         "true or false in optimizer hint"
-	HintStorageTypeAndTable "storage type and tables in optimizer hint"
+	TableOptimizerHintOpt    "optimizer hint"
+	HintTableList            "table list in optimizer hint"
+	HintTableListOpt         "optional table list in optimizer hint"
+	HintIndexList            "table name with index list in optimizer hint"
+	IndexNameList            "index list in optimizer hint"
+	IndexNameListOpt         "optional index list in optimizer hint"
+	ViewNameList             "view name list in optimizer hint"
+	SubqueryStrategies       "subquery strategies"
+	SubqueryStrategiesOpt    "optional subquery strategies"
+	HintTrueOrFalse          "true or false in optimizer hint"
+	HintStorageTypeAndTable  "storage type and tables in optimizer hint"
+	CardinalityHintTableList "table list in the CARDINALITY hint"
 
 %type	<table>
-	HintTable "Table in optimizer hint"
-	ViewName  "View name in optimizer hint"
+	HintTable            "Table in optimizer hint"
+	ViewName             "View name in optimizer hint"
+	CardinalityHintTable "Table in the CARDINALITY hint"
 
 %type	<modelIdents>
 	PartitionList    "partition name list in optimizer hint"
 	PartitionListOpt "optional partition name list in optimizer hint"
 
+%type	<cardinality>
+	CardinalityValue "row count or multiplier in the CARDINALITY hint"
+
 
 %start	Start
 
@@ -353,6 +360,14 @@ TableOptimizerHintOpt:
 			HintData: model.NewCIStr($4),
 		}
 	}
+|	"CARDINALITY" '(' QueryBlockOpt CardinalityHintTableList ',' CardinalityValue ')'
+	{
+		h := $4
+		h.HintName = model.NewCIStr($1)
+		h.QBName = model.NewCIStr($3)
+		h.HintData = $6
+		$$ = h
+	}
 
 StorageOptimizerHintOpt:
 	"READ_FROM_STORAGE" '(' QueryBlockOpt HintStorageTypeAndTableList ')'
@@ -497,6 +512,61 @@ ViewName:
 		}
 	}
 
+/**
+ * CardinalityHintTableList:
+ *
+ *	tbl_name [tbl_name ...]
+ */
+CardinalityHintTableList:
+	CardinalityHintTable
+	{
+		$$ = &ast.TableOptimizerHint{
+			Tables: []ast.HintTable{$1},
+		}
+	}
+|	CardinalityHintTableList CardinalityHintTable
+	{
+		h := $1
+		h.Tables = append(h.Tables, $2)
+		$$ = h
+	}
+
+CardinalityHintTable:
+	Identifier QueryBlockOpt
+	{
+		$$ = ast.HintTable{
+			TableName: model.NewCIStr($1),
+			QBName:    model.NewCIStr($2),
+		}
+	}
+|	Identifier '.' Identifier QueryBlockOpt
+	{
+		$$ = ast.HintTable{
+			DBName:    model.NewCIStr($1),
+			TableName: model.NewCIStr($3),
+			QBName:    model.NewCIStr($4),
+		}
+	}
+
+/**
+ * CardinalityValue:
+ *
+ *	row_count | * multiplier | / divisor
+ */
+CardinalityValue:
+	hintIntLit
+	{
+		$$ = ast.HintCardinality{Value: $1}
+	}
+|	'*' hintIntLit
+	{
+		$$ = ast.HintCardinality{Operator: ast.CardinalityMultiply, Value: $2}
+	}
+|	'/' hintIntLit
+	{
+		$$ = ast.HintCardinality{Operator: ast.CardinalityDivide, Value: $2}
+	}
+
 /**
  * HintIndexList:
  *
@@ -754,6 +824,7 @@ Identifier:
 |	"LEADING"
 |	"SEMI_JOIN_REWRITE"
 |	"NO_DECORRELATE"
+|	"CARDINALITY"
 /* other keywords */
 |	"OLAP"
 |	"OLTP"
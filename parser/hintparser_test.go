@@ -346,6 +346,34 @@ func TestParseHint(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: "CARDINALITY(t1, 100) CARDINALITY(@qb1 t1 test.t2, *10) CARDINALITY(t1@qb2 t2@qb2, /5)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("CARDINALITY"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("t1")}},
+					HintData: ast.HintCardinality{Value: 100},
+				},
+				{
+					HintName: model.NewCIStr("CARDINALITY"),
+					QBName:   model.NewCIStr("qb1"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("t1")}, {DBName: model.NewCIStr("test"), TableName: model.NewCIStr("t2")}},
+					HintData: ast.HintCardinality{Operator: ast.CardinalityMultiply, Value: 10},
+				},
+				{
+					HintName: model.NewCIStr("CARDINALITY"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("t1"), QBName: model.NewCIStr("qb2")}, {TableName: model.NewCIStr("t2"), QBName: model.NewCIStr("qb2")}},
+					HintData: ast.HintCardinality{Operator: ast.CardinalityDivide, Value: 5},
+				},
+			},
+		},
+		{
+			input: "CARDINALITY(t1, 0.5)",
+			errs: []string{
+				`Cannot use decimal number`,
+				`Optimizer hint syntax error at line 1 `,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -167,6 +167,7 @@ var tokenMap = map[string]int{
 	"AS":                       as,
 	"ASC":                      asc,
 	"ASCII":                    ascii,
+	"AT":                       at,
 	"ATTRIBUTE":                attribute,
 	"ATTRIBUTES":               attributes,
 	"BATCH":                    batch,
@@ -184,6 +185,7 @@ var tokenMap = map[string]int{
 	"BACKEND":                  backend,
 	"BACKUP":                   backup,
 	"BACKUPS":                  backups,
+	"BEFORE":                   before,
 	"BEGIN":                    begin,
 	"BETWEEN":                  between,
 	"BERNOULLI":                bernoulli,
@@ -249,6 +251,8 @@ var tokenMap = map[string]int{
 	"COMMIT":                   commit,
 	"COMMITTED":                committed,
 	"COMPACT":                  compact,
+	"COMPLETE":                 complete,
+	"COMPLETION":               completion,
 	"COMPRESSED":               compressed,
 	"COMPRESSION":              compression,
 	"CONCURRENCY":              concurrency,
@@ -258,6 +262,7 @@ var tokenMap = map[string]int{
 	"CONSISTENT":               consistent,
 	"CONSTRAINT":               constraint,
 	"CONSTRAINTS":              constraints,
+	"CONTAINS":                 contains,
 	"CONTEXT":                  context,
 	"CONTINUE":                 continueKwd,
 	"CONVERT":                  convert,
@@ -311,6 +316,7 @@ var tokenMap = map[string]int{
 	"DEPTH":                    depth,
 	"DESC":                     desc,
 	"DESCRIBE":                 describe,
+	"DETERMINISTIC":            deterministic,
 	"DIGEST":                   digest,
 	"DIRECTORY":                directory,
 	"DISABLE":                  disable,
@@ -332,13 +338,16 @@ var tokenMap = map[string]int{
 	"DUPLICATE":                duplicate,
 	"DURATION":                 timeDuration,
 	"DYNAMIC":                  dynamic,
+	"EACH":                     each,
 	"ELSE":                     elseKwd,
 	"ELSEIF":                   elseIfKwd,
+	"EMPTY":                    empty,
 	"ENABLE":                   enable,
 	"ENABLED":                  enabled,
 	"ENCLOSED":                 enclosed,
 	"ENCRYPTION":               encryption,
 	"END":                      end,
+	"ENDS":                     ends,
 	"END_TIME":                 endTime,
 	"ENFORCED":                 enforced,
 	"ENGINE":                   engine,
@@ -350,6 +359,7 @@ var tokenMap = map[string]int{
 	"ESCAPED":                  escaped,
 	"EVENT":                    event,
 	"EVENTS":                   events,
+	"EVERY":                    every,
 	"EVOLVE":                   evolve,
 	"EXACT":                    exact,
 	"EXEC_ELAPSED":             execElapsed,
@@ -381,6 +391,7 @@ var tokenMap = map[string]int{
 	"FOLLOWERS":                followers,
 	"FOLLOWER_CONSTRAINTS":     followerConstraints,
 	"FOLLOWING":                following,
+	"FOLLOWS":                  follows,
 	"FOR":                      forKwd,
 	"FORCE":                    force,
 	"FOREIGN":                  foreign,
@@ -394,6 +405,9 @@ var tokenMap = map[string]int{
 	"GC_TTL":                   gcTTL,
 	"GENERAL":                  general,
 	"GENERATED":                generated,
+	"GEOMCOLLECTION":           geomCollection,
+	"GEOMETRY":                 geometry,
+	"GEOMETRYCOLLECTION":       geometryCollection,
 	"GET_FORMAT":               getFormat,
 	"GLOBAL":                   global,
 	"GRANT":                    grant,
@@ -407,6 +421,7 @@ var tokenMap = map[string]int{
 	"HIGH_PRIORITY":            highPriority,
 	"HISTORY":                  history,
 	"HISTOGRAM":                histogram,
+	"HNSW":                     hnsw,
 	"HOSTS":                    hosts,
 	"HOUR_MICROSECOND":         hourMicrosecond,
 	"HOUR_MINUTE":              hourMinute,
@@ -460,8 +475,10 @@ var tokenMap = map[string]int{
 	"JOB":                      job,
 	"JOBS":                     jobs,
 	"JOIN":                     join,
+	"JOINT":                    joint,
 	"JSON_ARRAYAGG":            jsonArrayagg,
 	"JSON_OBJECTAGG":           jsonObjectAgg,
+	"JSON_TABLE":               jsonTable,
 	"JSON":                     jsonType,
 	"KEY_BLOCK_SIZE":           keyBlockSize,
 	"KEY":                      key,
@@ -472,6 +489,7 @@ var tokenMap = map[string]int{
 	"LAST_BACKUP":              lastBackup,
 	"LAST":                     last,
 	"LASTVAL":                  lastval,
+	"LATERAL":                  lateral,
 	"LEADER":                   leader,
 	"LEADER_CONSTRAINTS":       leaderConstraints,
 	"LEADING":                  leading,
@@ -486,6 +504,7 @@ var tokenMap = map[string]int{
 	"LIMIT":                    limit,
 	"LINEAR":                   linear,
 	"LINES":                    lines,
+	"LINESTRING":               lineString,
 	"LIST":                     list,
 	"LOAD":                     load,
 	"LOCAL":                    local,
@@ -501,6 +520,7 @@ var tokenMap = map[string]int{
 	"LOW_PRIORITY":             lowPriority,
 	"MASTER":                   master,
 	"MATCH":                    match,
+	"MATERIALIZED":             materialized,
 	"MAX_CONNECTIONS_PER_HOUR": maxConnectionsPerHour,
 	"MAX_IDXNUM":               max_idxnum,
 	"MAX_MINUTES":              max_minutes,
@@ -529,11 +549,16 @@ var tokenMap = map[string]int{
 	"MOD":                      mod,
 	"MODE":                     mode,
 	"MODIFY":                   modify,
+	"MODIFIES":                 modifies,
 	"MONTH":                    month,
+	"MULTILINESTRING":          multiLineString,
+	"MULTIPOINT":               multiPoint,
+	"MULTIPOLYGON":             multiPolygon,
 	"NAMES":                    names,
 	"NATIONAL":                 national,
 	"NATURAL":                  natural,
 	"NCHAR":                    ncharType,
+	"NESTED":                   nested,
 	"NEVER":                    never,
 	"NEXT_ROW_ID":              next_row_id,
 	"NEXT":                     next,
@@ -575,6 +600,7 @@ var tokenMap = map[string]int{
 	"OPTIONALLY":               optionally,
 	"OR":                       or,
 	"ORDER":                    order,
+	"ORDINALITY":               ordinality,
 	"OUT":                      out,
 	"OUTER":                    outer,
 	"OUTFILE":                  outfile,
@@ -586,6 +612,7 @@ var tokenMap = map[string]int{
 	"PARTITIONING":             partitioning,
 	"PARTITIONS":               partitions,
 	"PASSWORD":                 password,
+	"PATH":                     path,
 	"PAUSE":                    pause,
 	"PERCENT":                  percent,
 	"PER_DB":                   per_db,
@@ -597,9 +624,11 @@ var tokenMap = map[string]int{
 	"PLUGINS":                  plugins,
 	"POINT":                    point,
 	"POLICY":                   policy,
+	"POLYGON":                  polygon,
 	"POSITION":                 position,
 	"PRE_SPLIT_REGIONS":        preSplitRegions,
 	"PRECEDING":                preceding,
+	"PRECEDES":                 precedes,
 	"PREDICATE":                predicate,
 	"PRECISION":                precisionType,
 	"PREPARE":                  prepare,
@@ -623,12 +652,15 @@ var tokenMap = map[string]int{
 	"RANGE":                    rangeKwd,
 	"RATE_LIMIT":               rateLimit,
 	"READ":                     read,
+	"READS":                    reads,
 	"REAL":                     realType,
 	"REBUILD":                  rebuild,
 	"RECENT":                   recent,
+	"RECOMMEND":                recommend,
 	"RECOVER":                  recover,
 	"RECURSIVE":                recursive,
 	"REDUNDANT":                redundant,
+	"REFRESH":                  refresh,
 	"REFERENCES":               references,
 	"REGEXP":                   regexpKwd,
 	"REGION":                   region,
@@ -656,6 +688,8 @@ var tokenMap = map[string]int{
 	"RESTORES":                 restores,
 	"RESTORED_TS":              restoredTS,
 	"RESTRICT":                 restrict,
+	"RETURN":                   returnKwd,
+	"RETURNS":                  returns,
 	"REVERSE":                  reverse,
 	"REVOKE":                   revoke,
 	"RIGHT":                    right,
@@ -736,6 +770,7 @@ var tokenMap = map[string]int{
 	"SSL":                      ssl,
 	"STALENESS":                staleness,
 	"START":                    start,
+	"STARTS":                   starts,
 	"START_TIME":               startTime,
 	"START_TS":                 startTS,
 	"STARTING":                 starting,
@@ -862,6 +897,7 @@ var tokenMap = map[string]int{
 	"VARCHAR":                  varcharType,
 	"VARCHARACTER":             varcharacter,
 	"VARIABLES":                variables,
+	"VECTOR":                   vectorType,
 	"VARIANCE":                 varPop,
 	"VARYING":                  varying,
 	"VERBOSE":                  verboseType,
@@ -1043,6 +1079,7 @@ var hintTokenMap = map[string]int{
 	"LEADING":                 hintLeading,
 	"SEMI_JOIN_REWRITE":       hintSemiJoinRewrite,
 	"NO_DECORRELATE":          hintNoDecorrelate,
+	"CARDINALITY":             hintCardinality,
 
 	// TiDB hint aliases
 	"TIDB_HJ":   hintHashJoin,
@@ -104,6 +104,17 @@ const (
 	ActionDropResourceGroup             ActionType = 70
 	ActionAlterTablePartitioning        ActionType = 71
 	ActionRemovePartitioning            ActionType = 72
+	ActionCreateTrigger                 ActionType = 73
+	ActionDropTrigger                   ActionType = 74
+	ActionCreateMaterializedView        ActionType = 75
+	ActionDropMaterializedView          ActionType = 76
+	ActionCreateProcedure               ActionType = 77
+	ActionDropProcedure                 ActionType = 78
+	ActionCreateFunction                ActionType = 79
+	ActionDropFunction                  ActionType = 80
+	ActionCreateEvent                   ActionType = 81
+	ActionAlterEvent                    ActionType = 82
+	ActionDropEvent                     ActionType = 83
 )
 
 var actionMap = map[ActionType]string{
@@ -174,6 +185,17 @@ var actionMap = map[ActionType]string{
 	ActionDropResourceGroup:             "drop resource group",
 	ActionAlterTablePartitioning:        "alter table partition by",
 	ActionRemovePartitioning:            "alter table remove partitioning",
+	ActionCreateTrigger:                 "create trigger",
+	ActionDropTrigger:                   "drop trigger",
+	ActionCreateMaterializedView:        "create materialized view",
+	ActionDropMaterializedView:          "drop materialized view",
+	ActionCreateProcedure:               "create procedure",
+	ActionDropProcedure:                 "drop procedure",
+	ActionCreateFunction:                "create function",
+	ActionDropFunction:                  "drop function",
+	ActionCreateEvent:                   "create event",
+	ActionAlterEvent:                    "alter event",
+	ActionDropEvent:                     "drop event",
 
 	// `ActionAlterTableAlterPartition` is removed and will never be used.
 	// Just left a tombstone here for compatibility.
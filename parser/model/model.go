@@ -537,6 +537,17 @@ type TableInfo struct {
 	ExchangePartitionInfo *ExchangePartitionInfo `json:"exchange_partition_info"`
 
 	TTLInfo *TTLInfo `json:"ttl_info"`
+
+	// Triggers are the triggers of the table. The triggers with the same timing and event are executed in the
+	// order they appear in the slice.
+	Triggers []*TriggerInfo `json:"triggers"`
+
+	// MaterializedView is set if the table stores the data of a materialized view.
+	MaterializedView *MaterializedViewInfo `json:"materialized_view"`
+	// MaterializedViewLog is set if the table logs the changes of the base table of a materialized view.
+	MaterializedViewLog *MaterializedViewLogInfo `json:"materialized_view_log"`
+	// MaterializedViews are the IDs of the materialized views which are refreshed incrementally from the table.
+	MaterializedViews []int64 `json:"materialized_views"`
 }
 
 // SepAutoInc decides whether _rowid and auto_increment id use separate allocator.
@@ -749,6 +760,26 @@ func (t *TableInfo) Clone() *TableInfo {
 		nt.TTLInfo = t.TTLInfo.Clone()
 	}
 
+	if t.Triggers != nil {
+		nt.Triggers = make([]*TriggerInfo, len(t.Triggers))
+		for i := range t.Triggers {
+			nt.Triggers[i] = t.Triggers[i].Clone()
+		}
+	}
+
+	if t.MaterializedView != nil {
+		mv := *t.MaterializedView
+		nt.MaterializedView = &mv
+	}
+	if t.MaterializedViewLog != nil {
+		mvLog := *t.MaterializedViewLog
+		nt.MaterializedViewLog = &mvLog
+	}
+	if t.MaterializedViews != nil {
+		nt.MaterializedViews = make([]int64, len(t.MaterializedViews))
+		copy(nt.MaterializedViews, t.MaterializedViews)
+	}
+
 	return &nt
 }
 
@@ -1014,6 +1045,11 @@ func (t *TableInfo) IsSequence() bool {
 	return t.Sequence != nil
 }
 
+// IsMaterializedView checks if TableInfo stores the data of a materialized view.
+func (t *TableInfo) IsMaterializedView() bool {
+	return t.MaterializedView != nil
+}
+
 // IsBaseTable checks to see the table is neither a view or a sequence.
 func (t *TableInfo) IsBaseTable() bool {
 	return t.Sequence == nil && t.View == nil
@@ -1166,6 +1202,119 @@ func (p PartitionType) String() string {
 	}
 }
 
+// ProcedureInfo provides meta data describing a stored procedure or a stored function.
+type ProcedureInfo struct {
+	Name    CIStr              `json:"name"`
+	Definer *auth.UserIdentity `json:"definer"`
+	// ParamList and Body are the original text of the parameter list and the body.
+	ParamList string `json:"param_list"`
+	Body      string `json:"body"`
+	// Returns is the return type of the stored function, and it is empty for the stored procedure.
+	Returns       string `json:"returns,omitempty"`
+	Deterministic bool   `json:"deterministic,omitempty"`
+	DataAccess    string `json:"data_access,omitempty"`
+	Comment       string `json:"comment,omitempty"`
+	// SQLMode, CharsetClient and CollationConnection are the session context when the procedure is created,
+	// the body is parsed and executed in the same context.
+	SQLMode             string    `json:"sql_mode"`
+	CharsetClient       string    `json:"charset_client"`
+	CollationConnection string    `json:"collation_connection"`
+	CollationDatabase   string    `json:"collation_database"`
+	Created             time.Time `json:"created"`
+	LastAltered         time.Time `json:"last_altered"`
+}
+
+// TriggerTiming is the action time of a trigger.
+type TriggerTiming byte
+
+// Trigger timings.
+const (
+	TriggerTimingBefore TriggerTiming = iota
+	TriggerTimingAfter
+)
+
+// String implements fmt.Stringer interface.
+func (t TriggerTiming) String() string {
+	switch t {
+	case TriggerTimingBefore:
+		return "BEFORE"
+	case TriggerTimingAfter:
+		return "AFTER"
+	}
+	return ""
+}
+
+// TriggerEvent is the kind of the operation which activates a trigger.
+type TriggerEvent byte
+
+// Trigger events.
+const (
+	TriggerEventInsert TriggerEvent = iota
+	TriggerEventUpdate
+	TriggerEventDelete
+)
+
+// String implements fmt.Stringer interface.
+func (e TriggerEvent) String() string {
+	switch e {
+	case TriggerEventInsert:
+		return "INSERT"
+	case TriggerEventUpdate:
+		return "UPDATE"
+	case TriggerEventDelete:
+		return "DELETE"
+	}
+	return ""
+}
+
+// TriggerInfo provides meta data describing a row-level trigger of a table.
+type TriggerInfo struct {
+	Name    CIStr              `json:"name"`
+	Timing  TriggerTiming      `json:"timing"`
+	Event   TriggerEvent       `json:"event"`
+	Definer *auth.UserIdentity `json:"definer"`
+	// Body is the original text of the trigger body.
+	Body string `json:"body"`
+	// SQLMode, CharsetClient and CollationConnection are the session context when the trigger is created,
+	// the body is parsed in the same context.
+	SQLMode             string    `json:"sql_mode"`
+	CharsetClient       string    `json:"charset_client"`
+	CollationConnection string    `json:"collation_connection"`
+	CollationDatabase   string    `json:"collation_database"`
+	Created             time.Time `json:"created"`
+}
+
+// Clone clones TriggerInfo.
+func (t *TriggerInfo) Clone() *TriggerInfo {
+	nt := *t
+	if t.Definer != nil {
+		definer := *t.Definer
+		nt.Definer = &definer
+	}
+	return &nt
+}
+
+// MaterializedViewInfo provides meta data describing a materialized view. The data of the view is stored in the table
+// like a base table.
+type MaterializedViewInfo struct {
+	// SelectStmt is the restored text of the query of the view.
+	SelectStmt string `json:"select_stmt"`
+	// BaseTableID is the table read by the query and LogTableID is the table which logs the changes of the base table
+	// since the last refresh. They are 0 if the view can only be refreshed completely.
+	BaseTableID int64 `json:"base_table_id"`
+	LogTableID  int64 `json:"log_table_id"`
+}
+
+// MaterializedViewLogInfo provides meta data describing the change log of a materialized view. Each row of the log
+// table is a row inserted into or deleted from the base table, the updates are logged as a deletion and an insertion.
+type MaterializedViewLogInfo struct {
+	MViewID int64 `json:"mview_id"`
+}
+
+// MaterializedViewLogSignColumn is the column of a materialized view log that is 1 for the inserted rows and -1 for
+// the deleted rows.
+const MaterializedViewLogSignColumn = "_tidb_mvlog_sign"
+
 // ExchangePartitionInfo provides exchange partition info.
 type ExchangePartitionInfo struct {
 	// It is nt tableID when table which has the info is a partition table, else pt tableID.
@@ -1428,6 +1577,10 @@ func (t IndexType) String() string {
 		return "RTREE"
 	case IndexTypeHypo:
 		return "HYPO"
+	case IndexTypeFullText:
+		return "FULLTEXT"
+	case IndexTypeHNSW:
+		return "HNSW"
 	default:
 		return ""
 	}
@@ -1440,6 +1593,8 @@ const (
 	IndexTypeHash
 	IndexTypeRtree
 	IndexTypeHypo
+	IndexTypeFullText
+	IndexTypeHNSW
 )
 
 // IndexInfo provides meta data describing a DB index.
@@ -1459,6 +1614,11 @@ type IndexInfo struct {
 	Invisible     bool           `json:"is_invisible"` // Whether the index is invisible.
 	Global        bool           `json:"is_global"`    // Whether the index is global.
 	MVIndex       bool           `json:"mv_index"`     // Whether the index is multivalued index.
+	// FullTextParser is the name of the parser used to tokenize a full-text index, the empty string
+	// means the built-in whitespace parser.
+	FullTextParser string `json:"fulltext_parser,omitempty"`
+	// VectorDistanceMetric is the distance function that a vector index is built for, e.g. "L2" or "COSINE".
+	VectorDistanceMetric string `json:"vector_distance_metric,omitempty"`
 }
 
 // Clone clones IndexInfo.
@@ -1474,6 +1634,12 @@ func (index *IndexInfo) Clone() *IndexInfo {
 	return &ni
 }
 
+// IsSearchIndex returns whether the index is a full-text or vector index. The entries of such an index
+// are derived from the column values, so it is only read by its own reader rather than as an ordinary index.
+func (index *IndexInfo) IsSearchIndex() bool {
+	return index.Tp == IndexTypeFullText || index.Tp == IndexTypeHNSW
+}
+
 // HasPrefixIndex returns whether any columns of this index uses prefix length.
 func (index *IndexInfo) HasPrefixIndex() bool {
 	for _, ic := range index.Columns {
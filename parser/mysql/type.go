@@ -34,6 +34,9 @@ const (
 	TypeVarchar  byte = 15
 	TypeBit      byte = 16
 
+	// TypeVector is the VECTOR type of MySQL 9.0, whose values are arrays of float32 elements.
+	TypeVector byte = 0xf2
+
 	TypeJSON       byte = 0xf5
 	TypeNewDecimal byte = 0xf6
 	TypeEnum       byte = 0xf7
@@ -47,6 +50,18 @@ const (
 	TypeGeometry   byte = 0xff
 )
 
+// Geometry subtypes of TypeGeometry, the values are the same as the geometry type codes in WKB.
+const (
+	GeometryTypeGeometry           byte = 0
+	GeometryTypePoint              byte = 1
+	GeometryTypeLineString         byte = 2
+	GeometryTypePolygon            byte = 3
+	GeometryTypeMultiPoint         byte = 4
+	GeometryTypeMultiLineString    byte = 5
+	GeometryTypeMultiPolygon       byte = 6
+	GeometryTypeGeometryCollection byte = 7
+)
+
 // Flag information.
 const (
 	NotNullFlag        uint = 1 << 0  /* Field can't be NULL */
@@ -179,11 +179,13 @@ import (
 	int4Type          "INT4"
 	int8Type          "INT8"
 	iterate           "ITERATE"
+	jsonTable         "JSON_TABLE"
 	join              "JOIN"
 	key               "KEY"
 	keys              "KEYS"
 	kill              "KILL"
 	lag               "LAG"
+	lateral           "LATERAL"
 	lastValue         "LAST_VALUE"
 	lead              "LEAD"
 	leading           "LEADING"
@@ -321,6 +323,7 @@ import (
 	always                "ALWAYS"
 	any                   "ANY"
 	ascii                 "ASCII"
+	at                    "AT"
 	attribute             "ATTRIBUTE"
 	attributes            "ATTRIBUTES"
 	statsOptions          "STATS_OPTIONS"
@@ -336,6 +339,7 @@ import (
 	backend               "BACKEND"
 	backup                "BACKUP"
 	backups               "BACKUPS"
+	before                "BEFORE"
 	begin                 "BEGIN"
 	bernoulli             "BERNOULLI"
 	binding               "BINDING"
@@ -370,12 +374,15 @@ import (
 	commit                "COMMIT"
 	committed             "COMMITTED"
 	compact               "COMPACT"
+	complete              "COMPLETE"
+	completion            "COMPLETION"
 	compressed            "COMPRESSED"
 	compression           "COMPRESSION"
 	concurrency           "CONCURRENCY"
 	connection            "CONNECTION"
 	consistency           "CONSISTENCY"
 	consistent            "CONSISTENT"
+	contains              "CONTAINS"
 	context               "CONTEXT"
 	cpu                   "CPU"
 	csvBackslashEscape    "CSV_BACKSLASH_ESCAPE"
@@ -398,6 +405,7 @@ import (
 	declare               "DECLARE"
 	definer               "DEFINER"
 	delayKeyWrite         "DELAY_KEY_WRITE"
+	deterministic         "DETERMINISTIC"
 	digest                "DIGEST"
 	directory             "DIRECTORY"
 	disable               "DISABLE"
@@ -407,10 +415,13 @@ import (
 	do                    "DO"
 	duplicate             "DUPLICATE"
 	dynamic               "DYNAMIC"
+	each                  "EACH"
+	empty                 "EMPTY"
 	enable                "ENABLE"
 	enabled               "ENABLED"
 	encryption            "ENCRYPTION"
 	end                   "END"
+	ends                  "ENDS"
 	enforced              "ENFORCED"
 	engine                "ENGINE"
 	engines               "ENGINES"
@@ -419,6 +430,7 @@ import (
 	escape                "ESCAPE"
 	event                 "EVENT"
 	events                "EVENTS"
+	every                 "EVERY"
 	evolve                "EVOLVE"
 	exchange              "EXCHANGE"
 	exclusive             "EXCLUSIVE"
@@ -434,10 +446,14 @@ import (
 	flush                 "FLUSH"
 	found                 "FOUND"
 	following             "FOLLOWING"
+	follows               "FOLLOWS"
 	format                "FORMAT"
 	full                  "FULL"
 	function              "FUNCTION"
 	general               "GENERAL"
+	geomCollection        "GEOMCOLLECTION"
+	geometry              "GEOMETRY"
+	geometryCollection    "GEOMETRYCOLLECTION"
 	global                "GLOBAL"
 	grants                "GRANTS"
 	handler               "HANDLER"
@@ -445,6 +461,7 @@ import (
 	help                  "HELP"
 	histogram             "HISTOGRAM"
 	history               "HISTORY"
+	hnsw                  "HNSW"
 	hosts                 "HOSTS"
 	hour                  "HOUR"
 	identified            "IDENTIFIED"
@@ -472,11 +489,13 @@ import (
 	less                  "LESS"
 	level                 "LEVEL"
 	list                  "LIST"
+	lineString            "LINESTRING"
 	local                 "LOCAL"
 	locked                "LOCKED"
 	location              "LOCATION"
 	logs                  "LOGS"
 	master                "MASTER"
+	materialized          "MATERIALIZED"
 	max_idxnum            "MAX_IDXNUM"
 	max_minutes           "MAX_MINUTES"
 	maxConnectionsPerHour "MAX_CONNECTIONS_PER_HOUR"
@@ -494,10 +513,15 @@ import (
 	minValue              "MINVALUE"
 	mode                  "MODE"
 	modify                "MODIFY"
+	modifies              "MODIFIES"
 	month                 "MONTH"
+	multiLineString       "MULTILINESTRING"
+	multiPoint            "MULTIPOINT"
+	multiPolygon          "MULTIPOLYGON"
 	names                 "NAMES"
 	national              "NATIONAL"
 	ncharType             "NCHAR"
+	nested                "NESTED"
 	never                 "NEVER"
 	next                  "NEXT"
 	nextval               "NEXTVAL"
@@ -522,6 +546,7 @@ import (
 	only                  "ONLY"
 	open                  "OPEN"
 	optional              "OPTIONAL"
+	ordinality            "ORDINALITY"
 	packKeys              "PACK_KEYS"
 	pageSym               "PAGE"
 	parser                "PARSER"
@@ -529,6 +554,7 @@ import (
 	partitioning          "PARTITIONING"
 	partitions            "PARTITIONS"
 	password              "PASSWORD"
+	path                  "PATH"
 	pause                 "PAUSE"
 	percent               "PERCENT"
 	per_db                "PER_DB"
@@ -537,8 +563,10 @@ import (
 	plugins               "PLUGINS"
 	point                 "POINT"
 	policy                "POLICY"
+	polygon               "POLYGON"
 	preSplitRegions       "PRE_SPLIT_REGIONS"
 	preceding             "PRECEDING"
+	precedes              "PRECEDES"
 	prepare               "PREPARE"
 	preserve              "PRESERVE"
 	privileges            "PRIVILEGES"
@@ -553,9 +581,11 @@ import (
 	query                 "QUERY"
 	quick                 "QUICK"
 	rateLimit             "RATE_LIMIT"
+	reads                 "READS"
 	rebuild               "REBUILD"
 	recover               "RECOVER"
 	redundant             "REDUNDANT"
+	refresh               "REFRESH"
 	reload                "RELOAD"
 	remove                "REMOVE"
 	reorganize            "REORGANIZE"
@@ -571,6 +601,8 @@ import (
 	restore               "RESTORE"
 	restores              "RESTORES"
 	resume                "RESUME"
+	returnKwd             "RETURN"
+	returns               "RETURNS"
 	reuse                 "REUSE"
 	reverse               "REVERSE"
 	role                  "ROLE"
@@ -620,6 +652,7 @@ import (
 	sqlTsiWeek            "SQL_TSI_WEEK"
 	sqlTsiYear            "SQL_TSI_YEAR"
 	start                 "START"
+	starts                "STARTS"
 	statsAutoRecalc       "STATS_AUTO_RECALC"
 	statsPersistent       "STATS_PERSISTENT"
 	statsSamplePages      "STATS_SAMPLE_PAGES"
@@ -664,6 +697,7 @@ import (
 	validation            "VALIDATION"
 	value                 "VALUE"
 	variables             "VARIABLES"
+	vectorType            "VECTOR"
 	view                  "VIEW"
 	visible               "VISIBLE"
 	warnings              "WARNINGS"
@@ -812,11 +846,13 @@ import (
 	dry                        "DRY"
 	jobs                       "JOBS"
 	job                        "JOB"
+	joint                      "JOINT"
 	nodeID                     "NODE_ID"
 	nodeState                  "NODE_STATE"
 	optimistic                 "OPTIMISTIC"
 	pessimistic                "PESSIMISTIC"
 	pump                       "PUMP"
+	recommend                  "RECOMMEND"
 	run                        "RUN"
 	samples                    "SAMPLES"
 	sampleRate                 "SAMPLERATE"
@@ -936,6 +972,7 @@ import (
 %type	<statement>
 	AdminStmt                  "Check table statement or show ddl statement"
 	AlterDatabaseStmt          "Alter database statement"
+	AlterEventStmt             "Alter event statement"
 	AlterTableStmt             "Alter table statement"
 	AlterUserStmt              "Alter user statement"
 	AlterInstanceStmt          "Alter instance statement"
@@ -953,23 +990,31 @@ import (
 	CreateUserStmt             "CREATE User statement"
 	CreateRoleStmt             "CREATE Role statement"
 	CreateDatabaseStmt         "Create Database Statement"
+	CreateEventStmt            "CREATE EVENT statement"
 	CreateIndexStmt            "CREATE INDEX statement"
 	CreateBindingStmt          "CREATE BINDING statement"
 	CreatePolicyStmt           "CREATE PLACEMENT POLICY statement"
 	CreateProcedureStmt        "CREATE PROCEDURE statement"
+	CreateFunctionStmt         "CREATE FUNCTION statement"
 	AddQueryWatchStmt          "ADD QUERY WATCH statement"
 	CreateResourceGroupStmt    "CREATE RESOURCE GROUP statement"
 	CreateSequenceStmt         "CREATE SEQUENCE statement"
 	CreateStatisticsStmt       "CREATE STATISTICS statement"
+	CreateMaterializedViewStmt "CREATE MATERIALIZED VIEW statement"
+	CreateTriggerStmt          "CREATE TRIGGER statement"
 	DoStmt                     "Do statement"
 	DropDatabaseStmt           "DROP DATABASE statement"
+	DropEventStmt              "DROP EVENT statement"
 	DropIndexStmt              "DROP INDEX statement"
 	DropProcedureStmt          "DROP PROCEDURE statement"
+	DropFunctionStmt           "DROP FUNCTION statement"
 	DropQueryWatchStmt         "DROP QUERY WATCH statement"
 	DropResourceGroupStmt      "DROP RESOURCE GROUP statement"
 	DropStatisticsStmt         "DROP STATISTICS statement"
 	DropStatsStmt              "DROP STATS statement"
 	DropTableStmt              "DROP TABLE statement"
+	DropMaterializedViewStmt   "DROP MATERIALIZED VIEW statement"
+	DropTriggerStmt            "DROP TRIGGER statement"
 	DropSequenceStmt           "DROP SEQUENCE statement"
 	DropUserStmt               "DROP USER"
 	DropRoleStmt               "DROP ROLE"
@@ -1005,6 +1050,7 @@ import (
 	PlanReplayerStmt           "Plan replayer statement"
 	PreparedStmt               "PreparedStmt"
 	ProcedureProcStmt          "The entrance of procedure statements which contains all kinds of statements in procedure"
+	ProcedureReturn            "The return statement in stored function"
 	ProcedureStatementStmt     "The normal statements in procedure, such as dml, select, set ..."
 	SelectStmt                 "SELECT statement"
 	SelectStmtWithClause       "common table expression SELECT statement"
@@ -1012,6 +1058,8 @@ import (
 	RenameUserStmt             "rename user statement"
 	ReplaceIntoStmt            "REPLACE INTO statement"
 	RecoverTableStmt           "recover table statement"
+	RecommendIndexStmt         "RECOMMEND INDEX statement"
+	RefreshMViewStmt           "REFRESH MATERIALIZED VIEW statement"
 	RevokeStmt                 "Revoke statement"
 	RevokeRoleStmt             "Revoke role statement"
 	RollbackStmt               "ROLLBACK statement"
@@ -1117,6 +1165,7 @@ import (
 	ConnectionOptionList                   "connection options for CREATE USER statement"
 	ConnectionOptions                      "optional connection options for CREATE USER statement"
 	Constraint                             "table constraint"
+	VectorIndexConstraint                  "vector index definition"
 	ConstraintElem                         "table constraint element"
 	ConstraintKeywordOpt                   "Constraint Keyword or empty"
 	CreateSequenceOptionListOpt            "create sequence list opt"
@@ -1188,6 +1237,11 @@ import (
 	InsertValues                           "Rest part of INSERT/REPLACE INTO statement"
 	IntervalExpr                           "Interval expression"
 	JoinTable                              "join table"
+	JSONTable                              "JSON_TABLE table function"
+	JSONTableColumn                        "column definition of JSON_TABLE"
+	JSONTableColumnList                    "column definition list of JSON_TABLE"
+	JSONTableOnEmptyOnErrorOpt             "Optional ON EMPTY and ON ERROR clauses of JSON_TABLE column"
+	JSONTableOnResponse                    "response of ON EMPTY or ON ERROR clause of JSON_TABLE column"
 	JoinType                               "join type"
 	KillOrKillTiDB                         "Kill or Kill TiDB"
 	LocationLabelList                      "location label name list"
@@ -1259,6 +1313,7 @@ import (
 	OnDeleteUpdateOpt                      "optional ON DELETE and UPDATE clause"
 	OptGConcatSeparator                    "optional GROUP_CONCAT SEPARATOR"
 	ReferOpt                               "reference option"
+	RefreshMaterializedViewTypeOpt         "optional COMPLETE or INCREMENTAL of REFRESH MATERIALIZED VIEW"
 	ReorganizePartitionRuleOpt             "optional reorganize partition partition list and definitions"
 	RequireList                            "require list for tls options"
 	RequireListElement                     "require list element for tls option"
@@ -1347,6 +1402,9 @@ import (
 	TransactionChar                        "Transaction characteristic"
 	TransactionChars                       "Transaction characteristic list"
 	TrimDirection                          "Trim string direction"
+	TriggerEvent                           "INSERT, UPDATE or DELETE event of trigger"
+	TriggerOrderOpt                        "Optional FOLLOWS or PRECEDES clause of trigger"
+	TriggerTiming                          "BEFORE or AFTER timing of trigger"
 	SetOprOpt                              "Union/Except/Intersect Option(empty/ALL/DISTINCT)"
 	Username                               "Username"
 	UsernameList                           "UsernameList"
@@ -1422,6 +1480,9 @@ import (
 	BlobType                               "Blob types"
 	TextType                               "Text types"
 	DateAndTimeType                        "Date and Time types"
+	SpatialType                            "Spatial types"
+	VectorType                             "Vector types"
+	GeometryType                           "Geometry types"
 	OptFieldLen                            "Field length or empty"
 	FieldLen                               "Field length"
 	FieldOpts                              "Field type definition option list"
@@ -1440,12 +1501,27 @@ import (
 	TableOptimizerHints                    "Table level optimizer hints"
 	TableOptimizerHintsOpt                 "Table level optimizer hints option"
 	EnforcedOrNot                          "{ENFORCED|NOT ENFORCED}"
+	EventBodyOpt                           "Optional DO clause of ALTER EVENT"
+	EventCommentOpt                        "Optional COMMENT clause of event"
+	EventCompletion                        "ON COMPLETION clause of event"
+	EventCompletionOpt                     "Optional ON COMPLETION clause of event"
+	EventDefinerOpt                        "Optional DEFINER clause of ALTER EVENT"
+	EventEndsOpt                           "Optional ENDS clause of event schedule"
+	EventRenameOpt                         "Optional RENAME TO clause of ALTER EVENT"
+	EventSchedule                          "ON SCHEDULE clause of event"
+	EventScheduleAndCompletionOpt          "Optional ON SCHEDULE and ON COMPLETION clauses of ALTER EVENT"
+	EventStartsOpt                         "Optional STARTS clause of event schedule"
+	EventStatusOpt                         "Optional ENABLE or DISABLE clause of event"
 	EnforcedOrNotOpt                       "Optional {ENFORCED|NOT ENFORCED}"
 	EnforcedOrNotOrNotNullOpt              "{[ENFORCED|NOT ENFORCED|NOT NULL]}"
 	Match                                  "[MATCH FULL | MATCH PARTIAL | MATCH SIMPLE]"
 	MatchOpt                               "optional MATCH clause"
 	MaxMinutesOpt                          "MAX_MINUTES num(int)"
 	MaxIndexNumOpt                         "MAX_IDXNUM clause"
+	RecommendIndexForOpt                   "FOR clause of RECOMMEND INDEX"
+	RecommendIndexOptionsOpt               "WITH clause of RECOMMEND INDEX"
+	RecommendIndexOptionList               "RECOMMEND INDEX option list"
+	RecommendIndexOption                   "RECOMMEND INDEX option"
 	PerTable                               "Max index number PER_TABLE"
 	PerDB                                  "Max index number PER_DB"
 	BRIETables                             "List of tables or databases for BRIE statements"
@@ -1485,6 +1561,11 @@ import (
 	OptSpPdparams                          "Optional procedure param list"
 	SpPdparams                             "Procedure params"
 	SpPdparam                              "Procedure param"
+	OptSpFdparams                          "Optional function param list"
+	SpFdparams                             "Function param list"
+	SpFdparam                              "Function param"
+	FunctionCharacteristic                 "Stored function characteristic"
+	FunctionCharacteristicListOpt          "Optional stored function characteristic list"
 	ProcedureOptDefault                    "Optional procedure variable default value"
 	ProcedureProcStmts                     "Procedure statement list"
 	ProcedureProcStmt1s                    "One more procedure statement"
@@ -1612,6 +1693,7 @@ import (
 %precedence order
 %precedence lowerThanFunction
 %precedence function
+%precedence vectorType
 
 /* A dummy token to force the priority of TableRef production in a join. */
 %left tableRefPriority
@@ -2195,6 +2277,14 @@ AlterTableSpec:
 			Constraint: constraint,
 		}
 	}
+|	"ADD" VectorIndexConstraint
+	{
+		constraint := $2.(*ast.Constraint)
+		$$ = &ast.AlterTableSpec{
+			Tp:         ast.AlterTableAddConstraint,
+			Constraint: constraint,
+		}
+	}
 |	"ADD" "PARTITION" IfNotExists NoWriteToBinLogAliasOpt PartitionDefinitionListOpt
 	{
 		var defs []*ast.PartitionDefinition
@@ -3234,6 +3324,10 @@ AnalyzeOption:
 	{
 		$$ = ast.AnalyzeOpt{Type: ast.AnalyzeOptSampleRate, Value: ast.NewValueExpr($1, "", "")}
 	}
+|	"JOINT" "STATS"
+	{
+		$$ = ast.AnalyzeOpt{Type: ast.AnalyzeOptJointStats}
+	}
 
 /*******************************************************************************************/
 Assignment:
@@ -4180,6 +4274,10 @@ IndexKeyTypeOpt:
 	{
 		$$ = ast.IndexKeyTypeFullText
 	}
+|	"VECTOR"
+	{
+		$$ = ast.IndexKeyTypeVector
+	}
 
 /**************************************AlterDatabaseStmt***************************************
  * See https://dev.mysql.com/doc/refman/5.7/en/alter-database.html
@@ -6489,6 +6587,10 @@ IndexTypeName:
 	{
 		$$ = model.IndexTypeHypo
 	}
+|	"HNSW"
+	{
+		$$ = model.IndexTypeHNSW
+	}
 
 IndexInvisible:
 	"VISIBLE"
@@ -6511,6 +6613,21 @@ UnReservedKeyword:
 	"ACTION"
 |	"ADVISE"
 |	"ASCII"
+|	"AT"
+|	"COMPLETION"
+|	"ENDS"
+|	"EVERY"
+|	"STARTS"
+|	"BEFORE"
+|	"EACH"
+|	"FOLLOWS"
+|	"PRECEDES"
+|	"CONTAINS"
+|	"DETERMINISTIC"
+|	"MODIFIES"
+|	"READS"
+|	"RETURN"
+|	"RETURNS"
 |	"ATTRIBUTE"
 |	"ATTRIBUTES"
 |	"BINDING_CACHE"
@@ -6540,6 +6657,7 @@ UnReservedKeyword:
 |	"SAN"
 |	"COMMIT"
 |	"COMPACT"
+|	"COMPLETE"
 |	"COMPRESSED"
 |	"CONSISTENCY"
 |	"CONSISTENT"
@@ -6593,6 +6711,7 @@ UnReservedKeyword:
 |	"QUICK"
 |	"REBUILD"
 |	"REDUNDANT"
+|	"REFRESH"
 |	"REORGANIZE"
 |	"RESOURCE"
 |	"RESTART"
@@ -6608,6 +6727,14 @@ UnReservedKeyword:
 |	"STATUS"
 |	"OPEN"
 |	"POINT"
+|	"POLYGON"
+|	"GEOMETRY"
+|	"GEOMETRYCOLLECTION"
+|	"GEOMCOLLECTION"
+|	"LINESTRING"
+|	"MULTILINESTRING"
+|	"MULTIPOINT"
+|	"MULTIPOLYGON"
 |	"SUBPARTITIONS"
 |	"SUBPARTITION"
 |	"TABLES"
@@ -6639,6 +6766,7 @@ UnReservedKeyword:
 |	"COMPRESSION"
 |	"KEY_BLOCK_SIZE"
 |	"MASTER"
+|	"MATERIALIZED"
 |	"MAX_ROWS"
 |	"MIN_ROWS"
 |	"NATIONAL"
@@ -6764,6 +6892,8 @@ UnReservedKeyword:
 |	"WITHOUT"
 |	"RTREE"
 |	"HYPO"
+|	"HNSW"
+|	"VECTOR"
 |	"EXCHANGE"
 |	"COLUMN_FORMAT"
 |	"REPAIR"
@@ -6872,6 +7002,10 @@ UnReservedKeyword:
 |	"OLTP_READ_WRITE"
 |	"OLTP_READ_ONLY"
 |	"OLTP_WRITE_ONLY"
+|	"EMPTY"
+|	"NESTED"
+|	"ORDINALITY"
+|	"PATH"
 
 TiDBKeyword:
 	"ADMIN"
@@ -6889,9 +7023,11 @@ TiDBKeyword:
 |	"DRAINER"
 |	"JOBS"
 |	"JOB"
+|	"JOINT"
 |	"NODE_ID"
 |	"NODE_STATE"
 |	"PUMP"
+|	"RECOMMEND"
 |	"SAMPLES"
 |	"SAMPLERATE"
 |	"SESSION_STATES"
@@ -9598,12 +9734,132 @@ TableFactor:
 		resultNode := $1.(*ast.SubqueryExpr).Query
 		$$ = &ast.TableSource{Source: resultNode, AsName: $2.(model.CIStr)}
 	}
+|	"LATERAL" SubSelect TableAsNameOpt
+	{
+		resultNode := $2.(*ast.SubqueryExpr).Query
+		$$ = &ast.TableSource{Source: resultNode, AsName: $3.(model.CIStr), Lateral: true}
+	}
 |	'(' TableRefs ')'
 	{
 		j := $2.(*ast.Join)
 		j.ExplicitParens = true
 		$$ = $2
 	}
+|	JSONTable TableAsNameOpt
+	{
+		$$ = &ast.TableSource{Source: $1.(*ast.JSONTable), AsName: $2.(model.CIStr)}
+	}
+
+/*
+ * JSON_TABLE(expr, path COLUMNS (column_list))
+ * column_list:
+ *     column[, column][, ...]
+ * column:
+ *     name FOR ORDINALITY
+ *   | name type PATH string_path [on_empty] [on_error]
+ *   | name type EXISTS PATH string_path
+ *   | NESTED [PATH] path COLUMNS (column_list)
+ * on_empty:
+ *     {NULL | DEFAULT json_string | ERROR} ON EMPTY
+ * on_error:
+ *     {NULL | DEFAULT json_string | ERROR} ON ERROR
+ */
+JSONTable:
+	"JSON_TABLE" '(' Expression ',' stringLit "COLUMNS" '(' JSONTableColumnList ')' ')'
+	{
+		$$ = &ast.JSONTable{
+			Expr:    $3,
+			Path:    $5,
+			Columns: $8.([]*ast.JSONTableColumn),
+		}
+	}
+
+JSONTableColumnList:
+	JSONTableColumn
+	{
+		$$ = []*ast.JSONTableColumn{$1.(*ast.JSONTableColumn)}
+	}
+|	JSONTableColumnList ',' JSONTableColumn
+	{
+		$$ = append($1.([]*ast.JSONTableColumn), $3.(*ast.JSONTableColumn))
+	}
+
+JSONTableColumn:
+	Identifier "FOR" "ORDINALITY"
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:   ast.JSONTableColumnOrdinality,
+			Name: model.NewCIStr($1),
+		}
+	}
+|	Identifier Type "PATH" stringLit JSONTableOnEmptyOnErrorOpt
+	{
+		responses := $5.([]*ast.JSONTableOnResponse)
+		$$ = &ast.JSONTableColumn{
+			Tp:      ast.JSONTableColumnPath,
+			Name:    model.NewCIStr($1),
+			Type:    $2.(*types.FieldType),
+			Path:    $4,
+			OnEmpty: responses[0],
+			OnError: responses[1],
+		}
+	}
+|	Identifier Type "EXISTS" "PATH" stringLit
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:   ast.JSONTableColumnExistsPath,
+			Name: model.NewCIStr($1),
+			Type: $2.(*types.FieldType),
+			Path: $5,
+		}
+	}
+|	"NESTED" stringLit "COLUMNS" '(' JSONTableColumnList ')'
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:            ast.JSONTableColumnNested,
+			Path:          $2,
+			NestedColumns: $5.([]*ast.JSONTableColumn),
+		}
+	}
+|	"NESTED" "PATH" stringLit "COLUMNS" '(' JSONTableColumnList ')'
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:            ast.JSONTableColumnNested,
+			Path:          $3,
+			NestedColumns: $6.([]*ast.JSONTableColumn),
+		}
+	}
+
+JSONTableOnEmptyOnErrorOpt:
+	{
+		$$ = []*ast.JSONTableOnResponse{nil, nil}
+	}
+|	JSONTableOnResponse "ON" "EMPTY"
+	{
+		$$ = []*ast.JSONTableOnResponse{$1.(*ast.JSONTableOnResponse), nil}
+	}
+|	JSONTableOnResponse "ON" "ERROR"
+	{
+		$$ = []*ast.JSONTableOnResponse{nil, $1.(*ast.JSONTableOnResponse)}
+	}
+|	JSONTableOnResponse "ON" "EMPTY" JSONTableOnResponse "ON" "ERROR"
+	{
+		$$ = []*ast.JSONTableOnResponse{$1.(*ast.JSONTableOnResponse), $4.(*ast.JSONTableOnResponse)}
+	}
+
+JSONTableOnResponse:
+	"NULL"
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableOnResponseNull}
+	}
+|	"ERROR"
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableOnResponseError}
+	}
+|	"DEFAULT" stringLit
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableOnResponseDefault, Default: $2}
+	}
 
 PartitionNameListOpt:
 	/* empty */
@@ -11332,6 +11588,13 @@ ShowStmt:
 			Procedure: $4.(*ast.TableName),
 		}
 	}
+|	"SHOW" "CREATE" "FUNCTION" TableName
+	{
+		$$ = &ast.ShowStmt{
+			Tp:        ast.ShowCreateFunction,
+			Procedure: $4.(*ast.TableName),
+		}
+	}
 
 ShowPlacementTarget:
 	DatabaseSym DBName
@@ -11883,6 +12146,7 @@ Statement:
 	EmptyStmt
 |	AdminStmt
 |	AlterDatabaseStmt
+|	AlterEventStmt
 |	AlterTableStmt
 |	AlterUserStmt
 |	AlterInstanceStmt
@@ -11901,32 +12165,40 @@ Statement:
 |	CalibrateResourceStmt
 |	ChangeStmt
 |	CreateDatabaseStmt
+|	CreateEventStmt
 |	CreateIndexStmt
 |	CreateTableStmt
 |	CreateViewStmt
+|	CreateMaterializedViewStmt
 |	CreateUserStmt
 |	CreateRoleStmt
 |	CreateBindingStmt
 |	CreatePolicyStmt
 |	CreateProcedureStmt
+|	CreateFunctionStmt
 |	CreateResourceGroupStmt
 |	AddQueryWatchStmt
 |	CreateSequenceStmt
 |	CreateStatisticsStmt
+|	CreateTriggerStmt
 |	DoStmt
 |	DropDatabaseStmt
+|	DropEventStmt
 |	DropIndexStmt
 |	DropTableStmt
 |	DropProcedureStmt
+|	DropFunctionStmt
 |	DropPolicyStmt
 |	DropSequenceStmt
 |	DropViewStmt
+|	DropMaterializedViewStmt
 |	DropUserStmt
 |	DropResourceGroupStmt
 |	DropQueryWatchStmt
 |	DropRoleStmt
 |	DropStatisticsStmt
 |	DropStatsStmt
+|	DropTriggerStmt
 |	DropBindingStmt
 |	FlushStmt
 |	FlashbackTableStmt
@@ -11951,6 +12223,8 @@ Statement:
 |	RenameUserStmt
 |	ReplaceIntoStmt
 |	RecoverTableStmt
+|	RecommendIndexStmt
+|	RefreshMViewStmt
 |	ReleaseSavepointStmt
 |	RevokeStmt
 |	RevokeRoleStmt
@@ -12078,6 +12352,26 @@ Constraint:
 		$$ = cst
 	}
 
+/*
+ * VECTOR is not a reserved keyword, so the vector index definition is not a ConstraintElem, which
+ * can be preceded by an empty ConstraintKeywordOpt and then conflicts with a column named `vector`.
+ */
+VectorIndexConstraint:
+	"VECTOR" KeyOrIndex IfNotExists IndexName '(' IndexPartSpecificationList ')' IndexOptionList
+	{
+		c := &ast.Constraint{
+			IfNotExists:  $3.(bool),
+			Tp:           ast.ConstraintVector,
+			Keys:         $6.([]*ast.IndexPartSpecification),
+			Name:         $4.(*ast.NullString).String,
+			IsEmptyIndex: $4.(*ast.NullString).Empty,
+		}
+		if $8 != nil {
+			c.Option = $8.(*ast.IndexOption)
+		}
+		$$ = c
+	}
+
 CheckConstraintKeyword:
 	"CHECK"
 |	"CONSTRAINT"
@@ -12085,6 +12379,7 @@ CheckConstraintKeyword:
 TableElement:
 	ColumnDef
 |	Constraint
+|	VectorIndexConstraint
 
 TableElementList:
 	TableElement
@@ -12454,6 +12749,8 @@ Type:
 	NumericType
 |	StringType
 |	DateAndTimeType
+|	SpatialType
+|	VectorType
 
 NumericType:
 	IntegerType OptFieldLen FieldOpts
@@ -12985,6 +13282,64 @@ DateAndTimeType:
 		$$ = tp
 	}
 
+SpatialType:
+	GeometryType
+	{
+		tp := types.NewFieldType(mysql.TypeGeometry)
+		tp.SetGeometryType($1.(byte))
+		tp.SetCharset(charset.CharsetBin)
+		tp.SetCollate(charset.CollationBin)
+		$$ = tp
+	}
+
+GeometryType:
+	"GEOMETRY"
+	{
+		$$ = mysql.GeometryTypeGeometry
+	}
+|	"POINT"
+	{
+		$$ = mysql.GeometryTypePoint
+	}
+|	"LINESTRING"
+	{
+		$$ = mysql.GeometryTypeLineString
+	}
+|	"POLYGON"
+	{
+		$$ = mysql.GeometryTypePolygon
+	}
+|	"MULTIPOINT"
+	{
+		$$ = mysql.GeometryTypeMultiPoint
+	}
+|	"MULTILINESTRING"
+	{
+		$$ = mysql.GeometryTypeMultiLineString
+	}
+|	"MULTIPOLYGON"
+	{
+		$$ = mysql.GeometryTypeMultiPolygon
+	}
+|	"GEOMETRYCOLLECTION"
+	{
+		$$ = mysql.GeometryTypeGeometryCollection
+	}
+|	"GEOMCOLLECTION"
+	{
+		$$ = mysql.GeometryTypeGeometryCollection
+	}
+
+VectorType:
+	"VECTOR" OptFieldLen
+	{
+		tp := types.NewFieldType(mysql.TypeVector)
+		tp.SetFlen($2.(int))
+		tp.SetCharset(charset.CharsetBin)
+		tp.SetCollate(charset.CollationBin)
+		$$ = tp
+	}
+
 FieldLen:
 	'(' LengthNum ')'
 	{
@@ -12992,6 +13347,7 @@ FieldLen:
 	}
 
 OptFieldLen:
+	%prec lowerThanParenthese
 	{
 		$$ = types.UnspecifiedLength
 	}
@@ -13021,6 +13377,7 @@ FieldOpts:
 	}
 
 FloatOpt:
+	%prec lowerThanParenthese
 	{
 		$$ = &ast.FloatOpt{Flen: types.UnspecifiedLength, Decimal: types.UnspecifiedLength}
 	}
@@ -13046,6 +13403,7 @@ OptBinMod:
 	}
 
 OptBinary:
+	%prec lowerThanParenthese
 	{
 		$$ = &ast.OptBinary{
 			IsBinary: false,
@@ -15028,6 +15386,62 @@ PerDB:
 		$$ = getUint64FromNUM($2)
 	}
 
+/*******************************************************************
+ *
+ *  Recommend Index Statement
+ *
+ *  Example:
+ *	RECOMMEND INDEX RUN
+ *	[FOR 'sql']
+ *	[WITH option = number [, option = number] ...]
+ *******************************************************************/
+RecommendIndexStmt:
+	"RECOMMEND" "INDEX" "RUN" RecommendIndexForOpt RecommendIndexOptionsOpt
+	{
+		$$ = &ast.RecommendIndexStmt{
+			Action:  "run",
+			SQL:     $4.(string),
+			Options: $5.([]ast.RecommendIndexOption),
+		}
+	}
+
+RecommendIndexForOpt:
+	{
+		$$ = ""
+	}
+|	"FOR" stringLit
+	{
+		$$ = $2
+	}
+
+RecommendIndexOptionsOpt:
+	{
+		$$ = []ast.RecommendIndexOption(nil)
+	}
+|	"WITH" RecommendIndexOptionList
+	{
+		$$ = $2
+	}
+
+RecommendIndexOptionList:
+	RecommendIndexOption
+	{
+		$$ = []ast.RecommendIndexOption{$1.(ast.RecommendIndexOption)}
+	}
+|	RecommendIndexOptionList ',' RecommendIndexOption
+	{
+		$$ = append($1.([]ast.RecommendIndexOption), $3.(ast.RecommendIndexOption))
+	}
+
+RecommendIndexOption:
+	Identifier eq LengthNum
+	{
+		$$ = ast.RecommendIndexOption{
+			Option: strings.ToLower($1),
+			Value:  $3.(uint64),
+		}
+	}
+
 EncryptionOpt:
 	stringLit
 	{
@@ -15276,6 +15690,40 @@ SpPdparam:
 		$$ = x
 	}
 
+/* Stored FUNCTION parameter declaration list */
+OptSpFdparams:
+	/* Empty */
+	{
+		$$ = []*ast.StoreParameter{}
+	}
+|	SpFdparams
+	{
+		$$ = $1
+	}
+
+SpFdparams:
+	SpFdparams ',' SpFdparam
+	{
+		l := $1.([]*ast.StoreParameter)
+		l = append(l, $3.(*ast.StoreParameter))
+		$$ = l
+	}
+|	SpFdparam
+	{
+		$$ = []*ast.StoreParameter{$1.(*ast.StoreParameter)}
+	}
+
+SpFdparam:
+	Identifier Type
+	{
+		x := &ast.StoreParameter{
+			Paramstatus: ast.MODE_IN,
+			ParamType:   $2.(*types.FieldType),
+			ParamName:   $1,
+		}
+		$$ = x
+	}
+
 SpOptInout:
 	/* Empty */
 	{
@@ -15322,6 +15770,7 @@ ProcedureStatementStmt:
 |	DeleteFromStmt
 |	AnalyzeTableStmt
 |	TruncateTableStmt
+|	CallStmt
 
 ProcedureCursorSelectStmt:
 	SelectStmt
@@ -15769,6 +16218,14 @@ ProcedureLeave:
 		}
 	}
 
+ProcedureReturn:
+	"RETURN" Expression
+	{
+		$$ = &ast.ProcedureReturn{
+			Expr: $2,
+		}
+	}
+
 ProcedureProcStmt:
 	ProcedureStatementStmt
 |	ProcedureUnlabeledBlock
@@ -15782,6 +16239,7 @@ ProcedureProcStmt:
 |	ProcedurelabeledLoopStmt
 |	ProcedureIterate
 |	ProcedureLeave
+|	ProcedureReturn
 
 /********************************************************************************************
  *
@@ -15834,6 +16292,503 @@ DropProcedureStmt:
 		}
 	}
 
+/********************************************************************************************
+ *
+ *  Create Function Statement
+ *
+ *  Example:
+ *  CREATE
+ *  FUNCTION [IF NOT EXISTS] sp_name ([func_parameter[,...]])
+ *  RETURNS type
+ *  [characteristic ...]
+ *  routine_body
+ *  func_parameter:
+ *  param_name type
+ *  characteristic:
+ *  COMMENT 'string'
+ *  | [NOT] DETERMINISTIC
+ *  | { CONTAINS SQL | NO SQL | READS SQL DATA | MODIFIES SQL DATA }
+ * routine_body:
+ *  Valid SQL routine statement
+ ********************************************************************************************/
+CreateFunctionStmt:
+	"CREATE" "FUNCTION" IfNotExists TableName '(' OptSpFdparams ')' "RETURNS" Type FunctionCharacteristicListOpt ProcedureProcStmt
+	{
+		x := &ast.ProcedureInfo{
+			IfNotExists:    $3.(bool),
+			ProcedureName:  $4.(*ast.TableName),
+			ProcedureParam: $6.([]*ast.StoreParameter),
+			ProcedureBody:  $11,
+			IsFunction:     true,
+			ReturnType:     $9.(*types.FieldType),
+		}
+		for _, c := range $10.([]*ast.FunctionCharacteristic) {
+			switch c.Tp {
+			case ast.FunctionCharacteristicComment:
+				x.Comment = c.Comment
+			case ast.FunctionCharacteristicDeterministic:
+				x.Deterministic = c.Deterministic
+			case ast.FunctionCharacteristicDataAccess:
+				x.DataAccess = c.DataAccess
+			}
+		}
+		startOffset := parser.startOffset(&yyS[yypt])
+		originStmt := $11
+		originStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		startOffset = parser.startOffset(&yyS[yypt-6])
+		if parser.src[startOffset] == '(' {
+			startOffset++
+		}
+		endOffset := parser.startOffset(&yyS[yypt-4])
+		x.ProcedureParamStr = strings.TrimSpace(parser.src[startOffset:endOffset])
+		$$ = x
+	}
+
+FunctionCharacteristicListOpt:
+	/* Empty */
+	{
+		$$ = []*ast.FunctionCharacteristic{}
+	}
+|	FunctionCharacteristicListOpt FunctionCharacteristic
+	{
+		$$ = append($1.([]*ast.FunctionCharacteristic), $2.(*ast.FunctionCharacteristic))
+	}
+
+FunctionCharacteristic:
+	"COMMENT" stringLit
+	{
+		$$ = &ast.FunctionCharacteristic{Tp: ast.FunctionCharacteristicComment, Comment: $2}
+	}
+|	"DETERMINISTIC"
+	{
+		$$ = &ast.FunctionCharacteristic{Tp: ast.FunctionCharacteristicDeterministic, Deterministic: true}
+	}
+|	"NOT" "DETERMINISTIC"
+	{
+		$$ = &ast.FunctionCharacteristic{Tp: ast.FunctionCharacteristicDeterministic, Deterministic: false}
+	}
+|	"CONTAINS" "SQL"
+	{
+		$$ = &ast.FunctionCharacteristic{Tp: ast.FunctionCharacteristicDataAccess, DataAccess: ast.RoutineContainsSQL}
+	}
+|	"NO" "SQL"
+	{
+		$$ = &ast.FunctionCharacteristic{Tp: ast.FunctionCharacteristicDataAccess, DataAccess: ast.RoutineNoSQL}
+	}
+|	"READS" "SQL" "DATA"
+	{
+		$$ = &ast.FunctionCharacteristic{Tp: ast.FunctionCharacteristicDataAccess, DataAccess: ast.RoutineReadsSQLData}
+	}
+|	"MODIFIES" "SQL" "DATA"
+	{
+		$$ = &ast.FunctionCharacteristic{Tp: ast.FunctionCharacteristicDataAccess, DataAccess: ast.RoutineModifiesSQLData}
+	}
+
+/********************************************************************************************
+*  DROP FUNCTION  [IF EXISTS] sp_name
+********************************************************************************************/
+DropFunctionStmt:
+	"DROP" "FUNCTION" IfExists TableName
+	{
+		$$ = &ast.DropProcedureStmt{
+			IfExists:      $3.(bool),
+			IsFunction:    true,
+			ProcedureName: $4.(*ast.TableName),
+		}
+	}
+
+/********************************************************************************************
+ *
+ *  Create Event Statement
+ *
+ *  Example:
+ *  CREATE
+ *      [DEFINER = user]
+ *      EVENT
+ *      [IF NOT EXISTS]
+ *      event_name
+ *      ON SCHEDULE schedule
+ *      [ON COMPLETION [NOT] PRESERVE]
+ *      [ENABLE | DISABLE]
+ *      [COMMENT 'string']
+ *      DO event_body;
+ *
+ *  schedule: {
+ *      AT timestamp [+ INTERVAL interval] ...
+ *    | EVERY interval
+ *      [STARTS timestamp [+ INTERVAL interval] ...]
+ *      [ENDS timestamp [+ INTERVAL interval] ...]
+ *  }
+ ********************************************************************************************/
+CreateEventStmt:
+	"CREATE" OrReplace ViewAlgorithm ViewDefiner "EVENT" IfNotExists TableName "ON" "SCHEDULE" EventSchedule EventCompletionOpt EventStatusOpt EventCommentOpt "DO" ProcedureProcStmt
+	{
+		// OrReplace and ViewAlgorithm are only used to avoid conflicts with CREATE VIEW.
+		if $2.(bool) || $3.(model.ViewAlgorithm) != model.AlgorithmUndefined {
+			yylex.AppendError(yylex.Errorf("OR REPLACE and ALGORITHM are not supported in CREATE EVENT"))
+			return 1
+		}
+		x := &ast.CreateEventStmt{
+			Definer:     $4.(*auth.UserIdentity),
+			IfNotExists: $6.(bool),
+			EventName:   $7.(*ast.TableName),
+			Schedule:    $10.(*ast.EventSchedule),
+			Completion:  $11.(ast.EventCompletionType),
+			Status:      $12.(ast.EventStatusType),
+			Body:        $15,
+		}
+		if $13 != nil {
+			comment := $13.(string)
+			x.Comment = &comment
+		}
+		startOffset := parser.startOffset(&yyS[yypt])
+		x.Body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		$$ = x
+	}
+
+EventSchedule:
+	"AT" Expression
+	{
+		$$ = &ast.EventSchedule{At: $2}
+	}
+|	"EVERY" Expression TimeUnit EventStartsOpt EventEndsOpt
+	{
+		x := &ast.EventSchedule{
+			Every: $2,
+			Unit:  $3.(ast.TimeUnitType),
+		}
+		if $4 != nil {
+			x.Starts = $4.(ast.ExprNode)
+		}
+		if $5 != nil {
+			x.Ends = $5.(ast.ExprNode)
+		}
+		$$ = x
+	}
+
+EventStartsOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"STARTS" Expression
+	{
+		$$ = $2
+	}
+
+EventEndsOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"ENDS" Expression
+	{
+		$$ = $2
+	}
+
+EventCompletion:
+	"ON" "COMPLETION" "PRESERVE"
+	{
+		$$ = ast.EventCompletionPreserve
+	}
+|	"ON" "COMPLETION" "NOT" "PRESERVE"
+	{
+		$$ = ast.EventCompletionNotPreserve
+	}
+
+EventCompletionOpt:
+	/* EMPTY */
+	{
+		$$ = ast.EventCompletionUnspecified
+	}
+|	EventCompletion
+
+EventStatusOpt:
+	/* EMPTY */
+	{
+		$$ = ast.EventStatusUnspecified
+	}
+|	"ENABLE"
+	{
+		$$ = ast.EventStatusEnable
+	}
+|	"DISABLE"
+	{
+		$$ = ast.EventStatusDisable
+	}
+
+EventCommentOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"COMMENT" stringLit
+	{
+		$$ = $2
+	}
+
+/********************************************************************************************
+ *
+ *  Alter Event Statement
+ *
+ *  Example:
+ *  ALTER
+ *      [DEFINER = user]
+ *      EVENT event_name
+ *      [ON SCHEDULE schedule]
+ *      [ON COMPLETION [NOT] PRESERVE]
+ *      [RENAME TO new_event_name]
+ *      [ENABLE | DISABLE]
+ *      [COMMENT 'string']
+ *      [DO event_body]
+ ********************************************************************************************/
+AlterEventStmt:
+	"ALTER" EventDefinerOpt "EVENT" TableName EventScheduleAndCompletionOpt EventRenameOpt EventStatusOpt EventCommentOpt EventBodyOpt
+	{
+		x := &ast.AlterEventStmt{
+			EventName: $4.(*ast.TableName),
+			Status:    $7.(ast.EventStatusType),
+		}
+		if $2 != nil {
+			x.Definer = $2.(*auth.UserIdentity)
+		}
+		if $5 != nil {
+			x.Schedule = $5.(*ast.AlterEventStmt).Schedule
+			x.Completion = $5.(*ast.AlterEventStmt).Completion
+		}
+		if $6 != nil {
+			x.RenameTo = $6.(*ast.TableName)
+		}
+		if $8 != nil {
+			comment := $8.(string)
+			x.Comment = &comment
+		}
+		if $9 != nil {
+			x.Body = $9.(ast.StmtNode)
+		}
+		if x.Schedule == nil && x.Completion == ast.EventCompletionUnspecified && x.RenameTo == nil &&
+			x.Status == ast.EventStatusUnspecified && x.Comment == nil && x.Body == nil {
+			yylex.AppendError(yylex.Errorf("ALTER EVENT requires at least one clause"))
+			return 1
+		}
+		$$ = x
+	}
+
+EventDefinerOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"DEFINER" "=" Username
+	{
+		$$ = $3
+	}
+
+EventScheduleAndCompletionOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"ON" "SCHEDULE" EventSchedule EventCompletionOpt
+	{
+		$$ = &ast.AlterEventStmt{
+			Schedule:   $3.(*ast.EventSchedule),
+			Completion: $4.(ast.EventCompletionType),
+		}
+	}
+|	EventCompletion
+	{
+		$$ = &ast.AlterEventStmt{
+			Completion: $1.(ast.EventCompletionType),
+		}
+	}
+
+EventRenameOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"RENAME" "TO" TableName
+	{
+		$$ = $3
+	}
+
+EventBodyOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"DO" ProcedureProcStmt
+	{
+		startOffset := parser.startOffset(&yyS[yypt])
+		$2.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		$$ = $2
+	}
+
+/********************************************************************************************
+ *  DROP EVENT [IF EXISTS] event_name
+ ********************************************************************************************/
+DropEventStmt:
+	"DROP" "EVENT" IfExists TableName
+	{
+		$$ = &ast.DropEventStmt{
+			IfExists:  $3.(bool),
+			EventName: $4.(*ast.TableName),
+		}
+	}
+
+/********************************************************************************************
+ *
+ *  Create Trigger Statement
+ *
+ *  Example:
+ *  CREATE
+ *      [DEFINER = user]
+ *      TRIGGER [IF NOT EXISTS] trigger_name
+ *      trigger_time trigger_event
+ *      ON tbl_name FOR EACH ROW
+ *      [trigger_order]
+ *      trigger_body
+ *
+ *  trigger_time: { BEFORE | AFTER }
+ *  trigger_event: { INSERT | UPDATE | DELETE }
+ *  trigger_order: { FOLLOWS | PRECEDES } other_trigger_name
+ ********************************************************************************************/
+CreateTriggerStmt:
+	"CREATE" OrReplace ViewAlgorithm ViewDefiner "TRIGGER" IfNotExists TableName TriggerTiming TriggerEvent "ON" TableName "FOR" "EACH" "ROW" TriggerOrderOpt ProcedureProcStmt
+	{
+		// OrReplace and ViewAlgorithm are only used to avoid conflicts with CREATE VIEW.
+		if $2.(bool) || $3.(model.ViewAlgorithm) != model.AlgorithmUndefined {
+			yylex.AppendError(yylex.Errorf("OR REPLACE and ALGORITHM are not supported in CREATE TRIGGER"))
+			return 1
+		}
+		x := &ast.CreateTriggerStmt{
+			Definer:     $4.(*auth.UserIdentity),
+			IfNotExists: $6.(bool),
+			TriggerName: $7.(*ast.TableName),
+			Timing:      $8.(model.TriggerTiming),
+			Event:       $9.(model.TriggerEvent),
+			Table:       $11.(*ast.TableName),
+			Body:        $16,
+		}
+		if $15 != nil {
+			x.Order = $15.(*ast.TriggerOrder)
+		}
+		startOffset := parser.startOffset(&yyS[yypt])
+		x.Body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		$$ = x
+	}
+
+TriggerTiming:
+	"BEFORE"
+	{
+		$$ = model.TriggerTimingBefore
+	}
+|	"AFTER"
+	{
+		$$ = model.TriggerTimingAfter
+	}
+
+TriggerEvent:
+	"INSERT"
+	{
+		$$ = model.TriggerEventInsert
+	}
+|	"UPDATE"
+	{
+		$$ = model.TriggerEventUpdate
+	}
+|	"DELETE"
+	{
+		$$ = model.TriggerEventDelete
+	}
+
+TriggerOrderOpt:
+	{
+		$$ = nil
+	}
+|	"FOLLOWS" Identifier
+	{
+		$$ = &ast.TriggerOrder{Trigger: model.NewCIStr($2)}
+	}
+|	"PRECEDES" Identifier
+	{
+		$$ = &ast.TriggerOrder{Precedes: true, Trigger: model.NewCIStr($2)}
+	}
+
+/********************************************************************************************
+ *  DROP TRIGGER [IF EXISTS] [schema_name.]trigger_name
+ ********************************************************************************************/
+DropTriggerStmt:
+	"DROP" "TRIGGER" IfExists TableName
+	{
+		$$ = &ast.DropTriggerStmt{
+			IfExists:    $3.(bool),
+			TriggerName: $4.(*ast.TableName),
+		}
+	}
+
+/********************************************************************************************
+ *
+ *  Create Materialized View Statement
+ *
+ *  Example:
+ *  CREATE MATERIALIZED VIEW [IF NOT EXISTS] view_name [(column_list)] AS select_statement
+ ********************************************************************************************/
+CreateMaterializedViewStmt:
+	"CREATE" "MATERIALIZED" "VIEW" IfNotExists TableName ViewFieldList "AS" CreateViewSelectOpt
+	{
+		startOffset := parser.startOffset(&yyS[yypt])
+		selStmt := $8.(ast.StmtNode)
+		selStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
+		x := &ast.CreateMaterializedViewStmt{
+			IfNotExists: $4.(bool),
+			ViewName:    $5.(*ast.TableName),
+			Select:      selStmt,
+		}
+		if $6 != nil {
+			x.Cols = $6.([]model.CIStr)
+		}
+		$$ = x
+	}
+
+/********************************************************************************************
+ *  DROP MATERIALIZED VIEW [IF EXISTS] view_name
+ ********************************************************************************************/
+DropMaterializedViewStmt:
+	"DROP" "MATERIALIZED" "VIEW" IfExists TableName
+	{
+		$$ = &ast.DropMaterializedViewStmt{
+			IfExists: $4.(bool),
+			ViewName: $5.(*ast.TableName),
+		}
+	}
+
+/********************************************************************************************
+ *  REFRESH MATERIALIZED VIEW view_name [COMPLETE | INCREMENTAL]
+ ********************************************************************************************/
+RefreshMViewStmt:
+	"REFRESH" "MATERIALIZED" "VIEW" TableName RefreshMaterializedViewTypeOpt
+	{
+		$$ = &ast.RefreshMaterializedViewStmt{
+			ViewName: $4.(*ast.TableName),
+			Type:     $5.(ast.RefreshMaterializedViewType),
+		}
+	}
+
+RefreshMaterializedViewTypeOpt:
+	{
+		$$ = ast.RefreshMaterializedViewDefault
+	}
+|	"COMPLETE"
+	{
+		$$ = ast.RefreshMaterializedViewComplete
+	}
+|	"INCREMENTAL"
+	{
+		$$ = ast.RefreshMaterializedViewIncremental
+	}
+
 /********************************************************************
  *
  * Calibrate Resource Statement
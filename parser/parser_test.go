@@ -46,7 +46,7 @@ func TestSimple(t *testing.T) {
 		"exists", "explain", "false", "float", "fetch", "for", "force", "foreign", "from",
 		"fulltext", "grant", "group", "having", "hour_microsecond", "hour_minute",
 		"hour_second", "if", "ignore", "in", "index", "infile", "inner", "insert", "int", "into", "integer",
-		"interval", "is", "join", "key", "keys", "kill", "leading", "left", "like", "ilike", "limit", "lines", "load",
+		"interval", "is", "join", "key", "keys", "kill", "lateral", "leading", "left", "like", "ilike", "limit", "lines", "load",
 		"localtime", "localtimestamp", "lock", "longblob", "longtext", "mediumblob", "maxvalue", "mediumint", "mediumtext",
 		"minute_microsecond", "minute_second", "mod", "not", "no_write_to_binlog", "null", "numeric",
 		"on", "option", "optionally", "or", "order", "outer", "partition", "precision", "primary", "procedure", "range", "read", "real", "recursive",
@@ -3255,6 +3255,8 @@ func TestDDL(t *testing.T) {
 		{"CREATE FULLTEXT INDEX idx ON t (a) WITH PARSER ident comment 'string'", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`) WITH PARSER `ident` COMMENT 'string'"},
 		{"CREATE FULLTEXT INDEX idx ON t (a) comment 'string' with parser ident", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`) WITH PARSER `ident` COMMENT 'string'"},
 		{"CREATE FULLTEXT INDEX idx ON t (a) WITH PARSER ident comment 'string' lock default", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`) WITH PARSER `ident` COMMENT 'string'"},
+		{"CREATE VECTOR INDEX idx ON t ((VEC_COSINE_DISTANCE(v))) USING HNSW", true, "CREATE VECTOR INDEX `idx` ON `t` ((VEC_COSINE_DISTANCE(`v`))) USING HNSW"},
+		{"CREATE VECTOR INDEX IF NOT EXISTS idx ON t ((VEC_L2_DISTANCE(v)))", true, "CREATE VECTOR INDEX IF NOT EXISTS `idx` ON `t` ((VEC_L2_DISTANCE(`v`)))"},
 		{"CREATE INDEX idx ON t (a) USING HASH", true, "CREATE INDEX `idx` ON `t` (`a`) USING HASH"},
 		{"CREATE INDEX idx ON t (a) COMMENT 'foo'", true, "CREATE INDEX `idx` ON `t` (`a`) COMMENT 'foo'"},
 		{"CREATE INDEX idx ON t (a) USING HASH COMMENT 'foo'", true, "CREATE INDEX `idx` ON `t` (`a`) USING HASH COMMENT 'foo'"},
@@ -4749,6 +4751,22 @@ func TestType(t *testing.T) {
 
 		// for json type
 		{`create table t (a JSON);`, true, "CREATE TABLE `t` (`a` JSON)"},
+
+		// for spatial types
+		{"create table t (g geometry, p point not null, l linestring, pg polygon)", true, "CREATE TABLE `t` (`g` GEOMETRY,`p` POINT NOT NULL,`l` LINESTRING,`pg` POLYGON)"},
+		{"create table t (mp multipoint, ml multilinestring, mpg multipolygon, gc geometrycollection, gc1 geomcollection)", true, "CREATE TABLE `t` (`mp` MULTIPOINT,`ml` MULTILINESTRING,`mpg` MULTIPOLYGON,`gc` GEOMCOLLECTION,`gc1` GEOMCOLLECTION)"},
+		{"create table t (p point(10))", false, ""},
+		{"create table point (point int, polygon int, geometry int)", true, "CREATE TABLE `point` (`point` INT,`polygon` INT,`geometry` INT)"},
+		{"select point(1, 2), polygon from t", true, "SELECT POINT(1, 2),`polygon` FROM `t`"},
+
+		// for vector type
+		{"create table t (v vector, v3 vector(3))", true, "CREATE TABLE `t` (`v` VECTOR,`v3` VECTOR(3))"},
+		{"create table t (v vector(3), vector index idx ((vec_cosine_distance(v))) using hnsw)", true, "CREATE TABLE `t` (`v` VECTOR(3),VECTOR INDEX `idx`((VEC_COSINE_DISTANCE(`v`))) USING HNSW)"},
+		{"create table t (v vector(3), vector key ((vec_l2_distance(v))))", true, "CREATE TABLE `t` (`v` VECTOR(3),VECTOR INDEX((VEC_L2_DISTANCE(`v`))))"},
+		{"create table vector (vector int)", true, "CREATE TABLE `vector` (`vector` INT)"},
+		{"alter table t add vector index if not exists idx ((vec_l2_distance(v)))", true, "ALTER TABLE `t` ADD VECTOR INDEX IF NOT EXISTS `idx`((VEC_L2_DISTANCE(`v`)))"},
+		{"alter table t add column vector int", true, "ALTER TABLE `t` ADD COLUMN `vector` INT"},
+		{"select vec_cosine_distance(v, '[1,2,3]') as vector from t order by vector limit 3", true, "SELECT VEC_COSINE_DISTANCE(`v`, _UTF8MB4'[1,2,3]') AS `vector` FROM `t` ORDER BY `vector` LIMIT 3"},
 	}
 	RunTest(t, table, false)
 }
@@ -5615,6 +5633,141 @@ func TestView(t *testing.T) {
 	require.Equal(t, model.CheckOptionCascaded, v.CheckOption)
 }
 
+func TestEvent(t *testing.T) {
+	table := []testCase{
+		{"create event e on schedule at '2023-01-01 00:00:00' do delete from t", true, "CREATE EVENT `e` ON SCHEDULE AT _UTF8MB4'2023-01-01 00:00:00' DO DELETE FROM `t`"},
+		{"create event if not exists test.e on schedule at current_timestamp + interval 1 hour do insert into t values (1)", true, "CREATE EVENT IF NOT EXISTS `test`.`e` ON SCHEDULE AT DATE_ADD(CURRENT_TIMESTAMP(), INTERVAL 1 HOUR) DO INSERT INTO `t` VALUES (1)"},
+		{"create event e on schedule every 10 minute do delete from t where a < now()", true, "CREATE EVENT `e` ON SCHEDULE EVERY 10 MINUTE DO DELETE FROM `t` WHERE `a`<NOW()"},
+		{"create event e on schedule every 1 day starts '2023-01-01' ends '2024-01-01' on completion preserve disable comment 'x' do update t set a = a + 1", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 DAY STARTS _UTF8MB4'2023-01-01' ENDS _UTF8MB4'2024-01-01' ON COMPLETION PRESERVE DISABLE COMMENT 'x' DO UPDATE `t` SET `a`=`a`+1"},
+		{"create definer = 'root'@'%' event e on schedule every 1 hour on completion not preserve enable do delete from t", true, "CREATE DEFINER = `root`@`%` EVENT `e` ON SCHEDULE EVERY 1 HOUR ON COMPLETION NOT PRESERVE ENABLE DO DELETE FROM `t`"},
+		{"create definer = current_user event e on schedule every 1 hour do delete from t", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 HOUR DO DELETE FROM `t`"},
+		{"create event e on schedule every 1 hour do begin delete from t; delete from t1; end", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 HOUR DO BEGIN DELETE FROM `t`;DELETE FROM `t1`; END"},
+		{"create or replace event e on schedule every 1 hour do delete from t", false, ""},
+		{"create event e on schedule every 1 hour", false, ""},
+		{"create event e do delete from t", false, ""},
+		{"alter event e on schedule every 2 hour", true, "ALTER EVENT `e` ON SCHEDULE EVERY 2 HOUR"},
+		{"alter event e on completion preserve", true, "ALTER EVENT `e` ON COMPLETION PRESERVE"},
+		{"alter event e on schedule at '2023-01-01' on completion not preserve", true, "ALTER EVENT `e` ON SCHEDULE AT _UTF8MB4'2023-01-01' ON COMPLETION NOT PRESERVE"},
+		{"alter definer = 'u'@'%' event test.e rename to test.e1 disable comment '' do delete from t", true, "ALTER DEFINER = `u`@`%` EVENT `test`.`e` RENAME TO `test`.`e1` DISABLE COMMENT '' DO DELETE FROM `t`"},
+		{"alter event e enable", true, "ALTER EVENT `e` ENABLE"},
+		{"alter event e", false, ""},
+		{"drop event e", true, "DROP EVENT `e`"},
+		{"drop event if exists test.e", true, "DROP EVENT IF EXISTS `test`.`e`"},
+
+		// new unreserved keywords can still be used as identifiers
+		{"create table at (every int, starts int, ends int, completion int)", true, "CREATE TABLE `at` (`every` INT,`starts` INT,`ends` INT,`completion` INT)"},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	st, err := p.ParseOneStmt("create event e on schedule every 1 minute starts now() do delete from t where id > 10", "", "")
+	require.NoError(t, err)
+	e, ok := st.(*ast.CreateEventStmt)
+	require.True(t, ok)
+	require.True(t, e.Definer.CurrentUser)
+	require.Equal(t, ast.TimeUnitMinute, e.Schedule.Unit)
+	require.NotNil(t, e.Schedule.Starts)
+	require.Nil(t, e.Schedule.Ends)
+	require.Equal(t, "delete from t where id > 10", e.Body.Text())
+
+	st, err = p.ParseOneStmt("alter event e do delete from t1;", "", "")
+	require.NoError(t, err)
+	a, ok := st.(*ast.AlterEventStmt)
+	require.True(t, ok)
+	require.Nil(t, a.Definer)
+	require.Equal(t, "delete from t1", a.Body.Text())
+}
+
+func TestJSONTable(t *testing.T) {
+	table := []testCase{
+		{"select * from json_table('[1, 2]', '$[*]' columns (a int path '$')) as jt", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[1, 2]', '$[*]' COLUMNS (`a` INT PATH '$')) AS `jt`"},
+		{"select * from json_table('[1, 2]', '$[*]' columns (a int path '$')) jt", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[1, 2]', '$[*]' COLUMNS (`a` INT PATH '$')) AS `jt`"},
+		{"select * from json_table('[1, 2]', '$[*]' columns (a int path '$'))", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[1, 2]', '$[*]' COLUMNS (`a` INT PATH '$'))"},
+		{"select * from t, json_table(t.j, '$.items[*]' columns (id for ordinality, name varchar(20) path '$.name' default '\"x\"' on empty null on error, has_tag int exists path '$.tag')) as jt",
+			true, "SELECT * FROM (`t`) JOIN JSON_TABLE(`t`.`j`, '$.items[*]' COLUMNS (`id` FOR ORDINALITY, `name` VARCHAR(20) PATH '$.name' DEFAULT '\"x\"' ON EMPTY NULL ON ERROR, `has_tag` INT EXISTS PATH '$.tag')) AS `jt`"},
+		{"select * from t left join json_table(t.j, '$' columns (a json path '$.a' error on error, nested path '$.b[*]' columns (b int path '$', nested '$.c' columns (c int path '$' null on empty)))) as jt on true",
+			true, "SELECT * FROM `t` LEFT JOIN JSON_TABLE(`t`.`j`, '$' COLUMNS (`a` JSON PATH '$.a' ERROR ON ERROR, NESTED PATH '$.b[*]' COLUMNS (`b` INT PATH '$', NESTED PATH '$.c' COLUMNS (`c` INT PATH '$' NULL ON EMPTY)))) AS `jt` ON TRUE"},
+		{"select * from json_table('[]', '$' columns (a int path '$' error on error null on empty)) as jt", false, ""},
+		{"select * from json_table('[]', '$' columns ()) as jt", false, ""},
+		{"select * from json_table('[]', '$') as jt", false, ""},
+		{"select * from json_table('[]' columns (a int path '$')) as jt", false, ""},
+
+		// new unreserved keywords can still be used as identifiers
+		{"create table nested (path int, ordinality int, empty int)", true, "CREATE TABLE `nested` (`path` INT,`ordinality` INT,`empty` INT)"},
+		{"select * from json_table('[]', '$' columns (nested int path '$', path for ordinality)) as jt", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[]', '$' COLUMNS (`nested` INT PATH '$', `path` FOR ORDINALITY)) AS `jt`"},
+		{"create table json_table (a int)", false, ""},
+	}
+	RunTest(t, table, false)
+}
+
+func TestLateralDerivedTable(t *testing.T) {
+	table := []testCase{
+		{"select * from t, lateral (select * from t1 where t1.a = t.a limit 3) as dt", true, "SELECT * FROM (`t`) JOIN LATERAL (SELECT * FROM `t1` WHERE `t1`.`a`=`t`.`a` LIMIT 3) AS `dt`"},
+		{"select * from t join lateral (select t.a + 1 as b) dt on dt.b > 1", true, "SELECT * FROM `t` JOIN LATERAL (SELECT `t`.`a`+1 AS `b`) AS `dt` ON `dt`.`b`>1"},
+		{"select * from t left join lateral (select 1 union select t.a) dt on true", true, "SELECT * FROM `t` LEFT JOIN LATERAL (SELECT 1 UNION SELECT `t`.`a`) AS `dt` ON TRUE"},
+		{"select * from t, lateral t1", false, ""},
+		{"create table lateral (a int)", false, ""},
+	}
+	RunTest(t, table, false)
+}
+
+func TestTrigger(t *testing.T) {
+	table := []testCase{
+		{"create trigger tr before insert on t for each row set new.a = new.a + 1", true, "CREATE TRIGGER `tr` BEFORE INSERT ON `t` FOR EACH ROW SET @@SESSION.`new.a`=`new`.`a`+1"},
+		{"create trigger if not exists test.tr after update on test.t for each row insert into log values (old.a, new.a)", true, "CREATE TRIGGER IF NOT EXISTS `test`.`tr` AFTER UPDATE ON `test`.`t` FOR EACH ROW INSERT INTO `log` VALUES (`old`.`a`,`new`.`a`)"},
+		{"create definer = 'root'@'%' trigger tr after delete on t for each row follows tr1 delete from t1 where a = old.a", true, "CREATE DEFINER = `root`@`%` TRIGGER `tr` AFTER DELETE ON `t` FOR EACH ROW FOLLOWS `tr1` DELETE FROM `t1` WHERE `a`=`old`.`a`"},
+		{"create definer = current_user trigger tr before delete on t for each row precedes tr1 begin delete from t1; delete from t2; end", true, "CREATE TRIGGER `tr` BEFORE DELETE ON `t` FOR EACH ROW PRECEDES `tr1` BEGIN DELETE FROM `t1`;DELETE FROM `t2`; END"},
+		{"create or replace trigger tr before insert on t for each row set new.a = 1", false, ""},
+		{"create trigger tr before select on t for each row set new.a = 1", false, ""},
+		{"create trigger tr before insert on t set new.a = 1", false, ""},
+		{"create trigger tr insert on t for each row set new.a = 1", false, ""},
+		{"drop trigger tr", true, "DROP TRIGGER `tr`"},
+		{"drop trigger if exists test.tr", true, "DROP TRIGGER IF EXISTS `test`.`tr`"},
+
+		// new unreserved keywords can still be used as identifiers
+		{"create table before (each int, follows int, precedes int)", true, "CREATE TABLE `before` (`each` INT,`follows` INT,`precedes` INT)"},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	st, err := p.ParseOneStmt("create trigger tr before update on t for each row begin set new.b = old.b; end;", "", "")
+	require.NoError(t, err)
+	tr, ok := st.(*ast.CreateTriggerStmt)
+	require.True(t, ok)
+	require.True(t, tr.Definer.CurrentUser)
+	require.Equal(t, model.TriggerTimingBefore, tr.Timing)
+	require.Equal(t, model.TriggerEventUpdate, tr.Event)
+	require.Nil(t, tr.Order)
+	require.Equal(t, "begin set new.b = old.b; end", tr.Body.Text())
+}
+
+func TestMaterializedView(t *testing.T) {
+	table := []testCase{
+		{"create materialized view mv as select a, count(*) from t group by a", true, "CREATE MATERIALIZED VIEW `mv` AS SELECT `a`,COUNT(1) FROM `t` GROUP BY `a`"},
+		{"create materialized view if not exists test.mv (a, cnt) as select a, count(*) from t where b > 1 group by a", true, "CREATE MATERIALIZED VIEW IF NOT EXISTS `test`.`mv` (`a`,`cnt`) AS SELECT `a`,COUNT(1) FROM `t` WHERE `b`>1 GROUP BY `a`"},
+		{"create or replace materialized view mv as select 1", false, ""},
+		{"create materialized view mv", false, ""},
+		{"drop materialized view mv", true, "DROP MATERIALIZED VIEW `mv`"},
+		{"drop materialized view if exists test.mv", true, "DROP MATERIALIZED VIEW IF EXISTS `test`.`mv`"},
+		{"drop materialized view mv1, mv2", false, ""},
+		{"refresh materialized view mv", true, "REFRESH MATERIALIZED VIEW `mv`"},
+		{"refresh materialized view test.mv complete", true, "REFRESH MATERIALIZED VIEW `test`.`mv` COMPLETE"},
+		{"refresh materialized view mv incremental", true, "REFRESH MATERIALIZED VIEW `mv` INCREMENTAL"},
+		{"refresh materialized view mv fast", false, ""},
+
+		// new unreserved keywords can still be used as identifiers
+		{"create table materialized (complete int, refresh int)", true, "CREATE TABLE `materialized` (`complete` INT,`refresh` INT)"},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	st, err := p.ParseOneStmt("create materialized view mv as select a, sum(b) from t group by a", "", "")
+	require.NoError(t, err)
+	mv, ok := st.(*ast.CreateMaterializedViewStmt)
+	require.True(t, ok)
+	require.Equal(t, "select a, sum(b) from t group by a", mv.Select.Text())
+}
+
 func TestTimestampDiffUnit(t *testing.T) {
 	// Test case for timestampdiff unit.
 	// TimeUnit should be unified to upper case.
@@ -5851,6 +6004,9 @@ func TestAnalyze(t *testing.T) {
 		{"analyze table t index a predicate columns", false, ""},
 		{"analyze table t with 10 samplerate", true, "ANALYZE TABLE `t` WITH 10 SAMPLERATE"},
 		{"analyze table t with 0.1 samplerate", true, "ANALYZE TABLE `t` WITH 0.1 SAMPLERATE"},
+		{"analyze table t columns a,b with joint stats", true, "ANALYZE TABLE `t` COLUMNS `a`,`b` WITH JOINT STATS"},
+		{"analyze table t columns a,b with 4 topn, joint stats", true, "ANALYZE TABLE `t` COLUMNS `a`,`b` WITH 4 TOPN, JOINT STATS"},
+		{"analyze table t with joint", false, ""},
 	}
 	RunTest(t, table, false)
 }
@@ -6788,6 +6944,22 @@ func TestIndexAdviseStmt(t *testing.T) {
 	RunTest(t, table, false)
 }
 
+func TestRecommendIndexStmt(t *testing.T) {
+	table := []testCase{
+		{"RECOMMEND INDEX RUN", true, "RECOMMEND INDEX RUN"},
+		{"recommend index run for 'select a from t where b = 1'", true, "RECOMMEND INDEX RUN FOR 'select a from t where b = 1'"},
+		{"RECOMMEND INDEX RUN WITH max_num_index = 3", true, "RECOMMEND INDEX RUN WITH max_num_index = 3"},
+		{"RECOMMEND INDEX RUN WITH MAX_NUM_INDEX = 3, max_index_columns = 2", true, "RECOMMEND INDEX RUN WITH max_num_index = 3, max_index_columns = 2"},
+		{"RECOMMEND INDEX RUN FOR 'select 1' WITH max_num_query = 10", true, "RECOMMEND INDEX RUN FOR 'select 1' WITH max_num_query = 10"},
+		{"RECOMMEND INDEX RUN WITH max_num_index = -1", false, ""},
+		{"RECOMMEND INDEX RUN WITH max_num_index", false, ""},
+		{"RECOMMEND INDEX", false, ""},
+		{"RECOMMEND INDEX RUN FOR select 1", false, ""},
+		{"create table recommend (recommend int)", true, "CREATE TABLE `recommend` (`recommend` INT)"},
+	}
+	RunTest(t, table, false)
+}
+
 // For BRIE
 func TestBRIE(t *testing.T) {
 	table := []testCase{
@@ -68,6 +68,7 @@ var type2Str = map[byte]string{
 	mysql.TypeVarchar:     "varchar",
 	mysql.TypeVarString:   "var_string",
 	mysql.TypeYear:        "year",
+	mysql.TypeVector:      "vector",
 }
 
 var str2Type = map[string]byte{
@@ -98,6 +99,23 @@ var str2Type = map[string]byte{
 	"varchar":     mysql.TypeVarchar,
 	"var_string":  mysql.TypeVarString,
 	"year":        mysql.TypeYear,
+	"vector":      mysql.TypeVector,
+}
+
+var geometryType2Str = map[byte]string{
+	mysql.GeometryTypeGeometry:           "geometry",
+	mysql.GeometryTypePoint:              "point",
+	mysql.GeometryTypeLineString:         "linestring",
+	mysql.GeometryTypePolygon:            "polygon",
+	mysql.GeometryTypeMultiPoint:         "multipoint",
+	mysql.GeometryTypeMultiLineString:    "multilinestring",
+	mysql.GeometryTypeMultiPolygon:       "multipolygon",
+	mysql.GeometryTypeGeometryCollection: "geomcollection",
+}
+
+// GeometryTypeStr converts the geometry subtype to a string.
+func GeometryTypeStr(tp byte) string {
+	return geometryType2Str[tp]
 }
 
 // TypeStr converts tp to a string.
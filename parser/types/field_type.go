@@ -57,6 +57,8 @@ type FieldType struct {
 	elems            []string
 	elemsIsBinaryLit []bool
 	array            bool
+	// geometryType is the subtype of the geometry type, e.g. POINT or POLYGON.
+	geometryType byte
 	// Please keep in mind that jsonFieldType should be updated if you add a new field here.
 }
 
@@ -228,6 +230,16 @@ func (ft *FieldType) SetArray(array bool) {
 	ft.array = array
 }
 
+// GetGeometryType returns the subtype of the geometry type.
+func (ft *FieldType) GetGeometryType() byte {
+	return ft.geometryType
+}
+
+// SetGeometryType sets the subtype of the geometry type.
+func (ft *FieldType) SetGeometryType(tp byte) {
+	ft.geometryType = tp
+}
+
 // IsArray return true if the filed type is array.
 func (ft *FieldType) IsArray() bool {
 	return ft.array
@@ -296,6 +308,7 @@ func (ft *FieldType) Equal(other *FieldType) bool {
 		ft.charset == other.charset &&
 		ft.collate == other.collate &&
 		flenEqual &&
+		ft.geometryType == other.geometryType &&
 		mysql.HasUnsignedFlag(ft.flag) == mysql.HasUnsignedFlag(other.flag)
 	if !partialEqual || len(ft.elems) != len(other.elems) {
 		return false
@@ -370,6 +383,9 @@ func (ft *FieldType) Init(tp byte) {
 // This is used for showing column type in infoschema.
 func (ft *FieldType) CompactStr() string {
 	ts := TypeToStr(ft.GetType(), ft.charset)
+	if ft.GetType() == mysql.TypeGeometry {
+		ts = GeometryTypeStr(ft.geometryType)
+	}
 	suffix := ""
 
 	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(ft.GetType())
@@ -429,6 +445,11 @@ func (ft *FieldType) CompactStr() string {
 		suffix = fmt.Sprintf("(%d)", ft.flen)
 	case mysql.TypeNull:
 		suffix = "(0)"
+	case mysql.TypeVector:
+		// The length of a vector type is its dimension, a vector type without dimension accepts any vectors.
+		if ft.flen != UnspecifiedLength {
+			suffix = fmt.Sprintf("(%d)", ft.flen)
+		}
 	}
 	return ts + suffix
 }
@@ -473,6 +494,10 @@ func (ft *FieldType) String() string {
 
 // Restore implements Node interface.
 func (ft *FieldType) Restore(ctx *format.RestoreCtx) error {
+	if ft.GetType() == mysql.TypeGeometry {
+		ctx.WriteKeyWord(GeometryTypeStr(ft.geometryType))
+		return nil
+	}
 	ctx.WriteKeyWord(TypeToStr(ft.GetType(), ft.charset))
 
 	precision := UnspecifiedLength
@@ -644,6 +669,7 @@ type jsonFieldType struct {
 	Elems            []string
 	ElemsIsBinaryLit []bool
 	Array            bool
+	GeometryType     byte `json:",omitempty"`
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -660,6 +686,7 @@ func (ft *FieldType) UnmarshalJSON(data []byte) error {
 		ft.elems = r.Elems
 		ft.elemsIsBinaryLit = r.ElemsIsBinaryLit
 		ft.array = r.Array
+		ft.geometryType = r.GeometryType
 	}
 	return err
 }
@@ -676,6 +703,7 @@ func (ft *FieldType) MarshalJSON() ([]byte, error) {
 	r.Elems = ft.elems
 	r.ElemsIsBinaryLit = ft.elemsIsBinaryLit
 	r.Array = ft.array
+	r.GeometryType = ft.geometryType
 	return json.Marshal(r)
 }
 
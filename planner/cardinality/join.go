@@ -17,9 +17,14 @@ package cardinality
 import (
 	"math"
 
+	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/planner/property"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/statistics"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/collate"
 )
 
 // EstimateFullJoinRowCount estimates the row count of a full join.
@@ -49,3 +54,72 @@ func EstimateFullJoinRowCount(sctx sessionctx.Context,
 	// This estimation logic is referred to Presto.
 	return count * math.Pow(0.9, float64(len(leftJoinKeys)-max(leftColCnt, rightColCnt)))
 }
+
+// HeavyHitter is a value of a column which appears much more frequently than the others.
+type HeavyHitter struct {
+	Value types.Datum
+	// Ratio is the ratio of the rows having this value to all the rows.
+	Ratio float64
+}
+
+// GetHeavyHitters returns the values of the column whose ratios to all the rows are not less than the threshold,
+// according to the TopN of the column or single-column index statistics. Only integer columns and string columns with
+// binary collations are supported, with stats ver2:
+//   - The TopN stores the collation keys of strings, which can be decoded to values equal to the original ones only if
+//     the collation is binary, e.g. utf8mb4_bin without the trailing spaces.
+//   - The other types (e.g. decimal, time and json) aren't supported, since their encoded TopN values may lose the
+//     field types of the original values.
+//   - The TopN doesn't cover all the rows in stats ver1.
+//
+// The heavy hitters of a composite key can't be derived from the statistics of the single columns, so the callers
+// handle the skew only if there's a single join key.
+func GetHeavyHitters(sctx sessionctx.Context, coll *statistics.HistColl, col *expression.Column, threshold float64) ([]HeavyHitter, error) {
+	if coll == nil || threshold <= 0 || !IsHeavyHitterSupported(col.GetType()) {
+		return nil, nil
+	}
+	isIndex, i := findAvailableStatsForCol(sctx, coll, col.UniqueID)
+	if i < 0 {
+		return nil, nil
+	}
+	var (
+		statsVer int64
+		hist     *statistics.Histogram
+		topn     *statistics.TopN
+	)
+	if isIndex {
+		stats := coll.Indices[i]
+		statsVer, hist, topn = stats.StatsVer, &stats.Histogram, stats.TopN
+	} else {
+		stats := coll.Columns[i]
+		statsVer, hist, topn = stats.StatsVer, &stats.Histogram, stats.TopN
+	}
+	if statsVer != statistics.Version2 || topn.Num() == 0 {
+		return nil, nil
+	}
+	// In stats ver2, TopN + Histogram + NULL == All data.
+	totalCnt := float64(topn.TotalCount()) + hist.NotNullCount() + float64(hist.NullCount)
+	if totalCnt <= 0 {
+		return nil, nil
+	}
+	var heavyHitters []HeavyHitter
+	for _, item := range topn.TopN {
+		ratio := float64(item.Count) / totalCnt
+		if ratio < threshold {
+			continue
+		}
+		_, val, err := codec.DecodeOne(item.Encoded)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		heavyHitters = append(heavyHitters, HeavyHitter{Value: val, Ratio: ratio})
+	}
+	return heavyHitters, nil
+}
+
+// IsHeavyHitterSupported checks whether the heavy hitters of a column with the type can be got by GetHeavyHitters.
+func IsHeavyHitterSupported(tp *types.FieldType) bool {
+	if types.IsTypeInteger(tp.GetType()) {
+		return true
+	}
+	return types.IsString(tp.GetType()) && collate.IsBinCollation(tp.GetCollate())
+}
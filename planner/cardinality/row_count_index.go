@@ -91,6 +91,54 @@ func GetRowCountByIndexRanges(sctx sessionctx.Context, coll *statistics.HistColl
 	return result, errors.Trace(err)
 }
 
+// GetRowCountByHypoIndexRanges estimates the row count by a slice of Range of a hypothetical index, which has no
+// statistics of its own. The row count of each range is derived from the histograms of the index columns, whose
+// unique IDs are colIDs, assuming the columns are independent of each other.
+func GetRowCountByHypoIndexRanges(sctx sessionctx.Context, coll *statistics.HistColl, colIDs []int64, unique bool, indexRanges []*ranger.Range) (float64, error) {
+	sc := sctx.GetSessionVars().StmtCtx
+	if coll.Pseudo {
+		colsLen := -1
+		if unique {
+			colsLen = len(colIDs)
+		}
+		return getPseudoRowCountByIndexRanges(sc, indexRanges, float64(coll.RealtimeCount), colsLen)
+	}
+	realtimeCnt := float64(coll.RealtimeCount)
+	if realtimeCnt <= 0 {
+		return 0, nil
+	}
+	totalCount := float64(0)
+	for _, ran := range indexRanges {
+		rangePosition := getOrdinalOfRangeCond(sc, ran)
+		if unique && rangePosition == len(colIDs) {
+			totalCount++
+			continue
+		}
+		selectivity := 1.0
+		for i := 0; i < len(ran.LowVal) && i < len(colIDs); i++ {
+			colRange := &ranger.Range{
+				LowVal:    []types.Datum{ran.LowVal[i]},
+				HighVal:   []types.Datum{ran.HighVal[i]},
+				Collators: ran.Collators[i : i+1],
+			}
+			if i == rangePosition {
+				colRange.LowExclude, colRange.HighExclude = ran.LowExclude, ran.HighExclude
+			}
+			count, err := GetRowCountByColumnRanges(sctx, coll, colIDs[i], []*ranger.Range{colRange})
+			if err != nil {
+				return 0, errors.Trace(err)
+			}
+			selectivity *= count / realtimeCnt
+			// The columns after the first range column don't narrow down the scanned range of the index.
+			if i == rangePosition {
+				break
+			}
+		}
+		totalCount += selectivity * realtimeCnt
+	}
+	return math.Min(totalCount, realtimeCnt), nil
+}
+
 func getIndexRowCountForStatsV1(sctx sessionctx.Context, coll *statistics.HistColl, idxID int64, indexRanges []*ranger.Range) (float64, error) {
 	sc := sctx.GetSessionVars().StmtCtx
 	debugTrace := sc.EnableOptimizerDebugTrace
@@ -145,6 +145,11 @@ func Selectivity(
 			nodes[len(nodes)-1].Selectivity = cnt / float64(coll.RealtimeCount)
 		}
 	}
+	jointNodes, err := getJointStatsNodes(ctx, coll, nodes)
+	if err != nil {
+		return 0, nil, errors.Trace(err)
+	}
+	nodes = append(nodes, jointNodes...)
 	id2Paths := make(map[int64]*planutil.AccessPath)
 	for _, path := range filledPaths {
 		// Index merge path and table path don't have index.
@@ -439,6 +444,7 @@ const (
 	IndexType = iota
 	PkType
 	ColType
+	JointType
 )
 
 func compareType(l, r int) int {
@@ -451,12 +457,94 @@ func compareType(l, r int) int {
 	if l == PkType {
 		return 1
 	}
-	if r == ColType {
+	if l == JointType {
+		if r == ColType {
+			return 1
+		}
+		return -1
+	}
+	if r == ColType || r == JointType {
 		return 1
 	}
 	return -1
 }
 
+// maxJointStatsCombinations is the max number of value combinations looked up in the joint statistics for one node.
+const maxJointStatsCombinations = 100
+
+// getJointStatsNodes builds the StatsNodes of the joint statistics whose columns are all restricted to points by the
+// column nodes. The selectivity of such a node is the sum of the selectivities of the value combinations.
+func getJointStatsNodes(ctx sessionctx.Context, coll *statistics.HistColl, colNodes []*StatsNode) ([]*StatsNode, error) {
+	if len(coll.JointStats) == 0 {
+		return nil, nil
+	}
+	sc := ctx.GetSessionVars().StmtCtx
+	var nodes []*StatsNode
+jointStatsLoop:
+	for i, js := range coll.JointStats {
+		node := &StatsNode{Tp: JointType, ID: int64(i), numCols: len(js.ColIDs), Selectivity: 1}
+		combinations := 1
+		pointsOfCols := make([][]types.Datum, 0, len(js.ColIDs))
+		for _, colID := range js.ColIDs {
+			idx := slices.IndexFunc(colNodes, func(n *StatsNode) bool {
+				return (n.Tp == ColType || n.Tp == PkType) && n.ID == colID
+			})
+			if idx < 0 || len(colNodes[idx].Ranges) == 0 {
+				continue jointStatsLoop
+			}
+			colNode := colNodes[idx]
+			combinations *= len(colNode.Ranges)
+			if combinations > maxJointStatsCombinations {
+				continue jointStatsLoop
+			}
+			points := make([]types.Datum, 0, len(colNode.Ranges))
+			for _, ran := range colNode.Ranges {
+				if !ran.IsPointNonNullable(ctx) {
+					continue jointStatsLoop
+				}
+				point := *ran.LowVal[0].Clone()
+				// Use the collate key as statistics.BuildJointStats does.
+				if point.Kind() == types.KindString {
+					point.SetBytes(collate.GetCollator(point.Collation()).Key(point.GetString()))
+				}
+				points = append(points, point)
+			}
+			pointsOfCols = append(pointsOfCols, points)
+			node.mask |= colNode.mask
+			node.Selectivity = math.Min(node.Selectivity, colNode.Selectivity)
+		}
+		sel := 0.0
+		combination := make([]types.Datum, len(pointsOfCols))
+		var key []byte
+		var err error
+		var visit func(col int) error
+		visit = func(col int) error {
+			if col == len(pointsOfCols) {
+				key, err = codec.EncodeKey(sc, key[:0], combination...)
+				if err != nil {
+					return err
+				}
+				sel += js.EqualSelectivity(key)
+				return nil
+			}
+			for _, point := range pointsOfCols[col] {
+				combination[col] = point
+				if err := visit(col + 1); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if err := visit(0); err != nil {
+			return nil, err
+		}
+		// The combinations can't be more than the rows satisfying the condition on any single column.
+		node.Selectivity = math.Min(node.Selectivity, sel)
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
 const unknownColumnID = math.MinInt64
 
 // getConstantColumnID receives two expressions and if one of them is column and another is constant, it returns the
@@ -1395,3 +1395,56 @@ func TestIgnoreRealtimeStats(t *testing.T) {
 	testKit.MustExec("set @@tidb_opt_objective = 'determinate'")
 	testKit.MustQuery("explain select * from t where a = 1 and b > 2").Check(testkit.Rows(analyzedPlan...))
 }
+
+func TestJointStatsEstimation(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	h := dom.StatsHandle()
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_analyze_version = 2")
+	tk.MustExec("create table t (a int, b int, c varchar(10) collate utf8mb4_general_ci, d int)")
+	require.NoError(t, h.HandleDDLEvent(<-h.DDLEventCh()))
+	vals := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		vals = append(vals, fmt.Sprintf("(%d, %d, 'x%d', %d)", i%10, i%10, i%10, i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(vals, ", "))
+	require.NoError(t, h.DumpStatsDeltaToKV(handle.DumpAll))
+
+	estRows := func(sql string) string {
+		return tk.MustQuery("explain format = 'brief' " + sql).Rows()[0][1].(string)
+	}
+	tk.MustExec("analyze table t")
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	require.Equal(t, "10.00", estRows("select * from t where a = 1 and b = 1"))
+
+	tk.MustGetErrMsg("analyze table t with joint stats", "Joint statistics can only be collected on the specified columns, e.g, ANALYZE TABLE t COLUMNS a, b WITH JOINT STATS")
+	tk.MustGetErrMsg("analyze table t columns a with joint stats", "Joint statistics can only be collected on 2 to 4 columns")
+	tk.MustExec("set @@tidb_analyze_version = 1")
+	tk.MustGetErrMsg("analyze table t columns a, b with joint stats", "Only the version 2 of analyze supports collecting joint statistics")
+	tk.MustExec("set @@tidb_analyze_version = 2")
+
+	tk.MustExec("analyze table t columns a, b, c with joint stats")
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	tk.MustQuery("show stats_extended where table_name = 't'").CheckAt([]int{0, 1, 2, 3, 4, 5}, testkit.RowsWithSep("|",
+		"test|t|joint_1_2_3|[a,b,c]|joint|ndv: 10, topn: 10"))
+	// The correlated columns are estimated by the joint statistics.
+	require.Equal(t, "100.00", estRows("select * from t where a = 1 and b = 1 and c = 'X1'"))
+	require.Equal(t, "200.00", estRows("select * from t where a in (1, 2) and b in (1, 2) and c in ('x1', 'x2')"))
+	// The combination which doesn't appear is estimated to be rare.
+	require.Equal(t, "1.00", estRows("select * from t where a = 1 and b = 2 and c = 'x1'"))
+	// The joint statistics can't be used if any of the columns isn't restricted to points.
+	require.Equal(t, "10.00", estRows("select * from t where a = 1 and b = 1"))
+	require.Equal(t, "9.00", estRows("select * from t where a = 1 and b = 1 and c > 'x0'"))
+
+	// The joint statistics survive dumping and loading.
+	tbl, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	jsonTbl, err := h.DumpStatsToJSON("test", tbl.Meta(), nil, true)
+	require.NoError(t, err)
+	tk.MustExec("drop stats t")
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	require.NoError(t, h.LoadStatsFromJSON(dom.InfoSchema(), jsonTbl))
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	require.Equal(t, "100.00", estRows("select * from t where a = 1 and b = 1 and c = 'X1'"))
+}
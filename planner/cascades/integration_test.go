@@ -16,6 +16,7 @@ package cascades_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/failpoint"
@@ -23,6 +24,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/testkit/testdata"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSimpleProjDual(t *testing.T) {
@@ -439,3 +441,61 @@ func TestInlineProjection(t *testing.T) {
 		tk.MustQuery(sql).Check(testkit.Rows(output[i].Result...))
 	}
 }
+
+func TestJoinReorder(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2, t3, t4, t5")
+	for i, rowCount := range []int{1000, 10, 500, 50, 200} {
+		tk.MustExec(fmt.Sprintf("create table t%d(a int, b int, c int)", i+1))
+		values := make([]string, 0, rowCount)
+		for j := 0; j < rowCount; j++ {
+			values = append(values, fmt.Sprintf("(%d, %d, %d)", j%20, j%7, j%13))
+		}
+		tk.MustExec(fmt.Sprintf("insert into t%d values %s", i+1, strings.Join(values, ", ")))
+		tk.MustExec(fmt.Sprintf("analyze table t%d", i+1))
+	}
+
+	var input []string
+	var output []struct {
+		SQL         string
+		Plan        []string
+		DefaultPlan []string
+		Result      []string
+	}
+	integrationSuiteData := cascades.GetIntegrationSuiteData()
+	integrationSuiteData.LoadTestCases(t, &input, &output)
+	for i, sql := range input {
+		tk.MustExec("set session tidb_enable_cascades_planner = 0")
+		defaultPlan := testdata.ConvertRowsToStrings(tk.MustQuery("explain format = 'brief' " + sql).Rows())
+		result := tk.MustQuery(sql).Sort().Rows()
+		tk.MustExec("set session tidb_enable_cascades_planner = 1")
+		testdata.OnRecord(func() {
+			output[i].SQL = sql
+			output[i].Plan = testdata.ConvertRowsToStrings(tk.MustQuery("explain format = 'brief' " + sql).Rows())
+			output[i].DefaultPlan = defaultPlan
+			output[i].Result = testdata.ConvertRowsToStrings(tk.MustQuery(sql).Sort().Rows())
+		})
+		tk.MustQuery("explain format = 'brief' " + sql).Check(testkit.Rows(output[i].Plan...))
+		require.Equal(t, output[i].DefaultPlan, defaultPlan)
+		// Both planners give the same results.
+		tk.MustQuery(sql).Sort().Check(result)
+		tk.MustQuery(sql).Sort().Check(testkit.Rows(output[i].Result...))
+	}
+
+	// The joins are kept in the original order when the join reorder is disabled.
+	sql := "select count(*) from t1, t3, t2 where t1.a = t3.a and t1.b = t2.b"
+	straightJoinPlan := tk.MustQuery("explain format = 'brief' select straight_join count(*) from t1, t3, t2 where t1.a = t3.a and t1.b = t2.b").Rows()
+	require.NotEqual(t, straightJoinPlan, tk.MustQuery("explain format = 'brief' "+sql).Rows())
+	tk.MustExec("set session tidb_cascades_join_reorder_limit = 0")
+	tk.MustQuery("explain format = 'brief' " + sql).Check(straightJoinPlan)
+	tk.MustExec("set session tidb_cascades_join_reorder_limit = default")
+
+	// The queries with the operators not supported by the cascades planner fall back to the default planner.
+	sql = "with recursive cte(n) as (select 1 union all select n + 1 from cte where n < 3) select count(*) from cte join t2 on cte.n = t2.a"
+	plan := tk.MustQuery("explain format = 'brief' " + sql).Rows()
+	tk.MustExec("set session tidb_enable_cascades_planner = 0")
+	tk.MustQuery("explain format = 'brief' " + sql).Check(plan)
+}
@@ -0,0 +1,327 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cascades
+
+import (
+	"math/bits"
+
+	"github.com/pingcap/tidb/expression"
+	plannercore "github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/planner/memo"
+)
+
+// maxJoinReorderInputs is the max number of the inputs of a join tree to be reordered, so a set of inputs fits in a
+// uint64.
+const maxJoinReorderInputs = 64
+
+// joinOrderExplorer explores the join orders of the inner join trees in the memo.
+//
+// The inputs of a join tree are the groups below the reorderable inner joins. Every connected set of the inputs is
+// a group, whose schema is the concatenation of the inputs' schemas in their original order, so the group of the
+// whole set is the group of the original join tree. The groups are built from the smaller sets to the larger ones by
+// dynamic programming: among the pairs of the disjoint sets connected by any condition, only the cheapest one adds a
+// join to the group of their union. The cost of a set is the sum of the row counts of the joins in it, which depends
+// only on the logical stats. The sets whose costs are no less than the cost of the original join tree are pruned,
+// since they can't be part of a cheaper join order. The joins of the original join tree are always kept, and the
+// best one of all the joins in the memo is chosen by the physical cost in the implementation phase.
+//
+// The number of the joins added to the memo is limited by tidb_cascades_join_reorder_limit. Once the limit is
+// reached, the remaining pairs are not enumerated and the joins above them are kept in the original order.
+type joinOrderExplorer struct {
+	// budget is the number of the joins which can still be added to the memo.
+	budget  int
+	visited map[*memo.Group]struct{}
+	// deriveStats fills the stats of the group.
+	deriveStats func(g *memo.Group) error
+}
+
+func (e *joinOrderExplorer) explore(g *memo.Group) error {
+	if _, ok := e.visited[g]; ok {
+		return nil
+	}
+	e.visited[g] = struct{}{}
+	if elem := g.GetFirstElem(memo.OperandJoin); elem != nil && canReorderJoinExpr(elem.Value.(*memo.GroupExpr)) {
+		tree := &joinTree{groups: make(map[uint64]*memo.Group)}
+		tree.collect(g, e.visited)
+		if e.budget > 0 && tree.valid() {
+			if err := e.enumerate(tree); err != nil {
+				return err
+			}
+		}
+		for _, input := range tree.inputs {
+			if err := e.explore(input); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for elem := g.Equivalents.Front(); elem != nil; elem = elem.Next() {
+		for _, child := range elem.Value.(*memo.GroupExpr).Children {
+			if err := e.explore(child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func canReorderJoinExpr(expr *memo.GroupExpr) bool {
+	join := expr.ExprNode.(*plannercore.LogicalJoin)
+	// The conditions on a single side should have been pushed down to the children.
+	return join.CanReorder() && len(join.LeftConditions) == 0 && len(join.RightConditions) == 0
+}
+
+// joinCandidate is the cheapest pair of the sets found for their union.
+type joinCandidate struct {
+	left, right uint64
+	conds       []expression.Expression
+	// cost is the sum of the costs of the two sets.
+	cost float64
+}
+
+// enumerate builds the groups of the connected sets of the inputs bottom-up.
+func (e *joinOrderExplorer) enumerate(tree *joinTree) error {
+	upperBound, err := e.originalCost(tree)
+	if err != nil {
+		return err
+	}
+	setsBySize := make([][]uint64, len(tree.inputs)+1)
+	costs := make(map[uint64]float64)
+	for i := range tree.inputs {
+		setsBySize[1] = append(setsBySize[1], 1<<i)
+		costs[1<<i] = 0
+	}
+	for size := 2; size <= len(tree.inputs); size++ {
+		if e.budget <= 0 {
+			return nil
+		}
+		candidates := make(map[uint64]*joinCandidate)
+		// unions keeps the order in which the unions are found, so the joins are added deterministically.
+		var unions []uint64
+	scan:
+		for leftSize := 1; leftSize <= size/2; leftSize++ {
+			for _, s1 := range setsBySize[leftSize] {
+				// Every union found in this round takes a join from the budget.
+				if len(unions) >= e.budget {
+					break scan
+				}
+				for _, s2 := range setsBySize[size-leftSize] {
+					if s1&s2 != 0 || (leftSize == size-leftSize && s1 > s2) {
+						continue
+					}
+					// The costs are non-negative, so the pair can't be cheaper than the original join tree or the
+					// cheapest pair found for the union.
+					cost := costs[s1] + costs[s2]
+					if cost >= upperBound {
+						continue
+					}
+					c, ok := candidates[s1|s2]
+					if ok && cost >= c.cost {
+						continue
+					}
+					// The left side holds the first input, so the joins are in the original order if possible.
+					left, right := s1, s2
+					if bits.TrailingZeros64(s2) < bits.TrailingZeros64(s1) {
+						left, right = s2, s1
+					}
+					conds := tree.conditionsBetween(left, right)
+					if len(conds) == 0 {
+						continue
+					}
+					if !ok {
+						unions = append(unions, s1|s2)
+					}
+					candidates[s1|s2] = &joinCandidate{left: left, right: right, conds: conds, cost: cost}
+				}
+			}
+		}
+		for _, union := range unions {
+			c := candidates[union]
+			g, added := tree.addJoin(c.left, c.right, c.conds)
+			if added {
+				e.budget--
+			}
+			if err := e.deriveStats(g); err != nil {
+				return err
+			}
+			cost := c.cost + g.Prop.Stats.RowCount
+			if cost >= upperBound {
+				continue
+			}
+			costs[union] = cost
+			setsBySize[size] = append(setsBySize[size], union)
+		}
+	}
+	return nil
+}
+
+// originalCost returns the cost of the original join tree, which is the sum of the row counts of its joins.
+func (e *joinOrderExplorer) originalCost(tree *joinTree) (float64, error) {
+	var cost float64
+	for set, g := range tree.groups {
+		if err := e.deriveStats(g); err != nil {
+			return 0, err
+		}
+		if bits.OnesCount64(set) > 1 {
+			cost += g.Prop.Stats.RowCount
+		}
+	}
+	return cost, nil
+}
+
+// joinTree is a tree of the reorderable inner joins.
+type joinTree struct {
+	// template is the root join, which the new joins are copied from.
+	template *plannercore.LogicalJoin
+	inputs   []*memo.Group
+	conds    []expression.Expression
+	// condSets are the sets of the inputs referenced by the conditions.
+	condSets []uint64
+	// groups are the groups of the sets of the inputs.
+	groups map[uint64]*memo.Group
+	// invalid is set if the join tree can't be reordered.
+	invalid bool
+}
+
+// collect flattens the join tree of the group, and returns the set of the inputs below the group.
+func (t *joinTree) collect(g *memo.Group, visited map[*memo.Group]struct{}) uint64 {
+	var set uint64
+	if elem := g.GetFirstElem(memo.OperandJoin); elem != nil && canReorderJoinExpr(elem.Value.(*memo.GroupExpr)) {
+		visited[g] = struct{}{}
+		expr := elem.Value.(*memo.GroupExpr)
+		join := expr.ExprNode.(*plannercore.LogicalJoin)
+		if t.template == nil {
+			t.template = join
+		}
+		set = t.collect(expr.Children[0], visited) | t.collect(expr.Children[1], visited)
+		for _, cond := range join.EqualConditions {
+			t.conds = append(t.conds, cond)
+		}
+		t.conds = append(t.conds, join.OtherConditions...)
+	} else {
+		if len(t.inputs) == maxJoinReorderInputs {
+			t.invalid = true
+			return 0
+		}
+		set = 1 << len(t.inputs)
+		t.inputs = append(t.inputs, g)
+	}
+	t.groups[set] = g
+	return set
+}
+
+// valid checks whether the join tree can be reordered, and fills the sets referenced by the conditions.
+func (t *joinTree) valid() bool {
+	if t.invalid {
+		return false
+	}
+	// The output columns of the groups are kept in the order of the inputs.
+	for set, g := range t.groups {
+		cols := t.schema(set).Columns
+		if g.Prop.Schema.Len() != len(cols) {
+			return false
+		}
+		for i, col := range g.Prop.Schema.Columns {
+			if !col.Equal(nil, cols[i]) {
+				return false
+			}
+		}
+	}
+	t.condSets = make([]uint64, 0, len(t.conds))
+	for _, cond := range t.conds {
+		var set uint64
+		for _, col := range expression.ExtractColumns(cond) {
+			for i, input := range t.inputs {
+				if input.Prop.Schema.Contains(col) {
+					set |= 1 << i
+					break
+				}
+			}
+		}
+		// A condition which references less than 2 inputs can't be the join condition.
+		if bits.OnesCount64(set) < 2 {
+			return false
+		}
+		t.condSets = append(t.condSets, set)
+	}
+	return true
+}
+
+// schema returns the output columns of the set of the inputs, which are in the order of the inputs.
+func (t *joinTree) schema(set uint64) *expression.Schema {
+	var cols []*expression.Column
+	for i, input := range t.inputs {
+		if set&(1<<i) != 0 {
+			cols = append(cols, input.Prop.Schema.Columns...)
+		}
+	}
+	return expression.NewSchema(cols...)
+}
+
+// conditionsBetween returns the conditions which can be evaluated on the join of the two sets of the inputs.
+func (t *joinTree) conditionsBetween(left, right uint64) []expression.Expression {
+	var conds []expression.Expression
+	for i, set := range t.condSets {
+		if set&(left|right) == set && set&left != 0 && set&right != 0 {
+			conds = append(conds, t.conds[i])
+		}
+	}
+	return conds
+}
+
+// addJoin adds the join of the two sets of the inputs to the group of their union, and returns the group. It also
+// returns false if the join exists already.
+func (t *joinTree) addJoin(left, right uint64, conds []expression.Expression) (*memo.Group, bool) {
+	leftGroup, rightGroup := t.groups[left], t.groups[right]
+	g := t.groups[left|right]
+	if g != nil {
+		for elem := g.GetFirstElem(memo.OperandJoin); elem != nil; elem = elem.Next() {
+			expr := elem.Value.(*memo.GroupExpr)
+			if memo.GetOperand(expr.ExprNode) != memo.OperandJoin {
+				break
+			}
+			if expr.Children[0] == leftGroup && expr.Children[1] == rightGroup {
+				return g, false
+			}
+		}
+	}
+
+	join := t.template.Shallow()
+	join.EqualConditions, join.LeftConditions, join.RightConditions, join.OtherConditions = nil, nil, nil, nil
+	eq, leftConds, rightConds, other := join.ExtractOnCondition(conds, leftGroup.Prop.Schema, rightGroup.Prop.Schema, false, false)
+	join.AppendJoinConds(eq, leftConds, rightConds, other)
+	join.SetSchema(expression.MergeSchema(leftGroup.Prop.Schema, rightGroup.Prop.Schema))
+	// The stats are derived again from the new children.
+	join.SetStats(nil)
+	joinExpr := memo.NewGroupExpr(join)
+	joinExpr.SetChildren(leftGroup, rightGroup)
+
+	newExpr := joinExpr
+	schema := t.schema(left | right)
+	// The inputs of the right side precede some of the left side, so a Projection is needed to keep the order of
+	// the output columns.
+	if bits.Len64(left)-1 > bits.TrailingZeros64(right) {
+		proj := plannercore.LogicalProjection{Exprs: expression.Column2Exprs(schema.Columns)}.Init(join.SCtx(), join.SelectBlockOffset())
+		proj.SetSchema(schema)
+		newExpr = memo.NewGroupExpr(proj)
+		newExpr.SetChildren(memo.NewGroupWithSchema(joinExpr, join.Schema()))
+	}
+	if g == nil {
+		g = memo.NewGroupWithSchema(newExpr, schema)
+		t.groups[left|right] = g
+		return g, true
+	}
+	return g, g.Insert(newExpr)
+}
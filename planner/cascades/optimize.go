@@ -86,6 +86,17 @@ func (opt *Optimizer) GetImplementationRules(node plannercore.LogicalPlan) []Imp
 // graph, where nodes are expressions and directed edges are the transformation
 // rules.
 //
+// After the transformation rules are applied, the join orders of the inner join
+// trees are explored bottom-up. The cost of a join order is the sum of the row
+// counts of its joins, and a join order is pruned if it's no cheaper than the
+// original join tree or than another order of the same inputs, so only the
+// cheapest order of each set of inputs is added to the memo. The number of the
+// joins added to the memo is limited by tidb_cascades_join_reorder_limit, which
+// is checked before the pairs of each size and before each left side is paired.
+// The join orders are not explored by the transformation rules like the join
+// commutativity and associativity, because the memo doesn't merge the duplicated
+// Groups generated by them.
+//
 // ------------------------------------------------------------------------------
 // Phase 3: Implementation
 // ------------------------------------------------------------------------------
@@ -107,6 +118,10 @@ func (opt *Optimizer) FindBestPlan(sctx sessionctx.Context, logical plannercore.
 	if err != nil {
 		return nil, 0, err
 	}
+	err = opt.exploreJoinOrders(sctx, rootGroup)
+	if err != nil {
+		return nil, 0, err
+	}
 	p, cost, err = opt.onPhaseImplementation(sctx, rootGroup)
 	if err != nil {
 		return nil, 0, err
@@ -115,6 +130,21 @@ func (opt *Optimizer) FindBestPlan(sctx sessionctx.Context, logical plannercore.
 	return p, cost, err
 }
 
+// Supports checks whether all the operators of the logical plan can be optimized by the cascades planner. The
+// queries which can't be optimized should fall back to the default planner.
+func (opt *Optimizer) Supports(logical plannercore.LogicalPlan) bool {
+	// The DataSource is implemented after it's converted to the scans by EnumeratePaths.
+	if _, ok := logical.(*plannercore.DataSource); !ok && len(opt.implementationRuleMap[memo.GetOperand(logical)]) == 0 {
+		return false
+	}
+	for _, child := range logical.Children() {
+		if !opt.Supports(child) {
+			return false
+		}
+	}
+	return true
+}
+
 func (*Optimizer) onPhasePreprocessing(_ sessionctx.Context, plan plannercore.LogicalPlan) (plannercore.LogicalPlan, error) {
 	err := plan.PruneColumns(plan.Schema().Columns, nil)
 	if err != nil {
@@ -215,6 +245,16 @@ func (*Optimizer) findMoreEquiv(g *memo.Group, elem *list.Element, round int, ru
 	return eraseCur, nil
 }
 
+// exploreJoinOrders adds the different join orders of the inner join trees to the memo.
+func (opt *Optimizer) exploreJoinOrders(sctx sessionctx.Context, g *memo.Group) error {
+	explorer := &joinOrderExplorer{
+		budget:      sctx.GetSessionVars().CascadesJoinReorderLimit,
+		visited:     make(map[*memo.Group]struct{}),
+		deriveStats: opt.fillGroupStats,
+	}
+	return explorer.explore(g)
+}
+
 // fillGroupStats computes Stats property for each Group recursively.
 func (opt *Optimizer) fillGroupStats(g *memo.Group) (err error) {
 	if g.Prop.Stats != nil {
@@ -106,6 +106,68 @@ func TestFillGroupStats(t *testing.T) {
 	require.NotNil(t, rootGroup.Prop.Stats)
 }
 
+func TestExploreJoinOrders(t *testing.T) {
+	p := parser.New()
+	ctx := plannercore.MockContext()
+	defer func() {
+		domain.GetDomain(ctx).StatsHandle().Close()
+	}()
+	is := infoschema.MockInfoSchema([]*model.TableInfo{plannercore.MockSignedTable()})
+	domain.GetDomain(ctx).MockInfoCacheAndLoadInfoSchema(is)
+
+	stmt, err := p.ParseOneStmt("select * from t t1, t t2, t t3, t t4 where t1.a = t2.a and t2.b = t3.b and t3.c = t4.c and t1.d = t4.d", "", "")
+	require.NoError(t, err)
+	// countJoins returns the number of the joins in the groups of the join tree.
+	countJoins := func(g *memo.Group, visited map[*memo.Group]struct{}) (total, maxPerGroup int) {
+		var count func(g *memo.Group)
+		count = func(g *memo.Group) {
+			if _, ok := visited[g]; ok {
+				return
+			}
+			visited[g] = struct{}{}
+			joins := 0
+			for elem := g.Equivalents.Front(); elem != nil; elem = elem.Next() {
+				expr := elem.Value.(*memo.GroupExpr)
+				if memo.GetOperand(expr.ExprNode) == memo.OperandJoin {
+					joins++
+				}
+				for _, child := range expr.Children {
+					count(child)
+				}
+			}
+			total += joins
+			maxPerGroup = max(maxPerGroup, joins)
+		}
+		count(g)
+		return total, maxPerGroup
+	}
+	for _, limit := range []int{0, 1, 2, 4096} {
+		plan, _, err := plannercore.BuildLogicalPlanForTest(context.Background(), ctx, stmt, is)
+		require.NoError(t, err)
+		logic, err := NewOptimizer().onPhasePreprocessing(ctx, plan.(plannercore.LogicalPlan))
+		require.NoError(t, err)
+		opt := NewOptimizer()
+		rootGroup := memo.Convert2Group(logic)
+		require.NoError(t, opt.onPhaseExploration(ctx, rootGroup))
+		original, _ := countJoins(rootGroup, make(map[*memo.Group]struct{}))
+
+		explorer := &joinOrderExplorer{
+			budget:      limit,
+			visited:     make(map[*memo.Group]struct{}),
+			deriveStats: opt.fillGroupStats,
+		}
+		require.NoError(t, explorer.explore(rootGroup))
+		total, maxPerGroup := countJoins(rootGroup, make(map[*memo.Group]struct{}))
+		// No more joins than the limit are added.
+		require.LessOrEqual(t, total-original, limit)
+		// Only the cheapest join is added to the group of every set besides the original one.
+		require.LessOrEqual(t, maxPerGroup, 2)
+		if limit == 4096 {
+			require.Greater(t, total, original)
+		}
+	}
+}
+
 func TestPreparePossibleProperties(t *testing.T) {
 	p := parser.New()
 	ctx := plannercore.MockContext()
@@ -293,6 +293,46 @@ func (p *PhysicalTableScan) AccessObject() AccessObject {
 	return res
 }
 
+// AccessObject implements dataAccesser interface.
+func (p *PhysicalFullTextIndexReader) AccessObject() AccessObject {
+	res := &ScanAccessObject{
+		Database: p.TableReader.tablePlan.(*PhysicalTableScan).DBName.O,
+	}
+	tblName := p.Table.Name.O
+	if p.TableAsName != nil && p.TableAsName.O != "" {
+		tblName = p.TableAsName.O
+	}
+	res.Table = tblName
+	index := IndexAccess{
+		Name: p.Match.Index.Name.O,
+	}
+	for _, idxCol := range p.Match.Index.Columns {
+		index.Cols = append(index.Cols, idxCol.Name.O)
+	}
+	res.Indexes = []IndexAccess{index}
+	return res
+}
+
+// AccessObject implements dataAccesser interface.
+func (p *PhysicalVectorIndexReader) AccessObject() AccessObject {
+	res := &ScanAccessObject{
+		Database: p.TableReader.tablePlan.(*PhysicalTableScan).DBName.O,
+	}
+	tblName := p.Table.Name.O
+	if p.TableAsName != nil && p.TableAsName.O != "" {
+		tblName = p.TableAsName.O
+	}
+	res.Table = tblName
+	index := IndexAccess{
+		Name: p.Index.Name.O,
+	}
+	for _, idxCol := range p.Index.Columns {
+		index.Cols = append(index.Cols, idxCol.Name.O)
+	}
+	res.Indexes = []IndexAccess{index}
+	return res
+}
+
 // AccessObject implements dataAccesser interface.
 func (p *PhysicalMemTable) AccessObject() AccessObject {
 	return &ScanAccessObject{
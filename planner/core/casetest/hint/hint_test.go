@@ -647,3 +647,41 @@ func TestInvalidHint(t *testing.T) {
 		tk.MustQuery(tt).Check(testkit.Rows(output[i].Plan...))
 	}
 }
+
+func TestCardinalityHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2, t3")
+	tk.MustExec("create table t1(a int, b int, c int, key(a))")
+	tk.MustExec("create table t2(a int, b int, c int, key(a))")
+	tk.MustExec("create table t3(a int, b int, c int, key(a))")
+
+	var input []string
+	var output []struct {
+		SQL  string
+		Plan []string
+		Warn []string
+	}
+	integrationSuiteData := GetIntegrationSuiteData()
+	integrationSuiteData.LoadTestCases(t, &input, &output)
+	for i, tt := range input {
+		testdata.OnRecord(func() {
+			output[i].SQL = tt
+			output[i].Plan = testdata.ConvertRowsToStrings(tk.MustQuery("explain format = 'brief' " + tt).Rows())
+			output[i].Warn = testdata.ConvertRowsToStrings(tk.MustQuery("show warnings").Rows())
+		})
+		tk.MustQuery("explain format = 'brief' " + tt).Check(testkit.Rows(output[i].Plan...))
+		tk.MustQuery("show warnings").Check(testkit.Rows(output[i].Warn...))
+	}
+
+	// The hint works in the bindings.
+	tk.MustExec("create global binding for select * from t1 where b > 1 using select /*+ cardinality(t1, 100) */ * from t1 where b > 1")
+	rows := tk.MustQuery("show global bindings").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "SELECT /*+ cardinality(`t1`, 100)*/ * FROM `test`.`t1` WHERE `b` > 1", rows[0][1])
+	rows = tk.MustQuery("explain format = 'brief' select * from t1 where b > 1").Rows()
+	require.Equal(t, "100.00", rows[0][1])
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+}
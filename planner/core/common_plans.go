@@ -242,6 +242,18 @@ type SetConfig struct {
 	Value    expression.Expression
 }
 
+// Call represents a plan for the `CALL` statement.
+type Call struct {
+	baseSchemaProducer
+
+	DBName model.CIStr
+	Name   model.CIStr
+	Args   []expression.Expression
+	// OutVars are the names of the user variables passed as the arguments, which receive the values of the OUT and
+	// INOUT parameters. The name is empty if the argument isn't a user variable.
+	OutVars []string
+}
+
 // SQLBindOpType repreents the SQL bind type
 type SQLBindOpType int
 
@@ -533,6 +545,8 @@ type AnalyzeColumnsTask struct {
 	ColsInfo         []*model.ColumnInfo
 	TblInfo          *model.TableInfo
 	Indexes          []*model.IndexInfo
+	// JointStatsColIDs are the IDs of the columns whose joint statistics need to be collected.
+	JointStatsColIDs []int64
 	AnalyzeInfo
 }
 
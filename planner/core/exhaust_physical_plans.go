@@ -471,9 +471,53 @@ func (p *LogicalJoin) getHashJoin(prop *property.PhysicalProperty, innerIdx int,
 	}
 	hashJoin := NewPhysicalHashJoin(p, innerIdx, useOuterToBuild, p.StatsInfo().ScaleByExpectCnt(prop.ExpectedCnt), chReqProps...)
 	hashJoin.SetSchema(p.schema)
+	if !useOuterToBuild {
+		hashJoin.HotKeys = p.getBuildSideHotKeys4HashJoin(innerIdx)
+	}
 	return hashJoin
 }
 
+// getBuildSideHotKeys4HashJoin gets the heavy hitters of the join key of the build side. The build rows of the hot
+// keys are collected once after the hash table is built, so the probe rows with the hot keys needn't traverse and
+// compare all the build rows in the bucket one by one. The probe rows are dispatched to the probe workers by chunks
+// rather than by keys, so the hot keys of the probe side don't make any probe worker the bottleneck.
+//
+// NOTE: only a single join key of the types supported by cardinality.GetHeavyHitters is handled, composite keys are
+// joined without the hot keys.
+func (p *LogicalJoin) getBuildSideHotKeys4HashJoin(buildIdx int) []types.Datum {
+	threshold := p.SCtx().GetSessionVars().OptJoinSkewThreshold
+	if threshold <= 0 || len(p.EqualConditions) != 1 || p.isNAAJ() {
+		return nil
+	}
+	lkeys, rkeys, _, _ := p.GetJoinKeys()
+	if !isHotKeyComparable(lkeys[0], rkeys[0]) {
+		return nil
+	}
+	buildKey := lkeys[0]
+	if buildIdx == 1 {
+		buildKey = rkeys[0]
+	}
+	heavyHitters, err := cardinality.GetHeavyHitters(p.SCtx(), p.children[buildIdx].StatsInfo().HistColl, buildKey, threshold)
+	if err != nil || len(heavyHitters) == 0 {
+		return nil
+	}
+	hotKeys := make([]types.Datum, 0, len(heavyHitters))
+	for _, hh := range heavyHitters {
+		hotKeys = append(hotKeys, hh.Value)
+	}
+	return hotKeys
+}
+
+// isHotKeyComparable checks whether the hot keys of one join key can be matched with the other key, which requires the
+// keys are hashed in the same way.
+func isHotKeyComparable(lkey, rkey *expression.Column) bool {
+	ltp, rtp := lkey.GetType(), rkey.GetType()
+	if ltp.EvalType() != rtp.EvalType() {
+		return false
+	}
+	return ltp.EvalType() != types.ETString || ltp.GetCollate() == rtp.GetCollate()
+}
+
 // When inner plan is TableReader, the parameter `ranges` will be nil. Because pk only have one column. So all of its range
 // is generated during execution time.
 func (p *LogicalJoin) constructIndexJoin(
@@ -955,7 +999,9 @@ func (p *LogicalJoin) buildIndexJoinInner2IndexScan(
 	outerIdx int, avgInnerRowCnt float64) (joins []PhysicalPlan) {
 	ds := wrapper.ds
 	us := wrapper.us
-	helper, keyOff2IdxOff := p.getIndexJoinBuildHelper(ds, innerJoinKeys, func(path *util.AccessPath) bool { return !path.IsTablePath() }, outerJoinKeys)
+	helper, keyOff2IdxOff := p.getIndexJoinBuildHelper(ds, innerJoinKeys, func(path *util.AccessPath) bool {
+		return !path.IsTablePath() && path.FullTextCond == nil && path.VectorSearch == nil
+	}, outerJoinKeys)
 	if helper == nil {
 		return nil
 	}
@@ -2212,12 +2258,57 @@ func isJoinChildFitMPPBCJ(p *LogicalJoin, childIndexToBC int, mppStoreCnt int) b
 	return rowBC*float64(mppStoreCnt) <= rowHash
 }
 
+// isProbeSideSkewed4MppBCJ checks whether the join key of the probe side of a broadcast join has heavy hitters. In
+// a shuffle join, all the rows with the same key are sent to the same node, which becomes the bottleneck if some keys
+// dominate. The probe side isn't exchanged in a broadcast join, so it's preferred if the rows of the build side to
+// broadcast are no more than the rows with the hot keys, and the build side still fits the broadcast thresholds
+// tidb_broadcast_join_threshold_size and tidb_broadcast_join_threshold_count.
+//
+// NOTE: in MPP, the skew is only handled by choosing a broadcast join over a shuffle join. The hot keys aren't split
+// from the other keys, so a shuffle join whose build side is too large to broadcast is still skewed. Like the hash
+// join in TiDB, only a single join key of the types supported by cardinality.GetHeavyHitters is checked.
+func (p *LogicalJoin) isProbeSideSkewed4MppBCJ() bool {
+	threshold := p.SCtx().GetSessionVars().OptJoinSkewThreshold
+	if threshold <= 0 || len(p.EqualConditions) != 1 || p.isNAAJ() {
+		return false
+	}
+	// Keep the same build side as tryToGetMppHashJoin.
+	buildIdx := 1
+	switch p.JoinType {
+	case InnerJoin:
+		if p.children[0].StatsInfo().Count() <= p.children[1].StatsInfo().Count() {
+			buildIdx = 0
+		}
+	case RightOuterJoin:
+		buildIdx = 0
+	}
+	lkeys, rkeys, _, _ := p.GetJoinKeys()
+	probe, probeKey := p.children[0], lkeys[0]
+	if buildIdx == 0 {
+		probe, probeKey = p.children[1], rkeys[0]
+	}
+	heavyHitters, err := cardinality.GetHeavyHitters(p.SCtx(), probe.StatsInfo().HistColl, probeKey, threshold)
+	if err != nil || len(heavyHitters) == 0 {
+		return false
+	}
+	hotRatio := 0.0
+	for _, hh := range heavyHitters {
+		hotRatio += hh.Ratio
+	}
+	build := p.children[buildIdx]
+	return build.StatsInfo().RowCount <= hotRatio*probe.StatsInfo().RowCount && checkChildFitBC(build)
+}
+
 // If we can use mpp broadcast join, that's our first choice.
 func (p *LogicalJoin) preferMppBCJ() bool {
 	if len(p.EqualConditions) == 0 && p.SCtx().GetSessionVars().AllowCartesianBCJ == 2 {
 		return true
 	}
 
+	if p.isProbeSideSkewed4MppBCJ() {
+		return true
+	}
+
 	onlyCheckChild1 := p.JoinType == LeftOuterJoin || p.JoinType == SemiJoin || p.JoinType == AntiSemiJoin
 	onlyCheckChild0 := p.JoinType == RightOuterJoin
 
@@ -2660,6 +2751,10 @@ func pushLimitOrTopNForcibly(p LogicalPlan) bool {
 	var preferPushDown *bool
 	switch lp := p.(type) {
 	case *LogicalTopN:
+		// The nearest neighbor search by a vector index is a root task, so the TopN is kept in root.
+		if ds, ok := lp.children[0].(*DataSource); ok && ds.hasVectorPath() {
+			return false
+		}
 		preferPushDown = &lp.limitHints.preferLimitToCop
 		meetThreshold = lp.Count+lp.Offset <= uint64(lp.SCtx().GetSessionVars().LimitPushDownThreshold)
 	case *LogicalLimit:
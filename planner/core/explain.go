@@ -306,6 +306,58 @@ func (p *PhysicalTableReader) OperatorInfo(_ bool) string {
 	return "data:" + p.tablePlan.ExplainID().String()
 }
 
+// ExplainInfo implements Plan interface.
+func (p *PhysicalFullTextIndexReader) ExplainInfo() string {
+	return p.AccessObject().String() + ", " + p.OperatorInfo(false)
+}
+
+// ExplainNormalizedInfo implements Plan interface.
+func (p *PhysicalFullTextIndexReader) ExplainNormalizedInfo() string {
+	return p.AccessObject().NormalizedString() + ", " + p.OperatorInfo(true)
+}
+
+// OperatorInfo implements dataAccesser interface.
+func (p *PhysicalFullTextIndexReader) OperatorInfo(normalized bool) string {
+	var buffer strings.Builder
+	buffer.WriteString("against:")
+	if normalized {
+		buffer.WriteString("?")
+	} else {
+		buffer.WriteString(p.Match.Query.ExplainInfo())
+	}
+	if p.Match.BooleanMode {
+		buffer.WriteString(", mode:boolean")
+	} else {
+		buffer.WriteString(", mode:natural language")
+	}
+	return buffer.String()
+}
+
+// ExplainInfo implements Plan interface.
+func (p *PhysicalVectorIndexReader) ExplainInfo() string {
+	return p.AccessObject().String() + ", " + p.OperatorInfo(false)
+}
+
+// ExplainNormalizedInfo implements Plan interface.
+func (p *PhysicalVectorIndexReader) ExplainNormalizedInfo() string {
+	return p.AccessObject().NormalizedString() + ", " + p.OperatorInfo(true)
+}
+
+// OperatorInfo implements dataAccesser interface.
+func (p *PhysicalVectorIndexReader) OperatorInfo(normalized bool) string {
+	var buffer strings.Builder
+	buffer.WriteString("distance:")
+	buffer.WriteString(p.Distance.FuncName)
+	buffer.WriteString(", query:")
+	if normalized {
+		buffer.WriteString("?")
+	} else {
+		buffer.WriteString(p.Distance.Query.String())
+	}
+	fmt.Fprintf(&buffer, ", top k:%d", p.TopK)
+	return buffer.String()
+}
+
 // ExplainInfo implements Plan interface.
 func (p *PhysicalIndexReader) ExplainInfo() string {
 	return "index:" + p.indexPlan.ExplainID().String()
@@ -413,6 +465,11 @@ func (p *PhysicalTableDual) ExplainInfo() string {
 	return str.String()
 }
 
+// ExplainInfo implements Plan interface.
+func (p *PhysicalJSONTable) ExplainInfo() string {
+	return explainJSONTable(p.Expr, p.Path)
+}
+
 // ExplainInfo implements Plan interface.
 func (p *PhysicalSort) ExplainInfo() string {
 	buffer := bytes.NewBufferString("")
@@ -646,6 +703,9 @@ func (p *PhysicalHashJoin) explainInfo(normalized bool) string {
 	if p.TiFlashFineGrainedShuffleStreamCount > 0 {
 		fmt.Fprintf(buffer, ", stream_count: %d", p.TiFlashFineGrainedShuffleStreamCount)
 	}
+	if len(p.HotKeys) > 0 {
+		fmt.Fprintf(buffer, ", hot keys:%d", len(p.HotKeys))
+	}
 
 	// for runtime filter
 	if len(p.runtimeFilterList) > 0 {
@@ -843,6 +903,9 @@ func (p *PhysicalShuffle) ExplainInfo() string {
 
 	buffer := bytes.NewBufferString("")
 	fmt.Fprintf(buffer, "execution info: concurrency:%v, data sources:%v", p.Concurrency, explainIds)
+	if len(p.HotKeys) > 0 {
+		fmt.Fprintf(buffer, ", hot keys:%v, spread data source:%v", len(p.HotKeys), explainIds[p.HotKeySpreadIdx])
+	}
 	return buffer.String()
 }
 
@@ -920,6 +983,15 @@ func (p *LogicalTableDual) ExplainInfo() string {
 	return str.String()
 }
 
+// ExplainInfo implements Plan interface.
+func (p *LogicalJSONTable) ExplainInfo() string {
+	return explainJSONTable(p.Expr, p.Path)
+}
+
+func explainJSONTable(expr expression.Expression, path string) string {
+	return fmt.Sprintf("expr:%s, path:%s", expr.ExplainInfo(), path)
+}
+
 // ExplainInfo implements Plan interface.
 func (ds *DataSource) ExplainInfo() string {
 	buffer := bytes.NewBufferString("")
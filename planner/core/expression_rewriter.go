@@ -1335,6 +1335,8 @@ func (er *expressionRewriter) Leave(originInNode ast.Node) (retNode ast.Node, ok
 		er.isTrueToScalarFunc(v)
 	case *ast.DefaultExpr:
 		er.evalDefaultExpr(v)
+	case *ast.MatchAgainst:
+		er.matchAgainstToScalarFunc(v)
 	// TODO: Perhaps we don't need to transcode these back to generic integers/strings
 	case *ast.TrimDirectionExpr:
 		er.ctxStackAppend(&expression.Constant{
@@ -1633,6 +1635,94 @@ func (er *expressionRewriter) positionToScalarFunc(v *ast.PositionExpr) {
 	}
 }
 
+// matchAgainstToScalarFunc rewrites `MATCH(cols) AGAINST(query)` to the match function. The columns must
+// be exactly the columns of a full-text index.
+func (er *expressionRewriter) matchAgainstToScalarFunc(v *ast.MatchAgainst) {
+	if v.Modifier.WithQueryExpansion() {
+		er.err = ErrNotSupportedYet.GenWithStackByArgs("WITH QUERY EXPANSION")
+		return
+	}
+	stkLen := len(er.ctxStack)
+	colLen := len(v.ColumnNames)
+	query := er.ctxStack[stkLen-1]
+	if _, ok := query.(*expression.Constant); !ok {
+		er.err = ErrWrongArguments.GenWithStackByArgs("AGAINST")
+		return
+	}
+	tblInfo, idxInfo := er.findFullTextIndex(er.ctxStack[stkLen-colLen-1:stkLen-1], er.ctxNameStk[stkLen-colLen-1:stkLen-1])
+	if idxInfo == nil {
+		if er.err == nil {
+			er.err = expression.ErrFtMatchingKeyNotFound
+		}
+		return
+	}
+	args := make([]expression.Expression, 0, colLen+2)
+	args = append(args, query, &expression.Constant{
+		Value:   types.NewIntDatum(int64(v.Modifier)),
+		RetType: types.NewFieldType(mysql.TypeLonglong),
+	})
+	args = append(args, er.ctxStack[stkLen-colLen-1:stkLen-1]...)
+	init := func(match *expression.ScalarFunction) (expression.Expression, error) {
+		expression.SetFullTextIndex(match, tblInfo.ID, idxInfo)
+		return match, nil
+	}
+	function, err := er.newFunctionWithInit(ast.Match, types.NewFieldType(mysql.TypeDouble), init, args...)
+	if err != nil {
+		er.err = err
+		return
+	}
+	er.ctxStackPop(colLen + 1)
+	er.ctxStackAppend(function, types.EmptyName)
+}
+
+// findFullTextIndex finds the public full-text index whose columns are exactly the given columns.
+func (er *expressionRewriter) findFullTextIndex(cols []expression.Expression, names []*types.FieldName) (*model.TableInfo, *model.IndexInfo) {
+	var dbName, tblName model.CIStr
+	colNames := make(map[string]struct{}, len(cols))
+	for i, col := range cols {
+		if _, ok := col.(*expression.Column); !ok {
+			return nil, nil
+		}
+		name := names[i]
+		if name.OrigTblName.L == "" || (tblName.L != "" && (name.DBName.L != dbName.L || name.OrigTblName.L != tblName.L)) {
+			return nil, nil
+		}
+		dbName, tblName = name.DBName, name.OrigTblName
+		if name.OrigColName.L != "" {
+			colNames[name.OrigColName.L] = struct{}{}
+		} else {
+			colNames[name.ColName.L] = struct{}{}
+		}
+	}
+	if dbName.L == "" {
+		dbName = model.NewCIStr(er.sctx.GetSessionVars().CurrentDB)
+	}
+	if er.b.is == nil {
+		return nil, nil
+	}
+	tbl, err := er.b.is.TableByName(dbName, tblName)
+	if err != nil {
+		return nil, nil
+	}
+	tblInfo := tbl.Meta()
+	for _, idx := range tblInfo.Indices {
+		if idx.Tp != model.IndexTypeFullText || idx.State != model.StatePublic || len(idx.Columns) != len(colNames) {
+			continue
+		}
+		covered := true
+		for _, idxCol := range idx.Columns {
+			if _, ok := colNames[idxCol.Name.L]; !ok {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return tblInfo, idx
+		}
+	}
+	return nil, nil
+}
+
 func (er *expressionRewriter) isTrueToScalarFunc(v *ast.IsTruthExpr) {
 	stkLen := len(er.ctxStack)
 	op := ast.IsTruthWithoutNull
@@ -1995,6 +2085,35 @@ func (er *expressionRewriter) betweenToExpression(v *ast.BetweenExpr) {
 	er.ctxStackAppend(function, types.EmptyName)
 }
 
+// rewriteStoredFuncCall rewrites a FuncCallExpr calling a stored function, it returns false if the function isn't a
+// stored function. The function is looked up in the current database if the database isn't specified, and the
+// builtin functions take precedence over the stored functions in that case. If the database is specified, the
+// function must be a stored function.
+func (er *expressionRewriter) rewriteStoredFuncCall(v *ast.FuncCallExpr, args []expression.Expression) bool {
+	schema := v.Schema
+	if schema.L == "" {
+		currentDB := er.sctx.GetSessionVars().CurrentDB
+		if currentDB == "" || expression.IsFunctionSupported(v.FnName.L) {
+			return false
+		}
+		schema = model.NewCIStr(currentDB)
+	}
+	function, err := expression.NewStoredFunctionCall(er.sctx, schema, v.FnName, args...)
+	if err == nil && function == nil && v.Schema.L != "" {
+		err = expression.ErrFunctionNotExists.GenWithStackByArgs("FUNCTION", v.Schema.L+"."+v.FnName.L)
+	}
+	if err != nil {
+		er.err = err
+		return true
+	}
+	if function == nil {
+		return false
+	}
+	er.ctxStackPop(len(v.Args))
+	er.ctxStackAppend(function, types.EmptyName)
+	return true
+}
+
 // rewriteFuncCall handles a FuncCallExpr and generates a customized function.
 // It should return true if for the given FuncCallExpr a rewrite is performed so that original behavior is skipped.
 // Otherwise it should return false to indicate (the caller) that original behavior needs to be performed.
@@ -2080,7 +2199,7 @@ func (er *expressionRewriter) funcCallToExpression(v *ast.FuncCallExpr) {
 		return
 	}
 
-	if er.rewriteFuncCall(v) {
+	if er.rewriteStoredFuncCall(v, args) || er.rewriteFuncCall(v) {
 		return
 	}
 
@@ -55,6 +55,9 @@ const (
 	// If the actual row count is much more than the limit count, the unordered scan may cost much more than keep order.
 	// So when a limit exists, we don't apply the DescScanFactor.
 	smallScanThreshold = 10000
+
+	// jsonTableRowCount is the estimated row count of JSON_TABLE.
+	jsonTableRowCount = 10
 )
 
 var aggFuncFactor = map[string]float64{
@@ -157,6 +160,21 @@ func (p *LogicalTableDual) findBestTask(prop *property.PhysicalProperty, planCou
 	return &rootTask{p: dual, isEmpty: p.RowCount == 0}, 1, nil
 }
 
+func (p *LogicalJSONTable) findBestTask(prop *property.PhysicalProperty, planCounter *PlanCounterTp, opt *physicalOptimizeOp) (task, int64, error) {
+	if !prop.IsSortItemEmpty() || planCounter.Empty() {
+		return invalidTask, 0, nil
+	}
+	jt := PhysicalJSONTable{
+		Expr:    p.Expr,
+		Path:    p.Path,
+		Columns: p.Columns,
+	}.Init(p.SCtx(), p.StatsInfo(), p.SelectBlockOffset())
+	jt.SetSchema(p.schema)
+	planCounter.Dec(1)
+	opt.appendCandidate(p, jt, prop)
+	return &rootTask{p: jt}, 1, nil
+}
+
 func (p *LogicalShow) findBestTask(prop *property.PhysicalProperty, planCounter *PlanCounterTp, _ *physicalOptimizeOp) (task, int64, error) {
 	if !prop.IsSortItemEmpty() || planCounter.Empty() {
 		return invalidTask, 0, nil
@@ -868,6 +886,10 @@ func (ds *DataSource) skylinePruning(prop *property.PhysicalProperty) []*candida
 			candidates = append(candidates, ds.getIndexMergeCandidate(path, prop))
 			continue
 		}
+		if path.FullTextCond != nil || path.VectorSearch != nil {
+			candidates = append(candidates, &candidatePath{path: path})
+			continue
+		}
 		// if we already know the range of the scan is empty, just return a TableDual
 		if len(path.Ranges) == 0 {
 			return []*candidatePath{{path: path}}
@@ -1120,6 +1142,46 @@ func (ds *DataSource) findBestTask(prop *property.PhysicalProperty, planCounter
 			}
 			continue
 		}
+		if path.FullTextCond != nil {
+			fullTextTask := ds.convertToFullTextIndexReader(prop, candidate)
+			if !fullTextTask.invalid() {
+				cntPlan++
+				planCounter.Dec(1)
+			}
+			appendCandidate(ds, fullTextTask, prop, opt)
+
+			curIsBetter, err := compareTaskCost(ds.SCtx(), fullTextTask, t, opt)
+			if err != nil {
+				return nil, 0, err
+			}
+			if curIsBetter || planCounter.Empty() {
+				t = fullTextTask
+			}
+			if planCounter.Empty() {
+				return t, cntPlan, nil
+			}
+			continue
+		}
+		if path.VectorSearch != nil {
+			vectorTask := ds.convertToVectorIndexReader(prop, candidate)
+			if !vectorTask.invalid() {
+				cntPlan++
+				planCounter.Dec(1)
+			}
+			appendCandidate(ds, vectorTask, prop, opt)
+
+			curIsBetter, err := compareTaskCost(ds.SCtx(), vectorTask, t, opt)
+			if err != nil {
+				return nil, 0, err
+			}
+			if curIsBetter || planCounter.Empty() {
+				t = vectorTask
+			}
+			if planCounter.Empty() {
+				return t, cntPlan, nil
+			}
+			continue
+		}
 		// if we already know the range of the scan is empty, just return a TableDual
 		if len(path.Ranges) == 0 {
 			// We should uncache the tableDual plan.
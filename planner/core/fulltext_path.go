@@ -0,0 +1,107 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/planner/property"
+	"github.com/pingcap/tidb/planner/util"
+	"github.com/pingcap/tidb/util/ranger"
+	"golang.org/x/exp/slices"
+)
+
+// generateFullTextPath generates a full-text index AccessPath on this DataSource for the first
+// MATCH ... AGAINST condition. The condition cannot be pushed down to the storage, so it is found
+// in allConds and still evaluated by the Selection above the DataSource.
+func (ds *DataSource) generateFullTextPath() {
+	if ds.isPartition || ds.tableInfo.GetPartitionInfo() != nil || ds.SampleInfo != nil ||
+		ds.tableInfo.TempTableType != model.TempTableNone ||
+		ds.tableInfo.TableCacheStatusType != model.TableCacheStatusDisable {
+		return
+	}
+	for _, cond := range ds.allConds {
+		match, ok := expression.ExtractFullTextMatch(cond)
+		if !ok {
+			continue
+		}
+		idx := ds.tableInfo.FindIndexByName(match.Index.Name.L)
+		if idx == nil || idx.ID != match.Index.ID || idx.State != model.StatePublic {
+			continue
+		}
+		// Only the rows containing the tokens of the query are read, estimate them like the MATCH condition.
+		rowCount := ds.StatsInfo().RowCount * SelectionFactor
+		ds.possibleAccessPaths = append(ds.possibleAccessPaths, &util.AccessPath{
+			Index:            idx,
+			FullTextCond:     cond,
+			StoreType:        kv.TiKV,
+			CountAfterAccess: rowCount,
+			CountAfterIndex:  rowCount,
+		})
+		return
+	}
+}
+
+// convertToFullTextIndexReader converts the full-text index path to a PhysicalFullTextIndexReader,
+// the pushed down conditions are evaluated by a Selection on the rows read by the index.
+func (ds *DataSource) convertToFullTextIndexReader(prop *property.PhysicalProperty, candidate *candidatePath) task {
+	if prop.TaskTp != property.RootTaskType || !prop.IsSortItemEmpty() {
+		return invalidTask
+	}
+	path := candidate.path
+	match, ok := expression.ExtractFullTextMatch(path.FullTextCond)
+	if !ok {
+		return invalidTask
+	}
+	ts := PhysicalTableScan{
+		Table:           ds.tableInfo,
+		Columns:         slices.Clone(ds.Columns),
+		TableAsName:     ds.TableAsName,
+		DBName:          ds.DBName,
+		physicalTableID: ds.physicalTableID,
+		Ranges:          ranger.FullRange(),
+		StoreType:       kv.TiKV,
+		HandleCols:      ds.handleCols,
+		tblCols:         ds.TblCols,
+		tblColHists:     ds.TblColHists,
+		prop:            prop,
+	}.Init(ds.SCtx(), ds.SelectBlockOffset())
+	ts.SetSchema(ds.schema.Clone())
+	stats := ds.tableStats.ScaleByExpectCnt(path.CountAfterAccess)
+	ts.SetStats(stats)
+	tableReader := PhysicalTableReader{
+		tablePlan:      ts,
+		StoreType:      kv.TiKV,
+		IsCommonHandle: ds.tableInfo.IsCommonHandle,
+	}.Init(ds.SCtx(), ds.SelectBlockOffset())
+	tableReader.SetStats(stats)
+
+	reader := PhysicalFullTextIndexReader{
+		Table:       ds.tableInfo,
+		TableAsName: ds.TableAsName,
+		Match:       match,
+		TableReader: tableReader,
+	}.Init(ds.SCtx(), stats, ds.SelectBlockOffset())
+	reader.SetSchema(ds.schema)
+	if len(ds.pushedDownConds) == 0 {
+		return &rootTask{p: reader}
+	}
+	sel := PhysicalSelection{
+		Conditions: ds.pushedDownConds,
+	}.Init(ds.SCtx(), ds.StatsInfo().ScaleByExpectCnt(prop.ExpectedCnt), ds.SelectBlockOffset())
+	sel.SetChildren(reader)
+	return &rootTask{p: sel}
+}
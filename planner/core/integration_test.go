@@ -35,6 +35,7 @@ import (
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/planner/core/internal"
 	"github.com/pingcap/tidb/session"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/table"
@@ -5293,3 +5294,75 @@ func TestIssue46298(t *testing.T) {
 	tk.MustExec(`set @@tidb_enable_pipelined_window_function=0`)
 	tk.MustQuery("select *, first_value(v) over (partition by p order by o range between 3.1 preceding and 2.9 following) as a from test.first_range;")
 }
+
+func TestMPPJoinWithHotKeys(t *testing.T) {
+	store := testkit.CreateMockStore(t, internal.WithMockTiFlash(2))
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int)")
+	var buf bytes.Buffer
+	buf.WriteString("insert into t1 values (0, 0)")
+	for i := 1; i < 1000; i++ {
+		a := i
+		if i < 850 {
+			a = 1
+		}
+		buf.WriteString(fmt.Sprintf(", (%d, %d)", a, i))
+	}
+	tk.MustExec(buf.String())
+	buf.Reset()
+	buf.WriteString("insert into t2 values (0, 0)")
+	for i := 1; i < 800; i++ {
+		buf.WriteString(fmt.Sprintf(", (%d, %d)", i, i))
+	}
+	tk.MustExec(buf.String())
+	tk.MustExec("analyze table t1, t2")
+
+	// Create virtual tiflash replica info.
+	dom := domain.GetDomain(tk.Session())
+	is := dom.InfoSchema()
+	db, exists := is.SchemaByName(model.NewCIStr("test"))
+	require.True(t, exists)
+	for _, tblInfo := range db.Tables {
+		tblInfo.TiFlashReplica = &model.TiFlashReplicaInfo{
+			Count:     1,
+			Available: true,
+		}
+	}
+	tk.MustExec("set @@tidb_allow_mpp=1; set @@tidb_enforce_mpp=1")
+	tk.MustExec("set @@tidb_isolation_read_engines = 'tiflash'")
+	// Broadcasting t2 exchanges more data than shuffling both sides.
+	tk.MustExec("set @@tidb_prefer_broadcast_join_by_exchange_data_size = 1")
+
+	checkExchangeType := func(query, exchangeType string) {
+		explain := fmt.Sprintf("%v", tk.MustQuery("explain format = 'brief' "+query).Rows())
+		require.Contains(t, explain, "ExchangeType: "+exchangeType, query)
+	}
+	queries := []string{
+		"select * from t1 join t2 on t1.a = t2.a",
+		"select * from t1 left join t2 on t1.a = t2.a",
+		"select * from t1 where exists (select 1 from t2 where t1.a = t2.a)",
+	}
+	for _, query := range queries {
+		checkExchangeType(query, "HashPartition")
+	}
+	// The probe side t1 is skewed, broadcasting t2 avoids sending all the rows of t1.a = 1 to one node.
+	tk.MustExec("set @@tidb_opt_join_skew_threshold = 0.3")
+	for _, query := range queries {
+		checkExchangeType(query, "Broadcast")
+	}
+	// The build side of a right join is t1, it isn't broadcast since t2 isn't skewed.
+	checkExchangeType("select * from t1 right join t2 on t1.a = t2.a", "HashPartition")
+	// t2 doesn't fit the broadcast thresholds.
+	tk.MustExec("set @@tidb_broadcast_join_threshold_count = 0; set @@tidb_broadcast_join_threshold_size = 0")
+	for _, query := range queries {
+		checkExchangeType(query, "HashPartition")
+	}
+	tk.MustExec("set @@tidb_broadcast_join_threshold_count = default; set @@tidb_broadcast_join_threshold_size = default")
+	// No value of t1.a exceeds the threshold.
+	tk.MustExec("set @@tidb_opt_join_skew_threshold = 0.9")
+	for _, query := range queries {
+		checkExchangeType(query, "HashPartition")
+	}
+}
@@ -153,6 +153,8 @@ const (
 	HintSemiJoinRewrite = "semi_join_rewrite"
 	// HintNoDecorrelate indicates a LogicalApply not to be decorrelated.
 	HintNoDecorrelate = "no_decorrelate"
+	// HintCardinality overrides the estimated row count of a table or the join of some tables.
+	HintCardinality = "cardinality"
 
 	// HintMemoryQuota sets the memory limit for a query
 	HintMemoryQuota = "memory_quota"
@@ -529,6 +531,8 @@ func (b *PlanBuilder) buildResultSetNode(ctx context.Context, node ast.ResultSet
 		case *ast.TableName:
 			p, err = b.buildDataSource(ctx, v, &x.AsName)
 			isTableName = true
+		case *ast.JSONTable:
+			p, err = b.buildJSONTable(ctx, v, x.AsName)
 		default:
 			err = ErrUnsupportedType.GenWithStackByArgs(v)
 		}
@@ -938,6 +942,22 @@ func (p *LogicalJoin) setPreferredJoinType() {
 	}
 }
 
+func (ds *DataSource) setCardinalityHints(hintInfo *tableHintInfo) {
+	if hintInfo == nil {
+		return
+	}
+
+	alias := &hintTableInfo{dbName: ds.DBName, tblName: ds.tableInfo.Name, selectOffset: ds.SelectBlockOffset()}
+	if len(ds.TableAsName.L) != 0 {
+		alias.tblName = *ds.TableAsName
+	}
+	for _, hint := range hintInfo.cardinalityHints {
+		if hintInfo.matchTableName([]*hintTableInfo{alias}, hint.tables) {
+			ds.cardinalityHints = append(ds.cardinalityHints, hint)
+		}
+	}
+}
+
 func (ds *DataSource) setPreferredStoreType(hintInfo *tableHintInfo) {
 	if hintInfo == nil {
 		return
@@ -1023,7 +1043,17 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 		return nil, err
 	}
 
+	// The lateral table sources in the right side can refer to the columns of the left side.
+	isLateral := isLateralResultSetNode(joinNode.Right)
+	if isLateral {
+		b.outerSchemas = append(b.outerSchemas, leftPlan.Schema())
+		b.outerNames = append(b.outerNames, leftPlan.OutputNames())
+	}
 	rightPlan, err := b.buildResultSetNode(ctx, joinNode.Right, false)
+	if isLateral {
+		b.outerSchemas = b.outerSchemas[0 : len(b.outerSchemas)-1]
+		b.outerNames = b.outerNames[0 : len(b.outerNames)-1]
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1037,7 +1067,26 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 	handleMap2 := b.handleHelper.popMap()
 	b.handleHelper.mergeAndPush(handleMap1, handleMap2)
 
-	joinPlan := LogicalJoin{StraightJoin: joinNode.StraightJoin || b.inStraightJoin}.Init(b.ctx, b.getSelectOffset())
+	// If the right side refers to the columns of the left side, an apply is built and the right side is
+	// evaluated for each row of the left side.
+	var (
+		joinPlan *LogicalJoin
+		ap       *LogicalApply
+	)
+	if isLateral && len(extractCorColumnsBySchema4LogicalPlan(rightPlan, leftPlan.Schema())) > 0 {
+		if joinNode.Tp == ast.RightJoin {
+			return nil, ErrTableFunctionForbiddenJoinType.GenWithStackByArgs(joinNode.Right.(*ast.TableSource).AsName.O)
+		}
+		b.optFlag = b.optFlag | flagBuildKeyInfo | flagDecorrelate
+		ap = LogicalApply{}.Init(b.ctx, b.getSelectOffset())
+		joinPlan = &ap.LogicalJoin
+	} else {
+		joinPlan = LogicalJoin{StraightJoin: joinNode.StraightJoin || b.inStraightJoin}.Init(b.ctx, b.getSelectOffset())
+	}
+	var resultPlan LogicalPlan = joinPlan
+	if ap != nil {
+		resultPlan = ap
+	}
 	joinPlan.SetChildren(leftPlan, rightPlan)
 	joinPlan.SetSchema(expression.MergeSchema(leftPlan.Schema(), rightPlan.Schema()))
 	joinPlan.names = make([]*types.FieldName, leftPlan.Schema().Len()+rightPlan.Schema().Len())
@@ -1137,7 +1186,7 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 		// possible decorrelate optimizations. The ON clause is actually treated as a WHERE clause now.
 		if joinPlan.JoinType == InnerJoin {
 			sel := LogicalSelection{Conditions: onCondition}.Init(b.ctx, b.getSelectOffset())
-			sel.SetChildren(joinPlan)
+			sel.SetChildren(resultPlan)
 			return sel, nil
 		}
 		joinPlan.AttachOnConds(onCondition)
@@ -1147,7 +1196,131 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 		joinPlan.cartesianJoin = true
 	}
 
-	return joinPlan, nil
+	return resultPlan, nil
+}
+
+// isLateralResultSetNode checks whether the node can refer to the columns of the preceding tables in the FROM clause.
+func isLateralResultSetNode(node ast.ResultSetNode) bool {
+	ts, ok := node.(*ast.TableSource)
+	if !ok {
+		return false
+	}
+	if ts.Lateral {
+		return true
+	}
+	_, ok = ts.Source.(*ast.JSONTable)
+	return ok
+}
+
+// buildJSONTable builds the plan of JSON_TABLE. The expression of JSON_TABLE can only refer to the columns of
+// the outer plans, which are converted to correlated columns.
+func (b *PlanBuilder) buildJSONTable(ctx context.Context, jt *ast.JSONTable, asName model.CIStr) (LogicalPlan, error) {
+	mockTablePlan := LogicalTableDual{}.Init(b.ctx, b.getSelectOffset())
+	expr, np, err := b.rewrite(ctx, jt.Expr, mockTablePlan, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	if np != mockTablePlan {
+		return nil, ErrNotSupportedYet.GenWithStackByArgs("subquery in the expression of JSON_TABLE")
+	}
+	if tp := expr.GetType(); tp.GetType() != mysql.TypeJSON && tp.GetType() != mysql.TypeNull && !types.IsString(tp.GetType()) {
+		return nil, ErrWrongArguments.GenWithStackByArgs("JSON_TABLE")
+	}
+	if _, err = types.ParseJSONPathExpr(jt.Path); err != nil {
+		return nil, err
+	}
+
+	p := LogicalJSONTable{Expr: expr, Path: jt.Path}.Init(b.ctx, b.getSelectOffset())
+	schema := expression.NewSchema()
+	names := make(types.NameSlice, 0, len(jt.Columns))
+	p.Columns, err = b.buildJSONTableColumns(jt.Columns, asName, schema, &names)
+	if err != nil {
+		return nil, err
+	}
+	p.SetSchema(schema)
+	p.names = names
+	b.handleHelper.pushMap(nil)
+	return p, nil
+}
+
+func (b *PlanBuilder) buildJSONTableColumns(cols []*ast.JSONTableColumn, asName model.CIStr, schema *expression.Schema, names *types.NameSlice) ([]*JSONTableColumn, error) {
+	result := make([]*JSONTableColumn, 0, len(cols))
+	for _, col := range cols {
+		c := &JSONTableColumn{Tp: col.Tp, Name: col.Name, Path: col.Path, OnEmpty: col.OnEmpty, OnError: col.OnError}
+		if col.Tp != ast.JSONTableColumnOrdinality {
+			if _, err := types.ParseJSONPathExpr(col.Path); err != nil {
+				return nil, err
+			}
+		}
+		if col.Tp == ast.JSONTableColumnNested {
+			nested, err := b.buildJSONTableColumns(col.NestedColumns, asName, schema, names)
+			if err != nil {
+				return nil, err
+			}
+			c.NestedColumns = nested
+			result = append(result, c)
+			continue
+		}
+
+		for _, resp := range []*ast.JSONTableOnResponse{col.OnEmpty, col.OnError} {
+			if resp != nil && resp.Tp == ast.JSONTableOnResponseDefault {
+				if _, err := types.ParseBinaryJSONFromString(resp.Default); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		var tp *types.FieldType
+		if col.Tp == ast.JSONTableColumnOrdinality {
+			tp = types.NewFieldType(mysql.TypeLonglong)
+			tp.AddFlag(mysql.UnsignedFlag | mysql.NotNullFlag)
+		} else {
+			tp = col.Type.Clone()
+		}
+		b.setJSONTableColumnType(tp)
+
+		c.Offset = schema.Len()
+		schema.Append(&expression.Column{
+			UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
+			RetType:  tp,
+		})
+		*names = append(*names, &types.FieldName{
+			TblName:     asName,
+			OrigTblName: asName,
+			ColName:     col.Name,
+			OrigColName: col.Name,
+		})
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+// setJSONTableColumnType fills the unspecified length, decimal, charset and collation of the column type.
+func (b *PlanBuilder) setJSONTableColumnType(tp *types.FieldType) {
+	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(tp.GetType())
+	if tp.GetFlen() == types.UnspecifiedLength {
+		tp.SetFlen(defaultFlen)
+	}
+	if tp.GetDecimal() == types.UnspecifiedLength {
+		tp.SetDecimal(defaultDecimal)
+	}
+	if tp.GetCharset() != "" {
+		if tp.GetCollate() == "" {
+			if coll, err := charset.GetDefaultCollation(tp.GetCharset()); err == nil {
+				tp.SetCollate(coll)
+			}
+		}
+		return
+	}
+	if tp.EvalType() == types.ETString && !mysql.HasBinaryFlag(tp.GetFlag()) {
+		chs, coll := b.ctx.GetSessionVars().GetCharsetInfo()
+		tp.SetCharset(chs)
+		tp.SetCollate(coll)
+		return
+	}
+	chs, coll := types.DefaultCharsetForType(tp.GetType())
+	tp.SetCharset(chs)
+	tp.SetCollate(coll)
 }
 
 // buildUsingClause eliminate the redundant columns and ordering columns based
@@ -1956,6 +2129,10 @@ func unionJoinFieldType(a, b *types.FieldType) *types.FieldType {
 		resultTp.SetFlenUnderLimit(mathutil.Max(a.GetFlen()-a.GetDecimal(), b.GetFlen()-b.GetDecimal()) + resultTp.GetDecimal())
 	}
 	types.TryToFixFlenOfDatetime(resultTp)
+	if resultTp.GetType() == mysql.TypeVector && a.GetFlen() != b.GetFlen() {
+		// The vectors of different dimensions are united into a vector type without dimension.
+		resultTp.SetFlen(types.UnspecifiedLength)
+	}
 	if resultTp.EvalType() != types.ETInt && (a.EvalType() == types.ETInt || b.EvalType() == types.ETInt) && resultTp.GetFlen() < mysql.MaxIntWidth {
 		resultTp.SetFlen(mysql.MaxIntWidth)
 	}
@@ -4047,12 +4224,14 @@ func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLev
 		leadingJoinOrder                                                                []hintTableInfo
 		hjBuildTables, hjProbeTables                                                    []hintTableInfo
 		leadingHintCnt                                                                  int
+		cardinalityHints                                                                []*cardinalityHintInfo
 	)
 	for _, hint := range hints {
 		// Set warning for the hint that requires the table name.
 		switch hint.HintName.L {
 		case TiDBMergeJoin, HintSMJ, TiDBIndexNestedLoopJoin, HintINLJ, HintINLHJ, HintINLMJ, HintNoHashJoin, HintNoMergeJoin,
-			TiDBHashJoin, HintHJ, HintUseIndex, HintIgnoreIndex, HintForceIndex, HintOrderIndex, HintNoOrderIndex, HintIndexMerge, HintLeading:
+			TiDBHashJoin, HintHJ, HintUseIndex, HintIgnoreIndex, HintForceIndex, HintOrderIndex, HintNoOrderIndex, HintIndexMerge, HintLeading,
+			HintCardinality:
 			if len(hint.Tables) == 0 {
 				b.pushHintWithoutTableWarning(hint)
 				continue
@@ -4175,6 +4354,16 @@ func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLev
 				continue
 			}
 			b.subQueryHintFlags |= HintFlagNoDecorrelate
+		case HintCardinality:
+			hintData := hint.HintData.(ast.HintCardinality)
+			if hintData.Operator == ast.CardinalityDivide && hintData.Value == 0 {
+				b.ctx.GetSessionVars().StmtCtx.AppendWarning(ErrInternal.GenWithStack("The CARDINALITY hint is inapplicable, the estimated row count can't be divided by 0."))
+				continue
+			}
+			cardinalityHints = append(cardinalityHints, &cardinalityHintInfo{
+				tables: tableNames2HintTableInfo(b.ctx, hint.HintName.L, hint.Tables, b.hintProcessor, currentLevel),
+				hint:   hintData,
+			})
 		default:
 			// ignore hints that not implemented
 		}
@@ -4208,6 +4397,7 @@ func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLev
 		leadingJoinOrder:          leadingJoinOrder,
 		hjBuildTables:             hjBuildTables,
 		hjProbeTables:             hjProbeTables,
+		cardinalityHints:          cardinalityHints,
 	})
 }
 
@@ -4232,6 +4422,9 @@ func (b *PlanBuilder) popTableHints() {
 	b.appendUnmatchedJoinHintWarning(HintHashJoinBuild, "", hintInfo.hjBuildTables)
 	b.appendUnmatchedJoinHintWarning(HintHashJoinProbe, "", hintInfo.hjProbeTables)
 	b.appendUnmatchedJoinHintWarning(HintLeading, "", hintInfo.leadingJoinOrder)
+	for _, hint := range hintInfo.cardinalityHints {
+		b.appendUnmatchedJoinHintWarning(HintCardinality, "", hint.tables)
+	}
 	b.appendUnmatchedStorageHintWarning(hintInfo.tiflashTables, hintInfo.tikvTables)
 	b.tableHintInfo = b.tableHintInfo[:len(b.tableHintInfo)-1]
 }
@@ -4291,6 +4484,9 @@ func (b *PlanBuilder) TableHints() *tableHintInfo {
 }
 
 func (b *PlanBuilder) buildSelect(ctx context.Context, sel *ast.SelectStmt) (p LogicalPlan, err error) {
+	if mvSel := b.tryRewriteWithMaterializedView(sel); mvSel != nil {
+		sel = mvSel
+	}
 	b.pushSelectOffset(sel.QueryBlockOffset)
 	b.pushTableHints(sel.TableHints, sel.QueryBlockOffset)
 	defer func() {
@@ -5242,6 +5438,7 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 	ds.SetSchema(schema)
 	ds.names = names
 	ds.setPreferredStoreType(b.TableHints())
+	ds.setCardinalityHints(b.TableHints())
 	ds.SampleInfo = NewTableSampleInfo(tn.TableSample, schema.Clone(), b.partitionedTable)
 	b.isSampling = ds.SampleInfo != nil
 
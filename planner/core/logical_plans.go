@@ -51,6 +51,7 @@ var (
 	_ LogicalPlan = &LogicalApply{}
 	_ LogicalPlan = &LogicalMaxOneRow{}
 	_ LogicalPlan = &LogicalTableDual{}
+	_ LogicalPlan = &LogicalJSONTable{}
 	_ LogicalPlan = &DataSource{}
 	_ LogicalPlan = &TiKVSingleGather{}
 	_ LogicalPlan = &LogicalTableScan{}
@@ -220,6 +221,13 @@ func (p *LogicalJoin) Shallow() *LogicalJoin {
 	return join.Init(p.SCtx(), p.SelectBlockOffset())
 }
 
+// CanReorder checks whether the join can be reordered with its children joins, which requires it to be an inner join
+// without join hints or STRAIGHT_JOIN.
+func (p *LogicalJoin) CanReorder() bool {
+	return p.JoinType == InnerJoin && !p.StraightJoin && !p.isNAAJ() && !p.preferJoinOrder &&
+		p.preferJoinType == 0 && p.leftPreferJoinType == 0 && p.rightPreferJoinType == 0
+}
+
 // ExtractFD implements the interface LogicalPlan.
 func (p *LogicalJoin) ExtractFD() *fd.FDSet {
 	switch p.JoinType {
@@ -1424,6 +1432,35 @@ type LogicalTableDual struct {
 	RowCount int
 }
 
+// JSONTableColumn is a column or a nested path of JSON_TABLE.
+type JSONTableColumn struct {
+	Tp   ast.JSONTableColumnType
+	Name model.CIStr
+	// Offset is the offset of the column in the schema of JSON_TABLE, it's unused for nested paths.
+	Offset int
+	Path   string
+
+	OnEmpty *ast.JSONTableOnResponse
+	OnError *ast.JSONTableOnResponse
+
+	NestedColumns []*JSONTableColumn
+}
+
+// LogicalJSONTable represents the JSON_TABLE table function. Its expression may refer to the columns of
+// the preceding tables in the FROM clause by correlated columns, and then it's the inner child of an apply.
+type LogicalJSONTable struct {
+	logicalSchemaProducer
+
+	Expr    expression.Expression
+	Path    string
+	Columns []*JSONTableColumn
+}
+
+// ExtractCorrelatedCols implements LogicalPlan interface.
+func (p *LogicalJSONTable) ExtractCorrelatedCols() []*expression.CorrelatedColumn {
+	return expression.ExtractCorColumns(p.Expr)
+}
+
 // LogicalMemTable represents a memory table or virtual table
 // Some memory tables wants to take the ownership of some predications
 // e.g
@@ -1506,6 +1543,8 @@ type DataSource struct {
 	preferStoreType int
 	// preferPartitions store the map, the key represents store type, the value represents the partition name list.
 	preferPartitions map[int][]model.CIStr
+	// cardinalityHints are the CARDINALITY hints which reference the DataSource.
+	cardinalityHints []*cardinalityHintInfo
 	SampleInfo       *TableSampleInfo
 	is               infoschema.InfoSchema
 	// isForUpdateRead should be true in either of the following situations
@@ -1525,6 +1564,10 @@ type DataSource struct {
 	// It's calculated after we generated the access paths and estimated row count for them, and before entering findBestTask.
 	// It considers CountAfterIndex for index paths and CountAfterAccess for table paths and index merge paths.
 	accessPathMinSelectivity float64
+
+	// vectorSearch is the nearest neighbor search of the TopN pushed down to this DataSource,
+	// which can be served by a vector index.
+	vectorSearch *util.VectorSearch
 }
 
 // ExtractCorrelatedCols implements LogicalPlan interface.
@@ -1678,6 +1721,14 @@ func (ds *DataSource) detachCondAndBuildRangeForPath(path *util.AccessPath, cond
 			path.ConstCols[i] = res.ColumnValues[i] != nil
 		}
 	}
+	if path.Index.Tp == model.IndexTypeHypo {
+		colIDs := make([]int64, 0, len(path.IdxCols))
+		for _, col := range path.IdxCols {
+			colIDs = append(colIDs, col.UniqueID)
+		}
+		path.CountAfterAccess, err = cardinality.GetRowCountByHypoIndexRanges(ds.SCtx(), ds.tableStats.HistColl, colIDs, path.Index.Unique, path.Ranges)
+		return err
+	}
 	path.CountAfterAccess, err = cardinality.GetRowCountByIndexRanges(ds.SCtx(), ds.tableStats.HistColl, path.Index.ID, path.Ranges)
 	return err
 }
@@ -2233,6 +2284,7 @@ type ShowContents struct {
 	Tp                ast.ShowStmtType // Databases/Tables/Columns/....
 	DBName            string
 	Table             *ast.TableName  // Used for showing columns.
+	Procedure         *ast.TableName  // Used for showing create procedure.
 	Partition         model.CIStr     // Use for showing partition
 	Column            *ast.ColumnName // Used for `desc table column`.
 	IndexName         model.CIStr
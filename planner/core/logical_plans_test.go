@@ -556,6 +556,31 @@ func TestSubquery(t *testing.T) {
 	}
 }
 
+func TestLateralDerivedTable(t *testing.T) {
+	var input, output []string
+	planSuiteUnexportedData.LoadTestCases(t, &input, &output)
+
+	s := createPlannerSuite()
+	defer s.Close()
+	ctx := context.Background()
+	for i, ca := range input {
+		comment := fmt.Sprintf("for %s", ca)
+		stmt, err := s.p.ParseOneStmt(ca, "", "")
+		require.NoError(t, err, comment)
+
+		err = Preprocess(context.Background(), s.ctx, stmt, WithPreprocessorReturn(&PreprocessorReturn{InfoSchema: s.is}))
+		require.NoError(t, err, comment)
+		p, _, err := BuildLogicalPlanForTest(ctx, s.ctx, stmt, s.is)
+		require.NoError(t, err, comment)
+		p, err = logicalOptimize(context.TODO(), flagBuildKeyInfo|flagDecorrelate|flagPrunColumns|flagPrunColumnsAgain|flagPredicatePushDown, p.(LogicalPlan))
+		require.NoError(t, err, comment)
+		testdata.OnRecord(func() {
+			output[i] = ToString(p)
+		})
+		require.Equal(t, output[i], ToString(p), comment)
+	}
+}
+
 func TestPlanBuilder(t *testing.T) {
 	var input, output []string
 	planSuiteUnexportedData.LoadTestCases(t, &input, &output)
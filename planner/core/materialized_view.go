@@ -0,0 +1,496 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/privilege"
+	driver "github.com/pingcap/tidb/types/parser_driver"
+)
+
+// MaterializedViewAgg is an aggregate function in the output of a materialized view.
+type MaterializedViewAgg struct {
+	// Name is the lower-case name of the function, it's one of SUM, COUNT, MIN and MAX.
+	Name string
+	// Arg is the argument of the function, it's nil for COUNT(*).
+	Arg ast.ExprNode
+	// Offset is the offset of the function in the output of the view.
+	Offset int
+}
+
+// MaterializedViewQuery is the query of a materialized view which aggregates a single table. The changes of the base
+// table can be applied to such a view incrementally, and the view can be used to answer the queries which aggregate
+// the base table with the same filter.
+type MaterializedViewQuery struct {
+	// Table is the base table.
+	Table *ast.TableName
+	// Where is the filter of the query, it's nil if there is no filter.
+	Where ast.ExprNode
+	// GroupBy is the grouping columns.
+	GroupBy []model.CIStr
+	// GroupByOffsets is the offsets of the grouping columns in the output of the view.
+	GroupByOffsets []int
+	// Aggs is the aggregate functions in the output of the view.
+	Aggs []*MaterializedViewAgg
+}
+
+// AnalyzeMaterializedViewQuery analyzes the query of a materialized view. It returns nil if the query can't be
+// refreshed incrementally. The query must be a single-table `SELECT ... GROUP BY` whose output consists of the
+// grouping columns and the SUM, COUNT, MIN and MAX functions, where COUNT(*) is required and every SUM(e) needs a
+// COUNT(e). Note that the column names in the query are unqualified in place, so the node should not be reused.
+func AnalyzeMaterializedViewQuery(node ast.Node) *MaterializedViewQuery {
+	sel, ok := node.(*ast.SelectStmt)
+	if !ok || sel.Kind != ast.SelectStmtKindSelect || sel.With != nil || sel.Distinct || sel.Having != nil ||
+		sel.OrderBy != nil || sel.Limit != nil || len(sel.WindowSpecs) > 0 || sel.SelectIntoOpt != nil ||
+		(sel.LockInfo != nil && sel.LockInfo.LockType != ast.SelectLockNone) || sel.GroupBy == nil || sel.GroupBy.Rollup {
+		return nil
+	}
+	tn := singleTableOfSelect(sel)
+	if tn == nil {
+		return nil
+	}
+	sel.Accept(&columnQualifierStripper{})
+	if sel.Where != nil && !isMaterializedViewExpr(sel.Where) {
+		return nil
+	}
+
+	q := &MaterializedViewQuery{Table: tn, Where: sel.Where}
+	for _, item := range sel.GroupBy.Items {
+		col, ok := item.Expr.(*ast.ColumnNameExpr)
+		if !ok {
+			return nil
+		}
+		if q.groupByIndex(col.Name.Name) < 0 {
+			q.GroupBy = append(q.GroupBy, col.Name.Name)
+		}
+	}
+	q.GroupByOffsets = make([]int, len(q.GroupBy))
+	for i := range q.GroupByOffsets {
+		q.GroupByOffsets[i] = -1
+	}
+	for i, field := range sel.Fields.Fields {
+		switch x := field.Expr.(type) {
+		case *ast.ColumnNameExpr:
+			idx := q.groupByIndex(x.Name.Name)
+			if idx < 0 {
+				return nil
+			}
+			if q.GroupByOffsets[idx] >= 0 {
+				return nil
+			}
+			q.GroupByOffsets[idx] = i
+		case *ast.AggregateFuncExpr:
+			agg := newMaterializedViewAgg(x)
+			if agg == nil {
+				return nil
+			}
+			agg.Offset = i
+			q.Aggs = append(q.Aggs, agg)
+		default:
+			return nil
+		}
+	}
+	for _, offset := range q.GroupByOffsets {
+		if offset < 0 {
+			return nil
+		}
+	}
+	if q.FindAgg(ast.AggFuncCount, nil) == nil {
+		return nil
+	}
+	for _, agg := range q.Aggs {
+		if agg.Name == ast.AggFuncSum && q.FindAgg(ast.AggFuncCount, agg.Arg) == nil {
+			return nil
+		}
+	}
+	return q
+}
+
+// FindAgg finds the aggregate function with the given name and argument in the output of the view.
+func (q *MaterializedViewQuery) FindAgg(name string, arg ast.ExprNode) *MaterializedViewAgg {
+	argText := restoreMaterializedViewExpr(arg)
+	for _, agg := range q.Aggs {
+		if agg.Name == name && restoreMaterializedViewExpr(agg.Arg) == argText {
+			return agg
+		}
+	}
+	return nil
+}
+
+func (q *MaterializedViewQuery) groupByIndex(name model.CIStr) int {
+	for i, col := range q.GroupBy {
+		if col.L == name.L {
+			return i
+		}
+	}
+	return -1
+}
+
+// newMaterializedViewAgg converts the aggregate function to a MaterializedViewAgg, it returns nil if the function is
+// not supported. COUNT with a non-null constant argument is the same as COUNT(*).
+func newMaterializedViewAgg(x *ast.AggregateFuncExpr) *MaterializedViewAgg {
+	name := strings.ToLower(x.F)
+	switch name {
+	case ast.AggFuncSum, ast.AggFuncCount:
+		if x.Distinct {
+			return nil
+		}
+	case ast.AggFuncMin, ast.AggFuncMax:
+	default:
+		return nil
+	}
+	if len(x.Args) != 1 || x.Order != nil || !isMaterializedViewExpr(x.Args[0]) {
+		return nil
+	}
+	agg := &MaterializedViewAgg{Name: name, Arg: x.Args[0]}
+	if v, ok := x.Args[0].(*driver.ValueExpr); ok && name == ast.AggFuncCount && !v.Datum.IsNull() {
+		agg.Arg = nil
+	}
+	return agg
+}
+
+// RestoreMaterializedViewExpr restores the expression in a materialized view query to the SQL text.
+func RestoreMaterializedViewExpr(expr ast.ExprNode) (string, error) {
+	var sb strings.Builder
+	restoreFlags := format.RestoreStringSingleQuotes | format.RestoreKeyWordLowercase | format.RestoreNameBackQuotes
+	if err := expr.Restore(format.NewRestoreCtx(restoreFlags, &sb)); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// restoreMaterializedViewExpr is used to compare the expressions, it returns an empty string for a nil expression.
+func restoreMaterializedViewExpr(expr ast.ExprNode) string {
+	if expr == nil {
+		return ""
+	}
+	text, err := RestoreMaterializedViewExpr(expr)
+	if err != nil {
+		return ""
+	}
+	return text
+}
+
+func singleTableOfSelect(sel *ast.SelectStmt) *ast.TableName {
+	if sel.From == nil || sel.From.TableRefs == nil || sel.From.TableRefs.Right != nil {
+		return nil
+	}
+	ts, ok := sel.From.TableRefs.Left.(*ast.TableSource)
+	if !ok {
+		return nil
+	}
+	tn, ok := ts.Source.(*ast.TableName)
+	if !ok || tn.AsOf != nil || len(tn.PartitionNames) > 0 {
+		return nil
+	}
+	return tn
+}
+
+// columnQualifierStripper removes the schema and table names of the columns, since a materialized view query has
+// only one table.
+type columnQualifierStripper struct{}
+
+func (*columnQualifierStripper) Enter(in ast.Node) (ast.Node, bool) {
+	if col, ok := in.(*ast.ColumnName); ok {
+		col.Schema = model.CIStr{}
+		col.Table = model.CIStr{}
+	}
+	return in, false
+}
+
+func (*columnQualifierStripper) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// isMaterializedViewExpr checks whether the expression is deterministic and only refers to the columns of the base
+// table, so it gives the same result when the changes are applied.
+func isMaterializedViewExpr(expr ast.ExprNode) bool {
+	checker := &materializedViewExprChecker{valid: true}
+	expr.Accept(checker)
+	return checker.valid
+}
+
+type materializedViewExprChecker struct {
+	valid bool
+}
+
+func (c *materializedViewExprChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.SubqueryExpr, *ast.ExistsSubqueryExpr, *ast.AggregateFuncExpr, *ast.WindowFuncExpr, *ast.VariableExpr,
+		*ast.DefaultExpr, *ast.ValuesExpr, ast.ParamMarkerExpr:
+		c.valid = false
+	case *ast.FuncCallExpr:
+		// The functions other than the builtin functions, e.g. the stored functions, may be non-deterministic.
+		if x.Schema.L != "" || !expression.IsFunctionSupported(x.FnName.L) || !expression.IsDeterministicFunction(x.FnName.L) {
+			c.valid = false
+		}
+	}
+	return in, !c.valid
+}
+
+func (c *materializedViewExprChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, c.valid
+}
+
+// tryRewriteWithMaterializedView tries to answer the query from a materialized view of its table. It returns nil if
+// no view matches. A view matches if it has the same filter as the query, and the grouping columns of the query are
+// a subset of the view's. When the grouping columns are the same, the query reads the view directly; otherwise it
+// aggregates the rows of the view again. The result may be stale since the view is only updated when it's refreshed.
+func (b *PlanBuilder) tryRewriteWithMaterializedView(sel *ast.SelectStmt) *ast.SelectStmt {
+	sessVars := b.ctx.GetSessionVars()
+	if !sessVars.EnableMaterializedViewRewrite || sessVars.InRestrictedSQL || b.isCreateView ||
+		!sessVars.StmtCtx.InSelectStmt || sel.Kind != ast.SelectStmtKindSelect || sel.With != nil ||
+		len(sel.WindowSpecs) > 0 || sel.SelectIntoOpt != nil ||
+		(sel.LockInfo != nil && sel.LockInfo.LockType != ast.SelectLockNone) {
+		return nil
+	}
+	tn := singleTableOfSelect(sel)
+	if tn == nil || tn.TableInfo == nil || len(tn.TableInfo.MaterializedViews) == 0 {
+		return nil
+	}
+	var sb strings.Builder
+	if err := sel.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return nil
+	}
+	charset, collation := sessVars.GetCharsetInfo()
+	p := parser.New()
+	p.SetParserConfig(sessVars.BuildParserConfig())
+	pm := privilege.GetPrivilegeManager(b.ctx)
+	for _, mvID := range tn.TableInfo.MaterializedViews {
+		mvTbl, ok := b.is.TableByID(mvID)
+		if !ok || !mvTbl.Meta().IsMaterializedView() {
+			continue
+		}
+		mvInfo := mvTbl.Meta()
+		if pm != nil && !pm.RequestVerification(sessVars.ActiveRoles, tn.Schema.L, mvInfo.Name.L, "", mysql.SelectPriv) {
+			continue
+		}
+		mvNode, err := p.ParseOneStmt(mvInfo.MaterializedView.SelectStmt, charset, collation)
+		if err != nil {
+			continue
+		}
+		q := AnalyzeMaterializedViewQuery(mvNode)
+		if q == nil {
+			continue
+		}
+		// Parse the query for every view since the rewriting changes the AST.
+		node, err := p.ParseOneStmt(sb.String(), charset, collation)
+		if err != nil {
+			return nil
+		}
+		newSel, ok := node.(*ast.SelectStmt)
+		if !ok {
+			return nil
+		}
+		newSel.Accept(&columnQualifierStripper{})
+		rewriter := &materializedViewRewriter{p: p, charset: charset, collation: collation, q: q, cols: mvInfo.Cols()}
+		if !rewriter.rewrite(sel, newSel, tn.Schema, mvInfo.Name) {
+			continue
+		}
+		var authErr error
+		if user := sessVars.User; user != nil {
+			authErr = ErrTableaccessDenied.FastGenByArgs("SELECT", user.AuthUsername, user.AuthHostname, tn.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SelectPriv, tn.Schema.L, tn.Name.L, "", authErr)
+		sessVars.StmtCtx.SetSkipPlanCache(errors.Errorf("query is answered from materialized view '%s'", mvInfo.Name.O))
+		return newSel
+	}
+	return nil
+}
+
+// materializedViewRewriter rewrites a query to read a materialized view.
+type materializedViewRewriter struct {
+	p         *parser.Parser
+	charset   string
+	collation string
+	q         *MaterializedViewQuery
+	cols      []*model.ColumnInfo
+
+	// rollup indicates whether the rows of the view are aggregated again.
+	rollup bool
+	// groupBy is the grouping columns of the query.
+	groupBy map[string]struct{}
+	// aliases is the aliases of the fields, they can be referred in HAVING and ORDER BY.
+	aliases     map[string]struct{}
+	allowAlias  bool
+	hasAggFunc  bool
+	invalidExpr bool
+}
+
+// rewrite rewrites sel, which is a copy of the query origin, to read the view. It returns false if the view can't
+// answer the query.
+func (r *materializedViewRewriter) rewrite(origin, sel *ast.SelectStmt, schema, mvName model.CIStr) bool {
+	if restoreMaterializedViewExpr(sel.Where) != restoreMaterializedViewExpr(r.q.Where) {
+		return false
+	}
+	r.groupBy = make(map[string]struct{})
+	if sel.GroupBy != nil {
+		if sel.GroupBy.Rollup {
+			return false
+		}
+		for _, item := range sel.GroupBy.Items {
+			col, ok := item.Expr.(*ast.ColumnNameExpr)
+			if !ok || r.q.groupByIndex(col.Name.Name) < 0 {
+				return false
+			}
+			r.groupBy[col.Name.Name.L] = struct{}{}
+		}
+	}
+	r.rollup = len(r.groupBy) != len(r.q.GroupBy)
+	r.aliases = make(map[string]struct{})
+	for _, field := range sel.Fields.Fields {
+		if field.WildCard != nil {
+			return false
+		}
+		if field.AsName.L != "" {
+			r.aliases[field.AsName.L] = struct{}{}
+		}
+	}
+
+	for i, field := range sel.Fields.Fields {
+		field.Expr = r.rewriteExpr(field.Expr)
+		if field.AsName.L != "" {
+			continue
+		}
+		// Keep the names of the output columns.
+		switch x := getInnerFromParenthesesAndUnaryPlus(origin.Fields.Fields[i].Expr).(type) {
+		case *ast.ColumnNameExpr:
+			field.AsName = x.Name.Name
+		case *driver.ValueExpr:
+		default:
+			field.AsName = model.NewCIStr(parser.SpecFieldPattern.ReplaceAllStringFunc(origin.Fields.Fields[i].Text(), parser.TrimComment))
+		}
+	}
+	if r.rollup {
+		sel.Where = nil
+		if sel.GroupBy != nil {
+			for _, item := range sel.GroupBy.Items {
+				item.Expr = r.rewriteExpr(item.Expr)
+			}
+		}
+		if sel.Having != nil {
+			r.allowAlias = true
+			sel.Having.Expr = r.rewriteExpr(sel.Having.Expr)
+		}
+	} else {
+		// The rows of the view are already grouped and filtered, so HAVING becomes the filter of the view.
+		sel.Where = nil
+		if sel.Having != nil {
+			sel.Where = r.rewriteExpr(sel.Having.Expr)
+		}
+		sel.Having = nil
+		sel.GroupBy = nil
+	}
+	if sel.OrderBy != nil {
+		r.allowAlias = true
+		for _, item := range sel.OrderBy.Items {
+			item.Expr = r.rewriteExpr(item.Expr)
+		}
+	}
+	// A query without aggregation reads every row of the base table, which can't be answered by the view.
+	if r.invalidExpr || (len(r.groupBy) == 0 && !r.hasAggFunc) {
+		return false
+	}
+	sel.From = &ast.TableRefsClause{TableRefs: &ast.Join{Left: &ast.TableSource{
+		Source: &ast.TableName{Schema: schema, Name: mvName},
+	}}}
+	sel.TableHints = nil
+	sel.QueryBlockOffset = origin.QueryBlockOffset
+	return true
+}
+
+func (r *materializedViewRewriter) rewriteExpr(expr ast.ExprNode) ast.ExprNode {
+	node, _ := expr.Accept(r)
+	return node.(ast.ExprNode)
+}
+
+// Enter implements the ast.Visitor interface.
+func (r *materializedViewRewriter) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.AggregateFuncExpr:
+		r.hasAggFunc = true
+		return r.rewriteAggFunc(x), true
+	case *ast.ColumnNameExpr:
+		return r.rewriteColumn(x), true
+	case *ast.SubqueryExpr, *ast.ExistsSubqueryExpr, *ast.WindowFuncExpr, *ast.DefaultExpr, *ast.ValuesExpr:
+		r.invalidExpr = true
+		return in, true
+	case *ast.VariableExpr:
+		if x.Value != nil {
+			r.invalidExpr = true
+			return in, true
+		}
+	}
+	return in, false
+}
+
+// Leave implements the ast.Visitor interface.
+func (*materializedViewRewriter) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+func (r *materializedViewRewriter) rewriteAggFunc(x *ast.AggregateFuncExpr) ast.ExprNode {
+	agg := newMaterializedViewAgg(x)
+	if agg != nil {
+		agg = r.q.FindAgg(agg.Name, agg.Arg)
+	}
+	if agg == nil {
+		r.invalidExpr = true
+		return x
+	}
+	col := r.cols[agg.Offset].Name
+	if !r.rollup {
+		return &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: col}}
+	}
+	var sb strings.Builder
+	format.NewRestoreCtx(format.DefaultRestoreFlags, &sb).WriteName(col.O)
+	switch agg.Name {
+	case ast.AggFuncCount:
+		// The count of a group is the sum of the counts of its sub-groups.
+		return r.parseExpr("cast(ifnull(sum("+sb.String()+"), 0) as signed)", x)
+	default:
+		return r.parseExpr(agg.Name+"("+sb.String()+")", x)
+	}
+}
+
+func (r *materializedViewRewriter) rewriteColumn(x *ast.ColumnNameExpr) ast.ExprNode {
+	name := x.Name.Name
+	idx := r.q.groupByIndex(name)
+	if _, ok := r.groupBy[name.L]; ok || (!r.rollup && idx >= 0) {
+		return &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: r.cols[r.q.GroupByOffsets[idx]].Name}}
+	}
+	if _, ok := r.aliases[name.L]; ok && r.allowAlias {
+		return x
+	}
+	r.invalidExpr = true
+	return x
+}
+
+func (r *materializedViewRewriter) parseExpr(text string, origin ast.ExprNode) ast.ExprNode {
+	node, err := r.p.ParseOneStmt("select "+text, r.charset, r.collation)
+	if err != nil {
+		r.invalidExpr = true
+		return origin
+	}
+	return node.(*ast.SelectStmt).Fields.Fields[0].Expr
+}
@@ -30,6 +30,9 @@ var (
 	nonPreparedPlanCacheUnsupportedCounter prometheus.Counter
 	sessionPlanCacheInstancePlanNumCounter prometheus.Gauge
 	sessionPlanCacheInstanceMemoryUsage    prometheus.Gauge
+	instancePlanCachePlanNumCounter        prometheus.Gauge
+	instancePlanCacheMemoryUsage           prometheus.Gauge
+	instancePlanCacheEvictCounter          prometheus.Counter
 )
 
 func init() {
@@ -48,6 +51,9 @@ func InitMetricsVars() {
 	nonPreparedPlanCacheUnsupportedCounter = metrics.PlanCacheMissCounter.WithLabelValues("non-prepared-unsupported")
 	sessionPlanCacheInstancePlanNumCounter = metrics.PlanCacheInstancePlanNumCounter.WithLabelValues(" session-plan-cache")
 	sessionPlanCacheInstanceMemoryUsage = metrics.PlanCacheInstanceMemoryUsage.WithLabelValues(" session-plan-cache")
+	instancePlanCachePlanNumCounter = metrics.PlanCacheInstancePlanNumCounter.WithLabelValues(" instance-plan-cache")
+	instancePlanCacheMemoryUsage = metrics.PlanCacheInstanceMemoryUsage.WithLabelValues(" instance-plan-cache")
+	instancePlanCacheEvictCounter = metrics.PlanCacheEvictCounter.WithLabelValues(" instance-plan-cache")
 }
 
 // GetPlanCacheHitCounter get different plan cache hit counter
@@ -80,3 +86,18 @@ func GetPlanCacheInstanceNumCounter() prometheus.Gauge {
 func GetPlanCacheInstanceMemoryUsage() prometheus.Gauge {
 	return sessionPlanCacheInstanceMemoryUsage
 }
+
+// GetInstancePlanCacheNumCounter get the plan counter of the instance plan cache.
+func GetInstancePlanCacheNumCounter() prometheus.Gauge {
+	return instancePlanCachePlanNumCounter
+}
+
+// GetInstancePlanCacheMemoryUsage get the memory usage counter of the instance plan cache.
+func GetInstancePlanCacheMemoryUsage() prometheus.Gauge {
+	return instancePlanCacheMemoryUsage
+}
+
+// GetInstancePlanCacheEvictCounter get the eviction counter of the instance plan cache.
+func GetInstancePlanCacheEvictCounter() prometheus.Counter {
+	return instancePlanCacheEvictCounter
+}
@@ -73,6 +73,8 @@ var (
 	_ PhysicalPlan = &PhysicalShuffleReceiverStub{}
 	_ PhysicalPlan = &BatchPointGetPlan{}
 	_ PhysicalPlan = &PhysicalTableSample{}
+	_ PhysicalPlan = &PhysicalFullTextIndexReader{}
+	_ PhysicalPlan = &PhysicalVectorIndexReader{}
 )
 
 type tableScanAndPartitionInfo struct {
@@ -1327,6 +1329,10 @@ type PhysicalHashJoin struct {
 
 	// for runtime filter
 	runtimeFilterList []*RuntimeFilter
+
+	// HotKeys are the heavy hitters of the join key of the build side, whose build rows are collected once after the
+	// hash table is built.
+	HotKeys []types.Datum
 }
 
 // Clone implements PhysicalPlan interface.
@@ -1349,6 +1355,7 @@ func (p *PhysicalHashJoin) Clone() (PhysicalPlan, error) {
 		clonedRF := rf.Clone()
 		cloned.runtimeFilterList = append(cloned.runtimeFilterList, clonedRF)
 	}
+	cloned.HotKeys = types.CloneRow(p.HotKeys)
 	return cloned, nil
 }
 
@@ -1379,7 +1386,7 @@ func (p *PhysicalHashJoin) MemoryUsage() (sum int64) {
 		return
 	}
 
-	sum = p.basePhysicalJoin.MemoryUsage() + size.SizeOfUint + size.SizeOfSlice + size.SizeOfBool*2 + size.SizeOfUint8
+	sum = p.basePhysicalJoin.MemoryUsage() + size.SizeOfUint + size.SizeOfSlice*2 + size.SizeOfBool*2 + size.SizeOfUint8
 
 	for _, expr := range p.EqualConditions {
 		sum += expr.MemoryUsage()
@@ -1387,6 +1394,9 @@ func (p *PhysicalHashJoin) MemoryUsage() (sum int64) {
 	for _, expr := range p.NAEqualConditions {
 		sum += expr.MemoryUsage()
 	}
+	for i := range p.HotKeys {
+		sum += p.HotKeys[i].MemUsage()
+	}
 	return
 }
 
@@ -2208,6 +2218,31 @@ func (p *PhysicalMaxOneRow) MemoryUsage() (sum int64) {
 	return p.basePhysicalPlan.MemoryUsage()
 }
 
+// PhysicalJSONTable is the physical operator of JSON_TABLE.
+type PhysicalJSONTable struct {
+	physicalSchemaProducer
+
+	Expr    expression.Expression
+	Path    string
+	Columns []*JSONTableColumn
+}
+
+// ExtractCorrelatedCols implements PhysicalPlan interface.
+func (p *PhysicalJSONTable) ExtractCorrelatedCols() []*expression.CorrelatedColumn {
+	return expression.ExtractCorColumns(p.Expr)
+}
+
+// MemoryUsage return the memory usage of PhysicalJSONTable
+func (p *PhysicalJSONTable) MemoryUsage() (sum int64) {
+	if p == nil {
+		return
+	}
+
+	sum = p.physicalSchemaProducer.MemoryUsage() + p.Expr.MemoryUsage() + int64(len(p.Path)) + size.SizeOfSlice +
+		int64(cap(p.Columns))*size.SizeOfPointer
+	return
+}
+
 // PhysicalTableDual is the physical operator of dual.
 type PhysicalTableDual struct {
 	physicalSchemaProducer
@@ -2347,6 +2382,11 @@ type PhysicalShuffle struct {
 
 	SplitterType PartitionSplitterType
 	ByItemArrays [][]expression.Expression
+
+	// HotKeys are the heavy hitters of the partition keys. The rows of the data source HotKeySpreadIdx with hot keys
+	// are spread to all the workers, while the rows of the other data sources with hot keys are sent to every worker.
+	HotKeys         []types.Datum
+	HotKeySpreadIdx int
 }
 
 // MemoryUsage return the memory usage of PhysicalShuffle
@@ -2355,7 +2395,7 @@ func (p *PhysicalShuffle) MemoryUsage() (sum int64) {
 		return
 	}
 
-	sum = p.basePhysicalPlan.MemoryUsage() + size.SizeOfInt*2 + size.SizeOfSlice*(3+int64(cap(p.ByItemArrays))) +
+	sum = p.basePhysicalPlan.MemoryUsage() + size.SizeOfInt*3 + size.SizeOfSlice*(4+int64(cap(p.ByItemArrays))) +
 		int64(cap(p.Tails)+cap(p.DataSources))*size.SizeOfInterface
 
 	for _, plan := range p.Tails {
@@ -2370,6 +2410,9 @@ func (p *PhysicalShuffle) MemoryUsage() (sum int64) {
 			sum += expr.MemoryUsage()
 		}
 	}
+	for i := range p.HotKeys {
+		sum += p.HotKeys[i].MemUsage()
+	}
 	return
 }
 
@@ -2529,6 +2572,70 @@ func NewTableSampleInfo(node *ast.TableSample, fullSchema *expression.Schema, pt
 	}
 }
 
+// PhysicalFullTextIndexReader reads the rows that may match a MATCH ... AGAINST condition. It collects
+// the handles of the candidate rows from the full-text index, then reads the rows by TableReader.
+type PhysicalFullTextIndexReader struct {
+	physicalSchemaProducer
+
+	Table *model.TableInfo
+	// TableAsName is the alias of the table.
+	TableAsName *model.CIStr
+	// Match is the MATCH ... AGAINST condition read by the full-text index.
+	Match *expression.FullTextMatch
+	// TableReader reads the rows by the handles from the full-text index.
+	TableReader *PhysicalTableReader
+}
+
+// MemoryUsage return the memory usage of PhysicalFullTextIndexReader
+func (p *PhysicalFullTextIndexReader) MemoryUsage() (sum int64) {
+	if p == nil {
+		return
+	}
+
+	sum = p.physicalSchemaProducer.MemoryUsage() + size.SizeOfPointer*4
+	if p.Match != nil {
+		sum += p.Match.Query.MemoryUsage()
+	}
+	if p.TableReader != nil {
+		sum += p.TableReader.MemoryUsage()
+	}
+	return
+}
+
+// PhysicalVectorIndexReader reads the approximate nearest rows of a nearest neighbor search. It searches
+// the HNSW graph of the vector index for the handles of the nearest rows, then reads the rows by TableReader.
+type PhysicalVectorIndexReader struct {
+	physicalSchemaProducer
+
+	Table *model.TableInfo
+	// TableAsName is the alias of the table.
+	TableAsName *model.CIStr
+	// Index is the vector index.
+	Index *model.IndexInfo
+	// Distance is the distance function of the search.
+	Distance *expression.VectorDistance
+	// TopK is the number of the nearest rows to read.
+	TopK uint64
+	// TableReader reads the rows by the handles from the vector index.
+	TableReader *PhysicalTableReader
+}
+
+// MemoryUsage return the memory usage of PhysicalVectorIndexReader
+func (p *PhysicalVectorIndexReader) MemoryUsage() (sum int64) {
+	if p == nil {
+		return
+	}
+
+	sum = p.physicalSchemaProducer.MemoryUsage() + size.SizeOfPointer*5 + size.SizeOfUint64
+	if p.Distance != nil {
+		sum += int64(len(p.Distance.Query)) * size.SizeOfInt32
+	}
+	if p.TableReader != nil {
+		sum += p.TableReader.MemoryUsage()
+	}
+	return
+}
+
 // PhysicalCTE is for CTE.
 type PhysicalCTE struct {
 	physicalSchemaProducer
@@ -237,9 +237,52 @@ func optimizeByShuffle4MergeJoin(pp *PhysicalMergeJoin, ctx sessionctx.Context)
 		SplitterType: PartitionHashSplitterType,
 		ByItemArrays: [][]expression.Expression{leftByItemArray, rightByItemArray},
 	}.Init(ctx, pp.StatsInfo(), pp.SelectBlockOffset(), reqProp)
+	shuffle.HotKeys, shuffle.HotKeySpreadIdx = getHotKeys4MergeJoin(pp, dataSources, ctx)
 	return shuffle
 }
 
+// getHotKeys4MergeJoin gets the heavy hitters of the join key, and the index of the data source whose rows with the
+// hot keys can be spread to all the workers. All the rows should be partitioned by the join key, the workers handling
+// the hot keys would become the bottleneck otherwise. Since the rows of the other side with the hot keys are sent to
+// every worker, only the outer side of an outer join or a semi join can be spread, or the unmatched rows are output by
+// several workers. Like the hash join, composite join keys are partitioned without the hot keys.
+func getHotKeys4MergeJoin(pp *PhysicalMergeJoin, dataSources []PhysicalPlan, ctx sessionctx.Context) (hotKeys []types.Datum, spreadIdx int) {
+	threshold := ctx.GetSessionVars().OptJoinSkewThreshold
+	if threshold <= 0 || len(pp.LeftJoinKeys) != 1 || len(pp.RightJoinKeys) != 1 ||
+		!isHotKeyComparable(pp.LeftJoinKeys[0], pp.RightJoinKeys[0]) {
+		return nil, 0
+	}
+	var candidates []int
+	switch pp.JoinType {
+	case InnerJoin:
+		candidates = []int{0, 1}
+	case LeftOuterJoin, SemiJoin, AntiSemiJoin, LeftOuterSemiJoin, AntiLeftOuterSemiJoin:
+		candidates = []int{0}
+	case RightOuterJoin:
+		candidates = []int{1}
+	default:
+		return nil, 0
+	}
+	joinKeys := []*expression.Column{pp.LeftJoinKeys[0], pp.RightJoinKeys[0]}
+	maxHotRows := 0.0
+	for _, i := range candidates {
+		heavyHitters, err := cardinality.GetHeavyHitters(ctx, dataSources[i].StatsInfo().HistColl, joinKeys[i], threshold)
+		if err != nil || len(heavyHitters) == 0 {
+			continue
+		}
+		keys := make([]types.Datum, 0, len(heavyHitters))
+		hotRatio := 0.0
+		for _, hh := range heavyHitters {
+			keys = append(keys, hh.Value)
+			hotRatio += hh.Ratio
+		}
+		if hotRows := hotRatio * dataSources[i].StatsInfo().RowCount; hotRows > maxHotRows {
+			maxHotRows, hotKeys, spreadIdx = hotRows, keys, i
+		}
+	}
+	return hotKeys, spreadIdx
+}
+
 // LogicalPlan is a tree of logical operators.
 // We can do a lot of logical optimizations to it, like predicate pushdown and column pruning.
 type LogicalPlan interface {
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/mysql"
 	core_metrics "github.com/pingcap/tidb/planner/core/metrics"
@@ -174,6 +175,8 @@ func GetPlanFromSessionPlanCache(ctx context.Context, sctx sessionctx.Context,
 	// In rc or for update read, we need the latest schema version to decide whether we need to
 	// rebuild the plan. So we set this value in rc or for update read. In other cases, let it be 0.
 	var latestSchemaVersion int64
+	// instanceCache is nil if the statement can't use the instance plan cache.
+	var instanceCache sessionctx.InstancePlanCache
 
 	if stmtCtx.UseCache {
 		if sctx.GetSessionVars().IsIsolation(ast.ReadCommitted) || stmt.ForUpdateRead {
@@ -182,8 +185,17 @@ func GetPlanFromSessionPlanCache(ctx context.Context, sctx sessionctx.Context,
 			// up-to-date schema version which can lead plan cache miss and thus, the plan will be rebuilt.
 			latestSchemaVersion = domain.GetDomain(sctx).InfoSchema().SchemaMetaVersion()
 		}
-		if cacheKey, err = NewPlanCacheKey(sctx.GetSessionVars(), stmt.StmtText,
-			stmt.StmtDB, stmtAst.SchemaVersion, latestSchemaVersion, bindSQL, expression.ExprPushDownBlackListReloadTimeStamp.Load()); err != nil {
+		instanceCache = getInstancePlanCache(sctx, isNonPrepared, stmt)
+		if instanceCache != nil {
+			// the key of the instance plan cache can be used by the session plan cache as well, which caches the
+			// plans that can't be shared by the sessions.
+			cacheKey, err = NewInstancePlanCacheKey(sctx.GetSessionVars(), stmt.StmtText,
+				stmt.StmtDB, stmtAst.SchemaVersion, latestSchemaVersion, bindSQL, expression.ExprPushDownBlackListReloadTimeStamp.Load())
+		} else {
+			cacheKey, err = NewPlanCacheKey(sctx.GetSessionVars(), stmt.StmtText,
+				stmt.StmtDB, stmtAst.SchemaVersion, latestSchemaVersion, bindSQL, expression.ExprPushDownBlackListReloadTimeStamp.Load())
+		}
+		if err != nil {
 			return nil, nil, err
 		}
 	}
@@ -198,13 +210,18 @@ func GetPlanFromSessionPlanCache(ctx context.Context, sctx sessionctx.Context,
 	if err != nil {
 		return nil, nil, err
 	}
+	if stmtCtx.UseCache && instanceCache != nil {
+		if plan, names, ok, err := getCachedPlanFromInstanceCache(sctx, instanceCache, cacheKey, bindSQL, is, stmt, matchOpts); err != nil || ok {
+			return plan, names, err
+		}
+	}
 	if stmtCtx.UseCache { // for non-point plans
 		if plan, names, ok, err := getCachedPlan(sctx, isNonPrepared, cacheKey, bindSQL, is, stmt, matchOpts); err != nil || ok {
 			return plan, names, err
 		}
 	}
 
-	return generateNewPlan(ctx, sctx, isNonPrepared, is, stmt, cacheKey, latestSchemaVersion, bindSQL, matchOpts)
+	return generateNewPlan(ctx, sctx, isNonPrepared, is, stmt, cacheKey, latestSchemaVersion, bindSQL, matchOpts, instanceCache)
 }
 
 // parseParamTypes get parameters' types in PREPARE statement
@@ -294,11 +311,93 @@ func getCachedPlan(sctx sessionctx.Context, isNonPrepared bool, cacheKey kvcache
 	return cachedVal.Plan, cachedVal.OutPutNames, true, nil
 }
 
+// getInstancePlanCache returns the instance plan cache if the statement can use it. Only the read-only statements
+// using the non-prepared plan cache can use the instance plan cache, and it returns nil for the other statements.
+func getInstancePlanCache(sctx sessionctx.Context, isNonPrepared bool, stmt *PlanCacheStmt) sessionctx.InstancePlanCache {
+	if !isNonPrepared || !variable.EnableInstancePlanCache.Load() {
+		return nil
+	}
+	if !IsReadOnly(stmt.PreparedAst.Stmt, sctx.GetSessionVars()) {
+		return nil
+	}
+	return domain.GetDomain(sctx).InstancePlanCache()
+}
+
+func getCachedPlanFromInstanceCache(sctx sessionctx.Context, instanceCache sessionctx.InstancePlanCache,
+	cacheKey kvcache.Key, bindSQL string, is infoschema.InfoSchema, stmt *PlanCacheStmt,
+	matchOpts *utilpc.PlanCacheMatchOpts) (Plan, []*types.FieldName, bool, error) {
+	sessVars := sctx.GetSessionVars()
+	stmtCtx := sessVars.StmtCtx
+
+	candidate, exist := instanceCache.Get(sctx, cacheKey, matchOpts)
+	if !exist {
+		return nil, nil, false, nil
+	}
+	cachedVal := candidate.(*instancePlanCacheValue)
+	if err := CheckPreparedPriv(sctx, stmt, is); err != nil {
+		return nil, nil, false, err
+	}
+	for tblInfo, unionScan := range cachedVal.TblInfo2UnionScan {
+		if !unionScan && tableHasDirtyContent(sctx, tblInfo) {
+			// The plan is still valid for the other sessions, so keep it in the cache.
+			return nil, nil, false, nil
+		}
+	}
+	// The cached plan is shared by the sessions, so execute a clone of it which is bound to this session.
+	plan, ok := clonePlanWithNewCtx(sctx, cachedVal.Plan.(PhysicalPlan))
+	if !ok || !RebuildPlan4CachedPlan(plan) {
+		return nil, nil, false, nil
+	}
+	sessVars.FoundInPlanCache = true
+	if len(bindSQL) > 0 {
+		sessVars.FoundInBinding = true
+	}
+	core_metrics.GetPlanCacheHitCounter(true).Inc()
+	stmt.NormalizedPlan, stmt.PlanDigest = cachedVal.normalizedPlan, cachedVal.planDigest
+	stmtCtx.SetPlanDigest(stmt.NormalizedPlan, stmt.PlanDigest)
+	stmtCtx.StmtHints = *cachedVal.stmtHints
+	return plan, cachedVal.OutPutNames, true, nil
+}
+
+// putPlanIntoInstanceCache puts a clone of the plan which isn't bound to any session into the instance plan cache.
+// It returns false if the plan can't be shared by the sessions, and the caller should put it into the session plan
+// cache instead.
+func putPlanIntoInstanceCache(sctx sessionctx.Context, instanceCache sessionctx.InstancePlanCache, cacheKey kvcache.Key,
+	stmt *PlanCacheStmt, cached *PlanCacheValue, matchOpts *utilpc.PlanCacheMatchOpts) bool {
+	p, ok := cached.Plan.(PhysicalPlan)
+	if !ok {
+		return false
+	}
+	template, ok := clonePlanWithNewCtx(nil, p)
+	if !ok {
+		return false
+	}
+	sqlDigest := ""
+	if _, digest := parser.NormalizeDigest(stmt.StmtText); digest != nil {
+		sqlDigest = digest.String()
+	}
+	schemaName := stmt.StmtDB
+	if schemaName == "" {
+		schemaName = sctx.GetSessionVars().CurrentDB
+	}
+	shared := *cached
+	shared.Plan = template
+	instanceCache.Put(sctx, cacheKey, &instancePlanCacheValue{
+		PlanCacheValue: &shared,
+		schemaName:     schemaName,
+		sqlDigest:      sqlDigest,
+		sqlText:        stmt.StmtText,
+		normalizedPlan: stmt.NormalizedPlan,
+		planDigest:     stmt.PlanDigest,
+	}, matchOpts)
+	return true
+}
+
 // generateNewPlan call the optimizer to generate a new plan for current statement
 // and try to add it to cache
 func generateNewPlan(ctx context.Context, sctx sessionctx.Context, isNonPrepared bool, is infoschema.InfoSchema,
 	stmt *PlanCacheStmt, cacheKey kvcache.Key, latestSchemaVersion int64, bindSQL string,
-	matchOpts *utilpc.PlanCacheMatchOpts) (Plan, []*types.FieldName, error) {
+	matchOpts *utilpc.PlanCacheMatchOpts, instanceCache sessionctx.InstancePlanCache) (Plan, []*types.FieldName, error) {
 	stmtAst := stmt.PreparedAst
 	sessVars := sctx.GetSessionVars()
 	stmtCtx := sessVars.StmtCtx
@@ -337,7 +436,9 @@ func generateNewPlan(ctx context.Context, sctx sessionctx.Context, isNonPrepared
 		stmt.NormalizedPlan, stmt.PlanDigest = NormalizePlan(p)
 		stmtCtx.SetPlan(p)
 		stmtCtx.SetPlanDigest(stmt.NormalizedPlan, stmt.PlanDigest)
-		sctx.GetSessionPlanCache().Put(cacheKey, cached, matchOpts)
+		if instanceCache == nil || !putPlanIntoInstanceCache(sctx, instanceCache, cacheKey, stmt, cached, matchOpts) {
+			sctx.GetSessionPlanCache().Put(cacheKey, cached, matchOpts)
+		}
 	}
 	sessVars.FoundInPlanCache = false
 	return p, names, err
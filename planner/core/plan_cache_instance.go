@@ -0,0 +1,293 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"container/list"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/parser"
+	core_metrics "github.com/pingcap/tidb/planner/core/metrics"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/util/kvcache"
+	utilpc "github.com/pingcap/tidb/util/plancache"
+	"github.com/pingcap/tidb/util/syncutil"
+)
+
+// NewInstancePlanCacheKey creates a key to access the instance plan cache. Since the cached plans are shared by the
+// sessions, the key doesn't contain the connection ID, but contains the session variables affecting the optimizer.
+func NewInstancePlanCacheKey(sessionVars *variable.SessionVars, stmtText, stmtDB string, schemaVersion int64,
+	lastUpdatedSchemaVersion int64, bindSQL string, exprBlacklistTS int64) (kvcache.Key, error) {
+	key, err := NewPlanCacheKey(sessionVars, stmtText, stmtDB, schemaVersion, lastUpdatedSchemaVersion, bindSQL, exprBlacklistTS)
+	if err != nil {
+		return nil, err
+	}
+	k := key.(*planCacheKey)
+	k.connID = 0
+	h := fnv.New64a()
+	for _, name := range getOptimizerVarNames() {
+		val, _ := sessionVars.GetSystemVar(name)
+		_, _ = h.Write([]byte(name))
+		_, _ = h.Write([]byte{'='})
+		_, _ = h.Write([]byte(val))
+		_, _ = h.Write([]byte{';'})
+	}
+	k.optimizerVarsHash = h.Sum64()
+	return k, nil
+}
+
+var optimizerVarNames struct {
+	once  sync.Once
+	names []string
+}
+
+// getOptimizerVarNames returns the names of the session variables affecting the optimizer, which are the variables
+// prefixed with "tidb_opt_" and some other switches of the optimizer.
+func getOptimizerVarNames() []string {
+	optimizerVarNames.once.Do(func() {
+		others := []string{
+			variable.TiDBCostModelVersion,
+			variable.TiDBEnableCascadesPlanner,
+			variable.TiDBEnableIndexMerge,
+			variable.TiDBEnableIndexMergeJoin,
+			variable.TiDBOptimizerEnableOuterJoinReorder,
+			variable.TiDBOptimizerSelectivityLevel,
+			variable.TiDBDefaultStrMatchSelectivity,
+			variable.TiDBAllowMPPExecution,
+			variable.TiDBEnforceMPPExecution,
+			variable.TiDBEnableINLJoinInnerMultiPattern,
+		}
+		var names []string
+		for name, sv := range variable.GetSysVars() {
+			if sv.HasSessionScope() && (strings.HasPrefix(name, "tidb_opt_") || slices.Contains(others, name)) {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+		optimizerVarNames.names = names
+	})
+	return optimizerVarNames.names
+}
+
+// instancePlanCacheValue is the value of the instance plan cache. The plan in it is never executed, every session
+// executes a clone of it which is bound to the session, so the plan can be shared by the sessions safely.
+type instancePlanCacheValue struct {
+	*PlanCacheValue
+
+	schemaName     string
+	sqlDigest      string
+	sqlText        string
+	normalizedPlan string
+	planDigest     *parser.Digest
+}
+
+// MemoryUsage return the memory usage of instancePlanCacheValue
+func (v *instancePlanCacheValue) MemoryUsage() int64 {
+	return v.PlanCacheValue.MemoryUsage() + int64(len(v.schemaName)+len(v.sqlDigest)+len(v.sqlText)+len(v.normalizedPlan))
+}
+
+// instancePlanCacheEntry is the value of list.Element in the instance plan cache.
+type instancePlanCacheEntry struct {
+	key            kvcache.Key
+	value          *instancePlanCacheValue
+	memoryUsage    int64
+	hitCount       uint64
+	loadTime       time.Time
+	lastActiveTime time.Time
+}
+
+func newInstancePlanCacheEntry(key kvcache.Key, value *instancePlanCacheValue) *instancePlanCacheEntry {
+	now := time.Now()
+	return &instancePlanCacheEntry{
+		key:            key,
+		value:          value,
+		memoryUsage:    key.(*planCacheKey).MemoryUsage() + value.MemoryUsage(),
+		loadTime:       now,
+		lastActiveTime: now,
+	}
+}
+
+// InstancePlanCache is a least recently used plan cache shared by all the sessions in an instance. It's used by
+// the non-prepared plan cache if tidb_enable_instance_plan_cache is on, and the total memory usage of the cached
+// plans is limited by tidb_instance_plan_cache_max_mem_size.
+type InstancePlanCache struct {
+	// buckets replace the map in general LRU
+	buckets map[string]map[*list.Element]struct{}
+	lruList *list.List
+	lock    syncutil.Mutex
+
+	memoryUsageTotal int64
+}
+
+// NewInstancePlanCache creates an InstancePlanCache.
+func NewInstancePlanCache() *InstancePlanCache {
+	return &InstancePlanCache{
+		buckets: make(map[string]map[*list.Element]struct{}),
+		lruList: list.New(),
+	}
+}
+
+// Get tries to find the plan matching the key and the match options of the session. The returned plan can't be
+// executed directly, it should be cloned by clonePlanWithNewCtx first.
+func (c *InstancePlanCache) Get(sctx sessionctx.Context, key kvcache.Key, opts *utilpc.PlanCacheMatchOpts) (kvcache.Value, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	bucket, ok := c.buckets[strHashKey(key, false)]
+	if !ok {
+		return nil, false
+	}
+	for element := range bucket {
+		entry := element.Value.(*instancePlanCacheEntry)
+		if matchCachedPlan(sctx, entry.value.PlanCacheValue, opts) {
+			c.lruList.MoveToFront(element)
+			entry.hitCount++
+			entry.lastActiveTime = time.Now()
+			return entry.value, true
+		}
+	}
+	return nil, false
+}
+
+// Put puts the plan into the cache, and evicts the least recently used plans if the memory usage exceeds the limit.
+// The plan must not be used by any session after it's put into the cache.
+func (c *InstancePlanCache) Put(sctx sessionctx.Context, key kvcache.Key, value kvcache.Value, opts *utilpc.PlanCacheMatchOpts) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	newEntry := newInstancePlanCacheEntry(key, value.(*instancePlanCacheValue))
+	hash := strHashKey(key, true)
+	bucket, ok := c.buckets[hash]
+	if ok {
+		for element := range bucket {
+			entry := element.Value.(*instancePlanCacheEntry)
+			if matchCachedPlan(sctx, entry.value.PlanCacheValue, opts) {
+				c.updateMetrics(newEntry, entry)
+				element.Value = newEntry
+				c.lruList.MoveToFront(element)
+				c.evict()
+				return
+			}
+		}
+	} else {
+		bucket = make(map[*list.Element]struct{}, 1)
+		c.buckets[hash] = bucket
+	}
+	bucket[c.lruList.PushFront(newEntry)] = struct{}{}
+	c.updateMetrics(newEntry, nil)
+	c.evict()
+}
+
+// evict removes the least recently used plans until the memory usage doesn't exceed the limit.
+func (c *InstancePlanCache) evict() {
+	limit := variable.InstancePlanCacheMaxMemSize.Load()
+	for c.memoryUsageTotal > 0 && uint64(c.memoryUsageTotal) > limit {
+		element := c.lruList.Back()
+		if element == nil {
+			return
+		}
+		c.remove(element)
+		core_metrics.GetInstancePlanCacheEvictCounter().Inc()
+	}
+}
+
+func (c *InstancePlanCache) remove(element *list.Element) {
+	entry := element.Value.(*instancePlanCacheEntry)
+	hash := strHashKey(entry.key, false)
+	bucket := c.buckets[hash]
+	delete(bucket, element)
+	if len(bucket) == 0 {
+		delete(c.buckets, hash)
+	}
+	c.lruList.Remove(element)
+	c.updateMetrics(nil, entry)
+}
+
+// DeleteAll deletes all the plans from the cache.
+func (c *InstancePlanCache) DeleteAll() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	core_metrics.GetInstancePlanCacheNumCounter().Sub(float64(c.lruList.Len()))
+	core_metrics.GetInstancePlanCacheMemoryUsage().Sub(float64(c.memoryUsageTotal))
+	c.buckets = make(map[string]map[*list.Element]struct{})
+	c.lruList = list.New()
+	c.memoryUsageTotal = 0
+}
+
+// Size gets the number of the cached plans.
+func (c *InstancePlanCache) Size() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.lruList.Len()
+}
+
+// MemoryUsage returns the memory usage of the cached plans.
+func (c *InstancePlanCache) MemoryUsage() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.memoryUsageTotal
+}
+
+// Entries returns the information of the cached plans, from the most recently used one to the least recently used
+// one.
+func (c *InstancePlanCache) Entries() []*utilpc.InstancePlanCacheEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entries := make([]*utilpc.InstancePlanCacheEntry, 0, c.lruList.Len())
+	for element := c.lruList.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*instancePlanCacheEntry)
+		info := &utilpc.InstancePlanCacheEntry{
+			SchemaName:     entry.value.schemaName,
+			SQLDigest:      entry.value.sqlDigest,
+			SQLText:        entry.value.sqlText,
+			MemoryUsage:    entry.memoryUsage,
+			HitCount:       entry.hitCount,
+			LoadTime:       entry.loadTime,
+			LastActiveTime: entry.lastActiveTime,
+		}
+		if entry.value.planDigest != nil {
+			info.PlanDigest = entry.value.planDigest.String()
+		}
+		entries = append(entries, info)
+	}
+	return entries
+}
+
+// updateMetrics updates the memory usage and the plan num when a plan is put, replaced or removed.
+func (c *InstancePlanCache) updateMetrics(in, out *instancePlanCacheEntry) {
+	var delta int64
+	if in != nil {
+		delta += in.memoryUsage
+	}
+	if out != nil {
+		delta -= out.memoryUsage
+	}
+	c.memoryUsageTotal += delta
+	core_metrics.GetInstancePlanCacheMemoryUsage().Add(float64(delta))
+	if in != nil && out == nil {
+		core_metrics.GetInstancePlanCacheNumCounter().Inc()
+	} else if in == nil && out != nil {
+		core_metrics.GetInstancePlanCacheNumCounter().Dec()
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"slices"
+
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/expression/aggregation"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/planner/util"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+)
+
+// clonePlanWithNewCtx clones the plan cached in the instance plan cache and binds the cloned plan to the context.
+// The cloned plan shares the immutable parts with the original one, such as the schemas, the table infos and the
+// statistics, but owns the parts which may be changed when the plan is rebuilt or executed, such as the expressions,
+// the ranges and the parameters. It returns false if the plan contains any operator which can't be cloned safely.
+func clonePlanWithNewCtx(sctx sessionctx.Context, p PhysicalPlan) (PhysicalPlan, bool) {
+	switch x := p.(type) {
+	case *PhysicalTableReader:
+		if x.StoreType != kv.TiKV {
+			return nil, false
+		}
+		c := new(PhysicalTableReader)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		tablePlan, ok := clonePlanWithNewCtx(sctx, x.tablePlan)
+		if !ok {
+			return nil, false
+		}
+		c.tablePlan = tablePlan
+		c.TablePlans = flattenPushDownPlan(tablePlan)
+		c.PartitionInfo.PruningConds = expression.CloneExprsWithNewCtx(sctx, x.PartitionInfo.PruningConds)
+		return c, true
+	case *PhysicalIndexReader:
+		c := new(PhysicalIndexReader)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		indexPlan, ok := clonePlanWithNewCtx(sctx, x.indexPlan)
+		if !ok {
+			return nil, false
+		}
+		c.indexPlan = indexPlan
+		c.IndexPlans = flattenPushDownPlan(indexPlan)
+		c.PartitionInfo.PruningConds = expression.CloneExprsWithNewCtx(sctx, x.PartitionInfo.PruningConds)
+		return c, true
+	case *PhysicalIndexLookUpReader:
+		c := new(PhysicalIndexLookUpReader)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		indexPlan, ok := clonePlanWithNewCtx(sctx, x.indexPlan)
+		if !ok {
+			return nil, false
+		}
+		tablePlan, ok := clonePlanWithNewCtx(sctx, x.tablePlan)
+		if !ok {
+			return nil, false
+		}
+		c.indexPlan, c.tablePlan = indexPlan, tablePlan
+		c.IndexPlans, c.TablePlans = flattenPushDownPlan(indexPlan), flattenPushDownPlan(tablePlan)
+		c.PartitionInfo.PruningConds = expression.CloneExprsWithNewCtx(sctx, x.PartitionInfo.PruningConds)
+		return c, true
+	case *PhysicalTableScan:
+		if x.StoreType != kv.TiKV || x.SampleInfo != nil || len(x.runtimeFilterList) > 0 {
+			return nil, false
+		}
+		c := new(PhysicalTableScan)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		c.AccessCondition = expression.CloneExprsWithNewCtx(sctx, x.AccessCondition)
+		c.filterCondition = expression.CloneExprsWithNewCtx(sctx, x.filterCondition)
+		c.lateMaterializationFilterCondition = expression.CloneExprsWithNewCtx(sctx, x.lateMaterializationFilterCondition)
+		c.Ranges = util.CloneRanges(x.Ranges)
+		c.ByItems = cloneByItemsWithNewCtx(sctx, x.ByItems)
+		c.PartitionInfo.PruningConds = expression.CloneExprsWithNewCtx(sctx, x.PartitionInfo.PruningConds)
+		return c, true
+	case *PhysicalIndexScan:
+		if len(x.GenExprs) > 0 {
+			return nil, false
+		}
+		c := new(PhysicalIndexScan)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		c.AccessCondition = expression.CloneExprsWithNewCtx(sctx, x.AccessCondition)
+		c.Ranges = util.CloneRanges(x.Ranges)
+		c.ByItems = cloneByItemsWithNewCtx(sctx, x.ByItems)
+		return c, true
+	case *PhysicalSelection:
+		c := new(PhysicalSelection)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		c.Conditions = expression.CloneExprsWithNewCtx(sctx, x.Conditions)
+		return c, true
+	case *PhysicalProjection:
+		c := new(PhysicalProjection)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		c.Exprs = expression.CloneExprsWithNewCtx(sctx, x.Exprs)
+		return c, true
+	case *PhysicalLimit:
+		c := new(PhysicalLimit)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		return c, true
+	case *PhysicalTopN:
+		c := new(PhysicalTopN)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		c.ByItems = cloneByItemsWithNewCtx(sctx, x.ByItems)
+		return c, true
+	case *PhysicalSort:
+		c := new(PhysicalSort)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		c.ByItems = cloneByItemsWithNewCtx(sctx, x.ByItems)
+		return c, true
+	case *PhysicalHashAgg:
+		c := new(PhysicalHashAgg)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		c.AggFuncs = cloneAggFuncsWithNewCtx(sctx, x.AggFuncs)
+		c.GroupByItems = expression.CloneExprsWithNewCtx(sctx, x.GroupByItems)
+		return c, true
+	case *PhysicalStreamAgg:
+		c := new(PhysicalStreamAgg)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		c.AggFuncs = cloneAggFuncsWithNewCtx(sctx, x.AggFuncs)
+		c.GroupByItems = expression.CloneExprsWithNewCtx(sctx, x.GroupByItems)
+		return c, true
+	case *PhysicalHashJoin:
+		if x.storeTp == kv.TiFlash || len(x.runtimeFilterList) > 0 {
+			return nil, false
+		}
+		c := new(PhysicalHashJoin)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		c.LeftConditions = expression.CloneExprsWithNewCtx(sctx, x.LeftConditions)
+		c.RightConditions = expression.CloneExprsWithNewCtx(sctx, x.RightConditions)
+		c.OtherConditions = expression.CloneExprsWithNewCtx(sctx, x.OtherConditions)
+		c.EqualConditions = cloneScalarFuncsWithNewCtx(sctx, x.EqualConditions)
+		c.NAEqualConditions = cloneScalarFuncsWithNewCtx(sctx, x.NAEqualConditions)
+		return c, true
+	case *PhysicalTableDual:
+		c := new(PhysicalTableDual)
+		*c = *x
+		if !c.basePhysicalPlan.cloneChildrenWithNewCtx(sctx, c) {
+			return nil, false
+		}
+		return c, true
+	case *PointGetPlan:
+		if len(x.probeParents) > 0 || x.PartitionInfo != nil {
+			return nil, false
+		}
+		c := new(PointGetPlan)
+		*c = *x
+		c.SetSCtx(sctx)
+		c.ctx = sctx
+		c.HandleConstant = cloneConstantWithNewCtx(sctx, x.HandleConstant)
+		c.IndexConstants = cloneConstantsWithNewCtx(sctx, x.IndexConstants)
+		c.IndexValues = slices.Clone(x.IndexValues)
+		c.AccessConditions = expression.CloneExprsWithNewCtx(sctx, x.AccessConditions)
+		return c, true
+	case *BatchPointGetPlan:
+		if len(x.probeParents) > 0 || len(x.PartitionInfos) > 0 || x.PartitionExpr != nil {
+			return nil, false
+		}
+		c := new(BatchPointGetPlan)
+		*c = *x
+		c.SetSCtx(sctx)
+		c.ctx = sctx
+		c.Handles = slices.Clone(x.Handles)
+		c.HandleParams = cloneConstantsWithNewCtx(sctx, x.HandleParams)
+		if x.IndexValues != nil {
+			c.IndexValues = make([][]types.Datum, 0, len(x.IndexValues))
+			for _, values := range x.IndexValues {
+				c.IndexValues = append(c.IndexValues, slices.Clone(values))
+			}
+		}
+		if x.IndexValueParams != nil {
+			c.IndexValueParams = make([][]*expression.Constant, 0, len(x.IndexValueParams))
+			for _, params := range x.IndexValueParams {
+				c.IndexValueParams = append(c.IndexValueParams, cloneConstantsWithNewCtx(sctx, params))
+			}
+		}
+		c.PartitionIDs = slices.Clone(x.PartitionIDs)
+		c.AccessConditions = expression.CloneExprsWithNewCtx(sctx, x.AccessConditions)
+		return c, true
+	}
+	return nil, false
+}
+
+// cloneChildrenWithNewCtx fixes the basePhysicalPlan copied from the original plan: it points self to the cloned
+// plan, binds it to the context and clones its children.
+func (p *basePhysicalPlan) cloneChildrenWithNewCtx(sctx sessionctx.Context, self PhysicalPlan) bool {
+	if len(p.probeParents) > 0 {
+		return false
+	}
+	p.self = self
+	p.SetSCtx(sctx)
+	if p.children == nil {
+		return true
+	}
+	children := make([]PhysicalPlan, 0, len(p.children))
+	for _, child := range p.children {
+		cloned, ok := clonePlanWithNewCtx(sctx, child)
+		if !ok {
+			return false
+		}
+		children = append(children, cloned)
+	}
+	p.children = children
+	return true
+}
+
+func cloneByItemsWithNewCtx(sctx sessionctx.Context, items []*util.ByItems) []*util.ByItems {
+	if items == nil {
+		return nil
+	}
+	cloned := make([]*util.ByItems, 0, len(items))
+	for _, item := range items {
+		cloned = append(cloned, &util.ByItems{Expr: expression.CloneWithNewCtx(sctx, item.Expr), Desc: item.Desc})
+	}
+	return cloned
+}
+
+func cloneAggFuncsWithNewCtx(sctx sessionctx.Context, aggFuncs []*aggregation.AggFuncDesc) []*aggregation.AggFuncDesc {
+	if aggFuncs == nil {
+		return nil
+	}
+	cloned := make([]*aggregation.AggFuncDesc, 0, len(aggFuncs))
+	for _, aggFunc := range aggFuncs {
+		c := aggFunc.Clone()
+		c.Args = expression.CloneExprsWithNewCtx(sctx, aggFunc.Args)
+		c.OrderByItems = cloneByItemsWithNewCtx(sctx, aggFunc.OrderByItems)
+		cloned = append(cloned, c)
+	}
+	return cloned
+}
+
+func cloneScalarFuncsWithNewCtx(sctx sessionctx.Context, funcs []*expression.ScalarFunction) []*expression.ScalarFunction {
+	if funcs == nil {
+		return nil
+	}
+	cloned := make([]*expression.ScalarFunction, 0, len(funcs))
+	for _, f := range funcs {
+		cloned = append(cloned, expression.CloneWithNewCtx(sctx, f).(*expression.ScalarFunction))
+	}
+	return cloned
+}
+
+func cloneConstantWithNewCtx(sctx sessionctx.Context, c *expression.Constant) *expression.Constant {
+	if c == nil {
+		return nil
+	}
+	return expression.CloneWithNewCtx(sctx, c).(*expression.Constant)
+}
+
+func cloneConstantsWithNewCtx(sctx sessionctx.Context, constants []*expression.Constant) []*expression.Constant {
+	if constants == nil {
+		return nil
+	}
+	cloned := make([]*expression.Constant, 0, len(constants))
+	for _, c := range constants {
+		cloned = append(cloned, cloneConstantWithNewCtx(sctx, c))
+	}
+	return cloned
+}
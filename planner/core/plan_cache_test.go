@@ -2856,3 +2856,107 @@ func BenchmarkNonPreparedPlanCacheDML(b *testing.B) {
 		tk.MustExec("delete from t where a = 2")
 	}
 }
+
+func TestInstancePlanCacheAcrossSessions(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk1 := testkit.NewTestKit(t, store)
+	tk1.MustExec(`set global tidb_enable_instance_plan_cache=1`)
+	defer tk1.MustExec(`set global tidb_enable_instance_plan_cache=default`)
+	tk1.MustExec(`use test`)
+	tk1.MustExec(`create table t (a int primary key, b int, c int, key(b))`)
+	tk1.MustExec(`create table t2 (a int, b int)`)
+	for i := 0; i < 20; i++ {
+		tk1.MustExec(fmt.Sprintf("insert into t values (%v, %v, %v)", i, i%5, i%3))
+		tk1.MustExec(fmt.Sprintf("insert into t2 values (%v, %v)", i, i%7))
+	}
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustExec(`use test`)
+	tk1.MustExec(`set tidb_enable_non_prepared_plan_cache=1`)
+	tk2.MustExec(`set tidb_enable_non_prepared_plan_cache=1`)
+
+	queries := []string{
+		"select * from t where a<%v",
+		"select * from t where b in (%v, 3) and c=1",
+		"select * from t where b=%v",
+		"select * from t where b<%v order by c limit 3",
+		"select c, count(*) from t where a<%v group by c",
+		"select * from t, t2 where t.a=t2.a and t2.b<%v",
+	}
+	for _, query := range queries {
+		tk1.MustQuery(fmt.Sprintf(query, 2))
+		tk1.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("0"))
+
+		// the plan generated by tk1 is used by tk2 with different parameters.
+		tk2.MustExec(`set tidb_enable_non_prepared_plan_cache=0`)
+		expected := tk2.MustQuery(fmt.Sprintf(query, 4)).Sort().Rows()
+		tk2.MustExec(`set tidb_enable_non_prepared_plan_cache=1`)
+		tk2.MustQuery(fmt.Sprintf(query, 4)).Sort().Check(expected)
+		tk2.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("1"))
+		tk1.MustQuery(fmt.Sprintf(query, 2))
+		tk1.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("1"))
+	}
+
+	// the optimizer variables are a part of the key.
+	tk2.MustExec(`set tidb_opt_prefer_range_scan=1`)
+	tk2.MustQuery(`select * from t where b=1`)
+	tk2.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("0"))
+
+	// the plans are shared only if the instance plan cache is enabled.
+	tk1.MustExec(`set global tidb_enable_instance_plan_cache=0`)
+	tk1.MustQuery(`select * from t where c=1`)
+	tk2.MustQuery(`select * from t where c=1`)
+	tk2.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("0"))
+}
+
+func TestInstancePlanCacheInfoSchema(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec(`set global tidb_enable_instance_plan_cache=1`)
+	defer tk.MustExec(`set global tidb_enable_instance_plan_cache=default`)
+	tk.MustExec(`use test`)
+	tk.MustExec(`create table t (a int, b int, key(a))`)
+	tk.MustExec(`set tidb_enable_non_prepared_plan_cache=1`)
+	tk.MustExec(`admin flush instance plan_cache`)
+
+	tk.MustQuery(`select * from t where a<1`)
+	tk.MustQuery(`select * from t where a<2`)
+	tk.MustQuery(`select * from t where a<3`)
+	tk.MustQuery(`select schema_name, hit_count, mem_size>0, plan_digest!='' from information_schema.instance_plan_cache`).Check(
+		testkit.Rows("test 2 1 1"))
+	rows := tk.MustQuery(`select sql_text from information_schema.instance_plan_cache`).Rows()
+	require.Equal(t, "SELECT * FROM `test`.`t` WHERE `a`<?", rows[0][0])
+
+	tk.MustExec(`admin flush instance plan_cache`)
+	tk.MustQuery(`select count(*) from information_schema.instance_plan_cache`).Check(testkit.Rows("0"))
+
+}
+
+func TestInstancePlanCacheMemoryLimit(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec(`set global tidb_enable_instance_plan_cache=1`)
+	defer tk.MustExec(`set global tidb_enable_instance_plan_cache=default`)
+	defer tk.MustExec(`set global tidb_instance_plan_cache_max_mem_size=default`)
+	tk.MustExec(`use test`)
+	tk.MustExec(`create table t (a int, b int, key(a))`)
+	tk.MustExec(`set tidb_enable_non_prepared_plan_cache=1`)
+	tk.MustExec(`admin flush instance plan_cache`)
+
+	for i := 0; i < 5; i++ {
+		tk.MustQuery(fmt.Sprintf(`select * from t where b<%v`, i))
+		tk.MustQuery(fmt.Sprintf(`select * from t where a<%v`, i))
+	}
+	tk.MustQuery(`select count(*) from information_schema.instance_plan_cache`).Check(testkit.Rows("2"))
+
+	// the plans are evicted once the memory usage exceeds the limit.
+	tk.MustExec(`set global tidb_instance_plan_cache_max_mem_size=1`)
+	tk.MustQuery(`select * from t where a>1`)
+	tk.MustQuery(`select count(*) from information_schema.instance_plan_cache`).Check(testkit.Rows("0"))
+	tk.MustQuery(`select * from t where a>1`)
+	tk.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("0"))
+
+	tk.MustExec(`set global tidb_instance_plan_cache_max_mem_size=default`)
+	tk.MustQuery(`select * from t where a>1`)
+	tk.MustQuery(`select * from t where a>1`)
+	tk.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("1"))
+}
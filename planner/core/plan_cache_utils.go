@@ -220,6 +220,8 @@ type planCacheKey struct {
 	restrictedReadOnly       bool
 	TiDBSuperReadOnly        bool
 	exprBlacklistTS          int64 // expr-pushdown-blacklist can affect query optimization, so we need to consider it in plan cache.
+	// optimizerVarsHash is the hash of the variables affecting the optimizer, it's only set for the instance plan cache.
+	optimizerVarsHash uint64
 
 	memoryUsage int64 // Do not include in hash
 	hash        []byte
@@ -254,6 +256,7 @@ func (key *planCacheKey) Hash() []byte {
 		key.hash = append(key.hash, hack.Slice(strconv.FormatBool(key.restrictedReadOnly))...)
 		key.hash = append(key.hash, hack.Slice(strconv.FormatBool(key.TiDBSuperReadOnly))...)
 		key.hash = codec.EncodeInt(key.hash, key.exprBlacklistTS)
+		key.hash = codec.EncodeUint(key.hash, key.optimizerVarsHash)
 	}
 	return key.hash
 }
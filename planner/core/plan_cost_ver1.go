@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/statistics"
 	"github.com/pingcap/tidb/util/paging"
+	"github.com/pingcap/tidb/util/vectorindex"
 )
 
 const (
@@ -1157,6 +1158,42 @@ func (p *BatchPointGetPlan) getPlanCostVer1(_ property.TaskType, option *PlanCos
 	return p.planCost, nil
 }
 
+// getPlanCostVer1 calculates the cost of the plan if it has not been calculated yet and returns the cost.
+// The full-text index reader seeks the postings of the query and then reads the matched rows by their handles.
+func (p *PhysicalFullTextIndexReader) getPlanCostVer1(_ property.TaskType, option *PlanCostOption) (float64, error) {
+	costFlag := option.CostFlag
+	if p.planCostInit && !hasCostFlag(costFlag, CostFlagRecalculate) {
+		return p.planCost, nil
+	}
+	sessVars := p.SCtx().GetSessionVars()
+	rowCount := getCardinality(p, costFlag)
+	rowSize := getAvgRowSize(p.StatsInfo(), p.schema.Columns)
+	cost := rowCount * rowSize * sessVars.GetNetworkFactor(p.Table)
+	cost += rowCount * sessVars.GetSeekFactor(p.Table)
+	cost /= float64(sessVars.DistSQLScanConcurrency())
+	p.planCost = cost
+	p.planCostInit = true
+	return p.planCost, nil
+}
+
+// getPlanCostVer1 calculates the cost of the plan if it has not been calculated yet and returns the cost.
+// The vector index reader seeks the nodes of the HNSW graph and then reads the nearest rows by their handles.
+func (p *PhysicalVectorIndexReader) getPlanCostVer1(_ property.TaskType, option *PlanCostOption) (float64, error) {
+	costFlag := option.CostFlag
+	if p.planCostInit && !hasCostFlag(costFlag, CostFlagRecalculate) {
+		return p.planCost, nil
+	}
+	sessVars := p.SCtx().GetSessionVars()
+	rowCount := getCardinality(p, costFlag)
+	rowSize := getAvgRowSize(p.StatsInfo(), p.schema.Columns)
+	cost := rowCount * rowSize * sessVars.GetNetworkFactor(p.Table)
+	cost += math.Max(rowCount, vectorindex.DefaultEfSearch) * sessVars.GetSeekFactor(p.Table)
+	cost /= float64(sessVars.DistSQLScanConcurrency())
+	p.planCost = cost
+	p.planCostInit = true
+	return p.planCost, nil
+}
+
 // GetAvgRowSize return the average row size.
 func (p *BatchPointGetPlan) GetAvgRowSize() float64 {
 	cols := p.accessCols
@@ -777,6 +777,38 @@ func (p *BatchPointGetPlan) getPlanCostVer2(taskType property.TaskType, option *
 	return p.planCostVer2, nil
 }
 
+// getPlanCostVer2 returns the plan-cost of this sub-plan, which is:
+// plan-cost = rows * row-size * net-factor / concurrency
+func (p *PhysicalFullTextIndexReader) getPlanCostVer2(taskType property.TaskType, option *PlanCostOption) (costVer2, error) {
+	if p.planCostInit && !hasCostFlag(option.CostFlag, CostFlagRecalculate) {
+		return p.planCostVer2, nil
+	}
+	rows := getCardinality(p, option.CostFlag)
+	rowSize := getAvgRowSize(p.StatsInfo(), p.schema.Columns)
+	netFactor := getTaskNetFactorVer2(p, taskType)
+	concurrency := float64(p.SCtx().GetSessionVars().DistSQLScanConcurrency())
+
+	p.planCostVer2 = divCostVer2(netCostVer2(option, rows, rowSize, netFactor), concurrency)
+	p.planCostInit = true
+	return p.planCostVer2, nil
+}
+
+// getPlanCostVer2 returns the plan-cost of this sub-plan, which is:
+// plan-cost = rows * row-size * net-factor / concurrency
+func (p *PhysicalVectorIndexReader) getPlanCostVer2(taskType property.TaskType, option *PlanCostOption) (costVer2, error) {
+	if p.planCostInit && !hasCostFlag(option.CostFlag, CostFlagRecalculate) {
+		return p.planCostVer2, nil
+	}
+	rows := getCardinality(p, option.CostFlag)
+	rowSize := getAvgRowSize(p.StatsInfo(), p.schema.Columns)
+	netFactor := getTaskNetFactorVer2(p, taskType)
+	concurrency := float64(p.SCtx().GetSessionVars().DistSQLScanConcurrency())
+
+	p.planCostVer2 = divCostVer2(netCostVer2(option, rows, rowSize, netFactor), concurrency)
+	p.planCostInit = true
+	return p.planCostVer2, nil
+}
+
 func (p *PhysicalCTE) getPlanCostVer2(taskType property.TaskType, option *PlanCostOption) (costVer2, error) {
 	if p.planCostInit && !hasCostFlag(option.CostFlag, CostFlagRecalculate) {
 		return p.planCostVer2, nil
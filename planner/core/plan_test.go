@@ -1015,6 +1015,63 @@ func TestHypoIndexPlan(t *testing.T) {
 		`Point_Get_5 1.00 root table:t, index:hypo_a(a) `))
 }
 
+func TestHypoIndexStats(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec(`create table t (a int, b int, c int)`)
+	tk.MustExec(`insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3), (4, 4, 4), (5, 5, 5), (6, 6, 6), (7, 7, 7), (8, 8, 8)`)
+	for i := 0; i < 6; i++ {
+		tk.MustExec(`insert into t select a, b + 8, c from t`)
+	}
+	tk.MustExec(`analyze table t`)
+
+	// the estimation of a hypo-index is derived from the histograms of its columns
+	tk.MustExec(`create index hypo_a type hypo on t (a)`)
+	tk.MustQuery(`explain select a from t use index(hypo_a) where a = 1`).Check(testkit.Rows(
+		`IndexReader_6 64.00 root  index:IndexRangeScan_5`,
+		`└─IndexRangeScan_5 64.00 cop[tikv] table:t, index:hypo_a(a) range:[1,1], keep order:false`))
+	tk.MustQuery(`explain select a from t use index(hypo_a) where a < 3`).Check(testkit.Rows(
+		`IndexReader_6 128.00 root  index:IndexRangeScan_5`,
+		`└─IndexRangeScan_5 128.00 cop[tikv] table:t, index:hypo_a(a) range:[-inf,3), keep order:false`))
+	tk.MustExec(`create index hypo_ab type hypo on t (a, b)`)
+	tk.MustQuery(`explain select a from t use index(hypo_ab) where a = 1 and b = 1`).Check(testkit.Rows(
+		`Projection_4 0.12 root  test.t.a`,
+		`└─IndexReader_6 0.12 root  index:IndexRangeScan_5`,
+		`  └─IndexRangeScan_5 0.12 cop[tikv] table:t, index:hypo_ab(a, b) range:[1 1,1 1], keep order:false`))
+
+	// the estimation is the same as the real index
+	tk.MustExec(`create index a on t (a)`)
+	tk.MustExec(`analyze table t`)
+	tk.MustQuery(`explain select a from t use index(a) where a = 1`).Check(testkit.Rows(
+		`IndexReader_6 64.00 root  index:IndexRangeScan_5`,
+		`└─IndexRangeScan_5 64.00 cop[tikv] table:t, index:a(a) range:[1,1], keep order:false`))
+}
+
+func TestHypoIndexExecution(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec(`create table t (a int, b int)`)
+	tk.MustExec(`insert into t values (1, 1), (2, 2)`)
+	tk.MustExec(`create index hypo_a type hypo on t (a)`)
+	tk.MustExec(`create unique index hypo_b type hypo on t (b)`)
+
+	// hypo-indexes are only considered by EXPLAIN
+	tk.MustQuery(`explain select a from t where a = 1`).CheckContain("hypo_a")
+	tk.MustQuery(`explain analyze select a from t where a = 1`).CheckNotContain("hypo_a")
+	tk.MustQuery(`select a from t where a = 1`).Check(testkit.Rows("1"))
+	tk.MustQuery(`select b from t where b = 2`).Check(testkit.Rows("2"))
+	require.False(t, tk.HasPlanForLastExecution("hypo_b"))
+
+	// the plans with hypo-indexes are not cached
+	tk.MustExec(`set tidb_enable_non_prepared_plan_cache = 1`)
+	tk.MustQuery(`explain format = 'plan_cache' select a from t where a = 1`).CheckContain("hypo_a")
+	tk.MustQuery(`select a from t where a = 1`).Check(testkit.Rows("1"))
+	tk.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("0"))
+	require.False(t, tk.HasPlanForLastExecution("hypo_a"))
+}
+
 func TestHypoTiFlashReplica(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
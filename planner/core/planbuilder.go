@@ -20,6 +20,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -71,6 +72,7 @@ import (
 	"github.com/tikv/client-go/v2/oracle"
 	"github.com/tikv/client-go/v2/tikv"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 )
 
 type visitInfo struct {
@@ -110,6 +112,7 @@ type tableHintInfo struct {
 	leadingJoinOrder    []hintTableInfo
 	hjBuildTables       []hintTableInfo
 	hjProbeTables       []hintTableInfo
+	cardinalityHints    []*cardinalityHintInfo
 }
 
 type limitHintInfo struct {
@@ -121,6 +124,23 @@ type MergeHintInfo struct {
 	preferMerge bool
 }
 
+// cardinalityHintInfo is the CARDINALITY hint, which overrides the estimated row count of the tables or their join.
+type cardinalityHintInfo struct {
+	tables []hintTableInfo
+	hint   ast.HintCardinality
+}
+
+// apply returns the row count overridden by the hint.
+func (h *cardinalityHintInfo) apply(rowCount float64) float64 {
+	switch h.hint.Operator {
+	case ast.CardinalityMultiply:
+		return rowCount * float64(h.hint.Value)
+	case ast.CardinalityDivide:
+		return rowCount / float64(h.hint.Value)
+	}
+	return float64(h.hint.Value)
+}
+
 type hintTableInfo struct {
 	dbName       model.CIStr
 	tblName      model.CIStr
@@ -863,6 +883,8 @@ func (b *PlanBuilder) Build(ctx context.Context, node ast.Node) (Plan, error) {
 		return b.buildSet(ctx, x)
 	case *ast.SetConfigStmt:
 		return b.buildSetConfig(ctx, x)
+	case *ast.CallStmt:
+		return b.buildCall(ctx, x)
 	case *ast.AnalyzeTableStmt:
 		return b.buildAnalyze(x)
 	case *ast.BinlogStmt, *ast.FlushStmt, *ast.UseStmt, *ast.BRIEStmt,
@@ -870,7 +892,9 @@ func (b *PlanBuilder) Build(ctx context.Context, node ast.Node) (Plan, error) {
 		*ast.GrantStmt, *ast.DropUserStmt, *ast.AlterUserStmt, *ast.RevokeStmt, *ast.KillStmt, *ast.DropStatsStmt,
 		*ast.GrantRoleStmt, *ast.RevokeRoleStmt, *ast.SetRoleStmt, *ast.SetDefaultRoleStmt, *ast.ShutdownStmt,
 		*ast.RenameUserStmt, *ast.NonTransactionalDMLStmt, *ast.SetSessionStatesStmt, *ast.SetResourceGroupStmt,
-		*ast.LoadDataActionStmt, *ast.ImportIntoActionStmt, *ast.CalibrateResourceStmt, *ast.AddQueryWatchStmt, *ast.DropQueryWatchStmt:
+		*ast.LoadDataActionStmt, *ast.ImportIntoActionStmt, *ast.CalibrateResourceStmt, *ast.AddQueryWatchStmt, *ast.DropQueryWatchStmt,
+		*ast.CreateEventStmt, *ast.AlterEventStmt, *ast.DropEventStmt, *ast.ProcedureInfo, *ast.DropProcedureStmt,
+		*ast.RefreshMaterializedViewStmt, *ast.RecommendIndexStmt:
 		return b.buildSimple(ctx, node.(ast.StmtNode))
 	case ast.DDLNode:
 		return b.buildDDL(ctx, x)
@@ -901,6 +925,44 @@ func (b *PlanBuilder) buildSetConfig(ctx context.Context, v *ast.SetConfigStmt)
 	return &SetConfig{Name: v.Name, Type: v.Type, Instance: v.Instance, Value: expr}, err
 }
 
+func (b *PlanBuilder) buildCall(ctx context.Context, v *ast.CallStmt) (Plan, error) {
+	dbName := v.Procedure.Schema
+	if dbName.L == "" {
+		currentDB := b.ctx.GetSessionVars().CurrentDB
+		if currentDB == "" {
+			return nil, ErrNoDB
+		}
+		dbName = model.NewCIStr(currentDB)
+	}
+	var authErr error
+	if user := b.ctx.GetSessionVars().User; user != nil {
+		authErr = exeerrors.ErrProcaccessDenied.GenWithStackByArgs("execute", user.AuthUsername, user.AuthHostname,
+			fmt.Sprintf("%s.%s", dbName.O, v.Procedure.FnName.O))
+	}
+	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.ExecutePriv, dbName.L, "", "", authErr)
+
+	p := &Call{
+		DBName:  dbName,
+		Name:    v.Procedure.FnName,
+		Args:    make([]expression.Expression, 0, len(v.Procedure.Args)),
+		OutVars: make([]string, 0, len(v.Procedure.Args)),
+	}
+	mockTablePlan := LogicalTableDual{}.Init(b.ctx, b.getSelectOffset())
+	for _, arg := range v.Procedure.Args {
+		expr, _, err := b.rewrite(ctx, arg, mockTablePlan, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		p.Args = append(p.Args, expr)
+		outVar := ""
+		if variable, ok := arg.(*ast.VariableExpr); ok && !variable.IsSystem {
+			outVar = strings.ToLower(variable.Name)
+		}
+		p.OutVars = append(p.OutVars, outVar)
+	}
+	return p, nil
+}
+
 func (*PlanBuilder) buildChange(v *ast.ChangeStmt) (Plan, error) {
 	exe := &Change{
 		ChangeStmt: v,
@@ -1360,6 +1422,30 @@ func getLatestIndexInfo(ctx sessionctx.Context, id int64, startVer int64) (map[i
 	return latestIndexes, true, nil
 }
 
+// getHypoIndexPaths returns the access paths of the session's hypothetical indexes on the table. They're only
+// considered by EXPLAIN, since a plan with a hypothetical index can't be executed, so EXPLAIN ANALYZE and the plan
+// cache never see them. Each of them gets an ID after the existing indexes of the table to avoid conflicts.
+func getHypoIndexPaths(ctx sessionctx.Context, dbName model.CIStr, tblInfo *model.TableInfo) []*util.AccessPath {
+	sessVars := ctx.GetSessionVars()
+	if !sessVars.StmtCtx.InExplainStmt || sessVars.StmtCtx.InExplainAnalyzeStmt {
+		return nil
+	}
+	hypoIndexes := sessVars.HypoIndexes[dbName.L][tblInfo.Name.L]
+	if len(hypoIndexes) == 0 {
+		return nil
+	}
+	sessVars.StmtCtx.SetSkipPlanCache(errors.New("hypothetical indexes are considered"))
+	names := maps.Keys(hypoIndexes)
+	slices.Sort(names)
+	paths := make([]*util.AccessPath, 0, len(names))
+	for i, name := range names {
+		index := hypoIndexes[name].Clone()
+		index.ID = tblInfo.MaxIndexID + int64(i) + 1
+		paths = append(paths, &util.AccessPath{Index: index})
+	}
+	return paths
+}
+
 func getPossibleAccessPaths(ctx sessionctx.Context, tableHints *tableHintInfo, indexHints []*ast.IndexHint, tbl table.Table, dbName, tblName model.CIStr, check bool, hasFlagPartitionProcessor bool) ([]*util.AccessPath, error) {
 	tblInfo := tbl.Meta()
 	publicPaths := make([]*util.AccessPath, 0, len(tblInfo.Indices)+2)
@@ -1406,6 +1492,11 @@ func getPossibleAccessPaths(ctx sessionctx.Context, tableHints *tableHintInfo, i
 			if tblInfo.IsCommonHandle && index.Primary {
 				continue
 			}
+			// A full-text index is only read by MATCH ... AGAINST, see PhysicalFullTextIndexReader,
+			// and a vector index is only read by the nearest neighbor search, see PhysicalVectorIndexReader.
+			if index.IsSearchIndex() {
+				continue
+			}
 			if check && latestIndexes == nil {
 				latestIndexes, check, err = getLatestIndexInfo(ctx, tblInfo.ID, 0)
 				if err != nil {
@@ -1422,15 +1513,7 @@ func getPossibleAccessPaths(ctx sessionctx.Context, tableHints *tableHintInfo, i
 	}
 
 	// consider hypo-indexes
-	hypoIndexes := ctx.GetSessionVars().HypoIndexes
-	if ctx.GetSessionVars().StmtCtx.InExplainStmt && hypoIndexes != nil {
-		originalTableName := tblInfo.Name.L
-		if hypoIndexes[dbName.L] != nil && hypoIndexes[dbName.L][originalTableName] != nil {
-			for _, index := range hypoIndexes[dbName.L][originalTableName] {
-				publicPaths = append(publicPaths, &util.AccessPath{Index: index})
-			}
-		}
-	}
+	publicPaths = append(publicPaths, getHypoIndexPaths(ctx, dbName, tblInfo)...)
 
 	hasScanHint, hasUseOrForce := false, false
 	available := make([]*util.AccessPath, 0, len(publicPaths))
@@ -1955,6 +2038,10 @@ func (b *PlanBuilder) buildPhysicalIndexLookUpReaders(ctx context.Context, dbNam
 			// Skip checking clustered index.
 			continue
 		}
+		if idxInfo.IsSearchIndex() {
+			// Skip checking full-text and vector indexes, whose entries are not column values.
+			continue
+		}
 		if idxInfo.State != model.StatePublic {
 			logutil.Logger(ctx).Info("build physical index lookup reader, the index isn't public",
 				zap.String("index", idxInfo.Name.O),
@@ -2038,6 +2125,9 @@ func (b *PlanBuilder) buildAdminCheckTable(ctx context.Context, as *ast.AdminStm
 		if idx.Meta().State != model.StatePublic {
 			return nil, errors.Errorf("index %s state %s isn't public", as.Index, idx.Meta().State)
 		}
+		if idx.Meta().IsSearchIndex() {
+			return nil, errors.Errorf("checking full-text or vector index %s is not supported", as.Index)
+		}
 		p.CheckIndex = true
 		readerPlans, indexInfos, err = b.buildPhysicalIndexLookUpReaders(ctx, tblName.Schema, tbl, []table.Index{idx})
 	} else {
@@ -2108,7 +2198,8 @@ func getColsInfo(tn *ast.TableName) (indicesInfo []*model.IndexInfo, colsInfo []
 		colsInfo = append(colsInfo, col)
 	}
 	for _, idx := range tn.TableInfo.Indices {
-		if idx.State == model.StatePublic {
+		// Full-text and vector indexes have no statistics because their entries are not column values.
+		if idx.State == model.StatePublic && !idx.IsSearchIndex() {
 			indicesInfo = append(indicesInfo, idx)
 		}
 	}
@@ -2231,7 +2322,7 @@ func (b *PlanBuilder) getMustAnalyzedColumns(tbl *ast.TableName, cols *calcOnceM
 		}
 		virtualExprs := make([]expression.Expression, 0, len(tblInfo.Columns))
 		for _, idx := range tblInfo.Indices {
-			if idx.State != model.StatePublic || idx.MVIndex {
+			if idx.State != model.StatePublic || idx.MVIndex || idx.IsSearchIndex() {
 				continue
 			}
 			for _, idxCol := range idx.Columns {
@@ -2445,7 +2536,7 @@ func getModifiedIndexesInfoForAnalyze(tblInfo *model.TableInfo, allColumns bool,
 	idxsInfo := make([]*model.IndexInfo, 0, len(tblInfo.Indices))
 	independentIdxsInfo := make([]*model.IndexInfo, 0)
 	for _, originIdx := range tblInfo.Indices {
-		if originIdx.State != model.StatePublic {
+		if originIdx.State != model.StatePublic || originIdx.IsSearchIndex() {
 			continue
 		}
 		if originIdx.MVIndex {
@@ -2526,6 +2617,16 @@ func (b *PlanBuilder) buildAnalyzeFullSamplingTask(
 	if err != nil {
 		return err
 	}
+	var jointStatsColIDs []int64
+	if hasJointStatsOption(as.AnalyzeOpts) {
+		jointStatsColIDs = make([]int64, 0, len(astColList))
+		for _, col := range astColList {
+			if slices.Contains(jointStatsColIDs, col.ID) {
+				return errors.Errorf("Column %s is specified more than once for joint statistics", col.Name.O)
+			}
+			jointStatsColIDs = append(jointStatsColIDs, col.ID)
+		}
+	}
 
 	var predicateCols, mustAnalyzedCols calcOnceMap
 	ver := version
@@ -2568,15 +2669,21 @@ func (b *PlanBuilder) buildAnalyzeFullSamplingTask(
 			execColsInfo = colsInfo
 		}
 		execColsInfo = b.filterSkipColumnTypes(execColsInfo, tbl, &mustAnalyzedCols)
+		for _, colID := range jointStatsColIDs {
+			if !slices.ContainsFunc(execColsInfo, func(col *model.ColumnInfo) bool { return col.ID == colID }) {
+				return errors.Errorf("Joint statistics can't be collected on the columns whose statistics are skipped by tidb_analyze_skip_column_types")
+			}
+		}
 		allColumns := len(tbl.TableInfo.Columns) == len(execColsInfo)
 		indexes, independentIndexes := getModifiedIndexesInfoForAnalyze(tbl.TableInfo, allColumns, execColsInfo)
 		handleCols := BuildHandleColsForAnalyze(b.ctx, tbl.TableInfo, allColumns, execColsInfo)
 		newTask := AnalyzeColumnsTask{
-			HandleCols:  handleCols,
-			ColsInfo:    execColsInfo,
-			AnalyzeInfo: info,
-			TblInfo:     tbl.TableInfo,
-			Indexes:     indexes,
+			HandleCols:       handleCols,
+			ColsInfo:         execColsInfo,
+			AnalyzeInfo:      info,
+			TblInfo:          tbl.TableInfo,
+			Indexes:          indexes,
+			JointStatsColIDs: jointStatsColIDs,
 		}
 		if newTask.HandleCols == nil {
 			extraCol := model.NewExtraHandleColInfo()
@@ -2907,6 +3014,10 @@ func (b *PlanBuilder) buildAnalyzeIndex(as *ast.AnalyzeTableStmt, opts map[ast.A
 			b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing multi-valued indexes is not supported, skip %s", idx.Name.L))
 			continue
 		}
+		if idx.IsSearchIndex() {
+			b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing full-text and vector indexes is not supported, skip %s", idx.Name.L))
+			continue
+		}
 		p.IdxTasks = append(p.IdxTasks, generateIndexTasks(idx, as, tblInfo, names, physicalIDs, version)...)
 	}
 	return p, nil
@@ -2940,6 +3051,10 @@ func (b *PlanBuilder) buildAnalyzeAllIndex(as *ast.AnalyzeTableStmt, opts map[as
 				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing multi-valued indexes is not supported, skip %s", idx.Name.L))
 				continue
 			}
+			if idx.IsSearchIndex() {
+				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing full-text and vector indexes is not supported, skip %s", idx.Name.L))
+				continue
+			}
 
 			p.IdxTasks = append(p.IdxTasks, generateIndexTasks(idx, as, tblInfo, names, physicalIDs, version)...)
 		}
@@ -3032,6 +3147,10 @@ func handleAnalyzeOptionsV2(opts []ast.AnalyzeOpt) (map[ast.AnalyzeOptionType]ui
 	optMap := make(map[ast.AnalyzeOptionType]uint64, len(analyzeOptionDefault))
 	sampleNum, sampleRate := uint64(0), 0.0
 	for _, opt := range opts {
+		// JOINT STATS has no value, it's checked in buildAnalyze.
+		if opt.Type == ast.AnalyzeOptJointStats {
+			continue
+		}
 		datumValue := opt.Value.(*driver.ValueExpr).Datum
 		switch opt.Type {
 		case ast.AnalyzeOptNumTopN:
@@ -3095,6 +3214,10 @@ func handleAnalyzeOptions(opts []ast.AnalyzeOpt, statsVer int) (map[ast.AnalyzeO
 	}
 	sampleNum, sampleRate := uint64(0), 0.0
 	for _, opt := range opts {
+		// JOINT STATS has no value, it's checked in buildAnalyze.
+		if opt.Type == ast.AnalyzeOptJointStats {
+			continue
+		}
 		datumValue := opt.Value.(*driver.ValueExpr).Datum
 		switch opt.Type {
 		case ast.AnalyzeOptNumTopN:
@@ -3139,6 +3262,29 @@ func handleAnalyzeOptions(opts []ast.AnalyzeOpt, statsVer int) (map[ast.AnalyzeO
 	return optMap, nil
 }
 
+func hasJointStatsOption(opts []ast.AnalyzeOpt) bool {
+	return slices.ContainsFunc(opts, func(opt ast.AnalyzeOpt) bool { return opt.Type == ast.AnalyzeOptJointStats })
+}
+
+// checkJointStatsOption checks whether the joint statistics can be collected by the statement.
+func checkJointStatsOption(as *ast.AnalyzeTableStmt, statsVersion int) error {
+	if statsVersion != statistics.Version2 {
+		return errors.Errorf("Only the version 2 of analyze supports collecting joint statistics")
+	}
+	if as.IndexFlag || as.ColumnChoice != model.ColumnList {
+		return errors.Errorf("Joint statistics can only be collected on the specified columns, e.g, ANALYZE TABLE t COLUMNS a, b WITH JOINT STATS")
+	}
+	if len(as.ColumnNames) < 2 || len(as.ColumnNames) > statistics.MaxJointStatsColumns {
+		return errors.Errorf("Joint statistics can only be collected on 2 to %d columns", statistics.MaxJointStatsColumns)
+	}
+	for _, tbl := range as.TableNames {
+		if tbl.TableInfo.GetPartitionInfo() != nil {
+			return errors.Errorf("Joint statistics for partitioned table is not supported now")
+		}
+	}
+	return nil
+}
+
 func (b *PlanBuilder) buildAnalyze(as *ast.AnalyzeTableStmt) (Plan, error) {
 	// If enable fast analyze, the storage must be tikv.Storage.
 	if _, isTikvStorage := b.ctx.GetStore().(tikv.Storage); !isTikvStorage && b.ctx.GetSessionVars().EnableFastAnalyze {
@@ -3158,6 +3304,11 @@ func (b *PlanBuilder) buildAnalyze(as *ast.AnalyzeTableStmt) (Plan, error) {
 	if err != nil {
 		return nil, err
 	}
+	if hasJointStatsOption(as.AnalyzeOpts) {
+		if err := checkJointStatsOption(as, statsVersion); err != nil {
+			return nil, err
+		}
+	}
 
 	if as.IndexFlag {
 		if len(as.IndexNames) == 0 {
@@ -3361,6 +3512,19 @@ func buildCalibrateResourceSchema() (*expression.Schema, types.NameSlice) {
 	return schema.col2Schema(), schema.names
 }
 
+func buildRecommendIndexSchema() (*expression.Schema, types.NameSlice) {
+	schema := newColumnsWithNames(8)
+	schema.Append(buildColumnWithName("", "DATABASE", mysql.TypeVarchar, mysql.MaxDatabaseNameLength))
+	schema.Append(buildColumnWithName("", "TABLE", mysql.TypeVarchar, mysql.MaxTableNameLength))
+	schema.Append(buildColumnWithName("", "INDEX_NAME", mysql.TypeVarchar, mysql.MaxIndexIdentifierLen))
+	schema.Append(buildColumnWithName("", "INDEX_COLUMNS", mysql.TypeVarchar, 256))
+	schema.Append(buildColumnWithName("", "EST_BENEFIT", mysql.TypeDouble, 22))
+	schema.Append(buildColumnWithName("", "REASON", mysql.TypeBlob, mysql.MaxBlobWidth))
+	schema.Append(buildColumnWithName("", "TOP_IMPACTED_QUERIES", mysql.TypeBlob, mysql.MaxBlobWidth))
+	schema.Append(buildColumnWithName("", "CREATE_INDEX_STATEMENT", mysql.TypeVarchar, 1024))
+	return schema.col2Schema(), schema.names
+}
+
 func buildShowTelemetrySchema() (*expression.Schema, types.NameSlice) {
 	schema := newColumnsWithNames(1)
 	schema.Append(buildColumnWithName("", "TRACKING_ID", mysql.TypeVarchar, 64))
@@ -3437,6 +3601,7 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (Plan,
 			CountWarningsOrErrors: show.CountWarningsOrErrors,
 			DBName:                show.DBName,
 			Table:                 show.Table,
+			Procedure:             show.Procedure,
 			Partition:             show.Partition,
 			Column:                show.Column,
 			IndexName:             show.IndexName,
@@ -3527,6 +3692,10 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (Plan,
 			err = ErrTableaccessDenied.GenWithStackByArgs("SHOW", user.AuthUsername, user.AuthHostname, show.Table.Name.L)
 		}
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SelectPriv, show.Table.Schema.L, show.Table.Name.L, "", err)
+	case ast.ShowEvents, ast.ShowTriggers:
+		if p.DBName == "" {
+			return nil, ErrNoDB
+		}
 	case ast.ShowRegions:
 		tableInfo, err := b.is.TableByName(show.Table.Schema, show.Table.Name)
 		if err != nil {
@@ -3548,8 +3717,17 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (Plan,
 	np = p
 	// If we have ShowPredicateExtractor, we do not buildSelection with Pattern
 	if show.Pattern != nil && buildPattern {
+		patternCol := p.OutputNames()[0].ColName
+		if show.Tp == ast.ShowEvents || show.Tp == ast.ShowProcedureStatus || show.Tp == ast.ShowFunctionStatus {
+			// The pattern of `SHOW EVENTS` and `SHOW PROCEDURE|FUNCTION STATUS` matches the object name instead of
+			// the database name.
+			patternCol = p.OutputNames()[1].ColName
+		} else if show.Tp == ast.ShowTriggers {
+			// The pattern of `SHOW TRIGGERS` matches the table name.
+			patternCol = p.OutputNames()[2].ColName
+		}
 		show.Pattern.Expr = &ast.ColumnNameExpr{
-			Name: &ast.ColumnName{Name: p.OutputNames()[0].ColName},
+			Name: &ast.ColumnName{Name: patternCol},
 		}
 		np, err = b.buildSelection(ctx, np, show.Pattern, nil)
 		if err != nil {
@@ -3628,6 +3806,13 @@ func (b *PlanBuilder) buildSimple(ctx context.Context, node ast.StmtNode) (Plan,
 		err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or RESOURCE_GROUP_ADMIN")
 		b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "RESOURCE_GROUP_ADMIN", false, err)
 		p.setSchemaAndNames(buildCalibrateResourceSchema())
+	case *ast.RecommendIndexStmt:
+		if raw.SQL == "" {
+			// The workload is read from the statements summary, which contains the statements of all the users.
+			err := ErrSpecificAccessDenied.GenWithStackByArgs("PROCESS")
+			b.visitInfo = appendVisitInfo(b.visitInfo, mysql.ProcessPriv, "", "", "", err)
+		}
+		p.setSchemaAndNames(buildRecommendIndexSchema())
 	case *ast.AddQueryWatchStmt:
 		err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or RESOURCE_GROUP_ADMIN")
 		b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "RESOURCE_GROUP_ADMIN", false, err)
@@ -3685,6 +3870,34 @@ func (b *PlanBuilder) buildSimple(ctx context.Context, node ast.StmtNode) (Plan,
 		}
 	case *ast.ShutdownStmt:
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.ShutdownPriv, "", "", "", nil)
+	case *ast.CreateEventStmt:
+		if raw.Definer.CurrentUser && b.ctx.GetSessionVars().User != nil {
+			raw.Definer = b.ctx.GetSessionVars().User
+		}
+		b.visitInfo = appendVisitInfoForEvent(b.visitInfo, b.ctx, raw.EventName.Schema, raw.Definer)
+	case *ast.AlterEventStmt:
+		if raw.Definer != nil && raw.Definer.CurrentUser && b.ctx.GetSessionVars().User != nil {
+			raw.Definer = b.ctx.GetSessionVars().User
+		}
+		b.visitInfo = appendVisitInfoForEvent(b.visitInfo, b.ctx, raw.EventName.Schema, raw.Definer)
+		if raw.RenameTo != nil && raw.RenameTo.Schema.L != raw.EventName.Schema.L {
+			b.visitInfo = appendVisitInfoForEvent(b.visitInfo, b.ctx, raw.RenameTo.Schema, nil)
+		}
+	case *ast.DropEventStmt:
+		b.visitInfo = appendVisitInfoForEvent(b.visitInfo, b.ctx, raw.EventName.Schema, nil)
+	case *ast.ProcedureInfo:
+		b.visitInfo = appendVisitInfoForProcedure(b.visitInfo, b.ctx, mysql.CreateRoutinePriv, raw.ProcedureName.Schema)
+	case *ast.DropProcedureStmt:
+		b.visitInfo = appendVisitInfoForProcedure(b.visitInfo, b.ctx, mysql.AlterRoutinePriv, raw.ProcedureName.Schema)
+	case *ast.RefreshMaterializedViewStmt:
+		// Refreshing a view deletes its rows and inserts the new ones.
+		var insertErr, deleteErr error
+		if user := b.ctx.GetSessionVars().User; user != nil {
+			insertErr = ErrTableaccessDenied.GenWithStackByArgs("INSERT", user.AuthUsername, user.AuthHostname, raw.ViewName.Name.L)
+			deleteErr = ErrTableaccessDenied.GenWithStackByArgs("DELETE", user.AuthUsername, user.AuthHostname, raw.ViewName.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.InsertPriv, raw.ViewName.Schema.L, raw.ViewName.Name.L, "", insertErr)
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.DeletePriv, raw.ViewName.Schema.L, raw.ViewName.Name.L, "", deleteErr)
 	case *ast.BeginStmt:
 		readTS := b.ctx.GetSessionVars().TxnReadTS.PeakTxnReadTS()
 		if raw.AsOf != nil {
@@ -3715,6 +3928,30 @@ func (b *PlanBuilder) buildSimple(ctx context.Context, node ast.StmtNode) (Plan,
 	return p, nil
 }
 
+// appendVisitInfoForEvent appends the visitInfo to check the EVENT privilege on the schema of an event.
+// SUPER privilege is also required if the definer of the event is not the current user.
+func appendVisitInfoForEvent(vi []visitInfo, sctx sessionctx.Context, schema model.CIStr, definer *auth.UserIdentity) []visitInfo {
+	var authErr error
+	user := sctx.GetSessionVars().User
+	if user != nil {
+		authErr = ErrDBaccessDenied.GenWithStackByArgs(user.AuthUsername, user.AuthHostname, schema.O)
+	}
+	vi = appendVisitInfo(vi, mysql.EventPriv, schema.L, "", "", authErr)
+	if user != nil && definer != nil && definer.String() != user.String() {
+		vi = appendVisitInfo(vi, mysql.SuperPriv, "", "", "", ErrSpecificAccessDenied.GenWithStackByArgs("SUPER"))
+	}
+	return vi
+}
+
+// appendVisitInfoForProcedure appends the visitInfo to check the routine privilege on the schema of a procedure.
+func appendVisitInfoForProcedure(vi []visitInfo, sctx sessionctx.Context, priv mysql.PrivilegeType, schema model.CIStr) []visitInfo {
+	var authErr error
+	if user := sctx.GetSessionVars().User; user != nil {
+		authErr = ErrDBaccessDenied.GenWithStackByArgs(user.AuthUsername, user.AuthHostname, schema.O)
+	}
+	return appendVisitInfo(vi, priv, schema.L, "", "", authErr)
+}
+
 func collectVisitInfoFromRevokeStmt(sctx sessionctx.Context, vi []visitInfo, stmt *ast.RevokeStmt) ([]visitInfo, error) {
 	// To use REVOKE, you must have the GRANT OPTION privilege,
 	// and you must have the privileges that you are granting.
@@ -5002,6 +5239,49 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, err
 			b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "",
 				"", "", err)
 		}
+	case *ast.CreateMaterializedViewStmt:
+		b.isCreateView = true
+		b.capFlag |= canExpandAST
+		defer func() {
+			b.capFlag &= ^canExpandAST
+			b.isCreateView = false
+		}()
+
+		plan, err := b.Build(ctx, v.Select)
+		if err != nil {
+			return nil, err
+		}
+		schema := plan.Schema()
+		if v.Cols == nil {
+			adjustOverlongViewColname(plan.(LogicalPlan))
+			v.Cols = make([]model.CIStr, len(schema.Columns))
+			for i, name := range plan.OutputNames() {
+				v.Cols[i] = name.ColName
+			}
+		}
+		if len(v.Cols) != schema.Len() {
+			return nil, dbterror.ErrViewWrongList
+		}
+		// The columns of the view have the types of the query output, without the constraints.
+		v.ColTypes = make([]*types.FieldType, len(schema.Columns))
+		for i, col := range schema.Columns {
+			tp := col.RetType.Clone()
+			tp.SetFlag(tp.GetFlag() & (mysql.UnsignedFlag | mysql.BinaryFlag))
+			v.ColTypes[i] = tp
+		}
+		if user := b.ctx.GetSessionVars().User; user != nil {
+			authErr = ErrTableaccessDenied.GenWithStackByArgs("CREATE", user.AuthUsername,
+				user.AuthHostname, v.ViewName.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreatePriv, v.ViewName.Schema.L,
+			v.ViewName.Name.L, "", authErr)
+	case *ast.DropMaterializedViewStmt:
+		if user := b.ctx.GetSessionVars().User; user != nil {
+			authErr = ErrTableaccessDenied.GenWithStackByArgs("DROP", user.AuthUsername,
+				user.AuthHostname, v.ViewName.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.DropPriv, v.ViewName.Schema.L,
+			v.ViewName.Name.L, "", authErr)
 	case *ast.CreateSequenceStmt:
 		if b.ctx.GetSessionVars().User != nil {
 			authErr = ErrTableaccessDenied.GenWithStackByArgs("CREATE", b.ctx.GetSessionVars().User.AuthUsername,
@@ -5009,6 +5289,36 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, err
 		}
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreatePriv, v.Name.Schema.L,
 			v.Name.Name.L, "", authErr)
+	case *ast.CreateTriggerStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = ErrTableaccessDenied.GenWithStackByArgs("TRIGGER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.TriggerPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
+		if v.Definer == nil || (v.Definer.CurrentUser && b.ctx.GetSessionVars().User != nil) {
+			v.Definer = b.ctx.GetSessionVars().User
+		}
+		if b.ctx.GetSessionVars().User != nil && v.Definer.String() != b.ctx.GetSessionVars().User.String() {
+			err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER")
+			b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", err)
+		}
+	case *ast.DropTriggerStmt:
+		// The privilege is checked on the table of the trigger, or on the schema if the trigger doesn't exist.
+		var tableName string
+		for _, tbl := range b.is.SchemaTables(v.TriggerName.Schema) {
+			for _, trigger := range tbl.Meta().Triggers {
+				if trigger.Name.L == v.TriggerName.Name.L {
+					tableName = tbl.Meta().Name.L
+				}
+			}
+		}
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = ErrTableaccessDenied.GenWithStackByArgs("TRIGGER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, tableName)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.TriggerPriv, v.TriggerName.Schema.L,
+			tableName, "", authErr)
 	case *ast.DropDatabaseStmt:
 		if b.ctx.GetSessionVars().User != nil {
 			authErr = ErrDBaccessDenied.GenWithStackByArgs(b.ctx.GetSessionVars().User.AuthUsername,
@@ -5431,6 +5741,12 @@ func buildShowSchema(s *ast.ShowStmt, isView bool, isSequence bool) (schema *exp
 		}
 	case ast.ShowCreateView:
 		names = []string{"View", "Create View", "character_set_client", "collation_connection"}
+	case ast.ShowCreateProcedure:
+		names = []string{"Procedure", "sql_mode", "Create Procedure", "character_set_client", "collation_connection",
+			"Database Collation"}
+	case ast.ShowCreateFunction:
+		names = []string{"Function", "sql_mode", "Create Function", "character_set_client", "collation_connection",
+			"Database Collation"}
 	case ast.ShowCreateDatabase:
 		names = []string{"Database", "Create Database"}
 	case ast.ShowDrainerStatus:
@@ -263,6 +263,13 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 		p.flag |= inCreateOrDropTable
 		p.checkCreateViewGrammar(node)
 		p.checkCreateViewWithSelectGrammar(node)
+	case *ast.CreateMaterializedViewStmt:
+		p.stmtTp = TypeCreate
+		p.flag |= inCreateOrDropTable
+		p.checkCreateMaterializedViewGrammar(node)
+	case *ast.DropMaterializedViewStmt:
+		p.stmtTp = TypeDrop
+		p.flag |= inCreateOrDropTable
 	case *ast.DropTableStmt:
 		p.flag |= inCreateOrDropTable
 		p.stmtTp = TypeDrop
@@ -332,6 +339,37 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 			p.checkFlashbackDatabaseGrammar(node)
 		}
 		return in, true
+	case *ast.CreateEventStmt:
+		// The event body is resolved when the event is executed, so skip children here.
+		p.handleSchemaObjectName(node.EventName)
+		return in, true
+	case *ast.AlterEventStmt:
+		p.handleSchemaObjectName(node.EventName)
+		if node.RenameTo != nil {
+			p.handleSchemaObjectName(node.RenameTo)
+		}
+		return in, true
+	case *ast.DropEventStmt:
+		p.handleSchemaObjectName(node.EventName)
+		return in, true
+	case *ast.ProcedureInfo:
+		// The procedure body is resolved when the procedure is called, so skip children here.
+		p.handleSchemaObjectName(node.ProcedureName)
+		return in, true
+	case *ast.DropProcedureStmt:
+		p.handleSchemaObjectName(node.ProcedureName)
+		return in, true
+	case *ast.CreateTriggerStmt:
+		// The trigger body is resolved when the trigger is fired, so skip children here. The table of the trigger
+		// is in the schema of the trigger if it's not qualified.
+		p.handleSchemaObjectName(node.TriggerName)
+		if node.Table.Schema.L == "" {
+			node.Table.Schema = node.TriggerName.Schema
+		}
+		return in, true
+	case *ast.DropTriggerStmt:
+		p.handleSchemaObjectName(node.TriggerName)
+		return in, true
 	case *ast.RepairTableStmt:
 		p.stmtTp = TypeRepair
 		// The RepairTable should consist of the logic for creating tables and renaming tables.
@@ -354,10 +392,6 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 		if node.FnName.L == ast.NextVal || node.FnName.L == ast.LastVal || node.FnName.L == ast.SetVal {
 			p.flag |= inSequenceFunction
 		}
-		// not support procedure right now.
-		if node.Schema.L != "" {
-			p.err = expression.ErrFunctionNotExists.GenWithStackByArgs("FUNCTION", node.Schema.L+"."+node.FnName.L)
-		}
 	case *ast.BRIEStmt:
 		if node.Kind == ast.BRIEKindRestore {
 			p.flag |= inCreateOrDropTable
@@ -367,6 +401,9 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 		if _, ok := node.Source.(*ast.SelectStmt); ok && !isModeOracle && len(node.AsName.L) == 0 {
 			p.err = dbterror.ErrDerivedMustHaveAlias.GenWithStackByArgs()
 		}
+		if _, ok := node.Source.(*ast.JSONTable); ok && len(node.AsName.L) == 0 {
+			p.err = ErrTableFunctionMustHaveAlias.GenWithStackByArgs()
+		}
 		if v, ok := node.Source.(*ast.TableName); ok && v.TableSample != nil {
 			switch v.TableSample.SampleMethod {
 			case ast.SampleMethodTypeTiDBRegion:
@@ -560,7 +597,7 @@ func (p *preprocessor) Leave(in ast.Node) (out ast.Node, ok bool) {
 		p.flag &= ^inCreateOrDropTable
 		p.checkAutoIncrement(x)
 		p.checkContainDotColumn(x)
-	case *ast.CreateViewStmt:
+	case *ast.CreateViewStmt, *ast.CreateMaterializedViewStmt, *ast.DropMaterializedViewStmt:
 		p.flag &= ^inCreateOrDropTable
 	case *ast.DropTableStmt, *ast.AlterTableStmt, *ast.RenameTableStmt:
 		p.flag &= ^inCreateOrDropTable
@@ -971,6 +1008,21 @@ func (p *preprocessor) checkCreateViewGrammar(stmt *ast.CreateViewStmt) {
 	}
 }
 
+func (p *preprocessor) checkCreateMaterializedViewGrammar(stmt *ast.CreateMaterializedViewStmt) {
+	vName := stmt.ViewName.Name.String()
+	if util.IsInCorrectIdentifierName(vName) {
+		p.err = dbterror.ErrWrongTableName.GenWithStackByArgs(vName)
+		return
+	}
+	for _, col := range stmt.Cols {
+		if util.IsInCorrectIdentifierName(col.String()) {
+			p.err = dbterror.ErrWrongColumnName.GenWithStackByArgs(col)
+			return
+		}
+	}
+	p.checkCreateViewWithSelect(stmt.Select)
+}
+
 func (p *preprocessor) checkCreateViewWithSelect(stmt ast.Node) {
 	switch s := stmt.(type) {
 	case *ast.SelectStmt:
@@ -1506,6 +1558,21 @@ func (p *preprocessor) stmtType() string {
 	}
 }
 
+// handleSchemaObjectName fills the schema of an event, a procedure or a trigger name with the current database if it is not
+// specified.
+func (p *preprocessor) handleSchemaObjectName(tn *ast.TableName) {
+	if p.err != nil || tn.Schema.L != "" {
+		return
+	}
+
+	currentDB := p.sctx.GetSessionVars().CurrentDB
+	if currentDB == "" {
+		p.err = errors.Trace(ErrNoDB)
+		return
+	}
+	tn.Schema = model.NewCIStr(currentDB)
+}
+
 func (p *preprocessor) handleTableName(tn *ast.TableName) {
 	if tn.Schema.L == "" {
 		for _, cte := range p.preprocessWith.cteCanUsed {
@@ -1619,6 +1686,9 @@ func (p *preprocessor) resolveShowStmt(node *ast.ShowStmt) {
 	} else if node.Table != nil && node.Table.Schema.L == "" {
 		node.Table.Schema = model.NewCIStr(node.DBName)
 	}
+	if node.Procedure != nil {
+		p.handleSchemaObjectName(node.Procedure)
+	}
 	if node.User != nil && node.User.CurrentUser {
 		// Fill the Username and Hostname with the current user.
 		currentUser := p.sctx.GetSessionVars().User
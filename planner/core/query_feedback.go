@@ -0,0 +1,146 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"time"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/statistics"
+	"github.com/pingcap/tidb/util/execdetails"
+)
+
+// CollectQueryFeedback compares the estimated and actual row counts of the scans and the selections pushed down to
+// TiKV after the plan is executed, and returns the significant misestimates.
+// Only the operators which have been fully executed are compared, so the operators under a Limit and the inner sides
+// of index joins and applies are skipped.
+func CollectQueryFeedback(sctx sessionctx.Context, p Plan) []*statistics.QueryFeedback {
+	statsColl := sctx.GetSessionVars().StmtCtx.RuntimeStatsColl
+	if statsColl == nil {
+		return nil
+	}
+	var physicalPlan PhysicalPlan
+	switch x := p.(type) {
+	case PhysicalPlan:
+		physicalPlan = x
+	case *Insert:
+		physicalPlan = x.SelectPlan
+	case *Update:
+		physicalPlan = x.SelectPlan
+	case *Delete:
+		physicalPlan = x.SelectPlan
+	case *Explain:
+		if x.Analyze {
+			return CollectQueryFeedback(sctx, x.TargetPlan)
+		}
+	}
+	if physicalPlan == nil {
+		return nil
+	}
+	c := &feedbackCollector{statsColl: statsColl, now: time.Now()}
+	c.collect(physicalPlan)
+	return c.feedback
+}
+
+type feedbackCollector struct {
+	now       time.Time
+	statsColl *execdetails.RuntimeStatsColl
+	feedback  []*statistics.QueryFeedback
+}
+
+func (c *feedbackCollector) collect(p PhysicalPlan) {
+	switch x := p.(type) {
+	case *PhysicalLimit:
+		// The children may stop early once the limit is reached.
+		return
+	case *PhysicalApply, *PhysicalIndexJoin, *PhysicalIndexHashJoin, *PhysicalIndexMergeJoin:
+		// The inner side is executed once for every outer row, so only the outer side is compared.
+		innerIdx := x.(interface{ getInnerChildIdx() int }).getInnerChildIdx()
+		c.collect(p.Children()[1-innerIdx])
+		return
+	case *PhysicalTableReader:
+		if x.StoreType == kv.TiKV {
+			c.collectCopPlans(x.TablePlans)
+		}
+	case *PhysicalIndexReader:
+		c.collectCopPlans(x.IndexPlans)
+	case *PhysicalIndexLookUpReader:
+		if x.PushedLimit == nil {
+			c.collectCopPlans(x.IndexPlans)
+			c.collectCopPlans(x.TablePlans)
+		}
+	}
+	for _, child := range p.Children() {
+		c.collect(child)
+	}
+}
+
+// collectCopPlans compares the operators of a cop task, whose head is the scan.
+func (c *feedbackCollector) collectCopPlans(plans []PhysicalPlan) {
+	if len(plans) == 0 {
+		return
+	}
+	var (
+		tbl    *model.TableInfo
+		dbName model.CIStr
+	)
+	switch scan := plans[0].(type) {
+	case *PhysicalTableScan:
+		tbl, dbName = scan.Table, scan.DBName
+	case *PhysicalIndexScan:
+		tbl, dbName = scan.Table, scan.DBName
+	default:
+		return
+	}
+	for _, p := range plans {
+		if _, ok := p.(*PhysicalLimit); ok {
+			return
+		}
+	}
+	for _, p := range plans {
+		var predicate string
+		switch x := p.(type) {
+		case *PhysicalTableScan:
+			predicate = x.OperatorInfo(false)
+		case *PhysicalIndexScan:
+			predicate = x.OperatorInfo(false)
+		case *PhysicalSelection:
+			predicate = x.ExplainInfo()
+		default:
+			continue
+		}
+		// The operator hasn't been executed.
+		if !c.statsColl.ExistsCopStats(p.ID()) {
+			continue
+		}
+		estRows := p.getEstRowCountForDisplay()
+		actRows := c.statsColl.GetCopStats(p.ID()).GetActRows()
+		if !statistics.IsSignificantMisestimate(estRows, actRows) {
+			continue
+		}
+		c.feedback = append(c.feedback, &statistics.QueryFeedback{
+			LastSeen:  c.now,
+			DBName:    dbName.O,
+			TableName: tbl.Name.O,
+			Operator:  p.ExplainID().String(),
+			Predicate: predicate,
+			TableID:   tbl.ID,
+			EstRows:   estRows,
+			ActRows:   actRows,
+		})
+	}
+}
@@ -163,6 +163,13 @@ func (p *LogicalProjection) pushDownTopN(topN *LogicalTopN, opt *logicalOptimize
 	return p
 }
 
+func (ds *DataSource) pushDownTopN(topN *LogicalTopN, opt *logicalOptimizeOp) LogicalPlan {
+	if topN != nil {
+		ds.vectorSearch = ds.extractVectorSearch(topN)
+	}
+	return ds.baseLogicalPlan.pushDownTopN(topN, opt)
+}
+
 func (p *LogicalLock) pushDownTopN(topN *LogicalTopN, opt *logicalOptimizeOp) LogicalPlan {
 	if topN != nil {
 		p.children[0] = p.children[0].pushDownTopN(topN, opt)
@@ -63,6 +63,23 @@ func (p *LogicalTableDual) DeriveStats(_ []*property.StatsInfo, selfSchema *expr
 	return p.StatsInfo(), nil
 }
 
+// DeriveStats implement LogicalPlan DeriveStats interface.
+func (p *LogicalJSONTable) DeriveStats(_ []*property.StatsInfo, selfSchema *expression.Schema, _ []*expression.Schema, _ [][]*expression.Column) (*property.StatsInfo, error) {
+	if p.StatsInfo() != nil {
+		return p.StatsInfo(), nil
+	}
+	// The count of rows depends on the JSON document, so we use a fixed estimation here.
+	profile := &property.StatsInfo{
+		RowCount: jsonTableRowCount,
+		ColNDVs:  make(map[int64]float64, selfSchema.Len()),
+	}
+	for _, col := range selfSchema.Columns {
+		profile.ColNDVs[col.UniqueID] = jsonTableRowCount
+	}
+	p.SetStats(profile)
+	return p.StatsInfo(), nil
+}
+
 // DeriveStats implement LogicalPlan DeriveStats interface.
 func (p *LogicalMemTable) DeriveStats(_ []*property.StatsInfo, selfSchema *expression.Schema, _ []*expression.Schema, _ [][]*expression.Column) (*property.StatsInfo, error) {
 	if p.StatsInfo() != nil {
@@ -461,6 +478,14 @@ func (ds *DataSource) DeriveStats(_ []*property.StatsInfo, _ *expression.Schema,
 	// TODO: Can we move ds.deriveStatsByFilter after pruning by heuristics? In this way some computation can be avoided
 	// when ds.possibleAccessPaths are pruned.
 	ds.SetStats(ds.deriveStatsByFilter(ds.pushedDownConds, ds.possibleAccessPaths))
+	hintFactor := 1.0
+	if hint := ds.cardinalityHint(); hint != nil {
+		estRowCount := ds.StatsInfo().RowCount
+		ds.SetStats(scaleStatsByCardinalityHint(ds.StatsInfo(), hint))
+		if estRowCount > 0 {
+			hintFactor = ds.StatsInfo().RowCount / estRowCount
+		}
+	}
 	err := ds.derivePathStatsAndTryHeuristics()
 	if err != nil {
 		return nil, err
@@ -469,6 +494,11 @@ func (ds *DataSource) DeriveStats(_ []*property.StatsInfo, _ *expression.Schema,
 	if err := ds.generateIndexMergePath(); err != nil {
 		return nil, err
 	}
+	ds.generateFullTextPath()
+	ds.generateVectorPath()
+	if hintFactor != 1 {
+		ds.scalePathsByCardinalityHint(hintFactor)
+	}
 
 	if ds.SCtx().GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
 		debugTraceAccessPaths(ds.SCtx(), ds.possibleAccessPaths)
@@ -478,6 +508,52 @@ func (ds *DataSource) DeriveStats(_ []*property.StatsInfo, _ *expression.Schema,
 	return ds.StatsInfo(), nil
 }
 
+// cardinalityHint returns the CARDINALITY hint on the single table.
+func (ds *DataSource) cardinalityHint() *cardinalityHintInfo {
+	var result *cardinalityHintInfo
+	for _, hint := range ds.cardinalityHints {
+		if len(hint.tables) == 1 {
+			result = hint
+		}
+	}
+	return result
+}
+
+// scaleStatsByCardinalityHint scales the stats to the row count overridden by the CARDINALITY hint. The NDVs are
+// kept if the row count is increased.
+func scaleStatsByCardinalityHint(stats *property.StatsInfo, hint *cardinalityHintInfo) *property.StatsInfo {
+	rowCount := hint.apply(stats.RowCount)
+	if rowCount < stats.RowCount {
+		return stats.Scale(rowCount / stats.RowCount)
+	}
+	newStats := *stats
+	newStats.RowCount = rowCount
+	return &newStats
+}
+
+// scalePathsByCardinalityHint scales the row counts of the access paths by the same factor as the stats scaled by the
+// CARDINALITY hint, so that the access path is chosen by the hinted row count. The scaled counts never exceed the row
+// count of the table.
+func (ds *DataSource) scalePathsByCardinalityHint(factor float64) {
+	scaled := make(map[*util.AccessPath]struct{}, len(ds.possibleAccessPaths))
+	var scalePath func(path *util.AccessPath)
+	scalePath = func(path *util.AccessPath) {
+		// The partial paths may be shared by several index merge paths.
+		if _, ok := scaled[path]; ok {
+			return
+		}
+		scaled[path] = struct{}{}
+		path.CountAfterAccess = math.Min(path.CountAfterAccess*factor, ds.tableStats.RowCount)
+		path.CountAfterIndex = math.Min(path.CountAfterIndex*factor, ds.tableStats.RowCount)
+		for _, partialPath := range path.PartialIndexPaths {
+			scalePath(partialPath)
+		}
+	}
+	for _, path := range ds.possibleAccessPaths {
+		scalePath(path)
+	}
+}
+
 func getMinSelectivityFromPaths(paths []*util.AccessPath, totalRowCount float64) float64 {
 	minSelectivity := 1.0
 	if totalRowCount <= 0 {
@@ -810,6 +886,9 @@ func (p *LogicalJoin) DeriveStats(childStats []*property.StatsInfo, selfSchema *
 	} else if p.JoinType == RightOuterJoin {
 		count = math.Max(count, rightProfile.RowCount)
 	}
+	if hint := p.cardinalityHint(); hint != nil {
+		count = hint.apply(count)
+	}
 	colNDVs := make(map[int64]float64, selfSchema.Len())
 	for id, c := range leftProfile.ColNDVs {
 		colNDVs[id] = math.Min(c, count)
@@ -825,6 +904,51 @@ func (p *LogicalJoin) DeriveStats(childStats []*property.StatsInfo, selfSchema *
 	return p.StatsInfo(), nil
 }
 
+// cardinalityHint returns the CARDINALITY hint on exactly the tables joined by the join.
+func (p *LogicalJoin) cardinalityHint() *cardinalityHintInfo {
+	dataSources, ok := collectJoinedDataSources(p, nil)
+	// The children of the join are kept in the memo groups instead of the plan in the cascades planner.
+	if !ok || len(dataSources) == 0 {
+		return nil
+	}
+	var result *cardinalityHintInfo
+	for _, hint := range dataSources[0].cardinalityHints {
+		if len(hint.tables) != len(dataSources) {
+			continue
+		}
+		matched := true
+		for _, ds := range dataSources[1:] {
+			if !slices.Contains(ds.cardinalityHints, hint) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			result = hint
+		}
+	}
+	return result
+}
+
+// collectJoinedDataSources collects the DataSources joined by the joins. It returns false if any input of the joins
+// isn't a DataSource.
+func collectJoinedDataSources(p LogicalPlan, dataSources []*DataSource) ([]*DataSource, bool) {
+	switch x := p.(type) {
+	case *DataSource:
+		return append(dataSources, x), true
+	case *LogicalJoin, *LogicalSelection, *LogicalProjection:
+		var ok bool
+		for _, child := range x.Children() {
+			dataSources, ok = collectJoinedDataSources(child, dataSources)
+			if !ok {
+				return nil, false
+			}
+		}
+		return dataSources, true
+	}
+	return nil, false
+}
+
 // ExtractColGroups implements LogicalPlan ExtractColGroups interface.
 func (p *LogicalJoin) ExtractColGroups(colGroups [][]*expression.Column) [][]*expression.Column {
 	leftJoinKeys, rightJoinKeys, _, _ := p.GetJoinKeys()
@@ -231,6 +231,8 @@ func toString(in Plan, strs []string, idxs []int) ([]string, []int) {
 		str = fmt.Sprintf("TopN(%v,%d,%d)", x.ByItems, x.Offset, x.Count)
 	case *LogicalTableDual, *PhysicalTableDual:
 		str = "Dual"
+	case *LogicalJSONTable, *PhysicalJSONTable:
+		str = "JSONTable"
 	case *PhysicalHashAgg:
 		str = "HashAgg"
 	case *PhysicalStreamAgg:
@@ -259,6 +261,10 @@ func toString(in Plan, strs []string, idxs []int) ([]string, []int) {
 			str += ToString(paritalPlan)
 		}
 		str += "], TablePlan->" + ToString(x.tablePlan) + ")"
+	case *PhysicalFullTextIndexReader:
+		str = fmt.Sprintf("FullTextIndexReader(%s)", x.Match.Index.Name.O)
+	case *PhysicalVectorIndexReader:
+		str = fmt.Sprintf("VectorIndexReader(%s)", x.Index.Name.O)
 	case *PhysicalUnionScan:
 		str = fmt.Sprintf("UnionScan(%s)", x.Conditions)
 	case *PhysicalIndexJoin:
@@ -0,0 +1,128 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"math"
+
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/planner/property"
+	"github.com/pingcap/tidb/planner/util"
+	"github.com/pingcap/tidb/util/ranger"
+	"github.com/pingcap/tidb/util/vectorindex"
+	"golang.org/x/exp/slices"
+)
+
+// extractVectorSearch returns the nearest neighbor search of the TopN pushed down to this DataSource,
+// which orders the rows by the distance between a vector column and a constant vector. The search
+// can't be served by a vector index if there is any filter, since the filtered rows are not the
+// nearest neighbors anymore.
+func (ds *DataSource) extractVectorSearch(topN *LogicalTopN) *util.VectorSearch {
+	if len(topN.ByItems) != 1 || topN.ByItems[0].Desc || len(topN.PartitionBy) > 0 || len(ds.allConds) > 0 {
+		return nil
+	}
+	distance, ok := expression.ExtractVectorDistance(ds.SCtx(), topN.ByItems[0].Expr)
+	if !ok || !ds.schema.Contains(distance.Column) {
+		return nil
+	}
+	return &util.VectorSearch{Distance: distance, TopK: topN.Offset + topN.Count}
+}
+
+// generateVectorPath generates a vector index AccessPath on this DataSource for the nearest neighbor
+// search of the TopN, the vector index must be built on the column for the same distance function.
+func (ds *DataSource) generateVectorPath() {
+	search := ds.vectorSearch
+	if search == nil || search.TopK == 0 || ds.isPartition || ds.tableInfo.GetPartitionInfo() != nil ||
+		ds.SampleInfo != nil || ds.tableInfo.TempTableType != model.TempTableNone ||
+		ds.tableInfo.TableCacheStatusType != model.TableCacheStatusDisable {
+		return
+	}
+	metric, ok := vectorindex.MetricOfDistanceFunc(search.Distance.FuncName)
+	if !ok || len(search.Distance.Query) != search.Distance.Column.RetType.GetFlen() {
+		return
+	}
+	for _, idx := range ds.tableInfo.Indices {
+		if idx.Tp != model.IndexTypeHNSW || idx.State != model.StatePublic || idx.VectorDistanceMetric != metric {
+			continue
+		}
+		if ds.tableInfo.Columns[idx.Columns[0].Offset].ID != search.Distance.Column.ID {
+			continue
+		}
+		rowCount := math.Min(float64(search.TopK), ds.StatsInfo().RowCount)
+		ds.possibleAccessPaths = append(ds.possibleAccessPaths, &util.AccessPath{
+			Index:            idx,
+			VectorSearch:     search,
+			StoreType:        kv.TiKV,
+			CountAfterAccess: rowCount,
+			CountAfterIndex:  rowCount,
+		})
+		return
+	}
+}
+
+// hasVectorPath returns whether the DataSource can be accessed by a vector index.
+func (ds *DataSource) hasVectorPath() bool {
+	for _, path := range ds.possibleAccessPaths {
+		if path.VectorSearch != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// convertToVectorIndexReader converts the vector index path to a PhysicalVectorIndexReader, which reads
+// the approximate nearest rows in the order of distance. The TopN is kept above the reader to sort the
+// rows by the exact distances.
+func (ds *DataSource) convertToVectorIndexReader(prop *property.PhysicalProperty, candidate *candidatePath) task {
+	if prop.TaskTp != property.RootTaskType || !prop.IsSortItemEmpty() {
+		return invalidTask
+	}
+	path := candidate.path
+	ts := PhysicalTableScan{
+		Table:           ds.tableInfo,
+		Columns:         slices.Clone(ds.Columns),
+		TableAsName:     ds.TableAsName,
+		DBName:          ds.DBName,
+		physicalTableID: ds.physicalTableID,
+		Ranges:          ranger.FullRange(),
+		StoreType:       kv.TiKV,
+		HandleCols:      ds.handleCols,
+		tblCols:         ds.TblCols,
+		tblColHists:     ds.TblColHists,
+		prop:            prop,
+	}.Init(ds.SCtx(), ds.SelectBlockOffset())
+	ts.SetSchema(ds.schema.Clone())
+	stats := ds.tableStats.ScaleByExpectCnt(path.CountAfterAccess)
+	ts.SetStats(stats)
+	tableReader := PhysicalTableReader{
+		tablePlan:      ts,
+		StoreType:      kv.TiKV,
+		IsCommonHandle: ds.tableInfo.IsCommonHandle,
+	}.Init(ds.SCtx(), ds.SelectBlockOffset())
+	tableReader.SetStats(stats)
+
+	reader := PhysicalVectorIndexReader{
+		Table:       ds.tableInfo,
+		TableAsName: ds.TableAsName,
+		Index:       path.Index,
+		Distance:    path.VectorSearch.Distance,
+		TopK:        path.VectorSearch.TopK,
+		TableReader: tableReader,
+	}.Init(ds.SCtx(), stats, ds.SelectBlockOffset())
+	reader.SetSchema(ds.schema)
+	return &rootTask{p: reader}
+}
@@ -15,6 +15,7 @@
 package implementation
 
 import (
+	"github.com/pingcap/tidb/expression"
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/planner/memo"
 )
@@ -32,10 +33,25 @@ func NewProjectionImpl(proj *plannercore.PhysicalProjection) *ProjectionImpl {
 // CalcCost implements Implementation CalcCost interface.
 func (impl *ProjectionImpl) CalcCost(_ float64, children ...memo.Implementation) float64 {
 	proj := impl.plan.(*plannercore.PhysicalProjection)
+	// The columns are shallow copied from the child without evaluation, e.g. the Projection which keeps the column
+	// order of the reordered joins.
+	if isColumnsOnly(proj.Exprs) {
+		impl.cost = children[0].GetCost()
+		return impl.cost
+	}
 	impl.cost = proj.GetCost(children[0].GetPlan().StatsInfo().RowCount) + children[0].GetCost()
 	return impl.cost
 }
 
+func isColumnsOnly(exprs []expression.Expression) bool {
+	for _, expr := range exprs {
+		if _, ok := expr.(*expression.Column); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // ShowImpl is the Implementation of PhysicalShow.
 type ShowImpl struct {
 	baseImpl
@@ -411,6 +411,45 @@ func OptimizeForForeignKeyCascade(ctx context.Context, sctx sessionctx.Context,
 	return p, nil
 }
 
+// OptimizeForTrigger does optimization for the statements in the body of a trigger. Like the foreign key cascades,
+// the privileges are not checked since the statements are executed on behalf of the triggering statement.
+func OptimizeForTrigger(ctx context.Context, sctx sessionctx.Context, node ast.StmtNode, is infoschema.InfoSchema) (core.Plan, types.NameSlice, error) {
+	return optimizeRoutineStmt(ctx, sctx, node, is, false)
+}
+
+// OptimizeForStoredFunction does optimization for the statements in the body of a stored function. Unlike the
+// triggers, the privileges are checked since the stored functions are executed with the privileges of the invoker.
+func OptimizeForStoredFunction(ctx context.Context, sctx sessionctx.Context, node ast.StmtNode, is infoschema.InfoSchema) (core.Plan, types.NameSlice, error) {
+	return optimizeRoutineStmt(ctx, sctx, node, is, true)
+}
+
+func optimizeRoutineStmt(ctx context.Context, sctx sessionctx.Context, node ast.StmtNode, is infoschema.InfoSchema, checkPrivilege bool) (core.Plan, types.NameSlice, error) {
+	builder := planBuilderPool.Get().(*core.PlanBuilder)
+	defer planBuilderPool.Put(builder.ResetForReuse())
+	hintProcessor := &hint.BlockHintProcessor{Ctx: sctx}
+	builder.Init(sctx, is, hintProcessor)
+	p, err := builder.Build(ctx, node)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pm := privilege.GetPrivilegeManager(sctx); pm != nil && checkPrivilege {
+		visitInfo := core.VisitInfo4PrivCheck(is, node, builder.GetVisitInfo())
+		if err := core.CheckPrivilege(sctx.GetSessionVars().ActiveRoles, pm, visitInfo); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := core.CheckTableLock(sctx, is, builder.GetVisitInfo()); err != nil {
+		return nil, nil, err
+	}
+	names := p.OutputNames()
+	logic, isLogicalPlan := p.(core.LogicalPlan)
+	if !isLogicalPlan {
+		return p, names, nil
+	}
+	finalPlan, _, err := core.DoOptimize(ctx, sctx, builder.GetOptFlag(), logic)
+	return finalPlan, names, err
+}
+
 func allowInReadOnlyMode(sctx sessionctx.Context, node ast.Node) (bool, error) {
 	pm := privilege.GetPrivilegeManager(sctx)
 	if pm == nil {
@@ -515,8 +554,9 @@ func optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 		return p, names, 0, nil
 	}
 
-	// Handle the logical plan statement, use cascades planner if enabled.
-	if sessVars.GetEnableCascadesPlanner() {
+	// Handle the logical plan statement, use cascades planner if enabled and supported, otherwise fall back to the
+	// default planner.
+	if sessVars.GetEnableCascadesPlanner() && cascades.DefaultOptimizer.Supports(logic) {
 		finalPlan, cost, err := cascades.DefaultOptimizer.FindBestPlan(sctx, logic)
 		return finalPlan, names, cost, err
 	}
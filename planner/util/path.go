@@ -57,6 +57,10 @@ type AccessPath struct {
 	IndexMergeIsIntersection bool
 	// IndexMergeAccessMVIndex indicates whether this IndexMerge path accesses a MVIndex.
 	IndexMergeAccessMVIndex bool
+	// FullTextCond is the MATCH ... AGAINST condition of a full-text index path, whose Index is the full-text index.
+	FullTextCond expression.Expression
+	// VectorSearch is the nearest neighbor search of a vector index path, whose Index is the vector index.
+	VectorSearch *VectorSearch
 
 	StoreType kv.StoreType
 
@@ -76,6 +80,15 @@ type AccessPath struct {
 	IsUkShardIndexPath bool
 }
 
+// VectorSearch is the nearest neighbor search of `ORDER BY distance LIMIT k`, which reads the k rows
+// nearest to a constant vector.
+type VectorSearch struct {
+	// Distance is the distance function between the vector column and the constant vector.
+	Distance *expression.VectorDistance
+	// TopK is the number of the nearest rows to read.
+	TopK uint64
+}
+
 // Clone returns a deep copy of the original AccessPath.
 // Note that we rely on the Expression.Clone(), (*IndexInfo).Clone() and (*Range).Clone() in this method, so there are
 // some fields like FieldType are not deep-copied.
@@ -106,10 +119,14 @@ func (path *AccessPath) Clone() *AccessPath {
 		ForceNoKeepOrder:         path.ForceNoKeepOrder,
 		IsSingleScan:             path.IsSingleScan,
 		IsUkShardIndexPath:       path.IsUkShardIndexPath,
+		VectorSearch:             path.VectorSearch,
 	}
 	for _, partialPath := range path.PartialIndexPaths {
 		ret.PartialIndexPaths = append(ret.PartialIndexPaths, partialPath.Clone())
 	}
+	if path.FullTextCond != nil {
+		ret.FullTextCond = path.FullTextCond.Clone()
+	}
 	return ret
 }
 
@@ -1890,6 +1890,10 @@ func TestClusterConfigInfoschema(t *testing.T) {
 	require.Error(t, err)
 	require.EqualError(t, err, "[planner:1227]Access denied; you need (at least one of) the PROCESS privilege(s) for this operation")
 
+	err = tk.QueryToErr("SELECT * FROM information_schema.instance_plan_cache")
+	require.Error(t, err)
+	require.EqualError(t, err, "[planner:1227]Access denied; you need (at least one of) the PROCESS privilege(s) for this operation")
+
 	// With correct/CONFIG permissions
 	tk.Session().Auth(&auth.UserIdentity{
 		Username: "ccconfig",
@@ -1924,6 +1928,7 @@ func TestClusterConfigInfoschema(t *testing.T) {
 	tk.MustQuery("SELECT * FROM information_schema.CLUSTER_load")
 	tk.MustQuery("SELECT * FROM information_schema.CLUSTER_systeminfo")
 	tk.MustQuery("SELECT * FROM information_schema.CLUSTER_log WHERE time BETWEEN '1970-07-13 00:00:00' AND '1970-07-13 02:00:00' AND message like '%'")
+	tk.MustQuery("SELECT * FROM information_schema.INSTANCE_PLAN_CACHE")
 	// Missing CONFIG privilege
 	err = tk.QueryToErr("SELECT * FROM information_schema.CLUSTER_config")
 	require.Error(t, err)
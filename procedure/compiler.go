@@ -0,0 +1,702 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/opcode"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+// scope contains the variables and cursors declared in a `BEGIN ... END` block.
+type scope struct {
+	vars    map[string]int
+	cursors map[string]int
+}
+
+// label is a label of a block or a loop.
+type label struct {
+	name   string
+	isLoop bool
+	// start is the target of `ITERATE`.
+	start int
+	// leaves are the jumps of `LEAVE`, whose destinations are filled when the labeled statement is compiled.
+	leaves []*jumpInst
+}
+
+type compiler struct {
+	proc   *Procedure
+	scopes []*scope
+	labels []*label
+	// depth is the nesting depth of the block being compiled.
+	depth int
+	// trigger is the trigger being compiled, it's nil if a procedure is being compiled.
+	trigger *ast.CreateTriggerStmt
+	// hasReturn is whether there's a `RETURN` statement in the stored function being compiled.
+	hasReturn bool
+	// err is the first error found in binding the statements.
+	err error
+}
+
+// Compile compiles the `CREATE PROCEDURE` statement. The statements in the body are bound to the local variables
+// of the procedure, so the statement can't be used again after it's compiled.
+func Compile(stmt *ast.ProcedureInfo) (*Procedure, error) {
+	return compileRoutine(stmt, nil)
+}
+
+// compileRoutine compiles the `CREATE PROCEDURE` or `CREATE FUNCTION` statement, `retType` is the return type of
+// the function and it's nil for the procedure.
+func compileRoutine(stmt *ast.ProcedureInfo, retType *types.FieldType) (*Procedure, error) {
+	name := stmt.ProcedureName.Name.O
+	if stmt.ProcedureName.Schema.O != "" {
+		name = stmt.ProcedureName.Schema.O + "." + name
+	}
+	c := &compiler{proc: &Procedure{name: name, retType: retType}}
+
+	params := &scope{vars: make(map[string]int)}
+	for _, p := range stmt.ProcedureParam {
+		paramName := strings.ToLower(p.ParamName)
+		if _, ok := params.vars[paramName]; ok {
+			return nil, exeerrors.ErrSpDupParam.GenWithStackByArgs(p.ParamName)
+		}
+		slot := c.addVar(paramName, p.ParamType)
+		params.vars[paramName] = slot
+		c.proc.params = append(c.proc.params, &param{name: p.ParamName, mode: p.Paramstatus, slot: slot})
+	}
+	c.scopes = append(c.scopes, params)
+
+	if err := c.compileStmt(stmt.ProcedureBody); err != nil {
+		return nil, err
+	}
+	if retType != nil && !c.hasReturn {
+		return nil, exeerrors.ErrSpNoreturn.GenWithStackByArgs(name)
+	}
+	return c.proc, nil
+}
+
+func (c *compiler) pc() int {
+	return len(c.proc.code)
+}
+
+func (c *compiler) emit(inst instruction) {
+	c.proc.code = append(c.proc.code, inst)
+}
+
+func (c *compiler) addVar(name string, tp *types.FieldType) int {
+	c.proc.vars = append(c.proc.vars, &localVar{name: name, tp: tp})
+	return len(c.proc.vars) - 1
+}
+
+// lookupVar finds the variable by name from the innermost scope.
+func (c *compiler) lookupVar(name string) (int, bool) {
+	for i := len(c.scopes) - 1; i >= 0; i-- {
+		if slot, ok := c.scopes[i].vars[name]; ok {
+			return slot, true
+		}
+	}
+	return 0, false
+}
+
+func (c *compiler) lookupCursor(name string) (int, error) {
+	for i := len(c.scopes) - 1; i >= 0; i-- {
+		if idx, ok := c.scopes[i].cursors[name]; ok {
+			return idx, nil
+		}
+	}
+	return 0, exeerrors.ErrSpCursorMismatch.GenWithStackByArgs(name)
+}
+
+func (c *compiler) lookupLabel(name string) *label {
+	for i := len(c.labels) - 1; i >= 0; i-- {
+		if strings.EqualFold(c.labels[i].name, name) {
+			return c.labels[i]
+		}
+	}
+	return nil
+}
+
+// pushLabel pushes the label of a labeled statement, whose start is the current position.
+func (c *compiler) pushLabel(info ast.LabelInfo) (*label, error) {
+	if end, mismatch := info.GetErrorStatus(); mismatch {
+		return nil, exeerrors.ErrSpLabelMismatch.GenWithStackByArgs(end)
+	}
+	name := info.GetLabelName()
+	if c.lookupLabel(name) != nil {
+		return nil, exeerrors.ErrSpLabelRedefine.GenWithStackByArgs(name)
+	}
+	l := &label{name: name, isLoop: !info.IsBlock(), start: c.pc()}
+	c.labels = append(c.labels, l)
+	return l, nil
+}
+
+// popLabel pops the label and makes the `LEAVE` statements jump to the current position.
+func (c *compiler) popLabel() {
+	l := c.labels[len(c.labels)-1]
+	c.labels = c.labels[:len(c.labels)-1]
+	for _, jump := range l.leaves {
+		jump.dest = c.pc()
+	}
+}
+
+func (c *compiler) compileStmts(stmts []ast.StmtNode) error {
+	for _, stmt := range stmts {
+		if err := c.compileStmt(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *compiler) compileStmt(stmt ast.StmtNode) error {
+	switch x := stmt.(type) {
+	case *ast.ProcedureBlock:
+		return c.compileBlock(x)
+	case *ast.ProcedureLabelBlock:
+		if _, err := c.pushLabel(x); err != nil {
+			return err
+		}
+		if err := c.compileBlock(x.Block); err != nil {
+			return err
+		}
+		c.popLabel()
+		return nil
+	case *ast.ProcedureLabelLoop:
+		l, err := c.pushLabel(x)
+		if err != nil {
+			return err
+		}
+		if err = c.compileLoop(x.Block, l); err != nil {
+			return err
+		}
+		c.popLabel()
+		return nil
+	case *ast.ProcedureWhileStmt, *ast.ProcedureRepeatStmt:
+		return c.compileLoop(x, nil)
+	case *ast.ProcedureIfInfo:
+		end := &jumpTarget{}
+		if err := c.compileIf(x.IfBody, end); err != nil {
+			return err
+		}
+		end.resolve(c.pc())
+		return nil
+	case *ast.SimpleCaseStmt:
+		return c.compileSimpleCase(x)
+	case *ast.SearchCaseStmt:
+		whens := make([]*exprEval, 0, len(x.WhenCases))
+		bodies := make([][]ast.StmtNode, 0, len(x.WhenCases))
+		for _, when := range x.WhenCases {
+			whens = append(whens, c.bindExpr(when.Expr))
+			bodies = append(bodies, when.ProcedureStmts)
+		}
+		return c.compileCase(whens, bodies, x.ElseCases)
+	case *ast.ProcedureJump:
+		return c.compileJump(x)
+	case *ast.ProcedureOpenCur:
+		idx, err := c.lookupCursor(x.CurName)
+		if err != nil {
+			return err
+		}
+		c.emit(&openInst{cursor: idx})
+		return nil
+	case *ast.ProcedureFetchInto:
+		idx, err := c.lookupCursor(x.CurName)
+		if err != nil {
+			return err
+		}
+		slots := make([]int, 0, len(x.Variables))
+		for _, name := range x.Variables {
+			slot, ok := c.lookupVar(name)
+			if !ok {
+				return exeerrors.ErrSpUndeclaredVar.GenWithStackByArgs(name)
+			}
+			slots = append(slots, slot)
+		}
+		c.emit(&fetchInst{cursor: idx, slots: slots})
+		return nil
+	case *ast.ProcedureCloseCur:
+		idx, err := c.lookupCursor(x.CurName)
+		if err != nil {
+			return err
+		}
+		c.emit(&closeInst{cursor: idx})
+		return nil
+	case *ast.ProcedureReturn:
+		if c.proc.retType == nil {
+			return exeerrors.ErrSpBadreturn
+		}
+		c.hasReturn = true
+		c.emit(&returnInst{eval: c.bindExpr(x.Expr)})
+		return nil
+	case *ast.UseStmt:
+		return exeerrors.ErrSpBadstatement.GenWithStackByArgs("USE")
+	case *ast.SetStmt:
+		return c.compileSet(x)
+	case *ast.CallStmt:
+		return c.compileCall(x)
+	default:
+		if kind := c.routineKind(); kind != "" {
+			if err := checkRoutineStmt(kind, x); err != nil {
+				return err
+			}
+		}
+		c.emit(&stmtInst{eval: c.bindStmt(x)})
+		return nil
+	}
+}
+
+// routineKind returns the kind of the routine being compiled in which the statements are restricted, it's empty
+// for the procedures.
+func (c *compiler) routineKind() string {
+	switch {
+	case c.trigger != nil:
+		return "trigger"
+	case c.proc.retType != nil:
+		return "function"
+	}
+	return ""
+}
+
+// checkRoutineStmt checks whether the statement is allowed in the body of a trigger or a stored function.
+func checkRoutineStmt(kind string, stmt ast.StmtNode) error {
+	switch stmt.(type) {
+	case *ast.SelectStmt, *ast.SetOprStmt, *ast.ExplainStmt, *ast.AnalyzeTableStmt:
+		return exeerrors.ErrSpNoRetset.GenWithStackByArgs(kind)
+	case *ast.CommitStmt, *ast.RollbackStmt, ast.DDLNode:
+		return exeerrors.ErrCommitNotAllowedInSfOrTrg
+	}
+	return nil
+}
+
+// compileBlock compiles a `BEGIN ... END` block. The variables are initialized when the block is entered, and the
+// handlers are compiled after the statements of the block:
+//
+//	reset the cursors
+//	initialize the variables
+//	statements
+//	jump end
+//	handler 1
+//	handler end
+//	...
+//	end: reset the cursors
+func (c *compiler) compileBlock(block *ast.ProcedureBlock) error {
+	s := &scope{vars: make(map[string]int), cursors: make(map[string]int)}
+	c.scopes = append(c.scopes, s)
+	c.depth++
+	defer func() {
+		c.scopes = c.scopes[:len(c.scopes)-1]
+		c.depth--
+	}()
+
+	resetCursors := &resetCursorsInst{}
+	c.emit(resetCursors)
+
+	var handlerDecls []*ast.ProcedureErrorControl
+	for _, decl := range block.ProcedureVars {
+		switch x := decl.(type) {
+		case *ast.ProcedureDecl:
+			if len(s.cursors) > 0 || len(handlerDecls) > 0 {
+				return exeerrors.ErrSpVarcondAfterCurshndlr
+			}
+			if err := c.compileDecl(s, x); err != nil {
+				return err
+			}
+		case *ast.ProcedureCursor:
+			if len(handlerDecls) > 0 {
+				return exeerrors.ErrSpCursorAfterHandler
+			}
+			if _, ok := s.cursors[x.CurName]; ok {
+				return exeerrors.ErrSpDupCurs.GenWithStackByArgs(x.CurName)
+			}
+			eval := c.bindStmt(x.Selectstring)
+			c.proc.cursors = append(c.proc.cursors, &cursorDef{name: x.CurName, eval: eval})
+			idx := len(c.proc.cursors) - 1
+			s.cursors[x.CurName] = idx
+			resetCursors.cursors = append(resetCursors.cursors, idx)
+		case *ast.ProcedureErrorControl:
+			handlerDecls = append(handlerDecls, x)
+		}
+	}
+
+	// The handlers are in effect for the statements of the block.
+	handlers := make([]*handler, 0, len(handlerDecls))
+	for _, decl := range handlerDecls {
+		h, err := newHandler(decl, c.depth)
+		if err != nil {
+			return err
+		}
+		h.start = c.pc()
+		handlers = append(handlers, h)
+		c.proc.handlers = append(c.proc.handlers, h)
+	}
+
+	if err := c.compileStmts(block.ProcedureProcStmts); err != nil {
+		return err
+	}
+
+	if len(handlers) > 0 {
+		for _, h := range handlers {
+			h.end = c.pc()
+		}
+		end := &jumpInst{}
+		c.emit(end)
+
+		// The labels outside the handlers can't be referred by the statements of the handlers.
+		labels := c.labels
+		c.labels = nil
+		for i, h := range handlers {
+			h.entry = c.pc()
+			if err := c.compileStmt(handlerDecls[i].Operate); err != nil {
+				return err
+			}
+			c.emit(&handlerEndInst{handler: h})
+		}
+		c.labels = labels
+
+		end.dest = c.pc()
+		for _, h := range handlers {
+			h.exitPC = c.pc()
+		}
+	}
+	c.emit(&resetCursorsInst{cursors: resetCursors.cursors})
+	return nil
+}
+
+func (c *compiler) compileDecl(s *scope, decl *ast.ProcedureDecl) error {
+	var eval *exprEval
+	if decl.DeclDefault != nil {
+		eval = c.bindExpr(decl.DeclDefault)
+	}
+	for _, name := range decl.DeclNames {
+		if _, ok := s.vars[name]; ok {
+			return exeerrors.ErrSpDupVar.GenWithStackByArgs(name)
+		}
+		slot := c.addVar(name, decl.DeclType)
+		s.vars[name] = slot
+		c.emit(&setInst{slot: slot, eval: eval})
+	}
+	return nil
+}
+
+// compileLoop compiles a `WHILE` or `REPEAT` loop:
+//
+//	start: jump end if not condition		start: statements
+//	statements								jump start if not condition
+//	jump start								end:
+//	end:
+func (c *compiler) compileLoop(stmt ast.StmtNode, l *label) error {
+	start := c.pc()
+	if l != nil {
+		l.start = start
+	}
+
+	end := &jumpTarget{}
+	switch x := stmt.(type) {
+	case *ast.ProcedureWhileStmt:
+		cond := &jumpIfNotInst{eval: c.bindExpr(x.Condition)}
+		end.add(cond)
+		c.emit(cond)
+		if err := c.compileStmts(x.Body); err != nil {
+			return err
+		}
+		c.emit(&jumpInst{dest: start})
+	case *ast.ProcedureRepeatStmt:
+		if err := c.compileStmts(x.Body); err != nil {
+			return err
+		}
+		cond := &jumpIfNotInst{eval: c.bindExpr(x.Condition), dest: start}
+		end.addCont(cond)
+		c.emit(cond)
+	}
+	end.resolve(c.pc())
+	return nil
+}
+
+// compileIf compiles an `IF` statement, the branches jump to `end` after their statements are executed.
+func (c *compiler) compileIf(block *ast.ProcedureIfBlock, end *jumpTarget) error {
+	cond := &jumpIfNotInst{eval: c.bindExpr(block.IfExpr)}
+	end.addCont(cond)
+	c.emit(cond)
+	if err := c.compileStmts(block.ProcedureIfStmts); err != nil {
+		return err
+	}
+
+	if block.ProcedureElseStmt == nil {
+		cond.dest = c.pc()
+		return nil
+	}
+
+	jump := &jumpInst{}
+	end.add(jump)
+	c.emit(jump)
+	cond.dest = c.pc()
+	switch x := block.ProcedureElseStmt.(type) {
+	case *ast.ProcedureElseIfBlock:
+		return c.compileIf(x.ProcedureIfStmt, end)
+	case *ast.ProcedureElseBlock:
+		return c.compileStmts(x.ProcedureIfStmts)
+	}
+	return nil
+}
+
+// compileSimpleCase compiles a simple `CASE` statement. The case value is evaluated once and saved in an internal
+// variable, which is compared with the `WHEN` values.
+func (c *compiler) compileSimpleCase(stmt *ast.SimpleCaseStmt) error {
+	slot := c.addVar("", nil)
+	c.emit(&setInst{slot: slot, eval: c.bindExpr(stmt.Condition)})
+
+	whens := make([]*exprEval, 0, len(stmt.WhenCases))
+	bodies := make([][]ast.StmtNode, 0, len(stmt.WhenCases))
+	for _, when := range stmt.WhenCases {
+		value := newVarRef(slot)
+		eval := c.bindExpr(&ast.BinaryOperationExpr{Op: opcode.EQ, L: value.expr, R: when.Expr})
+		eval.refs = append(eval.refs, value)
+		whens = append(whens, eval)
+		bodies = append(bodies, when.ProcedureStmts)
+	}
+	return c.compileCase(whens, bodies, stmt.ElseCases)
+}
+
+// compileCase compiles the `WHEN` branches of a `CASE` statement, `ErrSpCaseNotFound` is raised if none of the
+// branches is matched and there's no `ELSE` branch.
+func (c *compiler) compileCase(whens []*exprEval, bodies [][]ast.StmtNode, elseStmts []ast.StmtNode) error {
+	end := &jumpTarget{}
+	for i, when := range whens {
+		cond := &jumpIfNotInst{eval: when}
+		end.addCont(cond)
+		c.emit(cond)
+		if err := c.compileStmts(bodies[i]); err != nil {
+			return err
+		}
+		jump := &jumpInst{}
+		end.add(jump)
+		c.emit(jump)
+		cond.dest = c.pc()
+	}
+
+	if elseStmts != nil {
+		if err := c.compileStmts(elseStmts); err != nil {
+			return err
+		}
+	} else {
+		c.emit(&raiseInst{err: exeerrors.ErrSpCaseNotFound})
+	}
+	end.resolve(c.pc())
+	return nil
+}
+
+func (c *compiler) compileJump(stmt *ast.ProcedureJump) error {
+	kind := "ITERATE"
+	if stmt.IsLeave {
+		kind = "LEAVE"
+	}
+
+	l := c.lookupLabel(stmt.Name)
+	if l == nil || (!stmt.IsLeave && !l.isLoop) {
+		return exeerrors.ErrSpLilabelMismatch.GenWithStackByArgs(kind, stmt.Name)
+	}
+
+	jump := &jumpInst{dest: l.start}
+	if stmt.IsLeave {
+		l.leaves = append(l.leaves, jump)
+	}
+	c.emit(jump)
+	return nil
+}
+
+// compileSet compiles a `SET` statement. The assignments to the local variables are executed by the procedure,
+// and the others are executed as `SET` statements in the session.
+func (c *compiler) compileSet(stmt *ast.SetStmt) error {
+	var others []*ast.VariableAssignment
+	flush := func() {
+		if len(others) > 0 {
+			c.emit(&stmtInst{eval: c.bindStmt(&ast.SetStmt{Variables: others})})
+			others = nil
+		}
+	}
+
+	for _, v := range stmt.Variables {
+		if v.IsSystem && !v.IsGlobal {
+			name := strings.ToLower(v.Name)
+			if table, column, ok := strings.Cut(name, "."); ok && c.trigger != nil && isRowRef(table) {
+				slot, err := c.lookupRowVar(table, column, true)
+				if err != nil {
+					return err
+				}
+				flush()
+				c.emit(&setInst{slot: slot, eval: c.bindExpr(v.Value)})
+				continue
+			}
+			if slot, ok := c.lookupVar(name); ok {
+				flush()
+				c.emit(&setInst{slot: slot, eval: c.bindExpr(v.Value)})
+				continue
+			}
+		}
+		others = append(others, v)
+	}
+	flush()
+	return nil
+}
+
+// compileCall compiles a `CALL` statement. The local variables passed as the arguments are passed by the user
+// variables, so they receive the values of the OUT and INOUT parameters like the user variables.
+func (c *compiler) compileCall(stmt *ast.CallStmt) error {
+	if kind := c.routineKind(); kind != "" {
+		return dbterror.ErrNotSupportedYet.GenWithStackByArgs("CALL in " + kind)
+	}
+	inst := &callInst{}
+	for i, arg := range stmt.Procedure.Args {
+		col, ok := arg.(*ast.ColumnNameExpr)
+		if !ok || col.Name.Table.L != "" {
+			continue
+		}
+		slot, ok := c.lookupVar(col.Name.Name.L)
+		if !ok {
+			continue
+		}
+		name := fmt.Sprintf("%s%d", callArgVarPrefix, slot)
+		stmt.Procedure.Args[i] = &ast.VariableExpr{Name: name}
+		inst.slots = append(inst.slots, slot)
+		inst.userVars = append(inst.userVars, name)
+	}
+	inst.eval = c.bindStmt(stmt)
+	c.emit(inst)
+	return nil
+}
+
+// bindStmt replaces the references of the local variables in the statement with constants, whose values are set
+// before the statement is executed.
+func (c *compiler) bindStmt(stmt ast.StmtNode) *exprEval {
+	if stmt.Text() == "" {
+		// The text of the statement is shown in the process list and the slow log.
+		var sb strings.Builder
+		if err := stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err == nil {
+			stmt.SetText(nil, sb.String())
+		}
+	}
+
+	b := &binder{compiler: c}
+	stmt.Accept(b)
+	// The flags of the expressions are used by the planner, for example, to detect the aggregate functions.
+	ast.SetFlag(stmt)
+	return &exprEval{stmt: stmt, refs: b.refs}
+}
+
+// bindExpr binds the expression, which is evaluated by a `SELECT` statement.
+func (c *compiler) bindExpr(expr ast.ExprNode) *exprEval {
+	b := &binder{compiler: c}
+	node, _ := expr.Accept(b)
+	eval := newExprEval(node.(ast.ExprNode), b.refs)
+	ast.SetFlag(eval.stmt)
+	return eval
+}
+
+// binder replaces the unqualified column names which refer to the local variables with constants.
+type binder struct {
+	compiler *compiler
+	refs     []*varRef
+}
+
+// Enter implements ast.Visitor interface.
+func (b *binder) Enter(n ast.Node) (ast.Node, bool) {
+	switch x := n.(type) {
+	case *ast.ValuesExpr:
+		// The argument of `VALUES()` is always a column.
+		return n, true
+	case *ast.SelectField:
+		// Name the field after the variable as MySQL does.
+		if col, ok := x.Expr.(*ast.ColumnNameExpr); ok && x.AsName.L == "" {
+			if _, ok := b.lookup(col); ok {
+				x.AsName = col.Name.Name
+			}
+		}
+	}
+	return n, false
+}
+
+// Leave implements ast.Visitor interface.
+func (b *binder) Leave(n ast.Node) (ast.Node, bool) {
+	col, ok := n.(*ast.ColumnNameExpr)
+	if !ok {
+		return n, true
+	}
+	slot, ok := b.lookup(col)
+	if !ok {
+		return n, true
+	}
+	ref := newVarRef(slot)
+	b.refs = append(b.refs, ref)
+	return ref.expr, true
+}
+
+func (b *binder) lookup(col *ast.ColumnNameExpr) (int, bool) {
+	c := b.compiler
+	if col.Name.Table.L == "" {
+		return c.lookupVar(col.Name.Name.L)
+	}
+	// `NEW.col` and `OLD.col` refer to the columns of the rows in triggers.
+	if col.Name.Schema.L != "" || c.trigger == nil || !isRowRef(col.Name.Table.L) {
+		return 0, false
+	}
+	slot, err := c.lookupRowVar(col.Name.Table.L, col.Name.Name.L, false)
+	if err != nil {
+		if c.err == nil {
+			c.err = err
+		}
+		return 0, false
+	}
+	return slot, true
+}
+
+// jumpTarget collects the jumps to the same position which is unknown when the jumps are emitted.
+type jumpTarget struct {
+	jumps []*jumpInst
+	conds []*jumpIfNotInst
+	conts []*jumpIfNotInst
+}
+
+func (t *jumpTarget) add(inst instruction) {
+	switch x := inst.(type) {
+	case *jumpInst:
+		t.jumps = append(t.jumps, x)
+	case *jumpIfNotInst:
+		t.conds = append(t.conds, x)
+		t.conts = append(t.conts, x)
+	}
+}
+
+// addCont adds a conditional jump whose continuation is the target, the continuation is where the execution
+// continues if an error occurred in evaluating the condition is handled by a `CONTINUE` handler.
+func (t *jumpTarget) addCont(inst *jumpIfNotInst) {
+	t.conts = append(t.conts, inst)
+}
+
+func (t *jumpTarget) resolve(pc int) {
+	for _, jump := range t.jumps {
+		jump.dest = pc
+	}
+	for _, cond := range t.conds {
+		cond.dest = pc
+	}
+	for _, cond := range t.conts {
+		cond.cont = pc
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedure
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/stretchr/testify/require"
+)
+
+func compile(t *testing.T, sql string) (*Procedure, error) {
+	stmt, err := parser.New().ParseOneStmt(sql, "", "")
+	require.NoError(t, err, sql)
+	create, ok := stmt.(*ast.ProcedureInfo)
+	require.True(t, ok, sql)
+	return Compile(create)
+}
+
+func TestCompileErrors(t *testing.T) {
+	cases := []struct {
+		sql string
+		err *terror.Error
+	}{
+		{"create procedure p(a int, A int) begin end", exeerrors.ErrSpDupParam},
+		{"create procedure p() begin declare a int; declare a int; end", exeerrors.ErrSpDupVar},
+		{"create procedure p() begin declare c cursor for select 1; declare c cursor for select 2; end", exeerrors.ErrSpDupCurs},
+		{"create procedure p() begin declare c cursor for select 1; declare a int; end", exeerrors.ErrSpVarcondAfterCurshndlr},
+		{"create procedure p() begin declare continue handler for sqlexception begin end; declare c cursor for select 1; end", exeerrors.ErrSpCursorAfterHandler},
+		{"create procedure p() begin open c; end", exeerrors.ErrSpCursorMismatch},
+		{"create procedure p() begin declare c cursor for select 1; fetch c into a; end", exeerrors.ErrSpUndeclaredVar},
+		{"create procedure p() begin leave l; end", exeerrors.ErrSpLilabelMismatch},
+		{"create procedure p() l: begin iterate l; end", exeerrors.ErrSpLilabelMismatch},
+		{"create procedure p() l: begin l: begin end; end", exeerrors.ErrSpLabelRedefine},
+		{"create procedure p() l1: begin end l2", exeerrors.ErrSpLabelMismatch},
+		{"create procedure p() begin use test; end", exeerrors.ErrSpBadstatement},
+		{"create procedure p() begin declare exit handler for sqlstate '00000' begin end; end", exeerrors.ErrSpBadSQLstate},
+	}
+	for _, c := range cases {
+		_, err := compile(t, c.sql)
+		require.Error(t, err, c.sql)
+		require.True(t, c.err.Equal(err), "%s: %v", c.sql, err)
+	}
+}
+
+func TestCompileControlFlow(t *testing.T) {
+	p, err := compile(t, `create procedure test.p(in a int, out b int)
+begin
+	declare i int default 0;
+	declare done int default 0;
+	declare c cursor for select a;
+	declare continue handler for not found set done = 1;
+	l: while i < a do
+		set i = i + 1;
+		if i = 2 then
+			iterate l;
+		elseif i > 5 then
+			leave l;
+		end if;
+	end while l;
+	set b = i;
+end`)
+	require.NoError(t, err)
+	require.Equal(t, "test.p", p.name)
+	require.Len(t, p.params, 2)
+	require.Equal(t, ast.MODE_OUT, p.params[1].mode)
+	require.Len(t, p.vars, 4)
+	require.Len(t, p.cursors, 1)
+	require.Len(t, p.handlers, 1)
+
+	h := p.handlers[0]
+	require.False(t, h.isExit)
+	require.Less(t, h.start, h.end)
+	require.GreaterOrEqual(t, h.entry, h.end)
+	_, ok := h.match(&sqlCondition{code: 1329, state: "02000", isError: true})
+	require.True(t, ok)
+	_, ok = h.match(&sqlCondition{code: 1146, state: "42S02", isError: true})
+	require.False(t, ok)
+
+	// All the jumps are resolved to the instructions in the procedure.
+	for _, inst := range p.code {
+		switch x := inst.(type) {
+		case *jumpInst:
+			require.LessOrEqual(t, x.dest, len(p.code))
+		case *jumpIfNotInst:
+			require.LessOrEqual(t, x.dest, len(p.code))
+			require.LessOrEqual(t, x.cont, len(p.code))
+		}
+	}
+}
+
+func TestCreateStmtText(t *testing.T) {
+	info := &model.ProcedureInfo{Name: model.NewCIStr("p1"), ParamList: "in a int", Body: "select a"}
+	require.Equal(t, "CREATE PROCEDURE `test`.`p1`(in a int)\nselect a", CreateStmtText(model.NewCIStr("test"), info))
+	require.Equal(t, "CREATE PROCEDURE `p1`(in a int)\nselect a", CreateStmtText(model.CIStr{}, info))
+
+	p, err := Load(model.NewCIStr("test"), info, 0)
+	require.NoError(t, err)
+	require.Equal(t, "test.p1", p.name)
+}
+
+func compileFunction(t *testing.T, sql string) (*Function, error) {
+	stmt, err := parser.New().ParseOneStmt(sql, "", "")
+	require.NoError(t, err, sql)
+	create, ok := stmt.(*ast.ProcedureInfo)
+	require.True(t, ok, sql)
+	return CompileFunction(create, "utf8mb4_bin")
+}
+
+func TestCompileFunction(t *testing.T) {
+	cases := []struct {
+		sql string
+		err *terror.Error
+	}{
+		{"create function f() returns int begin end", exeerrors.ErrSpNoreturn},
+		{"create function f() returns int begin select 1; return 1; end", exeerrors.ErrSpNoRetset},
+		{"create function f() returns int begin commit; return 1; end", exeerrors.ErrCommitNotAllowedInSfOrTrg},
+		{"create function f() returns int begin declare continue handler for sqlexception select 1; return 1; end", exeerrors.ErrSpNoRetset},
+		{"create function f(a int, a int) returns int return a", exeerrors.ErrSpDupParam},
+	}
+	for _, c := range cases {
+		_, err := compileFunction(t, c.sql)
+		require.Error(t, err, c.sql)
+		require.True(t, c.err.Equal(err), "%s: %v", c.sql, err)
+	}
+
+	// RETURN is only allowed in a function.
+	_, err := compile(t, "create procedure p() begin return 1; end")
+	require.True(t, exeerrors.ErrSpBadreturn.Equal(err), "%v", err)
+
+	f, err := compileFunction(t, "create function test.f(a int) returns varchar(10) begin declare c cursor for select a; if a > 1 then return 'a'; end if; return 'b'; end")
+	require.NoError(t, err)
+	require.Equal(t, "test.f", f.Name())
+	require.Equal(t, 1, f.NumParams())
+	require.Equal(t, mysql.TypeVarchar, f.RetType().GetType())
+	require.Equal(t, 10, f.RetType().GetFlen())
+	require.Equal(t, "utf8mb4", f.RetType().GetCharset())
+	require.Equal(t, "utf8mb4_bin", f.RetType().GetCollate())
+
+	f, err = compileFunction(t, "create function f() returns varchar(10) charset latin1 return 'a'")
+	require.NoError(t, err)
+	require.Equal(t, "latin1", f.RetType().GetCharset())
+	require.Equal(t, "latin1_bin", f.RetType().GetCollate())
+
+	f, err = compileFunction(t, "create function f() returns int return 1")
+	require.NoError(t, err)
+	require.Equal(t, 11, f.RetType().GetFlen())
+	require.Equal(t, "binary", f.RetType().GetCharset())
+}
+
+func TestFunctionCreateStmtText(t *testing.T) {
+	info := &model.ProcedureInfo{
+		Name:          model.NewCIStr("f1"),
+		ParamList:     "a int",
+		Returns:       "int",
+		Deterministic: true,
+		DataAccess:    "NO SQL",
+		Comment:       "it's f1",
+		Body:          "return a + 1",
+		SQLMode:       "STRICT_TRANS_TABLES",
+	}
+	require.Equal(t, "CREATE FUNCTION `test`.`f1`(a int) RETURNS int\n    DETERMINISTIC\n    NO SQL\n    COMMENT 'it\\'s f1'\nreturn a + 1", CreateStmtText(model.NewCIStr("test"), info))
+
+	f, err := LoadFunction(model.NewCIStr("test"), info)
+	require.NoError(t, err)
+	require.Equal(t, "test.f1", f.Name())
+	require.Equal(t, mysql.TypeLong, f.RetType().GetType())
+}
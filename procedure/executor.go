@@ -0,0 +1,294 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedure
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+// frame is an activation of a handler.
+type frame struct {
+	handler *handler
+	// returnPC is where the execution continues after a `CONTINUE` handler.
+	returnPC int
+}
+
+// executor executes the instructions of a procedure in a session.
+type executor struct {
+	proc *Procedure
+	se   Session
+	pc   int
+
+	vars     []types.Datum
+	varTypes []*types.FieldType
+	cursors  []*cursorState
+	frames   []frame
+
+	// warning is the first warning raised by the last executed statement.
+	warning *sqlCondition
+	results []sqlexec.RecordSet
+	// ret is the value returned by the stored function, returned is whether a `RETURN` statement is executed.
+	ret      types.Datum
+	returned bool
+}
+
+func newExecutor(proc *Procedure, se Session) *executor {
+	e := &executor{
+		proc:     proc,
+		se:       se,
+		vars:     make([]types.Datum, len(proc.vars)),
+		varTypes: make([]*types.FieldType, len(proc.vars)),
+		cursors:  make([]*cursorState, len(proc.cursors)),
+	}
+	for i := range e.cursors {
+		e.cursors[i] = &cursorState{}
+	}
+	return e
+}
+
+// run executes the instructions until the end of the procedure, or an error which is not handled by any handler.
+func (e *executor) run(ctx context.Context) error {
+	for e.pc < len(e.proc.code) {
+		pc := e.pc
+		e.pc++
+		e.warning = nil
+		err := e.proc.code[pc].execute(ctx, e)
+
+		cond := e.warning
+		if err != nil {
+			cond = newSQLCondition(err, true)
+		}
+		if cond == nil {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		h := e.findHandler(pc, cond)
+		if h == nil {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		e.frames = append(e.frames, frame{handler: h, returnPC: e.pc})
+		e.pc = h.entry
+	}
+	return nil
+}
+
+// findHandler finds the handler for the condition raised by the instruction at `pc`. The handlers declared in the
+// inner blocks take precedence, and then the handlers with more specific condition values.
+func (e *executor) findHandler(pc int, cond *sqlCondition) *handler {
+	var found *handler
+	foundPrecedence := 0
+	for _, h := range e.proc.handlers {
+		if pc < h.start || pc >= h.end {
+			continue
+		}
+		precedence, ok := h.match(cond)
+		if !ok {
+			continue
+		}
+		if found == nil || h.depth > found.depth || (h.depth == found.depth && precedence < foundPrecedence) {
+			found, foundPrecedence = h, precedence
+		}
+	}
+	return found
+}
+
+func (e *executor) varType(slot int) *types.FieldType {
+	if tp := e.proc.vars[slot].tp; tp != nil {
+		return tp
+	}
+	return e.varTypes[slot]
+}
+
+// assign assigns a value of type `tp` to the variable, the value is converted to the declared type of the variable.
+func (e *executor) assign(slot int, d types.Datum, tp *types.FieldType) error {
+	declared := e.proc.vars[slot].tp
+	if declared == nil {
+		e.vars[slot], e.varTypes[slot] = d, tp
+		return nil
+	}
+	if d.IsNull() {
+		e.vars[slot].SetNull()
+		return nil
+	}
+	converted, err := d.ConvertTo(e.se.GetSessionVars().StmtCtx, declared)
+	if err != nil {
+		return err
+	}
+	e.vars[slot] = converted
+	return nil
+}
+
+func (e *executor) bind(refs []*varRef) {
+	for _, ref := range refs {
+		ref.expr.Datum = e.vars[ref.slot]
+		if tp := e.varType(ref.slot); tp != nil {
+			ref.expr.SetType(tp)
+		} else {
+			types.DefaultTypeForValue(ref.expr.GetValue(), &ref.expr.Type, "", "")
+		}
+	}
+}
+
+// execStmt executes the statement with the values of the variables bound, the result set is buffered so the
+// session can execute other statements before the result set is consumed.
+func (e *executor) execStmt(ctx context.Context, eval *exprEval) (_ *resultSet, err error) {
+	e.bind(eval.refs)
+	// The statements of a trigger are executed in the statement context of the triggering statement, so only the
+	// warnings raised after the statement starts are checked.
+	sc := e.se.GetSessionVars().StmtCtx
+	warnCount := int(sc.WarningCount())
+	rs, err := e.se.ExecuteStmt(ctx, eval.stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *resultSet
+	if rs != nil {
+		defer terror.Call(rs.Close)
+		if result, err = drainRecordSet(ctx, rs); err != nil {
+			return nil, err
+		}
+	}
+
+	warnings := e.se.GetSessionVars().StmtCtx.GetWarnings()
+	if e.se.GetSessionVars().StmtCtx == sc {
+		warnings = warnings[min(warnCount, len(warnings)):]
+	}
+	for _, warn := range warnings {
+		if warn.Level != stmtctx.WarnLevelWarning {
+			continue
+		}
+		if e.warning = newSQLCondition(warn.Err, false); e.warning != nil {
+			break
+		}
+	}
+	return result, nil
+}
+
+// evalExpr evaluates the expression and returns the value and its type.
+func (e *executor) evalExpr(ctx context.Context, eval *exprEval) (types.Datum, *types.FieldType, error) {
+	rs, err := e.execStmt(ctx, eval)
+	if err != nil {
+		return types.Datum{}, nil, err
+	}
+	tp := &rs.Fields()[0].Column.FieldType
+	if len(rs.chunks) == 0 {
+		return types.Datum{}, tp, errors.New("no value is returned by the expression")
+	}
+	return rs.chunks[0].GetRow(0).GetDatum(0, tp), tp, nil
+}
+
+// cursorState is the state of a cursor at runtime.
+type cursorState struct {
+	isOpen bool
+	rs     *resultSet
+	row    int
+	chunk  int
+}
+
+func (c *cursorState) open(rs *resultSet) {
+	c.isOpen, c.rs, c.chunk, c.row = true, rs, 0, 0
+}
+
+func (c *cursorState) next() (chunk.Row, bool) {
+	for c.chunk < len(c.rs.chunks) {
+		chk := c.rs.chunks[c.chunk]
+		if c.row < chk.NumRows() {
+			c.row++
+			return chk.GetRow(c.row - 1), true
+		}
+		c.chunk, c.row = c.chunk+1, 0
+	}
+	return chunk.Row{}, false
+}
+
+func (c *cursorState) close() {
+	c.isOpen, c.rs = false, nil
+}
+
+// resultSet is a record set whose rows are buffered in memory.
+type resultSet struct {
+	fields []*ast.ResultField
+	chunks []*chunk.Chunk
+	cursor int
+}
+
+var _ sqlexec.RecordSet = &resultSet{}
+
+func drainRecordSet(ctx context.Context, rs sqlexec.RecordSet) (*resultSet, error) {
+	result := &resultSet{fields: rs.Fields()}
+	for {
+		chk := rs.NewChunk(nil)
+		if err := rs.Next(ctx, chk); err != nil {
+			return nil, err
+		}
+		if chk.NumRows() == 0 {
+			return result, nil
+		}
+		result.chunks = append(result.chunks, chk)
+	}
+}
+
+// Fields implements the sqlexec.RecordSet interface.
+func (r *resultSet) Fields() []*ast.ResultField {
+	return r.fields
+}
+
+// Next implements the sqlexec.RecordSet interface.
+func (r *resultSet) Next(_ context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if r.cursor < len(r.chunks) {
+		req.Append(r.chunks[r.cursor], 0, r.chunks[r.cursor].NumRows())
+		r.cursor++
+	}
+	return nil
+}
+
+// NewChunk implements the sqlexec.RecordSet interface.
+func (r *resultSet) NewChunk(alloc chunk.Allocator) *chunk.Chunk {
+	fields := make([]*types.FieldType, 0, len(r.fields))
+	maxChunkSize := 0
+	for _, field := range r.fields {
+		fields = append(fields, &field.Column.FieldType)
+	}
+	for _, chk := range r.chunks {
+		maxChunkSize = max(maxChunkSize, chk.NumRows())
+	}
+	maxChunkSize = max(maxChunkSize, 1)
+	if alloc != nil {
+		return alloc.Alloc(fields, 0, maxChunkSize)
+	}
+	return chunk.New(fields, maxChunkSize, maxChunkSize)
+}
+
+// Close implements the sqlexec.RecordSet interface.
+func (r *resultSet) Close() error {
+	r.cursor = 0
+	return nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedure
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+// Function is a compiled stored function. It's compiled like a procedure whose parameters are all IN parameters,
+// and the `RETURN` statement ends the execution with the value converted to the return type.
+type Function struct {
+	proc *Procedure
+}
+
+// LoadFunction parses and compiles the stored function stored in the schema.
+func LoadFunction(schema model.CIStr, info *model.ProcedureInfo) (*Function, error) {
+	sqlMode, err := mysql.GetSQLMode(info.SQLMode)
+	if err != nil {
+		return nil, err
+	}
+	p := parser.New()
+	p.SetSQLMode(sqlMode)
+	stmt, err := p.ParseOneStmt(CreateStmtText(schema, info), info.CharsetClient, info.CollationConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	create, ok := stmt.(*ast.ProcedureInfo)
+	if !ok || !create.IsFunction {
+		return nil, errors.Errorf("unexpected statement %T in the definition of function %s", stmt, info.Name.O)
+	}
+	return CompileFunction(create, info.CollationDatabase)
+}
+
+// CompileFunction compiles the `CREATE FUNCTION` statement, `collate` is the collation of the database which is the
+// default collation of the string return type.
+func CompileFunction(stmt *ast.ProcedureInfo, collate string) (*Function, error) {
+	proc, err := compileRoutine(stmt, returnType(stmt.ReturnType, collate))
+	if err != nil {
+		return nil, err
+	}
+	return &Function{proc: proc}, nil
+}
+
+// returnType fills the unspecified length, charset and collation of the return type with the defaults.
+func returnType(tp *types.FieldType, collate string) *types.FieldType {
+	tp = tp.Clone()
+	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(tp.GetType())
+	if tp.GetFlen() == types.UnspecifiedLength {
+		tp.SetFlen(defaultFlen)
+	}
+	if tp.GetDecimal() == types.UnspecifiedLength {
+		tp.SetDecimal(defaultDecimal)
+	}
+	if !types.IsString(tp.GetType()) && tp.GetType() != mysql.TypeEnum && tp.GetType() != mysql.TypeSet {
+		tp.SetCharset(charset.CharsetBin)
+		tp.SetCollate(charset.CollationBin)
+		return tp
+	}
+	switch {
+	case tp.GetCharset() == "" && tp.GetCollate() == "":
+		if collate == "" {
+			collate = mysql.DefaultCollationName
+		}
+		if coll, err := charset.GetCollationByName(collate); err == nil {
+			tp.SetCharset(coll.CharsetName)
+			tp.SetCollate(coll.Name)
+		}
+	case tp.GetCollate() == "":
+		if coll, err := charset.GetDefaultCollation(tp.GetCharset()); err == nil {
+			tp.SetCollate(coll)
+		}
+	case tp.GetCharset() == "":
+		if coll, err := charset.GetCollationByName(tp.GetCollate()); err == nil {
+			tp.SetCharset(coll.CharsetName)
+		}
+	}
+	if tp.GetCharset() == charset.CharsetBin {
+		tp.AddFlag(mysql.BinaryFlag)
+	}
+	return tp
+}
+
+// Name returns the qualified name of the function, for example: test.f1.
+func (f *Function) Name() string {
+	return f.proc.name
+}
+
+// NumParams returns the number of the parameters.
+func (f *Function) NumParams() int {
+	return len(f.proc.params)
+}
+
+// RetType returns the return type of the function.
+func (f *Function) RetType() *types.FieldType {
+	return f.proc.retType
+}
+
+// Call executes the function with the arguments in the session and returns the value returned by the function.
+func (f *Function) Call(ctx context.Context, se Session, args []types.Datum) (types.Datum, error) {
+	p := f.proc
+	if len(args) != len(p.params) {
+		return types.Datum{}, exeerrors.ErrSpWrongNoOfArgs.GenWithStackByArgs("FUNCTION", p.name, len(p.params), len(args))
+	}
+
+	e := newExecutor(p, se)
+	for i, param := range p.params {
+		if err := e.assign(param.slot, args[i], nil); err != nil {
+			return types.Datum{}, err
+		}
+	}
+
+	if err := e.run(ctx); err != nil {
+		return types.Datum{}, err
+	}
+	if !e.returned {
+		return types.Datum{}, exeerrors.ErrSpNoreturnend.GenWithStackByArgs(p.name)
+	}
+	return e.ret, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedure
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+// The kinds of handler conditions, in the order of precedence from high to low.
+const (
+	condErrorCode = iota
+	condSQLState
+	condClass
+)
+
+// condition is a condition value of a handler.
+type condition struct {
+	kind int
+	code uint16
+	// state is the SQLSTATE for condSQLState, or the class of SQLSTATE for condClass.
+	state string
+}
+
+// sqlCondition is an error or a warning raised by the statements of a procedure.
+type sqlCondition struct {
+	err     error
+	code    uint16
+	state   string
+	isError bool
+}
+
+// handler is a `DECLARE ... HANDLER` declared in a block.
+type handler struct {
+	isExit     bool
+	conditions []condition
+	// [start, end) is the range of the instructions in which the handler is in effect.
+	start, end int
+	// entry is the start of the statement of the handler.
+	entry int
+	// exitPC is the end of the block, where the execution continues after an `EXIT` handler.
+	exitPC int
+	// depth is the nesting depth of the block, the handlers in the inner blocks take precedence.
+	depth int
+}
+
+func newHandler(decl *ast.ProcedureErrorControl, depth int) (*handler, error) {
+	h := &handler{isExit: decl.ControlHandle == ast.PROCEDUR_EXIT, depth: depth}
+	for _, cond := range decl.ErrorCon {
+		switch x := cond.(type) {
+		case *ast.ProcedureErrorVal:
+			h.conditions = append(h.conditions, condition{kind: condErrorCode, code: uint16(x.ErrorNum)})
+		case *ast.ProcedureErrorState:
+			// SQLSTATE '00000' means success, which is not a valid condition.
+			if len(x.CodeStatus) != 5 || strings.HasPrefix(x.CodeStatus, "00") {
+				return nil, exeerrors.ErrSpBadSQLstate.GenWithStackByArgs(x.CodeStatus)
+			}
+			h.conditions = append(h.conditions, condition{kind: condSQLState, state: strings.ToUpper(x.CodeStatus)})
+		case *ast.ProcedureErrorCon:
+			switch x.ErrorCon {
+			case ast.PROCEDUR_SQLWARNING:
+				h.conditions = append(h.conditions, condition{kind: condClass, state: "01"})
+			case ast.PROCEDUR_NOT_FOUND:
+				h.conditions = append(h.conditions, condition{kind: condClass, state: "02"})
+			case ast.PROCEDUR_SQLEXCEPTION:
+				h.conditions = append(h.conditions, condition{kind: condClass})
+			}
+		}
+	}
+	return h, nil
+}
+
+// match returns whether the handler can handle the condition, and the precedence of the matched condition value.
+func (h *handler) match(cond *sqlCondition) (int, bool) {
+	matched, precedence := false, condClass
+	for _, c := range h.conditions {
+		var ok bool
+		switch c.kind {
+		case condErrorCode:
+			ok = c.code == cond.code
+		case condSQLState:
+			ok = c.state == cond.state
+		default:
+			switch c.state {
+			case "01":
+				// SQLWARNING matches the warnings and the SQLSTATEs of class '01'.
+				ok = !cond.isError || strings.HasPrefix(cond.state, "01")
+			case "02":
+				ok = strings.HasPrefix(cond.state, "02")
+			default:
+				ok = cond.isError && !strings.HasPrefix(cond.state, "00") && !strings.HasPrefix(cond.state, "01") &&
+					!strings.HasPrefix(cond.state, "02")
+			}
+		}
+		if ok && (!matched || c.kind < precedence) {
+			matched, precedence = true, c.kind
+		}
+	}
+	return precedence, matched
+}
+
+// newSQLCondition converts an error or a warning to a condition which can be handled by the handlers, nil is
+// returned if it can't be handled, for example, the statement is killed.
+func newSQLCondition(err error, isError bool) *sqlCondition {
+	tErr, ok := errors.Cause(err).(*terror.Error)
+	if !ok {
+		return nil
+	}
+	if exeerrors.ErrQueryInterrupted.Equal(tErr) || exeerrors.ErrMaxExecTimeExceeded.Equal(tErr) {
+		return nil
+	}
+	sqlErr := terror.ToSQLError(tErr)
+	return &sqlCondition{err: err, code: sqlErr.Code, state: sqlErr.State, isError: isError}
+}
@@ -0,0 +1,289 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedure
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/types"
+	driver "github.com/pingcap/tidb/types/parser_driver"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+)
+
+// instruction is an instruction of a compiled procedure. The instructions are executed in order, unless the
+// program counter is changed by a jump.
+type instruction interface {
+	execute(ctx context.Context, e *executor) error
+}
+
+// varRef is a reference of a local variable in a statement, the constant is set to the value of the variable
+// before the statement is executed.
+type varRef struct {
+	slot int
+	expr *driver.ValueExpr
+}
+
+func newVarRef(slot int) *varRef {
+	//nolint: forcetypeassert
+	return &varRef{slot: slot, expr: ast.NewValueExpr(nil, "", "").(*driver.ValueExpr)}
+}
+
+// exprEval is a statement with the references of the local variables. An expression is evaluated by a `SELECT`
+// statement which selects the expression.
+type exprEval struct {
+	stmt ast.StmtNode
+	refs []*varRef
+}
+
+func newExprEval(expr ast.ExprNode, refs []*varRef) *exprEval {
+	stmt := &ast.SelectStmt{
+		SelectStmtOpts: &ast.SelectStmtOpts{SQLCache: true},
+		Kind:           ast.SelectStmtKindSelect,
+		Fields:         &ast.FieldList{Fields: []*ast.SelectField{{Expr: expr}}},
+	}
+	return &exprEval{stmt: stmt, refs: refs}
+}
+
+// stmtInst executes a statement, the result set of the statement is returned by the procedure.
+type stmtInst struct {
+	eval *exprEval
+}
+
+func (i *stmtInst) execute(ctx context.Context, e *executor) error {
+	rs, err := e.execStmt(ctx, i.eval)
+	if err != nil {
+		return err
+	}
+	if rs != nil {
+		e.results = append(e.results, rs)
+	}
+	return nil
+}
+
+// callArgVarPrefix is the prefix of the user variables which pass the local variables to the called procedures.
+const callArgVarPrefix = "_tidb_sp_arg_"
+
+// callInst executes a `CALL` statement, the result sets produced by the called procedure are returned by the
+// procedure. The local variables in `slots` are passed by the user variables in `userVars`.
+type callInst struct {
+	eval     *exprEval
+	slots    []int
+	userVars []string
+}
+
+func (i *callInst) execute(ctx context.Context, e *executor) error {
+	sessVars := e.se.GetSessionVars()
+	for j, slot := range i.slots {
+		setUserVar(sessVars, i.userVars[j], e.vars[slot], e.varType(slot))
+	}
+	defer func() {
+		for _, name := range i.userVars {
+			sessVars.UnsetUserVar(name)
+		}
+	}()
+
+	_, err := e.execStmt(ctx, i.eval)
+	e.results = append(e.results, e.se.CallResults()...)
+	if err != nil {
+		return err
+	}
+	for j, slot := range i.slots {
+		d, _ := sessVars.GetUserVarVal(i.userVars[j])
+		tp, _ := sessVars.GetUserVarType(i.userVars[j])
+		if err = e.assign(slot, d, tp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setInst assigns the value of an expression to a variable, the variable is set to NULL if the expression is nil.
+type setInst struct {
+	slot int
+	eval *exprEval
+}
+
+func (i *setInst) execute(ctx context.Context, e *executor) error {
+	if i.eval == nil {
+		return e.assign(i.slot, types.Datum{}, nil)
+	}
+	d, tp, err := e.evalExpr(ctx, i.eval)
+	if err != nil {
+		return err
+	}
+	return e.assign(i.slot, d, tp)
+}
+
+// returnInst returns the value of an expression from the stored function, the value is converted to the return
+// type of the function.
+type returnInst struct {
+	eval *exprEval
+}
+
+func (i *returnInst) execute(ctx context.Context, e *executor) error {
+	d, _, err := e.evalExpr(ctx, i.eval)
+	if err != nil {
+		return err
+	}
+	if !d.IsNull() {
+		if d, err = d.ConvertTo(e.se.GetSessionVars().StmtCtx, e.proc.retType); err != nil {
+			return err
+		}
+	}
+	e.ret, e.returned = d, true
+	e.pc = len(e.proc.code)
+	return nil
+}
+
+type jumpInst struct {
+	dest int
+}
+
+func (i *jumpInst) execute(_ context.Context, e *executor) error {
+	e.pc = i.dest
+	return nil
+}
+
+// jumpIfNotInst jumps to `dest` if the condition is not true. The execution continues at `cont` if an error
+// occurred in evaluating the condition is handled by a `CONTINUE` handler.
+type jumpIfNotInst struct {
+	eval *exprEval
+	dest int
+	cont int
+}
+
+func (i *jumpIfNotInst) execute(ctx context.Context, e *executor) error {
+	d, _, err := e.evalExpr(ctx, i.eval)
+	if err != nil {
+		e.pc = i.cont
+		return err
+	}
+	if d.IsNull() {
+		e.pc = i.dest
+		return nil
+	}
+	isTrue, err := d.ToBool(e.se.GetSessionVars().StmtCtx)
+	if err != nil {
+		e.pc = i.cont
+		return err
+	}
+	if isTrue == 0 {
+		e.pc = i.dest
+	}
+	return nil
+}
+
+// raiseInst raises an error.
+type raiseInst struct {
+	err error
+}
+
+func (i *raiseInst) execute(context.Context, *executor) error {
+	return i.err
+}
+
+type openInst struct {
+	cursor int
+}
+
+func (i *openInst) execute(ctx context.Context, e *executor) error {
+	cursor := e.cursors[i.cursor]
+	if cursor.isOpen {
+		return exeerrors.ErrSpCursorAlreadyOpen
+	}
+	rs, err := e.execStmt(ctx, e.proc.cursors[i.cursor].eval)
+	if err != nil {
+		return err
+	}
+	cursor.open(rs)
+	return nil
+}
+
+type fetchInst struct {
+	cursor int
+	slots  []int
+}
+
+func (i *fetchInst) execute(_ context.Context, e *executor) error {
+	cursor := e.cursors[i.cursor]
+	if !cursor.isOpen {
+		return exeerrors.ErrSpCursorNotOpen
+	}
+	row, ok := cursor.next()
+	if !ok {
+		return exeerrors.ErrSpFetchNoData
+	}
+	if row.Len() != len(i.slots) {
+		return exeerrors.ErrSpWrongNoOfFetchArgs
+	}
+	fields := cursor.rs.Fields()
+	for j, slot := range i.slots {
+		tp := &fields[j].Column.FieldType
+		if err := e.assign(slot, row.GetDatum(j, tp), tp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type closeInst struct {
+	cursor int
+}
+
+func (i *closeInst) execute(_ context.Context, e *executor) error {
+	cursor := e.cursors[i.cursor]
+	if !cursor.isOpen {
+		return exeerrors.ErrSpCursorNotOpen
+	}
+	cursor.close()
+	return nil
+}
+
+// resetCursorsInst closes the cursors declared in a block when the block is entered or left.
+type resetCursorsInst struct {
+	cursors []int
+}
+
+func (i *resetCursorsInst) execute(_ context.Context, e *executor) error {
+	for _, idx := range i.cursors {
+		e.cursors[idx].close()
+	}
+	return nil
+}
+
+// handlerEndInst is the end of the statement of a handler. The execution continues after the statement which
+// activated the handler for a `CONTINUE` handler, and leaves the block which declares the handler for an `EXIT`
+// handler.
+type handlerEndInst struct {
+	handler *handler
+}
+
+func (i *handlerEndInst) execute(_ context.Context, e *executor) error {
+	for len(e.frames) > 0 {
+		frame := e.frames[len(e.frames)-1]
+		e.frames = e.frames[:len(e.frames)-1]
+		if frame.handler != i.handler {
+			continue
+		}
+		if i.handler.isExit {
+			e.pc = i.handler.exitPC
+		} else {
+			e.pc = frame.returnPC
+		}
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+// Session is the session in which the statements of a procedure are executed.
+type Session interface {
+	ExecuteStmt(context.Context, ast.StmtNode) (sqlexec.RecordSet, error)
+	GetSessionVars() *variable.SessionVars
+	// CallResults returns the result sets produced by the procedure called by the last executed `CALL` statement.
+	CallResults() []sqlexec.RecordSet
+}
+
+// Arg is an argument passed to the procedure.
+type Arg struct {
+	Value types.Datum
+	Type  *types.FieldType
+	// Var is the name of the user variable passed as the argument, which receives the value of the OUT or INOUT
+	// parameter. It's empty if the argument isn't a user variable.
+	Var string
+}
+
+// Procedure is a compiled stored procedure. The body of the procedure is compiled into a list of instructions, in
+// which the control flow statements are translated to jumps, and the SQL statements are executed in the session
+// with the local variables bound as constants.
+type Procedure struct {
+	// name is the qualified name of the procedure used in error messages, for example: test.p1.
+	name     string
+	params   []*param
+	vars     []*localVar
+	cursors  []*cursorDef
+	handlers []*handler
+	code     []instruction
+	// retType is the return type of the stored function, it's nil for the procedures and the triggers.
+	retType *types.FieldType
+}
+
+type param struct {
+	name string
+	mode int
+	slot int
+}
+
+// localVar is a local variable or a parameter of the procedure.
+type localVar struct {
+	name string
+	// tp is the declared type of the variable, values assigned to the variable are converted to it. It is nil for
+	// the internal variables, which take the type of the assigned values.
+	tp *types.FieldType
+}
+
+// cursorDef is a cursor declared in the procedure.
+type cursorDef struct {
+	name string
+	eval *exprEval
+}
+
+// CreateStmtText returns the text of the `CREATE PROCEDURE` or `CREATE FUNCTION` statement which creates the
+// procedure or the stored function.
+func CreateStmtText(schema model.CIStr, info *model.ProcedureInfo) string {
+	var sb strings.Builder
+	kind := "PROCEDURE"
+	if info.Returns != "" {
+		kind = "FUNCTION"
+	}
+	if schema.O != "" {
+		sqlexec.MustFormatSQL(&sb, "CREATE "+kind+" %n.%n", schema.O, info.Name.O)
+	} else {
+		sqlexec.MustFormatSQL(&sb, "CREATE "+kind+" %n", info.Name.O)
+	}
+	fmt.Fprintf(&sb, "(%s)", info.ParamList)
+	if info.Returns != "" {
+		fmt.Fprintf(&sb, " RETURNS %s", info.Returns)
+		if info.Deterministic {
+			sb.WriteString("\n    DETERMINISTIC")
+		}
+		if info.DataAccess != "" {
+			fmt.Fprintf(&sb, "\n    %s", info.DataAccess)
+		}
+		if info.Comment != "" {
+			sqlexec.MustFormatSQL(&sb, "\n    COMMENT %?", info.Comment)
+		}
+	}
+	fmt.Fprintf(&sb, "\n%s", info.Body)
+	return sb.String()
+}
+
+// Load parses and compiles the procedure stored in the schema.
+func Load(schema model.CIStr, info *model.ProcedureInfo, sqlMode mysql.SQLMode) (*Procedure, error) {
+	p := parser.New()
+	p.SetSQLMode(sqlMode)
+	stmt, err := p.ParseOneStmt(CreateStmtText(schema, info), info.CharsetClient, info.CollationConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	create, ok := stmt.(*ast.ProcedureInfo)
+	if !ok {
+		return nil, errors.Errorf("unexpected statement %T in the definition of procedure %s", stmt, info.Name.O)
+	}
+	return Compile(create)
+}
+
+// Name returns the qualified name of the procedure, for example: test.p1.
+func (p *Procedure) Name() string {
+	return p.name
+}
+
+// Call executes the procedure with the arguments in the session, and returns the result sets produced by the
+// statements of the procedure. The result sets produced before an unhandled error are also returned along with the
+// error. The values of the OUT and INOUT parameters are assigned to the user variables passed as the arguments.
+func (p *Procedure) Call(ctx context.Context, se Session, args []Arg) ([]sqlexec.RecordSet, error) {
+	if len(args) != len(p.params) {
+		return nil, exeerrors.ErrSpWrongNoOfArgs.GenWithStackByArgs("PROCEDURE", p.name, len(p.params), len(args))
+	}
+
+	e := newExecutor(p, se)
+	for i, param := range p.params {
+		if param.mode != ast.MODE_IN && args[i].Var == "" {
+			return nil, exeerrors.ErrSpNotVarArg.GenWithStackByArgs(i+1, p.name)
+		}
+		if param.mode == ast.MODE_OUT {
+			continue
+		}
+		if err := e.assign(param.slot, args[i].Value, args[i].Type); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := e.run(ctx); err != nil {
+		return e.results, err
+	}
+
+	sessVars := se.GetSessionVars()
+	for i, param := range p.params {
+		if param.mode != ast.MODE_IN {
+			setUserVar(sessVars, args[i].Var, e.vars[param.slot], e.varType(param.slot))
+		}
+	}
+	return e.results, nil
+}
+
+func setUserVar(sessVars *variable.SessionVars, name string, value types.Datum, tp *types.FieldType) {
+	if value.IsNull() {
+		sessVars.UnsetUserVar(name)
+		return
+	}
+	sessVars.SetUserVarVal(name, value)
+	if tp != nil {
+		sessVars.SetUserVarType(name, tp)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+const (
+	newRowRef = "new"
+	oldRowRef = "old"
+)
+
+// Trigger is a compiled row-level trigger. The columns of the NEW and OLD rows are bound as the variables named
+// `new.<column>` and `old.<column>`, which are set to the values of the row before the body is executed.
+type Trigger struct {
+	proc   *Procedure
+	timing model.TriggerTiming
+	// newSlots and oldSlots are the variables of the columns of the NEW and OLD rows, they are nil if there's no
+	// such row for the event of the trigger.
+	newSlots []int
+	oldSlots []int
+}
+
+// TriggerCreateStmtText returns the text of the `CREATE TRIGGER` statement which creates the trigger on the table.
+func TriggerCreateStmtText(schema, table model.CIStr, info *model.TriggerInfo) string {
+	var sb strings.Builder
+	if schema.O != "" {
+		sqlexec.MustFormatSQL(&sb, "CREATE TRIGGER %n.%n ", schema.O, info.Name.O)
+	} else {
+		sqlexec.MustFormatSQL(&sb, "CREATE TRIGGER %n ", info.Name.O)
+	}
+	fmt.Fprintf(&sb, "%s %s ON ", info.Timing, info.Event)
+	sqlexec.MustFormatSQL(&sb, "%n", table.O)
+	fmt.Fprintf(&sb, " FOR EACH ROW\n%s", info.Body)
+	return sb.String()
+}
+
+// LoadTrigger parses and compiles the trigger stored in the table.
+func LoadTrigger(schema model.CIStr, tbl *model.TableInfo, info *model.TriggerInfo) (*Trigger, error) {
+	sqlMode, err := mysql.GetSQLMode(info.SQLMode)
+	if err != nil {
+		return nil, err
+	}
+	p := parser.New()
+	p.SetSQLMode(sqlMode)
+	stmt, err := p.ParseOneStmt(TriggerCreateStmtText(schema, tbl.Name, info), info.CharsetClient, info.CollationConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	create, ok := stmt.(*ast.CreateTriggerStmt)
+	if !ok {
+		return nil, errors.Errorf("unexpected statement %T in the definition of trigger %s", stmt, info.Name.O)
+	}
+	return CompileTrigger(create, tbl.Cols())
+}
+
+// CompileTrigger compiles the `CREATE TRIGGER` statement, `cols` are the public columns of the table on which the
+// trigger is created.
+func CompileTrigger(stmt *ast.CreateTriggerStmt, cols []*model.ColumnInfo) (*Trigger, error) {
+	name := stmt.TriggerName.Name.O
+	if stmt.TriggerName.Schema.O != "" {
+		name = stmt.TriggerName.Schema.O + "." + name
+	}
+	c := &compiler{proc: &Procedure{name: name}, trigger: stmt}
+	t := &Trigger{proc: c.proc, timing: stmt.Timing}
+
+	rows := &scope{vars: make(map[string]int)}
+	addRow := func(ref string) []int {
+		slots := make([]int, 0, len(cols))
+		for _, col := range cols {
+			varName := ref + "." + col.Name.L
+			slot := c.addVar(varName, &col.FieldType)
+			rows.vars[varName] = slot
+			slots = append(slots, slot)
+		}
+		return slots
+	}
+	if stmt.Event != model.TriggerEventDelete {
+		t.newSlots = addRow(newRowRef)
+	}
+	if stmt.Event != model.TriggerEventInsert {
+		t.oldSlots = addRow(oldRowRef)
+	}
+	c.scopes = append(c.scopes, rows)
+
+	if err := c.compileStmt(stmt.Body); err != nil {
+		return nil, err
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return t, nil
+}
+
+// Fire executes the trigger for a row in the session. `oldRow` and `newRow` are the values of the public columns of
+// the row before and after the modification, and `newRow` is updated by the assignments to the NEW row in a
+// `BEFORE` trigger.
+func (t *Trigger) Fire(ctx context.Context, se Session, oldRow, newRow []types.Datum) error {
+	e := newExecutor(t.proc, se)
+	for i, slot := range t.oldSlots {
+		e.vars[slot] = oldRow[i]
+	}
+	for i, slot := range t.newSlots {
+		e.vars[slot] = newRow[i]
+	}
+
+	if err := e.run(ctx); err != nil {
+		return err
+	}
+
+	if t.timing == model.TriggerTimingBefore {
+		for i, slot := range t.newSlots {
+			newRow[i] = e.vars[slot]
+		}
+	}
+	return nil
+}
+
+func isRowRef(name string) bool {
+	return name == newRowRef || name == oldRowRef
+}
+
+// lookupRowVar finds the variable of a column of the NEW or OLD row in the trigger being compiled.
+func (c *compiler) lookupRowVar(ref, column string, assign bool) (int, error) {
+	refName := strings.ToUpper(ref)
+	slot, ok := c.lookupVar(ref + "." + column)
+	if !ok {
+		event := c.trigger.Event
+		if (ref == newRowRef && event == model.TriggerEventDelete) || (ref == oldRowRef && event == model.TriggerEventInsert) {
+			return 0, exeerrors.ErrTrgNoSuchRowInTrg.GenWithStackByArgs(refName, "on "+event.String())
+		}
+		return 0, dbterror.ErrBadField.GenWithStackByArgs(column, refName)
+	}
+	if assign {
+		if ref == oldRowRef {
+			return 0, exeerrors.ErrTrgCantChangeRow.GenWithStackByArgs(refName, "")
+		}
+		if c.trigger.Timing == model.TriggerTimingAfter {
+			return 0, exeerrors.ErrTrgCantChangeRow.GenWithStackByArgs(refName, "after ")
+		}
+	}
+	return slot, nil
+}
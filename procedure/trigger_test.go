@@ -0,0 +1,111 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedure
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/dbterror/exeerrors"
+	"github.com/stretchr/testify/require"
+)
+
+func testColumns() []*model.ColumnInfo {
+	return []*model.ColumnInfo{
+		{Name: model.NewCIStr("a"), FieldType: *types.NewFieldType(mysql.TypeLong)},
+		{Name: model.NewCIStr("B"), Offset: 1, FieldType: *types.NewFieldType(mysql.TypeVarchar)},
+	}
+}
+
+func compileTrigger(t *testing.T, sql string) (*Trigger, error) {
+	stmt, err := parser.New().ParseOneStmt(sql, "", "")
+	require.NoError(t, err, sql)
+	create, ok := stmt.(*ast.CreateTriggerStmt)
+	require.True(t, ok, sql)
+	return CompileTrigger(create, testColumns())
+}
+
+func TestCompileTriggerErrors(t *testing.T) {
+	cases := []struct {
+		sql string
+		err *terror.Error
+	}{
+		{"create trigger tr before insert on t for each row set old.a = 1", exeerrors.ErrTrgNoSuchRowInTrg},
+		{"create trigger tr before insert on t for each row insert into t1 values (old.a)", exeerrors.ErrTrgNoSuchRowInTrg},
+		{"create trigger tr after delete on t for each row insert into t1 values (new.a)", exeerrors.ErrTrgNoSuchRowInTrg},
+		{"create trigger tr before update on t for each row set old.a = 1", exeerrors.ErrTrgCantChangeRow},
+		{"create trigger tr after update on t for each row set new.a = 1", exeerrors.ErrTrgCantChangeRow},
+		{"create trigger tr before update on t for each row set new.c = 1", dbterror.ErrBadField},
+		{"create trigger tr before update on t for each row insert into t1 values (old.c)", dbterror.ErrBadField},
+		{"create trigger tr before insert on t for each row select 1", exeerrors.ErrSpNoRetset},
+		{"create trigger tr before insert on t for each row begin commit; end", exeerrors.ErrCommitNotAllowedInSfOrTrg},
+		{"create trigger tr before insert on t for each row truncate table t1", exeerrors.ErrCommitNotAllowedInSfOrTrg},
+		{"create trigger tr before insert on t for each row analyze table t1", exeerrors.ErrSpNoRetset},
+	}
+	for _, c := range cases {
+		_, err := compileTrigger(t, c.sql)
+		require.Error(t, err, c.sql)
+		require.True(t, c.err.Equal(err), "%s: %v", c.sql, err)
+	}
+}
+
+func TestCompileTrigger(t *testing.T) {
+	tr, err := compileTrigger(t, `create trigger test.tr before update on t for each row
+begin
+	declare c cursor for select new.a;
+	if new.a > old.a then
+		set new.b = concat(old.b, new.B);
+	end if;
+	insert into t1 values (old.a, NEW.a);
+end`)
+	require.NoError(t, err)
+	require.Equal(t, "test.tr", tr.proc.name)
+	require.Len(t, tr.newSlots, 2)
+	require.Len(t, tr.oldSlots, 2)
+	require.Equal(t, "new.b", tr.proc.vars[tr.newSlots[1]].name)
+	require.Equal(t, mysql.TypeVarchar, tr.proc.vars[tr.newSlots[1]].tp.GetType())
+
+	tr, err = compileTrigger(t, "create trigger tr after insert on t for each row insert into t1 values (new.a)")
+	require.NoError(t, err)
+	require.Len(t, tr.newSlots, 2)
+	require.Nil(t, tr.oldSlots)
+}
+
+func TestTriggerCreateStmtText(t *testing.T) {
+	info := &model.TriggerInfo{
+		Name:   model.NewCIStr("tr"),
+		Timing: model.TriggerTimingAfter,
+		Event:  model.TriggerEventDelete,
+		Body:   "delete from t1 where a = old.a",
+	}
+	tbl := &model.TableInfo{Name: model.NewCIStr("t"), Columns: testColumns()}
+	for _, col := range tbl.Columns {
+		col.State = model.StatePublic
+	}
+	require.Equal(t, "CREATE TRIGGER `test`.`tr` AFTER DELETE ON `t` FOR EACH ROW\ndelete from t1 where a = old.a",
+		TriggerCreateStmtText(model.NewCIStr("test"), tbl.Name, info))
+
+	tr, err := LoadTrigger(model.NewCIStr("test"), tbl, info)
+	require.NoError(t, err)
+	require.Equal(t, "test.tr", tr.proc.name)
+	require.Nil(t, tr.newSlots)
+	require.Len(t, tr.oldSlots, 2)
+}
@@ -2024,6 +2024,13 @@ func (cc *clientConn) handleStmt(ctx context.Context, stmt ast.StmtNode, warns [
 		if sv := cc.ctx.GetSessionVars(); sv != nil && sv.StmtCtx != nil {
 			sv.StmtCtx.DetachMemDiskTracker()
 		}
+		// The result sets produced by the procedure before the error are written before the error.
+		if written, writeErr := cc.writeCallResults(ctx, false, cc.ctx.Status()); written || writeErr != nil {
+			if writeErr != nil {
+				return false, writeErr
+			}
+			return false, err
+		}
 		return true, err
 	}
 
@@ -2033,6 +2040,9 @@ func (cc *clientConn) handleStmt(ctx context.Context, stmt ast.StmtNode, warns [
 	} else {
 		status |= mysql.ServerMoreResultsExists
 	}
+	if _, err := cc.writeCallResults(ctx, false, status); err != nil {
+		return false, err
+	}
 
 	if rs != nil {
 		if cc.getStatus() == connStatusShutdown {
@@ -2058,6 +2068,31 @@ func (cc *clientConn) handleStmt(ctx context.Context, stmt ast.StmtNode, warns [
 	return false, nil
 }
 
+// writeCallResults writes the result sets produced by the procedure called by a `CALL` statement. Each of them is
+// written with the SERVER_MORE_RESULTS_EXISTS flag, since it's followed by the OK packet of the `CALL` statement.
+// It returns whether there are any result sets written.
+func (cc *clientConn) writeCallResults(ctx context.Context, binary bool, status uint16) (bool, error) {
+	results := executor.TakeCallResults(cc.getCtx().Session)
+	if results == nil || len(results.ResultSets) == 0 {
+		return false, nil
+	}
+	for _, rs := range results.ResultSets {
+		defer terror.Call(rs.Close)
+	}
+	if cc.capability&mysql.ClientMultiResults == 0 {
+		return false, exeerrors.ErrSpBadselect.GenWithStackByArgs(results.Procedure)
+	}
+	for _, rs := range results.ResultSets {
+		if cc.getStatus() == connStatusShutdown {
+			return true, exeerrors.ErrQueryInterrupted
+		}
+		if _, err := cc.writeResultSet(ctx, resultset.New(rs, nil), binary, status|mysql.ServerMoreResultsExists, 0); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
 func (cc *clientConn) handleFileTransInConn(ctx context.Context, status uint16) (bool, error) {
 	handled := false
 	loadDataInfo := cc.ctx.Value(executor.LoadDataVarKey)
@@ -313,6 +313,12 @@ func (cc *clientConn) executePreparedStmtAndWriteResult(ctx context.Context, stm
 		if sv := cc.ctx.GetSessionVars(); sv != nil && sv.StmtCtx != nil {
 			sv.StmtCtx.DetachMemDiskTracker()
 		}
+		if written, writeErr := cc.writeCallResults(ctx, true, cc.ctx.Status()); written || writeErr != nil {
+			if writeErr != nil {
+				return false, writeErr
+			}
+			return false, errors.Annotate(err, cc.preparedStmt2String(uint32(stmt.ID())))
+		}
 		return true, errors.Annotate(err, cc.preparedStmt2String(uint32(stmt.ID())))
 	}
 
@@ -320,6 +326,9 @@ func (cc *clientConn) executePreparedStmtAndWriteResult(ctx context.Context, stm
 		if useCursor {
 			vars.SetStatusFlag(mysql.ServerStatusCursorExists, false)
 		}
+		if _, err := cc.writeCallResults(ctx, true, cc.ctx.Status()); err != nil {
+			return false, err
+		}
 		return false, cc.writeOK(ctx)
 	}
 	if planCacheStmt, ok := prepStmt.(*plannercore.PlanCacheStmt); ok {
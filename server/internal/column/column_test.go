@@ -161,6 +161,12 @@ func TestDumpTextValue(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "foo", mustDecodeStr(t, bs))
 
+	columns[0].Type = mysql.TypeGeometry
+	point := types.NewGeometryPoint(types.GeometrySRIDCartesian, 1, 2).Encode()
+	bs, err = DumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewBytesDatum(point)}).ToRow(), dp)
+	require.NoError(t, err)
+	require.Equal(t, string(point), mustDecodeStr(t, bs))
+
 	columns[0].Type = mysql.TypeVarchar
 	bs, err = DumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewStringDatum("bar")}).ToRow(), dp)
 	require.NoError(t, err)
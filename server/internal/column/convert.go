@@ -43,7 +43,14 @@ func ConvertColumnInfo(fld *ast.ResultField) (ci *Info) {
 	if fld.Column.GetFlen() != types.UnspecifiedLength {
 		ci.ColumnLength = uint32(fld.Column.GetFlen())
 	}
-	if fld.Column.GetType() == mysql.TypeNewDecimal {
+	if fld.Column.GetType() == mysql.TypeVector {
+		// The flen of a vector type is its dimension, each element takes 4 bytes.
+		if fld.Column.GetFlen() != types.UnspecifiedLength {
+			ci.ColumnLength *= 4
+		} else {
+			ci.ColumnLength = types.MaxVectorDimension * 4
+		}
+	} else if fld.Column.GetType() == mysql.TypeNewDecimal {
 		// Consider the negative sign.
 		ci.ColumnLength++
 		if fld.Column.GetDecimal() > types.DefaultFsp {
@@ -87,5 +94,9 @@ func ConvertColumnInfo(fld *ast.ResultField) (ci *Info) {
 	if ci.Type == mysql.TypeVarchar {
 		ci.Type = mysql.TypeVarString
 	}
+	// The clients before MySQL 9.0 don't know the vector type, so it's sent as a VARBINARY.
+	if ci.Type == mysql.TypeVector {
+		ci.Type = mysql.TypeVarString
+	}
 	return
 }
@@ -271,7 +271,7 @@ func ExecArgs(sc *stmtctx.StatementContext, params []expression.Expression, boun
 			}
 			continue
 		case mysql.TypeUnspecified, mysql.TypeVarchar, mysql.TypeVarString, mysql.TypeString,
-			mysql.TypeEnum, mysql.TypeSet, mysql.TypeGeometry, mysql.TypeBit:
+			mysql.TypeEnum, mysql.TypeSet, mysql.TypeGeometry, mysql.TypeVector, mysql.TypeBit:
 			if len(paramValues) < (pos + 1) {
 				err = mysql.ErrMalformPacket
 				return
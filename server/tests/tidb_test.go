@@ -383,6 +383,52 @@ func TestMultiStatements(t *testing.T) {
 	ts.RunTestMultiStatements(t)
 }
 
+func TestCallProcedure(t *testing.T) {
+	ts := createTidbTestSuite(t)
+
+	ts.RunTestsOnNewDB(t, nil, "CallProcedure", func(dbt *testkit.DBTestKit) {
+		dbt.MustExec("create table t (a int)")
+		dbt.MustExec("insert into t values (1), (2)")
+		dbt.MustExec("create procedure p1(in n int) begin select a from t order by a; insert into t values (n); select count(*), n from t; end")
+
+		// Each result set of the procedure is returned as a result of the `CALL` statement.
+		rows := dbt.MustQuery("call p1(10)")
+		var a, b int
+		var results [][]int
+		for {
+			var result []int
+			for rows.Next() {
+				cols, err := rows.Columns()
+				require.NoError(t, err)
+				if len(cols) == 1 {
+					require.NoError(t, rows.Scan(&a))
+					result = append(result, a)
+				} else {
+					require.NoError(t, rows.Scan(&a, &b))
+					result = append(result, a, b)
+				}
+			}
+			results = append(results, result)
+			if !rows.NextResultSet() {
+				break
+			}
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, rows.Close())
+		require.Equal(t, [][]int{{1, 2}, {3, 10}}, results)
+
+		// The connection is still usable after the result sets are consumed.
+		row := dbt.GetDB().QueryRow("select count(*) from t")
+		require.NoError(t, row.Scan(&a))
+		require.Equal(t, 3, a)
+
+		// The error is returned after the result sets produced before it.
+		dbt.MustExec("create procedure p2() begin select 1; select * from not_exists; end")
+		_, err := dbt.GetDB().Exec("call p2()")
+		require.Error(t, err)
+	})
+}
+
 func TestSocketForwarding(t *testing.T) {
 	tempDir := t.TempDir()
 	socketFile := tempDir + "/tidbtest.sock" // Unix Socket does not work on Windows, so '/' should be OK
@@ -452,6 +452,62 @@ const (
 		PRIMARY KEY (id),
 		KEY (update_time)
 	);`
+	// CreateAutoAnalyzeQueue stores the tables waiting for or running auto analyze, ordered by their priority score.
+	CreateAutoAnalyzeQueue = `CREATE TABLE IF NOT EXISTS mysql.auto_analyze_queue (
+		table_id BIGINT(64) NOT NULL comment 'physical ID of the table or partition to be analyzed',
+		table_schema CHAR(64) NOT NULL DEFAULT '',
+		table_name CHAR(64) NOT NULL DEFAULT '',
+		partition_name CHAR(64) NOT NULL DEFAULT '',
+		score DOUBLE NOT NULL DEFAULT 0,
+		modify_ratio DOUBLE NOT NULL DEFAULT 0,
+		row_count BIGINT(64) NOT NULL DEFAULT 0,
+		last_analyze_time TIMESTAMP NULL DEFAULT NULL,
+		failure_count BIGINT(64) UNSIGNED NOT NULL DEFAULT 0,
+		state ENUM('pending', 'running') NOT NULL DEFAULT 'pending',
+		instance VARCHAR(512) NOT NULL DEFAULT '' comment 'address of the TiDB instance running the auto analyze job',
+		update_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		PRIMARY KEY (table_id),
+		KEY idx_score (score)
+	);`
+	// CreateQueryFeedback stores the misestimates reported by every TiDB instance, so that the stats owner can
+	// prioritize the misestimated tables in auto analyze.
+	CreateQueryFeedback = `CREATE TABLE IF NOT EXISTS mysql.query_feedback (
+		instance VARCHAR(512) NOT NULL comment 'address of the TiDB instance reporting the misestimate',
+		plan_digest VARCHAR(64) NOT NULL,
+		operator VARCHAR(64) NOT NULL comment 'explain ID of the misestimated operator',
+		sql_digest VARCHAR(64) NOT NULL DEFAULT '',
+		table_id BIGINT(64) NOT NULL comment 'ID of the logical table',
+		table_schema CHAR(64) NOT NULL DEFAULT '',
+		table_name CHAR(64) NOT NULL DEFAULT '',
+		predicate TEXT,
+		est_rows DOUBLE NOT NULL DEFAULT 0,
+		act_rows BIGINT(64) NOT NULL DEFAULT 0,
+		exec_count BIGINT(64) UNSIGNED NOT NULL DEFAULT 0,
+		last_seen TIMESTAMP(6) NOT NULL,
+		PRIMARY KEY (instance, plan_digest, operator),
+		KEY idx_table_last_seen (table_id, last_seen),
+		KEY idx_last_seen (last_seen)
+	);`
+	// CreatePlanRegressions stores the plan regressions found from the statement summary, and the bindings proposed or
+	// created to fix them.
+	CreatePlanRegressions = `CREATE TABLE IF NOT EXISTS mysql.plan_regressions (
+		id BIGINT(64) NOT NULL AUTO_INCREMENT,
+		default_db VARCHAR(64) NOT NULL DEFAULT '',
+		sql_digest VARCHAR(64) NOT NULL,
+		original_sql TEXT NOT NULL,
+		regressed_plan_digest VARCHAR(64) NOT NULL,
+		regressed_avg_latency BIGINT(64) UNSIGNED NOT NULL DEFAULT 0 comment 'in nanoseconds',
+		regressed_exec_count BIGINT(64) UNSIGNED NOT NULL DEFAULT 0,
+		previous_plan_digest VARCHAR(64) NOT NULL,
+		previous_avg_latency BIGINT(64) UNSIGNED NOT NULL DEFAULT 0 comment 'in nanoseconds',
+		previous_exec_count BIGINT(64) UNSIGNED NOT NULL DEFAULT 0,
+		bind_sql TEXT NOT NULL comment 'the binding pinning the previous plan',
+		status ENUM('proposed', 'bound', 'rejected') NOT NULL DEFAULT 'proposed',
+		create_time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		update_time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+		PRIMARY KEY (id),
+		UNIQUE KEY idx_digest (sql_digest, regressed_plan_digest)
+	);`
 	// CreateAdvisoryLocks stores the advisory locks (get_lock, release_lock).
 	CreateAdvisoryLocks = `CREATE TABLE IF NOT EXISTS mysql.advisory_locks (
 		lock_name VARCHAR(64) NOT NULL PRIMARY KEY
@@ -988,11 +1044,23 @@ const (
 	// version 175
 	//   update normalized bindings of `in (?)` to `in (...)` to solve #44298.
 	version175 = 175
+
+	// version 176
+	//   create table `mysql.auto_analyze_queue` to persist the priority queue of auto analyze.
+	version176 = 176
+
+	// version 177
+	//   create table `mysql.plan_regressions` to record the plan regressions and the bindings fixing them.
+	version177 = 177
+
+	// version 178
+	//   create table `mysql.query_feedback` to persist the misestimates reported by the TiDB instances.
+	version178 = 178
 )
 
 // currentBootstrapVersion is defined as a variable, so we can modify its value for testing.
 // please make sure this is the largest version
-var currentBootstrapVersion int64 = version175
+var currentBootstrapVersion int64 = version178
 
 // DDL owner key's expired time is ManagerSessionTTL seconds, we should wait the time and give more time to have a chance to finish it.
 var internalSQLTimeout = owner.ManagerSessionTTL + 15
@@ -1143,6 +1211,9 @@ var (
 		upgradeToVer173,
 		upgradeToVer174,
 		upgradeToVer175,
+		upgradeToVer176,
+		upgradeToVer177,
+		upgradeToVer178,
 	}
 )
 
@@ -2799,6 +2870,27 @@ func upgradeToVer175(s Session, ver int64) {
 	}
 }
 
+func upgradeToVer176(s Session, ver int64) {
+	if ver >= version176 {
+		return
+	}
+	mustExecute(s, CreateAutoAnalyzeQueue)
+}
+
+func upgradeToVer177(s Session, ver int64) {
+	if ver >= version177 {
+		return
+	}
+	mustExecute(s, CreatePlanRegressions)
+}
+
+func upgradeToVer178(s Session, ver int64) {
+	if ver >= version178 {
+		return
+	}
+	mustExecute(s, CreateQueryFeedback)
+}
+
 func writeOOMAction(s Session) {
 	comment := "oom-action is `log` by default in v3.0.x, `cancel` by default in v4.0.11+"
 	mustExecute(s, `INSERT HIGH_PRIORITY INTO %n.%n VALUES (%?, %?, %?) ON DUPLICATE KEY UPDATE VARIABLE_VALUE= %?`,
@@ -2926,6 +3018,12 @@ func doDDLWorks(s Session) {
 	mustExecute(s, CreateDoneRunawayWatchTable)
 	// create dist_framework_meta
 	mustExecute(s, CreateDistFrameworkMeta)
+	// create auto_analyze_queue
+	mustExecute(s, CreateAutoAnalyzeQueue)
+	// create plan_regressions
+	mustExecute(s, CreatePlanRegressions)
+	// create query_feedback
+	mustExecute(s, CreateQueryFeedback)
 }
 
 // doBootstrapSQLFile executes SQL commands in a file as the last stage of bootstrap.
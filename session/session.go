@@ -46,6 +46,7 @@ import (
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/domain/infosync"
 	"github.com/pingcap/tidb/errno"
+	schedevent "github.com/pingcap/tidb/event"
 	"github.com/pingcap/tidb/executor"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/extension"
@@ -3486,6 +3487,17 @@ func bootstrapSessionImpl(store kv.Storage, createSessionsImpl func(store kv.Sto
 		return s
 	}
 	dom.StartTTLJobManager()
+	dom.StartEventManager(func(definer *auth.UserIdentity) (schedevent.Session, error) {
+		se, err := CreateSession(store)
+		if err != nil {
+			return nil, err
+		}
+		if !se.AuthWithoutVerification(definer) {
+			se.Close()
+			return nil, errors.Errorf("definer '%s'@'%s' of the event does not exist", definer.Username, definer.Hostname)
+		}
+		return se, nil
+	})
 
 	analyzeCtxs, err := createSessions(store, analyzeConcurrencyQuota)
 	if err != nil {
@@ -63,6 +63,17 @@ type PlanCache interface {
 	Close()
 }
 
+// InstancePlanCache is an interface for the plan cache shared by all the sessions in an instance.
+type InstancePlanCache interface {
+	Get(sctx Context, key kvcache.Key, opts *utilpc.PlanCacheMatchOpts) (value kvcache.Value, ok bool)
+	Put(sctx Context, key kvcache.Key, value kvcache.Value, opts *utilpc.PlanCacheMatchOpts)
+	DeleteAll()
+	Size() int
+	MemoryUsage() int64
+	// Entries returns the information of all the cached plans.
+	Entries() []*utilpc.InstancePlanCacheEntry
+}
+
 // Context is an interface for transaction and executive args environment.
 type Context interface {
 	SessionStatesHandler
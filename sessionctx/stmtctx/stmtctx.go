@@ -387,6 +387,21 @@ type StatementContext struct {
 
 	// InHandleForeignKeyTrigger indicates currently are handling foreign key trigger.
 	InHandleForeignKeyTrigger bool
+	// InHandleRoutine indicates the statements in the body of a trigger or a stored function are being executed.
+	InHandleRoutine bool
+
+	// StoredFunctions tracks the stored functions called by the statement, it's used to reject the recursive calls
+	// and to serialize the calls made by the parallel executors, which share the session.
+	StoredFunctions struct {
+		sync.Mutex
+		// Calling is the stack of the qualified names of the stored functions being called.
+		Calling []string
+		// Locks are held by the calls at each nesting level.
+		Locks []*sync.Mutex
+		// ModifiesData indicates the data is modified by the stored functions, so the changes need to be committed
+		// even if the statement calling them is read only.
+		ModifiesData bool
+	}
 
 	// ForeignKeyTriggerCtx is the contain information for foreign key cascade execution.
 	ForeignKeyTriggerCtx struct {
@@ -712,8 +727,9 @@ type TableEntry struct {
 
 // AddAffectedRows adds affected rows.
 func (sc *StatementContext) AddAffectedRows(rows uint64) {
-	if sc.InHandleForeignKeyTrigger {
-		// For compatibility with MySQL, not add the affected row cause by the foreign key trigger.
+	if sc.InHandleForeignKeyTrigger || sc.InHandleRoutine {
+		// For compatibility with MySQL, not add the affected row cause by the foreign key trigger, the triggers or the
+		// stored functions.
 		return
 	}
 	sc.mu.Lock()
@@ -758,6 +774,10 @@ func (sc *StatementContext) RecordRows() uint64 {
 
 // AddRecordRows adds record rows.
 func (sc *StatementContext) AddRecordRows(rows uint64) {
+	if sc.InHandleRoutine {
+		// The rows written by the statements in the triggers or the stored functions are not in the info message.
+		return
+	}
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
 	sc.mu.records += rows
@@ -772,6 +792,9 @@ func (sc *StatementContext) DeletedRows() uint64 {
 
 // AddDeletedRows adds record rows.
 func (sc *StatementContext) AddDeletedRows(rows uint64) {
+	if sc.InHandleRoutine {
+		return
+	}
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
 	sc.mu.deleted += rows
@@ -786,6 +809,9 @@ func (sc *StatementContext) UpdatedRows() uint64 {
 
 // AddUpdatedRows adds updated rows.
 func (sc *StatementContext) AddUpdatedRows(rows uint64) {
+	if sc.InHandleRoutine {
+		return
+	}
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
 	sc.mu.updated += rows
@@ -800,6 +826,9 @@ func (sc *StatementContext) CopiedRows() uint64 {
 
 // AddCopiedRows adds copied rows.
 func (sc *StatementContext) AddCopiedRows(rows uint64) {
+	if sc.InHandleRoutine {
+		return
+	}
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
 	sc.mu.copied += rows
@@ -814,6 +843,9 @@ func (sc *StatementContext) TouchedRows() uint64 {
 
 // AddTouchedRows adds touched rows.
 func (sc *StatementContext) AddTouchedRows(rows uint64) {
+	if sc.InHandleRoutine {
+		return
+	}
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
 	sc.mu.touched += rows
@@ -772,6 +772,10 @@ type SessionVars struct {
 	// InRestrictedSQL indicates if the session is handling restricted SQL execution.
 	InRestrictedSQL bool
 
+	// CallingProcedures are the qualified names of the stored procedures being called by the `CALL` statements, the
+	// outermost one comes first.
+	CallingProcedures []string
+
 	// SnapshotTS is used for reading history data. For simplicity, SnapshotTS only supports distsql request.
 	SnapshotTS uint64
 
@@ -819,6 +823,9 @@ type SessionVars struct {
 	// EnableSkewDistinctAgg can be set true to allow skew distinct aggregate rewrite
 	EnableSkewDistinctAgg bool
 
+	// OptJoinSkewThreshold is the ratio of rows above which a join key value is regarded as a heavy hitter.
+	OptJoinSkewThreshold float64
+
 	// Enable3StageDistinctAgg indicates whether to allow 3 stage distinct aggregate
 	Enable3StageDistinctAgg bool
 
@@ -1009,6 +1016,11 @@ type SessionVars struct {
 	// EnableCascadesPlanner enables the cascades planner.
 	EnableCascadesPlanner bool
 
+	// CascadesJoinReorderLimit is the max number of the joins added to the memo by the join reorder of the cascades
+	// planner for a query. The join orders are pruned by their costs first, and the limit is checked before the
+	// pairs of the inputs are enumerated. The join reorder is disabled if it's 0.
+	CascadesJoinReorderLimit int
+
 	// EnableWindowFunction enables the window function.
 	EnableWindowFunction bool
 
@@ -1552,6 +1564,14 @@ type SessionVars struct {
 	// OptObjectiveModerate: The default value. The optimizer considers the real-time stats (real-time row count, modify count).
 	// OptObjectiveDeterminate: The optimizer doesn't consider the real-time stats.
 	OptObjective string
+
+	// EnableMaterializedViewRewrite indicates whether the optimizer can answer queries from the materialized views.
+	// The views may be stale since they're only updated by REFRESH MATERIALIZED VIEW.
+	EnableMaterializedViewRewrite bool
+
+	// EnableQueryFeedback indicates whether to record the operators whose estimated row counts are far from the actual
+	// ones. The feedback is used to prioritize the tables in auto analyze.
+	EnableQueryFeedback bool
 }
 
 // GetOptimizerFixControlMap returns the specified value of the optimizer fix control.
@@ -1958,6 +1978,7 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		EnableVectorizedExpression:    DefEnableVectorizedExpression,
 		CommandValue:                  uint32(mysql.ComSleep),
 		TiDBOptJoinReorderThreshold:   DefTiDBOptJoinReorderThreshold,
+		CascadesJoinReorderLimit:      DefTiDBCascadesJoinReorderLimit,
 		SlowQueryFile:                 config.GetGlobalConfig().Log.SlowQueryFile,
 		WaitSplitRegionFinish:         DefTiDBWaitSplitRegionFinish,
 		WaitSplitRegionTimeout:        DefWaitSplitRegionTimeout,
@@ -2000,6 +2021,7 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		EnableLegacyInstanceScope:     DefEnableLegacyInstanceScope,
 		RemoveOrderbyInSubquery:       DefTiDBRemoveOrderbyInSubquery,
 		EnableSkewDistinctAgg:         DefTiDBSkewDistinctAgg,
+		OptJoinSkewThreshold:          DefTiDBOptJoinSkewThreshold,
 		Enable3StageDistinctAgg:       DefTiDB3StageDistinctAgg,
 		MaxAllowedPacket:              DefMaxAllowedPacket,
 		TiFlashFastScan:               DefTiFlashFastScan,
@@ -2029,6 +2051,7 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		windowConcurrency:                 DefTiDBWindowConcurrency,
 		mergeJoinConcurrency:              DefTiDBMergeJoinConcurrency,
 		streamAggConcurrency:              DefTiDBStreamAggConcurrency,
+		sortConcurrency:                   DefTiDBSortConcurrency,
 		indexMergeIntersectionConcurrency: DefTiDBIndexMergeIntersectionConcurrency,
 		ExecutorConcurrency:               DefExecutorConcurrency,
 	}
@@ -2753,6 +2776,9 @@ type Concurrency struct {
 	// streamAggConcurrency is deprecated, use ExecutorConcurrency instead.
 	streamAggConcurrency int
 
+	// sortConcurrency is the number of concurrent sort worker.
+	sortConcurrency int
+
 	// indexMergeIntersectionConcurrency is the number of indexMergeProcessWorker
 	// Only meaningful for dynamic pruned partition table.
 	indexMergeIntersectionConcurrency int
@@ -2817,6 +2843,11 @@ func (c *Concurrency) SetStreamAggConcurrency(n int) {
 	c.streamAggConcurrency = n
 }
 
+// SetSortConcurrency set the number of concurrent sort worker.
+func (c *Concurrency) SetSortConcurrency(n int) {
+	c.sortConcurrency = n
+}
+
 // SetIndexMergeIntersectionConcurrency set the number of concurrent intersection process worker.
 func (c *Concurrency) SetIndexMergeIntersectionConcurrency(n int) {
 	c.indexMergeIntersectionConcurrency = n
@@ -2904,6 +2935,14 @@ func (c *Concurrency) StreamAggConcurrency() int {
 	return c.ExecutorConcurrency
 }
 
+// SortConcurrency return the number of concurrent sort worker.
+func (c *Concurrency) SortConcurrency() int {
+	if c.sortConcurrency != ConcurrencyUnset {
+		return c.sortConcurrency
+	}
+	return c.ExecutorConcurrency
+}
+
 // IndexMergeIntersectionConcurrency return the number of concurrent process worker.
 func (c *Concurrency) IndexMergeIntersectionConcurrency() int {
 	if c.indexMergeIntersectionConcurrency != ConcurrencyUnset {
@@ -3649,6 +3688,15 @@ const (
 	OptObjectiveDeterminate = "determinate"
 )
 
+const (
+	// PlanRegressionOff is a possible value and the default value for TiDBCapturePlanRegression.
+	PlanRegressionOff = "OFF"
+	// PlanRegressionPropose is a possible value for TiDBCapturePlanRegression, which only records the regressions.
+	PlanRegressionPropose = "PROPOSE"
+	// PlanRegressionBind is a possible value for TiDBCapturePlanRegression, which fixes the regressions by bindings.
+	PlanRegressionBind = "BIND"
+)
+
 // GetOptObjective return the session variable "tidb_opt_objective".
 // Please see comments of SessionVars.OptObjective for details.
 func (s *SessionVars) GetOptObjective() string {
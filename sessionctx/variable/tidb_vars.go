@@ -65,6 +65,10 @@ const (
 	// TiDBOptSkewDistinctAgg is used to indicate the distinct agg has data skew
 	TiDBOptSkewDistinctAgg = "tidb_opt_skew_distinct_agg"
 
+	// TiDBOptJoinSkewThreshold is the ratio of rows above which a join key value is regarded as a heavy hitter,
+	// the optimizer generates skew-aware plans for the joins whose keys have heavy hitters. 0 disables it.
+	TiDBOptJoinSkewThreshold = "tidb_opt_join_skew_threshold"
+
 	// TiDBOpt3StageDistinctAgg is used to indicate whether to plan and execute the distinct agg in 3 stages
 	TiDBOpt3StageDistinctAgg = "tidb_opt_three_stage_distinct_agg"
 
@@ -432,6 +436,11 @@ const (
 	// TiDBEnableCascadesPlanner is used to control whether to enable the cascades planner.
 	TiDBEnableCascadesPlanner = "tidb_enable_cascades_planner"
 
+	// TiDBCascadesJoinReorderLimit is the max number of the joins added to the memo by the join reorder of the cascades
+	// planner for a query. Only the cheapest join order of each set of inputs is added, and the limit is checked
+	// before the pairs of the inputs are enumerated. The joins are kept in their original order once it's reached.
+	TiDBCascadesJoinReorderLimit = "tidb_cascades_join_reorder_limit"
+
 	// TiDBSkipUTF8Check skips the UTF8 validate process, validate UTF8 has performance cost, if we can make sure
 	// the input string values are valid, we can skip the check.
 	TiDBSkipUTF8Check = "tidb_skip_utf8_check"
@@ -472,6 +481,9 @@ const (
 	// tidb_stream_agg_concurrency is deprecated, use tidb_executor_concurrency instead.
 	TiDBStreamAggConcurrency = "tidb_streamagg_concurrency"
 
+	// TiDBSortConcurrency is used for sort parallel executor.
+	TiDBSortConcurrency = "tidb_sort_concurrency"
+
 	// TiDBIndexMergeIntersectionConcurrency is used for parallel worker of index merge intersection.
 	TiDBIndexMergeIntersectionConcurrency = "tidb_index_merge_intersection_concurrency"
 
@@ -589,6 +601,18 @@ const (
 	// TiDBCapturePlanBaseline indicates whether the capture of plan baselines is enabled.
 	TiDBCapturePlanBaseline = "tidb_capture_plan_baselines"
 
+	// TiDBCapturePlanRegression indicates what to do when a statement switches to a plan which is markedly slower than
+	// its previous plan. It's OFF, PROPOSE (record the binding pinning the previous plan in mysql.plan_regressions) or
+	// BIND (create the binding pinning the previous plan).
+	TiDBCapturePlanRegression = "tidb_capture_plan_regression"
+
+	// TiDBPlanRegressionLatencyRatio is the min ratio of the average latency of the new plan to the previous one for the
+	// new plan to be considered as a regression.
+	TiDBPlanRegressionLatencyRatio = "tidb_plan_regression_latency_ratio"
+
+	// TiDBPlanRegressionMinExecCount is the min number of executions of both plans before they're compared.
+	TiDBPlanRegressionMinExecCount = "tidb_plan_regression_min_exec_count"
+
 	// TiDBUsePlanBaselines indicates whether the use of plan baselines is enabled.
 	TiDBUsePlanBaselines = "tidb_use_plan_baselines"
 
@@ -816,6 +840,11 @@ const (
 	TiDBPlanCacheInvalidationOnFreshStats = "tidb_plan_cache_invalidation_on_fresh_stats"
 	// TiDBSessionPlanCacheSize controls the size of session plan cache.
 	TiDBSessionPlanCacheSize = "tidb_session_plan_cache_size"
+	// TiDBEnableInstancePlanCache indicates whether to share the cached plans of non-prepared statements among all
+	// the sessions in the instance.
+	TiDBEnableInstancePlanCache = "tidb_enable_instance_plan_cache"
+	// TiDBInstancePlanCacheMaxMemSize controls the max memory usage of the instance plan cache.
+	TiDBInstancePlanCacheMaxMemSize = "tidb_instance_plan_cache_max_mem_size"
 
 	// TiDBConstraintCheckInPlacePessimistic controls whether to skip certain kinds of pessimistic locks.
 	TiDBConstraintCheckInPlacePessimistic = "tidb_constraint_check_in_place_pessimistic"
@@ -909,6 +938,13 @@ const (
 	// TiDBOptObjective indicates whether the optimizer should be more stable, predictable or more aggressive.
 	// Please see comments of SessionVars.OptObjective for details.
 	TiDBOptObjective = "tidb_opt_objective"
+
+	// TiDBEnableMaterializedViewRewrite indicates whether the optimizer can answer queries from the materialized views.
+	TiDBEnableMaterializedViewRewrite = "tidb_enable_materialized_view_rewrite"
+
+	// TiDBEnableQueryFeedback indicates whether to compare the estimated and actual row counts of the operators after
+	// execution, and record the significant misestimates in information_schema.query_feedback.
+	TiDBEnableQueryFeedback = "tidb_enable_query_feedback"
 )
 
 // TiDB vars that have only global scope
@@ -1206,12 +1242,14 @@ const (
 	DefTiDBWindowConcurrency                       = ConcurrencyUnset
 	DefTiDBMergeJoinConcurrency                    = 1 // disable optimization by default
 	DefTiDBStreamAggConcurrency                    = 1
+	DefTiDBSortConcurrency                         = 1 // disable optimization by default
 	DefTiDBForcePriority                           = mysql.NoPriority
 	DefEnableWindowFunction                        = true
 	DefEnablePipelinedWindowFunction               = true
 	DefEnableStrictDoubleTypeCheck                 = true
 	DefEnableVectorizedExpression                  = true
 	DefTiDBOptJoinReorderThreshold                 = 0
+	DefTiDBCascadesJoinReorderLimit                = 4096
 	DefTiDBDDLSlowOprThreshold                     = 300
 	DefTiDBUseFastAnalyze                          = false
 	DefTiDBSkipIsolationLevelCheck                 = false
@@ -1270,6 +1308,9 @@ const (
 	DefTiDBStmtSummaryMaxStmtCount                 = 3000
 	DefTiDBStmtSummaryMaxSQLLength                 = 4096
 	DefTiDBCapturePlanBaseline                     = Off
+	DefTiDBCapturePlanRegression                   = PlanRegressionOff
+	DefTiDBPlanRegressionLatencyRatio              = 2.0
+	DefTiDBPlanRegressionMinExecCount              = 10
 	DefTiDBEnableIndexMerge                        = true
 	DefEnableLegacyInstanceScope                   = true
 	DefTiDBTableCacheLease                         = 3 // 3s
@@ -1285,6 +1326,7 @@ const (
 	DefRCReadCheckTS                               = false
 	DefTiDBRemoveOrderbyInSubquery                 = true
 	DefTiDBSkewDistinctAgg                         = false
+	DefTiDBOptJoinSkewThreshold                    = 0.0
 	DefTiDB3StageDistinctAgg                       = true
 	DefTiDB3StageMultiDistinctAgg                  = false
 	DefTiDBOptExplainEvaledSubquery                = false
@@ -1331,6 +1373,8 @@ const (
 	DefTiDBEnableNonPreparedPlanCacheForDML        = false
 	DefTiDBNonPreparedPlanCacheSize                = 100
 	DefTiDBPlanCacheMaxPlanSize                    = 2 * size.MB
+	DefTiDBEnableInstancePlanCache                 = false
+	DefTiDBInstancePlanCacheMaxMemSize             = 100 * size.MB
 	// MaxDDLReorgBatchSize is exported for testing.
 	MaxDDLReorgBatchSize                  int32  = 10240
 	MinDDLReorgBatchSize                  int32  = 32
@@ -1408,6 +1452,8 @@ const (
 	DefTiDBSkipMissingPartitionStats                  = true
 	DefTiDBOptObjective                               = OptObjectiveModerate
 	DefTiDBSchemaVersionCacheLimit                    = 16
+	DefTiDBEnableMaterializedViewRewrite              = false
+	DefTiDBEnableQueryFeedback                        = false
 )
 
 // Process global variables.
@@ -1450,6 +1496,8 @@ var (
 	MaxAutoAnalyzeTime                   = atomic.NewInt64(DefTiDBMaxAutoAnalyzeTime)
 	// variables for plan cache
 	PreparedPlanCacheMemoryGuardRatio = atomic.NewFloat64(DefTiDBPrepPlanCacheMemoryGuardRatio)
+	EnableInstancePlanCache           = atomic.NewBool(DefTiDBEnableInstancePlanCache)
+	InstancePlanCacheMaxMemSize       = atomic.NewUint64(DefTiDBInstancePlanCacheMaxMemSize)
 	EnableDistTask                    = atomic.NewBool(DefTiDBEnableDistTask)
 	DDLForce2Queue                    = atomic.NewBool(false)
 	EnableNoopVariables               = atomic.NewBool(DefTiDBEnableNoopVariables)
@@ -0,0 +1,336 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handle
+
+import (
+	"container/heap"
+	"context"
+	"math"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/domain/infosync"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/statistics"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+)
+
+// The weights of the factors that make up the priority score of an auto analyze job.
+// They sum up to 1, so the score of a job which has never failed is within [0, 1].
+const (
+	autoAnalyzeChangeWeight   = 0.5
+	autoAnalyzeSizeWeight     = 0.2
+	autoAnalyzeIntervalWeight = 0.3
+)
+
+// autoAnalyzeMisestimateBonus is added to the score of a job whose table is misestimated by queries after its last
+// analyze, which makes it rank ahead of the jobs without misestimates in most cases.
+const autoAnalyzeMisestimateBonus = 0.5
+
+// autoAnalyzeQueueBatchSize is the max number of rows written to mysql.auto_analyze_queue by one statement.
+const autoAnalyzeQueueBatchSize = 256
+
+// autoAnalyzeQueueTolerance is the max difference of the scores or the modify ratios with which a job saved in
+// mysql.auto_analyze_queue is considered unchanged.
+const autoAnalyzeQueueTolerance = 0.01
+
+// autoAnalyzeJob is a table, a partition, or a partitioned table in dynamic prune mode that needs to be analyzed.
+type autoAnalyzeJob struct {
+	// analyze re-checks and analyzes the table. It returns false if nothing needs to be analyzed anymore.
+	analyze         func() (bool, error)
+	lastAnalyzeTime time.Time
+	dbName          string
+	tableName       string
+	partitionName   string
+	tableID         int64
+	rowCount        int64
+	modifyRatio     float64
+	failureCount    uint64
+	score           float64
+	// misestimated indicates whether the query feedback shows misestimates on the table after its last analyze.
+	misestimated bool
+}
+
+// calcAutoAnalyzeScore calculates the priority score of an auto analyze job. Tables with more modifications,
+// more rows and older statistics get higher scores, and every past failure lowers the score so that a table
+// which keeps failing won't block the others.
+func calcAutoAnalyzeScore(modifyRatio float64, rowCount int64, lastAnalyzeTime time.Time, failureCount uint64, now time.Time) float64 {
+	change := math.Min(math.Max(modifyRatio, 0), 1)
+	size := 0.0
+	if rowCount > 1 {
+		// 10 billion rows get the full size factor.
+		size = math.Min(math.Log10(float64(rowCount))/10, 1)
+	}
+	// A table that has never been analyzed is considered as stale as possible.
+	interval := 1.0
+	if !lastAnalyzeTime.IsZero() {
+		interval = math.Min(math.Max(now.Sub(lastAnalyzeTime).Hours()/24, 0), 1)
+	}
+	score := autoAnalyzeChangeWeight*change + autoAnalyzeSizeWeight*size + autoAnalyzeIntervalWeight*interval
+	return score / float64(1+failureCount)
+}
+
+// autoAnalyzeModifyRatio returns the ratio of modified rows since the last analyze.
+func autoAnalyzeModifyRatio(tbl *statistics.Table) float64 {
+	if !TableAnalyzed(tbl) {
+		return 1
+	}
+	tblCnt := float64(tbl.RealtimeCount)
+	if histCnt := tbl.GetAnalyzeRowCount(); histCnt > 0 {
+		tblCnt = histCnt
+	}
+	if tblCnt <= 0 {
+		return 0
+	}
+	return float64(tbl.ModifyCount) / tblCnt
+}
+
+// lastAnalyzeTime returns the time when the table was analyzed last time, or a zero time if it has never been analyzed.
+func lastAnalyzeTime(tbl *statistics.Table) time.Time {
+	var version uint64
+	for _, col := range tbl.Columns {
+		if col.IsAnalyzed() && col.LastUpdateVersion > version {
+			version = col.LastUpdateVersion
+		}
+	}
+	for _, idx := range tbl.Indices {
+		if idx.IsAnalyzed() && idx.LastUpdateVersion > version {
+			version = idx.LastUpdateVersion
+		}
+	}
+	if version == 0 {
+		return time.Time{}
+	}
+	return oracle.GetTimeFromTS(version)
+}
+
+// autoAnalyzeQueue is a max heap of auto analyze jobs ordered by their scores.
+type autoAnalyzeQueue []*autoAnalyzeJob
+
+func (q autoAnalyzeQueue) Len() int { return len(q) }
+
+func (q autoAnalyzeQueue) Less(i, j int) bool {
+	if q[i].score != q[j].score {
+		return q[i].score > q[j].score
+	}
+	return q[i].tableID < q[j].tableID
+}
+
+func (q autoAnalyzeQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *autoAnalyzeQueue) Push(x interface{}) {
+	*q = append(*q, x.(*autoAnalyzeJob))
+}
+
+func (q *autoAnalyzeQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	job := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return job
+}
+
+// newAutoAnalyzeQueue scores the jobs and builds the priority queue.
+func newAutoAnalyzeQueue(jobs []*autoAnalyzeJob, failureCounts map[int64]uint64, now time.Time) *autoAnalyzeQueue {
+	q := make(autoAnalyzeQueue, 0, len(jobs))
+	for _, job := range jobs {
+		job.failureCount = failureCounts[job.tableID]
+		job.score = calcAutoAnalyzeScore(job.modifyRatio, job.rowCount, job.lastAnalyzeTime, job.failureCount, now)
+		if job.misestimated {
+			job.score += autoAnalyzeMisestimateBonus / float64(1+job.failureCount)
+		}
+		q = append(q, job)
+	}
+	heap.Init(&q)
+	return &q
+}
+
+func autoAnalyzeInstance() string {
+	serverInfo, err := infosync.GetServerInfo()
+	if err != nil {
+		return "unknown"
+	}
+	return net.JoinHostPort(serverInfo.IP, strconv.Itoa(int(serverInfo.Port)))
+}
+
+// savedAutoAnalyzeJob is a job saved in mysql.auto_analyze_queue.
+type savedAutoAnalyzeJob struct {
+	dbName        string
+	tableName     string
+	partitionName string
+	score         float64
+	modifyRatio   float64
+	rowCount      int64
+	// lastAnalyzeTime is the unix time in seconds, it's 0 if the table has never been analyzed.
+	lastAnalyzeTime int64
+	failureCount    uint64
+	running         bool
+}
+
+// changed returns whether the saved job needs to be rewritten for the job. The interval factor of the score grows
+// as time goes by, so the score and the modify ratio are compared with a tolerance, otherwise every job would be
+// rewritten in every round.
+func (s *savedAutoAnalyzeJob) changed(job *autoAnalyzeJob) bool {
+	var analyzeTime int64
+	if !job.lastAnalyzeTime.IsZero() {
+		analyzeTime = job.lastAnalyzeTime.Unix()
+	}
+	return s.running || s.dbName != job.dbName || s.tableName != job.tableName || s.partitionName != job.partitionName ||
+		math.Abs(s.score-job.score) > autoAnalyzeQueueTolerance ||
+		math.Abs(s.modifyRatio-job.modifyRatio) > autoAnalyzeQueueTolerance ||
+		s.rowCount != job.rowCount || s.lastAnalyzeTime != analyzeTime || s.failureCount != job.failureCount
+}
+
+// loadAutoAnalyzeQueue loads the jobs in mysql.auto_analyze_queue.
+func (h *Handle) loadAutoAnalyzeQueue() (map[int64]*savedAutoAnalyzeJob, error) {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	rows, _, err := h.execRestrictedSQL(ctx, "select table_id, table_schema, table_name, partition_name, score, modify_ratio, row_count, ifnull(unix_timestamp(last_analyze_time), 0), failure_count, state from mysql.auto_analyze_queue")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	saved := make(map[int64]*savedAutoAnalyzeJob, len(rows))
+	for _, row := range rows {
+		saved[row.GetInt64(0)] = &savedAutoAnalyzeJob{
+			dbName:          row.GetString(1),
+			tableName:       row.GetString(2),
+			partitionName:   row.GetString(3),
+			score:           row.GetFloat64(4),
+			modifyRatio:     row.GetFloat64(5),
+			rowCount:        row.GetInt64(6),
+			lastAnalyzeTime: row.GetInt64(7),
+			failureCount:    row.GetUint64(8),
+			running:         row.GetEnum(9).String() == "running",
+		}
+	}
+	return saved, nil
+}
+
+// saveAutoAnalyzeQueue makes mysql.auto_analyze_queue consistent with the jobs, so that every TiDB instance can see
+// which tables are waiting for auto analyze. Only the changed jobs are written and the stale ones are deleted, and
+// nothing is written if the saved jobs are up to date. The jobs are saved when no job is running, so the jobs marked
+// as running are left by the instances that failed to finish them and are put back to the pending state.
+func (h *Handle) saveAutoAnalyzeQueue(jobs autoAnalyzeQueue, saved map[int64]*savedAutoAnalyzeJob) (err error) {
+	var changed []*autoAnalyzeJob
+	for _, job := range jobs {
+		if s, ok := saved[job.tableID]; !ok || s.changed(job) {
+			changed = append(changed, job)
+		}
+	}
+	current := make(map[int64]struct{}, len(jobs))
+	for _, job := range jobs {
+		current[job.tableID] = struct{}{}
+	}
+	var stale []int64
+	for tableID := range saved {
+		if _, ok := current[tableID]; !ok {
+			stale = append(stale, tableID)
+		}
+	}
+	if len(changed) == 0 && len(stale) == 0 {
+		return nil
+	}
+	slices.Sort(stale)
+
+	se, err := h.pool.Get()
+	if err != nil {
+		return err
+	}
+	defer h.pool.Put(se)
+	exec := se.(sqlexec.SQLExecutor)
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+
+	_, err = exec.ExecuteInternal(ctx, "begin pessimistic")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		err = finishTransaction(ctx, exec, err)
+	}()
+	for i := 0; i < len(stale); i += autoAnalyzeQueueBatchSize {
+		end := min(i+autoAnalyzeQueueBatchSize, len(stale))
+		if _, err = exec.ExecuteInternal(ctx, "delete from mysql.auto_analyze_queue where table_id in (%?)", stale[i:end]); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < len(changed); i += autoAnalyzeQueueBatchSize {
+		end := min(i+autoAnalyzeQueueBatchSize, len(changed))
+		var sql strings.Builder
+		sql.WriteString("insert into mysql.auto_analyze_queue (table_id, table_schema, table_name, partition_name, score, modify_ratio, row_count, last_analyze_time, failure_count, state) values ")
+		for j, job := range changed[i:end] {
+			if j > 0 {
+				sql.WriteString(", ")
+			}
+			var analyzeTime interface{}
+			if !job.lastAnalyzeTime.IsZero() {
+				analyzeTime = job.lastAnalyzeTime.UTC().Format(types.TimeFormat)
+			}
+			sqlexec.MustFormatSQL(&sql, "(%?, %?, %?, %?, %?, %?, %?, CONVERT_TZ(%?, '+00:00', @@TIME_ZONE), %?, 'pending')",
+				job.tableID, job.dbName, job.tableName, job.partitionName, job.score, job.modifyRatio, job.rowCount, analyzeTime, job.failureCount)
+		}
+		sql.WriteString(" on duplicate key update table_schema = values(table_schema), table_name = values(table_name), " +
+			"partition_name = values(partition_name), score = values(score), modify_ratio = values(modify_ratio), " +
+			"row_count = values(row_count), last_analyze_time = values(last_analyze_time), " +
+			"failure_count = values(failure_count), state = 'pending', instance = ''")
+		if _, err = exec.ExecuteInternal(ctx, sql.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startAutoAnalyzeJob marks the job in mysql.auto_analyze_queue as running by the current instance.
+func (h *Handle) startAutoAnalyzeJob(job *autoAnalyzeJob) error {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	_, _, err := h.execRestrictedSQL(ctx, "update mysql.auto_analyze_queue set state = 'running', instance = %? where table_id = %?", autoAnalyzeInstance(), job.tableID)
+	return err
+}
+
+// finishAutoAnalyzeJob removes the job from mysql.auto_analyze_queue if it succeeded. Otherwise, the job is put back
+// to the pending state, and its failure count is increased if analyze failed.
+func (h *Handle) finishAutoAnalyzeJob(job *autoAnalyzeJob, analyzed bool, analyzeErr error) error {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	var err error
+	switch {
+	case analyzeErr != nil:
+		_, _, err = h.execRestrictedSQL(ctx, "update mysql.auto_analyze_queue set state = 'pending', instance = '', failure_count = failure_count + 1 where table_id = %?", job.tableID)
+	case analyzed:
+		_, _, err = h.execRestrictedSQL(ctx, "delete from mysql.auto_analyze_queue where table_id = %?", job.tableID)
+	default:
+		_, _, err = h.execRestrictedSQL(ctx, "update mysql.auto_analyze_queue set state = 'pending', instance = '' where table_id = %?", job.tableID)
+	}
+	return err
+}
+
+// runAutoAnalyzeJob runs the job and records its progress in mysql.auto_analyze_queue.
+// Failing to maintain the queue table doesn't stop the job from running.
+func (h *Handle) runAutoAnalyzeJob(job *autoAnalyzeJob) (analyzed bool) {
+	if err := h.startAutoAnalyzeJob(job); err != nil {
+		logutil.BgLogger().Warn("fail to mark auto analyze job as running", zap.String("category", "stats"), zap.Int64("table_id", job.tableID), zap.Error(err))
+	}
+	analyzed, analyzeErr := job.analyze()
+	if err := h.finishAutoAnalyzeJob(job, analyzed, analyzeErr); err != nil {
+		logutil.BgLogger().Warn("fail to update auto analyze job", zap.String("category", "stats"), zap.Int64("table_id", job.tableID), zap.Error(err))
+	}
+	return analyzed || analyzeErr != nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handle
+
+import (
+	"container/heap"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcAutoAnalyzeScore(t *testing.T) {
+	now := time.Now()
+	// A table which has never been analyzed gets the full interval factor.
+	require.InDelta(t, 0.5+0.2*0.3+0.3, calcAutoAnalyzeScore(1, 1000, time.Time{}, 0, now), 1e-9)
+	// The modify ratio and the interval factor are capped.
+	require.InDelta(t, 0.5+0.2*0.3+0.3, calcAutoAnalyzeScore(5, 1000, now.Add(-72*time.Hour), 0, now), 1e-9)
+	require.InDelta(t, 0.5*0.6+0.2*0.3+0.3*0.5, calcAutoAnalyzeScore(0.6, 1000, now.Add(-12*time.Hour), 0, now), 1e-9)
+	// Larger tables get higher scores.
+	require.Greater(t, calcAutoAnalyzeScore(0.6, 100000000, now, 0, now), calcAutoAnalyzeScore(0.6, 1000, now, 0, now))
+	// Every failure lowers the score.
+	require.InDelta(t, calcAutoAnalyzeScore(1, 1000, now, 0, now)/3, calcAutoAnalyzeScore(1, 1000, now, 2, now), 1e-9)
+}
+
+func TestAutoAnalyzeQueue(t *testing.T) {
+	now := time.Now()
+	jobs := []*autoAnalyzeJob{
+		{tableID: 1, modifyRatio: 0.6, rowCount: 1000, lastAnalyzeTime: now.Add(-time.Hour)},
+		{tableID: 2, modifyRatio: 0.6, rowCount: 100000000, lastAnalyzeTime: now.Add(-24 * time.Hour)},
+		{tableID: 3, modifyRatio: 1, rowCount: 100000000},
+		{tableID: 4, modifyRatio: 0.6, rowCount: 1000, lastAnalyzeTime: now.Add(-time.Hour)},
+	}
+	q := newAutoAnalyzeQueue(jobs, map[int64]uint64{3: 5}, now)
+	require.Equal(t, uint64(5), jobs[2].failureCount)
+	var order []int64
+	for q.Len() > 0 {
+		order = append(order, heap.Pop(q).(*autoAnalyzeJob).tableID)
+	}
+	require.Equal(t, []int64{2, 1, 4, 3}, order)
+
+	// The misestimated table is moved ahead, while the failures still lower its score.
+	jobs = []*autoAnalyzeJob{
+		{tableID: 1, modifyRatio: 0.8, rowCount: 100000, lastAnalyzeTime: now.Add(-2 * time.Hour)},
+		{tableID: 2, modifyRatio: 0.6, rowCount: 1000, lastAnalyzeTime: now.Add(-time.Hour), misestimated: true},
+		{tableID: 3, modifyRatio: 0.6, rowCount: 1000, lastAnalyzeTime: now.Add(-time.Hour), misestimated: true},
+	}
+	q = newAutoAnalyzeQueue(jobs, map[int64]uint64{3: 5}, now)
+	order = order[:0]
+	for q.Len() > 0 {
+		order = append(order, heap.Pop(q).(*autoAnalyzeJob).tableID)
+	}
+	require.Equal(t, []int64{2, 1, 3}, order)
+}
+
+func TestSavedAutoAnalyzeJobChanged(t *testing.T) {
+	now := time.Now()
+	job := &autoAnalyzeJob{tableID: 1, dbName: "test", tableName: "t", modifyRatio: 0.6, rowCount: 1000, lastAnalyzeTime: now, score: 0.4}
+	saved := &savedAutoAnalyzeJob{dbName: "test", tableName: "t", modifyRatio: 0.6, rowCount: 1000, lastAnalyzeTime: now.Unix(), score: 0.4}
+	require.False(t, saved.changed(job))
+	// The score grows slightly as time goes by, which is ignored.
+	job.score = 0.405
+	require.False(t, saved.changed(job))
+	job.score = 0.42
+	require.True(t, saved.changed(job))
+	job.score = 0.4
+	job.rowCount = 1001
+	require.True(t, saved.changed(job))
+	job.rowCount = 1000
+	job.lastAnalyzeTime = time.Time{}
+	require.True(t, saved.changed(job))
+	job.lastAnalyzeTime = now
+	// The jobs left running are put back to the pending state.
+	saved.running = true
+	require.True(t, saved.changed(job))
+}
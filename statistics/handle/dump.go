@@ -529,6 +529,7 @@ func TableStatsFromJSON(tableInfo *model.TableInfo, physicalID int64, jsonTbl *J
 		}
 	}
 	tbl.ExtendedStats = extendedStatsFromJSON(jsonTbl.ExtStats)
+	tbl.JointStats = statistics.JointStatsFromExtendedStats(tbl.ExtendedStats)
 	return tbl, nil
 }
 
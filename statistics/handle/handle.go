@@ -89,6 +89,9 @@ type Handle struct {
 	// statsUsage contains all the column stats usage information from collectors when we dump them to KV.
 	statsUsage *statsUsage
 
+	// queryFeedback contains the misestimates reported by the sessions.
+	queryFeedback *queryFeedbackStore
+
 	globalstatushandler *globalstats.GlobalStatusHandler
 
 	// StatsLoad is used to load stats concurrently
@@ -196,6 +199,7 @@ func NewHandle(_, initStatsCtx sessionctx.Context, lease time.Duration, pool ses
 	handle.statsCache = statsCache
 	handle.tableDelta = newTableDelta()
 	handle.statsUsage = newStatsUsage()
+	handle.queryFeedback = newQueryFeedbackStore()
 	handle.StatsLoad.SubCtxs = make([]sessionctx.Context, cfg.Performance.StatsLoadConcurrency)
 	handle.StatsLoad.NeededItemsCh = make(chan *NeededItemTask, cfg.Performance.StatsLoadQueueSize)
 	handle.StatsLoad.TimeoutItemsCh = make(chan *NeededItemTask, cfg.Performance.StatsLoadQueueSize)
@@ -644,6 +648,9 @@ func (h *Handle) FlushStats() {
 	if err := h.DumpStatsDeltaToKV(DumpAll); err != nil {
 		logutil.BgLogger().Error("dump stats delta fail", zap.String("category", "stats"), zap.Error(err))
 	}
+	if err := h.DumpQueryFeedbackToKV(); err != nil {
+		logutil.BgLogger().Error("dump query feedback fail", zap.String("category", "stats"), zap.Error(err))
+	}
 }
 
 // TableStatsFromStorage loads table stats info from storage.
@@ -997,7 +1004,7 @@ func SaveTableStatsToStorage(sctx sessionctx.Context, results *statistics.Analyz
 		switch item.Tp {
 		case ast.StatsTypeCardinality, ast.StatsTypeCorrelation:
 			statsStr = fmt.Sprintf("%f", item.ScalarVals)
-		case ast.StatsTypeDependency:
+		case ast.StatsTypeDependency, ast.StatsTypeJoint:
 			statsStr = item.StringVals
 		}
 		if _, err = exec.ExecuteInternal(ctx, "replace into mysql.stats_extended values (%?, %?, %?, %?, %?, %?, %?)", name, item.Tp, tableID, strColIDs, statsStr, version, statistics.ExtendedStatsAnalyzed); err != nil {
@@ -1503,7 +1510,7 @@ func (h *Handle) SaveExtendedStatsToStorage(tableID int64, extStats *statistics.
 		switch item.Tp {
 		case ast.StatsTypeCardinality, ast.StatsTypeCorrelation:
 			statsStr = fmt.Sprintf("%f", item.ScalarVals)
-		case ast.StatsTypeDependency:
+		case ast.StatsTypeDependency, ast.StatsTypeJoint:
 			statsStr = item.StringVals
 		}
 		// If isLoad is true, it's INSERT; otherwise, it's UPDATE.
@@ -0,0 +1,220 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handle
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/statistics"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+const (
+	// maxQueryFeedbackCount is the max number of the query feedback kept in memory. The least recently seen ones are
+	// evicted when it's exceeded.
+	maxQueryFeedbackCount = 1024
+	// queryFeedbackBatchSize is the max number of rows written to mysql.query_feedback by one statement.
+	queryFeedbackBatchSize = 256
+	// QueryFeedbackKeepDuration is how long the query feedback is kept in mysql.query_feedback.
+	QueryFeedbackKeepDuration = 7 * 24 * time.Hour
+)
+
+type queryFeedbackKey struct {
+	planDigest string
+	operator   string
+}
+
+// queryFeedbackStore keeps the recent misestimates reported by the sessions.
+// All methods of it are thread-safe.
+type queryFeedbackStore struct {
+	items map[queryFeedbackKey]*statistics.QueryFeedback
+	// dirty contains the items changed since they were dumped to mysql.query_feedback last time.
+	dirty map[queryFeedbackKey]struct{}
+	lock  sync.Mutex
+}
+
+func newQueryFeedbackStore() *queryFeedbackStore {
+	return &queryFeedbackStore{
+		items: make(map[queryFeedbackKey]*statistics.QueryFeedback),
+		dirty: make(map[queryFeedbackKey]struct{}),
+	}
+}
+
+func (s *queryFeedbackStore) record(feedback []*statistics.QueryFeedback) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, fb := range feedback {
+		key := queryFeedbackKey{planDigest: fb.PlanDigest, operator: fb.Operator}
+		if item, ok := s.items[key]; ok {
+			// The same operator of the same plan is merged, and the latest row counts are kept.
+			item.EstRows, item.ActRows, item.Predicate = fb.EstRows, fb.ActRows, fb.Predicate
+			item.SQLDigest, item.LastSeen = fb.SQLDigest, fb.LastSeen
+			item.Count++
+			s.dirty[key] = struct{}{}
+			continue
+		}
+		item := *fb
+		item.Count = 1
+		s.items[key] = &item
+		s.dirty[key] = struct{}{}
+	}
+	if len(s.items) > maxQueryFeedbackCount {
+		s.evict(len(s.items) - maxQueryFeedbackCount)
+	}
+}
+
+// evict removes the n least recently seen items.
+func (s *queryFeedbackStore) evict(n int) {
+	keys := make([]queryFeedbackKey, 0, len(s.items))
+	for key := range s.items {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return s.items[keys[i]].LastSeen.Before(s.items[keys[j]].LastSeen)
+	})
+	for _, key := range keys[:n] {
+		delete(s.items, key)
+		delete(s.dirty, key)
+	}
+}
+
+// sweepDirty returns the copies of the dirty items and clears the dirty set.
+func (s *queryFeedbackStore) sweepDirty() []statistics.QueryFeedback {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	feedback := make([]statistics.QueryFeedback, 0, len(s.dirty))
+	for key := range s.dirty {
+		feedback = append(feedback, *s.items[key])
+	}
+	s.dirty = make(map[queryFeedbackKey]struct{})
+	return feedback
+}
+
+// markDirty marks the items as dirty again if they are still kept, it's used when they failed to be dumped.
+func (s *queryFeedbackStore) markDirty(feedback []statistics.QueryFeedback) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, fb := range feedback {
+		key := queryFeedbackKey{planDigest: fb.PlanDigest, operator: fb.Operator}
+		if _, ok := s.items[key]; ok {
+			s.dirty[key] = struct{}{}
+		}
+	}
+}
+
+func (s *queryFeedbackStore) list() []statistics.QueryFeedback {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	feedback := make([]statistics.QueryFeedback, 0, len(s.items))
+	for _, item := range s.items {
+		feedback = append(feedback, *item)
+	}
+	sort.Slice(feedback, func(i, j int) bool {
+		return feedback[i].LastSeen.After(feedback[j].LastSeen)
+	})
+	return feedback
+}
+
+// feedbackAutoAnalyzeRatio halves the auto analyze ratio of the table if it's misestimated by queries after its last
+// analyze, so that it gets analyzed earlier. It also returns whether the table is misestimated. The lastSeen is the
+// time when the misestimates are seen last time by any TiDB instance, which is loaded by loadQueryFeedbackLastSeen.
+func feedbackAutoAnalyzeRatio(lastSeen map[int64]time.Time, tableID int64, statsTbl *statistics.Table, ratio float64) (float64, bool) {
+	seen, ok := lastSeen[tableID]
+	if !ok || !seen.After(lastAnalyzeTime(statsTbl)) {
+		return ratio, false
+	}
+	return ratio / 2, true
+}
+
+// loadQueryFeedbackLastSeen loads the time when the misestimates are seen last time for every table from
+// mysql.query_feedback, which contains the query feedback dumped by all the TiDB instances.
+func (h *Handle) loadQueryFeedbackLastSeen() (map[int64]time.Time, error) {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	rows, _, err := h.execRestrictedSQL(ctx, "select table_id, cast(unix_timestamp(max(last_seen)) * 1000000 as signed) from mysql.query_feedback group by table_id")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	lastSeen := make(map[int64]time.Time, len(rows))
+	for _, row := range rows {
+		lastSeen[row.GetInt64(0)] = time.UnixMicro(row.GetInt64(1))
+	}
+	return lastSeen, nil
+}
+
+// DumpQueryFeedbackToKV writes the query feedback changed since the last dump to mysql.query_feedback in batches.
+// Every TiDB instance dumps its own feedback, so that the stats owner can see the misestimates found by all of them.
+func (h *Handle) DumpQueryFeedbackToKV() (err error) {
+	feedback := h.queryFeedback.sweepDirty()
+	if len(feedback) == 0 {
+		return nil
+	}
+	defer func() {
+		if err != nil {
+			h.queryFeedback.markDirty(feedback)
+		}
+	}()
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	instance := autoAnalyzeInstance()
+	for i := 0; i < len(feedback); i += queryFeedbackBatchSize {
+		end := min(i+queryFeedbackBatchSize, len(feedback))
+		var sql strings.Builder
+		sql.WriteString("insert into mysql.query_feedback (instance, plan_digest, operator, sql_digest, table_id, table_schema, table_name, predicate, est_rows, act_rows, exec_count, last_seen) values ")
+		for j, fb := range feedback[i:end] {
+			if j > 0 {
+				sql.WriteString(", ")
+			}
+			sqlexec.MustFormatSQL(&sql, "(%?, %?, %?, %?, %?, %?, %?, %?, %?, %?, %?, CONVERT_TZ(%?, '+00:00', @@TIME_ZONE))",
+				instance, fb.PlanDigest, fb.Operator, fb.SQLDigest, fb.TableID, fb.DBName, fb.TableName, fb.Predicate,
+				fb.EstRows, fb.ActRows, fb.Count, fb.LastSeen.UTC().Format(types.TimeFSPFormat))
+		}
+		sql.WriteString(" on duplicate key update sql_digest = values(sql_digest), table_id = values(table_id), " +
+			"table_schema = values(table_schema), table_name = values(table_name), predicate = values(predicate), " +
+			"est_rows = values(est_rows), act_rows = values(act_rows), exec_count = values(exec_count), last_seen = values(last_seen)")
+		if _, _, err = h.execRestrictedSQL(ctx, sql.String()); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// GCQueryFeedback deletes the query feedback that isn't seen in the keep duration from mysql.query_feedback.
+func (h *Handle) GCQueryFeedback(keepDuration time.Duration) error {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	expired := time.Now().Add(-keepDuration).UTC().Format(types.TimeFSPFormat)
+	_, _, err := h.execRestrictedSQL(ctx, "delete from mysql.query_feedback where last_seen < CONVERT_TZ(%?, '+00:00', @@TIME_ZONE)", expired)
+	return errors.Trace(err)
+}
+
+// RecordQueryFeedback records the misestimates found after executing a statement. They are dumped to
+// mysql.query_feedback by DumpQueryFeedbackToKV, and the tables with misestimates seen after their last analyze get
+// a higher priority in auto analyze.
+func (h *Handle) RecordQueryFeedback(feedback []*statistics.QueryFeedback) {
+	if len(feedback) == 0 {
+		return
+	}
+	h.queryFeedback.record(feedback)
+}
+
+// QueryFeedback returns the recorded misestimates, the most recently seen first.
+func (h *Handle) QueryFeedback() []statistics.QueryFeedback {
+	return h.queryFeedback.list()
+}
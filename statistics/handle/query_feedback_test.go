@@ -0,0 +1,95 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handle
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/statistics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryFeedbackStore(t *testing.T) {
+	now := time.Now()
+	s := newQueryFeedbackStore()
+	s.record([]*statistics.QueryFeedback{
+		{PlanDigest: "p1", Operator: "TableFullScan_5", TableID: 1, EstRows: 10, ActRows: 1000, LastSeen: now},
+		{PlanDigest: "p1", Operator: "Selection_6", TableID: 1, EstRows: 10, ActRows: 500, LastSeen: now},
+	})
+	// The same operator of the same plan is merged.
+	s.record([]*statistics.QueryFeedback{
+		{PlanDigest: "p1", Operator: "TableFullScan_5", TableID: 1, EstRows: 10, ActRows: 2000, LastSeen: now.Add(time.Second)},
+	})
+	feedback := s.list()
+	require.Len(t, feedback, 2)
+	require.Equal(t, "TableFullScan_5", feedback[0].Operator)
+	require.Equal(t, int64(2000), feedback[0].ActRows)
+	require.Equal(t, uint64(2), feedback[0].Count)
+	require.Equal(t, uint64(1), feedback[1].Count)
+
+	// Only the changed items are dumped.
+	require.Len(t, s.sweepDirty(), 2)
+	require.Len(t, s.sweepDirty(), 0)
+	s.record([]*statistics.QueryFeedback{
+		{PlanDigest: "p1", Operator: "Selection_6", TableID: 1, EstRows: 10, ActRows: 600, LastSeen: now.Add(time.Second)},
+	})
+	dirty := s.sweepDirty()
+	require.Len(t, dirty, 1)
+	require.Equal(t, int64(600), dirty[0].ActRows)
+	// The items failed to be dumped are dumped again.
+	s.markDirty(dirty)
+	require.Len(t, s.sweepDirty(), 1)
+
+	// The least recently seen ones are evicted.
+	for i := 0; i < maxQueryFeedbackCount; i++ {
+		s.record([]*statistics.QueryFeedback{
+			{PlanDigest: fmt.Sprintf("p%d", i+2), Operator: "TableFullScan_5", TableID: 2, LastSeen: now.Add(time.Minute)},
+		})
+	}
+	feedback = s.list()
+	require.Len(t, feedback, maxQueryFeedbackCount)
+	for _, fb := range feedback {
+		require.Equal(t, int64(2), fb.TableID)
+	}
+	// The evicted items are not dumped.
+	require.Len(t, s.sweepDirty(), maxQueryFeedbackCount)
+}
+
+func TestFeedbackAutoAnalyzeRatio(t *testing.T) {
+	now := time.Now()
+	lastSeen := map[int64]time.Time{1: now}
+	tbl := &statistics.Table{}
+	// The table which has never been analyzed is misestimated by any feedback.
+	ratio, misestimated := feedbackAutoAnalyzeRatio(lastSeen, 1, tbl, 0.5)
+	require.True(t, misestimated)
+	require.Equal(t, 0.25, ratio)
+	ratio, misestimated = feedbackAutoAnalyzeRatio(lastSeen, 2, tbl, 0.5)
+	require.False(t, misestimated)
+	require.Equal(t, 0.5, ratio)
+	ratio, misestimated = feedbackAutoAnalyzeRatio(nil, 1, tbl, 0.5)
+	require.False(t, misestimated)
+	require.Equal(t, 0.5, ratio)
+}
+
+func TestIsSignificantMisestimate(t *testing.T) {
+	require.True(t, statistics.IsSignificantMisestimate(10, 1000))
+	require.True(t, statistics.IsSignificantMisestimate(1000, 0))
+	// The difference is too small.
+	require.False(t, statistics.IsSignificantMisestimate(1, 90))
+	// The ratio is too small.
+	require.False(t, statistics.IsSignificantMisestimate(1000, 5000))
+}
@@ -16,10 +16,10 @@ package handle
 
 import (
 	"cmp"
+	"container/heap"
 	"context"
 	"fmt"
 	"math"
-	"math/rand"
 	"slices"
 	"strconv"
 	"strings"
@@ -792,22 +792,18 @@ func (h *Handle) HandleAutoAnalyze(is infoschema.InfoSchema) (analyzed bool) {
 	}
 	pruneMode := variable.PartitionPruneMode(sctx.GetSessionVars().PartitionPruneMode.Load())
 	analyzeSnapshot := sctx.GetSessionVars().EnableAnalyzeSnapshot
-	rd := rand.New(rand.NewSource(time.Now().UnixNano())) // #nosec G404
-	rd.Shuffle(len(dbs), func(i, j int) {
-		dbs[i], dbs[j] = dbs[j], dbs[i]
-	})
+	// The query feedback is dumped by all the TiDB instances, so it's loaded from the storage rather than the memory.
+	feedbackLastSeen, err := h.loadQueryFeedbackLastSeen()
+	if err != nil {
+		logutil.BgLogger().Warn("load query feedback failed", zap.String("category", "stats"), zap.Error(err))
+	}
+	var jobs []*autoAnalyzeJob
 	for _, db := range dbs {
 		if util.IsMemOrSysDB(strings.ToLower(db)) {
 			continue
 		}
+		db := db
 		tbls := is.SchemaTables(model.NewCIStr(db))
-		// We shuffle dbs and tbls so that the order of iterating tables is random. If the order is fixed and the auto
-		// analyze job of one table fails for some reason, it may always analyze the same table and fail again and again
-		// when the HandleAutoAnalyze is triggered. Randomizing the order can avoid the problem.
-		// TODO: Design a priority queue to place the table which needs analyze most in the front.
-		rd.Shuffle(len(tbls), func(i, j int) {
-			tbls[i], tbls[j] = tbls[j], tbls[i]
-		})
 
 		// We need to check every partition of every table to see if it needs to be analyzed.
 		tidsAndPids := make([]int64, 0, len(tbls))
@@ -842,13 +838,22 @@ func (h *Handle) HandleAutoAnalyze(is infoschema.InfoSchema) (analyzed bool) {
 			pi := tblInfo.GetPartitionInfo()
 			if pi == nil {
 				statsTbl := h.GetTableStats(tblInfo)
-				sql := "analyze table %n.%n"
-				analyzed := h.autoAnalyzeTable(tblInfo, statsTbl, autoAnalyzeRatio, analyzeSnapshot, sql, db, tblInfo.Name.O)
-				if analyzed {
-					// analyze one table at a time to let it get the freshest parameters.
-					// others will be analyzed next round which is just 3s later.
-					return true
+				ratio, misestimated := feedbackAutoAnalyzeRatio(feedbackLastSeen, tblInfo.ID, statsTbl, autoAnalyzeRatio)
+				if !h.needAutoAnalyze(tblInfo, statsTbl, ratio) {
+					continue
 				}
+				jobs = append(jobs, &autoAnalyzeJob{
+					tableID:         tblInfo.ID,
+					dbName:          db,
+					tableName:       tblInfo.Name.O,
+					modifyRatio:     autoAnalyzeModifyRatio(statsTbl),
+					rowCount:        statsTbl.RealtimeCount,
+					lastAnalyzeTime: lastAnalyzeTime(statsTbl),
+					misestimated:    misestimated,
+					analyze: func() (bool, error) {
+						return h.autoAnalyzeTable(tblInfo, statsTbl, ratio, analyzeSnapshot, "analyze table %n.%n", db, tblInfo.Name.O)
+					},
+				})
 				continue
 			}
 			// Only analyze the partition that has not been locked.
@@ -859,43 +864,139 @@ func (h *Handle) HandleAutoAnalyze(is infoschema.InfoSchema) (analyzed bool) {
 				}
 			}
 			if pruneMode == variable.Dynamic {
-				analyzed := h.autoAnalyzePartitionTableInDynamicMode(tblInfo, partitionDefs, db, autoAnalyzeRatio, analyzeSnapshot)
-				if analyzed {
-					return true
+				// The feedback is recorded on the logical table, so it's compared with the global stats.
+				ratio, misestimated := feedbackAutoAnalyzeRatio(feedbackLastSeen, tblInfo.ID, h.GetTableStats(tblInfo), autoAnalyzeRatio)
+				if job := h.newDynamicPartitionAutoAnalyzeJob(tblInfo, partitionDefs, db, ratio, analyzeSnapshot); job != nil {
+					job.misestimated = misestimated
+					jobs = append(jobs, job)
 				}
 				continue
 			}
 			for _, def := range partitionDefs {
-				sql := "analyze table %n.%n partition %n"
 				statsTbl := h.GetPartitionStats(tblInfo, def.ID)
-				analyzed := h.autoAnalyzeTable(tblInfo, statsTbl, autoAnalyzeRatio, analyzeSnapshot, sql, db, tblInfo.Name.O, def.Name.O)
-				if analyzed {
-					return true
+				ratio, misestimated := feedbackAutoAnalyzeRatio(feedbackLastSeen, tblInfo.ID, statsTbl, autoAnalyzeRatio)
+				if !h.needAutoAnalyze(tblInfo, statsTbl, ratio) {
+					continue
 				}
+				partitionName := def.Name.O
+				jobs = append(jobs, &autoAnalyzeJob{
+					tableID:         def.ID,
+					dbName:          db,
+					tableName:       tblInfo.Name.O,
+					partitionName:   partitionName,
+					modifyRatio:     autoAnalyzeModifyRatio(statsTbl),
+					rowCount:        statsTbl.RealtimeCount,
+					lastAnalyzeTime: lastAnalyzeTime(statsTbl),
+					misestimated:    misestimated,
+					analyze: func() (bool, error) {
+						return h.autoAnalyzeTable(tblInfo, statsTbl, ratio, analyzeSnapshot, "analyze table %n.%n partition %n", db, tblInfo.Name.O, partitionName)
+					},
+				})
 			}
 		}
 	}
+
+	// Failures are remembered in mysql.auto_analyze_queue, so a table which keeps failing sinks in the queue
+	// even if the stats owner changes, instead of being retried again and again.
+	saved, err := h.loadAutoAnalyzeQueue()
+	if err != nil {
+		logutil.BgLogger().Warn("load auto analyze queue failed", zap.String("category", "stats"), zap.Error(err))
+	}
+	failureCounts := make(map[int64]uint64, len(saved))
+	for tableID, job := range saved {
+		failureCounts[tableID] = job.failureCount
+	}
+	queue := newAutoAnalyzeQueue(jobs, failureCounts, time.Now())
+	// The stale jobs can't be found if the saved jobs failed to load, so the queue is not saved.
+	if err == nil {
+		if err := h.saveAutoAnalyzeQueue(*queue, saved); err != nil {
+			logutil.BgLogger().Warn("save auto analyze queue failed", zap.String("category", "stats"), zap.Error(err))
+		}
+	}
+	for queue.Len() > 0 {
+		job := heap.Pop(queue).(*autoAnalyzeJob)
+		if h.runAutoAnalyzeJob(job) {
+			// analyze one table at a time to let it get the freshest parameters.
+			// others will be analyzed next round which is just 3s later.
+			return true
+		}
+	}
 	return false
 }
 
-func (h *Handle) autoAnalyzeTable(tblInfo *model.TableInfo, statsTbl *statistics.Table, ratio float64, analyzeSnapshot bool, sql string, params ...interface{}) bool {
+// needAutoAnalyze checks whether autoAnalyzeTable is going to analyze the table.
+func (h *Handle) needAutoAnalyze(tblInfo *model.TableInfo, statsTbl *statistics.Table, ratio float64) bool {
 	if statsTbl.Pseudo || statsTbl.RealtimeCount < AutoAnalyzeMinCnt {
 		return false
 	}
+	if needAnalyze, _ := NeedAnalyzeTable(statsTbl, 20*h.Lease(), ratio); needAnalyze {
+		return true
+	}
+	return hasUnanalyzedIndex(tblInfo, statsTbl)
+}
+
+func hasUnanalyzedIndex(tblInfo *model.TableInfo, statsTbl *statistics.Table) bool {
+	for _, idx := range tblInfo.Indices {
+		if _, ok := statsTbl.Indices[idx.ID]; !ok && idx.State == model.StatePublic {
+			return true
+		}
+	}
+	return false
+}
+
+// newDynamicPartitionAutoAnalyzeJob returns the job analyzing the partitions that need analyze together, or nil if
+// no partition needs analyze. The job is as stale as its stalest partition.
+func (h *Handle) newDynamicPartitionAutoAnalyzeJob(tblInfo *model.TableInfo, partitionDefs []model.PartitionDefinition, db string, ratio float64, analyzeSnapshot bool) *autoAnalyzeJob {
+	job := &autoAnalyzeJob{
+		tableID:   tblInfo.ID,
+		dbName:    db,
+		tableName: tblInfo.Name.O,
+		analyze: func() (bool, error) {
+			return h.autoAnalyzePartitionTableInDynamicMode(tblInfo, partitionDefs, db, ratio, analyzeSnapshot)
+		},
+	}
+	needAnalyze, neverAnalyzed := false, false
+	for _, def := range partitionDefs {
+		partitionStatsTbl := h.GetPartitionStats(tblInfo, def.ID)
+		if !h.needAutoAnalyze(tblInfo, partitionStatsTbl, ratio) {
+			continue
+		}
+		needAnalyze = true
+		job.modifyRatio = math.Max(job.modifyRatio, autoAnalyzeModifyRatio(partitionStatsTbl))
+		job.rowCount += partitionStatsTbl.RealtimeCount
+		analyzeTime := lastAnalyzeTime(partitionStatsTbl)
+		if analyzeTime.IsZero() {
+			neverAnalyzed = true
+		} else if job.lastAnalyzeTime.IsZero() || analyzeTime.Before(job.lastAnalyzeTime) {
+			job.lastAnalyzeTime = analyzeTime
+		}
+	}
+	if !needAnalyze {
+		return nil
+	}
+	if neverAnalyzed {
+		job.lastAnalyzeTime = time.Time{}
+	}
+	return job
+}
+
+func (h *Handle) autoAnalyzeTable(tblInfo *model.TableInfo, statsTbl *statistics.Table, ratio float64, analyzeSnapshot bool, sql string, params ...interface{}) (bool, error) {
+	if statsTbl.Pseudo || statsTbl.RealtimeCount < AutoAnalyzeMinCnt {
+		return false, nil
+	}
 	if needAnalyze, reason := NeedAnalyzeTable(statsTbl, 20*h.Lease(), ratio); needAnalyze {
 		escaped, err := sqlexec.EscapeSQL(sql, params...)
 		if err != nil {
-			return false
+			return false, err
 		}
 		logutil.BgLogger().Info("auto analyze triggered", zap.String("category", "stats"), zap.String("sql", escaped), zap.String("reason", reason))
 		tableStatsVer, err := h.GetCurrentAnalyzeVersion()
 		if err != nil {
 			logutil.BgLogger().Error("fail to get analyze version", zap.String("category", "stats"), zap.Error(err))
-			return false
+			return false, nil
 		}
 		statistics.CheckAnalyzeVerOnTable(statsTbl, &tableStatsVer)
-		h.execAutoAnalyze(tableStatsVer, analyzeSnapshot, sql, params...)
-		return true
+		return true, h.execAutoAnalyze(tableStatsVer, analyzeSnapshot, sql, params...)
 	}
 	for _, idx := range tblInfo.Indices {
 		if _, ok := statsTbl.Indices[idx.ID]; !ok && idx.State == model.StatePublic {
@@ -903,20 +1004,19 @@ func (h *Handle) autoAnalyzeTable(tblInfo *model.TableInfo, statsTbl *statistics
 			paramsWithIdx := append(params, idx.Name.O)
 			escaped, err := sqlexec.EscapeSQL(sqlWithIdx, paramsWithIdx...)
 			if err != nil {
-				return false
+				return false, err
 			}
 			logutil.BgLogger().Info("auto analyze for unanalyzed", zap.String("category", "stats"), zap.String("sql", escaped))
 			tableStatsVer, err := h.GetCurrentAnalyzeVersion()
 			if err != nil {
 				logutil.BgLogger().Error("fail to get analyze version", zap.String("category", "stats"), zap.Error(err))
-				return false
+				return false, nil
 			}
 			statistics.CheckAnalyzeVerOnTable(statsTbl, &tableStatsVer)
-			h.execAutoAnalyze(tableStatsVer, analyzeSnapshot, sqlWithIdx, paramsWithIdx...)
-			return true
+			return true, h.execAutoAnalyze(tableStatsVer, analyzeSnapshot, sqlWithIdx, paramsWithIdx...)
 		}
 	}
-	return false
+	return false, nil
 }
 
 // GetCurrentAnalyzeVersion returns the current analyze version.
@@ -947,15 +1047,17 @@ func (h *Handle) GetCurrentPruneMode() (string, error) {
 	return sctx.GetSessionVars().PartitionPruneMode.Load(), nil
 }
 
-func (h *Handle) autoAnalyzePartitionTableInDynamicMode(tblInfo *model.TableInfo, partitionDefs []model.PartitionDefinition, db string, ratio float64, analyzeSnapshot bool) bool {
+func (h *Handle) autoAnalyzePartitionTableInDynamicMode(tblInfo *model.TableInfo, partitionDefs []model.PartitionDefinition, db string, ratio float64, analyzeSnapshot bool) (bool, error) {
 	tableStatsVer, err := h.GetCurrentAnalyzeVersion()
 	if err != nil {
 		logutil.BgLogger().Info("fail to get analyze version", zap.String("category", "stats"),
 			zap.String("table", tblInfo.Name.String()),
 			zap.Error(err))
-		return false
+		return false, nil
 	}
 	analyzePartitionBatchSize := int(variable.AutoAnalyzePartitionBatchSize.Load())
+	// The remaining batches are still analyzed when one of them fails, and the first error is returned.
+	var firstErr error
 	partitionNames := make([]interface{}, 0, len(partitionDefs))
 	for _, def := range partitionDefs {
 		partitionStatsTbl := h.GetPartitionStats(tblInfo, def.ID)
@@ -997,9 +1099,11 @@ func (h *Handle) autoAnalyzePartitionTableInDynamicMode(tblInfo *model.TableInfo
 			logutil.BgLogger().Info("auto analyze triggered", zap.String("category", "stats"),
 				zap.String("table", tblInfo.Name.String()),
 				zap.Any("partitions", partitionNames[start:end]))
-			h.execAutoAnalyze(tableStatsVer, analyzeSnapshot, sql, params...)
+			if err := h.execAutoAnalyze(tableStatsVer, analyzeSnapshot, sql, params...); err != nil && firstErr == nil {
+				firstErr = err
+			}
 		}
-		return true
+		return true, firstErr
 	}
 	for _, idx := range tblInfo.Indices {
 		if idx.State != model.StatePublic {
@@ -1028,12 +1132,14 @@ func (h *Handle) autoAnalyzePartitionTableInDynamicMode(tblInfo *model.TableInfo
 					zap.String("table", tblInfo.Name.String()),
 					zap.String("index", idx.Name.String()),
 					zap.Any("partitions", partitionNames[start:end]))
-				h.execAutoAnalyze(tableStatsVer, analyzeSnapshot, sql, params...)
+				if err := h.execAutoAnalyze(tableStatsVer, analyzeSnapshot, sql, params...); err != nil && firstErr == nil {
+					firstErr = err
+				}
 			}
-			return true
+			return true, firstErr
 		}
 	}
-	return false
+	return false, nil
 }
 
 var execOptionForAnalyze = map[int]sqlexec.OptionFuncAlias{
@@ -1042,7 +1148,7 @@ var execOptionForAnalyze = map[int]sqlexec.OptionFuncAlias{
 	statistics.Version2: sqlexec.ExecOptionAnalyzeVer2,
 }
 
-func (h *Handle) execAutoAnalyze(statsVer int, analyzeSnapshot bool, sql string, params ...interface{}) {
+func (h *Handle) execAutoAnalyze(statsVer int, analyzeSnapshot bool, sql string, params ...interface{}) error {
 	startTime := time.Now()
 	autoAnalyzeProcID := h.autoAnalyzeProcIDGetter()
 	_, _, err := h.execRestrictedSQLWithStatsVer(context.Background(), statsVer, autoAnalyzeProcID, analyzeSnapshot, sql, params...)
@@ -1055,7 +1161,8 @@ func (h *Handle) execAutoAnalyze(statsVer int, analyzeSnapshot bool, sql string,
 		}
 		logutil.BgLogger().Error("auto analyze failed", zap.String("category", "stats"), zap.String("sql", escaped), zap.Duration("cost_time", dur), zap.Error(err))
 		metrics.AutoAnalyzeCounter.WithLabelValues("failed").Inc()
-	} else {
-		metrics.AutoAnalyzeCounter.WithLabelValues("succ").Inc()
+		return err
 	}
+	metrics.AutoAnalyzeCounter.WithLabelValues("succ").Inc()
+	return nil
 }
@@ -1572,3 +1572,115 @@ func TestAutoAnalyzePartitionTableAfterAddingIndex(t *testing.T) {
 	require.True(t, h.HandleAutoAnalyze(dom.InfoSchema()))
 	require.NotNil(t, h.GetTableStats(tblInfo).Indices[idxInfo.ID])
 }
+
+func TestAutoAnalyzeQueue(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	oriMinCnt := handle.AutoAnalyzeMinCnt
+	oriStart := tk.MustQuery("select @@tidb_auto_analyze_start_time").Rows()[0][0].(string)
+	oriEnd := tk.MustQuery("select @@tidb_auto_analyze_end_time").Rows()[0][0].(string)
+	defer func() {
+		handle.AutoAnalyzeMinCnt = oriMinCnt
+		tk.MustExec(fmt.Sprintf("set global tidb_auto_analyze_start_time='%v'", oriStart))
+		tk.MustExec(fmt.Sprintf("set global tidb_auto_analyze_end_time='%v'", oriEnd))
+	}()
+	handle.AutoAnalyzeMinCnt = 0
+	tk.MustExec("set global tidb_auto_analyze_start_time='00:00 +0000'")
+	tk.MustExec("set global tidb_auto_analyze_end_time='23:59 +0000'")
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int)")
+	tk.MustExec("create table t2 (a int)")
+	h := dom.StatsHandle()
+	require.NoError(t, h.HandleDDLEvent(<-h.DDLEventCh()))
+	require.NoError(t, h.HandleDDLEvent(<-h.DDLEventCh()))
+	tk.MustExec("insert into t1 values (1)" + strings.Repeat(", (1)", 9))
+	tk.MustExec("insert into t2 values (1)" + strings.Repeat(", (1)", 99))
+	require.NoError(t, h.DumpStatsDeltaToKV(handle.DumpAll))
+	tk.MustExec("analyze table t1, t2")
+
+	// t1 is modified more than t2, so it is analyzed first, and t2 is left in the queue.
+	tk.MustExec("insert into t1 values (1)" + strings.Repeat(", (1)", 9))
+	tk.MustExec("insert into t2 values (1)" + strings.Repeat(", (1)", 59))
+	require.NoError(t, h.DumpStatsDeltaToKV(handle.DumpAll))
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	require.True(t, h.HandleAutoAnalyze(dom.InfoSchema()))
+	tk.MustQuery("select table_schema, table_name, partition_name, modify_ratio, row_count, failure_count, state, last_analyze_time is not null from mysql.auto_analyze_queue").
+		Check(testkit.Rows("test t2  0.6 160 0 pending 1"))
+	tk.MustQuery("select score > 0.3 and score < 0.5 from mysql.auto_analyze_queue").Check(testkit.Rows("1"))
+
+	require.True(t, h.HandleAutoAnalyze(dom.InfoSchema()))
+	tk.MustQuery("select * from mysql.auto_analyze_queue").Check(testkit.Rows())
+	require.False(t, h.HandleAutoAnalyze(dom.InfoSchema()))
+}
+
+func TestQueryFeedback(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	oriMinCnt := handle.AutoAnalyzeMinCnt
+	oriStart := tk.MustQuery("select @@tidb_auto_analyze_start_time").Rows()[0][0].(string)
+	oriEnd := tk.MustQuery("select @@tidb_auto_analyze_end_time").Rows()[0][0].(string)
+	defer func() {
+		handle.AutoAnalyzeMinCnt = oriMinCnt
+		tk.MustExec(fmt.Sprintf("set global tidb_auto_analyze_start_time='%v'", oriStart))
+		tk.MustExec(fmt.Sprintf("set global tidb_auto_analyze_end_time='%v'", oriEnd))
+	}()
+	handle.AutoAnalyzeMinCnt = 0
+	tk.MustExec("set global tidb_auto_analyze_start_time='00:00 +0000'")
+	tk.MustExec("set global tidb_auto_analyze_end_time='23:59 +0000'")
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, key idx(a))")
+	h := dom.StatsHandle()
+	require.NoError(t, h.HandleDDLEvent(<-h.DDLEventCh()))
+	var sql strings.Builder
+	sql.WriteString("insert into t values (1, 1)")
+	for i := 2; i <= 1000; i++ {
+		sql.WriteString(fmt.Sprintf(", (%d, %d)", i, i))
+	}
+	tk.MustExec(sql.String())
+	require.NoError(t, h.DumpStatsDeltaToKV(handle.DumpAll))
+	tk.MustExec("analyze table t")
+
+	// 40% of the rows are moved out of the range of the histogram, which isn't enough to trigger auto analyze.
+	tk.MustExec("update t set a = a + 10000 where a <= 400")
+	require.NoError(t, h.DumpStatsDeltaToKV(handle.DumpAll))
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	require.False(t, h.HandleAutoAnalyze(dom.InfoSchema()))
+
+	// The feedback is only recorded when it's enabled.
+	tk.MustQuery("select count(b) from t use index(idx) where a > 5000").Check(testkit.Rows("400"))
+	tk.MustQuery("select * from information_schema.query_feedback").Check(testkit.Rows())
+	tk.MustExec("set @@tidb_enable_query_feedback = on")
+	tk.MustQuery("select count(b) from t use index(idx) where a > 5000").Check(testkit.Rows("400"))
+	tk.MustQuery("select count(b) from t use index(idx) where a > 5000").Check(testkit.Rows("400"))
+	tk.MustQuery("select table_schema, table_name, operator, predicate, act_rows, exec_count, est_rows < 40, plan_digest != '', digest != '' from information_schema.query_feedback order by operator").
+		Check(testkit.RowsWithSep("|",
+			"test|t|IndexRangeScan_15|range:(5000,+inf], keep order:false|400|2|1|1|1",
+			"test|t|TableRowIDScan_16|keep order:false|400|2|1|1|1"))
+	// The limit may stop the scan early, so it isn't compared.
+	tk.MustQuery("select * from t use index(idx) where a > 6000 limit 1")
+	tk.MustQuery("select count(*) from information_schema.query_feedback").Check(testkit.Rows("2"))
+
+	// The stats owner only sees the feedback dumped to the storage.
+	require.False(t, h.HandleAutoAnalyze(dom.InfoSchema()))
+	require.NoError(t, h.DumpQueryFeedbackToKV())
+	tk.MustQuery("select table_schema, table_name, operator, act_rows, exec_count from mysql.query_feedback order by operator").
+		Check(testkit.Rows("test t IndexRangeScan_15 400 2", "test t TableRowIDScan_16 400 2"))
+
+	// The misestimated table is analyzed with a lower ratio.
+	require.True(t, h.HandleAutoAnalyze(dom.InfoSchema()))
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	tbl, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	require.Equal(t, int64(0), h.GetTableStats(tbl.Meta()).ModifyCount)
+	// The feedback seen before the analyze doesn't trigger it again.
+	tk.MustExec("update t set b = b + 1 where a <= 800")
+	require.NoError(t, h.DumpStatsDeltaToKV(handle.DumpAll))
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	require.False(t, h.HandleAutoAnalyze(dom.InfoSchema()))
+
+	// The feedback not seen in the keep duration is deleted.
+	require.NoError(t, h.GCQueryFeedback(time.Hour))
+	tk.MustQuery("select count(*) from mysql.query_feedback").Check(testkit.Rows("2"))
+	require.NoError(t, h.GCQueryFeedback(0))
+	tk.MustQuery("select count(*) from mysql.query_feedback").Check(testkit.Rows("0"))
+}
@@ -226,6 +226,7 @@ func ExtendedStatsFromStorage(reader *StatsReader, table *Table, physicalID int6
 		}
 	}
 	table.ExtendedStats.LastUpdateVersion = lastVersion
+	table.JointStats = JointStatsFromExtendedStats(table.ExtendedStats)
 	return table, nil
 }
 
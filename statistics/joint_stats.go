@@ -0,0 +1,271 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxJointStatsColumns is the max number of columns in one group of joint statistics.
+	MaxJointStatsColumns = 4
+	// MaxJointStatsTopN is the max number of the most common value combinations kept in joint statistics.
+	MaxJointStatsTopN = 100
+	// maxJointStatsSize is the max size of the encoded joint statistics, which must fit in mysql.stats_extended.stats.
+	maxJointStatsSize = 60 * 1024
+)
+
+// JointTopNItem is a most common combination of the column values.
+type JointTopNItem struct {
+	// Encoded is the combination of the values encoded by codec.EncodeKey. String values are encoded by their collate keys.
+	Encoded []byte `json:"encoded"`
+	Count   uint64 `json:"count"`
+}
+
+// JointStats is the joint statistics of a group of columns, i.e, the number of distinct value combinations and
+// the most common value combinations. It is stored in mysql.stats_extended as the type ast.StatsTypeJoint. Unlike the
+// other extended statistics, it's collected by ANALYZE ... WITH JOINT STATS and used by the planner regardless of
+// tidb_enable_extended_stats.
+//
+// NOTE: there's no multi-column histogram. The joint statistics are only used when all the columns are restricted to
+// points, e.g. `a = 1 and b in (1, 2)`, and the combinations out of the TopN are assumed to be uniformly distributed.
+// The conditions with ranges on any of the columns are still estimated by the statistics of the single columns.
+type JointStats struct {
+	topNIdx map[string]int
+	// ColIDs are the IDs of the columns. They are column info IDs when loaded from storage, and are replaced by the
+	// unique IDs of the columns in the HistColl generated for the planner.
+	ColIDs       []int64         `json:"-"`
+	TopN         []JointTopNItem `json:"topn"`
+	NDV          int64           `json:"ndv"`
+	RowCount     int64           `json:"row_count"`
+	NotNullCount int64           `json:"not_null_count"`
+	topNCount    uint64
+}
+
+// JointStatsName returns the name of the joint statistics in mysql.stats_extended.
+func JointStatsName(colIDs []int64) string {
+	var sb strings.Builder
+	sb.WriteString("joint")
+	for _, id := range colIDs {
+		sb.WriteString("_")
+		sb.WriteString(strconv.FormatInt(id, 10))
+	}
+	return sb.String()
+}
+
+// BuildJointStats builds the joint statistics of the columns from the row samples. Rows in which any of the columns
+// is NULL or too long are not counted, so the statistics only describe the non-null combinations.
+func BuildJointStats(sc *stmtctx.StatementContext, colsInfo []*model.ColumnInfo, colIDs []int64, samples []*ReservoirRowSampleItem, rowCount int64, numTopN int) (*JointStats, error) {
+	offsets := make([]int, 0, len(colIDs))
+	collators := make([]collate.Collator, 0, len(colIDs))
+	for _, id := range colIDs {
+		offset := slices.IndexFunc(colsInfo, func(col *model.ColumnInfo) bool { return col.ID == id })
+		if offset < 0 {
+			return nil, errors.Errorf("column %d is not analyzed", id)
+		}
+		offsets = append(offsets, offset)
+		var collator collate.Collator
+		ft := colsInfo[offset].FieldType
+		// Use the collate key as (*AnalyzeColumnsExecV2).subBuildWorker does, so that the values can be matched
+		// with the ranges in the planner.
+		if ft.EvalType() == types.ETString && ft.GetType() != mysql.TypeEnum && ft.GetType() != mysql.TypeSet {
+			collator = collate.GetCollator(ft.GetCollate())
+		}
+		collators = append(collators, collator)
+	}
+	counts := make(map[string]uint64, len(samples))
+	datums := make([]types.Datum, len(offsets))
+	var key []byte
+	sampleCount := 0
+sampleLoop:
+	for _, sample := range samples {
+		for i, offset := range offsets {
+			val := sample.Columns[offset]
+			if val.IsNull() || len(val.GetBytes()) > MaxSampleValueLength {
+				continue sampleLoop
+			}
+			if collators[i] != nil {
+				val.SetBytes(collators[i].Key(val.GetString()))
+			}
+			datums[i] = val
+		}
+		var err error
+		key, err = codec.EncodeKey(sc, key[:0], datums...)
+		if err != nil {
+			return nil, err
+		}
+		counts[string(key)]++
+		sampleCount++
+	}
+
+	js := &JointStats{ColIDs: colIDs, RowCount: rowCount}
+	if sampleCount == 0 || len(samples) == 0 {
+		js.buildTopNIdx()
+		return js, nil
+	}
+	js.NotNullCount = int64(float64(rowCount) * float64(sampleCount) / float64(len(samples)))
+	if js.NotNullCount < int64(sampleCount) {
+		js.NotNullCount = int64(sampleCount)
+	}
+	scale := float64(js.NotNullCount) / float64(sampleCount)
+	js.NDV = estimateJointNDV(sampleCount, counts, js.NotNullCount)
+
+	for encoded, count := range counts {
+		// A combination which appears only once in the samples is not considered as common.
+		if count <= 1 {
+			continue
+		}
+		js.TopN = append(js.TopN, JointTopNItem{Encoded: []byte(encoded), Count: count})
+	}
+	slices.SortFunc(js.TopN, func(a, b JointTopNItem) int {
+		if a.Count != b.Count {
+			if a.Count > b.Count {
+				return -1
+			}
+			return 1
+		}
+		return bytes.Compare(a.Encoded, b.Encoded)
+	})
+	if len(js.TopN) > numTopN {
+		js.TopN = js.TopN[:numTopN]
+	}
+	for i := range js.TopN {
+		js.TopN[i].Count = uint64(float64(js.TopN[i].Count) * scale)
+	}
+	if err := js.trimTopN(); err != nil {
+		return nil, err
+	}
+	js.buildTopNIdx()
+	return js, nil
+}
+
+// estimateJointNDV estimates the number of distinct combinations from the samples by the Duj1 estimator.
+func estimateJointNDV(sampleCount int, counts map[string]uint64, total int64) int64 {
+	n, d := float64(sampleCount), float64(len(counts))
+	if int64(sampleCount) >= total {
+		return int64(d)
+	}
+	f1 := 0.0
+	for _, count := range counts {
+		if count == 1 {
+			f1++
+		}
+	}
+	ndv := n * d / (n - f1 + f1*n/float64(total))
+	return int64(math.Round(math.Min(math.Max(ndv, d), float64(total))))
+}
+
+// trimTopN removes the least common combinations until the encoded joint statistics fit in the storage.
+func (js *JointStats) trimTopN() error {
+	for {
+		data, err := json.Marshal(js)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(data) <= maxJointStatsSize || len(js.TopN) == 0 {
+			return nil
+		}
+		js.TopN = js.TopN[:len(js.TopN)*maxJointStatsSize/len(data)]
+	}
+}
+
+func (js *JointStats) buildTopNIdx() {
+	js.topNIdx = make(map[string]int, len(js.TopN))
+	js.topNCount = 0
+	for i, item := range js.TopN {
+		js.topNIdx[string(item.Encoded)] = i
+		js.topNCount += item.Count
+	}
+}
+
+// Encode encodes the joint statistics into the string stored in mysql.stats_extended.
+func (js *JointStats) Encode() (string, error) {
+	data, err := json.Marshal(js)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(data), nil
+}
+
+// DecodeJointStats decodes the joint statistics stored in mysql.stats_extended.
+func DecodeJointStats(colIDs []int64, data string) (*JointStats, error) {
+	js := &JointStats{}
+	if err := json.Unmarshal([]byte(data), js); err != nil {
+		return nil, errors.Trace(err)
+	}
+	js.ColIDs = colIDs
+	js.buildTopNIdx()
+	return js, nil
+}
+
+// JointStatsFromExtendedStats decodes all the joint statistics in the extended statistics. The joint statistics which
+// fail to be decoded are skipped.
+func JointStatsFromExtendedStats(coll *ExtendedStatsColl) []*JointStats {
+	if coll == nil {
+		return nil
+	}
+	var jointStats []*JointStats
+	for name, item := range coll.Stats {
+		if item.Tp != ast.StatsTypeJoint {
+			continue
+		}
+		js, err := DecodeJointStats(item.ColIDs, item.StringVals)
+		if err != nil {
+			logutil.BgLogger().Warn("decode joint stats failed", zap.String("category", "stats"), zap.String("name", name), zap.Error(err))
+			continue
+		}
+		jointStats = append(jointStats, js)
+	}
+	// Keep the order stable so that the estimation doesn't depend on the map iteration order.
+	slices.SortFunc(jointStats, func(a, b *JointStats) int {
+		return slices.Compare(a.ColIDs, b.ColIDs)
+	})
+	return jointStats
+}
+
+// EqualSelectivity returns the fraction of the rows whose values of the columns are equal to the encoded combination.
+func (js *JointStats) EqualSelectivity(encoded []byte) float64 {
+	if js.RowCount <= 0 {
+		return 0
+	}
+	if i, ok := js.topNIdx[string(encoded)]; ok {
+		return math.Min(float64(js.TopN[i].Count)/float64(js.RowCount), 1)
+	}
+	// Assume the other combinations are uniformly distributed.
+	restNDV := math.Max(float64(js.NDV-int64(len(js.TopN))), 1)
+	restCount := math.Max(float64(js.NotNullCount)-float64(js.topNCount), 1)
+	return math.Min(restCount/restNDV/float64(js.RowCount), 1)
+}
+
+// String returns a brief description of the joint statistics.
+func (js *JointStats) String() string {
+	return "ndv: " + strconv.FormatInt(js.NDV, 10) + ", topn: " + strconv.Itoa(len(js.TopN))
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildJointStats(t *testing.T) {
+	sc := &stmtctx.StatementContext{TimeZone: time.Local}
+	colsInfo := []*model.ColumnInfo{
+		{ID: 1, FieldType: *types.NewFieldType(mysql.TypeLonglong)},
+		{ID: 2, FieldType: *types.NewFieldType(mysql.TypeLonglong)},
+		{ID: 3, FieldType: *types.NewFieldType(mysql.TypeLonglong)},
+	}
+	// 100 of 1000 rows are sampled, (a, b) has 10 combinations and c is unique.
+	samples := make([]*ReservoirRowSampleItem, 0, 110)
+	for i := 0; i < 100; i++ {
+		samples = append(samples, &ReservoirRowSampleItem{Columns: []types.Datum{
+			types.NewIntDatum(int64(i % 10)), types.NewIntDatum(int64(i % 10)), types.NewIntDatum(int64(i)),
+		}})
+	}
+	// The rows with NULL values are not counted.
+	for i := 0; i < 10; i++ {
+		samples = append(samples, &ReservoirRowSampleItem{Columns: []types.Datum{
+			{}, types.NewIntDatum(1), types.NewIntDatum(int64(i + 100)),
+		}})
+	}
+	js, err := BuildJointStats(sc, colsInfo, []int64{1, 2}, samples, 1100, 5)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), js.NDV)
+	require.Equal(t, int64(1000), js.NotNullCount)
+	require.Len(t, js.TopN, 5)
+	require.Equal(t, uint64(100), js.TopN[0].Count)
+	key, err := codec.EncodeKey(sc, nil, types.NewIntDatum(0), types.NewIntDatum(0))
+	require.NoError(t, err)
+	require.InDelta(t, 100.0/1100, js.EqualSelectivity(key), 1e-9)
+	// The combinations out of the TopN share the rest of the rows.
+	key, err = codec.EncodeKey(sc, nil, types.NewIntDatum(9), types.NewIntDatum(9))
+	require.NoError(t, err)
+	require.InDelta(t, 100.0/1100, js.EqualSelectivity(key), 1e-9)
+
+	// Every combination of (a, c) appears only once in the samples, so the NDV is scaled up.
+	js, err = BuildJointStats(sc, colsInfo, []int64{1, 3}, samples, 1100, 5)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), js.NDV)
+	require.Len(t, js.TopN, 0)
+
+	_, err = BuildJointStats(sc, colsInfo, []int64{1, 4}, samples, 1100, 5)
+	require.Error(t, err)
+}
+
+func TestJointStatsFromExtendedStats(t *testing.T) {
+	sc := &stmtctx.StatementContext{TimeZone: time.Local}
+	colsInfo := []*model.ColumnInfo{
+		{ID: 1, FieldType: *types.NewFieldType(mysql.TypeLonglong)},
+		{ID: 2, FieldType: *types.NewFieldType(mysql.TypeLonglong)},
+	}
+	samples := make([]*ReservoirRowSampleItem, 0, 100)
+	for i := 0; i < 100; i++ {
+		samples = append(samples, &ReservoirRowSampleItem{Columns: []types.Datum{
+			types.NewIntDatum(int64(i % 10)), types.NewIntDatum(int64(i % 10)),
+		}})
+	}
+	js, err := BuildJointStats(sc, colsInfo, []int64{1, 2}, samples, 100, 100)
+	require.NoError(t, err)
+	str, err := js.Encode()
+	require.NoError(t, err)
+
+	coll := NewExtendedStatsColl()
+	coll.Stats[JointStatsName([]int64{1, 2})] = &ExtendedStatsItem{Tp: ast.StatsTypeJoint, ColIDs: []int64{1, 2}, StringVals: str}
+	coll.Stats["corr"] = &ExtendedStatsItem{Tp: ast.StatsTypeCorrelation, ColIDs: []int64{1, 2}, ScalarVals: 1}
+	coll.Stats["invalid"] = &ExtendedStatsItem{Tp: ast.StatsTypeJoint, ColIDs: []int64{1, 3}, StringVals: "{"}
+	jointStats := JointStatsFromExtendedStats(coll)
+	require.Len(t, jointStats, 1)
+	require.Equal(t, []int64{1, 2}, jointStats[0].ColIDs)
+	require.Equal(t, js.NDV, jointStats[0].NDV)
+	require.Equal(t, js.TopN, jointStats[0].TopN)
+	require.Equal(t, "joint_1_2", JointStatsName([]int64{1, 2}))
+	require.Equal(t, "ndv: 10, topn: 10", jointStats[0].String())
+}
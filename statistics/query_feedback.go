@@ -0,0 +1,58 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// misestimateRatio is the min ratio between the larger and the smaller one of the estimated and the actual
+	// row counts for a misestimate to be recorded.
+	misestimateRatio = 10
+	// misestimateMinRows is the min difference between the estimated and the actual row counts for a misestimate
+	// to be recorded, so that small tables don't produce meaningless feedback.
+	misestimateMinRows = 100
+)
+
+// QueryFeedback records an operator whose estimated row count is far from the actual one.
+type QueryFeedback struct {
+	LastSeen   time.Time
+	PlanDigest string
+	SQLDigest  string
+	DBName     string
+	TableName  string
+	// Operator is the explain ID of the operator, like TableRangeScan_5.
+	Operator string
+	// Predicate is the ranges or the conditions of the operator.
+	Predicate string
+	// TableID is the ID of the logical table, which is also used for the partitions.
+	TableID int64
+	EstRows float64
+	ActRows int64
+	// Count is the number of times the misestimate is seen.
+	Count uint64
+}
+
+// IsSignificantMisestimate checks whether the estimated row count is far enough from the actual one to be recorded
+// as a query feedback.
+func IsSignificantMisestimate(estRows float64, actRows int64) bool {
+	act := float64(actRows)
+	if math.Abs(estRows-act) < misestimateMinRows {
+		return false
+	}
+	return math.Max(estRows, act) >= misestimateRatio*math.Max(math.Min(estRows, act), 1)
+}
@@ -106,7 +106,9 @@ type HistColl struct {
 	Idx2ColumnIDs map[int64][]int64
 	// ColID2IdxIDs maps the column id to a list index ids whose first column is it. It's used to calculate the selectivity in planner.
 	ColID2IdxIDs map[int64][]int64
-	PhysicalID   int64
+	// JointStats are the joint statistics of the column groups. They are decoded from the extended statistics.
+	JointStats []*JointStats
+	PhysicalID int64
 	// TODO: add AnalyzeCount here
 	RealtimeCount int64 // RealtimeCount is the current table row count, maintained by applying stats delta based on AnalyzeCount.
 	ModifyCount   int64 // Total modify count in a table.
@@ -283,6 +285,7 @@ func (t *Table) Copy() *Table {
 		Indices:        make(map[int64]*Index, len(t.Indices)),
 		Pseudo:         t.Pseudo,
 		ModifyCount:    t.ModifyCount,
+		JointStats:     t.JointStats,
 	}
 	for id, col := range t.Columns {
 		newHistColl.Columns[id] = col.Copy()
@@ -321,6 +324,7 @@ func (t *Table) ShallowCopy() *Table {
 		Indices:        t.Indices,
 		Pseudo:         t.Pseudo,
 		ModifyCount:    t.ModifyCount,
+		JointStats:     t.JointStats,
 	}
 	nt := &Table{
 		HistColl:        newHistColl,
@@ -537,7 +541,7 @@ func (coll *HistColl) ID2UniqueID(columns []*expression.Column) *HistColl {
 	return newColl
 }
 
-// GenerateHistCollFromColumnInfo generates a new HistColl whose ColID2IdxIDs and IdxID2ColIDs is built from the given parameter.
+// GenerateHistCollFromColumnInfo generates a new HistColl whose ColID2IdxIDs, IdxID2ColIDs and JointStats is built from the given parameter.
 func (coll *HistColl) GenerateHistCollFromColumnInfo(tblInfo *model.TableInfo, columns []*expression.Column) *HistColl {
 	newColHistMap := make(map[int64]*Column)
 	colInfoID2UniqueID := make(map[int64]int64, len(columns))
@@ -582,6 +586,22 @@ func (coll *HistColl) GenerateHistCollFromColumnInfo(tblInfo *model.TableInfo, c
 	for _, idxIDs := range colID2IdxIDs {
 		slices.Sort(idxIDs)
 	}
+	var jointStats []*JointStats
+jointStatsLoop:
+	for _, js := range coll.JointStats {
+		ids := make([]int64, 0, len(js.ColIDs))
+		for _, id := range js.ColIDs {
+			uniqueID, ok := colInfoID2UniqueID[id]
+			// The joint statistics can only be used when all the columns are used in this query.
+			if !ok {
+				continue jointStatsLoop
+			}
+			ids = append(ids, uniqueID)
+		}
+		newJS := *js
+		newJS.ColIDs = ids
+		jointStats = append(jointStats, &newJS)
+	}
 	newColl := &HistColl{
 		PhysicalID:     coll.PhysicalID,
 		HavePhysicalID: coll.HavePhysicalID,
@@ -592,6 +612,7 @@ func (coll *HistColl) GenerateHistCollFromColumnInfo(tblInfo *model.TableInfo, c
 		Indices:        newIdxHistMap,
 		ColID2IdxIDs:   colID2IdxIDs,
 		Idx2ColumnIDs:  idx2Columns,
+		JointStats:     jointStats,
 	}
 	return newColl
 }
@@ -69,6 +69,22 @@ func WithCtx(ctx context.Context) CreateIdxOptFunc {
 	}
 }
 
+// DeleteIdxOpt contains the options will be used when deleting an index.
+type DeleteIdxOpt struct {
+	Ctx context.Context
+}
+
+// DeleteIdxOptFunc is defined for the Delete() method of Index interface.
+type DeleteIdxOptFunc func(*DeleteIdxOpt)
+
+// DeleteWithCtx returns a DeleteIdxOptFunc.
+// This option is used to pass context.Context.
+func DeleteWithCtx(ctx context.Context) DeleteIdxOptFunc {
+	return func(opt *DeleteIdxOpt) {
+		opt.Ctx = ctx
+	}
+}
+
 // IndexIter is index kvs iter.
 type IndexIter interface {
 	Next(kb []byte) ([]byte, []byte, bool, error)
@@ -84,7 +100,7 @@ type Index interface {
 	// Create supports insert into statement.
 	Create(ctx sessionctx.Context, txn kv.Transaction, indexedValues []types.Datum, h kv.Handle, handleRestoreData []types.Datum, opts ...CreateIdxOptFunc) (kv.Handle, error)
 	// Delete supports delete from statement.
-	Delete(sc *stmtctx.StatementContext, txn kv.Transaction, indexedValues []types.Datum, h kv.Handle) error
+	Delete(sc *stmtctx.StatementContext, txn kv.Transaction, indexedValues []types.Datum, h kv.Handle, opts ...DeleteIdxOptFunc) error
 	// GenIndexKVIter generate index key and value for multi-valued index, use iterator to reduce the memory allocation.
 	GenIndexKVIter(sc *stmtctx.StatementContext, indexedValue []types.Datum, h kv.Handle, handleRestoreData []types.Datum) IndexIter
 	// Exist supports check index exists or not.
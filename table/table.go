@@ -109,6 +109,8 @@ var (
 	ErrOptOnCacheTable = dbterror.ClassDDL.NewStd(mysql.ErrOptOnCacheTable)
 	// ErrCheckConstraintViolated return when check constraint is violated.
 	ErrCheckConstraintViolated = dbterror.ClassTable.NewStd(mysql.ErrCheckConstraintViolated)
+	// ErrJSONSchemaValidationErrorWithDetailedReport returns the reason why a JSON_SCHEMA_VALID check constraint is violated.
+	ErrJSONSchemaValidationErrorWithDetailedReport = dbterror.ClassTable.NewStd(mysql.ErrJSONSchemaValidationErrorWithDetailedReport)
 )
 
 // RecordIterFunc is used for low-level record iteration.
@@ -191,7 +193,7 @@ type Table interface {
 	UpdateRecord(ctx context.Context, sctx sessionctx.Context, h kv.Handle, currData, newData []types.Datum, touched []bool) error
 
 	// RemoveRecord removes a row in the table.
-	RemoveRecord(ctx sessionctx.Context, h kv.Handle, r []types.Datum) error
+	RemoveRecord(ctx sessionctx.Context, h kv.Handle, r []types.Datum, opts ...DeleteIdxOptFunc) error
 
 	// Allocators returns all allocators.
 	Allocators(ctx sessionctx.Context) autoid.Allocators
@@ -270,9 +270,9 @@ func (c *cachedTable) UpdateRecord(ctx context.Context, sctx sessionctx.Context,
 }
 
 // RemoveRecord implements table.Table RemoveRecord interface.
-func (c *cachedTable) RemoveRecord(sctx sessionctx.Context, h kv.Handle, r []types.Datum) error {
+func (c *cachedTable) RemoveRecord(sctx sessionctx.Context, h kv.Handle, r []types.Datum, opts ...table.DeleteIdxOptFunc) error {
 	txnCtxAddCachedTable(sctx, c.Meta().ID, c)
-	return c.TableCommon.RemoveRecord(sctx, h, r)
+	return c.TableCommon.RemoveRecord(sctx, h, r, opts...)
 }
 
 // TestMockRenewLeaseABA2 is used by test function TestRenewLeaseABAFailPoint.
@@ -28,8 +28,10 @@ import (
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/fulltext"
 	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tidb/util/tracing"
+	"github.com/pingcap/tidb/util/vectorindex"
 )
 
 // index is the data structure for index data in the KV store.
@@ -99,7 +101,7 @@ func (c *index) GenIndexKey(sc *stmtctx.StatementContext, indexedValues []types.
 // GenIndexValue generates the index value.
 func (c *index) GenIndexValue(sc *stmtctx.StatementContext, distinct bool, indexedValues []types.Datum, h kv.Handle, restoredData []types.Datum) ([]byte, error) {
 	c.initNeedRestoreData.Do(func() {
-		c.needRestoredData = NeedRestoredData(c.idxInfo.Columns, c.tblInfo.Columns)
+		c.needRestoredData = c.idxInfo.Tp != model.IndexTypeFullText && NeedRestoredData(c.idxInfo.Columns, c.tblInfo.Columns)
 	})
 	return tablecodec.GenIndexValuePortal(sc, c.tblInfo, c.idxInfo, c.needRestoredData, distinct, false, indexedValues, h, c.phyTblID, restoredData)
 }
@@ -110,6 +112,9 @@ func (c *index) GenIndexValue(sc *stmtctx.StatementContext, distinct bool, index
 // 3. (i1, null, i2, ...) ==> [(i1, null, i2, ...)]
 // 4. (i1, [], i2, ...) ==> nothing.
 func (c *index) getIndexedValue(indexedValues []types.Datum) [][]types.Datum {
+	if c.idxInfo.Tp == model.IndexTypeFullText {
+		return c.getFullTextIndexedValue(indexedValues)
+	}
 	if !c.idxInfo.MVIndex {
 		return [][]types.Datum{indexedValues}
 	}
@@ -156,6 +161,54 @@ out:
 	return vals
 }
 
+// getFullTextIndexedValue tokenizes the texts of the indexed columns and returns the values of
+// the full-text index entries, see fulltext.IndexEntries.
+func (c *index) getFullTextIndexedValue(indexedValues []types.Datum) [][]types.Datum {
+	texts := make([]string, 0, len(indexedValues))
+	for _, v := range indexedValues {
+		if !v.IsNull() {
+			texts = append(texts, v.GetString())
+		}
+	}
+	tokenizer, ok := fulltext.NewTokenizer(c.idxInfo.FullTextParser)
+	if !ok {
+		tokenizer, _ = fulltext.NewTokenizer("")
+	}
+	return fulltext.IndexEntries(fulltext.NewDocument(tokenizer, texts...))
+}
+
+// updateFullTextStats adds the document to the statistics of the full-text index, or removes it. The first
+// entry of a document is the entry of the empty token, whose term frequency is its length.
+func (c *index) updateFullTextStats(ctx context.Context, txn kv.Transaction, entries [][]types.Datum, remove bool) error {
+	return fulltext.UpdateStats(ctx, txn, c.phyTblID, c.idxInfo.ID, entries[0][1].GetInt64(), remove)
+}
+
+// updateVectorIndex adds the vector of the row to the HNSW graph of the vector index, or removes the
+// row from the graph. The NULL values are not indexed.
+func (c *index) updateVectorIndex(ctx context.Context, txn kv.Transaction, indexedValue []types.Datum, h kv.Handle, remove bool) error {
+	graph := vectorindex.NewGraph(txn, c.tblInfo, c.phyTblID, c.idxInfo)
+	if remove {
+		if err := graph.Delete(ctx, h); err != nil {
+			return err
+		}
+		return graph.Flush(txn)
+	}
+	if len(indexedValue) == 0 || indexedValue[0].IsNull() {
+		return nil
+	}
+	vec, err := types.ParseVectorBinary(indexedValue[0].GetBytes())
+	if err != nil {
+		return err
+	}
+	if err = vec.CheckDimension(c.tblInfo.Columns[c.idxInfo.Columns[0].Offset].GetFlen()); err != nil {
+		return err
+	}
+	if err = graph.Insert(ctx, h, vec); err != nil {
+		return err
+	}
+	return graph.Flush(txn)
+}
+
 // Create creates a new entry in the kvIndex data.
 // If the index is unique and there is an existing entry with the same key,
 // Create will return the existing entry's handle as the first return value, ErrKeyExists as the second return value.
@@ -168,7 +221,6 @@ func (c *index) Create(sctx sessionctx.Context, txn kv.Transaction, indexedValue
 		fn(&opt)
 	}
 
-	indexedValues := c.getIndexedValue(indexedValue)
 	ctx := opt.Ctx
 	if ctx != nil {
 		var r tracing.Region
@@ -177,6 +229,21 @@ func (c *index) Create(sctx sessionctx.Context, txn kv.Transaction, indexedValue
 	} else {
 		ctx = context.TODO()
 	}
+	if c.idxInfo.Tp == model.IndexTypeHNSW {
+		return nil, c.updateVectorIndex(ctx, txn, indexedValue, h, false)
+	}
+
+	indexedValues := c.getIndexedValue(indexedValue)
+	if c.idxInfo.Tp == model.IndexTypeFullText {
+		// The handle is decoded from the key and never restored from a full-text index.
+		handleRestoreData = nil
+		// The statistics are rebuilt after the index is backfilled.
+		if !opt.FromBackFill && !opt.Untouched {
+			if err := c.updateFullTextStats(ctx, txn, indexedValues, false); err != nil {
+				return nil, err
+			}
+		}
+	}
 	vars := sctx.GetSessionVars()
 	writeBufs := vars.GetWriteStmtBufs()
 	skipCheck := vars.StmtCtx.BatchCheck
@@ -230,7 +297,7 @@ func (c *index) Create(sctx sessionctx.Context, txn kv.Transaction, indexedValue
 		// save the key buffer to reuse.
 		writeBufs.IndexKeyBuf = key
 		c.initNeedRestoreData.Do(func() {
-			c.needRestoredData = NeedRestoredData(c.idxInfo.Columns, c.tblInfo.Columns)
+			c.needRestoredData = c.idxInfo.Tp != model.IndexTypeFullText && NeedRestoredData(c.idxInfo.Columns, c.tblInfo.Columns)
 		})
 		idxVal, err := tablecodec.GenIndexValuePortal(sctx.GetSessionVars().StmtCtx, c.tblInfo, c.idxInfo, c.needRestoredData, distinct, opt.Untouched, value, h, c.phyTblID, handleRestoreData)
 		if err != nil {
@@ -392,8 +459,24 @@ func needPresumeKeyNotExistsFlag(ctx context.Context, txn kv.Transaction, key, t
 }
 
 // Delete removes the entry for handle h and indexedValues from KV index.
-func (c *index) Delete(sc *stmtctx.StatementContext, txn kv.Transaction, indexedValue []types.Datum, h kv.Handle) error {
+func (c *index) Delete(sc *stmtctx.StatementContext, txn kv.Transaction, indexedValue []types.Datum, h kv.Handle, opts ...table.DeleteIdxOptFunc) error {
+	var opt table.DeleteIdxOpt
+	for _, fn := range opts {
+		fn(&opt)
+	}
+	ctx := opt.Ctx
+	if ctx == nil {
+		ctx = context.TODO()
+	}
+	if c.idxInfo.Tp == model.IndexTypeHNSW {
+		return c.updateVectorIndex(ctx, txn, indexedValue, h, true)
+	}
 	indexedValues := c.getIndexedValue(indexedValue)
+	if c.idxInfo.Tp == model.IndexTypeFullText {
+		if err := c.updateFullTextStats(ctx, txn, indexedValues, true); err != nil {
+			return err
+		}
+	}
 	for _, value := range indexedValues {
 		key, distinct, err := c.GenIndexKey(sc, value, h, nil)
 		if err != nil {
@@ -405,7 +488,7 @@ func (c *index) Delete(sc *stmtctx.StatementContext, txn kv.Transaction, indexed
 		if len(tempKey) > 0 && c.idxInfo.Unique {
 			// Get the origin value of the unique temporary index key.
 			// Append the new delete operations to the end of the origin value.
-			originTempVal, err = getKeyInTxn(context.TODO(), txn, tempKey)
+			originTempVal, err = getKeyInTxn(ctx, txn, tempKey)
 			if err != nil {
 				return err
 			}
@@ -472,6 +555,13 @@ func (c *index) Delete(sc *stmtctx.StatementContext, txn kv.Transaction, indexed
 
 func (c *index) GenIndexKVIter(sc *stmtctx.StatementContext, indexedValue []types.Datum, h kv.Handle, handleRestoreData []types.Datum) table.IndexIter {
 	indexedValues := c.getIndexedValue(indexedValue)
+	switch c.idxInfo.Tp {
+	case model.IndexTypeFullText:
+		handleRestoreData = nil
+	case model.IndexTypeHNSW:
+		// The nodes of an HNSW graph depend on the other rows, which can't be generated from a row alone.
+		indexedValues = nil
+	}
 	return &indexGenerator{
 		c:                 c,
 		sctx:              sc,
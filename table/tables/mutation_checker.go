@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/fulltext"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/rowcodec"
 	"go.uber.org/zap"
@@ -146,6 +147,13 @@ func checkHandleConsistency(rowInsertion mutation, indexMutations []mutation, in
 		if !ok {
 			return errors.New("index not found")
 		}
+		if indexInfo.Tp == model.IndexTypeHNSW {
+			// The mutations of a vector index include the neighbors of the row, whose handles are different.
+			continue
+		}
+		if indexInfo.Tp == model.IndexTypeFullText && fulltext.IsStatsKey(m.key) {
+			continue
+		}
 
 		// If this is the temporary index data, need to remove the last byte of index data(version about when it is written).
 		var (
@@ -172,9 +180,9 @@ func checkHandleConsistency(rowInsertion mutation, indexMutations []mutation, in
 			}
 			orgKey = append(orgKey, m.key...)
 			tablecodec.TempIndexKey2IndexKey(orgKey)
-			indexHandle, err = tablecodec.DecodeIndexHandle(orgKey, value, len(indexInfo.Columns))
+			indexHandle, err = tablecodec.DecodeIndexHandle(orgKey, value, indexValuesLen(indexInfo))
 		} else {
-			indexHandle, err = tablecodec.DecodeIndexHandle(m.key, m.value, len(indexInfo.Columns))
+			indexHandle, err = tablecodec.DecodeIndexHandle(m.key, m.value, indexValuesLen(indexInfo))
 		}
 		if err != nil {
 			return errors.Trace(err)
@@ -190,6 +198,14 @@ func checkHandleConsistency(rowInsertion mutation, indexMutations []mutation, in
 	return err
 }
 
+// indexValuesLen returns the number of values encoded in the keys of the index.
+func indexValuesLen(indexInfo *model.IndexInfo) int {
+	if indexInfo.Tp == model.IndexTypeFullText {
+		return fulltext.IndexEntryLen
+	}
+	return len(indexInfo.Columns)
+}
+
 // checkIndexKeys checks whether the decoded data from keys of index mutations are consistent with the expected ones.
 //
 // How it works:
@@ -215,6 +231,10 @@ func checkIndexKeys(
 		if !ok {
 			return errors.New("index not found")
 		}
+		if indexInfo.IsSearchIndex() {
+			// The values of a full-text index are tokens, and the values of a vector index are graph nodes.
+			continue
+		}
 		rowColInfos, ok := indexIDToRowColInfos[idxID]
 		if !ok {
 			return errors.New("index not found")
@@ -338,7 +358,8 @@ func collectTableMutationsFromBufferStage(t *TableCommon, memBuffer kv.MemBuffer
 					}
 				}
 			} else {
-				_, m.indexID, _, err = tablecodec.DecodeIndexKey(m.key)
+				// The keys of a vector index are graph nodes rather than encoded values, so only the index ID is decoded.
+				m.indexID, err = tablecodec.DecodeIndexID(m.key)
 				if err != nil {
 					err = errors.Trace(err)
 				}
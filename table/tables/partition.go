@@ -1668,14 +1668,14 @@ func (t *partitionTableWithGivenSets) GetAllPartitionIDs() []int64 {
 }
 
 // RemoveRecord implements table.Table RemoveRecord interface.
-func (t *partitionedTable) RemoveRecord(ctx sessionctx.Context, h kv.Handle, r []types.Datum) error {
+func (t *partitionedTable) RemoveRecord(ctx sessionctx.Context, h kv.Handle, r []types.Datum, opts ...table.DeleteIdxOptFunc) error {
 	pid, err := t.locatePartition(ctx, r)
 	if err != nil {
 		return errors.Trace(err)
 	}
 
 	tbl := t.GetPartition(pid)
-	err = tbl.RemoveRecord(ctx, h, r)
+	err = tbl.RemoveRecord(ctx, h, r, opts...)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -1686,7 +1686,7 @@ func (t *partitionedTable) RemoveRecord(ctx sessionctx.Context, h kv.Handle, r [
 			return errors.Trace(err)
 		}
 		tbl = t.GetPartition(pid)
-		err = tbl.RemoveRecord(ctx, h, r)
+		err = tbl.RemoveRecord(ctx, h, r, opts...)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -1759,7 +1759,7 @@ func partitionedTableUpdateRecord(gctx context.Context, ctx sessionctx.Context,
 		// So this special order is chosen: add record first, errors such as
 		// 'Key Already Exists' will generally happen during step1, errors are
 		// unlikely to happen in step2.
-		err = t.GetPartition(from).RemoveRecord(ctx, h, currData)
+		err = t.GetPartition(from).RemoveRecord(ctx, h, currData, table.DeleteWithCtx(gctx))
 		if err != nil {
 			logutil.BgLogger().Error("update partition record fails", zap.String("message", "new record inserted while old record is not removed"), zap.Error(err))
 			return errors.Trace(err)
@@ -1793,7 +1793,7 @@ func partitionedTableUpdateRecord(gctx context.Context, ctx sessionctx.Context,
 		}
 		if newFrom != 0 {
 			tbl := t.GetPartition(newFrom)
-			err = tbl.RemoveRecord(ctx, h, currData)
+			err = tbl.RemoveRecord(ctx, h, currData, table.DeleteWithCtx(gctx))
 			// TODO: Can this happen? When the data is not yet backfilled?
 			if err != nil {
 				return errors.Trace(err)
@@ -1820,7 +1820,7 @@ func partitionedTableUpdateRecord(gctx context.Context, ctx sessionctx.Context,
 		if newTo == newFrom {
 			tbl = t.GetPartition(newTo)
 			if t.Meta().Partition.DDLState == model.StateDeleteOnly {
-				err = tbl.RemoveRecord(ctx, h, currData)
+				err = tbl.RemoveRecord(ctx, h, currData, table.DeleteWithCtx(gctx))
 			} else {
 				err = tbl.UpdateRecord(gctx, ctx, h, currData, newData, touched)
 			}
@@ -1837,7 +1837,7 @@ func partitionedTableUpdateRecord(gctx context.Context, ctx sessionctx.Context,
 			}
 		}
 		tbl = t.GetPartition(newFrom)
-		err = tbl.RemoveRecord(ctx, h, currData)
+		err = tbl.RemoveRecord(ctx, h, currData, table.DeleteWithCtx(gctx))
 		if err != nil {
 			return errors.Trace(err)
 		}
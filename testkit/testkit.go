@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/executor"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/ast"
@@ -347,9 +348,23 @@ func (tk *TestKit) ExecWithContext(ctx context.Context, sql string, args ...inte
 		for i, stmt := range stmts {
 			var rs sqlexec.RecordSet
 			var err error
-			if s, ok := stmt.(*ast.NonTransactionalDMLStmt); ok {
+			switch s := stmt.(type) {
+			case *ast.NonTransactionalDMLStmt:
 				rs, err = session.HandleNonTransactionalDML(ctx, s, tk.Session())
-			} else {
+			case *ast.CallStmt:
+				// Only the first result set of the procedure is returned, use executor.TakeCallResults to check all
+				// of them.
+				rs, err = tk.Session().ExecuteStmt(ctx, s)
+				if results := executor.TakeCallResults(tk.Session()); results != nil {
+					for j, r := range results.ResultSets {
+						if j == 0 {
+							rs = r
+						} else {
+							terror.Call(r.Close)
+						}
+					}
+				}
+			default:
 				rs, err = tk.Session().ExecuteStmt(ctx, stmt)
 			}
 			if i == 0 {
@@ -0,0 +1,68 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importintotest
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/fsouza/fake-gcs-server/fakestorage"
+	"github.com/pingcap/tidb/testkit"
+)
+
+// test.avro is written by Kafka Connect style schema with deflate codec, see
+// br/pkg/lightning/mydump/avro/kafka_connect.avro.
+//
+//go:embed test.avro
+var avroContent []byte
+
+func (s *mockGCSSuite) TestLoadAvro() {
+	s.tk.MustExec("DROP DATABASE IF EXISTS load_avro;")
+	s.tk.MustExec("CREATE DATABASE load_avro;")
+	s.tk.MustExec("USE load_avro;")
+	s.tk.MustExec("CREATE TABLE t (" +
+		"id BIGINT, name VARCHAR(20), price DECIMAL(10, 2), birthday DATE, clock TIME(3), " +
+		"active BOOL, score FLOAT, ratio DOUBLE, status VARCHAR(10), payload VARBINARY(10), " +
+		"tags JSON, attrs JSON);")
+	// the created_at field of the file is skipped.
+	columns := "(id, name, price, @1, birthday, clock, active, score, ratio, status, payload, tags, attrs)"
+	expectedRows := testkit.RowsWithSep("|",
+		`1|alice|123.45|1990-01-02|13:14:15.500|1|1.5|0.25|ACTIVE|0001|["a", "b"]|{"k": 1}`,
+		`2|<nil>|-0.05|<nil>|00:00:00.000|0|-2|10000000000|INACTIVE||[]|{}`,
+		`3|carol|<nil>|2000-02-29|00:00:01.000|1|0|-0.5|ACTIVE|78|["c"]|{}`,
+		`4|dave|100.00|<nil>|00:01:00.000|0|3.25|3.5|INACTIVE|79|[]|{}`,
+		`5|eve|0.01|<nil>|00:00:00.000|1|4|4.5|ACTIVE|7A|[]|{}`,
+	)
+	query := "SELECT id, name, price, birthday, clock, active, score, ratio, status, hex(payload), tags, attrs FROM t;"
+
+	tempDir := s.T().TempDir()
+	s.NoError(os.WriteFile(path.Join(tempDir, "test.avro"), avroContent, 0o644))
+	s.tk.MustQuery(fmt.Sprintf("IMPORT INTO t%s FROM '%s' FORMAT 'avro';", columns, path.Join(tempDir, "test.avro")))
+	s.tk.MustQuery(query).Check(expectedRows)
+	s.tk.MustExec("TRUNCATE TABLE t;")
+
+	s.server.CreateObject(fakestorage.Object{
+		ObjectAttrs: fakestorage.ObjectAttrs{
+			BucketName: "test-load-avro",
+			Name:       "a.avro",
+		},
+		Content: avroContent,
+	})
+	s.tk.MustQuery(fmt.Sprintf("IMPORT INTO t%s FROM 'gs://test-load-avro/*.avro?endpoint=%s' FORMAT 'avro';",
+		columns, gcsEndpoint))
+	s.tk.MustQuery(query).Check(expectedRows)
+}
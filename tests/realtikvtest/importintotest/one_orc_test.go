@@ -0,0 +1,65 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importintotest
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/fsouza/fake-gcs-server/fakestorage"
+	"github.com/pingcap/tidb/testkit"
+)
+
+// test.orc is written with zlib compression in 2 stripes, see
+// br/pkg/lightning/mydump/orc/hive.orc.
+//
+//go:embed test.orc
+var orcContent []byte
+
+func (s *mockGCSSuite) TestLoadORC() {
+	s.tk.MustExec("DROP DATABASE IF EXISTS load_orc;")
+	s.tk.MustExec("CREATE DATABASE load_orc;")
+	s.tk.MustExec("USE load_orc;")
+	s.tk.MustExec("CREATE TABLE t (" +
+		"id BIGINT, name VARCHAR(20), price DECIMAL(10, 2), created_at DATETIME(3), birthday DATE, " +
+		"active BOOL, score FLOAT, ratio DOUBLE, status VARCHAR(10), payload VARBINARY(10), " +
+		"tags JSON, attrs JSON);")
+	expectedRows := testkit.RowsWithSep("|",
+		`1|alice|123.45|2023-07-01 12:30:45.123|1990-01-02|1|1.5|0.25|ACTIVE|0001|["a", "b"]|{"k": 1}`,
+		`2|<nil>|-0.05|1970-01-01 00:00:00.000|<nil>|0|-2|10000000000|INACTIVE||[]|{}`,
+		`3|carol|<nil>|2000-02-29 23:59:59.000|2000-02-29|1|0|-0.5|ACTIVE|78|["c"]|{}`,
+		`4|dave|100.00|2023-12-31 01:02:03.000|<nil>|0|3.25|3.5|INACTIVE|79|[]|{}`,
+		`5|eve|0.01|2024-01-01 00:00:00.000|<nil>|1|4|4.5|ACTIVE|7A|[]|{}`,
+	)
+	query := "SELECT id, name, price, created_at, birthday, active, score, ratio, status, hex(payload), tags, attrs FROM t;"
+
+	tempDir := s.T().TempDir()
+	s.NoError(os.WriteFile(path.Join(tempDir, "test.orc"), orcContent, 0o644))
+	s.tk.MustQuery(fmt.Sprintf("IMPORT INTO t FROM '%s' FORMAT 'orc';", path.Join(tempDir, "test.orc")))
+	s.tk.MustQuery(query).Check(expectedRows)
+	s.tk.MustExec("TRUNCATE TABLE t;")
+
+	s.server.CreateObject(fakestorage.Object{
+		ObjectAttrs: fakestorage.ObjectAttrs{
+			BucketName: "test-load-orc",
+			Name:       "a.orc",
+		},
+		Content: orcContent,
+	})
+	s.tk.MustQuery(fmt.Sprintf("IMPORT INTO t FROM 'gs://test-load-orc/*.orc?endpoint=%s' FORMAT 'orc';", gcsEndpoint))
+	s.tk.MustQuery(query).Check(expectedRows)
+}
@@ -91,6 +91,13 @@ func WithSetSchedExpr(tp SchedPolicyType, expr string) UpdateTimerOption {
 	}
 }
 
+// WithSetData indicates to set the timer's data.
+func WithSetData(data []byte) UpdateTimerOption {
+	return func(update *TimerUpdate) {
+		update.Data.Set(data)
+	}
+}
+
 // WithSetWatermark indicates to set the timer's watermark.
 func WithSetWatermark(watermark time.Time) UpdateTimerOption {
 	return func(update *TimerUpdate) {
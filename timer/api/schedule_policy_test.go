@@ -70,6 +70,33 @@ func TestIntervalPolicy(t *testing.T) {
 	}
 }
 
+func TestOncePolicy(t *testing.T) {
+	p, err := CreateSchedEventPolicy(SchedEventOnce, "2021-11-21")
+	require.Nil(t, p)
+	require.ErrorContains(t, err, "invalid once expr '2021-11-21'")
+
+	p, err = CreateSchedEventPolicy(SchedEventOnce, "2021-11-21T11:21:31+08:00")
+	require.NoError(t, err)
+	require.IsType(t, &OncePolicy{}, p)
+
+	tm, err := time.Parse(time.RFC3339, "2021-11-21T11:21:31+08:00")
+	require.NoError(t, err)
+
+	next, ok := p.NextEventTime(time.Time{})
+	require.True(t, ok)
+	require.True(t, tm.Equal(next))
+
+	next, ok = p.NextEventTime(tm.Add(-time.Second))
+	require.True(t, ok)
+	require.True(t, tm.Equal(next))
+
+	_, ok = p.NextEventTime(tm)
+	require.False(t, ok)
+
+	_, ok = p.NextEventTime(tm.Add(time.Hour))
+	require.False(t, ok)
+}
+
 func TestCronPolicy(t *testing.T) {
 	locE2 := time.FixedZone("UTC+1", 2*60*60)
 	locW2 := time.FixedZone("UTC-1", -2*60*60)
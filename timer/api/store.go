@@ -174,6 +174,8 @@ type TimerUpdate struct {
 	SchedPolicyType OptionalVal[SchedPolicyType]
 	// SchedPolicyExpr indicates to set the timer's `SchedPolicyExpr` field.
 	SchedPolicyExpr OptionalVal[string]
+	// Data indicates to set the timer's `Data` field.
+	Data OptionalVal[[]byte]
 	// ManualRequest indicates to set the timer's manual request.
 	ManualRequest OptionalVal[ManualRequest]
 	// EventStatus indicates the event status.
@@ -231,6 +233,10 @@ func (u *TimerUpdate) apply(record *TimerRecord) (*TimerRecord, error) {
 		record.SchedPolicyExpr = v
 	}
 
+	if v, ok := u.Data.Get(); ok {
+		record.Data = v
+	}
+
 	if v, ok := u.ManualRequest.Get(); ok {
 		record.ManualRequest = v
 	}
@@ -249,6 +249,7 @@ func TestTimerUpdate(t *testing.T) {
 		TimeZone:        NewOptionalVal("UTC"),
 		SchedPolicyType: NewOptionalVal(SchedEventInterval),
 		SchedPolicyExpr: NewOptionalVal("5h"),
+		Data:            NewOptionalVal([]byte("data1")),
 		Watermark:       NewOptionalVal(now),
 		SummaryData:     NewOptionalVal([]byte("summarydata1")),
 		EventStatus:     NewOptionalVal(SchedEventTrigger),
@@ -277,6 +278,7 @@ func TestTimerUpdate(t *testing.T) {
 	require.Equal(t, time.UTC, record.Location)
 	require.Equal(t, SchedEventInterval, record.SchedPolicyType)
 	require.Equal(t, "5h", record.SchedPolicyExpr)
+	require.Equal(t, []byte("data1"), record.Data)
 	require.Equal(t, now, record.Watermark)
 	require.Equal(t, []byte("summarydata1"), record.SummaryData)
 	require.Equal(t, SchedEventTrigger, record.EventStatus)
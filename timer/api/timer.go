@@ -31,6 +31,8 @@ const (
 	SchedEventInterval SchedPolicyType = "INTERVAL"
 	// SchedEventCron indicates to schedule events by cron expression.
 	SchedEventCron SchedPolicyType = "CRON"
+	// SchedEventOnce indicates to schedule only one event at a specified time.
+	SchedEventOnce SchedPolicyType = "ONCE"
 )
 
 // SchedEventPolicy is an interface to tell the runtime how to schedule a timer's events.
@@ -91,6 +93,32 @@ func (p *CronPolicy) NextEventTime(watermark time.Time) (time.Time, bool) {
 	return next, !next.IsZero()
 }
 
+// OncePolicy implements SchedEventPolicy, it is the policy of type `SchedEventOnce`.
+type OncePolicy struct {
+	tm time.Time
+}
+
+// NewOncePolicy creates a new OncePolicy. The expr should be a time in the format of RFC3339.
+func NewOncePolicy(expr string) (*OncePolicy, error) {
+	tm, err := time.Parse(time.RFC3339, expr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid once expr '%s'", expr)
+	}
+
+	return &OncePolicy{
+		tm: tm,
+	}, nil
+}
+
+// NextEventTime returns the next time of the timer event.
+// An event is only scheduled at the specified time if it has not been triggered, that is, the watermark is before it.
+func (p *OncePolicy) NextEventTime(watermark time.Time) (time.Time, bool) {
+	if watermark.Before(p.tm) {
+		return p.tm, true
+	}
+	return time.Time{}, false
+}
+
 // ManualRequest is the request info to trigger timer manually.
 type ManualRequest struct {
 	// ManualRequestID is the id of manual request.
@@ -197,6 +225,8 @@ func CreateSchedEventPolicy(tp SchedPolicyType, expr string) (SchedEventPolicy,
 		return NewSchedIntervalPolicy(expr)
 	case SchedEventCron:
 		return NewCronPolicy(expr)
+	case SchedEventOnce:
+		return NewOncePolicy(expr)
 	default:
 		return nil, errors.Errorf("invalid schedule event type: '%s'", tp)
 	}
@@ -428,6 +428,11 @@ func buildUpdateCriteria(update *api.TimerUpdate, args []any) (string, []any, er
 		args = append(args, val)
 	}
 
+	if val, ok := update.Data.Get(); ok {
+		updateFields = append(updateFields, "TIMER_DATA = %?")
+		args = append(args, val)
+	}
+
 	if val, ok := update.EventStatus.Get(); ok {
 		updateFields = append(updateFields, "EVENT_STATUS = %?")
 		args = append(args, string(val))
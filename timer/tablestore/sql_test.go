@@ -419,6 +419,7 @@ func TestBuildUpdateCriteria(t *testing.T) {
 				TimeZone:        api.NewOptionalVal("Asia/Shanghai"),
 				SchedPolicyType: api.NewOptionalVal(api.SchedEventInterval),
 				SchedPolicyExpr: api.NewOptionalVal("1h"),
+				Data:            api.NewOptionalVal([]byte("timerdata")),
 				ManualRequest: api.NewOptionalVal(api.ManualRequest{
 					ManualRequestID:   "req1",
 					ManualRequestTime: time.Unix(123, 0),
@@ -439,13 +440,13 @@ func TestBuildUpdateCriteria(t *testing.T) {
 				CheckEventID: api.NewOptionalVal("ee"),
 				CheckVersion: api.NewOptionalVal(uint64(1)),
 			},
-			criteria: "ENABLE = %?, TIMEZONE = %?, SCHED_POLICY_TYPE = %?, SCHED_POLICY_EXPR = %?, EVENT_STATUS = %?, " +
+			criteria: "ENABLE = %?, TIMEZONE = %?, SCHED_POLICY_TYPE = %?, SCHED_POLICY_EXPR = %?, TIMER_DATA = %?, EVENT_STATUS = %?, " +
 				"EVENT_ID = %?, EVENT_DATA = %?, EVENT_START = FROM_UNIXTIME(%?), " +
 				"WATERMARK = FROM_UNIXTIME(%?), SUMMARY_DATA = %?, " +
 				"TIMER_EXT = JSON_MERGE_PATCH(TIMER_EXT, %?), " +
 				"VERSION = VERSION + 1",
 			args: []any{
-				false, "Asia/Shanghai", "INTERVAL", "1h", "TRIGGER", "event1", []byte("data1"), now.Unix(),
+				false, "Asia/Shanghai", "INTERVAL", "1h", []byte("timerdata"), "TRIGGER", "event1", []byte("data1"), now.Unix(),
 				now.Unix() + 1, []byte("summary"),
 				json.RawMessage(`{` +
 					`"event":{"manual_request_id":"req2","watermark_unix":456},` +
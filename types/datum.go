@@ -941,6 +941,10 @@ func (d *Datum) ConvertTo(sc *stmtctx.StatementContext, target *FieldType) (Datu
 		return d.convertToMysqlSet(sc, target)
 	case mysql.TypeJSON:
 		return d.convertToMysqlJSON(sc, target)
+	case mysql.TypeGeometry:
+		return d.convertToGeometry(target)
+	case mysql.TypeVector:
+		return d.convertToVector(target)
 	case mysql.TypeNull:
 		return Datum{}, nil
 	default:
@@ -1090,7 +1094,8 @@ func (d *Datum) convertToString(sc *stmtctx.StatementContext, target *FieldType)
 // whether we should pad `\0` for `binary(flen)` type.
 func ProduceStrWithSpecifiedTp(s string, tp *FieldType, sc *stmtctx.StatementContext, padZero bool) (_ string, err error) {
 	flen, chs := tp.GetFlen(), tp.GetCharset()
-	if flen >= 0 {
+	// The flen of a vector type is its dimension rather than the length of the value.
+	if flen >= 0 && tp.GetType() != mysql.TypeVector {
 		// overflowed stores the part of the string that is out of the length constraint, it is later checked to see if the
 		// overflowed part is all whitespaces
 		var overflowed string
@@ -1740,6 +1745,56 @@ func (d *Datum) convertToMysqlJSON(_ *stmtctx.StatementContext, _ *FieldType) (r
 	return ret, errors.Trace(err)
 }
 
+func (d *Datum) convertToGeometry(target *FieldType) (ret Datum, err error) {
+	switch d.k {
+	case KindNull:
+		return ret, nil
+	case KindString, KindBytes, KindBinaryLiteral:
+		var g Geometry
+		g, err = ParseGeometry(d.GetBytes(), "")
+		if err != nil && !ErrGISInvalidData.Equal(err) {
+			// The unknown spatial reference systems and the out-of-range coordinates are reported as is.
+			return ret, errors.Trace(err)
+		}
+		if err == nil && (target.GetGeometryType() == mysql.GeometryTypeGeometry || target.GetGeometryType() == g.Type) {
+			ret.SetBytes(g.Encode())
+			return ret, nil
+		}
+	}
+	return ret, ErrCantCreateGeometryObject.GenWithStackByArgs()
+}
+
+func (d *Datum) convertToVector(target *FieldType) (ret Datum, err error) {
+	var v Vector
+	switch d.k {
+	case KindNull:
+		return ret, nil
+	case KindString:
+		// The binary strings, such as the values of the vector columns, are in the internal format.
+		if d.collation == charset.CollationBin {
+			v, err = ParseVectorBinary(d.GetBytes())
+		} else {
+			v, err = ParseVectorText(d.GetString())
+		}
+	case KindBytes, KindBinaryLiteral:
+		v, err = ParseVectorBinary(d.GetBytes())
+	default:
+		var s string
+		s, err = d.ToString()
+		if err == nil {
+			err = ErrVectorInvalidValue.GenWithStackByArgs(s)
+		}
+	}
+	if err != nil {
+		return ret, errors.Trace(err)
+	}
+	if err = v.CheckDimension(target.GetFlen()); err != nil {
+		return ret, err
+	}
+	ret.SetBytes(v.Encode())
+	return ret, nil
+}
+
 // ToBool converts to a bool.
 // We will use 1 for true, and 0 for false.
 func (d *Datum) ToBool(sc *stmtctx.StatementContext) (int64, error) {
@@ -51,7 +51,7 @@ func IsTypeUnspecified(tp byte) bool {
 // IsTypePrefixable returns a boolean indicating
 // whether an index on a column with the tp can be defined with a prefix.
 func IsTypePrefixable(tp byte) bool {
-	return IsTypeBlob(tp) || IsTypeChar(tp)
+	return IsTypeBlob(tp) || IsTypeChar(tp) || tp == mysql.TypeGeometry
 }
 
 // IsTypeFractionable returns a boolean indicating
@@ -178,6 +178,9 @@ func KindStr(kind byte) (r string) {
 //	cs: charset
 var TypeToStr = ast.TypeToStr
 
+// GeometryTypeStr converts the geometry subtype to a string.
+var GeometryTypeStr = ast.GeometryTypeStr
+
 // EOFAsNil filtrates errors,
 // If err is equal to io.EOF returns nil.
 func EOFAsNil(err error) error {
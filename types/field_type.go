@@ -373,6 +373,13 @@ func DefaultCharsetForType(tp byte) (defaultCharset string, defaultCollation str
 // The result field type of the case expression is the merged type of the two when clause.
 // See https://github.com/mysql/mysql-server/blob/8.0/sql/field.cc#L1042
 func MergeFieldType(a byte, b byte) byte {
+	// The vector type isn't in the merge rules of MySQL 8.0, a vector only merges with another vector or NULL.
+	if a == mysql.TypeVector || b == mysql.TypeVector {
+		if (a == mysql.TypeVector || a == mysql.TypeNull) && (b == mysql.TypeVector || b == mysql.TypeNull) {
+			return mysql.TypeVector
+		}
+		return mysql.TypeLongBlob
+	}
 	ia := getFieldTypeIndex(a)
 	ib := getFieldTypeIndex(b)
 	return fieldTypeMergeRules[ia][ib]
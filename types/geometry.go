@@ -0,0 +1,627 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/util/dbterror"
+)
+
+const (
+	// GeometrySRIDCartesian is the SRID of the Cartesian plane, whose coordinates have no units.
+	GeometrySRIDCartesian uint32 = 0
+	// GeometrySRIDWGS84 is the SRID of the geographic spatial reference system WGS 84.
+	// Like MySQL, its axis order in WKT and WKB is latitude-longitude.
+	GeometrySRIDWGS84 uint32 = 4326
+
+	geometrySRIDLen = 4
+	wkbHeaderLen    = 5
+	wkbPointLen     = 16
+	// maxGeometryDepth is the max nesting depth of the geometry collections.
+	maxGeometryDepth = 64
+
+	wkbBigEndian    byte = 0
+	wkbLittleEndian byte = 1
+)
+
+var (
+	// ErrCantCreateGeometryObject is returned when the value stored into a geometry column isn't a geometry.
+	ErrCantCreateGeometryObject = dbterror.ClassTypes.NewStd(errno.ErrCantCreateGeometryObject)
+	// ErrGISInvalidData is returned when the geometry argument of a function is invalid.
+	ErrGISInvalidData = dbterror.ClassTypes.NewStd(errno.ErrGISInvalidData)
+	// ErrGISDifferentSRIDs is returned when the geometries of a binary function have different SRIDs.
+	ErrGISDifferentSRIDs = dbterror.ClassTypes.NewStd(errno.ErrGISDifferentSRIDs)
+	// ErrGISUnsupportedArgument is returned when the types of the geometries aren't supported by a function.
+	ErrGISUnsupportedArgument = dbterror.ClassTypes.NewStd(errno.ErrGISUnsupportedArgument)
+	// ErrSRSNotFound is returned when the spatial reference system of a geometry is unknown.
+	ErrSRSNotFound = dbterror.ClassTypes.NewStd(errno.ErrSRSNotFound)
+	// ErrNonpositiveRadius is returned when the radius of a sphere isn't positive.
+	ErrNonpositiveRadius = dbterror.ClassTypes.NewStd(errno.ErrNonpositiveRadius)
+	// ErrLongitudeOutOfRange is returned when a longitude is out of (-180, 180].
+	ErrLongitudeOutOfRange = dbterror.ClassTypes.NewStd(errno.ErrLongitudeOutOfRange)
+	// ErrLatitudeOutOfRange is returned when a latitude is out of [-90, 90].
+	ErrLatitudeOutOfRange = dbterror.ClassTypes.NewStd(errno.ErrLatitudeOutOfRange)
+	// errGeographicNotSupported is returned when a function doesn't support the geographic spatial reference systems yet.
+	errGeographicNotSupported = dbterror.ClassTypes.NewStd(errno.ErrNotSupportedYet)
+)
+
+// GeoPoint is a point of a geometry. For the geographic spatial reference systems,
+// X is the longitude and Y is the latitude in degrees.
+type GeoPoint struct {
+	X float64
+	Y float64
+}
+
+// Geometry is a geometry value of the OGC simple feature model. Its storage format is the same as MySQL,
+// which is a 4-byte little-endian SRID followed by the geometry in WKB, see Encode and ParseGeometry.
+type Geometry struct {
+	// SRID is the spatial reference system identifier of the geometry.
+	SRID uint32
+	// Type is one of mysql.GeometryTypePoint, ..., mysql.GeometryTypeGeometryCollection.
+	Type byte
+	// Points are the coordinates of a point or a linestring.
+	Points []GeoPoint
+	// Geometries are the rings of a polygon, or the elements of a multi-geometry or a geometry collection.
+	Geometries []Geometry
+}
+
+// NewGeometryPoint creates a point in the given spatial reference system.
+func NewGeometryPoint(srid uint32, x, y float64) Geometry {
+	return Geometry{SRID: srid, Type: mysql.GeometryTypePoint, Points: []GeoPoint{{X: x, Y: y}}}
+}
+
+// IsGeographicSRID returns whether the spatial reference system is geographic.
+func IsGeographicSRID(srid uint32) bool {
+	return srid == GeometrySRIDWGS84
+}
+
+// CheckGeometrySRID checks whether the spatial reference system is known.
+func CheckGeometrySRID(srid uint32) error {
+	if srid != GeometrySRIDCartesian && srid != GeometrySRIDWGS84 {
+		return ErrSRSNotFound.GenWithStackByArgs(srid)
+	}
+	return nil
+}
+
+// ParseGeometry parses a geometry stored in the MySQL internal format.
+func ParseGeometry(data []byte, funcName string) (Geometry, error) {
+	if len(data) < geometrySRIDLen+wkbHeaderLen {
+		return Geometry{}, ErrGISInvalidData.GenWithStackByArgs(funcName)
+	}
+	srid := binary.LittleEndian.Uint32(data)
+	// The coordinates are always stored in the longitude-latitude order.
+	return parseWKB(data[geometrySRIDLen:], srid, false, funcName)
+}
+
+// ParseGeometryFromWKB parses a geometry from WKB in the given spatial reference system.
+func ParseGeometryFromWKB(wkb []byte, srid uint32, funcName string) (Geometry, error) {
+	return parseWKB(wkb, srid, IsGeographicSRID(srid), funcName)
+}
+
+func parseWKB(wkb []byte, srid uint32, swapAxes bool, funcName string) (Geometry, error) {
+	if err := CheckGeometrySRID(srid); err != nil {
+		return Geometry{}, err
+	}
+	r := wkbReader{data: wkb}
+	g, ok := r.readGeometry(srid, 0)
+	if !ok || r.pos != len(r.data) {
+		return Geometry{}, ErrGISInvalidData.GenWithStackByArgs(funcName)
+	}
+	return g.finish(swapAxes, funcName)
+}
+
+// ParseGeometryFromWKT parses a geometry from WKT in the given spatial reference system.
+func ParseGeometryFromWKT(wkt string, srid uint32, funcName string) (Geometry, error) {
+	if err := CheckGeometrySRID(srid); err != nil {
+		return Geometry{}, err
+	}
+	p := wktParser{s: wkt}
+	g, ok := p.parseGeometry(srid, 0)
+	if ok {
+		p.skipSpaces()
+		ok = p.pos == len(p.s) && g.isValid()
+	}
+	if !ok {
+		return Geometry{}, ErrGISInvalidData.GenWithStackByArgs(funcName)
+	}
+	return g.finish(IsGeographicSRID(srid), funcName)
+}
+
+// finish swaps the axes of the geometry parsed in the latitude-longitude order, and checks the ranges of
+// the geographic coordinates.
+func (g Geometry) finish(swapAxes bool, funcName string) (Geometry, error) {
+	if swapAxes {
+		g.walkPoints(func(p *GeoPoint) { p.X, p.Y = p.Y, p.X })
+	}
+	if IsGeographicSRID(g.SRID) {
+		var err error
+		g.walkPoints(func(p *GeoPoint) {
+			if err == nil {
+				err = checkGeographicPoint(*p, funcName)
+			}
+		})
+		if err != nil {
+			return Geometry{}, err
+		}
+	}
+	return g, nil
+}
+
+func checkGeographicPoint(p GeoPoint, funcName string) error {
+	if p.X <= -180 || p.X > 180 {
+		return ErrLongitudeOutOfRange.GenWithStackByArgs(p.X, funcName, -180.0, 180.0)
+	}
+	if p.Y < -90 || p.Y > 90 {
+		return ErrLatitudeOutOfRange.GenWithStackByArgs(p.Y, funcName, -90.0, 90.0)
+	}
+	return nil
+}
+
+func (g *Geometry) walkPoints(f func(p *GeoPoint)) {
+	for i := range g.Points {
+		f(&g.Points[i])
+	}
+	for i := range g.Geometries {
+		g.Geometries[i].walkPoints(f)
+	}
+}
+
+// isValid checks the structure of the geometry: a linestring has at least 2 points, the rings of a polygon
+// are closed and have at least 4 points, the elements of the multi-geometries have the expected types,
+// and all the coordinates are finite.
+func (g *Geometry) isValid() bool {
+	for _, p := range g.Points {
+		if math.IsNaN(p.X) || math.IsInf(p.X, 0) || math.IsNaN(p.Y) || math.IsInf(p.Y, 0) {
+			return false
+		}
+	}
+	switch g.Type {
+	case mysql.GeometryTypePoint:
+		return len(g.Points) == 1
+	case mysql.GeometryTypeLineString:
+		return len(g.Points) >= 2
+	case mysql.GeometryTypePolygon:
+		if len(g.Geometries) == 0 {
+			return false
+		}
+		for _, ring := range g.Geometries {
+			n := len(ring.Points)
+			if !ring.isValid() || n < 4 || ring.Points[0] != ring.Points[n-1] {
+				return false
+			}
+		}
+		return true
+	case mysql.GeometryTypeMultiPoint, mysql.GeometryTypeMultiLineString, mysql.GeometryTypeMultiPolygon:
+		if len(g.Geometries) == 0 {
+			return false
+		}
+		for i := range g.Geometries {
+			if g.Geometries[i].Type != g.Type-3 || !g.Geometries[i].isValid() {
+				return false
+			}
+		}
+		return true
+	case mysql.GeometryTypeGeometryCollection:
+		for i := range g.Geometries {
+			if !g.Geometries[i].isValid() {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// IsEmpty returns whether the geometry is an empty geometry collection.
+func (g Geometry) IsEmpty() bool {
+	return g.Type == mysql.GeometryTypeGeometryCollection && len(g.Geometries) == 0
+}
+
+// TypeName returns the name of the geometry type, e.g. POINT or POLYGON.
+func (g Geometry) TypeName() string {
+	return strings.ToUpper(GeometryTypeStr(g.Type))
+}
+
+// NumPoints returns the number of the points of a linestring.
+func (g Geometry) NumPoints() int {
+	return len(g.Points)
+}
+
+// X returns the first coordinate of a point in the axis order of its spatial reference system.
+func (g Geometry) X() float64 {
+	if IsGeographicSRID(g.SRID) {
+		return g.Points[0].Y
+	}
+	return g.Points[0].X
+}
+
+// Y returns the second coordinate of a point in the axis order of its spatial reference system.
+func (g Geometry) Y() float64 {
+	if IsGeographicSRID(g.SRID) {
+		return g.Points[0].X
+	}
+	return g.Points[0].Y
+}
+
+// Encode encodes the geometry into the MySQL internal format.
+func (g Geometry) Encode() []byte {
+	buf := make([]byte, geometrySRIDLen, geometrySRIDLen+g.wkbLen())
+	binary.LittleEndian.PutUint32(buf, g.SRID)
+	return g.appendWKB(buf, false)
+}
+
+// WKB encodes the geometry into little-endian WKB in the axis order of its spatial reference system.
+func (g Geometry) WKB() []byte {
+	return g.appendWKB(make([]byte, 0, g.wkbLen()), IsGeographicSRID(g.SRID))
+}
+
+func (g *Geometry) wkbLen() int {
+	l := wkbHeaderLen
+	switch g.Type {
+	case mysql.GeometryTypePoint:
+		return l + wkbPointLen
+	case mysql.GeometryTypeLineString:
+		return l + 4 + len(g.Points)*wkbPointLen
+	case mysql.GeometryTypePolygon:
+		l += 4
+		for _, ring := range g.Geometries {
+			l += 4 + len(ring.Points)*wkbPointLen
+		}
+		return l
+	}
+	l += 4
+	for i := range g.Geometries {
+		l += g.Geometries[i].wkbLen()
+	}
+	return l
+}
+
+func (g *Geometry) appendWKB(buf []byte, swapAxes bool) []byte {
+	buf = append(buf, wkbLittleEndian)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(g.Type))
+	appendPoints := func(buf []byte, points []GeoPoint) []byte {
+		for _, p := range points {
+			x, y := p.X, p.Y
+			if swapAxes {
+				x, y = y, x
+			}
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(x))
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(y))
+		}
+		return buf
+	}
+	switch g.Type {
+	case mysql.GeometryTypePoint:
+		return appendPoints(buf, g.Points)
+	case mysql.GeometryTypeLineString:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Points)))
+		return appendPoints(buf, g.Points)
+	case mysql.GeometryTypePolygon:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Geometries)))
+		for _, ring := range g.Geometries {
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(ring.Points)))
+			buf = appendPoints(buf, ring.Points)
+		}
+		return buf
+	}
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Geometries)))
+	for i := range g.Geometries {
+		buf = g.Geometries[i].appendWKB(buf, swapAxes)
+	}
+	return buf
+}
+
+// String returns the geometry in WKT in the axis order of its spatial reference system.
+func (g Geometry) String() string {
+	return string(g.appendWKT(nil, IsGeographicSRID(g.SRID), true))
+}
+
+func (g *Geometry) appendWKT(buf []byte, swapAxes bool, withType bool) []byte {
+	appendPoint := func(buf []byte, p GeoPoint) []byte {
+		x, y := p.X, p.Y
+		if swapAxes {
+			x, y = y, x
+		}
+		buf = appendGeometryCoordinate(buf, x)
+		buf = append(buf, ' ')
+		return appendGeometryCoordinate(buf, y)
+	}
+	appendPoints := func(buf []byte, points []GeoPoint) []byte {
+		buf = append(buf, '(')
+		for i, p := range points {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendPoint(buf, p)
+		}
+		return append(buf, ')')
+	}
+	if withType {
+		if g.Type == mysql.GeometryTypeGeometryCollection {
+			buf = append(buf, "GEOMETRYCOLLECTION"...)
+			if len(g.Geometries) == 0 {
+				return append(buf, " EMPTY"...)
+			}
+		} else {
+			buf = append(buf, g.TypeName()...)
+		}
+	}
+	switch g.Type {
+	case mysql.GeometryTypePoint, mysql.GeometryTypeLineString:
+		return appendPoints(buf, g.Points)
+	}
+	buf = append(buf, '(')
+	for i := range g.Geometries {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		// The elements of a geometry collection are written with their types.
+		buf = g.Geometries[i].appendWKT(buf, swapAxes, g.Type == mysql.GeometryTypeGeometryCollection)
+	}
+	return append(buf, ')')
+}
+
+func appendGeometryCoordinate(buf []byte, f float64) []byte {
+	if f == 0 {
+		// Avoid writing the negative zero.
+		return append(buf, '0')
+	}
+	if abs := math.Abs(f); abs < 1e15 && abs >= 1e-5 {
+		return strconv.AppendFloat(buf, f, 'f', -1, 64)
+	}
+	// Write the exponent like MySQL does, e.g. 1e20 instead of 1e+20, and 1e-7 instead of 1e-07.
+	s := strings.Replace(strconv.FormatFloat(f, 'g', -1, 64), "e+", "e", 1)
+	if i := strings.Index(s, "e-0"); i >= 0 {
+		s = s[:i+2] + s[i+3:]
+	}
+	return append(buf, s...)
+}
+
+type wkbReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *wkbReader) readUint32(order binary.ByteOrder) (uint32, bool) {
+	if r.pos+4 > len(r.data) {
+		return 0, false
+	}
+	v := order.Uint32(r.data[r.pos:])
+	r.pos += 4
+	return v, true
+}
+
+func (r *wkbReader) readPoints(order binary.ByteOrder, n uint32) ([]GeoPoint, bool) {
+	if uint64(n)*wkbPointLen > uint64(len(r.data)-r.pos) {
+		return nil, false
+	}
+	points := make([]GeoPoint, n)
+	for i := range points {
+		points[i].X = math.Float64frombits(order.Uint64(r.data[r.pos:]))
+		points[i].Y = math.Float64frombits(order.Uint64(r.data[r.pos+8:]))
+		r.pos += wkbPointLen
+	}
+	return points, true
+}
+
+func (r *wkbReader) readGeometry(srid uint32, depth int) (g Geometry, ok bool) {
+	if depth > maxGeometryDepth || r.pos >= len(r.data) {
+		return g, false
+	}
+	var order binary.ByteOrder
+	switch r.data[r.pos] {
+	case wkbLittleEndian:
+		order = binary.LittleEndian
+	case wkbBigEndian:
+		order = binary.BigEndian
+	default:
+		return g, false
+	}
+	r.pos++
+	tp, ok := r.readUint32(order)
+	if !ok || tp < uint32(mysql.GeometryTypePoint) || tp > uint32(mysql.GeometryTypeGeometryCollection) {
+		return g, false
+	}
+	g = Geometry{SRID: srid, Type: byte(tp)}
+	if g.Type == mysql.GeometryTypePoint {
+		g.Points, ok = r.readPoints(order, 1)
+		return g, ok && g.isValid()
+	}
+	n, ok := r.readUint32(order)
+	if !ok {
+		return g, false
+	}
+	switch g.Type {
+	case mysql.GeometryTypeLineString:
+		g.Points, ok = r.readPoints(order, n)
+	case mysql.GeometryTypePolygon:
+		// Each ring needs at least 4 bytes.
+		if uint64(n)*4 > uint64(len(r.data)-r.pos) {
+			return g, false
+		}
+		g.Geometries = make([]Geometry, 0, n)
+		for i := uint32(0); i < n && ok; i++ {
+			var m uint32
+			if m, ok = r.readUint32(order); ok {
+				ring := Geometry{SRID: srid, Type: mysql.GeometryTypeLineString}
+				ring.Points, ok = r.readPoints(order, m)
+				g.Geometries = append(g.Geometries, ring)
+			}
+		}
+	default:
+		// Each element needs at least a WKB header.
+		if uint64(n)*wkbHeaderLen > uint64(len(r.data)-r.pos) {
+			return g, false
+		}
+		g.Geometries = make([]Geometry, 0, n)
+		for i := uint32(0); i < n && ok; i++ {
+			var elem Geometry
+			elem, ok = r.readGeometry(srid, depth+1)
+			g.Geometries = append(g.Geometries, elem)
+		}
+	}
+	return g, ok && g.isValid()
+}
+
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) skipSpaces() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t' || p.s[p.pos] == '\n' || p.s[p.pos] == '\r') {
+		p.pos++
+	}
+}
+
+func (p *wktParser) peek(c byte) bool {
+	p.skipSpaces()
+	return p.pos < len(p.s) && p.s[p.pos] == c
+}
+
+func (p *wktParser) consume(c byte) bool {
+	if p.peek(c) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *wktParser) word() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] >= 'a' && p.s[p.pos] <= 'z' || p.s[p.pos] >= 'A' && p.s[p.pos] <= 'Z') {
+		p.pos++
+	}
+	return strings.ToUpper(p.s[start:p.pos])
+}
+
+func (p *wktParser) number() (float64, bool) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte("+-.0123456789eE", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	f, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	return f, err == nil
+}
+
+func (p *wktParser) point() (pt GeoPoint, ok bool) {
+	if pt.X, ok = p.number(); !ok {
+		return pt, false
+	}
+	pt.Y, ok = p.number()
+	return pt, ok
+}
+
+// points parses "(x y, x y, ...)".
+func (p *wktParser) points() ([]GeoPoint, bool) {
+	if !p.consume('(') {
+		return nil, false
+	}
+	var points []GeoPoint
+	for {
+		pt, ok := p.point()
+		if !ok {
+			return nil, false
+		}
+		points = append(points, pt)
+		if !p.consume(',') {
+			break
+		}
+	}
+	return points, p.consume(')')
+}
+
+// list parses "(elem, elem, ...)".
+func (p *wktParser) list(elem func() (Geometry, bool)) ([]Geometry, bool) {
+	if !p.consume('(') {
+		return nil, false
+	}
+	var geometries []Geometry
+	for {
+		g, ok := elem()
+		if !ok {
+			return nil, false
+		}
+		geometries = append(geometries, g)
+		if !p.consume(',') {
+			break
+		}
+	}
+	return geometries, p.consume(')')
+}
+
+func (p *wktParser) parseGeometry(srid uint32, depth int) (g Geometry, ok bool) {
+	if depth > maxGeometryDepth {
+		return g, false
+	}
+	g.SRID = srid
+	lineString := func() (Geometry, bool) {
+		points, ok := p.points()
+		return Geometry{SRID: srid, Type: mysql.GeometryTypeLineString, Points: points}, ok
+	}
+	polygon := func() (Geometry, bool) {
+		rings, ok := p.list(lineString)
+		return Geometry{SRID: srid, Type: mysql.GeometryTypePolygon, Geometries: rings}, ok
+	}
+	switch p.word() {
+	case "POINT":
+		g.Type = mysql.GeometryTypePoint
+		g.Points, ok = p.points()
+	case "LINESTRING":
+		return lineString()
+	case "POLYGON":
+		return polygon()
+	case "MULTIPOINT":
+		g.Type = mysql.GeometryTypeMultiPoint
+		g.Geometries, ok = p.list(func() (Geometry, bool) {
+			// Both MULTIPOINT(0 0, 1 1) and MULTIPOINT((0 0), (1 1)) are accepted.
+			var pt GeoPoint
+			ok := false
+			if p.consume('(') {
+				pt, ok = p.point()
+				ok = ok && p.consume(')')
+			} else {
+				pt, ok = p.point()
+			}
+			return NewGeometryPoint(srid, pt.X, pt.Y), ok
+		})
+	case "MULTILINESTRING":
+		g.Type = mysql.GeometryTypeMultiLineString
+		g.Geometries, ok = p.list(lineString)
+	case "MULTIPOLYGON":
+		g.Type = mysql.GeometryTypeMultiPolygon
+		g.Geometries, ok = p.list(polygon)
+	case "GEOMETRYCOLLECTION", "GEOMCOLLECTION":
+		g.Type = mysql.GeometryTypeGeometryCollection
+		if !p.peek('(') {
+			return g, p.word() == "EMPTY"
+		}
+		start := p.pos
+		if p.consume('(') && p.consume(')') {
+			return g, true
+		}
+		p.pos = start
+		g.Geometries, ok = p.list(func() (Geometry, bool) { return p.parseGeometry(srid, depth+1) })
+	}
+	return g, ok
+}
@@ -0,0 +1,590 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pingcap/tidb/parser/mysql"
+)
+
+// GeometryRelation is a spatial relation between two geometries.
+type GeometryRelation byte
+
+const (
+	// GeometryContains means no points of the second geometry lie in the exterior of the first one,
+	// and at least one point of the interior of the second geometry lies in the interior of the first one.
+	GeometryContains GeometryRelation = iota
+	// GeometryWithin means the first geometry is contained by the second one.
+	GeometryWithin
+	// GeometryIntersects means the two geometries have at least one point in common.
+	GeometryIntersects
+	// GeometryDisjoint means the two geometries have no points in common.
+	GeometryDisjoint
+	// GeometryEquals means the two geometries are spatially equal.
+	GeometryEquals
+)
+
+const (
+	// wgs84SemiMajorAxis and wgs84Flattening are the parameters of the WGS 84 ellipsoid.
+	wgs84SemiMajorAxis = 6378137.0
+	wgs84Flattening    = 1 / 298.257223563
+	// DefaultSphereRadius is the default radius of the sphere used by ST_Distance_Sphere, in meters.
+	DefaultSphereRadius = 6370986.0
+)
+
+// Locations of a point relative to a geometry.
+const (
+	geoOutside = iota
+	geoBoundary
+	geoInside
+)
+
+// geoElement is a point, a linestring or a polygon of a geometry.
+type geoElement struct {
+	// dim is 0 for a point, 1 for a linestring and 2 for a polygon.
+	dim    int
+	points []GeoPoint
+	rings  [][]GeoPoint
+}
+
+func (g *Geometry) elements(elems []geoElement) []geoElement {
+	switch g.Type {
+	case mysql.GeometryTypePoint:
+		return append(elems, geoElement{dim: 0, points: g.Points})
+	case mysql.GeometryTypeLineString:
+		return append(elems, geoElement{dim: 1, points: g.Points})
+	case mysql.GeometryTypePolygon:
+		rings := make([][]GeoPoint, 0, len(g.Geometries))
+		for _, ring := range g.Geometries {
+			rings = append(rings, ring.Points)
+		}
+		return append(elems, geoElement{dim: 2, rings: rings})
+	}
+	for i := range g.Geometries {
+		elems = g.Geometries[i].elements(elems)
+	}
+	return elems
+}
+
+// segments calls f with the segments of the element. A point is a degenerate segment.
+func (e *geoElement) segments(f func(a, b GeoPoint) bool) bool {
+	if e.dim == 0 {
+		return f(e.points[0], e.points[0])
+	}
+	lines := e.rings
+	if e.dim == 1 {
+		lines = [][]GeoPoint{e.points}
+	}
+	for _, line := range lines {
+		for i := 0; i+1 < len(line); i++ {
+			if f(line[i], line[i+1]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *Geometry) isPuntal() bool {
+	return g.Type == mysql.GeometryTypePoint || g.Type == mysql.GeometryTypeMultiPoint
+}
+
+func checkSameSRID(g1, g2 *Geometry, funcName string) error {
+	if g1.SRID != g2.SRID {
+		return ErrGISDifferentSRIDs.GenWithStackByArgs(funcName, g1.SRID, g2.SRID)
+	}
+	return nil
+}
+
+// GeometryRelate checks whether the spatial relation holds between the two geometries.
+// When a geometry consists of several elements, an element of the other geometry is regarded as
+// covered only if a single element covers it.
+func GeometryRelate(g1, g2 Geometry, rel GeometryRelation, funcName string) (bool, error) {
+	if err := checkSameSRID(&g1, &g2, funcName); err != nil {
+		return false, err
+	}
+	// The relations between the points are the same in all the spatial reference systems.
+	if IsGeographicSRID(g1.SRID) && !(g1.isPuntal() && g2.isPuntal()) {
+		return false, errGeographicNotSupported.GenWithStackByArgs(funcName + " on a geographic spatial reference system")
+	}
+	elems1, elems2 := g1.elements(nil), g2.elements(nil)
+	switch rel {
+	case GeometryContains:
+		return geoContains(elems1, elems2), nil
+	case GeometryWithin:
+		return geoContains(elems2, elems1), nil
+	case GeometryIntersects:
+		return geoIntersects(elems1, elems2), nil
+	case GeometryDisjoint:
+		return !geoIntersects(elems1, elems2), nil
+	case GeometryEquals:
+		return geoCoversAll(elems1, elems2) && geoCoversAll(elems2, elems1), nil
+	}
+	return false, nil
+}
+
+func geoIntersects(elems1, elems2 []geoElement) bool {
+	for i := range elems1 {
+		for j := range elems2 {
+			if elementsIntersect(&elems1[i], &elems2[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func geoContains(elems1, elems2 []geoElement) bool {
+	if len(elems2) == 0 {
+		return false
+	}
+	interior := false
+	for j := range elems2 {
+		covered := false
+		for i := range elems1 {
+			if c, in := elementCovers(&elems1[i], &elems2[j]); c {
+				covered, interior = true, interior || in
+				if interior {
+					break
+				}
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return interior
+}
+
+func geoCoversAll(elems1, elems2 []geoElement) bool {
+	for j := range elems2 {
+		covered := false
+		for i := range elems1 {
+			if covered, _ = elementCovers(&elems1[i], &elems2[j]); covered {
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func elementsIntersect(e1, e2 *geoElement) bool {
+	if e1.dim > e2.dim {
+		e1, e2 = e2, e1
+	}
+	switch {
+	case e1.dim == 0 && e2.dim == 0:
+		return e1.points[0] == e2.points[0]
+	case e1.dim == 0 && e2.dim == 1:
+		return lineLocation(e1.points[0], e2.points) != geoOutside
+	case e1.dim == 0:
+		return polygonLocation(e1.points[0], e2.rings) != geoOutside
+	case e1.dim == 1 && e2.dim == 1:
+		return segmentsOfIntersect(e1, e2)
+	case e1.dim == 1:
+		for _, p := range e1.points {
+			if polygonLocation(p, e2.rings) != geoOutside {
+				return true
+			}
+		}
+		return segmentsOfIntersect(e1, e2)
+	}
+	return segmentsOfIntersect(e1, e2) ||
+		polygonLocation(e1.rings[0][0], e2.rings) != geoOutside ||
+		polygonLocation(e2.rings[0][0], e1.rings) != geoOutside
+}
+
+func segmentsOfIntersect(e1, e2 *geoElement) bool {
+	return e1.segments(func(a, b GeoPoint) bool {
+		return e2.segments(func(c, d GeoPoint) bool {
+			return segmentsIntersect(a, b, c, d)
+		})
+	})
+}
+
+// elementCovers returns whether no points of e2 lie in the exterior of e1,
+// and whether the interiors of them intersect.
+func elementCovers(e1, e2 *geoElement) (covered bool, interior bool) {
+	if e1.dim < e2.dim {
+		return false, false
+	}
+	switch {
+	case e2.dim == 0:
+		var loc int
+		switch e1.dim {
+		case 0:
+			if e1.points[0] == e2.points[0] {
+				loc = geoInside
+			}
+		case 1:
+			loc = lineLocation(e2.points[0], e1.points)
+		default:
+			loc = polygonLocation(e2.points[0], e1.rings)
+		}
+		return loc != geoOutside, loc == geoInside
+	case e1.dim == 1:
+		for i := 0; i+1 < len(e2.points); i++ {
+			if !segmentCoveredByLine(e2.points[i], e2.points[i+1], e1.points) {
+				return false, false
+			}
+		}
+		return true, true
+	case e2.dim == 1:
+		return lineCoveredByPolygon(e2.points, e1.rings)
+	}
+	if covered, _ = lineCoveredByPolygon(e2.rings[0], e1.rings); !covered {
+		return false, false
+	}
+	// The holes of e1 mustn't lie in the interior of e2.
+	for _, hole := range e1.rings[1:] {
+		for i := 0; i+1 < len(hole); i++ {
+			mid := GeoPoint{X: (hole[i].X + hole[i+1].X) / 2, Y: (hole[i].Y + hole[i+1].Y) / 2}
+			if polygonLocation(hole[i], e2.rings) == geoInside || polygonLocation(mid, e2.rings) == geoInside {
+				return false, false
+			}
+		}
+	}
+	return true, true
+}
+
+// segmentCoveredByLine checks whether the segment pq lies on the linestring.
+func segmentCoveredByLine(p, q GeoPoint, line []GeoPoint) bool {
+	if p == q {
+		return lineLocation(p, line) != geoOutside
+	}
+	dx, dy := q.X-p.X, q.Y-p.Y
+	l2 := dx*dx + dy*dy
+	type interval struct{ start, end float64 }
+	intervals := make([]interval, 0, 4)
+	for i := 0; i+1 < len(line); i++ {
+		a, b := line[i], line[i+1]
+		if cross(p, q, a) != 0 || cross(p, q, b) != 0 {
+			continue
+		}
+		ta := ((a.X-p.X)*dx + (a.Y-p.Y)*dy) / l2
+		tb := ((b.X-p.X)*dx + (b.Y-p.Y)*dy) / l2
+		intervals = append(intervals, interval{math.Min(ta, tb), math.Max(ta, tb)})
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].start < intervals[j].start })
+	covered := 0.0
+	for _, in := range intervals {
+		if in.start > covered {
+			return false
+		}
+		covered = math.Max(covered, in.end)
+		if covered >= 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// lineCoveredByPolygon splits the linestring at its intersections with the rings of the polygon,
+// and checks the locations of the vertices and the midpoints of the pieces.
+func lineCoveredByPolygon(line []GeoPoint, rings [][]GeoPoint) (covered bool, interior bool) {
+	check := func(p GeoPoint) bool {
+		switch polygonLocation(p, rings) {
+		case geoOutside:
+			return false
+		case geoInside:
+			interior = true
+		}
+		return true
+	}
+	if len(line) == 1 {
+		return check(line[0]), interior
+	}
+	for i := 0; i+1 < len(line); i++ {
+		p, q := line[i], line[i+1]
+		ts := []float64{0, 1}
+		for _, ring := range rings {
+			for j := 0; j+1 < len(ring); j++ {
+				ts = append(ts, segmentIntersectionParams(p, q, ring[j], ring[j+1])...)
+			}
+		}
+		sort.Float64s(ts)
+		for k := 0; k < len(ts); k++ {
+			if !check(pointAt(p, q, ts[k])) {
+				return false, false
+			}
+			if k+1 < len(ts) && ts[k+1] > ts[k] && !check(pointAt(p, q, (ts[k]+ts[k+1])/2)) {
+				return false, false
+			}
+		}
+	}
+	return true, interior
+}
+
+func pointAt(p, q GeoPoint, t float64) GeoPoint {
+	return GeoPoint{X: p.X + (q.X-p.X)*t, Y: p.Y + (q.Y-p.Y)*t}
+}
+
+// segmentIntersectionParams returns the parameters on pq of the intersections of the segments pq and ab.
+func segmentIntersectionParams(p, q, a, b GeoPoint) []float64 {
+	if !segmentsIntersect(p, q, a, b) {
+		return nil
+	}
+	dx, dy := q.X-p.X, q.Y-p.Y
+	denom := dx*(b.Y-a.Y) - dy*(b.X-a.X)
+	if denom != 0 {
+		t := ((a.X-p.X)*(b.Y-a.Y) - (a.Y-p.Y)*(b.X-a.X)) / denom
+		return []float64{math.Max(0, math.Min(1, t))}
+	}
+	// The segments are collinear, the overlapping part is bounded by the projections of a and b.
+	l2 := dx*dx + dy*dy
+	if l2 == 0 {
+		return nil
+	}
+	ta := ((a.X-p.X)*dx + (a.Y-p.Y)*dy) / l2
+	tb := ((b.X-p.X)*dx + (b.Y-p.Y)*dy) / l2
+	return []float64{math.Max(0, math.Min(1, ta)), math.Max(0, math.Min(1, tb))}
+}
+
+func cross(o, a, b GeoPoint) float64 {
+	return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+}
+
+func onSegment(p, a, b GeoPoint) bool {
+	return cross(a, b, p) == 0 &&
+		math.Min(a.X, b.X) <= p.X && p.X <= math.Max(a.X, b.X) &&
+		math.Min(a.Y, b.Y) <= p.Y && p.Y <= math.Max(a.Y, b.Y)
+}
+
+func segmentsIntersect(a, b, c, d GeoPoint) bool {
+	d1, d2 := cross(c, d, a), cross(c, d, b)
+	d3, d4 := cross(a, b, c), cross(a, b, d)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return onSegment(a, c, d) || onSegment(b, c, d) || onSegment(c, a, b) || onSegment(d, a, b)
+}
+
+// lineLocation returns the location of the point relative to the linestring,
+// whose boundary is its endpoints unless it's closed.
+func lineLocation(p GeoPoint, line []GeoPoint) int {
+	n := len(line)
+	if line[0] != line[n-1] && (p == line[0] || p == line[n-1]) {
+		return geoBoundary
+	}
+	for i := 0; i+1 < n; i++ {
+		if onSegment(p, line[i], line[i+1]) {
+			return geoInside
+		}
+	}
+	return geoOutside
+}
+
+func ringLocation(p GeoPoint, ring []GeoPoint) int {
+	inside := false
+	for i := 0; i+1 < len(ring); i++ {
+		a, b := ring[i], ring[i+1]
+		if onSegment(p, a, b) {
+			return geoBoundary
+		}
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < a.X+(p.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y) {
+			inside = !inside
+		}
+	}
+	if inside {
+		return geoInside
+	}
+	return geoOutside
+}
+
+func polygonLocation(p GeoPoint, rings [][]GeoPoint) int {
+	loc := ringLocation(p, rings[0])
+	if loc != geoInside {
+		return loc
+	}
+	for _, hole := range rings[1:] {
+		switch ringLocation(p, hole) {
+		case geoInside:
+			return geoOutside
+		case geoBoundary:
+			return geoBoundary
+		}
+	}
+	return geoInside
+}
+
+func pointSegmentDistance(p, a, b GeoPoint) float64 {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	l2 := dx*dx + dy*dy
+	if l2 == 0 {
+		return math.Hypot(p.X-a.X, p.Y-a.Y)
+	}
+	t := math.Max(0, math.Min(1, ((p.X-a.X)*dx+(p.Y-a.Y)*dy)/l2))
+	return math.Hypot(p.X-(a.X+t*dx), p.Y-(a.Y+t*dy))
+}
+
+func segmentDistance(a, b, c, d GeoPoint) float64 {
+	if segmentsIntersect(a, b, c, d) {
+		return 0
+	}
+	return math.Min(math.Min(pointSegmentDistance(a, c, d), pointSegmentDistance(b, c, d)),
+		math.Min(pointSegmentDistance(c, a, b), pointSegmentDistance(d, a, b)))
+}
+
+// GeometryDistance returns the distance between the two geometries. The distance on a geographic
+// spatial reference system is measured in meters on its ellipsoid, which is only supported for the points.
+func GeometryDistance(g1, g2 Geometry, funcName string) (float64, error) {
+	if err := checkSameSRID(&g1, &g2, funcName); err != nil {
+		return 0, err
+	}
+	if g1.IsEmpty() || g2.IsEmpty() {
+		return 0, ErrGISInvalidData.GenWithStackByArgs(funcName)
+	}
+	elems1, elems2 := g1.elements(nil), g2.elements(nil)
+	if IsGeographicSRID(g1.SRID) {
+		if !g1.isPuntal() || !g2.isPuntal() {
+			return 0, errGeographicNotSupported.GenWithStackByArgs(funcName + " on a geographic spatial reference system")
+		}
+		return minPointsDistance(elems1, elems2, andoyerDistance), nil
+	}
+	if geoIntersects(elems1, elems2) {
+		return 0, nil
+	}
+	dist := math.Inf(1)
+	for i := range elems1 {
+		for j := range elems2 {
+			elems1[i].segments(func(a, b GeoPoint) bool {
+				return elems2[j].segments(func(c, d GeoPoint) bool {
+					dist = math.Min(dist, segmentDistance(a, b, c, d))
+					return false
+				})
+			})
+		}
+	}
+	return dist, nil
+}
+
+// GeometryDistanceSphere returns the distance in meters between the points or the multipoints on a sphere.
+// The coordinates are regarded as the longitudes and the latitudes in degrees.
+func GeometryDistanceSphere(g1, g2 Geometry, radius float64, funcName string) (float64, error) {
+	if err := checkSameSRID(&g1, &g2, funcName); err != nil {
+		return 0, err
+	}
+	if !g1.isPuntal() || !g2.isPuntal() {
+		return 0, ErrGISUnsupportedArgument.GenWithStackByArgs(funcName)
+	}
+	if radius <= 0 {
+		return 0, ErrNonpositiveRadius.GenWithStackByArgs(funcName)
+	}
+	elems1, elems2 := g1.elements(nil), g2.elements(nil)
+	for _, elems := range [][]geoElement{elems1, elems2} {
+		for _, e := range elems {
+			if err := checkGeographicPoint(e.points[0], funcName); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return minPointsDistance(elems1, elems2, func(p1, p2 GeoPoint) float64 {
+		return haversineDistance(p1, p2, radius)
+	}), nil
+}
+
+func minPointsDistance(elems1, elems2 []geoElement, distance func(p1, p2 GeoPoint) float64) float64 {
+	dist := math.Inf(1)
+	for _, e1 := range elems1 {
+		for _, e2 := range elems2 {
+			dist = math.Min(dist, distance(e1.points[0], e2.points[0]))
+		}
+	}
+	return dist
+}
+
+func haversineDistance(p1, p2 GeoPoint, radius float64) float64 {
+	lat1, lat2 := p1.Y*math.Pi/180, p2.Y*math.Pi/180
+	sinDLat := math.Sin((lat2 - lat1) / 2)
+	sinDLon := math.Sin((p2.X - p1.X) * math.Pi / 180 / 2)
+	h := sinDLat*sinDLat + math.Cos(lat1)*math.Cos(lat2)*sinDLon*sinDLon
+	return 2 * radius * math.Asin(math.Sqrt(math.Min(1, h)))
+}
+
+// andoyerDistance returns the distance in meters between the points on the WGS 84 ellipsoid,
+// using the Andoyer-Lambert formula as MySQL does.
+func andoyerDistance(p1, p2 GeoPoint) float64 {
+	if p1 == p2 {
+		return 0
+	}
+	lon1, lat1 := p1.X*math.Pi/180, p1.Y*math.Pi/180
+	lon2, lat2 := p2.X*math.Pi/180, p2.Y*math.Pi/180
+	sinLat1, cosLat1 := math.Sincos(lat1)
+	sinLat2, cosLat2 := math.Sincos(lat2)
+	cosD := sinLat1*sinLat2 + cosLat1*cosLat2*math.Cos(lon2-lon1)
+	cosD = math.Max(-1, math.Min(1, cosD))
+	d := math.Acos(cosD)
+	sinD := math.Sin(d)
+	k := (sinLat1 - sinLat2) * (sinLat1 - sinLat2)
+	l := (sinLat1 + sinLat2) * (sinLat1 + sinLat2)
+	var h, g float64
+	if 1-cosD != 0 {
+		h = (d + 3*sinD) / (1 - cosD)
+	}
+	if 1+cosD != 0 {
+		g = (d - 3*sinD) / (1 + cosD)
+	}
+	dd := -wgs84Flattening / 4 * (h*k + g*l)
+	return wgs84SemiMajorAxis * (d + dd)
+}
+
+// Area returns the area of a polygon or a multipolygon.
+func (g Geometry) Area(funcName string) (float64, error) {
+	if g.Type != mysql.GeometryTypePolygon && g.Type != mysql.GeometryTypeMultiPolygon {
+		return 0, ErrGISUnsupportedArgument.GenWithStackByArgs(funcName)
+	}
+	if IsGeographicSRID(g.SRID) {
+		return 0, errGeographicNotSupported.GenWithStackByArgs(funcName + " on a geographic spatial reference system")
+	}
+	area := 0.0
+	for _, e := range g.elements(nil) {
+		for i, ring := range e.rings {
+			a := 0.0
+			for j := 0; j+1 < len(ring); j++ {
+				a += ring[j].X*ring[j+1].Y - ring[j+1].X*ring[j].Y
+			}
+			if i == 0 {
+				area += math.Abs(a) / 2
+			} else {
+				area -= math.Abs(a) / 2
+			}
+		}
+	}
+	return area, nil
+}
+
+// Length returns the length of a linestring or a multilinestring. The length on a geographic
+// spatial reference system is measured in meters on its ellipsoid.
+func (g Geometry) Length(funcName string) (float64, error) {
+	if g.Type != mysql.GeometryTypeLineString && g.Type != mysql.GeometryTypeMultiLineString {
+		return 0, ErrGISUnsupportedArgument.GenWithStackByArgs(funcName)
+	}
+	distance := func(p1, p2 GeoPoint) float64 { return math.Hypot(p2.X-p1.X, p2.Y-p1.Y) }
+	if IsGeographicSRID(g.SRID) {
+		distance = andoyerDistance
+	}
+	length := 0.0
+	for _, e := range g.elements(nil) {
+		for i := 0; i+1 < len(e.points); i++ {
+			length += distance(e.points[i], e.points[i+1])
+		}
+	}
+	return length, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseWKT(t *testing.T, wkt string, srid uint32) Geometry {
+	g, err := ParseGeometryFromWKT(wkt, srid, "st_geomfromtext")
+	require.NoError(t, err, wkt)
+	return g
+}
+
+func TestGeometryRelate(t *testing.T) {
+	const square = "POLYGON((0 0,4 0,4 4,0 4,0 0))"
+	const squareWithHole = "POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,3 1,3 3,1 3,1 1))"
+	tests := []struct {
+		g1, g2     string
+		contains   bool
+		within     bool
+		intersects bool
+		equals     bool
+	}{
+		{"POINT(1 1)", "POINT(1 1)", true, true, true, true},
+		{"POINT(1 1)", "POINT(1 2)", false, false, false, false},
+		{square, "POINT(2 2)", true, false, true, false},
+		{square, "POINT(0 2)", false, false, true, false},
+		{square, "POINT(5 5)", false, false, false, false},
+		{squareWithHole, "POINT(2 2)", false, false, false, false},
+		{squareWithHole, "POINT(0.5 0.5)", true, false, true, false},
+		{square, "LINESTRING(1 1,3 3)", true, false, true, false},
+		{square, "LINESTRING(1 1,5 5)", false, false, true, false},
+		{squareWithHole, "LINESTRING(0.5 0.5,3.5 3.5)", false, false, true, false},
+		{square, "POLYGON((1 1,2 1,2 2,1 2,1 1))", true, false, true, false},
+		{square, "POLYGON((5 5,6 5,6 6,5 5))", false, false, false, false},
+		{square, "POLYGON((0 4,4 0,4 4,0 4))", true, false, true, false},
+		{square, "POLYGON((0 0,0 4,4 4,4 0,0 0))", true, true, true, true},
+		{"LINESTRING(0 0,4 4)", "LINESTRING(0 4,4 0)", false, false, true, false},
+		{"LINESTRING(0 0,4 4)", "LINESTRING(1 1,2 2)", true, false, true, false},
+		{"LINESTRING(0 0,4 4)", "POINT(0 0)", false, false, true, false},
+		{"MULTIPOINT(1 1,2 2)", "POINT(2 2)", true, false, true, false},
+		{"GEOMETRYCOLLECTION(POINT(9 9),POLYGON((0 0,4 0,4 4,0 4,0 0)))", "POINT(2 2)", true, false, true, false},
+	}
+	for _, tt := range tests {
+		g1, g2 := mustParseWKT(t, tt.g1, 0), mustParseWKT(t, tt.g2, 0)
+		for rel, expected := range map[GeometryRelation]bool{
+			GeometryContains:   tt.contains,
+			GeometryWithin:     tt.within,
+			GeometryIntersects: tt.intersects,
+			GeometryDisjoint:   !tt.intersects,
+			GeometryEquals:     tt.equals,
+		} {
+			res, err := GeometryRelate(g1, g2, rel, "st_relate")
+			require.NoError(t, err)
+			require.Equal(t, expected, res, "%s %s %d", tt.g1, tt.g2, rel)
+		}
+		// Within is the converse of contains.
+		res, err := GeometryRelate(g2, g1, GeometryWithin, "st_within")
+		require.NoError(t, err)
+		require.Equal(t, tt.contains, res, "%s %s", tt.g1, tt.g2)
+	}
+
+	_, err := GeometryRelate(mustParseWKT(t, "POINT(1 1)", 0), mustParseWKT(t, "POINT(1 1)", GeometrySRIDWGS84), GeometryEquals, "st_equals")
+	require.True(t, ErrGISDifferentSRIDs.Equal(err))
+	res, err := GeometryRelate(mustParseWKT(t, "POINT(1 1)", GeometrySRIDWGS84), mustParseWKT(t, "POINT(1 1)", GeometrySRIDWGS84), GeometryEquals, "st_equals")
+	require.NoError(t, err)
+	require.True(t, res)
+	_, err = GeometryRelate(mustParseWKT(t, square, GeometrySRIDWGS84), mustParseWKT(t, "POINT(1 1)", GeometrySRIDWGS84), GeometryContains, "st_contains")
+	require.True(t, errGeographicNotSupported.Equal(err))
+}
+
+func TestGeometryDistance(t *testing.T) {
+	tests := []struct {
+		g1, g2   string
+		distance float64
+	}{
+		{"POINT(0 0)", "POINT(3 4)", 5},
+		{"POINT(0 5)", "LINESTRING(-1 0,1 0)", 5},
+		{"POINT(2 2)", "POLYGON((0 0,4 0,4 4,0 4,0 0))", 0},
+		{"POINT(6 2)", "POLYGON((0 0,4 0,4 4,0 4,0 0))", 2},
+		{"LINESTRING(0 0,4 4)", "LINESTRING(0 4,4 0)", 0},
+		{"LINESTRING(0 0,1 0)", "LINESTRING(0 2,1 2)", 2},
+		{"MULTIPOINT(10 10,1 0)", "POINT(0 0)", 1},
+	}
+	for _, tt := range tests {
+		d, err := GeometryDistance(mustParseWKT(t, tt.g1, 0), mustParseWKT(t, tt.g2, 0), "st_distance")
+		require.NoError(t, err)
+		require.InDelta(t, tt.distance, d, 1e-9, "%s %s", tt.g1, tt.g2)
+	}
+
+	// One degree of longitude on the equator of WGS 84.
+	d, err := GeometryDistance(mustParseWKT(t, "POINT(0 0)", GeometrySRIDWGS84), mustParseWKT(t, "POINT(0 1)", GeometrySRIDWGS84), "st_distance")
+	require.NoError(t, err)
+	require.InDelta(t, 111319.49, d, 1)
+
+	d, err = GeometryDistanceSphere(NewGeometryPoint(0, 0, 0), NewGeometryPoint(0, 0, 90), 1, "st_distance_sphere")
+	require.NoError(t, err)
+	require.InDelta(t, 1.5707963267948966, d, 1e-12)
+	// Half of the circumference of the default sphere.
+	d, err = GeometryDistanceSphere(NewGeometryPoint(0, 0, 0), NewGeometryPoint(0, 180, 0), DefaultSphereRadius, "st_distance_sphere")
+	require.NoError(t, err)
+	require.InDelta(t, 20015042.813723423, d, 1e-6)
+	_, err = GeometryDistanceSphere(NewGeometryPoint(0, 0, 0), NewGeometryPoint(0, 0, 90), 0, "st_distance_sphere")
+	require.True(t, ErrNonpositiveRadius.Equal(err))
+	_, err = GeometryDistanceSphere(NewGeometryPoint(0, 200, 0), NewGeometryPoint(0, 0, 90), 1, "st_distance_sphere")
+	require.True(t, ErrLongitudeOutOfRange.Equal(err))
+	_, err = GeometryDistanceSphere(mustParseWKT(t, "LINESTRING(0 0,1 1)", 0), NewGeometryPoint(0, 0, 90), 1, "st_distance_sphere")
+	require.True(t, ErrGISUnsupportedArgument.Equal(err))
+}
+
+func TestGeometryMeasure(t *testing.T) {
+	area, err := mustParseWKT(t, "POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 2,1 1))", 0).Area("st_area")
+	require.NoError(t, err)
+	require.Equal(t, 15.0, area)
+	area, err = mustParseWKT(t, "MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((0 0,2 0,2 2,0 0)))", 0).Area("st_area")
+	require.NoError(t, err)
+	require.Equal(t, 2.5, area)
+	_, err = mustParseWKT(t, "POINT(0 0)", 0).Area("st_area")
+	require.True(t, ErrGISUnsupportedArgument.Equal(err))
+
+	length, err := mustParseWKT(t, "LINESTRING(0 0,3 4,3 5)", 0).Length("st_length")
+	require.NoError(t, err)
+	require.Equal(t, 6.0, length)
+	length, err = mustParseWKT(t, "MULTILINESTRING((0 0,1 0),(0 0,0 2))", 0).Length("st_length")
+	require.NoError(t, err)
+	require.Equal(t, 3.0, length)
+	_, err = mustParseWKT(t, "POINT(0 0)", 0).Length("st_length")
+	require.True(t, ErrGISUnsupportedArgument.Equal(err))
+}
@@ -0,0 +1,144 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGeometryFromWKT(t *testing.T) {
+	tests := []struct {
+		wkt string
+		tp  byte
+		out string
+	}{
+		{"POINT(1 2)", mysql.GeometryTypePoint, "POINT(1 2)"},
+		{" point ( -1.5   2e3 ) ", mysql.GeometryTypePoint, "POINT(-1.5 2000)"},
+		{"LINESTRING(0 0,1 1,2 0)", mysql.GeometryTypeLineString, "LINESTRING(0 0,1 1,2 0)"},
+		{"POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))", mysql.GeometryTypePolygon, "POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))"},
+		{"MULTIPOINT(1 1,2 2)", mysql.GeometryTypeMultiPoint, "MULTIPOINT((1 1),(2 2))"},
+		{"MULTIPOINT((1 1),(2 2))", mysql.GeometryTypeMultiPoint, "MULTIPOINT((1 1),(2 2))"},
+		{"MULTILINESTRING((0 0,1 1),(2 2,3 3))", mysql.GeometryTypeMultiLineString, "MULTILINESTRING((0 0,1 1),(2 2,3 3))"},
+		{"MULTIPOLYGON(((0 0,1 0,1 1,0 0)))", mysql.GeometryTypeMultiPolygon, "MULTIPOLYGON(((0 0,1 0,1 1,0 0)))"},
+		{"GEOMETRYCOLLECTION(POINT(1 1),LINESTRING(0 0,1 1))", mysql.GeometryTypeGeometryCollection, "GEOMETRYCOLLECTION(POINT(1 1),LINESTRING(0 0,1 1))"},
+		{"GEOMCOLLECTION()", mysql.GeometryTypeGeometryCollection, "GEOMETRYCOLLECTION EMPTY"},
+		{"GEOMETRYCOLLECTION EMPTY", mysql.GeometryTypeGeometryCollection, "GEOMETRYCOLLECTION EMPTY"},
+	}
+	for _, tt := range tests {
+		g, err := ParseGeometryFromWKT(tt.wkt, GeometrySRIDCartesian, "st_geomfromtext")
+		require.NoError(t, err, tt.wkt)
+		require.Equal(t, tt.tp, g.Type, tt.wkt)
+		require.Equal(t, tt.out, g.String(), tt.wkt)
+
+		// The internal format and WKB can both be decoded back.
+		decoded, err := ParseGeometry(g.Encode(), "st_astext")
+		require.NoError(t, err, tt.wkt)
+		require.Equal(t, tt.out, decoded.String(), tt.wkt)
+		decoded, err = ParseGeometryFromWKB(g.WKB(), GeometrySRIDCartesian, "st_geomfromwkb")
+		require.NoError(t, err, tt.wkt)
+		require.Equal(t, tt.out, decoded.String(), tt.wkt)
+	}
+
+	g, err := ParseGeometryFromWKT("LINESTRING(0 0,1 1,2 0)", GeometrySRIDCartesian, "st_linefromtext")
+	require.NoError(t, err)
+	require.Equal(t, 3, g.NumPoints())
+	require.False(t, g.IsEmpty())
+	g, err = ParseGeometryFromWKT("GEOMETRYCOLLECTION EMPTY", GeometrySRIDCartesian, "st_geomfromtext")
+	require.NoError(t, err)
+	require.True(t, g.IsEmpty())
+
+	invalid := []string{
+		"",
+		"POINT(1)",
+		"POINT(1 2",
+		"POINT(1 2) x",
+		"LINESTRING(0 0)",
+		"POLYGON((0 0,1 1,0 0))",
+		"POLYGON((0 0,1 0,1 1,0 1))",
+		"CIRCLE(0 0,1)",
+		"GEOMETRYCOLLECTION(POINT(1 1),)",
+	}
+	for _, wkt := range invalid {
+		_, err := ParseGeometryFromWKT(wkt, GeometrySRIDCartesian, "st_geomfromtext")
+		require.True(t, ErrGISInvalidData.Equal(err), wkt)
+	}
+
+	_, err = ParseGeometryFromWKT("POINT(1 2)", 1234, "st_geomfromtext")
+	require.True(t, ErrSRSNotFound.Equal(err))
+}
+
+func TestParseGeographicGeometry(t *testing.T) {
+	// The WKT of SRID 4326 is in latitude-longitude order.
+	g, err := ParseGeometryFromWKT("POINT(30 120)", GeometrySRIDWGS84, "st_geomfromtext")
+	require.NoError(t, err)
+	require.Equal(t, GeometrySRIDWGS84, g.SRID)
+	require.Equal(t, "POINT(30 120)", g.String())
+	require.Equal(t, 30.0, g.X())
+	require.Equal(t, 30.0, g.Points[0].Y)
+	require.Equal(t, 120.0, g.Points[0].X)
+
+	decoded, err := ParseGeometry(g.Encode(), "st_astext")
+	require.NoError(t, err)
+	require.Equal(t, g, decoded)
+	decoded, err = ParseGeometryFromWKB(g.WKB(), GeometrySRIDWGS84, "st_geomfromwkb")
+	require.NoError(t, err)
+	require.Equal(t, g, decoded)
+
+	_, err = ParseGeometryFromWKT("POINT(91 0)", GeometrySRIDWGS84, "st_geomfromtext")
+	require.True(t, ErrLatitudeOutOfRange.Equal(err))
+	_, err = ParseGeometryFromWKT("POINT(0 -180)", GeometrySRIDWGS84, "st_geomfromtext")
+	require.True(t, ErrLongitudeOutOfRange.Equal(err))
+}
+
+func TestParseGeometryFromWKB(t *testing.T) {
+	// POINT(1 -1) in big-endian WKB.
+	wkb, err := hex.DecodeString("00000000013FF0000000000000BFF0000000000000")
+	require.NoError(t, err)
+	g, err := ParseGeometryFromWKB(wkb, GeometrySRIDCartesian, "st_geomfromwkb")
+	require.NoError(t, err)
+	require.Equal(t, "POINT(1 -1)", g.String())
+	// The result is always little-endian.
+	require.Equal(t, "0101000000000000000000F03F000000000000F0BF", strings.ToUpper(hex.EncodeToString(g.WKB())))
+	require.Equal(t, "00000000"+"0101000000000000000000F03F000000000000F0BF", strings.ToUpper(hex.EncodeToString(g.Encode())))
+
+	for _, data := range []string{"", "01", "0101000000000000000000F03F", "0109000000000000000000F03F000000000000F0BF", "0101000000000000000000F03F000000000000F0BF00"} {
+		wkb, err := hex.DecodeString(data)
+		require.NoError(t, err)
+		_, err = ParseGeometryFromWKB(wkb, GeometrySRIDCartesian, "st_geomfromwkb")
+		require.True(t, ErrGISInvalidData.Equal(err), data)
+	}
+	_, err = ParseGeometry([]byte{0, 0}, "st_astext")
+	require.True(t, ErrGISInvalidData.Equal(err))
+}
+
+func TestGeometryCoordinateFormat(t *testing.T) {
+	tests := []struct {
+		x, y float64
+		out  string
+	}{
+		{0, 0, "POINT(0 0)"},
+		{1.25, -3, "POINT(1.25 -3)"},
+		{0.1, 100000, "POINT(0.1 100000)"},
+		{1e20, 1e-7, "POINT(1e20 1e-7)"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.out, NewGeometryPoint(GeometrySRIDCartesian, tt.x, tt.y).String())
+	}
+}
@@ -240,6 +240,10 @@ var (
 	ErrInvalidJSONPathArrayCell = dbterror.ClassJSON.NewStd(mysql.ErrInvalidJSONPathArrayCell)
 	// ErrUnsupportedSecondArgumentType means unsupported second argument type in json_objectagg
 	ErrUnsupportedSecondArgumentType = dbterror.ClassJSON.NewStd(mysql.ErrUnsupportedSecondArgumentType)
+	// ErrInvalidJSONType means the JSON value is not of the type required by a function.
+	ErrInvalidJSONType = dbterror.ClassJSON.NewStd(mysql.ErrInvalidJSONType)
+	// ErrJSONSchemaNotSupported means the JSON Schema uses a feature which is not supported.
+	ErrJSONSchemaNotSupported = dbterror.ClassJSON.NewStd(mysql.ErrNotSupportedYet)
 )
 
 // json_contains_path function type choices
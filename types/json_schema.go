@@ -0,0 +1,656 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// JSON Schema type names.
+const (
+	jsonSchemaTypeArray   = "array"
+	jsonSchemaTypeBoolean = "boolean"
+	jsonSchemaTypeInteger = "integer"
+	jsonSchemaTypeNull    = "null"
+	jsonSchemaTypeNumber  = "number"
+	jsonSchemaTypeObject  = "object"
+	jsonSchemaTypeString  = "string"
+)
+
+// JSONSchema is a compiled JSON Schema, which supports the keywords of JSON Schema draft 4.
+// Like MySQL, the unknown keywords and the keywords with values of unexpected types are ignored.
+type JSONSchema struct {
+	root *jsonSchemaNode
+}
+
+// JSONSchemaValidationError describes the first place where a JSON document fails the validation of a JSON Schema.
+type JSONSchemaValidationError struct {
+	// SchemaLocation is the JSON pointer to the subschema that the document fails.
+	SchemaLocation string
+	// DocumentLocation is the JSON pointer to the part of the document that fails the subschema.
+	DocumentLocation string
+	// Keyword is the keyword of the subschema that the document fails.
+	Keyword string
+}
+
+// Reason returns the human-readable reason of the validation failure.
+func (e *JSONSchemaValidationError) Reason() string {
+	return fmt.Sprintf("The JSON document location '%s' failed requirement '%s' at JSON Schema location '%s'",
+		e.DocumentLocation, e.Keyword, e.SchemaLocation)
+}
+
+type jsonSchemaPatternNode struct {
+	pattern *regexp.Regexp
+	node    *jsonSchemaNode
+}
+
+// jsonSchemaNode is a compiled (sub)schema. `location` is the JSON pointer to it in the root schema.
+type jsonSchemaNode struct {
+	location string
+	ref      *jsonSchemaNode
+
+	types []string
+	enum  []BinaryJSON
+
+	// for numbers
+	multipleOf       float64
+	maximum          *float64
+	minimum          *float64
+	exclusiveMaximum bool
+	exclusiveMinimum bool
+
+	// for strings, maxLength is -1 if it's unspecified
+	maxLength int
+	minLength int
+	pattern   *regexp.Regexp
+
+	// for arrays, maxItems is -1 if it's unspecified
+	items             *jsonSchemaNode
+	itemsList         []*jsonSchemaNode
+	additionalItems   *jsonSchemaNode
+	noAdditionalItems bool
+	maxItems          int
+	minItems          int
+	uniqueItems       bool
+
+	// for objects, maxProperties is -1 if it's unspecified
+	maxProperties          int
+	minProperties          int
+	required               []string
+	properties             map[string]*jsonSchemaNode
+	patternProperties      []jsonSchemaPatternNode
+	additionalProperties   *jsonSchemaNode
+	noAdditionalProperties bool
+	propertyDependencies   map[string][]string
+	schemaDependencies     map[string]*jsonSchemaNode
+
+	allOf []*jsonSchemaNode
+	anyOf []*jsonSchemaNode
+	oneOf []*jsonSchemaNode
+	not   *jsonSchemaNode
+}
+
+type jsonSchemaCompiler struct {
+	root  BinaryJSON
+	nodes map[string]*jsonSchemaNode
+}
+
+// ParseJSONSchema compiles a JSON Schema, the schema must be a JSON object.
+func ParseJSONSchema(schema BinaryJSON) (*JSONSchema, error) {
+	c := &jsonSchemaCompiler{root: schema, nodes: make(map[string]*jsonSchemaNode)}
+	root, err := c.compile(schema, "#")
+	if err != nil {
+		return nil, err
+	}
+	// Resolve the chains of references, the references in a cycle are ignored.
+	for _, n := range c.nodes {
+		target := n.ref
+		for steps := 0; target != nil && target.ref != nil; steps++ {
+			if steps > len(c.nodes) {
+				target = nil
+				break
+			}
+			target = target.ref
+		}
+		n.ref = target
+	}
+	return &JSONSchema{root: root}, nil
+}
+
+// Validate validates the JSON document against the schema, nil is returned if the document is valid.
+func (s *JSONSchema) Validate(doc BinaryJSON) *JSONSchemaValidationError {
+	return s.root.validate(doc, "#")
+}
+
+func (c *jsonSchemaCompiler) compile(schema BinaryJSON, location string) (*jsonSchemaNode, error) {
+	if n, ok := c.nodes[location]; ok {
+		return n, nil
+	}
+	n := &jsonSchemaNode{location: location, maxLength: -1, maxItems: -1, maxProperties: -1}
+	c.nodes[location] = n
+	if schema.TypeCode != JSONTypeCodeObject {
+		return n, nil
+	}
+
+	// All the other keywords are ignored if there is a reference.
+	if ref, ok := schema.objectSearchKey([]byte("$ref")); ok && ref.TypeCode == JSONTypeCodeString {
+		return n, c.compileRef(n, string(ref.GetString()))
+	}
+
+	var err error
+	for i := 0; i < schema.GetElemCount() && err == nil; i++ {
+		key, val := string(schema.objectGetKey(i)), schema.objectGetVal(i)
+		child := location + "/" + escapeJSONPointerToken(key)
+		switch key {
+		case "type":
+			n.types = jsonSchemaStrings(val)
+		case "enum":
+			if val.TypeCode == JSONTypeCodeArray {
+				for j := 0; j < val.GetElemCount(); j++ {
+					n.enum = append(n.enum, val.ArrayGetElem(j))
+				}
+			}
+		case "multipleOf":
+			if v, ok := jsonSchemaNumber(val); ok && v > 0 {
+				n.multipleOf = v
+			}
+		case "maximum":
+			if v, ok := jsonSchemaNumber(val); ok {
+				n.maximum = &v
+			}
+		case "minimum":
+			if v, ok := jsonSchemaNumber(val); ok {
+				n.minimum = &v
+			}
+		case "exclusiveMaximum":
+			n.exclusiveMaximum = jsonSchemaBool(val)
+		case "exclusiveMinimum":
+			n.exclusiveMinimum = jsonSchemaBool(val)
+		case "maxLength":
+			if v, ok := jsonSchemaCount(val); ok {
+				n.maxLength = v
+			}
+		case "minLength":
+			if v, ok := jsonSchemaCount(val); ok {
+				n.minLength = v
+			}
+		case "pattern":
+			if val.TypeCode == JSONTypeCodeString {
+				n.pattern, _ = regexp.Compile(string(val.GetString()))
+			}
+		case "items":
+			if val.TypeCode == JSONTypeCodeArray {
+				n.itemsList, err = c.compileList(val, child)
+			} else {
+				n.items, err = c.compile(val, child)
+			}
+		case "additionalItems":
+			if val.TypeCode == JSONTypeCodeLiteral {
+				n.noAdditionalItems = !jsonSchemaBool(val)
+			} else {
+				n.additionalItems, err = c.compile(val, child)
+			}
+		case "maxItems":
+			if v, ok := jsonSchemaCount(val); ok {
+				n.maxItems = v
+			}
+		case "minItems":
+			if v, ok := jsonSchemaCount(val); ok {
+				n.minItems = v
+			}
+		case "uniqueItems":
+			n.uniqueItems = jsonSchemaBool(val)
+		case "maxProperties":
+			if v, ok := jsonSchemaCount(val); ok {
+				n.maxProperties = v
+			}
+		case "minProperties":
+			if v, ok := jsonSchemaCount(val); ok {
+				n.minProperties = v
+			}
+		case "required":
+			n.required = jsonSchemaStrings(val)
+		case "properties":
+			if val.TypeCode != JSONTypeCodeObject {
+				continue
+			}
+			n.properties = make(map[string]*jsonSchemaNode, val.GetElemCount())
+			for j := 0; j < val.GetElemCount() && err == nil; j++ {
+				name := string(val.objectGetKey(j))
+				n.properties[name], err = c.compile(val.objectGetVal(j), child+"/"+escapeJSONPointerToken(name))
+			}
+		case "patternProperties":
+			if val.TypeCode != JSONTypeCodeObject {
+				continue
+			}
+			for j := 0; j < val.GetElemCount() && err == nil; j++ {
+				pattern := string(val.objectGetKey(j))
+				re, reErr := regexp.Compile(pattern)
+				if reErr != nil {
+					continue
+				}
+				var node *jsonSchemaNode
+				node, err = c.compile(val.objectGetVal(j), child+"/"+escapeJSONPointerToken(pattern))
+				n.patternProperties = append(n.patternProperties, jsonSchemaPatternNode{pattern: re, node: node})
+			}
+		case "additionalProperties":
+			if val.TypeCode == JSONTypeCodeLiteral {
+				n.noAdditionalProperties = !jsonSchemaBool(val)
+			} else {
+				n.additionalProperties, err = c.compile(val, child)
+			}
+		case "dependencies":
+			if val.TypeCode != JSONTypeCodeObject {
+				continue
+			}
+			for j := 0; j < val.GetElemCount() && err == nil; j++ {
+				name, dep := string(val.objectGetKey(j)), val.objectGetVal(j)
+				if dep.TypeCode == JSONTypeCodeArray {
+					if n.propertyDependencies == nil {
+						n.propertyDependencies = make(map[string][]string)
+					}
+					n.propertyDependencies[name] = jsonSchemaStrings(dep)
+					continue
+				}
+				if n.schemaDependencies == nil {
+					n.schemaDependencies = make(map[string]*jsonSchemaNode)
+				}
+				n.schemaDependencies[name], err = c.compile(dep, child+"/"+escapeJSONPointerToken(name))
+			}
+		case "allOf":
+			n.allOf, err = c.compileList(val, child)
+		case "anyOf":
+			n.anyOf, err = c.compileList(val, child)
+		case "oneOf":
+			n.oneOf, err = c.compileList(val, child)
+		case "not":
+			n.not, err = c.compile(val, child)
+		}
+	}
+	return n, err
+}
+
+func (c *jsonSchemaCompiler) compileList(schemas BinaryJSON, location string) ([]*jsonSchemaNode, error) {
+	if schemas.TypeCode != JSONTypeCodeArray {
+		return nil, nil
+	}
+	nodes := make([]*jsonSchemaNode, 0, schemas.GetElemCount())
+	for i := 0; i < schemas.GetElemCount(); i++ {
+		node, err := c.compile(schemas.ArrayGetElem(i), location+"/"+strconv.Itoa(i))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// compileRef resolves a reference in the schema. Only the references to the subschemas in the same schema
+// are supported, and the references which can't be resolved are ignored.
+func (c *jsonSchemaCompiler) compileRef(n *jsonSchemaNode, ref string) error {
+	if !strings.HasPrefix(ref, "#") {
+		return ErrJSONSchemaNotSupported.GenWithStackByArgs("references in JSON Schema")
+	}
+	pointer := strings.TrimPrefix(ref, "#")
+	if pointer != "" && !strings.HasPrefix(pointer, "/") {
+		return nil
+	}
+
+	target, location := c.root, "#"
+	if pointer != "" {
+		for _, token := range strings.Split(pointer[1:], "/") {
+			token = unescapeJSONPointerToken(token)
+			location += "/" + escapeJSONPointerToken(token)
+			switch target.TypeCode {
+			case JSONTypeCodeObject:
+				val, ok := target.objectSearchKey([]byte(token))
+				if !ok {
+					return nil
+				}
+				target = val
+			case JSONTypeCodeArray:
+				idx, err := strconv.Atoi(token)
+				if err != nil || idx < 0 || idx >= target.GetElemCount() {
+					return nil
+				}
+				target = target.ArrayGetElem(idx)
+			default:
+				return nil
+			}
+		}
+	}
+
+	node, err := c.compile(target, location)
+	if err != nil {
+		return err
+	}
+	if node != n {
+		n.ref = node
+	}
+	return nil
+}
+
+func (n *jsonSchemaNode) fail(docLocation, keyword string) *JSONSchemaValidationError {
+	return &JSONSchemaValidationError{SchemaLocation: n.location, DocumentLocation: docLocation, Keyword: keyword}
+}
+
+func (n *jsonSchemaNode) validate(doc BinaryJSON, docLocation string) *JSONSchemaValidationError {
+	if n.ref != nil {
+		n = n.ref
+	}
+
+	tp := jsonSchemaTypeOf(doc)
+	if len(n.types) > 0 {
+		matched := false
+		for _, t := range n.types {
+			if t == tp || (t == jsonSchemaTypeNumber && tp == jsonSchemaTypeInteger) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return n.fail(docLocation, "type")
+		}
+	}
+
+	if len(n.enum) > 0 {
+		matched := false
+		for _, v := range n.enum {
+			if CompareBinaryJSON(v, doc) == 0 {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return n.fail(docLocation, "enum")
+		}
+	}
+
+	var err *JSONSchemaValidationError
+	switch tp {
+	case jsonSchemaTypeInteger, jsonSchemaTypeNumber:
+		err = n.validateNumber(doc, docLocation)
+	case jsonSchemaTypeString:
+		err = n.validateString(doc, docLocation)
+	case jsonSchemaTypeArray:
+		err = n.validateArray(doc, docLocation)
+	case jsonSchemaTypeObject:
+		err = n.validateObject(doc, docLocation)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range n.allOf {
+		if err := sub.validate(doc, docLocation); err != nil {
+			return err
+		}
+	}
+	if len(n.anyOf) > 0 {
+		matched := false
+		for _, sub := range n.anyOf {
+			if sub.validate(doc, docLocation) == nil {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return n.fail(docLocation, "anyOf")
+		}
+	}
+	if len(n.oneOf) > 0 {
+		matched := 0
+		for _, sub := range n.oneOf {
+			if sub.validate(doc, docLocation) == nil {
+				matched++
+			}
+		}
+		if matched != 1 {
+			return n.fail(docLocation, "oneOf")
+		}
+	}
+	if n.not != nil && n.not.validate(doc, docLocation) == nil {
+		return n.fail(docLocation, "not")
+	}
+	return nil
+}
+
+func (n *jsonSchemaNode) validateNumber(doc BinaryJSON, docLocation string) *JSONSchemaValidationError {
+	v, _ := jsonSchemaNumber(doc)
+	if n.multipleOf > 0 {
+		q := v / n.multipleOf
+		if math.IsInf(q, 0) || math.Abs(q-math.Round(q)) > 1e-9 {
+			return n.fail(docLocation, "multipleOf")
+		}
+	}
+	if n.maximum != nil && (v > *n.maximum || (n.exclusiveMaximum && v == *n.maximum)) {
+		return n.fail(docLocation, "maximum")
+	}
+	if n.minimum != nil && (v < *n.minimum || (n.exclusiveMinimum && v == *n.minimum)) {
+		return n.fail(docLocation, "minimum")
+	}
+	return nil
+}
+
+func (n *jsonSchemaNode) validateString(doc BinaryJSON, docLocation string) *JSONSchemaValidationError {
+	if n.maxLength < 0 && n.minLength == 0 && n.pattern == nil {
+		return nil
+	}
+	var s string
+	if doc.TypeCode == JSONTypeCodeString {
+		s = string(doc.GetString())
+	} else {
+		s, _ = doc.Unquote()
+	}
+	length := utf8.RuneCountInString(s)
+	if n.maxLength >= 0 && length > n.maxLength {
+		return n.fail(docLocation, "maxLength")
+	}
+	if length < n.minLength {
+		return n.fail(docLocation, "minLength")
+	}
+	if n.pattern != nil && !n.pattern.MatchString(s) {
+		return n.fail(docLocation, "pattern")
+	}
+	return nil
+}
+
+func (n *jsonSchemaNode) validateArray(doc BinaryJSON, docLocation string) *JSONSchemaValidationError {
+	count := doc.GetElemCount()
+	if n.maxItems >= 0 && count > n.maxItems {
+		return n.fail(docLocation, "maxItems")
+	}
+	if count < n.minItems {
+		return n.fail(docLocation, "minItems")
+	}
+	if n.noAdditionalItems && n.itemsList != nil && count > len(n.itemsList) {
+		return n.fail(docLocation, "additionalItems")
+	}
+	for i := 0; i < count; i++ {
+		sub := n.items
+		if n.itemsList != nil {
+			if i < len(n.itemsList) {
+				sub = n.itemsList[i]
+			} else {
+				sub = n.additionalItems
+			}
+		}
+		if sub == nil {
+			continue
+		}
+		if err := sub.validate(doc.ArrayGetElem(i), docLocation+"/"+strconv.Itoa(i)); err != nil {
+			return err
+		}
+	}
+	if n.uniqueItems {
+		for i := 0; i < count; i++ {
+			for j := i + 1; j < count; j++ {
+				if CompareBinaryJSON(doc.ArrayGetElem(i), doc.ArrayGetElem(j)) == 0 {
+					return n.fail(docLocation, "uniqueItems")
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (n *jsonSchemaNode) validateObject(doc BinaryJSON, docLocation string) *JSONSchemaValidationError {
+	count := doc.GetElemCount()
+	if n.maxProperties >= 0 && count > n.maxProperties {
+		return n.fail(docLocation, "maxProperties")
+	}
+	if count < n.minProperties {
+		return n.fail(docLocation, "minProperties")
+	}
+	for _, name := range n.required {
+		if _, ok := doc.objectSearchKey([]byte(name)); !ok {
+			return n.fail(docLocation, "required")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		key, val := string(doc.objectGetKey(i)), doc.objectGetVal(i)
+		location := docLocation + "/" + escapeJSONPointerToken(key)
+		matched := false
+		if sub, ok := n.properties[key]; ok {
+			matched = true
+			if err := sub.validate(val, location); err != nil {
+				return err
+			}
+		}
+		for _, p := range n.patternProperties {
+			if !p.pattern.MatchString(key) {
+				continue
+			}
+			matched = true
+			if err := p.node.validate(val, location); err != nil {
+				return err
+			}
+		}
+		if matched {
+			continue
+		}
+		if n.noAdditionalProperties {
+			return n.fail(docLocation, "additionalProperties")
+		}
+		if n.additionalProperties != nil {
+			if err := n.additionalProperties.validate(val, location); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name, deps := range n.propertyDependencies {
+		if _, ok := doc.objectSearchKey([]byte(name)); !ok {
+			continue
+		}
+		for _, dep := range deps {
+			if _, ok := doc.objectSearchKey([]byte(dep)); !ok {
+				return n.fail(docLocation, "dependencies")
+			}
+		}
+	}
+	for name, sub := range n.schemaDependencies {
+		if _, ok := doc.objectSearchKey([]byte(name)); !ok {
+			continue
+		}
+		if err := sub.validate(doc, docLocation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonSchemaTypeOf returns the JSON Schema type of a JSON value. The temporal values and the opaque values
+// are regarded as strings.
+func jsonSchemaTypeOf(bj BinaryJSON) string {
+	switch bj.TypeCode {
+	case JSONTypeCodeObject:
+		return jsonSchemaTypeObject
+	case JSONTypeCodeArray:
+		return jsonSchemaTypeArray
+	case JSONTypeCodeLiteral:
+		if bj.Value[0] == JSONLiteralNil {
+			return jsonSchemaTypeNull
+		}
+		return jsonSchemaTypeBoolean
+	case JSONTypeCodeInt64, JSONTypeCodeUint64:
+		return jsonSchemaTypeInteger
+	case JSONTypeCodeFloat64:
+		return jsonSchemaTypeNumber
+	default:
+		return jsonSchemaTypeString
+	}
+}
+
+func jsonSchemaNumber(bj BinaryJSON) (float64, bool) {
+	switch bj.TypeCode {
+	case JSONTypeCodeInt64:
+		return float64(bj.GetInt64()), true
+	case JSONTypeCodeUint64:
+		return float64(bj.GetUint64()), true
+	case JSONTypeCodeFloat64:
+		return bj.GetFloat64(), true
+	}
+	return 0, false
+}
+
+// jsonSchemaCount returns the value of the keywords like `maxLength`, which must be non-negative integers.
+func jsonSchemaCount(bj BinaryJSON) (int, bool) {
+	v, ok := jsonSchemaNumber(bj)
+	if !ok || v < 0 || v != math.Trunc(v) || v > math.MaxInt32 {
+		return 0, false
+	}
+	return int(v), true
+}
+
+func jsonSchemaBool(bj BinaryJSON) bool {
+	return bj.TypeCode == JSONTypeCodeLiteral && bj.Value[0] == JSONLiteralTrue
+}
+
+// jsonSchemaStrings returns the strings in a JSON string or a JSON array, the other values are ignored.
+func jsonSchemaStrings(bj BinaryJSON) []string {
+	if bj.TypeCode == JSONTypeCodeString {
+		return []string{string(bj.GetString())}
+	}
+	if bj.TypeCode != JSONTypeCodeArray {
+		return nil
+	}
+	strs := make([]string, 0, bj.GetElemCount())
+	for i := 0; i < bj.GetElemCount(); i++ {
+		if elem := bj.ArrayGetElem(i); elem.TypeCode == JSONTypeCodeString {
+			strs = append(strs, string(elem.GetString()))
+		}
+	}
+	return strs
+}
+
+var (
+	jsonPointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+func escapeJSONPointerToken(token string) string {
+	return jsonPointerEscaper.Replace(token)
+}
+
+func unescapeJSONPointerToken(token string) string {
+	return jsonPointerUnescaper.Replace(token)
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSchemaValidate(t *testing.T) {
+	var tests = []struct {
+		schema   string
+		doc      string
+		location string // document location of the failure, empty if the document is valid
+		keyword  string
+		schemaAt string
+	}{
+		{`{}`, `[1, "a", null]`, "", "", ""},
+		{`{"type": "object"}`, `{}`, "", "", ""},
+		{`{"type": "object"}`, `[]`, "#", "type", "#"},
+		{`{"type": ["string", "null"]}`, `null`, "", "", ""},
+		{`{"type": "number"}`, `1`, "", "", ""},
+		{`{"type": "integer"}`, `1.5`, "#", "type", "#"},
+		{`{"enum": [1, "a", [2]]}`, `[2]`, "", "", ""},
+		{`{"enum": [1, "a", [2]]}`, `"b"`, "#", "enum", "#"},
+
+		// numbers
+		{`{"minimum": 1, "maximum": 3}`, `3`, "", "", ""},
+		{`{"minimum": 1, "maximum": 3, "exclusiveMaximum": true}`, `3`, "#", "maximum", "#"},
+		{`{"minimum": 1}`, `0.5`, "#", "minimum", "#"},
+		{`{"multipleOf": 0.1}`, `0.3`, "", "", ""},
+		{`{"multipleOf": 2}`, `3`, "#", "multipleOf", "#"},
+		{`{"maximum": 3}`, `"10"`, "", "", ""},
+
+		// strings
+		{`{"minLength": 2, "maxLength": 3}`, `"你好"`, "", "", ""},
+		{`{"maxLength": 3}`, `"abcd"`, "#", "maxLength", "#"},
+		{`{"pattern": "^a+$"}`, `"aab"`, "#", "pattern", "#"},
+
+		// arrays
+		{`{"items": {"type": "integer"}}`, `[1, 2, "3"]`, "#/2", "type", "#/items"},
+		{`{"items": [{"type": "integer"}], "additionalItems": false}`, `[1, 2]`, "#", "additionalItems", "#"},
+		{`{"items": [{"type": "integer"}], "additionalItems": {"type": "string"}}`, `[1, "a"]`, "", "", ""},
+		{`{"minItems": 1, "maxItems": 2}`, `[]`, "#", "minItems", "#"},
+		{`{"uniqueItems": true}`, `[1, {"a": 1}, {"a": 1}]`, "#", "uniqueItems", "#"},
+
+		// objects
+		{`{"required": ["a"]}`, `{"b": 1}`, "#", "required", "#"},
+		{`{"properties": {"a/b": {"maximum": 1}}}`, `{"a/b": 2}`, "#/a~1b", "maximum", "#/properties/a~1b"},
+		{`{"patternProperties": {"^x": {"type": "string"}}, "additionalProperties": false}`, `{"x1": "a"}`, "", "", ""},
+		{`{"patternProperties": {"^x": {"type": "string"}}, "additionalProperties": false}`, `{"y": "a"}`, "#", "additionalProperties", "#"},
+		{`{"additionalProperties": {"type": "integer"}}`, `{"a": 1, "b": true}`, "#/b", "type", "#/additionalProperties"},
+		{`{"minProperties": 2}`, `{"a": 1}`, "#", "minProperties", "#"},
+		{`{"dependencies": {"a": ["b"]}}`, `{"a": 1}`, "#", "dependencies", "#"},
+		{`{"dependencies": {"a": {"required": ["c"]}}}`, `{"a": 1, "c": 2}`, "", "", ""},
+
+		// combinations
+		{`{"allOf": [{"minimum": 1}, {"maximum": 2}]}`, `3`, "#", "maximum", "#/allOf/1"},
+		{`{"anyOf": [{"type": "string"}, {"type": "null"}]}`, `1`, "#", "anyOf", "#"},
+		{`{"oneOf": [{"minimum": 1}, {"maximum": 2}]}`, `1.5`, "#", "oneOf", "#"},
+		{`{"not": {"type": "string"}}`, `"a"`, "#", "not", "#"},
+
+		// references
+		{`{"definitions": {"pos": {"minimum": 0}}, "properties": {"a": {"$ref": "#/definitions/pos"}}}`, `{"a": -1}`,
+			"#/a", "minimum", "#/definitions/pos"},
+		{`{"properties": {"child": {"$ref": "#"}}, "required": ["id"]}`, `{"id": 1, "child": {"id": 2, "child": {}}}`,
+			"#/child/child", "required", "#"},
+		{`{"$ref": "#"}`, `1`, "", "", ""},
+		{`{"$ref": "#/definitions/unknown"}`, `1`, "", "", ""},
+	}
+
+	for _, test := range tests {
+		schema, err := ParseBinaryJSONFromString(test.schema)
+		require.NoError(t, err)
+		doc, err := ParseBinaryJSONFromString(test.doc)
+		require.NoError(t, err)
+		s, err := ParseJSONSchema(schema)
+		require.NoError(t, err, test.schema)
+
+		failure := s.Validate(doc)
+		if test.location == "" {
+			require.Nil(t, failure, "%s %s", test.schema, test.doc)
+			continue
+		}
+		require.NotNil(t, failure, "%s %s", test.schema, test.doc)
+		require.Equal(t, test.location, failure.DocumentLocation, "%s %s", test.schema, test.doc)
+		require.Equal(t, test.keyword, failure.Keyword, "%s %s", test.schema, test.doc)
+		require.Equal(t, test.schemaAt, failure.SchemaLocation, "%s %s", test.schema, test.doc)
+	}
+
+	schema, err := ParseBinaryJSONFromString(`{"$ref": "http://example.com/schema"}`)
+	require.NoError(t, err)
+	_, err = ParseJSONSchema(schema)
+	require.True(t, ErrJSONSchemaNotSupported.Equal(err))
+}
@@ -0,0 +1,176 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/util/dbterror"
+)
+
+const (
+	// MaxVectorDimension is the max number of dimensions of a vector, which is the same as MySQL.
+	MaxVectorDimension = 16383
+
+	vectorElementLen = 4
+)
+
+var (
+	// ErrVectorInvalidValue is returned when a value can't be converted to a vector.
+	ErrVectorInvalidValue = dbterror.ClassTypes.NewStd(errno.ErrVectorInvalidValue)
+	// ErrVectorDimensionMismatch is returned when the dimension of a vector doesn't match the VECTOR(n) type.
+	ErrVectorDimensionMismatch = dbterror.ClassTypes.NewStd(errno.ErrVectorDimensionMismatch)
+	// ErrVectorDifferentDims is returned when the vectors given to a function have different dimensions.
+	ErrVectorDifferentDims = dbterror.ClassTypes.NewStd(errno.ErrVectorDifferentDims)
+)
+
+// Vector is a value of the VECTOR type. Its storage format is the same as MySQL,
+// which is the little-endian float32 elements without any header, see Encode and ParseVectorBinary.
+type Vector []float32
+
+// ParseVectorText parses a vector from its text representation, such as "[1, 2.5, -3]".
+func ParseVectorText(s string) (Vector, error) {
+	str := strings.TrimSpace(s)
+	if len(str) < 2 || str[0] != '[' || str[len(str)-1] != ']' {
+		return nil, ErrVectorInvalidValue.GenWithStackByArgs(s)
+	}
+	str = strings.TrimSpace(str[1 : len(str)-1])
+	if len(str) == 0 {
+		return nil, ErrVectorInvalidValue.GenWithStackByArgs(s)
+	}
+	elems := strings.Split(str, ",")
+	if len(elems) > MaxVectorDimension {
+		return nil, ErrVectorInvalidValue.GenWithStackByArgs(s)
+	}
+	v := make(Vector, 0, len(elems))
+	for _, elem := range elems {
+		f, err := strconv.ParseFloat(strings.TrimSpace(elem), 32)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, ErrVectorInvalidValue.GenWithStackByArgs(s)
+		}
+		v = append(v, float32(f))
+	}
+	return v, nil
+}
+
+// ParseVectorBinary parses a vector stored in the MySQL internal format.
+func ParseVectorBinary(data []byte) (Vector, error) {
+	if len(data) == 0 || len(data)%vectorElementLen != 0 || len(data)/vectorElementLen > MaxVectorDimension {
+		return nil, ErrVectorInvalidValue.GenWithStackByArgs(strconv.Quote(string(data)))
+	}
+	v := make(Vector, len(data)/vectorElementLen)
+	for i := range v {
+		f := math.Float32frombits(binary.LittleEndian.Uint32(data[i*vectorElementLen:]))
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return nil, ErrVectorInvalidValue.GenWithStackByArgs(strconv.Quote(string(data)))
+		}
+		v[i] = f
+	}
+	return v, nil
+}
+
+// Encode encodes the vector in the MySQL internal format.
+func (v Vector) Encode() []byte {
+	data := make([]byte, len(v)*vectorElementLen)
+	for i, f := range v {
+		binary.LittleEndian.PutUint32(data[i*vectorElementLen:], math.Float32bits(f))
+	}
+	return data
+}
+
+// String returns the text representation of the vector.
+func (v Vector) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// CheckDimension checks whether the vector fits the VECTOR(flen) type.
+// A vector type without the dimension accepts vectors of any dimensions.
+func (v Vector) CheckDimension(flen int) error {
+	if flen != UnspecifiedLength && len(v) != flen {
+		return ErrVectorDimensionMismatch.GenWithStackByArgs(len(v), flen)
+	}
+	return nil
+}
+
+// L2Norm returns the euclidean norm of the vector.
+func (v Vector) L2Norm() float64 {
+	var sum float64
+	for _, f := range v {
+		sum += float64(f) * float64(f)
+	}
+	return math.Sqrt(sum)
+}
+
+// VectorDistanceFunc computes the distance of two vectors of the same dimension.
+type VectorDistanceFunc func(a, b Vector) float64
+
+// VectorL2Distance returns the euclidean distance of two vectors.
+func VectorL2Distance(a, b Vector) float64 {
+	var sum float64
+	for i := range a {
+		d := float64(a[i]) - float64(b[i])
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// VectorL1Distance returns the manhattan distance of two vectors.
+func VectorL1Distance(a, b Vector) float64 {
+	var sum float64
+	for i := range a {
+		sum += math.Abs(float64(a[i]) - float64(b[i]))
+	}
+	return sum
+}
+
+// VectorNegativeInnerProduct returns the negative inner product of two vectors,
+// so that a smaller value means the vectors are more similar like the other distances.
+func VectorNegativeInnerProduct(a, b Vector) float64 {
+	var sum float64
+	for i := range a {
+		sum += float64(a[i]) * float64(b[i])
+	}
+	return -sum
+}
+
+// VectorCosineDistance returns one minus the cosine similarity of two vectors, which is in [0, 2].
+// The cosine distance is undefined for a zero vector, NaN is returned then.
+func VectorCosineDistance(a, b Vector) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return math.NaN()
+	}
+	similarity := dot / math.Sqrt(normA*normB)
+	// Clamp the similarity to eliminate the floating-point error.
+	return 1 - math.Max(-1, math.Min(1, similarity))
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVector(t *testing.T) {
+	tests := []struct {
+		text string
+		out  string
+	}{
+		{"[1,2,3]", "[1,2,3]"},
+		{" [ 1.5 , -2e3, 0 ] ", "[1.5,-2000,0]"},
+		{"[0.1]", "[0.1]"},
+	}
+	for _, tt := range tests {
+		v, err := ParseVectorText(tt.text)
+		require.NoError(t, err, tt.text)
+		require.Equal(t, tt.out, v.String(), tt.text)
+
+		decoded, err := ParseVectorBinary(v.Encode())
+		require.NoError(t, err, tt.text)
+		require.Equal(t, v, decoded, tt.text)
+	}
+
+	for _, text := range []string{"", "[]", "1,2", "[1,2", "[1,,2]", "[a]", "[NaN]", "[1e40]"} {
+		_, err := ParseVectorText(text)
+		require.True(t, ErrVectorInvalidValue.Equal(err), text)
+	}
+	_, err := ParseVectorText("[" + strings.Repeat("1,", MaxVectorDimension) + "1]")
+	require.True(t, ErrVectorInvalidValue.Equal(err))
+
+	for _, data := range [][]byte{nil, {1, 2, 3}, Vector{float32(math.Inf(1))}.Encode()} {
+		_, err := ParseVectorBinary(data)
+		require.True(t, ErrVectorInvalidValue.Equal(err))
+	}
+}
+
+func TestVectorDistance(t *testing.T) {
+	a := Vector{1, 2, 3}
+	b := Vector{4, 6, 3}
+	require.Equal(t, 5.0, VectorL2Distance(a, b))
+	require.Equal(t, 7.0, VectorL1Distance(a, b))
+	require.Equal(t, -25.0, VectorNegativeInnerProduct(a, b))
+	require.InDelta(t, 1-25/(math.Sqrt(14)*math.Sqrt(61)), VectorCosineDistance(a, b), 1e-9)
+	require.Equal(t, 0.0, VectorCosineDistance(a, Vector{2, 4, 6}))
+	require.Equal(t, 2.0, VectorCosineDistance(a, Vector{-1, -2, -3}))
+	require.True(t, math.IsNaN(VectorCosineDistance(a, Vector{0, 0, 0})))
+	require.InDelta(t, math.Sqrt(14), a.L2Norm(), 1e-9)
+}
+
+func TestConvertToVector(t *testing.T) {
+	sc := new(stmtctx.StatementContext)
+	ft := NewFieldType(mysql.TypeVector)
+
+	tests := []struct {
+		d    Datum
+		flen int
+		out  Vector
+		err  error
+	}{
+		{NewStringDatum("[1, 2, 3]"), 3, Vector{1, 2, 3}, nil},
+		{NewBytesDatum(Vector{1, 2, 3}.Encode()), 3, Vector{1, 2, 3}, nil},
+		{NewStringDatum("[1, 2]"), 3, nil, ErrVectorDimensionMismatch},
+		{NewStringDatum("abc"), 3, nil, ErrVectorInvalidValue},
+		{NewStringDatum("[1, 2]"), UnspecifiedLength, Vector{1, 2}, nil},
+	}
+	for _, tt := range tests {
+		ft.SetFlen(tt.flen)
+		d, err := tt.d.ConvertTo(sc, ft)
+		if tt.err != nil {
+			require.ErrorIs(t, err, tt.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tt.out.Encode(), d.GetBytes())
+	}
+}
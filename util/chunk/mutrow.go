@@ -93,7 +93,7 @@ func zeroValForType(tp *types.FieldType) interface{} {
 		return int64(0)
 	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar:
 		return ""
-	case mysql.TypeBlob, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
+	case mysql.TypeBlob, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeGeometry, mysql.TypeVector:
 		return []byte{}
 	case mysql.TypeDuration:
 		return types.ZeroDuration
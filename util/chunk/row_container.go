@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pingcap/failpoint"
@@ -466,6 +467,12 @@ type SortedRowContainer struct {
 		// It will get an ErrCannotAddBecauseSorted when trying to insert data if rowPtrs != nil.
 		rowPtrs []RowPtr
 	}
+	// sealed is set once the spill action is triggered. The records are sorted and spilled in the background, so Add
+	// returns ErrCannotAddBecauseSorted without waiting for the sorting, and the caller can go on to add the records
+	// to a new SortedRowContainer.
+	sealed atomic.Bool
+	// spillWg waits for the goroutine that sorts and spills the records.
+	spillWg sync.WaitGroup
 
 	ByItemsDesc []bool
 	// keyColumns is the column index of the by items.
@@ -479,6 +486,9 @@ type SortedRowContainer struct {
 	// Sort is a time-consuming operation, we need to set a checkpoint to detect
 	// the outside signal periodically.
 	timesOfRowCompare uint
+
+	// concurrency is the number of goroutines to sort the records.
+	concurrency int
 }
 
 // NewSortedRowContainer creates a new SortedRowContainer in memory.
@@ -521,24 +531,38 @@ func (c *SortedRowContainer) lessRow(rowI, rowJ Row) bool {
 // SignalCheckpointForSort indicates the times of row comparation that a signal detection will be triggered.
 const SignalCheckpointForSort uint = 10240
 
+// MinRowsPerSortWorker is the min number of rows sorted by a goroutine in a parallel sort.
+const MinRowsPerSortWorker = 1024
+
 // keyColumnsLess is the less function for key columns.
 func (c *SortedRowContainer) keyColumnsLess(i, j int) bool {
-	if c.timesOfRowCompare >= SignalCheckpointForSort {
+	return c.rowPtrLess(c.ptrM.rowPtrs[i], c.ptrM.rowPtrs[j], &c.timesOfRowCompare)
+}
+
+// rowPtrLess compares the rows the pointers pointed to. Every sorting goroutine has its own timesOfRowCompare.
+func (c *SortedRowContainer) rowPtrLess(ptrI, ptrJ RowPtr, timesOfRowCompare *uint) bool {
+	if *timesOfRowCompare >= SignalCheckpointForSort {
 		// Trigger Consume for checking the NeedKill signal
 		c.memTracker.Consume(1)
-		c.timesOfRowCompare = 0
+		*timesOfRowCompare = 0
 	}
 	failpoint.Inject("SignalCheckpointForSort", func(val failpoint.Value) {
 		if val.(bool) {
-			c.timesOfRowCompare += 1024
+			*timesOfRowCompare += 1024
 		}
 	})
-	c.timesOfRowCompare++
-	rowI := c.m.records.inMemory.GetRow(c.ptrM.rowPtrs[i])
-	rowJ := c.m.records.inMemory.GetRow(c.ptrM.rowPtrs[j])
+	*timesOfRowCompare++
+	rowI := c.m.records.inMemory.GetRow(ptrI)
+	rowJ := c.m.records.inMemory.GetRow(ptrJ)
 	return c.lessRow(rowI, rowJ)
 }
 
+// SetConcurrency sets the number of goroutines to sort the records. Every goroutine sorts at least
+// MinRowsPerSortWorker rows, so the records may be sorted by fewer goroutines.
+func (c *SortedRowContainer) SetConcurrency(concurrency int) {
+	c.concurrency = concurrency
+}
+
 // Sort inits pointers and sorts the records.
 func (c *SortedRowContainer) Sort() {
 	c.ptrM.Lock()
@@ -557,16 +581,133 @@ func (c *SortedRowContainer) Sort() {
 			c.ptrM.rowPtrs = append(c.ptrM.rowPtrs, RowPtr{ChkIdx: uint32(chkIdx), RowIdx: uint32(rowIdx)})
 		}
 	}
+	if concurrency := min(c.concurrency, len(c.ptrM.rowPtrs)/MinRowsPerSortWorker); concurrency > 1 {
+		c.parallelSort(concurrency)
+		return
+	}
 	sort.Slice(c.ptrM.rowPtrs, c.keyColumnsLess)
 }
 
+// parallelSort splits the row pointers into segments and sorts them concurrently, then merges every two adjacent
+// sorted segments concurrently until there's only one segment.
+func (c *SortedRowContainer) parallelSort(concurrency int) {
+	ptrs := c.ptrM.rowPtrs
+	bounds := make([]int, 0, concurrency+1)
+	for i := 0; i <= concurrency; i++ {
+		bounds = append(bounds, len(ptrs)*i/concurrency)
+	}
+	runConcurrently(concurrency, func(i int) {
+		var timesOfRowCompare uint
+		segment := ptrs[bounds[i]:bounds[i+1]]
+		sort.Slice(segment, func(x, y int) bool {
+			return c.rowPtrLess(segment[x], segment[y], &timesOfRowCompare)
+		})
+	})
+
+	// The buffer to merge the segments into.
+	bufSize := int64(8 * len(ptrs))
+	c.GetMemTracker().Consume(bufSize)
+	defer c.GetMemTracker().Consume(-bufSize)
+	src, dst := ptrs, make([]RowPtr, len(ptrs))
+	for len(bounds) > 2 {
+		numSegments := len(bounds) - 1
+		runConcurrently((numSegments+1)/2, func(i int) {
+			begin, mid := bounds[2*i], bounds[2*i+1]
+			if 2*i+2 >= len(bounds) {
+				// The last segment has no one to merge with.
+				copy(dst[begin:mid], src[begin:mid])
+				return
+			}
+			var timesOfRowCompare uint
+			c.mergeRowPtrs(src[begin:mid], src[mid:bounds[2*i+2]], dst[begin:bounds[2*i+2]], &timesOfRowCompare)
+		})
+		newBounds := bounds[:0]
+		for i := 0; i < len(bounds); i += 2 {
+			newBounds = append(newBounds, bounds[i])
+		}
+		if numSegments%2 == 1 {
+			newBounds = append(newBounds, len(ptrs))
+		}
+		bounds = newBounds
+		src, dst = dst, src
+	}
+	c.ptrM.rowPtrs = src
+}
+
+// mergeRowPtrs merges two sorted row pointer slices into dst.
+func (c *SortedRowContainer) mergeRowPtrs(left, right, dst []RowPtr, timesOfRowCompare *uint) {
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		if c.rowPtrLess(right[j], left[i], timesOfRowCompare) {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
+
+// runConcurrently runs f(0), f(1), ..., f(n-1) in n goroutines and waits for them to finish. A panic in the
+// goroutines, e.g. the one raised by the memory tracker to kill the query, is raised again in the caller.
+func runConcurrently(n int, f func(i int)) {
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		recovered any
+	)
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					mu.Lock()
+					recovered = r
+					mu.Unlock()
+				}
+				wg.Done()
+			}()
+			f(i)
+		}(i)
+	}
+	wg.Wait()
+	if recovered != nil {
+		panic(recovered)
+	}
+}
+
 func (c *SortedRowContainer) sortAndSpillToDisk() {
 	c.Sort()
 	c.RowContainer.SpillToDisk()
 }
 
+// sortAndSpillToDiskAsync seals the records, and sorts and spills them in a new goroutine. done is called after
+// the records are spilled if it's not nil.
+func (c *SortedRowContainer) sortAndSpillToDiskAsync(done func()) {
+	c.sealed.Store(true)
+	c.spillWg.Add(1)
+	go func() {
+		defer c.spillWg.Done()
+		c.sortAndSpillToDisk()
+		if done != nil {
+			done()
+		}
+	}()
+}
+
+// WaitForSpill waits until the records sorted and spilled in the background are spilled.
+func (c *SortedRowContainer) WaitForSpill() {
+	c.spillWg.Wait()
+}
+
 // Add appends a chunk into the SortedRowContainer.
 func (c *SortedRowContainer) Add(chk *Chunk) (err error) {
+	if c.sealed.Load() {
+		return ErrCannotAddBecauseSorted
+	}
 	c.ptrM.RLock()
 	defer c.ptrM.RUnlock()
 	if c.ptrM.rowPtrs != nil {
@@ -638,14 +779,10 @@ func (a *SortAndSpillDiskAction) Action(t *memory.Tracker) {
 				zap.Int64("consumed", t.BytesConsumed()), zap.Int64("quota", t.GetBytesLimit()))
 			if a.testSyncInputFunc != nil {
 				a.testSyncInputFunc()
-				c := a.c
-				go func() {
-					c.sortAndSpillToDisk()
-					a.testSyncOutputFunc()
-				}()
+				a.c.sortAndSpillToDiskAsync(a.testSyncOutputFunc)
 				return
 			}
-			go a.c.sortAndSpillToDisk()
+			a.c.sortAndSpillToDiskAsync(nil)
 		})
 		return
 	}
@@ -17,6 +17,7 @@ package chunk
 import (
 	"crypto/rand"
 	rand2 "math/rand"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -188,6 +189,68 @@ func TestSortedRowContainerSortSpillAction(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSortedRowContainerParallelSort(t *testing.T) {
+	fields := []*types.FieldType{types.NewFieldType(mysql.TypeLonglong)}
+	byItemsDesc := []bool{true}
+	keyColumns := []int{0}
+	keyCmpFuncs := []CompareFunc{cmpInt64}
+	numRows := MinRowsPerSortWorker*5 + 37
+	for _, concurrency := range []int{1, 2, 3, 5, 8} {
+		rc := NewSortedRowContainer(fields, 1024, byItemsDesc, keyColumns, keyCmpFuncs)
+		rc.SetConcurrency(concurrency)
+		expected := make([]int64, 0, numRows)
+		for i := 0; i < numRows; i += 1024 {
+			chk := NewChunkWithCapacity(fields, 1024)
+			for j := i; j < numRows && j < i+1024; j++ {
+				v := int64(j*7919) % 1000
+				chk.AppendInt64(0, v)
+				expected = append(expected, v)
+			}
+			require.NoError(t, rc.Add(chk))
+		}
+		memUsage := rc.GetMemTracker().BytesConsumed()
+		rc.Sort()
+		// The buffer to merge the sorted segments is released.
+		require.Less(t, rc.GetMemTracker().BytesConsumed(), memUsage+int64(8*numRows))
+		slices.Sort(expected)
+		slices.Reverse(expected)
+		for i := 0; i < numRows; i++ {
+			row, err := rc.GetSortedRow(i)
+			require.NoError(t, err)
+			require.Equal(t, expected[i], row.GetInt64(0))
+		}
+		require.NoError(t, rc.Close())
+	}
+}
+
+func TestSortedRowContainerAddWhileSpilling(t *testing.T) {
+	fields := []*types.FieldType{types.NewFieldType(mysql.TypeLonglong)}
+	sz := 20
+	rc := NewSortedRowContainer(fields, sz, []bool{false}, []int{0}, []CompareFunc{cmpInt64})
+	chk := NewChunkWithCapacity(fields, sz)
+	for i := 0; i < sz; i++ {
+		chk.AppendInt64(0, int64(sz-i))
+	}
+	require.NoError(t, rc.Add(chk))
+
+	// Block the sorting of the spilling goroutine.
+	rc.ptrM.Lock()
+	rc.sortAndSpillToDiskAsync(nil)
+	// Add doesn't wait for the sorting once the spilling is triggered.
+	require.ErrorIs(t, rc.Add(chk), ErrCannotAddBecauseSorted)
+	rc.ptrM.Unlock()
+	rc.WaitForSpill()
+
+	require.True(t, rc.AlreadySpilledSafeForTest())
+	require.Equal(t, sz, rc.NumRow())
+	for i := 0; i < sz; i++ {
+		row, err := rc.GetSortedRow(i)
+		require.NoError(t, err)
+		require.Equal(t, int64(i+1), row.GetInt64(0))
+	}
+	require.NoError(t, rc.Close())
+}
+
 func TestRowContainerResetAndAction(t *testing.T) {
 	fields := []*types.FieldType{types.NewFieldType(mysql.TypeLonglong)}
 	sz := 20
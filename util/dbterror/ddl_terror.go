@@ -269,6 +269,14 @@ var (
 	ErrWrongObject = ClassDDL.NewStd(mysql.ErrWrongObject)
 	// ErrTableCantHandleFt returns FULLTEXT keys are not supported by table type
 	ErrTableCantHandleFt = ClassDDL.NewStd(mysql.ErrTableCantHandleFt)
+	// ErrBadFtColumn returns when a column cannot be part of FULLTEXT index.
+	ErrBadFtColumn = ClassDDL.NewStd(mysql.ErrBadFtColumn)
+	// ErrFulltextNotSupportedWithPartitioning returns when creating FULLTEXT index on partitioned table.
+	ErrFulltextNotSupportedWithPartitioning = ClassDDL.NewStd(mysql.ErrFulltextNotSupportedWithPartitioning)
+	// ErrFulltextFunctionalIndex returns when creating FULLTEXT index on expressions.
+	ErrFulltextFunctionalIndex = ClassDDL.NewStd(mysql.ErrFulltextFunctionalIndex)
+	// ErrPluginIsNotLoaded returns when the full-text parser is not found.
+	ErrPluginIsNotLoaded = ClassDDL.NewStd(mysql.ErrPluginIsNotLoaded)
 	// ErrFieldNotFoundPart returns an error when 'partition by columns' are not found in table columns.
 	ErrFieldNotFoundPart = ClassDDL.NewStd(mysql.ErrFieldNotFoundPart)
 	// ErrWrongTypeColumnValue returns 'Partition column values of incorrect type'
@@ -469,6 +477,19 @@ var (
 	ErrCheckConstraintUsingFKReferActionColumn = ClassDDL.NewStd(mysql.ErrCheckConstraintClauseUsingFKReferActionColumn)
 	// ErrNonBooleanExprForCheckConstraint is returned for non bool expression.
 	ErrNonBooleanExprForCheckConstraint = ClassDDL.NewStd(mysql.ErrNonBooleanExprForCheckConstraint)
+
+	// ErrTrgAlreadyExists is returned when creating a trigger whose name is used by another trigger in the schema.
+	ErrTrgAlreadyExists = ClassDDL.NewStd(mysql.ErrTrgAlreadyExists)
+	// ErrTrgDoesNotExist is returned when dropping a non-existent trigger.
+	ErrTrgDoesNotExist = ClassDDL.NewStd(mysql.ErrTrgDoesNotExist)
+	// ErrTrgOnViewOrTempTable is returned when creating a trigger on a view or a temporary table.
+	ErrTrgOnViewOrTempTable = ClassDDL.NewStd(mysql.ErrTrgOnViewOrTempTable)
+	// ErrTrgInWrongSchema is returned when the trigger and its table are in different schemas.
+	ErrTrgInWrongSchema = ClassDDL.NewStd(mysql.ErrTrgInWrongSchema)
+	// ErrReferencedTrgDoesNotExist is returned when the trigger in `FOLLOWS` or `PRECEDES` doesn't exist.
+	ErrReferencedTrgDoesNotExist = ClassDDL.NewStd(mysql.ErrReferencedTrgDoesNotExist)
+	// ErrNoTriggersOnSystemSchema is returned when creating a trigger on a table in a system schema.
+	ErrNoTriggersOnSystemSchema = ClassDDL.NewStd(mysql.ErrNoTriggersOnSystemSchema)
 	// ErrWarnDeprecatedIntegerDisplayWidth share the same code 1681, and it will be returned when length is specified in integer.
 	ErrWarnDeprecatedIntegerDisplayWidth = ClassDDL.NewStdErr(
 		mysql.ErrWarnDeprecatedSyntaxNoReplacement,
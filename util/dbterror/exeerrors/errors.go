@@ -67,6 +67,8 @@ var (
 	ErrBRIEExportFailed               = dbterror.ClassExecutor.NewStd(mysql.ErrBRIEExportFailed)
 	ErrBRJobNotFound                  = dbterror.ClassExecutor.NewStd(mysql.ErrBRJobNotFound)
 	ErrCTEMaxRecursionDepth           = dbterror.ClassExecutor.NewStd(mysql.ErrCTEMaxRecursionDepth)
+	ErrMissingJSONTableValue          = dbterror.ClassExecutor.NewStd(mysql.ErrMissingJSONTableValue)
+	ErrWrongJSONTableValue            = dbterror.ClassExecutor.NewStd(mysql.ErrWrongJSONTableValue)
 	ErrNotSupportedWithSem            = dbterror.ClassOptimizer.NewStd(mysql.ErrNotSupportedWithSem)
 	ErrPluginIsNotLoaded              = dbterror.ClassExecutor.NewStd(mysql.ErrPluginIsNotLoaded)
 	ErrSetPasswordAuthPlugin          = dbterror.ClassExecutor.NewStd(mysql.ErrSetPasswordAuthPlugin)
@@ -81,6 +83,51 @@ var (
 	ErrTruncateWrongInsertValue     = dbterror.ClassTable.NewStdErr(mysql.ErrTruncatedWrongValue, parser_mysql.Message("Incorrect %-.32s value: '%-.128s' for column '%.192s' at row %d", nil))
 	ErrExistsInHistoryPassword      = dbterror.ClassExecutor.NewStd(mysql.ErrExistsInHistoryPassword)
 
+	ErrEventAlreadyExists               = dbterror.ClassExecutor.NewStd(mysql.ErrEventAlreadyExists)
+	ErrEventDoesNotExist                = dbterror.ClassExecutor.NewStd(mysql.ErrEventDoesNotExist)
+	ErrEventIntervalNotPositiveOrTooBig = dbterror.ClassExecutor.NewStd(mysql.ErrEventIntervalNotPositiveOrTooBig)
+	ErrEventEndsBeforeStarts            = dbterror.ClassExecutor.NewStd(mysql.ErrEventEndsBeforeStarts)
+	ErrEventExecTimeInThePast           = dbterror.ClassExecutor.NewStd(mysql.ErrEventExecTimeInThePast)
+	ErrEventSameName                    = dbterror.ClassExecutor.NewStd(mysql.ErrEventSameName)
+	ErrEventRecursionForbidden          = dbterror.ClassExecutor.NewStd(mysql.ErrEventRecursionForbidden)
+	ErrEventCannotCreateInThePast       = dbterror.ClassExecutor.NewStd(mysql.ErrEventCannotCreateInThePast)
+	ErrEventCannotAlterInThePast        = dbterror.ClassExecutor.NewStd(mysql.ErrEventCannotAlterInThePast)
+
+	ErrSpAlreadyExists         = dbterror.ClassExecutor.NewStd(mysql.ErrSpAlreadyExists)
+	ErrSpDoesNotExist          = dbterror.ClassExecutor.NewStd(mysql.ErrSpDoesNotExist)
+	ErrSpLilabelMismatch       = dbterror.ClassExecutor.NewStd(mysql.ErrSpLilabelMismatch)
+	ErrSpLabelRedefine         = dbterror.ClassExecutor.NewStd(mysql.ErrSpLabelRedefine)
+	ErrSpLabelMismatch         = dbterror.ClassExecutor.NewStd(mysql.ErrSpLabelMismatch)
+	ErrSpBadselect             = dbterror.ClassExecutor.NewStd(mysql.ErrSpBadselect)
+	ErrSpBadstatement          = dbterror.ClassExecutor.NewStd(mysql.ErrSpBadstatement)
+	ErrSpWrongNoOfArgs         = dbterror.ClassExecutor.NewStd(mysql.ErrSpWrongNoOfArgs)
+	ErrSpCursorMismatch        = dbterror.ClassExecutor.NewStd(mysql.ErrSpCursorMismatch)
+	ErrSpCursorAlreadyOpen     = dbterror.ClassExecutor.NewStd(mysql.ErrSpCursorAlreadyOpen)
+	ErrSpCursorNotOpen         = dbterror.ClassExecutor.NewStd(mysql.ErrSpCursorNotOpen)
+	ErrSpUndeclaredVar         = dbterror.ClassExecutor.NewStd(mysql.ErrSpUndeclaredVar)
+	ErrSpWrongNoOfFetchArgs    = dbterror.ClassExecutor.NewStd(mysql.ErrSpWrongNoOfFetchArgs)
+	ErrSpFetchNoData           = dbterror.ClassExecutor.NewStd(mysql.ErrSpFetchNoData)
+	ErrSpDupParam              = dbterror.ClassExecutor.NewStd(mysql.ErrSpDupParam)
+	ErrSpDupVar                = dbterror.ClassExecutor.NewStd(mysql.ErrSpDupVar)
+	ErrSpDupCurs               = dbterror.ClassExecutor.NewStd(mysql.ErrSpDupCurs)
+	ErrSpVarcondAfterCurshndlr = dbterror.ClassExecutor.NewStd(mysql.ErrSpVarcondAfterCurshndlr)
+	ErrSpCursorAfterHandler    = dbterror.ClassExecutor.NewStd(mysql.ErrSpCursorAfterHandler)
+	ErrSpCaseNotFound          = dbterror.ClassExecutor.NewStd(mysql.ErrSpCaseNotFound)
+	ErrProcaccessDenied        = dbterror.ClassExecutor.NewStd(mysql.ErrProcaccessDenied)
+	ErrSpBadSQLstate           = dbterror.ClassExecutor.NewStd(mysql.ErrSpBadSQLstate)
+	ErrSpNotVarArg             = dbterror.ClassExecutor.NewStd(mysql.ErrSpNotVarArg)
+	ErrSpNoRetset              = dbterror.ClassExecutor.NewStd(mysql.ErrSpNoRetset)
+	ErrSpBadreturn             = dbterror.ClassExecutor.NewStd(mysql.ErrSpBadreturn)
+	ErrSpNoreturn              = dbterror.ClassExecutor.NewStd(mysql.ErrSpNoreturn)
+	ErrSpNoreturnend           = dbterror.ClassExecutor.NewStd(mysql.ErrSpNoreturnend)
+	ErrSpNoRecursion           = dbterror.ClassExecutor.NewStd(mysql.ErrSpNoRecursion)
+	ErrSpRecursionLimit        = dbterror.ClassExecutor.NewStd(mysql.ErrSpRecursionLimit)
+
+	ErrTrgCantChangeRow             = dbterror.ClassExecutor.NewStd(mysql.ErrTrgCantChangeRow)
+	ErrTrgNoSuchRowInTrg            = dbterror.ClassExecutor.NewStd(mysql.ErrTrgNoSuchRowInTrg)
+	ErrCommitNotAllowedInSfOrTrg    = dbterror.ClassExecutor.NewStd(mysql.ErrCommitNotAllowedInSfOrTrg)
+	ErrCantUpdateUsedTableInSfOrTrg = dbterror.ClassExecutor.NewStd(mysql.ErrCantUpdateUsedTableInSfOrTrg)
+
 	ErrWarnTooFewRecords              = dbterror.ClassExecutor.NewStd(mysql.ErrWarnTooFewRecords)
 	ErrWarnTooManyRecords             = dbterror.ClassExecutor.NewStd(mysql.ErrWarnTooManyRecords)
 	ErrLoadDataFromServerDisk         = dbterror.ClassExecutor.NewStd(mysql.ErrLoadDataFromServerDisk)
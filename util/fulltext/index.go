@@ -0,0 +1,334 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+)
+
+// A full-text index is an inverted index. Its entries are non-unique index entries whose values are
+// (token, term frequency) pairs, so the key of an entry is
+//
+//	tablePrefix{tableID}_indexPrefixSep{indexID}_{token}_{term frequency}_{handle}
+//
+// Every document also has an entry of the empty token whose term frequency is the length of the
+// document. The document count and the total length of the documents are kept in the counters of
+// the statsTag, so ranking doesn't scan the index. The counters are
+//
+//	tablePrefix{tableID}_indexPrefixSep{indexID}{statsTag}             the merged counter
+//	tablePrefix{tableID}_indexPrefixSep{indexID}{statsTag}{startTS}    the delta of a transaction
+//
+// A transaction only writes the delta of its own, so the concurrent DML never writes the same
+// counter. The deltas are merged into the merged counter later by MergeStats, and the counters are
+// rebuilt from the entries of the empty token after the index is backfilled. The statistics of the
+// index are the sum of all the counters.
+
+// IndexEntryLen is the number of values of a full-text index entry.
+const IndexEntryLen = 2
+
+// statsTag never conflicts with the entries, whose keys start with the flag of the encoded bytes.
+const statsTag byte = 's'
+
+
+// IndexEntries returns the values of the index entries of a document.
+func IndexEntries(doc *Document) [][]types.Datum {
+	tokens := make([]string, 0, len(doc.Freq))
+	for token := range doc.Freq {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+	entries := make([][]types.Datum, 0, len(tokens)+1)
+	entries = append(entries, []types.Datum{types.NewBytesDatum([]byte{}), types.NewIntDatum(doc.Len())})
+	for _, token := range tokens {
+		entries = append(entries, []types.Datum{types.NewBytesDatum([]byte(token)), types.NewIntDatum(doc.Freq[token])})
+	}
+	return entries
+}
+
+// IndexReader reads the entries of a full-text index.
+type IndexReader struct {
+	retriever kv.Retriever
+	prefix    kv.Key
+}
+
+// NewIndexReader creates an IndexReader of the index of the table.
+func NewIndexReader(retriever kv.Retriever, tableID, indexID int64) *IndexReader {
+	return &IndexReader{
+		retriever: retriever,
+		prefix:    tablecodec.EncodeTableIndexPrefix(tableID, indexID),
+	}
+}
+
+func (r *IndexReader) tokenKey(token []byte) (kv.Key, error) {
+	key := make([]byte, 0, len(r.prefix)+len(token)+10)
+	key = append(key, r.prefix...)
+	return codec.EncodeKey(nil, key, types.NewBytesDatum(token))
+}
+
+// Scan calls fn with the handle and the term frequency of every document that contains the token.
+// If prefix is true, it scans every token that starts with the given one.
+func (r *IndexReader) Scan(ctx context.Context, token string, prefix bool, fn func(token string, h kv.Handle, tf int64) error) error {
+	start, err := r.tokenKey([]byte(token))
+	if err != nil {
+		return err
+	}
+	end := start.PrefixNext()
+	if prefix {
+		if end, err = r.tokenKey(kv.Key(token).PrefixNext()); err != nil {
+			return err
+		}
+	}
+	it, err := r.retriever.Iter(start, end)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer it.Close()
+	for it.Valid() {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		key := it.Key()
+		values, _, err := tablecodec.CutIndexKeyNew(key, IndexEntryLen)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		_, t, err := codec.DecodeOne(values[0])
+		if err != nil {
+			return errors.Trace(err)
+		}
+		_, tf, err := codec.DecodeOne(values[1])
+		if err != nil {
+			return errors.Trace(err)
+		}
+		h, err := tablecodec.DecodeIndexHandle(key, it.Value(), IndexEntryLen)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err = fn(string(t.GetBytes()), h, tf.GetInt64()); err != nil {
+			return err
+		}
+		if err = it.Next(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func statsPrefix(tableID, indexID int64) kv.Key {
+	return append(tablecodec.EncodeTableIndexPrefix(tableID, indexID), statsTag)
+}
+
+func statsDeltaKey(tableID, indexID int64, startTS uint64) kv.Key {
+	return codec.EncodeUint(statsPrefix(tableID, indexID), startTS)
+}
+
+func encodeStats(docCount, totalLen int64) []byte {
+	return codec.EncodeVarint(codec.EncodeVarint(nil, docCount), totalLen)
+}
+
+func decodeStats(val []byte) (docCount, totalLen int64, err error) {
+	val, docCount, err = codec.DecodeVarint(val)
+	if err != nil {
+		return 0, 0, errors.Trace(err)
+	}
+	_, totalLen, err = codec.DecodeVarint(val)
+	return docCount, totalLen, errors.Trace(err)
+}
+
+// IsStatsKey returns whether the key is a counter of the statistics of a full-text index.
+func IsStatsKey(key kv.Key) bool {
+	prefixLen := len(tablecodec.EncodeTableIndexPrefix(0, 0))
+	return tablecodec.IsIndexKey(key) && len(key) > prefixLen && key[prefixLen] == statsTag
+}
+
+// UpdateStats adds the document whose length is docLen to the delta of the statistics of the index
+// of the transaction, or removes the document if remove is true.
+func UpdateStats(ctx context.Context, txn kv.Transaction, tableID, indexID int64, docLen int64, remove bool) error {
+	key := statsDeltaKey(tableID, indexID, txn.StartTS())
+	var docCount, totalLen int64
+	// The delta is only written by the transaction, so it's only in the memory buffer if it exists.
+	val, err := txn.GetMemBuffer().Get(ctx, key)
+	if err == nil {
+		if docCount, totalLen, err = decodeStats(val); err != nil {
+			return err
+		}
+	} else if !kv.ErrNotExist.Equal(err) {
+		return errors.Trace(err)
+	}
+	if remove {
+		docCount, totalLen = docCount-1, totalLen-docLen
+	} else {
+		docCount, totalLen = docCount+1, totalLen+docLen
+	}
+	return errors.Trace(txn.Set(key, encodeStats(docCount, totalLen)))
+}
+
+// MergeStats merges the deltas of the statistics of the index into the merged counter, and returns
+// the number of the merged deltas. The deltas of the transactions not committed yet are merged by
+// the next call. It only conflicts with the other merging or rebuilding of the index.
+func MergeStats(txn kv.Transaction, tableID, indexID int64) (int, error) {
+	return setStats(txn, tableID, indexID, 0, 0, true)
+}
+
+// RebuildStats rebuilds the statistics of the index from its entries of the empty token. It reads
+// every document of the index, so it's only used after the index is backfilled.
+func RebuildStats(ctx context.Context, txn kv.Transaction, tableID, indexID int64) error {
+	var docCount, totalLen int64
+	err := NewIndexReader(txn, tableID, indexID).Scan(ctx, "", false, func(_ string, _ kv.Handle, docLen int64) error {
+		docCount++
+		totalLen += docLen
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// The DML committed after the snapshot of txn is neither scanned nor merged, its delta is kept.
+	_, err = setStats(txn, tableID, indexID, docCount, totalLen, false)
+	return err
+}
+
+// setStats removes all the counters of the index visible to txn, and writes the merged counter of
+// docCount and totalLen, to which the removed counters are added if merge is true.
+func setStats(txn kv.Transaction, tableID, indexID int64, docCount, totalLen int64, merge bool) (int, error) {
+	prefix := statsPrefix(tableID, indexID)
+	it, err := txn.Iter(prefix, prefix.PrefixNext())
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	defer it.Close()
+	var deltas []kv.Key
+	for it.Valid() {
+		if merge {
+			count, length, err := decodeStats(it.Value())
+			if err != nil {
+				return 0, err
+			}
+			docCount, totalLen = docCount+count, totalLen+length
+		}
+		if len(it.Key()) > len(prefix) {
+			deltas = append(deltas, it.Key().Clone())
+		}
+		if err = it.Next(); err != nil {
+			return 0, errors.Trace(err)
+		}
+	}
+	if merge && len(deltas) == 0 {
+		return 0, nil
+	}
+	for _, key := range deltas {
+		if err = txn.Delete(key); err != nil {
+			return 0, errors.Trace(err)
+		}
+	}
+	return len(deltas), errors.Trace(txn.Set(prefix, encodeStats(docCount, totalLen)))
+}
+
+// IndexStats implements Stats by reading a full-text index. The document count and the average document
+// length are loaded when it's created, and the document frequencies are read lazily and cached, so an
+// IndexStats should only be used within one statement.
+type IndexStats struct {
+	reader   *IndexReader
+	docCount int64
+	totalLen int64
+
+	mu      sync.RWMutex
+	docFreq map[string]int64
+}
+
+// LoadIndexStats creates an IndexStats that reads the index by the reader, it loads the statistics of
+// the index and the document frequencies of the tokens.
+func LoadIndexStats(ctx context.Context, reader *IndexReader, tokens ...string) (*IndexStats, error) {
+	s := &IndexStats{reader: reader, docFreq: make(map[string]int64, len(tokens))}
+	found := false
+	prefix := append(reader.prefix.Clone(), statsTag)
+	it, err := reader.retriever.Iter(prefix, prefix.PrefixNext())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer it.Close()
+	for it.Valid() {
+		docCount, totalLen, err := decodeStats(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		s.docCount, s.totalLen, found = s.docCount+docCount, s.totalLen+totalLen, true
+		if err = it.Next(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if !found {
+		// The index has no counters if no document has ever been indexed, the scan is cheap then.
+		err = reader.Scan(ctx, "", false, func(_ string, _ kv.Handle, docLen int64) error {
+			s.docCount++
+			s.totalLen += docLen
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, token := range tokens {
+		if _, err = s.docFreqOf(ctx, token); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// DocCount implements the Stats interface.
+func (s *IndexStats) DocCount() (int64, error) {
+	return s.docCount, nil
+}
+
+// AvgDocLen implements the Stats interface.
+func (s *IndexStats) AvgDocLen() (float64, error) {
+	if s.docCount <= 0 {
+		return 0, nil
+	}
+	return float64(s.totalLen) / float64(s.docCount), nil
+}
+
+// DocFreq implements the Stats interface.
+func (s *IndexStats) DocFreq(token string) (int64, error) {
+	return s.docFreqOf(context.Background(), token)
+}
+
+func (s *IndexStats) docFreqOf(ctx context.Context, token string) (int64, error) {
+	s.mu.RLock()
+	df, ok := s.docFreq[token]
+	s.mu.RUnlock()
+	if ok {
+		return df, nil
+	}
+	err := s.reader.Scan(ctx, token, false, func(string, kv.Handle, int64) error {
+		df++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	s.docFreq[token] = df
+	s.mu.Unlock()
+	return df, nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Operator is how a term of a query affects whether a document matches.
+type Operator int
+
+const (
+	// OpOptional means the term is not required, but documents containing it rank higher.
+	OpOptional Operator = iota
+	// OpRequired means the term must be present, it is the `+` operator of the boolean mode.
+	OpRequired
+	// OpExcluded means the term must not be present, it is the `-` operator of the boolean mode.
+	OpExcluded
+)
+
+const (
+	// weightIncrease and weightDecrease are the factors applied by the `>` and `<` operators.
+	weightIncrease = 1.5
+	weightDecrease = 1 / weightIncrease
+)
+
+// Term is a term of a full-text query. It is either a word, a phrase or a parenthesized group.
+type Term struct {
+	Op Operator
+	// Weight scales the relevance contributed by the term, it is negative for a term with the `~` operator.
+	Weight float64
+	// Words is the single word of a word term or the words of a phrase term.
+	Words []string
+	// Prefix means the word is a prefix that matches every token starting with it, the `*` operator.
+	Prefix bool
+	// Group is the terms of a parenthesized group.
+	Group []*Term
+}
+
+// IsPhrase returns whether the term is a phrase.
+func (t *Term) IsPhrase() bool {
+	return len(t.Words) > 1
+}
+
+// Query is a parsed full-text search query.
+type Query struct {
+	Terms []*Term
+}
+
+// ParseQuery parses a query of the boolean mode or the natural language mode.
+func ParseQuery(tokenizer Tokenizer, query string, booleanMode bool) *Query {
+	if booleanMode {
+		return ParseBooleanQuery(tokenizer, query)
+	}
+	return ParseNaturalLanguageQuery(tokenizer, query)
+}
+
+// ParseNaturalLanguageQuery parses a query of the natural language mode, every token of which is an
+// optional term.
+func ParseNaturalLanguageQuery(tokenizer Tokenizer, query string) *Query {
+	tokens := tokenizer.Tokenize(nil, query)
+	terms := make([]*Term, 0, len(tokens))
+	for _, token := range tokens {
+		terms = append(terms, &Term{Op: OpOptional, Weight: 1, Words: []string{token}})
+	}
+	return &Query{Terms: terms}
+}
+
+// ParseBooleanQuery parses a query of the boolean mode. It supports the `+`, `-`, `>`, `<` and `~`
+// operators, `*` for prefixes, double-quoted phrases and parenthesized groups. A word that the
+// tokenizer splits into several tokens is treated as a phrase.
+func ParseBooleanQuery(tokenizer Tokenizer, query string) *Query {
+	p := &booleanQueryParser{tokenizer: tokenizer, query: []rune(query)}
+	return &Query{Terms: p.parseTerms(0)}
+}
+
+type booleanQueryParser struct {
+	tokenizer Tokenizer
+	query     []rune
+	pos       int
+}
+
+func (p *booleanQueryParser) parseTerms(depth int) []*Term {
+	var terms []*Term
+	for p.pos < len(p.query) {
+		r := p.query[p.pos]
+		if unicode.IsSpace(r) {
+			p.pos++
+			continue
+		}
+		if r == ')' {
+			p.pos++
+			if depth > 0 {
+				return terms
+			}
+			continue
+		}
+		term := &Term{Op: OpOptional, Weight: 1}
+		p.parseOperators(term)
+		if p.pos >= len(p.query) {
+			break
+		}
+		switch p.query[p.pos] {
+		case '(':
+			p.pos++
+			term.Group = p.parseTerms(depth + 1)
+			if len(term.Group) == 0 {
+				continue
+			}
+		case '"':
+			p.pos++
+			start := p.pos
+			for p.pos < len(p.query) && p.query[p.pos] != '"' {
+				p.pos++
+			}
+			term.Words = p.tokenizer.Tokenize(nil, string(p.query[start:p.pos]))
+			p.pos++
+			if len(term.Words) == 0 {
+				continue
+			}
+		default:
+			if !p.parseWord(term) {
+				continue
+			}
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+func (p *booleanQueryParser) parseOperators(term *Term) {
+	for ; p.pos < len(p.query); p.pos++ {
+		switch p.query[p.pos] {
+		case '+':
+			term.Op = OpRequired
+		case '-':
+			term.Op = OpExcluded
+		case '>':
+			term.Weight *= weightIncrease
+		case '<':
+			term.Weight *= weightDecrease
+		case '~':
+			term.Weight = -term.Weight
+		default:
+			return
+		}
+	}
+}
+
+// parseWord parses a word term, it returns false if the word has no token.
+func (p *booleanQueryParser) parseWord(term *Term) bool {
+	start := p.pos
+	for p.pos < len(p.query) {
+		r := p.query[p.pos]
+		if unicode.IsSpace(r) || r == '(' || r == ')' || r == '"' {
+			break
+		}
+		p.pos++
+	}
+	word := string(p.query[start:p.pos])
+	if strings.HasSuffix(word, "*") {
+		word = strings.TrimRight(word, "*")
+		term.Words = p.tokenizer.Tokenize(nil, word)
+		if len(term.Words) <= 1 {
+			// A prefix may be shorter than the minimum token size, so it is not filtered by the tokenizer.
+			var prefix string
+			splitWords(word, func(w string) {
+				if prefix == "" {
+					prefix = w
+				}
+			})
+			if prefix == "" {
+				return false
+			}
+			term.Words, term.Prefix = []string{prefix}, true
+		}
+		return true
+	}
+	term.Words = p.tokenizer.Tokenize(nil, word)
+	return len(term.Words) > 0
+}
+
+// IndexTokens returns the tokens whose documents may match the query, a document matches the query
+// only if it contains at least one of the tokens or one token starting with the prefixes. For a
+// phrase only its first word is returned.
+func (q *Query) IndexTokens() (tokens, prefixes []string) {
+	var collect func(terms []*Term)
+	collect = func(terms []*Term) {
+		for _, term := range terms {
+			switch {
+			case term.Op == OpExcluded:
+			case len(term.Group) > 0:
+				collect(term.Group)
+			case term.Prefix:
+				prefixes = append(prefixes, term.Words[0])
+			default:
+				tokens = append(tokens, term.Words[0])
+			}
+		}
+	}
+	collect(q.Terms)
+	return tokens, prefixes
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockStats struct {
+	docCount  int64
+	avgDocLen float64
+	docFreq   map[string]int64
+}
+
+func (s *mockStats) DocCount() (int64, error) {
+	return s.docCount, nil
+}
+
+func (s *mockStats) AvgDocLen() (float64, error) {
+	return s.avgDocLen, nil
+}
+
+func (s *mockStats) DocFreq(token string) (int64, error) {
+	return s.docFreq[token], nil
+}
+
+func TestParseBooleanQuery(t *testing.T) {
+	tokenizer, _ := NewTokenizer("")
+	q := ParseBooleanQuery(tokenizer, `+mysql -oracle >fast <slow ~legacy data* "full text search" (+green apple)`)
+	require.Len(t, q.Terms, 8)
+	require.Equal(t, OpRequired, q.Terms[0].Op)
+	require.Equal(t, OpExcluded, q.Terms[1].Op)
+	require.Greater(t, q.Terms[2].Weight, 1.0)
+	require.Less(t, q.Terms[3].Weight, 1.0)
+	require.Less(t, q.Terms[4].Weight, 0.0)
+	require.True(t, q.Terms[5].Prefix)
+	require.Equal(t, []string{"data"}, q.Terms[5].Words)
+	require.True(t, q.Terms[6].IsPhrase())
+	require.Equal(t, []string{"full", "text", "search"}, q.Terms[6].Words)
+	require.Len(t, q.Terms[7].Group, 2)
+
+	tokens, prefixes := q.IndexTokens()
+	require.Equal(t, []string{"mysql", "fast", "slow", "legacy", "full", "green", "apple"}, tokens)
+	require.Equal(t, []string{"data"}, prefixes)
+
+	// Stopwords and too short words are dropped.
+	require.Empty(t, ParseBooleanQuery(tokenizer, "+the -a ()").Terms)
+}
+
+func TestRelevance(t *testing.T) {
+	tokenizer, _ := NewTokenizer("")
+	stats := &mockStats{docCount: 4, avgDocLen: 4, docFreq: map[string]int64{"mysql": 2, "tidb": 1, "database": 3}}
+	doc := NewDocument(tokenizer, "TiDB is a distributed database", "compatible with MySQL")
+	require.Equal(t, int64(5), doc.Len())
+
+	matched, relevance, err := ParseQuery(tokenizer, "tidb", false).Relevance(doc, stats)
+	require.NoError(t, err)
+	require.True(t, matched)
+	require.Greater(t, relevance, 0.0)
+	// A rare token is more relevant than a common one.
+	_, common, err := ParseQuery(tokenizer, "database", false).Relevance(doc, stats)
+	require.NoError(t, err)
+	require.Less(t, common, relevance)
+
+	matched, _, err = ParseQuery(tokenizer, "postgres", false).Relevance(doc, stats)
+	require.NoError(t, err)
+	require.False(t, matched)
+
+	for _, c := range []struct {
+		query   string
+		matched bool
+	}{
+		{"+tidb +mysql", true},
+		{"+tidb -mysql", false},
+		{"+tidb +oracle", false},
+		{"distrib*", true},
+		{`"distributed database"`, true},
+		{`"database distributed"`, false},
+		{"+(oracle postgres) tidb", false},
+		{"-oracle", false},
+	} {
+		matched, _, err = ParseQuery(tokenizer, c.query, true).Relevance(doc, stats)
+		require.NoError(t, err)
+		require.Equal(t, c.matched, matched, c.query)
+	}
+}
+
+func TestBM25(t *testing.T) {
+	require.Zero(t, BM25(0, 10, 1, 10, 10))
+	// A higher term frequency and a shorter document are more relevant.
+	require.Greater(t, BM25(2, 10, 1, 10, 10), BM25(1, 10, 1, 10, 10))
+	require.Greater(t, BM25(1, 5, 1, 10, 10), BM25(1, 20, 1, 10, 10))
+	require.Greater(t, BM25(1, 10, 1, 10, 10), 0.0)
+}
@@ -0,0 +1,227 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	// bm25K1 controls the saturation of the term frequency.
+	bm25K1 = 1.2
+	// bm25B controls how much the document length normalizes the term frequency.
+	bm25B = 0.75
+)
+
+// Document is the tokenized text of a row.
+type Document struct {
+	// Tokens are the tokens of the document in order.
+	Tokens []string
+	// Freq is the number of occurrences of every distinct token.
+	Freq map[string]int64
+}
+
+// NewDocument tokenizes the texts of a row. The texts of different columns are concatenated.
+func NewDocument(tokenizer Tokenizer, texts ...string) *Document {
+	doc := &Document{Freq: make(map[string]int64)}
+	for _, text := range texts {
+		doc.Tokens = tokenizer.Tokenize(doc.Tokens, text)
+	}
+	for _, token := range doc.Tokens {
+		doc.Freq[token]++
+	}
+	return doc
+}
+
+// Len returns the number of tokens of the document.
+func (d *Document) Len() int64 {
+	return int64(len(d.Tokens))
+}
+
+// Stats provides the statistics of the indexed documents that are needed to rank a document.
+type Stats interface {
+	// DocCount returns the number of indexed documents.
+	DocCount() (int64, error)
+	// AvgDocLen returns the average number of tokens of the indexed documents.
+	AvgDocLen() (float64, error)
+	// DocFreq returns the number of indexed documents that contain the token.
+	DocFreq(token string) (int64, error)
+}
+
+// BM25 returns the Okapi BM25 relevance of a token which occurs tf times in a document of docLen
+// tokens, given that df of the n indexed documents contain the token.
+func BM25(tf, docLen, df, n int64, avgDocLen float64) float64 {
+	if tf <= 0 {
+		return 0
+	}
+	if df > n {
+		// The document may not be indexed yet.
+		n = df
+	}
+	idf := math.Log(1 + (float64(n-df)+0.5)/(float64(df)+0.5))
+	norm := 1.0
+	if avgDocLen > 0 {
+		norm = 1 - bm25B + bm25B*float64(docLen)/avgDocLen
+	}
+	return idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + bm25K1*norm)
+}
+
+// Relevance returns whether the document matches the query and its relevance. The relevance is
+// the BM25 score of the matched terms scaled by their weights.
+func (q *Query) Relevance(doc *Document, stats Stats) (matched bool, relevance float64, err error) {
+	r := &ranker{doc: doc, stats: stats}
+	return r.rankTerms(q.Terms)
+}
+
+type ranker struct {
+	doc       *Document
+	stats     Stats
+	n         int64
+	avgDocLen float64
+	loaded    bool
+}
+
+func (r *ranker) bm25(tf int64, token string) (float64, error) {
+	if !r.loaded {
+		var err error
+		if r.n, err = r.stats.DocCount(); err != nil {
+			return 0, err
+		}
+		if r.avgDocLen, err = r.stats.AvgDocLen(); err != nil {
+			return 0, err
+		}
+		r.loaded = true
+	}
+	df, err := r.stats.DocFreq(token)
+	if err != nil {
+		return 0, err
+	}
+	return BM25(tf, r.doc.Len(), df, r.n, r.avgDocLen), nil
+}
+
+// rankTerms ranks a list of terms. The list matches if all the required terms match, none of the
+// excluded terms matches, and at least one optional term matches when there is no required term.
+func (r *ranker) rankTerms(terms []*Term) (bool, float64, error) {
+	var hasRequired, optionalMatched bool
+	relevance := 0.0
+	for _, term := range terms {
+		matched, score, err := r.rankTerm(term)
+		if err != nil {
+			return false, 0, err
+		}
+		switch term.Op {
+		case OpRequired:
+			if !matched {
+				return false, 0, nil
+			}
+			hasRequired = true
+		case OpExcluded:
+			if matched {
+				return false, 0, nil
+			}
+			continue
+		case OpOptional:
+			if !matched {
+				continue
+			}
+			optionalMatched = true
+		}
+		relevance += score
+	}
+	if !hasRequired && !optionalMatched {
+		return false, 0, nil
+	}
+	return true, relevance, nil
+}
+
+func (r *ranker) rankTerm(term *Term) (bool, float64, error) {
+	if len(term.Group) > 0 {
+		matched, score, err := r.rankTerms(term.Group)
+		return matched, term.Weight * score, err
+	}
+	score := 0.0
+	switch {
+	case term.Prefix:
+		for token, tf := range r.doc.Freq {
+			if !strings.HasPrefix(token, term.Words[0]) {
+				continue
+			}
+			s, err := r.bm25(tf, token)
+			if err != nil {
+				return false, 0, err
+			}
+			score += s
+		}
+	case term.IsPhrase():
+		tf := r.phraseFreq(term.Words)
+		if tf == 0 {
+			return false, 0, nil
+		}
+		// The rarest word of the phrase approximates the frequency of the phrase in other documents.
+		rarest, rarestDF := "", int64(math.MaxInt64)
+		for _, word := range term.Words {
+			df, err := r.stats.DocFreq(word)
+			if err != nil {
+				return false, 0, err
+			}
+			if df < rarestDF {
+				rarest, rarestDF = word, df
+			}
+		}
+		s, err := r.bm25(tf, rarest)
+		if err != nil {
+			return false, 0, err
+		}
+		score = s
+	default:
+		tf := r.doc.Freq[term.Words[0]]
+		if tf == 0 {
+			return false, 0, nil
+		}
+		s, err := r.bm25(tf, term.Words[0])
+		if err != nil {
+			return false, 0, err
+		}
+		score = s
+	}
+	if score == 0 {
+		return false, 0, nil
+	}
+	return true, term.Weight * score, nil
+}
+
+// phraseFreq returns the number of occurrences of the words in a row in the document.
+func (r *ranker) phraseFreq(words []string) int64 {
+	for _, word := range words {
+		if r.doc.Freq[word] == 0 {
+			return 0
+		}
+	}
+	var freq int64
+	for i := 0; i+len(words) <= len(r.doc.Tokens); i++ {
+		matched := true
+		for j, word := range words {
+			if r.doc.Tokens[i+j] != word {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			freq++
+		}
+	}
+	return freq
+}
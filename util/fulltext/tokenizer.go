@@ -0,0 +1,125 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// ParserNgram is the name of the ngram full-text parser.
+	ParserNgram = "ngram"
+	// MinTokenSize is the minimum length of a token of the built-in parser, the same as innodb_ft_min_token_size.
+	MinTokenSize = 3
+	// MaxTokenSize is the maximum length of a token of the built-in parser, the same as innodb_ft_max_token_size.
+	MaxTokenSize = 84
+	// NgramTokenSize is the length of the tokens of the ngram parser, the same as ngram_token_size.
+	NgramTokenSize = 2
+)
+
+// stopwords is the default stopword list of InnoDB, see INFORMATION_SCHEMA.INNODB_FT_DEFAULT_STOPWORD.
+var stopwords = map[string]struct{}{
+	"a": {}, "about": {}, "an": {}, "are": {}, "as": {}, "at": {}, "be": {}, "by": {}, "com": {},
+	"de": {}, "en": {}, "for": {}, "from": {}, "how": {}, "i": {}, "in": {}, "is": {}, "it": {},
+	"la": {}, "of": {}, "on": {}, "or": {}, "that": {}, "the": {}, "this": {}, "to": {}, "was": {},
+	"what": {}, "when": {}, "where": {}, "who": {}, "will": {}, "with": {}, "und": {}, "www": {},
+}
+
+// Tokenizer splits text into normalized tokens.
+type Tokenizer interface {
+	// Tokenize appends the tokens of text to tokens in the order they appear.
+	Tokenize(tokens []string, text string) []string
+}
+
+// NewTokenizer returns the tokenizer of the named full-text parser, the empty name stands for the
+// built-in parser. It returns false if there is no such parser.
+func NewTokenizer(parser string) (Tokenizer, bool) {
+	switch strings.ToLower(parser) {
+	case "":
+		return wordTokenizer{}, true
+	case ParserNgram:
+		return ngramTokenizer{n: NgramTokenSize}, true
+	}
+	return nil, false
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// splitWords calls fn with every lower-cased run of word characters of text.
+func splitWords(text string, fn func(word string)) {
+	start := -1
+	for i, r := range text {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			fn(strings.ToLower(text[start:i]))
+			start = -1
+		}
+	}
+	if start >= 0 {
+		fn(strings.ToLower(text[start:]))
+	}
+}
+
+// wordTokenizer is the built-in parser. It splits text at non-word characters and drops the words
+// which are stopwords or whose length is out of [MinTokenSize, MaxTokenSize].
+type wordTokenizer struct{}
+
+// Tokenize implements the Tokenizer interface.
+func (wordTokenizer) Tokenize(tokens []string, text string) []string {
+	splitWords(text, func(word string) {
+		if n := utf8.RuneCountInString(word); n < MinTokenSize || n > MaxTokenSize {
+			return
+		}
+		if _, ok := stopwords[word]; ok {
+			return
+		}
+		tokens = append(tokens, word)
+	})
+	return tokens
+}
+
+// ngramTokenizer is the ngram parser. It produces every sequence of n contiguous characters of the
+// words of text, which makes it suitable for ideographic languages that do not use word delimiters.
+type ngramTokenizer struct {
+	n int
+}
+
+// Tokenize implements the Tokenizer interface.
+func (t ngramTokenizer) Tokenize(tokens []string, text string) []string {
+	splitWords(text, func(word string) {
+		// offsets holds the byte offsets of the last n+1 character boundaries.
+		offsets := make([]int, 0, t.n+1)
+		for i := range word {
+			offsets = append(offsets, i)
+			if len(offsets) > t.n {
+				tokens = append(tokens, word[offsets[0]:i])
+				offsets = offsets[1:]
+			}
+		}
+		if len(offsets) == t.n {
+			tokens = append(tokens, word[offsets[0]:])
+		}
+	})
+	return tokens
+}
@@ -0,0 +1,40 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordTokenizer(t *testing.T) {
+	tokenizer, ok := NewTokenizer("")
+	require.True(t, ok)
+	require.Equal(t, []string{"quick", "brown", "fox", "jumps", "over", "lazy", "dog"},
+		tokenizer.Tokenize(nil, "The Quick brown-fox jumps over? the lazy DOG"))
+	require.Equal(t, []string{"tidb_server", "2023"}, tokenizer.Tokenize(nil, "an tidb_server, 2023"))
+	require.Empty(t, tokenizer.Tokenize(nil, "it is on at"))
+
+	_, ok = NewTokenizer("mecab")
+	require.False(t, ok)
+}
+
+func TestNgramTokenizer(t *testing.T) {
+	tokenizer, ok := NewTokenizer("NGRAM")
+	require.True(t, ok)
+	require.Equal(t, []string{"数据", "据库", "ab"}, tokenizer.Tokenize(nil, "数据库 ab c"))
+	require.Equal(t, []string{"ti", "id", "db"}, tokenizer.Tokenize(nil, "TiDB"))
+}
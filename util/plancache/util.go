@@ -15,6 +15,8 @@
 package util
 
 import (
+	"time"
+
 	"github.com/pingcap/tidb/types"
 )
 
@@ -34,3 +36,15 @@ type PlanCacheMatchOpts struct {
 	// Below are some variables that can affect the plan
 	ForeignKeyChecks bool
 }
+
+// InstancePlanCacheEntry is the information of a cached plan in the instance plan cache.
+type InstancePlanCacheEntry struct {
+	SchemaName     string
+	SQLDigest      string
+	SQLText        string
+	PlanDigest     string
+	MemoryUsage    int64
+	HitCount       uint64
+	LoadTime       time.Time
+	LastActiveTime time.Time
+}
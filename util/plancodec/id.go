@@ -139,6 +139,12 @@ const (
 	TypeSequence = "Sequence"
 	// TypeScalarSubQuery is the type of ScalarQuery
 	TypeScalarSubQuery = "ScalarSubQuery"
+	// TypeJSONTable is the type of JSON_TABLE.
+	TypeJSONTable = "JSONTable"
+	// TypeFullTextIndexReader is the type of FullTextIndexReader.
+	TypeFullTextIndexReader = "FullTextIndexReader"
+	// TypeVectorIndexReader is the type of VectorIndexReader.
+	TypeVectorIndexReader = "VectorIndexReader"
 )
 
 // plan id.
@@ -204,6 +210,9 @@ const (
 	typeExpandID              int = 58
 	typeImportIntoID          int = 59
 	TypeScalarSubQueryID      int = 60
+	typeJSONTableID           int = 61
+	typeFullTextIndexReaderID int = 62
+	typeVectorIndexReaderID   int = 63
 )
 
 // TypeStringToPhysicalID converts the plan type string to plan id.
@@ -329,6 +338,12 @@ func TypeStringToPhysicalID(tp string) int {
 		return typeImportIntoID
 	case TypeScalarSubQuery:
 		return TypeScalarSubQueryID
+	case TypeJSONTable:
+		return typeJSONTableID
+	case TypeFullTextIndexReader:
+		return typeFullTextIndexReaderID
+	case TypeVectorIndexReader:
+		return typeVectorIndexReaderID
 	}
 	// Should never reach here.
 	return 0
@@ -457,6 +472,12 @@ func PhysicalIDToTypeString(id int) string {
 		return TypeImportInto
 	case TypeScalarSubQueryID:
 		return TypeScalarSubQuery
+	case typeJSONTableID:
+		return TypeJSONTable
+	case typeFullTextIndexReaderID:
+		return TypeFullTextIndexReader
+	case typeVectorIndexReaderID:
+		return TypeVectorIndexReader
 	}
 
 	// Should never reach here.
@@ -342,7 +342,7 @@ func appendDatumForChecksum(buf []byte, dat *data.Datum, typ byte) (out []byte,
 		out = binary.LittleEndian.AppendUint64(buf, v)
 	case mysql.TypeJSON:
 		out = appendLengthValue(buf, []byte(dat.GetMysqlJSON().String()))
-	case mysql.TypeNull, mysql.TypeGeometry:
+	case mysql.TypeNull, mysql.TypeGeometry, mysql.TypeVector:
 		out = buf
 	default:
 		return buf, errInvalidChecksumTyp
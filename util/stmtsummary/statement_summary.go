@@ -381,6 +381,27 @@ type BindableStmt struct {
 	Charset   string
 	Collation string
 	Users     map[string]struct{} // which users have processed this stmt
+
+	// The following fields are only filled by GetBindablePlans.
+	Digest     string
+	PlanDigest string
+	ExecCount  int64
+	SumLatency time.Duration
+	FirstSeen  time.Time
+	LastSeen   time.Time
+}
+
+// AvgLatency returns the average latency of the statement.
+func (s *BindableStmt) AvgLatency() time.Duration {
+	if s.ExecCount <= 0 {
+		return 0
+	}
+	return s.SumLatency / time.Duration(s.ExecCount)
+}
+
+// IsBindableStmtType checks whether bindings can be created on the type of statements.
+func IsBindableStmtType(stmtType string) bool {
+	return stmtType == "Select" || stmtType == "Delete" || stmtType == "Update" || stmtType == "Insert" || stmtType == "Replace"
 }
 
 // GetMoreThanCntBindableStmt gets users' select/update/delete SQLs that occurred more than the specified count.
@@ -426,6 +447,59 @@ func (ssMap *stmtSummaryByDigestMap) GetMoreThanCntBindableStmt(cnt int64) []*Bi
 	return stmts
 }
 
+// GetBindablePlans gets the execution summary of every plan of users' bindable SQLs, which is accumulated
+// over all the history intervals.
+func (ssMap *stmtSummaryByDigestMap) GetBindablePlans() []*BindableStmt {
+	ssMap.Lock()
+	values := ssMap.summaryMap.Values()
+	ssMap.Unlock()
+
+	stmts := make([]*BindableStmt, 0, len(values))
+	for _, value := range values {
+		ssbd := value.(*stmtSummaryByDigest)
+		func() {
+			ssbd.Lock()
+			defer ssbd.Unlock()
+			if !ssbd.initialized || !IsBindableStmtType(ssbd.stmtType) || ssbd.history.Len() == 0 {
+				return
+			}
+			stmt := &BindableStmt{
+				Schema:     ssbd.schemaName,
+				Digest:     ssbd.digest,
+				PlanDigest: ssbd.planDigest,
+				Users:      make(map[string]struct{}),
+			}
+			for e := ssbd.history.Front(); e != nil; e = e.Next() {
+				ssElement := e.Value.(*stmtSummaryByDigestElement)
+				ssElement.Lock()
+				// The latest interval decides the sample of the statement.
+				stmt.Query = ssElement.sampleSQL
+				if ssElement.prepared {
+					stmt.Query = ssbd.normalizedSQL
+				}
+				stmt.PlanHint = ssElement.planHint
+				stmt.Charset = ssElement.charset
+				stmt.Collation = ssElement.collation
+				stmt.ExecCount += ssElement.execCount
+				stmt.SumLatency += ssElement.sumLatency
+				if stmt.FirstSeen.IsZero() || ssElement.firstSeen.Before(stmt.FirstSeen) {
+					stmt.FirstSeen = ssElement.firstSeen
+				}
+				if ssElement.lastSeen.After(stmt.LastSeen) {
+					stmt.LastSeen = ssElement.lastSeen
+				}
+				maps.Copy(stmt.Users, ssElement.authUsers)
+				ssElement.Unlock()
+			}
+			// Empty auth users means that it is an internal queries.
+			if len(stmt.Users) > 0 {
+				stmts = append(stmts, stmt)
+			}
+		}()
+	}
+	return stmts
+}
+
 // SetEnabled enables or disables statement summary
 func (ssMap *stmtSummaryByDigestMap) SetEnabled(value bool) error {
 	// `optEnabled` and `ssMap` don't need to be strictly atomically updated.
@@ -377,6 +377,45 @@ func (s *StmtSummary) GetMoreThanCntBindableStmt(cnt int64) []*stmtsummary.Binda
 	return stmts
 }
 
+// GetBindablePlans is used to get the execution summary of every plan of the bindable statements.
+// Like GetMoreThanCntBindableStmt, only the current window in memory is referred to.
+func (s *StmtSummary) GetBindablePlans() []*stmtsummary.BindableStmt {
+	s.windowLock.Lock()
+	values := s.window.lru.Values()
+	s.windowLock.Unlock()
+	stmts := make([]*stmtsummary.BindableStmt, 0, len(values))
+	for _, value := range values {
+		record := value.(*lockedStmtRecord)
+		func() {
+			record.Lock()
+			defer record.Unlock()
+			if !stmtsummary.IsBindableStmtType(record.StmtType) || len(record.AuthUsers) == 0 {
+				return
+			}
+			stmt := &stmtsummary.BindableStmt{
+				Schema:     record.SchemaName,
+				Query:      record.SampleSQL,
+				PlanHint:   record.PlanHint,
+				Charset:    record.Charset,
+				Collation:  record.Collation,
+				Users:      make(map[string]struct{}),
+				Digest:     record.Digest,
+				PlanDigest: record.PlanDigest,
+				ExecCount:  record.ExecCount,
+				SumLatency: record.SumLatency,
+				FirstSeen:  record.FirstSeen,
+				LastSeen:   record.LastSeen,
+			}
+			maps.Copy(stmt.Users, record.AuthUsers)
+			if record.Prepared {
+				stmt.Query = record.NormalizedSQL
+			}
+			stmts = append(stmts, stmt)
+		}()
+	}
+	return stmts
+}
+
 func (s *StmtSummary) rotateLoop() {
 	tick := time.NewTicker(defaultRotateCheckInterval * time.Second)
 	defer tick.Stop()
@@ -611,3 +650,12 @@ func GetMoreThanCntBindableStmt(frequency int64) []*stmtsummary.BindableStmt {
 	}
 	return stmtsummary.StmtSummaryByDigestMap.GetMoreThanCntBindableStmt(frequency)
 }
+
+// GetBindablePlans wraps GlobalStmtSummary.GetBindablePlans and
+// stmtsummary.StmtSummaryByDigestMap.GetBindablePlans.
+func GetBindablePlans() []*stmtsummary.BindableStmt {
+	if config.GetGlobalConfig().Instance.StmtSummaryEnablePersistent {
+		return GlobalStmtSummary.GetBindablePlans()
+	}
+	return stmtsummary.StmtSummaryByDigestMap.GetBindablePlans()
+}
@@ -0,0 +1,22 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vectorindex
+
+import "github.com/pingcap/tidb/kv"
+
+// EntryShardForTest returns the shard of the entry point of the row for test.
+func EntryShardForTest(h kv.Handle) byte {
+	return metaShard(encodeHandle(h))
+}
@@ -0,0 +1,656 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vectorindex
+
+import (
+	"container/heap"
+	"context"
+	"hash/fnv"
+	"math"
+	"sort"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/mathutil"
+	"golang.org/x/exp/slices"
+)
+
+// A vector index is an HNSW (Hierarchical Navigable Small World) graph stored in the index key space.
+// Every row is a node of the graph, whose key is
+//
+//	tablePrefix{tableID}_indexPrefixSep{indexID}{nodeTag}{handle}
+//
+// and whose value is the level of the node, the vector, and the neighbors of the node on every layer
+// from 0 to its level. The entry points of the graph are stored in the keys of the metaTag, which are
+// sharded by the handle:
+//
+//	tablePrefix{tableID}_indexPrefixSep{indexID}{metaTag}{shard}
+//
+// A node becomes the entry point of its shard if the shard is empty or the node is at a higher level
+// than the entry point, and the search starts from the entry points of all the shards. So the rows
+// of different shards never write the same entry point key.
+//
+// The graph is read and written in the transaction of the row changes, so the index is always
+// consistent with the rows. Inserting a node changes the neighbor lists of some nearby nodes, so
+// concurrent writes to the nearby rows may still encounter write conflicts.
+
+const (
+	// maxNeighbors is the max number of neighbors of a node on the layers above 0, which is the M of HNSW.
+	maxNeighbors = 16
+	// maxNeighbors0 is the max number of neighbors of a node on layer 0.
+	maxNeighbors0 = 2 * maxNeighbors
+	// efConstruction is the size of the dynamic candidate list when inserting a node.
+	efConstruction = 64
+	// maxLevel limits the level of the nodes.
+	maxLevel = 16
+
+	// DefaultEfSearch is the min size of the dynamic candidate list when searching the graph.
+	DefaultEfSearch = 40
+
+	metaTag byte = 'm'
+	nodeTag byte = 'n'
+	// metaShards is the number of the shards of the entry points.
+	metaShards = 16
+)
+
+// levelMult is the mL of HNSW, which is 1/ln(M).
+var levelMult = 1 / math.Log(maxNeighbors)
+
+type node struct {
+	handle kv.Handle
+	// key is the encoded handle, which identifies the node.
+	key       string
+	level     int
+	vec       types.Vector
+	neighbors [][]string
+}
+
+type meta struct {
+	entry string
+	level int
+}
+
+// SearchResult is a row found by the nearest neighbor search.
+type SearchResult struct {
+	Handle   kv.Handle
+	Distance float64
+}
+
+// Graph reads and writes the HNSW graph of a vector index. The nodes read are cached, so a Graph
+// should only be used within one operation.
+type Graph struct {
+	retriever      kv.Retriever
+	prefix         kv.Key
+	isCommonHandle bool
+	distance       types.VectorDistanceFunc
+
+	nodes map[string]*node
+	// metas are the entry points of the shards, nil if the shard is empty.
+	metas   [metaShards]*meta
+	loaded  bool
+	dirty   map[string]*node
+	removed map[string]struct{}
+	// dirtyMetas are the shards whose entry points are changed.
+	dirtyMetas map[byte]struct{}
+}
+
+// NewGraph creates a Graph of the vector index of the table.
+func NewGraph(retriever kv.Retriever, tblInfo *model.TableInfo, physicalID int64, idxInfo *model.IndexInfo) *Graph {
+	return &Graph{
+		retriever:      retriever,
+		prefix:         tablecodec.EncodeTableIndexPrefix(physicalID, idxInfo.ID),
+		isCommonHandle: tblInfo.IsCommonHandle,
+		distance:       distanceOf(idxInfo.VectorDistanceMetric),
+		nodes:          make(map[string]*node),
+		dirty:          make(map[string]*node),
+		removed:        make(map[string]struct{}),
+		dirtyMetas:     make(map[byte]struct{}),
+	}
+}
+
+func (g *Graph) tagKey(tag byte) kv.Key {
+	key := make([]byte, 0, len(g.prefix)+1)
+	key = append(key, g.prefix...)
+	return append(key, tag)
+}
+
+func (g *Graph) nodeKey(key string) kv.Key {
+	return append(g.tagKey(nodeTag), key...)
+}
+
+func encodeHandle(h kv.Handle) string {
+	return string(h.Encoded())
+}
+
+func (g *Graph) decodeHandle(key string) (kv.Handle, error) {
+	if g.isCommonHandle {
+		return kv.NewCommonHandle([]byte(key))
+	}
+	_, v, err := codec.DecodeInt([]byte(key))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return kv.IntHandle(v), nil
+}
+
+func metaShard(key string) byte {
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(key))
+	return byte(hasher.Sum32() % metaShards)
+}
+
+// loadMetas loads the entry points of all the shards.
+func (g *Graph) loadMetas() error {
+	if g.loaded {
+		return nil
+	}
+	g.loaded = true
+	start := g.tagKey(metaTag)
+	it, err := g.retriever.Iter(start, start.PrefixNext())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer it.Close()
+	for it.Valid() {
+		key := it.Key()
+		if len(key) != len(start)+1 || key[len(start)] >= metaShards {
+			return errors.Errorf("invalid entry point key %v of the vector index", key)
+		}
+		val, level, err := codec.DecodeVarint(it.Value())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		_, entry, err := codec.DecodeCompactBytes(val)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		g.metas[key[len(start)]] = &meta{entry: string(entry), level: int(level)}
+		if err = it.Next(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (g *Graph) setMeta(shard byte, m *meta) {
+	g.metas[shard] = m
+	g.dirtyMetas[shard] = struct{}{}
+}
+
+// loadNode returns the node of the encoded handle, nil is returned if the node doesn't exist.
+func (g *Graph) loadNode(ctx context.Context, key string) (*node, error) {
+	if n, ok := g.nodes[key]; ok {
+		return n, nil
+	}
+	if _, ok := g.removed[key]; ok {
+		return nil, nil
+	}
+	val, err := g.retriever.Get(ctx, g.nodeKey(key))
+	if kv.IsErrNotFound(err) {
+		g.nodes[key] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	n, err := g.decodeNode(key, val)
+	if err != nil {
+		return nil, err
+	}
+	g.nodes[key] = n
+	return n, nil
+}
+
+func (g *Graph) decodeNode(key string, val []byte) (*node, error) {
+	h, err := g.decodeHandle(key)
+	if err != nil {
+		return nil, err
+	}
+	val, level, err := codec.DecodeVarint(val)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	val, vecData, err := codec.DecodeCompactBytes(val)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	vec, err := types.ParseVectorBinary(vecData)
+	if err != nil {
+		return nil, err
+	}
+	n := &node{handle: h, key: key, level: int(level), vec: vec, neighbors: make([][]string, level+1)}
+	for l := range n.neighbors {
+		var cnt int64
+		if val, cnt, err = codec.DecodeVarint(val); err != nil {
+			return nil, errors.Trace(err)
+		}
+		n.neighbors[l] = make([]string, 0, cnt)
+		for i := int64(0); i < cnt; i++ {
+			var neighbor []byte
+			if val, neighbor, err = codec.DecodeCompactBytes(val); err != nil {
+				return nil, errors.Trace(err)
+			}
+			n.neighbors[l] = append(n.neighbors[l], string(neighbor))
+		}
+	}
+	return n, nil
+}
+
+func encodeNode(n *node) []byte {
+	vecData := n.vec.Encode()
+	val := make([]byte, 0, len(vecData)+16)
+	val = codec.EncodeVarint(val, int64(n.level))
+	val = codec.EncodeCompactBytes(val, vecData)
+	for _, neighbors := range n.neighbors {
+		val = codec.EncodeVarint(val, int64(len(neighbors)))
+		for _, neighbor := range neighbors {
+			val = codec.EncodeCompactBytes(val, []byte(neighbor))
+		}
+	}
+	return val
+}
+
+// randomLevel returns the level of a new node. It's derived from the hash of the handle rather than
+// a random number, so a row keeps its level when it's re-inserted.
+func randomLevel(key string) int {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(key))
+	u := (float64(hasher.Sum64()>>11) + 1) / (1 << 53)
+	level := int(-math.Log(u) * levelMult)
+	if level > maxLevel {
+		level = maxLevel
+	}
+	return level
+}
+
+func maxNeighborsOf(level int) int {
+	if level == 0 {
+		return maxNeighbors0
+	}
+	return maxNeighbors
+}
+
+type candidate struct {
+	n        *node
+	distance float64
+}
+
+// candidateHeap is a min-heap of the candidates by distance, or a max-heap if reverse is true.
+type candidateHeap struct {
+	items   []candidate
+	reverse bool
+}
+
+func (h *candidateHeap) Len() int { return len(h.items) }
+
+func (h *candidateHeap) Less(i, j int) bool {
+	if h.reverse {
+		return h.items[i].distance > h.items[j].distance
+	}
+	return h.items[i].distance < h.items[j].distance
+}
+
+func (h *candidateHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *candidateHeap) Push(x any) { h.items = append(h.items, x.(candidate)) }
+
+func (h *candidateHeap) Pop() any {
+	item := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return item
+}
+
+// searchLayer returns at most ef nodes closest to q on the layer, which are found by a greedy search
+// from the entry points. The result is sorted by distance.
+func (g *Graph) searchLayer(ctx context.Context, q types.Vector, entries []candidate, ef, layer int) ([]candidate, error) {
+	visited := make(map[string]struct{}, ef*maxNeighbors0)
+	candidates := &candidateHeap{}
+	results := &candidateHeap{reverse: true}
+	for _, e := range entries {
+		visited[e.n.key] = struct{}{}
+		heap.Push(candidates, e)
+		heap.Push(results, e)
+		if results.Len() > ef {
+			heap.Pop(results)
+		}
+	}
+	for candidates.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		c := heap.Pop(candidates).(candidate)
+		if results.Len() >= ef && c.distance > results.items[0].distance {
+			break
+		}
+		if layer >= len(c.n.neighbors) {
+			continue
+		}
+		for _, key := range c.n.neighbors[layer] {
+			if _, ok := visited[key]; ok {
+				continue
+			}
+			visited[key] = struct{}{}
+			n, err := g.loadNode(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			// The dangling neighbor is skipped.
+			if n == nil || len(n.vec) != len(q) {
+				continue
+			}
+			d := g.distance(q, n.vec)
+			if results.Len() < ef || d < results.items[0].distance {
+				heap.Push(candidates, candidate{n: n, distance: d})
+				heap.Push(results, candidate{n: n, distance: d})
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+	sorted := results.items
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].distance < sorted[j].distance })
+	return sorted, nil
+}
+
+// entryPoints returns the entry points of the graph for the search on the layer, which are found by
+// the greedy search on the layers above it from the entry points of all the shards. It also returns
+// the top level of the graph.
+func (g *Graph) entryPoints(ctx context.Context, q types.Vector, layer int) ([]candidate, int, error) {
+	if err := g.loadMetas(); err != nil {
+		return nil, 0, err
+	}
+	var (
+		eps      []candidate
+		topLevel int
+	)
+	for _, m := range g.metas {
+		if m == nil || slices.ContainsFunc(eps, func(c candidate) bool { return c.n.key == m.entry }) {
+			continue
+		}
+		entry, err := g.loadNode(ctx, m.entry)
+		if err != nil {
+			return nil, 0, err
+		}
+		if entry == nil || len(entry.vec) != len(q) {
+			continue
+		}
+		eps = append(eps, candidate{n: entry, distance: g.distance(q, entry.vec)})
+		topLevel = mathutil.Max(topLevel, entry.level)
+	}
+	// The entry points of the shards may not be connected, so as many candidates as the entry points
+	// are kept on the upper layers.
+	ef := len(eps)
+	for l := topLevel; l > layer && len(eps) > 0; l-- {
+		var err error
+		if eps, err = g.searchLayer(ctx, q, eps, ef, l); err != nil {
+			return nil, 0, err
+		}
+	}
+	return eps, topLevel, nil
+}
+
+// Search returns at most k rows nearest to q, ef is the size of the dynamic candidate list.
+func (g *Graph) Search(ctx context.Context, q types.Vector, k, ef int) ([]SearchResult, error) {
+	if ef < k {
+		ef = k
+	}
+	eps, _, err := g.entryPoints(ctx, q, 0)
+	if err != nil || len(eps) == 0 {
+		return nil, err
+	}
+	found, err := g.searchLayer(ctx, q, eps, ef, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) > k {
+		found = found[:k]
+	}
+	results := make([]SearchResult, 0, len(found))
+	for _, c := range found {
+		results = append(results, SearchResult{Handle: c.n.handle, Distance: c.distance})
+	}
+	return results, nil
+}
+
+func (g *Graph) markDirty(n *node) {
+	g.dirty[n.key] = n
+}
+
+// shrinkNeighbors keeps the closest neighbors of the node on the layer.
+func (g *Graph) shrinkNeighbors(ctx context.Context, n *node, layer int) error {
+	limit := maxNeighborsOf(layer)
+	if len(n.neighbors[layer]) <= limit {
+		return nil
+	}
+	candidates := make([]candidate, 0, len(n.neighbors[layer]))
+	for _, key := range n.neighbors[layer] {
+		neighbor, err := g.loadNode(ctx, key)
+		if err != nil {
+			return err
+		}
+		if neighbor == nil || len(neighbor.vec) != len(n.vec) {
+			continue
+		}
+		candidates = append(candidates, candidate{n: neighbor, distance: g.distance(n.vec, neighbor.vec)})
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	n.neighbors[layer] = n.neighbors[layer][:0]
+	for _, c := range candidates {
+		n.neighbors[layer] = append(n.neighbors[layer], c.n.key)
+	}
+	return nil
+}
+
+func addNeighbor(n *node, layer int, key string) {
+	for _, neighbor := range n.neighbors[layer] {
+		if neighbor == key {
+			return
+		}
+	}
+	n.neighbors[layer] = append(n.neighbors[layer], key)
+}
+
+// Insert adds the row to the graph. If the row is already in the graph with a different vector, it's re-inserted.
+func (g *Graph) Insert(ctx context.Context, h kv.Handle, vec types.Vector) error {
+	key := encodeHandle(h)
+	old, err := g.loadNode(ctx, key)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if slices.Equal(old.vec, vec) {
+			return nil
+		}
+		if err = g.Delete(ctx, h); err != nil {
+			return err
+		}
+	}
+	n := &node{handle: h, key: key, level: randomLevel(key), vec: vec}
+	n.neighbors = make([][]string, n.level+1)
+	eps, topLevel, err := g.entryPoints(ctx, vec, n.level)
+	if err != nil {
+		return err
+	}
+	delete(g.removed, key)
+	g.nodes[key] = n
+	g.markDirty(n)
+	shard := metaShard(key)
+	if m := g.metas[shard]; m == nil || n.level > m.level {
+		g.setMeta(shard, &meta{entry: key, level: n.level})
+	}
+	if len(eps) == 0 {
+		return nil
+	}
+	for l := mathutil.Min(topLevel, n.level); l >= 0; l-- {
+		found, err := g.searchLayer(ctx, vec, eps, efConstruction, l)
+		if err != nil {
+			return err
+		}
+		limit := maxNeighborsOf(l)
+		for _, c := range found {
+			if len(n.neighbors[l]) >= limit {
+				break
+			}
+			if c.n.key == key {
+				continue
+			}
+			n.neighbors[l] = append(n.neighbors[l], c.n.key)
+			addNeighbor(c.n, l, key)
+			if err = g.shrinkNeighbors(ctx, c.n, l); err != nil {
+				return err
+			}
+			g.markDirty(c.n)
+		}
+		eps = found
+	}
+	return nil
+}
+
+// Delete removes the row from the graph. The neighbors of the node are connected with each other to
+// repair the graph.
+func (g *Graph) Delete(ctx context.Context, h kv.Handle) error {
+	key := encodeHandle(h)
+	n, err := g.loadNode(ctx, key)
+	if err != nil || n == nil {
+		return err
+	}
+	for l, neighbors := range n.neighbors {
+		for _, neighborKey := range neighbors {
+			neighbor, err := g.loadNode(ctx, neighborKey)
+			if err != nil {
+				return err
+			}
+			if neighbor == nil || l >= len(neighbor.neighbors) {
+				continue
+			}
+			kept := neighbor.neighbors[l][:0]
+			for _, k := range neighbor.neighbors[l] {
+				if k != key {
+					kept = append(kept, k)
+				}
+			}
+			neighbor.neighbors[l] = kept
+			for _, k := range neighbors {
+				if k != neighborKey {
+					addNeighbor(neighbor, l, k)
+				}
+			}
+			if err = g.shrinkNeighbors(ctx, neighbor, l); err != nil {
+				return err
+			}
+			g.markDirty(neighbor)
+		}
+	}
+	g.nodes[key] = nil
+	delete(g.dirty, key)
+	g.removed[key] = struct{}{}
+
+	if err = g.loadMetas(); err != nil {
+		return err
+	}
+	var newEntry *meta
+	for shard, m := range g.metas {
+		if m == nil || m.entry != key {
+			continue
+		}
+		// Choose the neighbor of the highest level as the new entry point of the shard.
+		if newEntry == nil {
+			for l := len(n.neighbors) - 1; l >= 0 && newEntry == nil; l-- {
+				for _, k := range n.neighbors[l] {
+					neighbor, err := g.loadNode(ctx, k)
+					if err != nil {
+						return err
+					}
+					if neighbor != nil && (newEntry == nil || neighbor.level > newEntry.level) {
+						newEntry = &meta{entry: k, level: neighbor.level}
+					}
+				}
+			}
+		}
+		g.setMeta(byte(shard), newEntry)
+	}
+	for _, m := range g.metas {
+		if m != nil {
+			return nil
+		}
+	}
+	// The node is isolated and no shard has an entry point, any other node can be the entry point.
+	return g.pickAnyEntry()
+}
+
+func (g *Graph) pickAnyEntry() error {
+	start := g.tagKey(nodeTag)
+	it, err := g.retriever.Iter(start, start.PrefixNext())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer it.Close()
+	for it.Valid() {
+		key := string(it.Key()[len(start):])
+		if _, ok := g.removed[key]; !ok {
+			n, err := g.decodeNode(key, it.Value())
+			if err != nil {
+				return err
+			}
+			g.setMeta(metaShard(key), &meta{entry: key, level: n.level})
+			return nil
+		}
+		if err = it.Next(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// Flush writes the changed nodes to the transaction.
+func (g *Graph) Flush(mutator kv.Mutator) error {
+	for key := range g.removed {
+		if err := mutator.Delete(g.nodeKey(key)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for key, n := range g.dirty {
+		if err := mutator.Set(g.nodeKey(key), encodeNode(n)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for shard := range g.dirtyMetas {
+		key := append(g.tagKey(metaTag), shard)
+		m := g.metas[shard]
+		if m == nil {
+			if err := mutator.Delete(key); err != nil {
+				return errors.Trace(err)
+			}
+			continue
+		}
+		val := codec.EncodeVarint(nil, int64(m.level))
+		val = codec.EncodeCompactBytes(val, []byte(m.entry))
+		if err := mutator.Set(key, val); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	g.dirty = make(map[string]*node)
+	g.removed = make(map[string]struct{})
+	g.dirtyMetas = make(map[byte]struct{})
+	return nil
+}
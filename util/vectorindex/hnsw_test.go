@@ -0,0 +1,222 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vectorindex_test
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/store/mockstore"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/vectorindex"
+	"github.com/stretchr/testify/require"
+)
+
+func randomVector(r *rand.Rand, dims int) types.Vector {
+	v := make(types.Vector, dims)
+	for i := range v {
+		v[i] = r.Float32()*2 - 1
+	}
+	return v
+}
+
+func bruteForce(vecs map[int64]types.Vector, q types.Vector, k int) []int64 {
+	handles := make([]int64, 0, len(vecs))
+	for h := range vecs {
+		handles = append(handles, h)
+	}
+	sort.Slice(handles, func(i, j int) bool {
+		return types.VectorL2Distance(vecs[handles[i]], q) < types.VectorL2Distance(vecs[handles[j]], q)
+	})
+	return handles[:k]
+}
+
+func recall(t *testing.T, g *vectorindex.Graph, vecs map[int64]types.Vector, q types.Vector, k int) float64 {
+	results, err := g.Search(context.Background(), q, k, vectorindex.DefaultEfSearch)
+	require.NoError(t, err)
+	require.Len(t, results, k)
+	found := make(map[int64]struct{}, k)
+	for i, res := range results {
+		_, ok := vecs[res.Handle.IntValue()]
+		require.True(t, ok)
+		require.Equal(t, types.VectorL2Distance(vecs[res.Handle.IntValue()], q), res.Distance)
+		if i > 0 {
+			require.LessOrEqual(t, results[i-1].Distance, res.Distance)
+		}
+		found[res.Handle.IntValue()] = struct{}{}
+	}
+	hit := 0
+	for _, h := range bruteForce(vecs, q, k) {
+		if _, ok := found[h]; ok {
+			hit++
+		}
+	}
+	return float64(hit) / float64(k)
+}
+
+func TestGraph(t *testing.T) {
+	store, err := mockstore.NewMockStore()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+	ctx := context.Background()
+	tblInfo := &model.TableInfo{ID: 1}
+	idxInfo := &model.IndexInfo{ID: 1, Tp: model.IndexTypeHNSW, VectorDistanceMetric: vectorindex.MetricL2}
+	r := rand.New(rand.NewSource(1))
+
+	// Every row is inserted in its own transaction like the DML.
+	vecs := make(map[int64]types.Vector)
+	for h := int64(0); h < 500; h++ {
+		txn, err := store.Begin()
+		require.NoError(t, err)
+		g := vectorindex.NewGraph(txn, tblInfo, tblInfo.ID, idxInfo)
+		vecs[h] = randomVector(r, 8)
+		require.NoError(t, g.Insert(ctx, kv.IntHandle(h), vecs[h]))
+		require.NoError(t, g.Flush(txn))
+		require.NoError(t, txn.Commit(ctx))
+	}
+
+	check := func() {
+		snapshot := store.GetSnapshot(kv.MaxVersion)
+		total := 0.0
+		for i := 0; i < 20; i++ {
+			total += recall(t, vectorindex.NewGraph(snapshot, tblInfo, tblInfo.ID, idxInfo), vecs, randomVector(r, 8), 10)
+		}
+		require.GreaterOrEqual(t, total/20, 0.9)
+	}
+	check()
+
+	// Delete half of the rows, including the entry point, in one transaction.
+	txn, err := store.Begin()
+	require.NoError(t, err)
+	g := vectorindex.NewGraph(txn, tblInfo, tblInfo.ID, idxInfo)
+	for h := int64(0); h < 500; h += 2 {
+		require.NoError(t, g.Delete(ctx, kv.IntHandle(h)))
+		delete(vecs, h)
+	}
+	// Deleting a row not in the graph is a no-op.
+	require.NoError(t, g.Delete(ctx, kv.IntHandle(1000)))
+	require.NoError(t, g.Flush(txn))
+	require.NoError(t, txn.Commit(ctx))
+	check()
+
+	// Updating a vector re-inserts the row.
+	txn, err = store.Begin()
+	require.NoError(t, err)
+	g = vectorindex.NewGraph(txn, tblInfo, tblInfo.ID, idxInfo)
+	vecs[1] = types.Vector{10, 10, 10, 10, 10, 10, 10, 10}
+	require.NoError(t, g.Insert(ctx, kv.IntHandle(1), vecs[1]))
+	require.NoError(t, g.Flush(txn))
+	results, err := g.Search(ctx, types.Vector{9, 9, 9, 9, 9, 9, 9, 9}, 1, vectorindex.DefaultEfSearch)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, int64(1), results[0].Handle.IntValue())
+	require.NoError(t, txn.Commit(ctx))
+	check()
+
+	// Delete all the rows.
+	txn, err = store.Begin()
+	require.NoError(t, err)
+	g = vectorindex.NewGraph(txn, tblInfo, tblInfo.ID, idxInfo)
+	for h := range vecs {
+		require.NoError(t, g.Delete(ctx, kv.IntHandle(h)))
+	}
+	require.NoError(t, g.Flush(txn))
+	results, err = g.Search(ctx, types.Vector{0, 0, 0, 0, 0, 0, 0, 0}, 1, vectorindex.DefaultEfSearch)
+	require.NoError(t, err)
+	require.Len(t, results, 0)
+	require.NoError(t, txn.Commit(ctx))
+}
+
+func TestConcurrentInsert(t *testing.T) {
+	store, err := mockstore.NewMockStore()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+	ctx := context.Background()
+	tblInfo := &model.TableInfo{ID: 1}
+	idxInfo := &model.IndexInfo{ID: 1, Tp: model.IndexTypeHNSW, VectorDistanceMetric: vectorindex.MetricL2}
+	r := rand.New(rand.NewSource(1))
+
+	// The rows of different shards are inserted into the empty graph by concurrent transactions, which
+	// don't write the same entry point key.
+	var handles []int64
+	shards := make(map[byte]struct{})
+	for h := int64(0); len(handles) < 8; h++ {
+		shard := vectorindex.EntryShardForTest(kv.IntHandle(h))
+		if _, ok := shards[shard]; !ok {
+			shards[shard] = struct{}{}
+			handles = append(handles, h)
+		}
+	}
+	vecs := make(map[int64]types.Vector, len(handles))
+	txns := make([]kv.Transaction, 0, len(handles))
+	for _, h := range handles {
+		vecs[h] = randomVector(r, 8)
+		txn, err := store.Begin()
+		require.NoError(t, err)
+		txns = append(txns, txn)
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, len(handles))
+	for i, h := range handles {
+		wg.Add(1)
+		go func(i int, h int64) {
+			defer wg.Done()
+			g := vectorindex.NewGraph(txns[i], tblInfo, tblInfo.ID, idxInfo)
+			if errs[i] = g.Insert(ctx, kv.IntHandle(h), vecs[h]); errs[i] != nil {
+				return
+			}
+			if errs[i] = g.Flush(txns[i]); errs[i] != nil {
+				return
+			}
+			errs[i] = txns[i].Commit(ctx)
+		}(i, h)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	// The search starts from the entry points of all the shards, so every row is found.
+	g := vectorindex.NewGraph(store.GetSnapshot(kv.MaxVersion), tblInfo, tblInfo.ID, idxInfo)
+	results, err := g.Search(ctx, randomVector(r, 8), len(handles), vectorindex.DefaultEfSearch)
+	require.NoError(t, err)
+	require.Len(t, results, len(handles))
+
+	// The rows inserted later connect the entry points of the shards.
+	for h := int64(1000); h < 1200; h++ {
+		txn, err := store.Begin()
+		require.NoError(t, err)
+		g := vectorindex.NewGraph(txn, tblInfo, tblInfo.ID, idxInfo)
+		vecs[h] = randomVector(r, 8)
+		require.NoError(t, g.Insert(ctx, kv.IntHandle(h), vecs[h]))
+		require.NoError(t, g.Flush(txn))
+		require.NoError(t, txn.Commit(ctx))
+	}
+	snapshot := store.GetSnapshot(kv.MaxVersion)
+	total := 0.0
+	for i := 0; i < 20; i++ {
+		total += recall(t, vectorindex.NewGraph(snapshot, tblInfo, tblInfo.ID, idxInfo), vecs, randomVector(r, 8), 10)
+	}
+	require.GreaterOrEqual(t, total/20, 0.9)
+}
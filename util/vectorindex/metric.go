@@ -0,0 +1,75 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vectorindex
+
+import (
+	"math"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/types"
+)
+
+// The distance metrics of the vector indexes, a vector index only serves the distance function of its metric.
+const (
+	MetricL2           = "L2"
+	MetricCosine       = "COSINE"
+	MetricInnerProduct = "INNER_PRODUCT"
+	MetricL1           = "L1"
+)
+
+var metricDistanceFuncs = map[string]string{
+	MetricL2:           ast.VecL2Distance,
+	MetricCosine:       ast.VecCosineDistance,
+	MetricInnerProduct: ast.VecNegativeInnerProduct,
+	MetricL1:           ast.VecL1Distance,
+}
+
+// MetricOfDistanceFunc returns the metric of a vector distance function.
+func MetricOfDistanceFunc(funcName string) (string, bool) {
+	for metric, name := range metricDistanceFuncs {
+		if name == funcName {
+			return metric, true
+		}
+	}
+	return "", false
+}
+
+// DistanceFuncName returns the name of the distance function of the metric.
+func DistanceFuncName(metric string) string {
+	return metricDistanceFuncs[metric]
+}
+
+// distanceOf returns the distance function of the metric. The undefined distances, such as the
+// cosine distance of a zero vector, are regarded as the farthest.
+func distanceOf(metric string) types.VectorDistanceFunc {
+	var distance types.VectorDistanceFunc
+	switch metric {
+	case MetricCosine:
+		distance = types.VectorCosineDistance
+	case MetricInnerProduct:
+		distance = types.VectorNegativeInnerProduct
+	case MetricL1:
+		distance = types.VectorL1Distance
+	default:
+		distance = types.VectorL2Distance
+	}
+	return func(a, b types.Vector) float64 {
+		d := distance(a, b)
+		if math.IsNaN(d) {
+			return math.Inf(1)
+		}
+		return d
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vectorindex
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetric(t *testing.T) {
+	metric, ok := MetricOfDistanceFunc(ast.VecCosineDistance)
+	require.True(t, ok)
+	require.Equal(t, MetricCosine, metric)
+	require.Equal(t, ast.VecCosineDistance, DistanceFuncName(metric))
+	_, ok = MetricOfDistanceFunc(ast.VecL2Norm)
+	require.False(t, ok)
+
+	distance := distanceOf(MetricCosine)
+	require.Equal(t, 0.0, distance(types.Vector{1, 0}, types.Vector{2, 0}))
+	require.True(t, distance(types.Vector{0, 0}, types.Vector{1, 0}) > 2)
+}